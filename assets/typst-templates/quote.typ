@@ -0,0 +1,161 @@
+#import "default.typ": parse-date, format-date, format-number, format-currency
+
+#let quote-data = json(sys.inputs.path)
+
+#let currency = quote-data.at("currency", default: "$")
+#let precision = quote-data.at("precision", default: 2)
+#let quote-number = quote-data.at("quote_number", default: "")
+#let biller = quote-data.at("biller", default: (:))
+#let recipient = quote-data.at("recipient", default: (:))
+#let items = quote-data.at("line_items", default: ())
+#let phases = quote-data.at("phases", default: ())
+#let subtotal = quote-data.at("subtotal", default: 0)
+#let discount = quote-data.at("total_discount", default: 0)
+#let total = quote-data.at("total", default: 0)
+
+#let display-date = (date-str) => {
+  if date-str == "" { "--" } else { format-date(parse-date(date-str)) }
+}
+
+#let issuing-date = if quote-data.at("issuing_date", default: "") != "" {
+  quote-data.issuing_date
+} else {
+  datetime.today().display("[year]-[month]-[day]")
+}
+
+#let start-date = quote-data.at("start_date", default: "")
+
+#set document(
+  title: "Quote " + quote-number,
+  date: parse-date(issuing-date),
+)
+
+#set page(
+  margin: (top: 12mm, right: 10mm, bottom: 10mm, left: 10mm),
+  numbering: none,
+)
+
+#set text(font: "Inter", size: 9pt)
+
+#set table(stroke: none)
+
+#text(weight: "bold", size: 2.2em)[Quote]
+
+#v(0.8em)
+
+#text(weight: "medium", size: 10pt)[Quote number:] #text(size: 10pt, fill: rgb("#666666"))[#quote-number] \
+#text(weight: "medium", size: 10pt)[Date of issue:] #text(size: 10pt, fill: rgb("#666666"))[#display-date(issuing-date)] \
+#text(weight: "medium", size: 10pt)[Valid until:] #text(size: 10pt, fill: rgb("#666666"))[#display-date(quote-data.at("valid_until", default: ""))] \
+#text(weight: "medium", size: 10pt)[Subscription start:] #text(size: 10pt, fill: rgb("#666666"))[#if start-date != "" { display-date(start-date) } else { "On acceptance" }] \
+#text(weight: "medium", size: 10pt)[Billing period:] #text(size: 10pt, fill: rgb("#666666"))[#quote-data.at("billing_period", default: "--")]
+
+#line(length: 100%, stroke: 0.5pt + rgb("#e0e0e0"))
+
+#v(1.2em)
+
+#grid(
+  columns: (1fr, 1fr),
+  gutter: 0.8em,
+  [
+    #text(weight: "semibold", size: 11pt)[From]
+    #v(0.3em)
+    #text(weight: "semibold", size: 10pt)[#biller.at("name", default: "")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#biller.at("email", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#biller.at("address", default: (:)).at("street", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#biller.at("address", default: (:)).at("city", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#biller.at("address", default: (:)).at("postal_code", default: "--")]
+  ],
+  [
+    #text(weight: "semibold", size: 11pt)[Prepared for]
+    #v(0.3em)
+    #text(weight: "semibold", size: 10pt)[#recipient.at("name", default: "")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#recipient.at("email", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#recipient.at("address", default: (:)).at("street", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#recipient.at("address", default: (:)).at("city", default: "--")] \
+    #text(size: 9pt, fill: rgb("#666666"))[#recipient.at("address", default: (:)).at("postal_code", default: "--")]
+  ]
+)
+
+#if quote-data.at("description", default: "") != "" {
+  v(1em)
+  text(size: 10pt)[#quote-data.description]
+}
+
+#v(1.5em)
+
+// Quoted line items, amounts are per billing period
+#table(
+  columns: (3fr, 1.5fr, 0.8fr, 0.8fr, 1.2fr),
+  inset: (top: 10pt, bottom: 10pt, left: 8pt, right: 8pt),
+  align: (left, left, center, right, right),
+  fill: (x, y) => if y == 0 { rgb("#f8f9fa") } else { white },
+  stroke: (x, y) => (
+    bottom: if y == 0 { 1pt + rgb("#e9ecef") } else { 0.5pt + rgb("#e9ecef") },
+  ),
+  table.header(
+    [#text(weight: "semibold", size: 10pt, fill: rgb("#2c3e50"))[Item]],
+    [#text(weight: "semibold", size: 10pt, fill: rgb("#2c3e50"))[Phase]],
+    [#text(weight: "semibold", size: 10pt, fill: rgb("#2c3e50"))[Quantity]],
+    [#text(weight: "semibold", size: 10pt, fill: rgb("#2c3e50"))[Discount]],
+    [#text(weight: "semibold", size: 10pt, fill: rgb("#2c3e50"))[Amount]],
+  ),
+  ..items.map((item) => {
+    let discount-percentage = item.at("discount_percentage", default: 0)
+    (
+      [#item.at("display_name", default: "")],
+      [#if item.at("phase", default: "") != "" { item.phase } else { "All" }],
+      [#format-number(item.at("quantity", default: 0))],
+      [#if discount-percentage > 0 { [#format-number(discount-percentage)%] } else { "-" }],
+      [#if item.at("usage", default: false) and item.amount == 0 {
+        "Billed on usage"
+      } else {
+        [#currency #format-currency(item.amount, precision: precision)]
+      }],
+    )
+  }).flatten()
+)
+
+#v(1em)
+
+#align(right,
+  table(
+    columns: 2,
+    align: (left, right),
+    inset: 6pt,
+    stroke: none,
+    [Subtotal], [#currency#format-currency(subtotal, precision: precision)],
+    ..if discount > 0 { ([Discount], [−#currency#format-currency(discount, precision: precision)]) } else { () },
+    table.hline(stroke: 0.5pt + black),
+    [*Total per period*], [*#currency#format-currency(total, precision: precision)*],
+  )
+)
+
+// Phases of the subscription
+#if phases.len() > 0 {
+  v(2em)
+  text(weight: "medium", size: 1.1em)[Phases]
+  v(0.5em)
+
+  table(
+    columns: (2fr, 1fr, 1fr, 1fr, 1fr),
+    inset: 8pt,
+    align: (left, left, left, left, right),
+    stroke: (x, y) => (
+      bottom: 1pt + rgb("#e0e0e0"),
+    ),
+    table.header(
+      [*Phase*],
+      [*Duration*],
+      [*Start*],
+      [*End*],
+      [*Amount per period*],
+    ),
+    ..phases.map((phase) => (
+      [#phase.at("name", default: "")],
+      [#if phase.at("duration_periods", default: 0) > 0 { [#phase.duration_periods periods] } else { "Ongoing" }],
+      [#display-date(phase.at("start_date", default: ""))],
+      [#display-date(phase.at("end_date", default: ""))],
+      [#currency#format-currency(phase.at("amount", default: 0), precision: precision)],
+    )).flatten()
+  )
+}
@@ -170,6 +170,10 @@ func main() {
 			repository.NewScheduledTaskRepository,
 			repository.NewPriceUnitRepository,
 			repository.NewWorkflowExecutionRepository,
+			repository.NewExperimentRepository,
+			repository.NewExperimentAssignmentRepository,
+			repository.NewQuoteRepository,
+			repository.NewSubscriptionMigrationResultRepository,
 			repository.NewRawEventRepository,
 
 			// PubSub
@@ -248,6 +252,11 @@ func main() {
 			service.NewDashboardService,
 			service.NewWorkflowExecutionService,
 			service.NewWorkflowService,
+			service.NewPricingSimulationService,
+			service.NewSubscriptionMigrationService,
+			service.NewCatalogService,
+			service.NewExperimentService,
+			service.NewQuoteService,
 
 			// Enterprise (ee) services
 			ee.NewEnterpriseParams,
@@ -337,6 +346,11 @@ func provideHandlers(
 	customerPortalService service.CustomerPortalService,
 	dashboardService service.DashboardService,
 	workflowService service.WorkflowService,
+	pricingSimulationService service.PricingSimulationService,
+	subscriptionMigrationService service.SubscriptionMigrationService,
+	catalogService service.CatalogService,
+	experimentService service.ExperimentService,
+	quoteService service.QuoteService,
 ) api.Handlers {
 	return api.Handlers{
 		Events:                   v1.NewEventsHandler(eventService, eventPostProcessingService, featureUsageTrackingService, rawEventsReprocessingService, cfg, logger),
@@ -387,6 +401,11 @@ func provideHandlers(
 		CustomerPortal:           v1.NewCustomerPortalHandler(customerPortalService, logger),
 		Dashboard:                v1.NewDashboardHandler(dashboardService, logger),
 		Workflow:                 v1.NewWorkflowHandler(workflowService, logger),
+		PricingSimulation:        v1.NewPricingSimulationHandler(pricingSimulationService, logger),
+		SubscriptionMigration:    v1.NewSubscriptionMigrationHandler(subscriptionMigrationService, logger),
+		Catalog:                  v1.NewCatalogHandler(catalogService, logger),
+		Experiment:               v1.NewExperimentHandler(experimentService, logger),
+		Quote:                    v1.NewQuoteHandler(quoteService, logger),
 	}
 }
 
@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/addonassociation"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // AddonAssociation is the model entity for the AddonAssociation schema.
@@ -42,6 +44,14 @@ type AddonAssociation struct {
 	StartDate *time.Time `json:"start_date,omitempty"`
 	// EndDate holds the value of the "end_date" field.
 	EndDate *time.Time `json:"end_date,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// BillingPeriod holds the value of the "billing_period" field.
+	BillingPeriod types.BillingPeriod `json:"billing_period,omitempty"`
+	// BillingPeriodCount holds the value of the "billing_period_count" field.
+	BillingPeriodCount int `json:"billing_period_count,omitempty"`
+	// TrialEnd holds the value of the "trial_end" field.
+	TrialEnd *time.Time `json:"trial_end,omitempty"`
 	// AddonStatus holds the value of the "addon_status" field.
 	AddonStatus string `json:"addon_status,omitempty"`
 	// CancellationReason holds the value of the "cancellation_reason" field.
@@ -60,9 +70,13 @@ func (*AddonAssociation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case addonassociation.FieldMetadata:
 			values[i] = new([]byte)
-		case addonassociation.FieldID, addonassociation.FieldTenantID, addonassociation.FieldStatus, addonassociation.FieldCreatedBy, addonassociation.FieldUpdatedBy, addonassociation.FieldEnvironmentID, addonassociation.FieldEntityID, addonassociation.FieldEntityType, addonassociation.FieldAddonID, addonassociation.FieldAddonStatus, addonassociation.FieldCancellationReason:
+		case addonassociation.FieldQuantity:
+			values[i] = new(decimal.Decimal)
+		case addonassociation.FieldBillingPeriodCount:
+			values[i] = new(sql.NullInt64)
+		case addonassociation.FieldID, addonassociation.FieldTenantID, addonassociation.FieldStatus, addonassociation.FieldCreatedBy, addonassociation.FieldUpdatedBy, addonassociation.FieldEnvironmentID, addonassociation.FieldEntityID, addonassociation.FieldEntityType, addonassociation.FieldAddonID, addonassociation.FieldBillingPeriod, addonassociation.FieldAddonStatus, addonassociation.FieldCancellationReason:
 			values[i] = new(sql.NullString)
-		case addonassociation.FieldCreatedAt, addonassociation.FieldUpdatedAt, addonassociation.FieldStartDate, addonassociation.FieldEndDate, addonassociation.FieldCancelledAt:
+		case addonassociation.FieldCreatedAt, addonassociation.FieldUpdatedAt, addonassociation.FieldStartDate, addonassociation.FieldEndDate, addonassociation.FieldTrialEnd, addonassociation.FieldCancelledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -159,6 +173,31 @@ func (aa *AddonAssociation) assignValues(columns []string, values []any) error {
 				aa.EndDate = new(time.Time)
 				*aa.EndDate = value.Time
 			}
+		case addonassociation.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				aa.Quantity = *value
+			}
+		case addonassociation.FieldBillingPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_period", values[i])
+			} else if value.Valid {
+				aa.BillingPeriod = types.BillingPeriod(value.String)
+			}
+		case addonassociation.FieldBillingPeriodCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_period_count", values[i])
+			} else if value.Valid {
+				aa.BillingPeriodCount = int(value.Int64)
+			}
+		case addonassociation.FieldTrialEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_end", values[i])
+			} else if value.Valid {
+				aa.TrialEnd = new(time.Time)
+				*aa.TrialEnd = value.Time
+			}
 		case addonassociation.FieldAddonStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field addon_status", values[i])
@@ -262,6 +301,20 @@ func (aa *AddonAssociation) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("billing_period=")
+	builder.WriteString(fmt.Sprintf("%v", aa.BillingPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("billing_period_count=")
+	builder.WriteString(fmt.Sprintf("%v", aa.BillingPeriodCount))
+	builder.WriteString(", ")
+	if v := aa.TrialEnd; v != nil {
+		builder.WriteString("trial_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("addon_status=")
 	builder.WriteString(aa.AddonStatus)
 	builder.WriteString(", ")
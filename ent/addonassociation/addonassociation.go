@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -37,6 +38,14 @@ const (
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldBillingPeriod holds the string denoting the billing_period field in the database.
+	FieldBillingPeriod = "billing_period"
+	// FieldBillingPeriodCount holds the string denoting the billing_period_count field in the database.
+	FieldBillingPeriodCount = "billing_period_count"
+	// FieldTrialEnd holds the string denoting the trial_end field in the database.
+	FieldTrialEnd = "trial_end"
 	// FieldAddonStatus holds the string denoting the addon_status field in the database.
 	FieldAddonStatus = "addon_status"
 	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
@@ -64,6 +73,10 @@ var Columns = []string{
 	FieldAddonID,
 	FieldStartDate,
 	FieldEndDate,
+	FieldQuantity,
+	FieldBillingPeriod,
+	FieldBillingPeriodCount,
+	FieldTrialEnd,
 	FieldAddonStatus,
 	FieldCancellationReason,
 	FieldCancelledAt,
@@ -101,6 +114,10 @@ var (
 	AddonIDValidator func(string) error
 	// DefaultStartDate holds the default value on creation for the "start_date" field.
 	DefaultStartDate func() time.Time
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity decimal.Decimal
+	// DefaultBillingPeriodCount holds the default value on creation for the "billing_period_count" field.
+	DefaultBillingPeriodCount int
 	// DefaultAddonStatus holds the default value on creation for the "addon_status" field.
 	DefaultAddonStatus string
 	// AddonStatusValidator is a validator for the "addon_status" field. It is called by the builders before save.
@@ -175,6 +192,26 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
 }
 
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByBillingPeriod orders the results by the billing_period field.
+func ByBillingPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingPeriod, opts...).ToFunc()
+}
+
+// ByBillingPeriodCount orders the results by the billing_period_count field.
+func ByBillingPeriodCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingPeriodCount, opts...).ToFunc()
+}
+
+// ByTrialEnd orders the results by the trial_end field.
+func ByTrialEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialEnd, opts...).ToFunc()
+}
+
 // ByAddonStatus orders the results by the addon_status field.
 func ByAddonStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddonStatus, opts...).ToFunc()
@@ -7,6 +7,8 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -124,6 +126,27 @@ func EndDate(v time.Time) predicate.AddonAssociation {
 	return predicate.AddonAssociation(sql.FieldEQ(FieldEndDate, v))
 }
 
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldQuantity, v))
+}
+
+// BillingPeriod applies equality check predicate on the "billing_period" field. It's identical to BillingPeriodEQ.
+func BillingPeriod(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodCount applies equality check predicate on the "billing_period_count" field. It's identical to BillingPeriodCountEQ.
+func BillingPeriodCount(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldBillingPeriodCount, v))
+}
+
+// TrialEnd applies equality check predicate on the "trial_end" field. It's identical to TrialEndEQ.
+func TrialEnd(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldTrialEnd, v))
+}
+
 // AddonStatus applies equality check predicate on the "addon_status" field. It's identical to AddonStatusEQ.
 func AddonStatus(v string) predicate.AddonAssociation {
 	return predicate.AddonAssociation(sql.FieldEQ(FieldAddonStatus, v))
@@ -869,6 +892,230 @@ func EndDateNotNil() predicate.AddonAssociation {
 	return predicate.AddonAssociation(sql.FieldNotNull(FieldEndDate))
 }
 
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLTE(FieldQuantity, v))
+}
+
+// BillingPeriodEQ applies the EQ predicate on the "billing_period" field.
+func BillingPeriodEQ(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodNEQ applies the NEQ predicate on the "billing_period" field.
+func BillingPeriodNEQ(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldNEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodIn applies the In predicate on the "billing_period" field.
+func BillingPeriodIn(vs ...types.BillingPeriod) predicate.AddonAssociation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.AddonAssociation(sql.FieldIn(FieldBillingPeriod, v...))
+}
+
+// BillingPeriodNotIn applies the NotIn predicate on the "billing_period" field.
+func BillingPeriodNotIn(vs ...types.BillingPeriod) predicate.AddonAssociation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.AddonAssociation(sql.FieldNotIn(FieldBillingPeriod, v...))
+}
+
+// BillingPeriodGT applies the GT predicate on the "billing_period" field.
+func BillingPeriodGT(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldGT(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodGTE applies the GTE predicate on the "billing_period" field.
+func BillingPeriodGTE(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldGTE(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodLT applies the LT predicate on the "billing_period" field.
+func BillingPeriodLT(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldLT(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodLTE applies the LTE predicate on the "billing_period" field.
+func BillingPeriodLTE(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldLTE(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodContains applies the Contains predicate on the "billing_period" field.
+func BillingPeriodContains(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldContains(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodHasPrefix applies the HasPrefix predicate on the "billing_period" field.
+func BillingPeriodHasPrefix(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldHasPrefix(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodHasSuffix applies the HasSuffix predicate on the "billing_period" field.
+func BillingPeriodHasSuffix(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldHasSuffix(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodIsNil applies the IsNil predicate on the "billing_period" field.
+func BillingPeriodIsNil() predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldIsNull(FieldBillingPeriod))
+}
+
+// BillingPeriodNotNil applies the NotNil predicate on the "billing_period" field.
+func BillingPeriodNotNil() predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNotNull(FieldBillingPeriod))
+}
+
+// BillingPeriodEqualFold applies the EqualFold predicate on the "billing_period" field.
+func BillingPeriodEqualFold(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldEqualFold(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodContainsFold applies the ContainsFold predicate on the "billing_period" field.
+func BillingPeriodContainsFold(v types.BillingPeriod) predicate.AddonAssociation {
+	vc := string(v)
+	return predicate.AddonAssociation(sql.FieldContainsFold(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodCountEQ applies the EQ predicate on the "billing_period_count" field.
+func BillingPeriodCountEQ(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountNEQ applies the NEQ predicate on the "billing_period_count" field.
+func BillingPeriodCountNEQ(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNEQ(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountIn applies the In predicate on the "billing_period_count" field.
+func BillingPeriodCountIn(vs ...int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldIn(FieldBillingPeriodCount, vs...))
+}
+
+// BillingPeriodCountNotIn applies the NotIn predicate on the "billing_period_count" field.
+func BillingPeriodCountNotIn(vs ...int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNotIn(FieldBillingPeriodCount, vs...))
+}
+
+// BillingPeriodCountGT applies the GT predicate on the "billing_period_count" field.
+func BillingPeriodCountGT(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGT(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountGTE applies the GTE predicate on the "billing_period_count" field.
+func BillingPeriodCountGTE(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGTE(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountLT applies the LT predicate on the "billing_period_count" field.
+func BillingPeriodCountLT(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLT(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountLTE applies the LTE predicate on the "billing_period_count" field.
+func BillingPeriodCountLTE(v int) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLTE(FieldBillingPeriodCount, v))
+}
+
+// TrialEndEQ applies the EQ predicate on the "trial_end" field.
+func TrialEndEQ(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldEQ(FieldTrialEnd, v))
+}
+
+// TrialEndNEQ applies the NEQ predicate on the "trial_end" field.
+func TrialEndNEQ(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNEQ(FieldTrialEnd, v))
+}
+
+// TrialEndIn applies the In predicate on the "trial_end" field.
+func TrialEndIn(vs ...time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldIn(FieldTrialEnd, vs...))
+}
+
+// TrialEndNotIn applies the NotIn predicate on the "trial_end" field.
+func TrialEndNotIn(vs ...time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNotIn(FieldTrialEnd, vs...))
+}
+
+// TrialEndGT applies the GT predicate on the "trial_end" field.
+func TrialEndGT(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGT(FieldTrialEnd, v))
+}
+
+// TrialEndGTE applies the GTE predicate on the "trial_end" field.
+func TrialEndGTE(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldGTE(FieldTrialEnd, v))
+}
+
+// TrialEndLT applies the LT predicate on the "trial_end" field.
+func TrialEndLT(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLT(FieldTrialEnd, v))
+}
+
+// TrialEndLTE applies the LTE predicate on the "trial_end" field.
+func TrialEndLTE(v time.Time) predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldLTE(FieldTrialEnd, v))
+}
+
+// TrialEndIsNil applies the IsNil predicate on the "trial_end" field.
+func TrialEndIsNil() predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldIsNull(FieldTrialEnd))
+}
+
+// TrialEndNotNil applies the NotNil predicate on the "trial_end" field.
+func TrialEndNotNil() predicate.AddonAssociation {
+	return predicate.AddonAssociation(sql.FieldNotNull(FieldTrialEnd))
+}
+
 // AddonStatusEQ applies the EQ predicate on the "addon_status" field.
 func AddonStatusEQ(v string) predicate.AddonAssociation {
 	return predicate.AddonAssociation(sql.FieldEQ(FieldAddonStatus, v))
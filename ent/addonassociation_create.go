@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/addonassociation"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // AddonAssociationCreate is the builder for creating a AddonAssociation entity.
@@ -156,6 +158,62 @@ func (aac *AddonAssociationCreate) SetNillableEndDate(t *time.Time) *AddonAssoci
 	return aac
 }
 
+// SetQuantity sets the "quantity" field.
+func (aac *AddonAssociationCreate) SetQuantity(d decimal.Decimal) *AddonAssociationCreate {
+	aac.mutation.SetQuantity(d)
+	return aac
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (aac *AddonAssociationCreate) SetNillableQuantity(d *decimal.Decimal) *AddonAssociationCreate {
+	if d != nil {
+		aac.SetQuantity(*d)
+	}
+	return aac
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (aac *AddonAssociationCreate) SetBillingPeriod(tp types.BillingPeriod) *AddonAssociationCreate {
+	aac.mutation.SetBillingPeriod(tp)
+	return aac
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (aac *AddonAssociationCreate) SetNillableBillingPeriod(tp *types.BillingPeriod) *AddonAssociationCreate {
+	if tp != nil {
+		aac.SetBillingPeriod(*tp)
+	}
+	return aac
+}
+
+// SetBillingPeriodCount sets the "billing_period_count" field.
+func (aac *AddonAssociationCreate) SetBillingPeriodCount(i int) *AddonAssociationCreate {
+	aac.mutation.SetBillingPeriodCount(i)
+	return aac
+}
+
+// SetNillableBillingPeriodCount sets the "billing_period_count" field if the given value is not nil.
+func (aac *AddonAssociationCreate) SetNillableBillingPeriodCount(i *int) *AddonAssociationCreate {
+	if i != nil {
+		aac.SetBillingPeriodCount(*i)
+	}
+	return aac
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (aac *AddonAssociationCreate) SetTrialEnd(t time.Time) *AddonAssociationCreate {
+	aac.mutation.SetTrialEnd(t)
+	return aac
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (aac *AddonAssociationCreate) SetNillableTrialEnd(t *time.Time) *AddonAssociationCreate {
+	if t != nil {
+		aac.SetTrialEnd(*t)
+	}
+	return aac
+}
+
 // SetAddonStatus sets the "addon_status" field.
 func (aac *AddonAssociationCreate) SetAddonStatus(s string) *AddonAssociationCreate {
 	aac.mutation.SetAddonStatus(s)
@@ -265,6 +323,14 @@ func (aac *AddonAssociationCreate) defaults() {
 		v := addonassociation.DefaultStartDate()
 		aac.mutation.SetStartDate(v)
 	}
+	if _, ok := aac.mutation.Quantity(); !ok {
+		v := addonassociation.DefaultQuantity
+		aac.mutation.SetQuantity(v)
+	}
+	if _, ok := aac.mutation.BillingPeriodCount(); !ok {
+		v := addonassociation.DefaultBillingPeriodCount
+		aac.mutation.SetBillingPeriodCount(v)
+	}
 	if _, ok := aac.mutation.AddonStatus(); !ok {
 		v := addonassociation.DefaultAddonStatus
 		aac.mutation.SetAddonStatus(v)
@@ -314,6 +380,17 @@ func (aac *AddonAssociationCreate) check() error {
 			return &ValidationError{Name: "addon_id", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.addon_id": %w`, err)}
 		}
 	}
+	if _, ok := aac.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "AddonAssociation.quantity"`)}
+	}
+	if v, ok := aac.mutation.BillingPeriod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.billing_period": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.BillingPeriodCount(); !ok {
+		return &ValidationError{Name: "billing_period_count", err: errors.New(`ent: missing required field "AddonAssociation.billing_period_count"`)}
+	}
 	if _, ok := aac.mutation.AddonStatus(); !ok {
 		return &ValidationError{Name: "addon_status", err: errors.New(`ent: missing required field "AddonAssociation.addon_status"`)}
 	}
@@ -405,6 +482,22 @@ func (aac *AddonAssociationCreate) createSpec() (*AddonAssociation, *sqlgraph.Cr
 		_spec.SetField(addonassociation.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = &value
 	}
+	if value, ok := aac.mutation.Quantity(); ok {
+		_spec.SetField(addonassociation.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := aac.mutation.BillingPeriod(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriod, field.TypeString, value)
+		_node.BillingPeriod = value
+	}
+	if value, ok := aac.mutation.BillingPeriodCount(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriodCount, field.TypeInt, value)
+		_node.BillingPeriodCount = value
+	}
+	if value, ok := aac.mutation.TrialEnd(); ok {
+		_spec.SetField(addonassociation.FieldTrialEnd, field.TypeTime, value)
+		_node.TrialEnd = &value
+	}
 	if value, ok := aac.mutation.AddonStatus(); ok {
 		_spec.SetField(addonassociation.FieldAddonStatus, field.TypeString, value)
 		_node.AddonStatus = value
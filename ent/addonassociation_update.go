@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/addonassociation"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // AddonAssociationUpdate is the builder for updating AddonAssociation entities.
@@ -108,6 +110,81 @@ func (aau *AddonAssociationUpdate) ClearEndDate() *AddonAssociationUpdate {
 	return aau
 }
 
+// SetQuantity sets the "quantity" field.
+func (aau *AddonAssociationUpdate) SetQuantity(d decimal.Decimal) *AddonAssociationUpdate {
+	aau.mutation.SetQuantity(d)
+	return aau
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (aau *AddonAssociationUpdate) SetNillableQuantity(d *decimal.Decimal) *AddonAssociationUpdate {
+	if d != nil {
+		aau.SetQuantity(*d)
+	}
+	return aau
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (aau *AddonAssociationUpdate) SetBillingPeriod(tp types.BillingPeriod) *AddonAssociationUpdate {
+	aau.mutation.SetBillingPeriod(tp)
+	return aau
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (aau *AddonAssociationUpdate) SetNillableBillingPeriod(tp *types.BillingPeriod) *AddonAssociationUpdate {
+	if tp != nil {
+		aau.SetBillingPeriod(*tp)
+	}
+	return aau
+}
+
+// ClearBillingPeriod clears the value of the "billing_period" field.
+func (aau *AddonAssociationUpdate) ClearBillingPeriod() *AddonAssociationUpdate {
+	aau.mutation.ClearBillingPeriod()
+	return aau
+}
+
+// SetBillingPeriodCount sets the "billing_period_count" field.
+func (aau *AddonAssociationUpdate) SetBillingPeriodCount(i int) *AddonAssociationUpdate {
+	aau.mutation.ResetBillingPeriodCount()
+	aau.mutation.SetBillingPeriodCount(i)
+	return aau
+}
+
+// SetNillableBillingPeriodCount sets the "billing_period_count" field if the given value is not nil.
+func (aau *AddonAssociationUpdate) SetNillableBillingPeriodCount(i *int) *AddonAssociationUpdate {
+	if i != nil {
+		aau.SetBillingPeriodCount(*i)
+	}
+	return aau
+}
+
+// AddBillingPeriodCount adds i to the "billing_period_count" field.
+func (aau *AddonAssociationUpdate) AddBillingPeriodCount(i int) *AddonAssociationUpdate {
+	aau.mutation.AddBillingPeriodCount(i)
+	return aau
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (aau *AddonAssociationUpdate) SetTrialEnd(t time.Time) *AddonAssociationUpdate {
+	aau.mutation.SetTrialEnd(t)
+	return aau
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (aau *AddonAssociationUpdate) SetNillableTrialEnd(t *time.Time) *AddonAssociationUpdate {
+	if t != nil {
+		aau.SetTrialEnd(*t)
+	}
+	return aau
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (aau *AddonAssociationUpdate) ClearTrialEnd() *AddonAssociationUpdate {
+	aau.mutation.ClearTrialEnd()
+	return aau
+}
+
 // SetAddonStatus sets the "addon_status" field.
 func (aau *AddonAssociationUpdate) SetAddonStatus(s string) *AddonAssociationUpdate {
 	aau.mutation.SetAddonStatus(s)
@@ -217,6 +294,11 @@ func (aau *AddonAssociationUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (aau *AddonAssociationUpdate) check() error {
+	if v, ok := aau.mutation.BillingPeriod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.billing_period": %w`, err)}
+		}
+	}
 	if v, ok := aau.mutation.AddonStatus(); ok {
 		if err := addonassociation.AddonStatusValidator(v); err != nil {
 			return &ValidationError{Name: "addon_status", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.addon_status": %w`, err)}
@@ -267,6 +349,27 @@ func (aau *AddonAssociationUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if aau.mutation.EndDateCleared() {
 		_spec.ClearField(addonassociation.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := aau.mutation.Quantity(); ok {
+		_spec.SetField(addonassociation.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := aau.mutation.BillingPeriod(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriod, field.TypeString, value)
+	}
+	if aau.mutation.BillingPeriodCleared() {
+		_spec.ClearField(addonassociation.FieldBillingPeriod, field.TypeString)
+	}
+	if value, ok := aau.mutation.BillingPeriodCount(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriodCount, field.TypeInt, value)
+	}
+	if value, ok := aau.mutation.AddedBillingPeriodCount(); ok {
+		_spec.AddField(addonassociation.FieldBillingPeriodCount, field.TypeInt, value)
+	}
+	if value, ok := aau.mutation.TrialEnd(); ok {
+		_spec.SetField(addonassociation.FieldTrialEnd, field.TypeTime, value)
+	}
+	if aau.mutation.TrialEndCleared() {
+		_spec.ClearField(addonassociation.FieldTrialEnd, field.TypeTime)
+	}
 	if value, ok := aau.mutation.AddonStatus(); ok {
 		_spec.SetField(addonassociation.FieldAddonStatus, field.TypeString, value)
 	}
@@ -388,6 +491,81 @@ func (aauo *AddonAssociationUpdateOne) ClearEndDate() *AddonAssociationUpdateOne
 	return aauo
 }
 
+// SetQuantity sets the "quantity" field.
+func (aauo *AddonAssociationUpdateOne) SetQuantity(d decimal.Decimal) *AddonAssociationUpdateOne {
+	aauo.mutation.SetQuantity(d)
+	return aauo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (aauo *AddonAssociationUpdateOne) SetNillableQuantity(d *decimal.Decimal) *AddonAssociationUpdateOne {
+	if d != nil {
+		aauo.SetQuantity(*d)
+	}
+	return aauo
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (aauo *AddonAssociationUpdateOne) SetBillingPeriod(tp types.BillingPeriod) *AddonAssociationUpdateOne {
+	aauo.mutation.SetBillingPeriod(tp)
+	return aauo
+}
+
+// SetNillableBillingPeriod sets the "billing_period" field if the given value is not nil.
+func (aauo *AddonAssociationUpdateOne) SetNillableBillingPeriod(tp *types.BillingPeriod) *AddonAssociationUpdateOne {
+	if tp != nil {
+		aauo.SetBillingPeriod(*tp)
+	}
+	return aauo
+}
+
+// ClearBillingPeriod clears the value of the "billing_period" field.
+func (aauo *AddonAssociationUpdateOne) ClearBillingPeriod() *AddonAssociationUpdateOne {
+	aauo.mutation.ClearBillingPeriod()
+	return aauo
+}
+
+// SetBillingPeriodCount sets the "billing_period_count" field.
+func (aauo *AddonAssociationUpdateOne) SetBillingPeriodCount(i int) *AddonAssociationUpdateOne {
+	aauo.mutation.ResetBillingPeriodCount()
+	aauo.mutation.SetBillingPeriodCount(i)
+	return aauo
+}
+
+// SetNillableBillingPeriodCount sets the "billing_period_count" field if the given value is not nil.
+func (aauo *AddonAssociationUpdateOne) SetNillableBillingPeriodCount(i *int) *AddonAssociationUpdateOne {
+	if i != nil {
+		aauo.SetBillingPeriodCount(*i)
+	}
+	return aauo
+}
+
+// AddBillingPeriodCount adds i to the "billing_period_count" field.
+func (aauo *AddonAssociationUpdateOne) AddBillingPeriodCount(i int) *AddonAssociationUpdateOne {
+	aauo.mutation.AddBillingPeriodCount(i)
+	return aauo
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (aauo *AddonAssociationUpdateOne) SetTrialEnd(t time.Time) *AddonAssociationUpdateOne {
+	aauo.mutation.SetTrialEnd(t)
+	return aauo
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (aauo *AddonAssociationUpdateOne) SetNillableTrialEnd(t *time.Time) *AddonAssociationUpdateOne {
+	if t != nil {
+		aauo.SetTrialEnd(*t)
+	}
+	return aauo
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (aauo *AddonAssociationUpdateOne) ClearTrialEnd() *AddonAssociationUpdateOne {
+	aauo.mutation.ClearTrialEnd()
+	return aauo
+}
+
 // SetAddonStatus sets the "addon_status" field.
 func (aauo *AddonAssociationUpdateOne) SetAddonStatus(s string) *AddonAssociationUpdateOne {
 	aauo.mutation.SetAddonStatus(s)
@@ -510,6 +688,11 @@ func (aauo *AddonAssociationUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (aauo *AddonAssociationUpdateOne) check() error {
+	if v, ok := aauo.mutation.BillingPeriod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.billing_period": %w`, err)}
+		}
+	}
 	if v, ok := aauo.mutation.AddonStatus(); ok {
 		if err := addonassociation.AddonStatusValidator(v); err != nil {
 			return &ValidationError{Name: "addon_status", err: fmt.Errorf(`ent: validator failed for field "AddonAssociation.addon_status": %w`, err)}
@@ -577,6 +760,27 @@ func (aauo *AddonAssociationUpdateOne) sqlSave(ctx context.Context) (_node *Addo
 	if aauo.mutation.EndDateCleared() {
 		_spec.ClearField(addonassociation.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := aauo.mutation.Quantity(); ok {
+		_spec.SetField(addonassociation.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := aauo.mutation.BillingPeriod(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriod, field.TypeString, value)
+	}
+	if aauo.mutation.BillingPeriodCleared() {
+		_spec.ClearField(addonassociation.FieldBillingPeriod, field.TypeString)
+	}
+	if value, ok := aauo.mutation.BillingPeriodCount(); ok {
+		_spec.SetField(addonassociation.FieldBillingPeriodCount, field.TypeInt, value)
+	}
+	if value, ok := aauo.mutation.AddedBillingPeriodCount(); ok {
+		_spec.AddField(addonassociation.FieldBillingPeriodCount, field.TypeInt, value)
+	}
+	if value, ok := aauo.mutation.TrialEnd(); ok {
+		_spec.SetField(addonassociation.FieldTrialEnd, field.TypeTime, value)
+	}
+	if aauo.mutation.TrialEndCleared() {
+		_spec.ClearField(addonassociation.FieldTrialEnd, field.TypeTime)
+	}
 	if value, ok := aauo.mutation.AddonStatus(); ok {
 		_spec.SetField(addonassociation.FieldAddonStatus, field.TypeString, value)
 	}
@@ -33,6 +33,8 @@ import (
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
@@ -44,11 +46,14 @@ import (
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
@@ -106,6 +111,10 @@ type Client struct {
 	EntityIntegrationMapping *EntityIntegrationMappingClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
+	// Experiment is the client for interacting with the Experiment builders.
+	Experiment *ExperimentClient
+	// ExperimentAssignment is the client for interacting with the ExperimentAssignment builders.
+	ExperimentAssignment *ExperimentAssignmentClient
 	// Feature is the client for interacting with the Feature builders.
 	Feature *FeatureClient
 	// Group is the client for interacting with the Group builders.
@@ -128,6 +137,10 @@ type Client struct {
 	Price *PriceClient
 	// PriceUnit is the client for interacting with the PriceUnit builders.
 	PriceUnit *PriceUnitClient
+	// Quote is the client for interacting with the Quote builders.
+	Quote *QuoteClient
+	// QuoteLineItem is the client for interacting with the QuoteLineItem builders.
+	QuoteLineItem *QuoteLineItemClient
 	// ScheduledTask is the client for interacting with the ScheduledTask builders.
 	ScheduledTask *ScheduledTaskClient
 	// Secret is the client for interacting with the Secret builders.
@@ -138,6 +151,8 @@ type Client struct {
 	Subscription *SubscriptionClient
 	// SubscriptionLineItem is the client for interacting with the SubscriptionLineItem builders.
 	SubscriptionLineItem *SubscriptionLineItemClient
+	// SubscriptionMigrationResult is the client for interacting with the SubscriptionMigrationResult builders.
+	SubscriptionMigrationResult *SubscriptionMigrationResultClient
 	// SubscriptionPause is the client for interacting with the SubscriptionPause builders.
 	SubscriptionPause *SubscriptionPauseClient
 	// SubscriptionPhase is the client for interacting with the SubscriptionPhase builders.
@@ -191,6 +206,8 @@ func (c *Client) init() {
 	c.Entitlement = NewEntitlementClient(c.config)
 	c.EntityIntegrationMapping = NewEntityIntegrationMappingClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
+	c.Experiment = NewExperimentClient(c.config)
+	c.ExperimentAssignment = NewExperimentAssignmentClient(c.config)
 	c.Feature = NewFeatureClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
@@ -202,11 +219,14 @@ func (c *Client) init() {
 	c.Plan = NewPlanClient(c.config)
 	c.Price = NewPriceClient(c.config)
 	c.PriceUnit = NewPriceUnitClient(c.config)
+	c.Quote = NewQuoteClient(c.config)
+	c.QuoteLineItem = NewQuoteLineItemClient(c.config)
 	c.ScheduledTask = NewScheduledTaskClient(c.config)
 	c.Secret = NewSecretClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.SubscriptionLineItem = NewSubscriptionLineItemClient(c.config)
+	c.SubscriptionMigrationResult = NewSubscriptionMigrationResultClient(c.config)
 	c.SubscriptionPause = NewSubscriptionPauseClient(c.config)
 	c.SubscriptionPhase = NewSubscriptionPhaseClient(c.config)
 	c.SubscriptionSchedule = NewSubscriptionScheduleClient(c.config)
@@ -309,54 +329,59 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                      ctx,
-		config:                   cfg,
-		Addon:                    NewAddonClient(cfg),
-		AddonAssociation:         NewAddonAssociationClient(cfg),
-		AlertLogs:                NewAlertLogsClient(cfg),
-		Auth:                     NewAuthClient(cfg),
-		BillingSequence:          NewBillingSequenceClient(cfg),
-		Connection:               NewConnectionClient(cfg),
-		Costsheet:                NewCostsheetClient(cfg),
-		Coupon:                   NewCouponClient(cfg),
-		CouponApplication:        NewCouponApplicationClient(cfg),
-		CouponAssociation:        NewCouponAssociationClient(cfg),
-		CreditGrant:              NewCreditGrantClient(cfg),
-		CreditGrantApplication:   NewCreditGrantApplicationClient(cfg),
-		CreditNote:               NewCreditNoteClient(cfg),
-		CreditNoteLineItem:       NewCreditNoteLineItemClient(cfg),
-		Customer:                 NewCustomerClient(cfg),
-		Entitlement:              NewEntitlementClient(cfg),
-		EntityIntegrationMapping: NewEntityIntegrationMappingClient(cfg),
-		Environment:              NewEnvironmentClient(cfg),
-		Feature:                  NewFeatureClient(cfg),
-		Group:                    NewGroupClient(cfg),
-		Invoice:                  NewInvoiceClient(cfg),
-		InvoiceLineItem:          NewInvoiceLineItemClient(cfg),
-		InvoiceSequence:          NewInvoiceSequenceClient(cfg),
-		Meter:                    NewMeterClient(cfg),
-		Payment:                  NewPaymentClient(cfg),
-		PaymentAttempt:           NewPaymentAttemptClient(cfg),
-		Plan:                     NewPlanClient(cfg),
-		Price:                    NewPriceClient(cfg),
-		PriceUnit:                NewPriceUnitClient(cfg),
-		ScheduledTask:            NewScheduledTaskClient(cfg),
-		Secret:                   NewSecretClient(cfg),
-		Settings:                 NewSettingsClient(cfg),
-		Subscription:             NewSubscriptionClient(cfg),
-		SubscriptionLineItem:     NewSubscriptionLineItemClient(cfg),
-		SubscriptionPause:        NewSubscriptionPauseClient(cfg),
-		SubscriptionPhase:        NewSubscriptionPhaseClient(cfg),
-		SubscriptionSchedule:     NewSubscriptionScheduleClient(cfg),
-		Task:                     NewTaskClient(cfg),
-		TaxApplied:               NewTaxAppliedClient(cfg),
-		TaxAssociation:           NewTaxAssociationClient(cfg),
-		TaxRate:                  NewTaxRateClient(cfg),
-		Tenant:                   NewTenantClient(cfg),
-		User:                     NewUserClient(cfg),
-		Wallet:                   NewWalletClient(cfg),
-		WalletTransaction:        NewWalletTransactionClient(cfg),
-		WorkflowExecution:        NewWorkflowExecutionClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		Addon:                       NewAddonClient(cfg),
+		AddonAssociation:            NewAddonAssociationClient(cfg),
+		AlertLogs:                   NewAlertLogsClient(cfg),
+		Auth:                        NewAuthClient(cfg),
+		BillingSequence:             NewBillingSequenceClient(cfg),
+		Connection:                  NewConnectionClient(cfg),
+		Costsheet:                   NewCostsheetClient(cfg),
+		Coupon:                      NewCouponClient(cfg),
+		CouponApplication:           NewCouponApplicationClient(cfg),
+		CouponAssociation:           NewCouponAssociationClient(cfg),
+		CreditGrant:                 NewCreditGrantClient(cfg),
+		CreditGrantApplication:      NewCreditGrantApplicationClient(cfg),
+		CreditNote:                  NewCreditNoteClient(cfg),
+		CreditNoteLineItem:          NewCreditNoteLineItemClient(cfg),
+		Customer:                    NewCustomerClient(cfg),
+		Entitlement:                 NewEntitlementClient(cfg),
+		EntityIntegrationMapping:    NewEntityIntegrationMappingClient(cfg),
+		Environment:                 NewEnvironmentClient(cfg),
+		Experiment:                  NewExperimentClient(cfg),
+		ExperimentAssignment:        NewExperimentAssignmentClient(cfg),
+		Feature:                     NewFeatureClient(cfg),
+		Group:                       NewGroupClient(cfg),
+		Invoice:                     NewInvoiceClient(cfg),
+		InvoiceLineItem:             NewInvoiceLineItemClient(cfg),
+		InvoiceSequence:             NewInvoiceSequenceClient(cfg),
+		Meter:                       NewMeterClient(cfg),
+		Payment:                     NewPaymentClient(cfg),
+		PaymentAttempt:              NewPaymentAttemptClient(cfg),
+		Plan:                        NewPlanClient(cfg),
+		Price:                       NewPriceClient(cfg),
+		PriceUnit:                   NewPriceUnitClient(cfg),
+		Quote:                       NewQuoteClient(cfg),
+		QuoteLineItem:               NewQuoteLineItemClient(cfg),
+		ScheduledTask:               NewScheduledTaskClient(cfg),
+		Secret:                      NewSecretClient(cfg),
+		Settings:                    NewSettingsClient(cfg),
+		Subscription:                NewSubscriptionClient(cfg),
+		SubscriptionLineItem:        NewSubscriptionLineItemClient(cfg),
+		SubscriptionMigrationResult: NewSubscriptionMigrationResultClient(cfg),
+		SubscriptionPause:           NewSubscriptionPauseClient(cfg),
+		SubscriptionPhase:           NewSubscriptionPhaseClient(cfg),
+		SubscriptionSchedule:        NewSubscriptionScheduleClient(cfg),
+		Task:                        NewTaskClient(cfg),
+		TaxApplied:                  NewTaxAppliedClient(cfg),
+		TaxAssociation:              NewTaxAssociationClient(cfg),
+		TaxRate:                     NewTaxRateClient(cfg),
+		Tenant:                      NewTenantClient(cfg),
+		User:                        NewUserClient(cfg),
+		Wallet:                      NewWalletClient(cfg),
+		WalletTransaction:           NewWalletTransactionClient(cfg),
+		WorkflowExecution:           NewWorkflowExecutionClient(cfg),
 	}, nil
 }
 
@@ -374,54 +399,59 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                      ctx,
-		config:                   cfg,
-		Addon:                    NewAddonClient(cfg),
-		AddonAssociation:         NewAddonAssociationClient(cfg),
-		AlertLogs:                NewAlertLogsClient(cfg),
-		Auth:                     NewAuthClient(cfg),
-		BillingSequence:          NewBillingSequenceClient(cfg),
-		Connection:               NewConnectionClient(cfg),
-		Costsheet:                NewCostsheetClient(cfg),
-		Coupon:                   NewCouponClient(cfg),
-		CouponApplication:        NewCouponApplicationClient(cfg),
-		CouponAssociation:        NewCouponAssociationClient(cfg),
-		CreditGrant:              NewCreditGrantClient(cfg),
-		CreditGrantApplication:   NewCreditGrantApplicationClient(cfg),
-		CreditNote:               NewCreditNoteClient(cfg),
-		CreditNoteLineItem:       NewCreditNoteLineItemClient(cfg),
-		Customer:                 NewCustomerClient(cfg),
-		Entitlement:              NewEntitlementClient(cfg),
-		EntityIntegrationMapping: NewEntityIntegrationMappingClient(cfg),
-		Environment:              NewEnvironmentClient(cfg),
-		Feature:                  NewFeatureClient(cfg),
-		Group:                    NewGroupClient(cfg),
-		Invoice:                  NewInvoiceClient(cfg),
-		InvoiceLineItem:          NewInvoiceLineItemClient(cfg),
-		InvoiceSequence:          NewInvoiceSequenceClient(cfg),
-		Meter:                    NewMeterClient(cfg),
-		Payment:                  NewPaymentClient(cfg),
-		PaymentAttempt:           NewPaymentAttemptClient(cfg),
-		Plan:                     NewPlanClient(cfg),
-		Price:                    NewPriceClient(cfg),
-		PriceUnit:                NewPriceUnitClient(cfg),
-		ScheduledTask:            NewScheduledTaskClient(cfg),
-		Secret:                   NewSecretClient(cfg),
-		Settings:                 NewSettingsClient(cfg),
-		Subscription:             NewSubscriptionClient(cfg),
-		SubscriptionLineItem:     NewSubscriptionLineItemClient(cfg),
-		SubscriptionPause:        NewSubscriptionPauseClient(cfg),
-		SubscriptionPhase:        NewSubscriptionPhaseClient(cfg),
-		SubscriptionSchedule:     NewSubscriptionScheduleClient(cfg),
-		Task:                     NewTaskClient(cfg),
-		TaxApplied:               NewTaxAppliedClient(cfg),
-		TaxAssociation:           NewTaxAssociationClient(cfg),
-		TaxRate:                  NewTaxRateClient(cfg),
-		Tenant:                   NewTenantClient(cfg),
-		User:                     NewUserClient(cfg),
-		Wallet:                   NewWalletClient(cfg),
-		WalletTransaction:        NewWalletTransactionClient(cfg),
-		WorkflowExecution:        NewWorkflowExecutionClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		Addon:                       NewAddonClient(cfg),
+		AddonAssociation:            NewAddonAssociationClient(cfg),
+		AlertLogs:                   NewAlertLogsClient(cfg),
+		Auth:                        NewAuthClient(cfg),
+		BillingSequence:             NewBillingSequenceClient(cfg),
+		Connection:                  NewConnectionClient(cfg),
+		Costsheet:                   NewCostsheetClient(cfg),
+		Coupon:                      NewCouponClient(cfg),
+		CouponApplication:           NewCouponApplicationClient(cfg),
+		CouponAssociation:           NewCouponAssociationClient(cfg),
+		CreditGrant:                 NewCreditGrantClient(cfg),
+		CreditGrantApplication:      NewCreditGrantApplicationClient(cfg),
+		CreditNote:                  NewCreditNoteClient(cfg),
+		CreditNoteLineItem:          NewCreditNoteLineItemClient(cfg),
+		Customer:                    NewCustomerClient(cfg),
+		Entitlement:                 NewEntitlementClient(cfg),
+		EntityIntegrationMapping:    NewEntityIntegrationMappingClient(cfg),
+		Environment:                 NewEnvironmentClient(cfg),
+		Experiment:                  NewExperimentClient(cfg),
+		ExperimentAssignment:        NewExperimentAssignmentClient(cfg),
+		Feature:                     NewFeatureClient(cfg),
+		Group:                       NewGroupClient(cfg),
+		Invoice:                     NewInvoiceClient(cfg),
+		InvoiceLineItem:             NewInvoiceLineItemClient(cfg),
+		InvoiceSequence:             NewInvoiceSequenceClient(cfg),
+		Meter:                       NewMeterClient(cfg),
+		Payment:                     NewPaymentClient(cfg),
+		PaymentAttempt:              NewPaymentAttemptClient(cfg),
+		Plan:                        NewPlanClient(cfg),
+		Price:                       NewPriceClient(cfg),
+		PriceUnit:                   NewPriceUnitClient(cfg),
+		Quote:                       NewQuoteClient(cfg),
+		QuoteLineItem:               NewQuoteLineItemClient(cfg),
+		ScheduledTask:               NewScheduledTaskClient(cfg),
+		Secret:                      NewSecretClient(cfg),
+		Settings:                    NewSettingsClient(cfg),
+		Subscription:                NewSubscriptionClient(cfg),
+		SubscriptionLineItem:        NewSubscriptionLineItemClient(cfg),
+		SubscriptionMigrationResult: NewSubscriptionMigrationResultClient(cfg),
+		SubscriptionPause:           NewSubscriptionPauseClient(cfg),
+		SubscriptionPhase:           NewSubscriptionPhaseClient(cfg),
+		SubscriptionSchedule:        NewSubscriptionScheduleClient(cfg),
+		Task:                        NewTaskClient(cfg),
+		TaxApplied:                  NewTaxAppliedClient(cfg),
+		TaxAssociation:              NewTaxAssociationClient(cfg),
+		TaxRate:                     NewTaxRateClient(cfg),
+		Tenant:                      NewTenantClient(cfg),
+		User:                        NewUserClient(cfg),
+		Wallet:                      NewWalletClient(cfg),
+		WalletTransaction:           NewWalletTransactionClient(cfg),
+		WorkflowExecution:           NewWorkflowExecutionClient(cfg),
 	}, nil
 }
 
@@ -455,12 +485,13 @@ func (c *Client) Use(hooks ...Hook) {
 		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
 		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
 		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
+		c.Experiment, c.ExperimentAssignment, c.Feature, c.Group, c.Invoice,
+		c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.Payment, c.PaymentAttempt,
+		c.Plan, c.Price, c.PriceUnit, c.Quote, c.QuoteLineItem, c.ScheduledTask,
 		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
-		c.SubscriptionPause, c.SubscriptionPhase, c.SubscriptionSchedule, c.Task,
-		c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant, c.User, c.Wallet,
-		c.WalletTransaction, c.WorkflowExecution,
+		c.SubscriptionMigrationResult, c.SubscriptionPause, c.SubscriptionPhase,
+		c.SubscriptionSchedule, c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate,
+		c.Tenant, c.User, c.Wallet, c.WalletTransaction, c.WorkflowExecution,
 	} {
 		n.Use(hooks...)
 	}
@@ -474,12 +505,13 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.Connection, c.Costsheet, c.Coupon, c.CouponApplication, c.CouponAssociation,
 		c.CreditGrant, c.CreditGrantApplication, c.CreditNote, c.CreditNoteLineItem,
 		c.Customer, c.Entitlement, c.EntityIntegrationMapping, c.Environment,
-		c.Feature, c.Group, c.Invoice, c.InvoiceLineItem, c.InvoiceSequence, c.Meter,
-		c.Payment, c.PaymentAttempt, c.Plan, c.Price, c.PriceUnit, c.ScheduledTask,
+		c.Experiment, c.ExperimentAssignment, c.Feature, c.Group, c.Invoice,
+		c.InvoiceLineItem, c.InvoiceSequence, c.Meter, c.Payment, c.PaymentAttempt,
+		c.Plan, c.Price, c.PriceUnit, c.Quote, c.QuoteLineItem, c.ScheduledTask,
 		c.Secret, c.Settings, c.Subscription, c.SubscriptionLineItem,
-		c.SubscriptionPause, c.SubscriptionPhase, c.SubscriptionSchedule, c.Task,
-		c.TaxApplied, c.TaxAssociation, c.TaxRate, c.Tenant, c.User, c.Wallet,
-		c.WalletTransaction, c.WorkflowExecution,
+		c.SubscriptionMigrationResult, c.SubscriptionPause, c.SubscriptionPhase,
+		c.SubscriptionSchedule, c.Task, c.TaxApplied, c.TaxAssociation, c.TaxRate,
+		c.Tenant, c.User, c.Wallet, c.WalletTransaction, c.WorkflowExecution,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -524,6 +556,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.EntityIntegrationMapping.mutate(ctx, m)
 	case *EnvironmentMutation:
 		return c.Environment.mutate(ctx, m)
+	case *ExperimentMutation:
+		return c.Experiment.mutate(ctx, m)
+	case *ExperimentAssignmentMutation:
+		return c.ExperimentAssignment.mutate(ctx, m)
 	case *FeatureMutation:
 		return c.Feature.mutate(ctx, m)
 	case *GroupMutation:
@@ -546,6 +582,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Price.mutate(ctx, m)
 	case *PriceUnitMutation:
 		return c.PriceUnit.mutate(ctx, m)
+	case *QuoteMutation:
+		return c.Quote.mutate(ctx, m)
+	case *QuoteLineItemMutation:
+		return c.QuoteLineItem.mutate(ctx, m)
 	case *ScheduledTaskMutation:
 		return c.ScheduledTask.mutate(ctx, m)
 	case *SecretMutation:
@@ -556,6 +596,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Subscription.mutate(ctx, m)
 	case *SubscriptionLineItemMutation:
 		return c.SubscriptionLineItem.mutate(ctx, m)
+	case *SubscriptionMigrationResultMutation:
+		return c.SubscriptionMigrationResult.mutate(ctx, m)
 	case *SubscriptionPauseMutation:
 		return c.SubscriptionPause.mutate(ctx, m)
 	case *SubscriptionPhaseMutation:
@@ -3235,6 +3277,272 @@ func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation)
 	}
 }
 
+// ExperimentClient is a client for the Experiment schema.
+type ExperimentClient struct {
+	config
+}
+
+// NewExperimentClient returns a client for the Experiment from the given config.
+func NewExperimentClient(c config) *ExperimentClient {
+	return &ExperimentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `experiment.Hooks(f(g(h())))`.
+func (c *ExperimentClient) Use(hooks ...Hook) {
+	c.hooks.Experiment = append(c.hooks.Experiment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `experiment.Intercept(f(g(h())))`.
+func (c *ExperimentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Experiment = append(c.inters.Experiment, interceptors...)
+}
+
+// Create returns a builder for creating a Experiment entity.
+func (c *ExperimentClient) Create() *ExperimentCreate {
+	mutation := newExperimentMutation(c.config, OpCreate)
+	return &ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Experiment entities.
+func (c *ExperimentClient) CreateBulk(builders ...*ExperimentCreate) *ExperimentCreateBulk {
+	return &ExperimentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExperimentClient) MapCreateBulk(slice any, setFunc func(*ExperimentCreate, int)) *ExperimentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExperimentCreateBulk{err: fmt.Errorf("calling to ExperimentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExperimentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExperimentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Experiment.
+func (c *ExperimentClient) Update() *ExperimentUpdate {
+	mutation := newExperimentMutation(c.config, OpUpdate)
+	return &ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExperimentClient) UpdateOne(e *Experiment) *ExperimentUpdateOne {
+	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperiment(e))
+	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExperimentClient) UpdateOneID(id string) *ExperimentUpdateOne {
+	mutation := newExperimentMutation(c.config, OpUpdateOne, withExperimentID(id))
+	return &ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Experiment.
+func (c *ExperimentClient) Delete() *ExperimentDelete {
+	mutation := newExperimentMutation(c.config, OpDelete)
+	return &ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExperimentClient) DeleteOne(e *Experiment) *ExperimentDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExperimentClient) DeleteOneID(id string) *ExperimentDeleteOne {
+	builder := c.Delete().Where(experiment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExperimentDeleteOne{builder}
+}
+
+// Query returns a query builder for Experiment.
+func (c *ExperimentClient) Query() *ExperimentQuery {
+	return &ExperimentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExperiment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Experiment entity by its id.
+func (c *ExperimentClient) Get(ctx context.Context, id string) (*Experiment, error) {
+	return c.Query().Where(experiment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExperimentClient) GetX(ctx context.Context, id string) *Experiment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExperimentClient) Hooks() []Hook {
+	return c.hooks.Experiment
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExperimentClient) Interceptors() []Interceptor {
+	return c.inters.Experiment
+}
+
+func (c *ExperimentClient) mutate(ctx context.Context, m *ExperimentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExperimentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExperimentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExperimentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExperimentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Experiment mutation op: %q", m.Op())
+	}
+}
+
+// ExperimentAssignmentClient is a client for the ExperimentAssignment schema.
+type ExperimentAssignmentClient struct {
+	config
+}
+
+// NewExperimentAssignmentClient returns a client for the ExperimentAssignment from the given config.
+func NewExperimentAssignmentClient(c config) *ExperimentAssignmentClient {
+	return &ExperimentAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `experimentassignment.Hooks(f(g(h())))`.
+func (c *ExperimentAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ExperimentAssignment = append(c.hooks.ExperimentAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `experimentassignment.Intercept(f(g(h())))`.
+func (c *ExperimentAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExperimentAssignment = append(c.inters.ExperimentAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a ExperimentAssignment entity.
+func (c *ExperimentAssignmentClient) Create() *ExperimentAssignmentCreate {
+	mutation := newExperimentAssignmentMutation(c.config, OpCreate)
+	return &ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExperimentAssignment entities.
+func (c *ExperimentAssignmentClient) CreateBulk(builders ...*ExperimentAssignmentCreate) *ExperimentAssignmentCreateBulk {
+	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExperimentAssignmentClient) MapCreateBulk(slice any, setFunc func(*ExperimentAssignmentCreate, int)) *ExperimentAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExperimentAssignmentCreateBulk{err: fmt.Errorf("calling to ExperimentAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExperimentAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExperimentAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Update() *ExperimentAssignmentUpdate {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdate)
+	return &ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExperimentAssignmentClient) UpdateOne(ea *ExperimentAssignment) *ExperimentAssignmentUpdateOne {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignment(ea))
+	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExperimentAssignmentClient) UpdateOneID(id string) *ExperimentAssignmentUpdateOne {
+	mutation := newExperimentAssignmentMutation(c.config, OpUpdateOne, withExperimentAssignmentID(id))
+	return &ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Delete() *ExperimentAssignmentDelete {
+	mutation := newExperimentAssignmentMutation(c.config, OpDelete)
+	return &ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExperimentAssignmentClient) DeleteOne(ea *ExperimentAssignment) *ExperimentAssignmentDeleteOne {
+	return c.DeleteOneID(ea.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExperimentAssignmentClient) DeleteOneID(id string) *ExperimentAssignmentDeleteOne {
+	builder := c.Delete().Where(experimentassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExperimentAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for ExperimentAssignment.
+func (c *ExperimentAssignmentClient) Query() *ExperimentAssignmentQuery {
+	return &ExperimentAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExperimentAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExperimentAssignment entity by its id.
+func (c *ExperimentAssignmentClient) Get(ctx context.Context, id string) (*ExperimentAssignment, error) {
+	return c.Query().Where(experimentassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExperimentAssignmentClient) GetX(ctx context.Context, id string) *ExperimentAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExperimentAssignmentClient) Hooks() []Hook {
+	return c.hooks.ExperimentAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExperimentAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ExperimentAssignment
+}
+
+func (c *ExperimentAssignmentClient) mutate(ctx context.Context, m *ExperimentAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExperimentAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExperimentAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExperimentAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExperimentAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExperimentAssignment mutation op: %q", m.Op())
+	}
+}
+
 // FeatureClient is a client for the Feature schema.
 type FeatureClient struct {
 	config
@@ -4858,6 +5166,304 @@ func (c *PriceUnitClient) mutate(ctx context.Context, m *PriceUnitMutation) (Val
 	}
 }
 
+// QuoteClient is a client for the Quote schema.
+type QuoteClient struct {
+	config
+}
+
+// NewQuoteClient returns a client for the Quote from the given config.
+func NewQuoteClient(c config) *QuoteClient {
+	return &QuoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quote.Hooks(f(g(h())))`.
+func (c *QuoteClient) Use(hooks ...Hook) {
+	c.hooks.Quote = append(c.hooks.Quote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quote.Intercept(f(g(h())))`.
+func (c *QuoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quote = append(c.inters.Quote, interceptors...)
+}
+
+// Create returns a builder for creating a Quote entity.
+func (c *QuoteClient) Create() *QuoteCreate {
+	mutation := newQuoteMutation(c.config, OpCreate)
+	return &QuoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Quote entities.
+func (c *QuoteClient) CreateBulk(builders ...*QuoteCreate) *QuoteCreateBulk {
+	return &QuoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuoteClient) MapCreateBulk(slice any, setFunc func(*QuoteCreate, int)) *QuoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuoteCreateBulk{err: fmt.Errorf("calling to QuoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Quote.
+func (c *QuoteClient) Update() *QuoteUpdate {
+	mutation := newQuoteMutation(c.config, OpUpdate)
+	return &QuoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuoteClient) UpdateOne(q *Quote) *QuoteUpdateOne {
+	mutation := newQuoteMutation(c.config, OpUpdateOne, withQuote(q))
+	return &QuoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuoteClient) UpdateOneID(id string) *QuoteUpdateOne {
+	mutation := newQuoteMutation(c.config, OpUpdateOne, withQuoteID(id))
+	return &QuoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Quote.
+func (c *QuoteClient) Delete() *QuoteDelete {
+	mutation := newQuoteMutation(c.config, OpDelete)
+	return &QuoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuoteClient) DeleteOne(q *Quote) *QuoteDeleteOne {
+	return c.DeleteOneID(q.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuoteClient) DeleteOneID(id string) *QuoteDeleteOne {
+	builder := c.Delete().Where(quote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuoteDeleteOne{builder}
+}
+
+// Query returns a query builder for Quote.
+func (c *QuoteClient) Query() *QuoteQuery {
+	return &QuoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Quote entity by its id.
+func (c *QuoteClient) Get(ctx context.Context, id string) (*Quote, error) {
+	return c.Query().Where(quote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuoteClient) GetX(ctx context.Context, id string) *Quote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLineItems queries the line_items edge of a Quote.
+func (c *QuoteClient) QueryLineItems(q *Quote) *QuoteLineItemQuery {
+	query := (&QuoteLineItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quote.Table, quote.FieldID, id),
+			sqlgraph.To(quotelineitem.Table, quotelineitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quote.LineItemsTable, quote.LineItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuoteClient) Hooks() []Hook {
+	return c.hooks.Quote
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuoteClient) Interceptors() []Interceptor {
+	return c.inters.Quote
+}
+
+func (c *QuoteClient) mutate(ctx context.Context, m *QuoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Quote mutation op: %q", m.Op())
+	}
+}
+
+// QuoteLineItemClient is a client for the QuoteLineItem schema.
+type QuoteLineItemClient struct {
+	config
+}
+
+// NewQuoteLineItemClient returns a client for the QuoteLineItem from the given config.
+func NewQuoteLineItemClient(c config) *QuoteLineItemClient {
+	return &QuoteLineItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quotelineitem.Hooks(f(g(h())))`.
+func (c *QuoteLineItemClient) Use(hooks ...Hook) {
+	c.hooks.QuoteLineItem = append(c.hooks.QuoteLineItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quotelineitem.Intercept(f(g(h())))`.
+func (c *QuoteLineItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuoteLineItem = append(c.inters.QuoteLineItem, interceptors...)
+}
+
+// Create returns a builder for creating a QuoteLineItem entity.
+func (c *QuoteLineItemClient) Create() *QuoteLineItemCreate {
+	mutation := newQuoteLineItemMutation(c.config, OpCreate)
+	return &QuoteLineItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuoteLineItem entities.
+func (c *QuoteLineItemClient) CreateBulk(builders ...*QuoteLineItemCreate) *QuoteLineItemCreateBulk {
+	return &QuoteLineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuoteLineItemClient) MapCreateBulk(slice any, setFunc func(*QuoteLineItemCreate, int)) *QuoteLineItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuoteLineItemCreateBulk{err: fmt.Errorf("calling to QuoteLineItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuoteLineItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuoteLineItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuoteLineItem.
+func (c *QuoteLineItemClient) Update() *QuoteLineItemUpdate {
+	mutation := newQuoteLineItemMutation(c.config, OpUpdate)
+	return &QuoteLineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuoteLineItemClient) UpdateOne(qli *QuoteLineItem) *QuoteLineItemUpdateOne {
+	mutation := newQuoteLineItemMutation(c.config, OpUpdateOne, withQuoteLineItem(qli))
+	return &QuoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuoteLineItemClient) UpdateOneID(id string) *QuoteLineItemUpdateOne {
+	mutation := newQuoteLineItemMutation(c.config, OpUpdateOne, withQuoteLineItemID(id))
+	return &QuoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuoteLineItem.
+func (c *QuoteLineItemClient) Delete() *QuoteLineItemDelete {
+	mutation := newQuoteLineItemMutation(c.config, OpDelete)
+	return &QuoteLineItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuoteLineItemClient) DeleteOne(qli *QuoteLineItem) *QuoteLineItemDeleteOne {
+	return c.DeleteOneID(qli.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuoteLineItemClient) DeleteOneID(id string) *QuoteLineItemDeleteOne {
+	builder := c.Delete().Where(quotelineitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuoteLineItemDeleteOne{builder}
+}
+
+// Query returns a query builder for QuoteLineItem.
+func (c *QuoteLineItemClient) Query() *QuoteLineItemQuery {
+	return &QuoteLineItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuoteLineItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuoteLineItem entity by its id.
+func (c *QuoteLineItemClient) Get(ctx context.Context, id string) (*QuoteLineItem, error) {
+	return c.Query().Where(quotelineitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuoteLineItemClient) GetX(ctx context.Context, id string) *QuoteLineItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuote queries the quote edge of a QuoteLineItem.
+func (c *QuoteLineItemClient) QueryQuote(qli *QuoteLineItem) *QuoteQuery {
+	query := (&QuoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qli.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quotelineitem.Table, quotelineitem.FieldID, id),
+			sqlgraph.To(quote.Table, quote.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quotelineitem.QuoteTable, quotelineitem.QuoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(qli.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuoteLineItemClient) Hooks() []Hook {
+	return c.hooks.QuoteLineItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuoteLineItemClient) Interceptors() []Interceptor {
+	return c.inters.QuoteLineItem
+}
+
+func (c *QuoteLineItemClient) mutate(ctx context.Context, m *QuoteLineItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuoteLineItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuoteLineItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuoteLineItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuoteLineItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuoteLineItem mutation op: %q", m.Op())
+	}
+}
+
 // ScheduledTaskClient is a client for the ScheduledTask schema.
 type ScheduledTaskClient struct {
 	config
@@ -5683,6 +6289,139 @@ func (c *SubscriptionLineItemClient) mutate(ctx context.Context, m *Subscription
 	}
 }
 
+// SubscriptionMigrationResultClient is a client for the SubscriptionMigrationResult schema.
+type SubscriptionMigrationResultClient struct {
+	config
+}
+
+// NewSubscriptionMigrationResultClient returns a client for the SubscriptionMigrationResult from the given config.
+func NewSubscriptionMigrationResultClient(c config) *SubscriptionMigrationResultClient {
+	return &SubscriptionMigrationResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionmigrationresult.Hooks(f(g(h())))`.
+func (c *SubscriptionMigrationResultClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionMigrationResult = append(c.hooks.SubscriptionMigrationResult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionmigrationresult.Intercept(f(g(h())))`.
+func (c *SubscriptionMigrationResultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionMigrationResult = append(c.inters.SubscriptionMigrationResult, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionMigrationResult entity.
+func (c *SubscriptionMigrationResultClient) Create() *SubscriptionMigrationResultCreate {
+	mutation := newSubscriptionMigrationResultMutation(c.config, OpCreate)
+	return &SubscriptionMigrationResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionMigrationResult entities.
+func (c *SubscriptionMigrationResultClient) CreateBulk(builders ...*SubscriptionMigrationResultCreate) *SubscriptionMigrationResultCreateBulk {
+	return &SubscriptionMigrationResultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionMigrationResultClient) MapCreateBulk(slice any, setFunc func(*SubscriptionMigrationResultCreate, int)) *SubscriptionMigrationResultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionMigrationResultCreateBulk{err: fmt.Errorf("calling to SubscriptionMigrationResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionMigrationResultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionMigrationResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionMigrationResult.
+func (c *SubscriptionMigrationResultClient) Update() *SubscriptionMigrationResultUpdate {
+	mutation := newSubscriptionMigrationResultMutation(c.config, OpUpdate)
+	return &SubscriptionMigrationResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionMigrationResultClient) UpdateOne(smr *SubscriptionMigrationResult) *SubscriptionMigrationResultUpdateOne {
+	mutation := newSubscriptionMigrationResultMutation(c.config, OpUpdateOne, withSubscriptionMigrationResult(smr))
+	return &SubscriptionMigrationResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionMigrationResultClient) UpdateOneID(id string) *SubscriptionMigrationResultUpdateOne {
+	mutation := newSubscriptionMigrationResultMutation(c.config, OpUpdateOne, withSubscriptionMigrationResultID(id))
+	return &SubscriptionMigrationResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionMigrationResult.
+func (c *SubscriptionMigrationResultClient) Delete() *SubscriptionMigrationResultDelete {
+	mutation := newSubscriptionMigrationResultMutation(c.config, OpDelete)
+	return &SubscriptionMigrationResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionMigrationResultClient) DeleteOne(smr *SubscriptionMigrationResult) *SubscriptionMigrationResultDeleteOne {
+	return c.DeleteOneID(smr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionMigrationResultClient) DeleteOneID(id string) *SubscriptionMigrationResultDeleteOne {
+	builder := c.Delete().Where(subscriptionmigrationresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionMigrationResultDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionMigrationResult.
+func (c *SubscriptionMigrationResultClient) Query() *SubscriptionMigrationResultQuery {
+	return &SubscriptionMigrationResultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionMigrationResult},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionMigrationResult entity by its id.
+func (c *SubscriptionMigrationResultClient) Get(ctx context.Context, id string) (*SubscriptionMigrationResult, error) {
+	return c.Query().Where(subscriptionmigrationresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionMigrationResultClient) GetX(ctx context.Context, id string) *SubscriptionMigrationResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionMigrationResultClient) Hooks() []Hook {
+	return c.hooks.SubscriptionMigrationResult
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionMigrationResultClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionMigrationResult
+}
+
+func (c *SubscriptionMigrationResultClient) mutate(ctx context.Context, m *SubscriptionMigrationResultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionMigrationResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionMigrationResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionMigrationResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionMigrationResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionMigrationResult mutation op: %q", m.Op())
+	}
+}
+
 // SubscriptionPauseClient is a client for the SubscriptionPause schema.
 type SubscriptionPauseClient struct {
 	config
@@ -7333,23 +8072,25 @@ type (
 		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
 		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
 		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule, Task, TaxApplied,
-		TaxAssociation, TaxRate, Tenant, User, Wallet, WalletTransaction,
-		WorkflowExecution []ent.Hook
+		EntityIntegrationMapping, Environment, Experiment, ExperimentAssignment,
+		Feature, Group, Invoice, InvoiceLineItem, InvoiceSequence, Meter, Payment,
+		PaymentAttempt, Plan, Price, PriceUnit, Quote, QuoteLineItem, ScheduledTask,
+		Secret, Settings, Subscription, SubscriptionLineItem,
+		SubscriptionMigrationResult, SubscriptionPause, SubscriptionPhase,
+		SubscriptionSchedule, Task, TaxApplied, TaxAssociation, TaxRate, Tenant, User,
+		Wallet, WalletTransaction, WorkflowExecution []ent.Hook
 	}
 	inters struct {
 		Addon, AddonAssociation, AlertLogs, Auth, BillingSequence, Connection,
 		Costsheet, Coupon, CouponApplication, CouponAssociation, CreditGrant,
 		CreditGrantApplication, CreditNote, CreditNoteLineItem, Customer, Entitlement,
-		EntityIntegrationMapping, Environment, Feature, Group, Invoice,
-		InvoiceLineItem, InvoiceSequence, Meter, Payment, PaymentAttempt, Plan, Price,
-		PriceUnit, ScheduledTask, Secret, Settings, Subscription, SubscriptionLineItem,
-		SubscriptionPause, SubscriptionPhase, SubscriptionSchedule, Task, TaxApplied,
-		TaxAssociation, TaxRate, Tenant, User, Wallet, WalletTransaction,
-		WorkflowExecution []ent.Interceptor
+		EntityIntegrationMapping, Environment, Experiment, ExperimentAssignment,
+		Feature, Group, Invoice, InvoiceLineItem, InvoiceSequence, Meter, Payment,
+		PaymentAttempt, Plan, Price, PriceUnit, Quote, QuoteLineItem, ScheduledTask,
+		Secret, Settings, Subscription, SubscriptionLineItem,
+		SubscriptionMigrationResult, SubscriptionPause, SubscriptionPhase,
+		SubscriptionSchedule, Task, TaxApplied, TaxAssociation, TaxRate, Tenant, User,
+		Wallet, WalletTransaction, WorkflowExecution []ent.Interceptor
 	}
 )
 
@@ -54,7 +54,9 @@ type Customer struct {
 	AddressCountry string `json:"address_country,omitempty"`
 	// ParentCustomerID holds the value of the "parent_customer_id" field.
 	ParentCustomerID *string `json:"parent_customer_id,omitempty"`
-	selectValues     sql.SelectValues
+	// ConsolidateInvoices holds the value of the "consolidate_invoices" field.
+	ConsolidateInvoices *bool `json:"consolidate_invoices,omitempty"`
+	selectValues        sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -64,6 +66,8 @@ func (*Customer) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case customer.FieldMetadata:
 			values[i] = new([]byte)
+		case customer.FieldConsolidateInvoices:
+			values[i] = new(sql.NullBool)
 		case customer.FieldID, customer.FieldTenantID, customer.FieldStatus, customer.FieldCreatedBy, customer.FieldUpdatedBy, customer.FieldEnvironmentID, customer.FieldExternalID, customer.FieldName, customer.FieldEmail, customer.FieldAddressLine1, customer.FieldAddressLine2, customer.FieldAddressCity, customer.FieldAddressState, customer.FieldAddressPostalCode, customer.FieldAddressCountry, customer.FieldParentCustomerID:
 			values[i] = new(sql.NullString)
 		case customer.FieldCreatedAt, customer.FieldUpdatedAt:
@@ -200,6 +204,13 @@ func (c *Customer) assignValues(columns []string, values []any) error {
 				c.ParentCustomerID = new(string)
 				*c.ParentCustomerID = value.String
 			}
+		case customer.FieldConsolidateInvoices:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field consolidate_invoices", values[i])
+			} else if value.Valid {
+				c.ConsolidateInvoices = new(bool)
+				*c.ConsolidateInvoices = value.Bool
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -291,6 +302,11 @@ func (c *Customer) String() string {
 		builder.WriteString("parent_customer_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := c.ConsolidateInvoices; v != nil {
+		builder.WriteString("consolidate_invoices=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
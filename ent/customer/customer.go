@@ -49,6 +49,8 @@ const (
 	FieldAddressCountry = "address_country"
 	// FieldParentCustomerID holds the string denoting the parent_customer_id field in the database.
 	FieldParentCustomerID = "parent_customer_id"
+	// FieldConsolidateInvoices holds the string denoting the consolidate_invoices field in the database.
+	FieldConsolidateInvoices = "consolidate_invoices"
 	// Table holds the table name of the customer in the database.
 	Table = "customers"
 )
@@ -74,6 +76,7 @@ var Columns = []string{
 	FieldAddressPostalCode,
 	FieldAddressCountry,
 	FieldParentCustomerID,
+	FieldConsolidateInvoices,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -197,3 +200,8 @@ func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
 func ByParentCustomerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentCustomerID, opts...).ToFunc()
 }
+
+// ByConsolidateInvoices orders the results by the consolidate_invoices field.
+func ByConsolidateInvoices(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsolidateInvoices, opts...).ToFunc()
+}
@@ -149,6 +149,11 @@ func ParentCustomerID(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldParentCustomerID, v))
 }
 
+// ConsolidateInvoices applies equality check predicate on the "consolidate_invoices" field. It's identical to ConsolidateInvoicesEQ.
+func ConsolidateInvoices(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldConsolidateInvoices, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldTenantID, v))
@@ -1324,6 +1329,26 @@ func ParentCustomerIDContainsFold(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldContainsFold(FieldParentCustomerID, v))
 }
 
+// ConsolidateInvoicesEQ applies the EQ predicate on the "consolidate_invoices" field.
+func ConsolidateInvoicesEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldConsolidateInvoices, v))
+}
+
+// ConsolidateInvoicesNEQ applies the NEQ predicate on the "consolidate_invoices" field.
+func ConsolidateInvoicesNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldConsolidateInvoices, v))
+}
+
+// ConsolidateInvoicesIsNil applies the IsNil predicate on the "consolidate_invoices" field.
+func ConsolidateInvoicesIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldConsolidateInvoices))
+}
+
+// ConsolidateInvoicesNotNil applies the NotNil predicate on the "consolidate_invoices" field.
+func ConsolidateInvoicesNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldConsolidateInvoices))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Customer) predicate.Customer {
 	return predicate.Customer(sql.AndPredicates(predicates...))
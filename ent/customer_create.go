@@ -240,6 +240,20 @@ func (cc *CustomerCreate) SetNillableParentCustomerID(s *string) *CustomerCreate
 	return cc
 }
 
+// SetConsolidateInvoices sets the "consolidate_invoices" field.
+func (cc *CustomerCreate) SetConsolidateInvoices(b bool) *CustomerCreate {
+	cc.mutation.SetConsolidateInvoices(b)
+	return cc
+}
+
+// SetNillableConsolidateInvoices sets the "consolidate_invoices" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableConsolidateInvoices(b *bool) *CustomerCreate {
+	if b != nil {
+		cc.SetConsolidateInvoices(*b)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CustomerCreate) SetID(s string) *CustomerCreate {
 	cc.mutation.SetID(s)
@@ -441,6 +455,10 @@ func (cc *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
 		_spec.SetField(customer.FieldParentCustomerID, field.TypeString, value)
 		_node.ParentCustomerID = &value
 	}
+	if value, ok := cc.mutation.ConsolidateInvoices(); ok {
+		_spec.SetField(customer.FieldConsolidateInvoices, field.TypeBool, value)
+		_node.ConsolidateInvoices = &value
+	}
 	return _node, _spec
 }
 
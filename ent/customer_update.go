@@ -268,6 +268,26 @@ func (cu *CustomerUpdate) ClearParentCustomerID() *CustomerUpdate {
 	return cu
 }
 
+// SetConsolidateInvoices sets the "consolidate_invoices" field.
+func (cu *CustomerUpdate) SetConsolidateInvoices(b bool) *CustomerUpdate {
+	cu.mutation.SetConsolidateInvoices(b)
+	return cu
+}
+
+// SetNillableConsolidateInvoices sets the "consolidate_invoices" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableConsolidateInvoices(b *bool) *CustomerUpdate {
+	if b != nil {
+		cu.SetConsolidateInvoices(*b)
+	}
+	return cu
+}
+
+// ClearConsolidateInvoices clears the value of the "consolidate_invoices" field.
+func (cu *CustomerUpdate) ClearConsolidateInvoices() *CustomerUpdate {
+	cu.mutation.ClearConsolidateInvoices()
+	return cu
+}
+
 // Mutation returns the CustomerMutation object of the builder.
 func (cu *CustomerUpdate) Mutation() *CustomerMutation {
 	return cu.mutation
@@ -414,6 +434,12 @@ func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.ParentCustomerIDCleared() {
 		_spec.ClearField(customer.FieldParentCustomerID, field.TypeString)
 	}
+	if value, ok := cu.mutation.ConsolidateInvoices(); ok {
+		_spec.SetField(customer.FieldConsolidateInvoices, field.TypeBool, value)
+	}
+	if cu.mutation.ConsolidateInvoicesCleared() {
+		_spec.ClearField(customer.FieldConsolidateInvoices, field.TypeBool)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{customer.Label}
@@ -674,6 +700,26 @@ func (cuo *CustomerUpdateOne) ClearParentCustomerID() *CustomerUpdateOne {
 	return cuo
 }
 
+// SetConsolidateInvoices sets the "consolidate_invoices" field.
+func (cuo *CustomerUpdateOne) SetConsolidateInvoices(b bool) *CustomerUpdateOne {
+	cuo.mutation.SetConsolidateInvoices(b)
+	return cuo
+}
+
+// SetNillableConsolidateInvoices sets the "consolidate_invoices" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableConsolidateInvoices(b *bool) *CustomerUpdateOne {
+	if b != nil {
+		cuo.SetConsolidateInvoices(*b)
+	}
+	return cuo
+}
+
+// ClearConsolidateInvoices clears the value of the "consolidate_invoices" field.
+func (cuo *CustomerUpdateOne) ClearConsolidateInvoices() *CustomerUpdateOne {
+	cuo.mutation.ClearConsolidateInvoices()
+	return cuo
+}
+
 // Mutation returns the CustomerMutation object of the builder.
 func (cuo *CustomerUpdateOne) Mutation() *CustomerMutation {
 	return cuo.mutation
@@ -850,6 +896,12 @@ func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err
 	if cuo.mutation.ParentCustomerIDCleared() {
 		_spec.ClearField(customer.FieldParentCustomerID, field.TypeString)
 	}
+	if value, ok := cuo.mutation.ConsolidateInvoices(); ok {
+		_spec.SetField(customer.FieldConsolidateInvoices, field.TypeBool, value)
+	}
+	if cuo.mutation.ConsolidateInvoicesCleared() {
+		_spec.ClearField(customer.FieldConsolidateInvoices, field.TypeBool)
+	}
 	_node = &Customer{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
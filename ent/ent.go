@@ -30,6 +30,8 @@ import (
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
@@ -41,11 +43,14 @@ import (
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
@@ -118,52 +123,57 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			addon.Table:                    addon.ValidColumn,
-			addonassociation.Table:         addonassociation.ValidColumn,
-			alertlogs.Table:                alertlogs.ValidColumn,
-			auth.Table:                     auth.ValidColumn,
-			billingsequence.Table:          billingsequence.ValidColumn,
-			connection.Table:               connection.ValidColumn,
-			costsheet.Table:                costsheet.ValidColumn,
-			coupon.Table:                   coupon.ValidColumn,
-			couponapplication.Table:        couponapplication.ValidColumn,
-			couponassociation.Table:        couponassociation.ValidColumn,
-			creditgrant.Table:              creditgrant.ValidColumn,
-			creditgrantapplication.Table:   creditgrantapplication.ValidColumn,
-			creditnote.Table:               creditnote.ValidColumn,
-			creditnotelineitem.Table:       creditnotelineitem.ValidColumn,
-			customer.Table:                 customer.ValidColumn,
-			entitlement.Table:              entitlement.ValidColumn,
-			entityintegrationmapping.Table: entityintegrationmapping.ValidColumn,
-			environment.Table:              environment.ValidColumn,
-			feature.Table:                  feature.ValidColumn,
-			group.Table:                    group.ValidColumn,
-			invoice.Table:                  invoice.ValidColumn,
-			invoicelineitem.Table:          invoicelineitem.ValidColumn,
-			invoicesequence.Table:          invoicesequence.ValidColumn,
-			meter.Table:                    meter.ValidColumn,
-			payment.Table:                  payment.ValidColumn,
-			paymentattempt.Table:           paymentattempt.ValidColumn,
-			plan.Table:                     plan.ValidColumn,
-			price.Table:                    price.ValidColumn,
-			priceunit.Table:                priceunit.ValidColumn,
-			scheduledtask.Table:            scheduledtask.ValidColumn,
-			secret.Table:                   secret.ValidColumn,
-			settings.Table:                 settings.ValidColumn,
-			subscription.Table:             subscription.ValidColumn,
-			subscriptionlineitem.Table:     subscriptionlineitem.ValidColumn,
-			subscriptionpause.Table:        subscriptionpause.ValidColumn,
-			subscriptionphase.Table:        subscriptionphase.ValidColumn,
-			subscriptionschedule.Table:     subscriptionschedule.ValidColumn,
-			task.Table:                     task.ValidColumn,
-			taxapplied.Table:               taxapplied.ValidColumn,
-			taxassociation.Table:           taxassociation.ValidColumn,
-			taxrate.Table:                  taxrate.ValidColumn,
-			tenant.Table:                   tenant.ValidColumn,
-			user.Table:                     user.ValidColumn,
-			wallet.Table:                   wallet.ValidColumn,
-			wallettransaction.Table:        wallettransaction.ValidColumn,
-			workflowexecution.Table:        workflowexecution.ValidColumn,
+			addon.Table:                       addon.ValidColumn,
+			addonassociation.Table:            addonassociation.ValidColumn,
+			alertlogs.Table:                   alertlogs.ValidColumn,
+			auth.Table:                        auth.ValidColumn,
+			billingsequence.Table:             billingsequence.ValidColumn,
+			connection.Table:                  connection.ValidColumn,
+			costsheet.Table:                   costsheet.ValidColumn,
+			coupon.Table:                      coupon.ValidColumn,
+			couponapplication.Table:           couponapplication.ValidColumn,
+			couponassociation.Table:           couponassociation.ValidColumn,
+			creditgrant.Table:                 creditgrant.ValidColumn,
+			creditgrantapplication.Table:      creditgrantapplication.ValidColumn,
+			creditnote.Table:                  creditnote.ValidColumn,
+			creditnotelineitem.Table:          creditnotelineitem.ValidColumn,
+			customer.Table:                    customer.ValidColumn,
+			entitlement.Table:                 entitlement.ValidColumn,
+			entityintegrationmapping.Table:    entityintegrationmapping.ValidColumn,
+			environment.Table:                 environment.ValidColumn,
+			experiment.Table:                  experiment.ValidColumn,
+			experimentassignment.Table:        experimentassignment.ValidColumn,
+			feature.Table:                     feature.ValidColumn,
+			group.Table:                       group.ValidColumn,
+			invoice.Table:                     invoice.ValidColumn,
+			invoicelineitem.Table:             invoicelineitem.ValidColumn,
+			invoicesequence.Table:             invoicesequence.ValidColumn,
+			meter.Table:                       meter.ValidColumn,
+			payment.Table:                     payment.ValidColumn,
+			paymentattempt.Table:              paymentattempt.ValidColumn,
+			plan.Table:                        plan.ValidColumn,
+			price.Table:                       price.ValidColumn,
+			priceunit.Table:                   priceunit.ValidColumn,
+			quote.Table:                       quote.ValidColumn,
+			quotelineitem.Table:               quotelineitem.ValidColumn,
+			scheduledtask.Table:               scheduledtask.ValidColumn,
+			secret.Table:                      secret.ValidColumn,
+			settings.Table:                    settings.ValidColumn,
+			subscription.Table:                subscription.ValidColumn,
+			subscriptionlineitem.Table:        subscriptionlineitem.ValidColumn,
+			subscriptionmigrationresult.Table: subscriptionmigrationresult.ValidColumn,
+			subscriptionpause.Table:           subscriptionpause.ValidColumn,
+			subscriptionphase.Table:           subscriptionphase.ValidColumn,
+			subscriptionschedule.Table:        subscriptionschedule.ValidColumn,
+			task.Table:                        task.ValidColumn,
+			taxapplied.Table:                  taxapplied.ValidColumn,
+			taxassociation.Table:              taxassociation.ValidColumn,
+			taxrate.Table:                     taxrate.ValidColumn,
+			tenant.Table:                      tenant.ValidColumn,
+			user.Table:                        user.ValidColumn,
+			wallet.Table:                      wallet.ValidColumn,
+			wallettransaction.Table:           wallettransaction.ValidColumn,
+			workflowexecution.Table:           workflowexecution.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -1375,6 +1375,16 @@ func EndDateNotNil() predicate.Entitlement {
 	return predicate.Entitlement(sql.FieldNotNull(FieldEndDate))
 }
 
+// RolloverConfigIsNil applies the IsNil predicate on the "rollover_config" field.
+func RolloverConfigIsNil() predicate.Entitlement {
+	return predicate.Entitlement(sql.FieldIsNull(FieldRolloverConfig))
+}
+
+// RolloverConfigNotNil applies the NotNil predicate on the "rollover_config" field.
+func RolloverConfigNotNil() predicate.Entitlement {
+	return predicate.Entitlement(sql.FieldNotNull(FieldRolloverConfig))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Entitlement) predicate.Entitlement {
 	return predicate.Entitlement(sql.AndPredicates(predicates...))
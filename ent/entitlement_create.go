@@ -277,6 +277,12 @@ func (ec *EntitlementCreate) SetNillableEndDate(t *time.Time) *EntitlementCreate
 	return ec
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (ec *EntitlementCreate) SetRolloverConfig(tc *types.RolloverConfig) *EntitlementCreate {
+	ec.mutation.SetRolloverConfig(tc)
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EntitlementCreate) SetID(s string) *EntitlementCreate {
 	ec.mutation.SetID(s)
@@ -406,6 +412,11 @@ func (ec *EntitlementCreate) check() error {
 	if _, ok := ec.mutation.DisplayOrder(); !ok {
 		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "Entitlement.display_order"`)}
 	}
+	if v, ok := ec.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "Entitlement.rollover_config": %w`, err)}
+		}
+	}
 	if v, ok := ec.mutation.ID(); ok {
 		if err := entitlement.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Entitlement.id": %w`, err)}
@@ -526,6 +537,10 @@ func (ec *EntitlementCreate) createSpec() (*Entitlement, *sqlgraph.CreateSpec) {
 		_spec.SetField(entitlement.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = &value
 	}
+	if value, ok := ec.mutation.RolloverConfig(); ok {
+		_spec.SetField(entitlement.FieldRolloverConfig, field.TypeJSON, value)
+		_node.RolloverConfig = value
+	}
 	return _node, _spec
 }
 
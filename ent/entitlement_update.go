@@ -313,6 +313,18 @@ func (eu *EntitlementUpdate) ClearEndDate() *EntitlementUpdate {
 	return eu
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (eu *EntitlementUpdate) SetRolloverConfig(tc *types.RolloverConfig) *EntitlementUpdate {
+	eu.mutation.SetRolloverConfig(tc)
+	return eu
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (eu *EntitlementUpdate) ClearRolloverConfig() *EntitlementUpdate {
+	eu.mutation.ClearRolloverConfig()
+	return eu
+}
+
 // Mutation returns the EntitlementMutation object of the builder.
 func (eu *EntitlementUpdate) Mutation() *EntitlementMutation {
 	return eu.mutation
@@ -376,6 +388,11 @@ func (eu *EntitlementUpdate) check() error {
 			return &ValidationError{Name: "usage_reset_period", err: fmt.Errorf(`ent: validator failed for field "Entitlement.usage_reset_period": %w`, err)}
 		}
 	}
+	if v, ok := eu.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "Entitlement.rollover_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -478,6 +495,12 @@ func (eu *EntitlementUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.EndDateCleared() {
 		_spec.ClearField(entitlement.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := eu.mutation.RolloverConfig(); ok {
+		_spec.SetField(entitlement.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if eu.mutation.RolloverConfigCleared() {
+		_spec.ClearField(entitlement.FieldRolloverConfig, field.TypeJSON)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{entitlement.Label}
@@ -782,6 +805,18 @@ func (euo *EntitlementUpdateOne) ClearEndDate() *EntitlementUpdateOne {
 	return euo
 }
 
+// SetRolloverConfig sets the "rollover_config" field.
+func (euo *EntitlementUpdateOne) SetRolloverConfig(tc *types.RolloverConfig) *EntitlementUpdateOne {
+	euo.mutation.SetRolloverConfig(tc)
+	return euo
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (euo *EntitlementUpdateOne) ClearRolloverConfig() *EntitlementUpdateOne {
+	euo.mutation.ClearRolloverConfig()
+	return euo
+}
+
 // Mutation returns the EntitlementMutation object of the builder.
 func (euo *EntitlementUpdateOne) Mutation() *EntitlementMutation {
 	return euo.mutation
@@ -858,6 +893,11 @@ func (euo *EntitlementUpdateOne) check() error {
 			return &ValidationError{Name: "usage_reset_period", err: fmt.Errorf(`ent: validator failed for field "Entitlement.usage_reset_period": %w`, err)}
 		}
 	}
+	if v, ok := euo.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "Entitlement.rollover_config": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -977,6 +1017,12 @@ func (euo *EntitlementUpdateOne) sqlSave(ctx context.Context) (_node *Entitlemen
 	if euo.mutation.EndDateCleared() {
 		_spec.ClearField(entitlement.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := euo.mutation.RolloverConfig(); ok {
+		_spec.SetField(entitlement.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if euo.mutation.RolloverConfigCleared() {
+		_spec.ClearField(entitlement.FieldRolloverConfig, field.TypeJSON)
+	}
 	_node = &Entitlement{config: euo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// ExperimentCreate is the builder for creating a Experiment entity.
+type ExperimentCreate struct {
+	config
+	mutation *ExperimentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ec *ExperimentCreate) SetTenantID(s string) *ExperimentCreate {
+	ec.mutation.SetTenantID(s)
+	return ec
+}
+
+// SetStatus sets the "status" field.
+func (ec *ExperimentCreate) SetStatus(s string) *ExperimentCreate {
+	ec.mutation.SetStatus(s)
+	return ec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableStatus(s *string) *ExperimentCreate {
+	if s != nil {
+		ec.SetStatus(*s)
+	}
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *ExperimentCreate) SetCreatedAt(t time.Time) *ExperimentCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableCreatedAt(t *time.Time) *ExperimentCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ec *ExperimentCreate) SetUpdatedAt(t time.Time) *ExperimentCreate {
+	ec.mutation.SetUpdatedAt(t)
+	return ec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableUpdatedAt(t *time.Time) *ExperimentCreate {
+	if t != nil {
+		ec.SetUpdatedAt(*t)
+	}
+	return ec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ec *ExperimentCreate) SetCreatedBy(s string) *ExperimentCreate {
+	ec.mutation.SetCreatedBy(s)
+	return ec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableCreatedBy(s *string) *ExperimentCreate {
+	if s != nil {
+		ec.SetCreatedBy(*s)
+	}
+	return ec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ec *ExperimentCreate) SetUpdatedBy(s string) *ExperimentCreate {
+	ec.mutation.SetUpdatedBy(s)
+	return ec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableUpdatedBy(s *string) *ExperimentCreate {
+	if s != nil {
+		ec.SetUpdatedBy(*s)
+	}
+	return ec
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (ec *ExperimentCreate) SetEnvironmentID(s string) *ExperimentCreate {
+	ec.mutation.SetEnvironmentID(s)
+	return ec
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableEnvironmentID(s *string) *ExperimentCreate {
+	if s != nil {
+		ec.SetEnvironmentID(*s)
+	}
+	return ec
+}
+
+// SetMetadata sets the "metadata" field.
+func (ec *ExperimentCreate) SetMetadata(m map[string]string) *ExperimentCreate {
+	ec.mutation.SetMetadata(m)
+	return ec
+}
+
+// SetName sets the "name" field.
+func (ec *ExperimentCreate) SetName(s string) *ExperimentCreate {
+	ec.mutation.SetName(s)
+	return ec
+}
+
+// SetDescription sets the "description" field.
+func (ec *ExperimentCreate) SetDescription(s string) *ExperimentCreate {
+	ec.mutation.SetDescription(s)
+	return ec
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableDescription(s *string) *ExperimentCreate {
+	if s != nil {
+		ec.SetDescription(*s)
+	}
+	return ec
+}
+
+// SetPlanID sets the "plan_id" field.
+func (ec *ExperimentCreate) SetPlanID(s string) *ExperimentCreate {
+	ec.mutation.SetPlanID(s)
+	return ec
+}
+
+// SetExperimentStatus sets the "experiment_status" field.
+func (ec *ExperimentCreate) SetExperimentStatus(ts types.ExperimentStatus) *ExperimentCreate {
+	ec.mutation.SetExperimentStatus(ts)
+	return ec
+}
+
+// SetNillableExperimentStatus sets the "experiment_status" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableExperimentStatus(ts *types.ExperimentStatus) *ExperimentCreate {
+	if ts != nil {
+		ec.SetExperimentStatus(*ts)
+	}
+	return ec
+}
+
+// SetVariants sets the "variants" field.
+func (ec *ExperimentCreate) SetVariants(tv []types.ExperimentVariant) *ExperimentCreate {
+	ec.mutation.SetVariants(tv)
+	return ec
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ec *ExperimentCreate) SetStartedAt(t time.Time) *ExperimentCreate {
+	ec.mutation.SetStartedAt(t)
+	return ec
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableStartedAt(t *time.Time) *ExperimentCreate {
+	if t != nil {
+		ec.SetStartedAt(*t)
+	}
+	return ec
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (ec *ExperimentCreate) SetEndedAt(t time.Time) *ExperimentCreate {
+	ec.mutation.SetEndedAt(t)
+	return ec
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (ec *ExperimentCreate) SetNillableEndedAt(t *time.Time) *ExperimentCreate {
+	if t != nil {
+		ec.SetEndedAt(*t)
+	}
+	return ec
+}
+
+// SetID sets the "id" field.
+func (ec *ExperimentCreate) SetID(s string) *ExperimentCreate {
+	ec.mutation.SetID(s)
+	return ec
+}
+
+// Mutation returns the ExperimentMutation object of the builder.
+func (ec *ExperimentCreate) Mutation() *ExperimentMutation {
+	return ec.mutation
+}
+
+// Save creates the Experiment in the database.
+func (ec *ExperimentCreate) Save(ctx context.Context) (*Experiment, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *ExperimentCreate) SaveX(ctx context.Context) *Experiment {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *ExperimentCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *ExperimentCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *ExperimentCreate) defaults() {
+	if _, ok := ec.mutation.Status(); !ok {
+		v := experiment.DefaultStatus
+		ec.mutation.SetStatus(v)
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := experiment.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		v := experiment.DefaultUpdatedAt()
+		ec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ec.mutation.EnvironmentID(); !ok {
+		v := experiment.DefaultEnvironmentID
+		ec.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := ec.mutation.ExperimentStatus(); !ok {
+		v := experiment.DefaultExperimentStatus
+		ec.mutation.SetExperimentStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *ExperimentCreate) check() error {
+	if _, ok := ec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Experiment.tenant_id"`)}
+	}
+	if v, ok := ec.mutation.TenantID(); ok {
+		if err := experiment.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Experiment.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Experiment.status"`)}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Experiment.created_at"`)}
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Experiment.updated_at"`)}
+	}
+	if _, ok := ec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Experiment.name"`)}
+	}
+	if v, ok := ec.mutation.Name(); ok {
+		if err := experiment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Experiment.name": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "Experiment.plan_id"`)}
+	}
+	if v, ok := ec.mutation.PlanID(); ok {
+		if err := experiment.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Experiment.plan_id": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.ExperimentStatus(); !ok {
+		return &ValidationError{Name: "experiment_status", err: errors.New(`ent: missing required field "Experiment.experiment_status"`)}
+	}
+	if v, ok := ec.mutation.ExperimentStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "experiment_status", err: fmt.Errorf(`ent: validator failed for field "Experiment.experiment_status": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Variants(); !ok {
+		return &ValidationError{Name: "variants", err: errors.New(`ent: missing required field "Experiment.variants"`)}
+	}
+	return nil
+}
+
+func (ec *ExperimentCreate) sqlSave(ctx context.Context) (*Experiment, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Experiment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *ExperimentCreate) createSpec() (*Experiment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Experiment{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(experiment.Table, sqlgraph.NewFieldSpec(experiment.FieldID, field.TypeString))
+	)
+	if id, ok := ec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ec.mutation.TenantID(); ok {
+		_spec.SetField(experiment.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := ec.mutation.Status(); ok {
+		_spec.SetField(experiment.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(experiment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.UpdatedAt(); ok {
+		_spec.SetField(experiment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ec.mutation.CreatedBy(); ok {
+		_spec.SetField(experiment.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ec.mutation.UpdatedBy(); ok {
+		_spec.SetField(experiment.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ec.mutation.EnvironmentID(); ok {
+		_spec.SetField(experiment.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := ec.mutation.Metadata(); ok {
+		_spec.SetField(experiment.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := ec.mutation.Name(); ok {
+		_spec.SetField(experiment.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ec.mutation.Description(); ok {
+		_spec.SetField(experiment.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ec.mutation.PlanID(); ok {
+		_spec.SetField(experiment.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
+	if value, ok := ec.mutation.ExperimentStatus(); ok {
+		_spec.SetField(experiment.FieldExperimentStatus, field.TypeString, value)
+		_node.ExperimentStatus = value
+	}
+	if value, ok := ec.mutation.Variants(); ok {
+		_spec.SetField(experiment.FieldVariants, field.TypeJSON, value)
+		_node.Variants = value
+	}
+	if value, ok := ec.mutation.StartedAt(); ok {
+		_spec.SetField(experiment.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := ec.mutation.EndedAt(); ok {
+		_spec.SetField(experiment.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	return _node, _spec
+}
+
+// ExperimentCreateBulk is the builder for creating many Experiment entities in bulk.
+type ExperimentCreateBulk struct {
+	config
+	err      error
+	builders []*ExperimentCreate
+}
+
+// Save creates the Experiment entities in the database.
+func (ecb *ExperimentCreateBulk) Save(ctx context.Context) ([]*Experiment, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Experiment, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExperimentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *ExperimentCreateBulk) SaveX(ctx context.Context) []*Experiment {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *ExperimentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *ExperimentCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// ExperimentUpdate is the builder for updating Experiment entities.
+type ExperimentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExperimentMutation
+}
+
+// Where appends a list predicates to the ExperimentUpdate builder.
+func (eu *ExperimentUpdate) Where(ps ...predicate.Experiment) *ExperimentUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetStatus sets the "status" field.
+func (eu *ExperimentUpdate) SetStatus(s string) *ExperimentUpdate {
+	eu.mutation.SetStatus(s)
+	return eu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableStatus(s *string) *ExperimentUpdate {
+	if s != nil {
+		eu.SetStatus(*s)
+	}
+	return eu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eu *ExperimentUpdate) SetUpdatedAt(t time.Time) *ExperimentUpdate {
+	eu.mutation.SetUpdatedAt(t)
+	return eu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (eu *ExperimentUpdate) SetUpdatedBy(s string) *ExperimentUpdate {
+	eu.mutation.SetUpdatedBy(s)
+	return eu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableUpdatedBy(s *string) *ExperimentUpdate {
+	if s != nil {
+		eu.SetUpdatedBy(*s)
+	}
+	return eu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (eu *ExperimentUpdate) ClearUpdatedBy() *ExperimentUpdate {
+	eu.mutation.ClearUpdatedBy()
+	return eu
+}
+
+// SetMetadata sets the "metadata" field.
+func (eu *ExperimentUpdate) SetMetadata(m map[string]string) *ExperimentUpdate {
+	eu.mutation.SetMetadata(m)
+	return eu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (eu *ExperimentUpdate) ClearMetadata() *ExperimentUpdate {
+	eu.mutation.ClearMetadata()
+	return eu
+}
+
+// SetName sets the "name" field.
+func (eu *ExperimentUpdate) SetName(s string) *ExperimentUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableName(s *string) *ExperimentUpdate {
+	if s != nil {
+		eu.SetName(*s)
+	}
+	return eu
+}
+
+// SetDescription sets the "description" field.
+func (eu *ExperimentUpdate) SetDescription(s string) *ExperimentUpdate {
+	eu.mutation.SetDescription(s)
+	return eu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableDescription(s *string) *ExperimentUpdate {
+	if s != nil {
+		eu.SetDescription(*s)
+	}
+	return eu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (eu *ExperimentUpdate) ClearDescription() *ExperimentUpdate {
+	eu.mutation.ClearDescription()
+	return eu
+}
+
+// SetExperimentStatus sets the "experiment_status" field.
+func (eu *ExperimentUpdate) SetExperimentStatus(ts types.ExperimentStatus) *ExperimentUpdate {
+	eu.mutation.SetExperimentStatus(ts)
+	return eu
+}
+
+// SetNillableExperimentStatus sets the "experiment_status" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableExperimentStatus(ts *types.ExperimentStatus) *ExperimentUpdate {
+	if ts != nil {
+		eu.SetExperimentStatus(*ts)
+	}
+	return eu
+}
+
+// SetVariants sets the "variants" field.
+func (eu *ExperimentUpdate) SetVariants(tv []types.ExperimentVariant) *ExperimentUpdate {
+	eu.mutation.SetVariants(tv)
+	return eu
+}
+
+// AppendVariants appends tv to the "variants" field.
+func (eu *ExperimentUpdate) AppendVariants(tv []types.ExperimentVariant) *ExperimentUpdate {
+	eu.mutation.AppendVariants(tv)
+	return eu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (eu *ExperimentUpdate) SetStartedAt(t time.Time) *ExperimentUpdate {
+	eu.mutation.SetStartedAt(t)
+	return eu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableStartedAt(t *time.Time) *ExperimentUpdate {
+	if t != nil {
+		eu.SetStartedAt(*t)
+	}
+	return eu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (eu *ExperimentUpdate) ClearStartedAt() *ExperimentUpdate {
+	eu.mutation.ClearStartedAt()
+	return eu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (eu *ExperimentUpdate) SetEndedAt(t time.Time) *ExperimentUpdate {
+	eu.mutation.SetEndedAt(t)
+	return eu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (eu *ExperimentUpdate) SetNillableEndedAt(t *time.Time) *ExperimentUpdate {
+	if t != nil {
+		eu.SetEndedAt(*t)
+	}
+	return eu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (eu *ExperimentUpdate) ClearEndedAt() *ExperimentUpdate {
+	eu.mutation.ClearEndedAt()
+	return eu
+}
+
+// Mutation returns the ExperimentMutation object of the builder.
+func (eu *ExperimentUpdate) Mutation() *ExperimentMutation {
+	return eu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *ExperimentUpdate) Save(ctx context.Context) (int, error) {
+	eu.defaults()
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *ExperimentUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *ExperimentUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *ExperimentUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eu *ExperimentUpdate) defaults() {
+	if _, ok := eu.mutation.UpdatedAt(); !ok {
+		v := experiment.UpdateDefaultUpdatedAt()
+		eu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *ExperimentUpdate) check() error {
+	if v, ok := eu.mutation.Name(); ok {
+		if err := experiment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Experiment.name": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.ExperimentStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "experiment_status", err: fmt.Errorf(`ent: validator failed for field "Experiment.experiment_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eu *ExperimentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(experiment.Table, experiment.Columns, sqlgraph.NewFieldSpec(experiment.FieldID, field.TypeString))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Status(); ok {
+		_spec.SetField(experiment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.UpdatedAt(); ok {
+		_spec.SetField(experiment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eu.mutation.CreatedByCleared() {
+		_spec.ClearField(experiment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := eu.mutation.UpdatedBy(); ok {
+		_spec.SetField(experiment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if eu.mutation.UpdatedByCleared() {
+		_spec.ClearField(experiment.FieldUpdatedBy, field.TypeString)
+	}
+	if eu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(experiment.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := eu.mutation.Metadata(); ok {
+		_spec.SetField(experiment.FieldMetadata, field.TypeJSON, value)
+	}
+	if eu.mutation.MetadataCleared() {
+		_spec.ClearField(experiment.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.SetField(experiment.FieldName, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Description(); ok {
+		_spec.SetField(experiment.FieldDescription, field.TypeString, value)
+	}
+	if eu.mutation.DescriptionCleared() {
+		_spec.ClearField(experiment.FieldDescription, field.TypeString)
+	}
+	if value, ok := eu.mutation.ExperimentStatus(); ok {
+		_spec.SetField(experiment.FieldExperimentStatus, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Variants(); ok {
+		_spec.SetField(experiment.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := eu.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, experiment.FieldVariants, value)
+		})
+	}
+	if value, ok := eu.mutation.StartedAt(); ok {
+		_spec.SetField(experiment.FieldStartedAt, field.TypeTime, value)
+	}
+	if eu.mutation.StartedAtCleared() {
+		_spec.ClearField(experiment.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.EndedAt(); ok {
+		_spec.SetField(experiment.FieldEndedAt, field.TypeTime, value)
+	}
+	if eu.mutation.EndedAtCleared() {
+		_spec.ClearField(experiment.FieldEndedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experiment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// ExperimentUpdateOne is the builder for updating a single Experiment entity.
+type ExperimentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExperimentMutation
+}
+
+// SetStatus sets the "status" field.
+func (euo *ExperimentUpdateOne) SetStatus(s string) *ExperimentUpdateOne {
+	euo.mutation.SetStatus(s)
+	return euo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableStatus(s *string) *ExperimentUpdateOne {
+	if s != nil {
+		euo.SetStatus(*s)
+	}
+	return euo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (euo *ExperimentUpdateOne) SetUpdatedAt(t time.Time) *ExperimentUpdateOne {
+	euo.mutation.SetUpdatedAt(t)
+	return euo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (euo *ExperimentUpdateOne) SetUpdatedBy(s string) *ExperimentUpdateOne {
+	euo.mutation.SetUpdatedBy(s)
+	return euo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableUpdatedBy(s *string) *ExperimentUpdateOne {
+	if s != nil {
+		euo.SetUpdatedBy(*s)
+	}
+	return euo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (euo *ExperimentUpdateOne) ClearUpdatedBy() *ExperimentUpdateOne {
+	euo.mutation.ClearUpdatedBy()
+	return euo
+}
+
+// SetMetadata sets the "metadata" field.
+func (euo *ExperimentUpdateOne) SetMetadata(m map[string]string) *ExperimentUpdateOne {
+	euo.mutation.SetMetadata(m)
+	return euo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (euo *ExperimentUpdateOne) ClearMetadata() *ExperimentUpdateOne {
+	euo.mutation.ClearMetadata()
+	return euo
+}
+
+// SetName sets the "name" field.
+func (euo *ExperimentUpdateOne) SetName(s string) *ExperimentUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableName(s *string) *ExperimentUpdateOne {
+	if s != nil {
+		euo.SetName(*s)
+	}
+	return euo
+}
+
+// SetDescription sets the "description" field.
+func (euo *ExperimentUpdateOne) SetDescription(s string) *ExperimentUpdateOne {
+	euo.mutation.SetDescription(s)
+	return euo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableDescription(s *string) *ExperimentUpdateOne {
+	if s != nil {
+		euo.SetDescription(*s)
+	}
+	return euo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (euo *ExperimentUpdateOne) ClearDescription() *ExperimentUpdateOne {
+	euo.mutation.ClearDescription()
+	return euo
+}
+
+// SetExperimentStatus sets the "experiment_status" field.
+func (euo *ExperimentUpdateOne) SetExperimentStatus(ts types.ExperimentStatus) *ExperimentUpdateOne {
+	euo.mutation.SetExperimentStatus(ts)
+	return euo
+}
+
+// SetNillableExperimentStatus sets the "experiment_status" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableExperimentStatus(ts *types.ExperimentStatus) *ExperimentUpdateOne {
+	if ts != nil {
+		euo.SetExperimentStatus(*ts)
+	}
+	return euo
+}
+
+// SetVariants sets the "variants" field.
+func (euo *ExperimentUpdateOne) SetVariants(tv []types.ExperimentVariant) *ExperimentUpdateOne {
+	euo.mutation.SetVariants(tv)
+	return euo
+}
+
+// AppendVariants appends tv to the "variants" field.
+func (euo *ExperimentUpdateOne) AppendVariants(tv []types.ExperimentVariant) *ExperimentUpdateOne {
+	euo.mutation.AppendVariants(tv)
+	return euo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (euo *ExperimentUpdateOne) SetStartedAt(t time.Time) *ExperimentUpdateOne {
+	euo.mutation.SetStartedAt(t)
+	return euo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableStartedAt(t *time.Time) *ExperimentUpdateOne {
+	if t != nil {
+		euo.SetStartedAt(*t)
+	}
+	return euo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (euo *ExperimentUpdateOne) ClearStartedAt() *ExperimentUpdateOne {
+	euo.mutation.ClearStartedAt()
+	return euo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (euo *ExperimentUpdateOne) SetEndedAt(t time.Time) *ExperimentUpdateOne {
+	euo.mutation.SetEndedAt(t)
+	return euo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (euo *ExperimentUpdateOne) SetNillableEndedAt(t *time.Time) *ExperimentUpdateOne {
+	if t != nil {
+		euo.SetEndedAt(*t)
+	}
+	return euo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (euo *ExperimentUpdateOne) ClearEndedAt() *ExperimentUpdateOne {
+	euo.mutation.ClearEndedAt()
+	return euo
+}
+
+// Mutation returns the ExperimentMutation object of the builder.
+func (euo *ExperimentUpdateOne) Mutation() *ExperimentMutation {
+	return euo.mutation
+}
+
+// Where appends a list predicates to the ExperimentUpdate builder.
+func (euo *ExperimentUpdateOne) Where(ps ...predicate.Experiment) *ExperimentUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *ExperimentUpdateOne) Select(field string, fields ...string) *ExperimentUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Experiment entity.
+func (euo *ExperimentUpdateOne) Save(ctx context.Context) (*Experiment, error) {
+	euo.defaults()
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *ExperimentUpdateOne) SaveX(ctx context.Context) *Experiment {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *ExperimentUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *ExperimentUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (euo *ExperimentUpdateOne) defaults() {
+	if _, ok := euo.mutation.UpdatedAt(); !ok {
+		v := experiment.UpdateDefaultUpdatedAt()
+		euo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *ExperimentUpdateOne) check() error {
+	if v, ok := euo.mutation.Name(); ok {
+		if err := experiment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Experiment.name": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.ExperimentStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "experiment_status", err: fmt.Errorf(`ent: validator failed for field "Experiment.experiment_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (euo *ExperimentUpdateOne) sqlSave(ctx context.Context) (_node *Experiment, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(experiment.Table, experiment.Columns, sqlgraph.NewFieldSpec(experiment.FieldID, field.TypeString))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Experiment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, experiment.FieldID)
+		for _, f := range fields {
+			if !experiment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != experiment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Status(); ok {
+		_spec.SetField(experiment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.UpdatedAt(); ok {
+		_spec.SetField(experiment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if euo.mutation.CreatedByCleared() {
+		_spec.ClearField(experiment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := euo.mutation.UpdatedBy(); ok {
+		_spec.SetField(experiment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if euo.mutation.UpdatedByCleared() {
+		_spec.ClearField(experiment.FieldUpdatedBy, field.TypeString)
+	}
+	if euo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(experiment.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := euo.mutation.Metadata(); ok {
+		_spec.SetField(experiment.FieldMetadata, field.TypeJSON, value)
+	}
+	if euo.mutation.MetadataCleared() {
+		_spec.ClearField(experiment.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.SetField(experiment.FieldName, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Description(); ok {
+		_spec.SetField(experiment.FieldDescription, field.TypeString, value)
+	}
+	if euo.mutation.DescriptionCleared() {
+		_spec.ClearField(experiment.FieldDescription, field.TypeString)
+	}
+	if value, ok := euo.mutation.ExperimentStatus(); ok {
+		_spec.SetField(experiment.FieldExperimentStatus, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Variants(); ok {
+		_spec.SetField(experiment.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := euo.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, experiment.FieldVariants, value)
+		})
+	}
+	if value, ok := euo.mutation.StartedAt(); ok {
+		_spec.SetField(experiment.FieldStartedAt, field.TypeTime, value)
+	}
+	if euo.mutation.StartedAtCleared() {
+		_spec.ClearField(experiment.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.EndedAt(); ok {
+		_spec.SetField(experiment.FieldEndedAt, field.TypeTime, value)
+	}
+	if euo.mutation.EndedAtCleared() {
+		_spec.ClearField(experiment.FieldEndedAt, field.TypeTime)
+	}
+	_node = &Experiment{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experiment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
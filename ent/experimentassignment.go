@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+)
+
+// ExperimentAssignment is the model entity for the ExperimentAssignment schema.
+type ExperimentAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// ExperimentID holds the value of the "experiment_id" field.
+	ExperimentID string `json:"experiment_id,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// VariantKey holds the value of the "variant_key" field.
+	VariantKey string `json:"variant_key,omitempty"`
+	// Plan of the variant at the time of the assignment
+	PlanID string `json:"plan_id,omitempty"`
+	// Subscription created for the assignment
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// When the subscription of the assignment was first activated
+	ConvertedAt  *time.Time `json:"converted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExperimentAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case experimentassignment.FieldID, experimentassignment.FieldTenantID, experimentassignment.FieldStatus, experimentassignment.FieldCreatedBy, experimentassignment.FieldUpdatedBy, experimentassignment.FieldEnvironmentID, experimentassignment.FieldExperimentID, experimentassignment.FieldCustomerID, experimentassignment.FieldVariantKey, experimentassignment.FieldPlanID, experimentassignment.FieldSubscriptionID:
+			values[i] = new(sql.NullString)
+		case experimentassignment.FieldCreatedAt, experimentassignment.FieldUpdatedAt, experimentassignment.FieldConvertedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExperimentAssignment fields.
+func (ea *ExperimentAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case experimentassignment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ea.ID = value.String
+			}
+		case experimentassignment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ea.TenantID = value.String
+			}
+		case experimentassignment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ea.Status = value.String
+			}
+		case experimentassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ea.CreatedAt = value.Time
+			}
+		case experimentassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ea.UpdatedAt = value.Time
+			}
+		case experimentassignment.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ea.CreatedBy = value.String
+			}
+		case experimentassignment.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ea.UpdatedBy = value.String
+			}
+		case experimentassignment.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ea.EnvironmentID = value.String
+			}
+		case experimentassignment.FieldExperimentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field experiment_id", values[i])
+			} else if value.Valid {
+				ea.ExperimentID = value.String
+			}
+		case experimentassignment.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				ea.CustomerID = value.String
+			}
+		case experimentassignment.FieldVariantKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_key", values[i])
+			} else if value.Valid {
+				ea.VariantKey = value.String
+			}
+		case experimentassignment.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				ea.PlanID = value.String
+			}
+		case experimentassignment.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				ea.SubscriptionID = new(string)
+				*ea.SubscriptionID = value.String
+			}
+		case experimentassignment.FieldConvertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field converted_at", values[i])
+			} else if value.Valid {
+				ea.ConvertedAt = new(time.Time)
+				*ea.ConvertedAt = value.Time
+			}
+		default:
+			ea.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExperimentAssignment.
+// This includes values selected through modifiers, order, etc.
+func (ea *ExperimentAssignment) Value(name string) (ent.Value, error) {
+	return ea.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExperimentAssignment.
+// Note that you need to call ExperimentAssignment.Unwrap() before calling this method if this ExperimentAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ea *ExperimentAssignment) Update() *ExperimentAssignmentUpdateOne {
+	return NewExperimentAssignmentClient(ea.config).UpdateOne(ea)
+}
+
+// Unwrap unwraps the ExperimentAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ea *ExperimentAssignment) Unwrap() *ExperimentAssignment {
+	_tx, ok := ea.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExperimentAssignment is not a transactional entity")
+	}
+	ea.config.driver = _tx.drv
+	return ea
+}
+
+// String implements the fmt.Stringer.
+func (ea *ExperimentAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExperimentAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ea.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(ea.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ea.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ea.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ea.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ea.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ea.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(ea.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("experiment_id=")
+	builder.WriteString(ea.ExperimentID)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(ea.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("variant_key=")
+	builder.WriteString(ea.VariantKey)
+	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(ea.PlanID)
+	builder.WriteString(", ")
+	if v := ea.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ea.ConvertedAt; v != nil {
+		builder.WriteString("converted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExperimentAssignments is a parsable slice of ExperimentAssignment.
+type ExperimentAssignments []*ExperimentAssignment
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package experimentassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// ExperimentID applies equality check predicate on the "experiment_id" field. It's identical to ExperimentIDEQ.
+func ExperimentID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldExperimentID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// VariantKey applies equality check predicate on the "variant_key" field. It's identical to VariantKeyEQ.
+func VariantKey(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldVariantKey, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldPlanID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// ConvertedAt applies equality check predicate on the "converted_at" field. It's identical to ConvertedAtEQ.
+func ConvertedAt(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldConvertedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// ExperimentIDEQ applies the EQ predicate on the "experiment_id" field.
+func ExperimentIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldExperimentID, v))
+}
+
+// ExperimentIDNEQ applies the NEQ predicate on the "experiment_id" field.
+func ExperimentIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldExperimentID, v))
+}
+
+// ExperimentIDIn applies the In predicate on the "experiment_id" field.
+func ExperimentIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldExperimentID, vs...))
+}
+
+// ExperimentIDNotIn applies the NotIn predicate on the "experiment_id" field.
+func ExperimentIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldExperimentID, vs...))
+}
+
+// ExperimentIDGT applies the GT predicate on the "experiment_id" field.
+func ExperimentIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldExperimentID, v))
+}
+
+// ExperimentIDGTE applies the GTE predicate on the "experiment_id" field.
+func ExperimentIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldExperimentID, v))
+}
+
+// ExperimentIDLT applies the LT predicate on the "experiment_id" field.
+func ExperimentIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldExperimentID, v))
+}
+
+// ExperimentIDLTE applies the LTE predicate on the "experiment_id" field.
+func ExperimentIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldExperimentID, v))
+}
+
+// ExperimentIDContains applies the Contains predicate on the "experiment_id" field.
+func ExperimentIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldExperimentID, v))
+}
+
+// ExperimentIDHasPrefix applies the HasPrefix predicate on the "experiment_id" field.
+func ExperimentIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldExperimentID, v))
+}
+
+// ExperimentIDHasSuffix applies the HasSuffix predicate on the "experiment_id" field.
+func ExperimentIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldExperimentID, v))
+}
+
+// ExperimentIDEqualFold applies the EqualFold predicate on the "experiment_id" field.
+func ExperimentIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldExperimentID, v))
+}
+
+// ExperimentIDContainsFold applies the ContainsFold predicate on the "experiment_id" field.
+func ExperimentIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldExperimentID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// VariantKeyEQ applies the EQ predicate on the "variant_key" field.
+func VariantKeyEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldVariantKey, v))
+}
+
+// VariantKeyNEQ applies the NEQ predicate on the "variant_key" field.
+func VariantKeyNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldVariantKey, v))
+}
+
+// VariantKeyIn applies the In predicate on the "variant_key" field.
+func VariantKeyIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldVariantKey, vs...))
+}
+
+// VariantKeyNotIn applies the NotIn predicate on the "variant_key" field.
+func VariantKeyNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldVariantKey, vs...))
+}
+
+// VariantKeyGT applies the GT predicate on the "variant_key" field.
+func VariantKeyGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldVariantKey, v))
+}
+
+// VariantKeyGTE applies the GTE predicate on the "variant_key" field.
+func VariantKeyGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldVariantKey, v))
+}
+
+// VariantKeyLT applies the LT predicate on the "variant_key" field.
+func VariantKeyLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldVariantKey, v))
+}
+
+// VariantKeyLTE applies the LTE predicate on the "variant_key" field.
+func VariantKeyLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldVariantKey, v))
+}
+
+// VariantKeyContains applies the Contains predicate on the "variant_key" field.
+func VariantKeyContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldVariantKey, v))
+}
+
+// VariantKeyHasPrefix applies the HasPrefix predicate on the "variant_key" field.
+func VariantKeyHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldVariantKey, v))
+}
+
+// VariantKeyHasSuffix applies the HasSuffix predicate on the "variant_key" field.
+func VariantKeyHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldVariantKey, v))
+}
+
+// VariantKeyEqualFold applies the EqualFold predicate on the "variant_key" field.
+func VariantKeyEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldVariantKey, v))
+}
+
+// VariantKeyContainsFold applies the ContainsFold predicate on the "variant_key" field.
+func VariantKeyContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldVariantKey, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldPlanID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// ConvertedAtEQ applies the EQ predicate on the "converted_at" field.
+func ConvertedAtEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldEQ(FieldConvertedAt, v))
+}
+
+// ConvertedAtNEQ applies the NEQ predicate on the "converted_at" field.
+func ConvertedAtNEQ(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNEQ(FieldConvertedAt, v))
+}
+
+// ConvertedAtIn applies the In predicate on the "converted_at" field.
+func ConvertedAtIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIn(FieldConvertedAt, vs...))
+}
+
+// ConvertedAtNotIn applies the NotIn predicate on the "converted_at" field.
+func ConvertedAtNotIn(vs ...time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotIn(FieldConvertedAt, vs...))
+}
+
+// ConvertedAtGT applies the GT predicate on the "converted_at" field.
+func ConvertedAtGT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGT(FieldConvertedAt, v))
+}
+
+// ConvertedAtGTE applies the GTE predicate on the "converted_at" field.
+func ConvertedAtGTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldGTE(FieldConvertedAt, v))
+}
+
+// ConvertedAtLT applies the LT predicate on the "converted_at" field.
+func ConvertedAtLT(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLT(FieldConvertedAt, v))
+}
+
+// ConvertedAtLTE applies the LTE predicate on the "converted_at" field.
+func ConvertedAtLTE(v time.Time) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldLTE(FieldConvertedAt, v))
+}
+
+// ConvertedAtIsNil applies the IsNil predicate on the "converted_at" field.
+func ConvertedAtIsNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldIsNull(FieldConvertedAt))
+}
+
+// ConvertedAtNotNil applies the NotNil predicate on the "converted_at" field.
+func ConvertedAtNotNil() predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.FieldNotNull(FieldConvertedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExperimentAssignment) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExperimentAssignment) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExperimentAssignment) predicate.ExperimentAssignment {
+	return predicate.ExperimentAssignment(sql.NotPredicates(p))
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+)
+
+// ExperimentAssignmentCreate is the builder for creating a ExperimentAssignment entity.
+type ExperimentAssignmentCreate struct {
+	config
+	mutation *ExperimentAssignmentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eac *ExperimentAssignmentCreate) SetTenantID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetTenantID(s)
+	return eac
+}
+
+// SetStatus sets the "status" field.
+func (eac *ExperimentAssignmentCreate) SetStatus(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetStatus(s)
+	return eac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableStatus(s *string) *ExperimentAssignmentCreate {
+	if s != nil {
+		eac.SetStatus(*s)
+	}
+	return eac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eac *ExperimentAssignmentCreate) SetCreatedAt(t time.Time) *ExperimentAssignmentCreate {
+	eac.mutation.SetCreatedAt(t)
+	return eac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableCreatedAt(t *time.Time) *ExperimentAssignmentCreate {
+	if t != nil {
+		eac.SetCreatedAt(*t)
+	}
+	return eac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eac *ExperimentAssignmentCreate) SetUpdatedAt(t time.Time) *ExperimentAssignmentCreate {
+	eac.mutation.SetUpdatedAt(t)
+	return eac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableUpdatedAt(t *time.Time) *ExperimentAssignmentCreate {
+	if t != nil {
+		eac.SetUpdatedAt(*t)
+	}
+	return eac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (eac *ExperimentAssignmentCreate) SetCreatedBy(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetCreatedBy(s)
+	return eac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableCreatedBy(s *string) *ExperimentAssignmentCreate {
+	if s != nil {
+		eac.SetCreatedBy(*s)
+	}
+	return eac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (eac *ExperimentAssignmentCreate) SetUpdatedBy(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetUpdatedBy(s)
+	return eac
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableUpdatedBy(s *string) *ExperimentAssignmentCreate {
+	if s != nil {
+		eac.SetUpdatedBy(*s)
+	}
+	return eac
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (eac *ExperimentAssignmentCreate) SetEnvironmentID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetEnvironmentID(s)
+	return eac
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableEnvironmentID(s *string) *ExperimentAssignmentCreate {
+	if s != nil {
+		eac.SetEnvironmentID(*s)
+	}
+	return eac
+}
+
+// SetExperimentID sets the "experiment_id" field.
+func (eac *ExperimentAssignmentCreate) SetExperimentID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetExperimentID(s)
+	return eac
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (eac *ExperimentAssignmentCreate) SetCustomerID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetCustomerID(s)
+	return eac
+}
+
+// SetVariantKey sets the "variant_key" field.
+func (eac *ExperimentAssignmentCreate) SetVariantKey(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetVariantKey(s)
+	return eac
+}
+
+// SetPlanID sets the "plan_id" field.
+func (eac *ExperimentAssignmentCreate) SetPlanID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetPlanID(s)
+	return eac
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (eac *ExperimentAssignmentCreate) SetSubscriptionID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetSubscriptionID(s)
+	return eac
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableSubscriptionID(s *string) *ExperimentAssignmentCreate {
+	if s != nil {
+		eac.SetSubscriptionID(*s)
+	}
+	return eac
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (eac *ExperimentAssignmentCreate) SetConvertedAt(t time.Time) *ExperimentAssignmentCreate {
+	eac.mutation.SetConvertedAt(t)
+	return eac
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (eac *ExperimentAssignmentCreate) SetNillableConvertedAt(t *time.Time) *ExperimentAssignmentCreate {
+	if t != nil {
+		eac.SetConvertedAt(*t)
+	}
+	return eac
+}
+
+// SetID sets the "id" field.
+func (eac *ExperimentAssignmentCreate) SetID(s string) *ExperimentAssignmentCreate {
+	eac.mutation.SetID(s)
+	return eac
+}
+
+// Mutation returns the ExperimentAssignmentMutation object of the builder.
+func (eac *ExperimentAssignmentCreate) Mutation() *ExperimentAssignmentMutation {
+	return eac.mutation
+}
+
+// Save creates the ExperimentAssignment in the database.
+func (eac *ExperimentAssignmentCreate) Save(ctx context.Context) (*ExperimentAssignment, error) {
+	eac.defaults()
+	return withHooks(ctx, eac.sqlSave, eac.mutation, eac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eac *ExperimentAssignmentCreate) SaveX(ctx context.Context) *ExperimentAssignment {
+	v, err := eac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eac *ExperimentAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := eac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eac *ExperimentAssignmentCreate) ExecX(ctx context.Context) {
+	if err := eac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eac *ExperimentAssignmentCreate) defaults() {
+	if _, ok := eac.mutation.Status(); !ok {
+		v := experimentassignment.DefaultStatus
+		eac.mutation.SetStatus(v)
+	}
+	if _, ok := eac.mutation.CreatedAt(); !ok {
+		v := experimentassignment.DefaultCreatedAt()
+		eac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := eac.mutation.UpdatedAt(); !ok {
+		v := experimentassignment.DefaultUpdatedAt()
+		eac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := eac.mutation.EnvironmentID(); !ok {
+		v := experimentassignment.DefaultEnvironmentID
+		eac.mutation.SetEnvironmentID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eac *ExperimentAssignmentCreate) check() error {
+	if _, ok := eac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ExperimentAssignment.tenant_id"`)}
+	}
+	if v, ok := eac.mutation.TenantID(); ok {
+		if err := experimentassignment.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ExperimentAssignment.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := eac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExperimentAssignment.status"`)}
+	}
+	if _, ok := eac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExperimentAssignment.created_at"`)}
+	}
+	if _, ok := eac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExperimentAssignment.updated_at"`)}
+	}
+	if _, ok := eac.mutation.ExperimentID(); !ok {
+		return &ValidationError{Name: "experiment_id", err: errors.New(`ent: missing required field "ExperimentAssignment.experiment_id"`)}
+	}
+	if v, ok := eac.mutation.ExperimentID(); ok {
+		if err := experimentassignment.ExperimentIDValidator(v); err != nil {
+			return &ValidationError{Name: "experiment_id", err: fmt.Errorf(`ent: validator failed for field "ExperimentAssignment.experiment_id": %w`, err)}
+		}
+	}
+	if _, ok := eac.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "ExperimentAssignment.customer_id"`)}
+	}
+	if v, ok := eac.mutation.CustomerID(); ok {
+		if err := experimentassignment.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "ExperimentAssignment.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := eac.mutation.VariantKey(); !ok {
+		return &ValidationError{Name: "variant_key", err: errors.New(`ent: missing required field "ExperimentAssignment.variant_key"`)}
+	}
+	if v, ok := eac.mutation.VariantKey(); ok {
+		if err := experimentassignment.VariantKeyValidator(v); err != nil {
+			return &ValidationError{Name: "variant_key", err: fmt.Errorf(`ent: validator failed for field "ExperimentAssignment.variant_key": %w`, err)}
+		}
+	}
+	if _, ok := eac.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "ExperimentAssignment.plan_id"`)}
+	}
+	if v, ok := eac.mutation.PlanID(); ok {
+		if err := experimentassignment.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "ExperimentAssignment.plan_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eac *ExperimentAssignmentCreate) sqlSave(ctx context.Context) (*ExperimentAssignment, error) {
+	if err := eac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ExperimentAssignment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	eac.mutation.id = &_node.ID
+	eac.mutation.done = true
+	return _node, nil
+}
+
+func (eac *ExperimentAssignmentCreate) createSpec() (*ExperimentAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExperimentAssignment{config: eac.config}
+		_spec = sqlgraph.NewCreateSpec(experimentassignment.Table, sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeString))
+	)
+	if id, ok := eac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := eac.mutation.TenantID(); ok {
+		_spec.SetField(experimentassignment.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := eac.mutation.Status(); ok {
+		_spec.SetField(experimentassignment.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := eac.mutation.CreatedAt(); ok {
+		_spec.SetField(experimentassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := eac.mutation.UpdatedAt(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := eac.mutation.CreatedBy(); ok {
+		_spec.SetField(experimentassignment.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := eac.mutation.UpdatedBy(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := eac.mutation.EnvironmentID(); ok {
+		_spec.SetField(experimentassignment.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := eac.mutation.ExperimentID(); ok {
+		_spec.SetField(experimentassignment.FieldExperimentID, field.TypeString, value)
+		_node.ExperimentID = value
+	}
+	if value, ok := eac.mutation.CustomerID(); ok {
+		_spec.SetField(experimentassignment.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := eac.mutation.VariantKey(); ok {
+		_spec.SetField(experimentassignment.FieldVariantKey, field.TypeString, value)
+		_node.VariantKey = value
+	}
+	if value, ok := eac.mutation.PlanID(); ok {
+		_spec.SetField(experimentassignment.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
+	if value, ok := eac.mutation.SubscriptionID(); ok {
+		_spec.SetField(experimentassignment.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := eac.mutation.ConvertedAt(); ok {
+		_spec.SetField(experimentassignment.FieldConvertedAt, field.TypeTime, value)
+		_node.ConvertedAt = &value
+	}
+	return _node, _spec
+}
+
+// ExperimentAssignmentCreateBulk is the builder for creating many ExperimentAssignment entities in bulk.
+type ExperimentAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*ExperimentAssignmentCreate
+}
+
+// Save creates the ExperimentAssignment entities in the database.
+func (eacb *ExperimentAssignmentCreateBulk) Save(ctx context.Context) ([]*ExperimentAssignment, error) {
+	if eacb.err != nil {
+		return nil, eacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eacb.builders))
+	nodes := make([]*ExperimentAssignment, len(eacb.builders))
+	mutators := make([]Mutator, len(eacb.builders))
+	for i := range eacb.builders {
+		func(i int, root context.Context) {
+			builder := eacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExperimentAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eacb *ExperimentAssignmentCreateBulk) SaveX(ctx context.Context) []*ExperimentAssignment {
+	v, err := eacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eacb *ExperimentAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := eacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eacb *ExperimentAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := eacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
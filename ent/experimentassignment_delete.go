@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ExperimentAssignmentDelete is the builder for deleting a ExperimentAssignment entity.
+type ExperimentAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExperimentAssignmentMutation
+}
+
+// Where appends a list predicates to the ExperimentAssignmentDelete builder.
+func (ead *ExperimentAssignmentDelete) Where(ps ...predicate.ExperimentAssignment) *ExperimentAssignmentDelete {
+	ead.mutation.Where(ps...)
+	return ead
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ead *ExperimentAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ead.sqlExec, ead.mutation, ead.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ead *ExperimentAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := ead.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ead *ExperimentAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(experimentassignment.Table, sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeString))
+	if ps := ead.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ead.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ead.mutation.done = true
+	return affected, err
+}
+
+// ExperimentAssignmentDeleteOne is the builder for deleting a single ExperimentAssignment entity.
+type ExperimentAssignmentDeleteOne struct {
+	ead *ExperimentAssignmentDelete
+}
+
+// Where appends a list predicates to the ExperimentAssignmentDelete builder.
+func (eado *ExperimentAssignmentDeleteOne) Where(ps ...predicate.ExperimentAssignment) *ExperimentAssignmentDeleteOne {
+	eado.ead.mutation.Where(ps...)
+	return eado
+}
+
+// Exec executes the deletion query.
+func (eado *ExperimentAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := eado.ead.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{experimentassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eado *ExperimentAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := eado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ExperimentAssignmentQuery is the builder for querying ExperimentAssignment entities.
+type ExperimentAssignmentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []experimentassignment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ExperimentAssignment
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExperimentAssignmentQuery builder.
+func (eaq *ExperimentAssignmentQuery) Where(ps ...predicate.ExperimentAssignment) *ExperimentAssignmentQuery {
+	eaq.predicates = append(eaq.predicates, ps...)
+	return eaq
+}
+
+// Limit the number of records to be returned by this query.
+func (eaq *ExperimentAssignmentQuery) Limit(limit int) *ExperimentAssignmentQuery {
+	eaq.ctx.Limit = &limit
+	return eaq
+}
+
+// Offset to start from.
+func (eaq *ExperimentAssignmentQuery) Offset(offset int) *ExperimentAssignmentQuery {
+	eaq.ctx.Offset = &offset
+	return eaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eaq *ExperimentAssignmentQuery) Unique(unique bool) *ExperimentAssignmentQuery {
+	eaq.ctx.Unique = &unique
+	return eaq
+}
+
+// Order specifies how the records should be ordered.
+func (eaq *ExperimentAssignmentQuery) Order(o ...experimentassignment.OrderOption) *ExperimentAssignmentQuery {
+	eaq.order = append(eaq.order, o...)
+	return eaq
+}
+
+// First returns the first ExperimentAssignment entity from the query.
+// Returns a *NotFoundError when no ExperimentAssignment was found.
+func (eaq *ExperimentAssignmentQuery) First(ctx context.Context) (*ExperimentAssignment, error) {
+	nodes, err := eaq.Limit(1).All(setContextOp(ctx, eaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{experimentassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) FirstX(ctx context.Context) *ExperimentAssignment {
+	node, err := eaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExperimentAssignment ID from the query.
+// Returns a *NotFoundError when no ExperimentAssignment ID was found.
+func (eaq *ExperimentAssignmentQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eaq.Limit(1).IDs(setContextOp(ctx, eaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{experimentassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) FirstIDX(ctx context.Context) string {
+	id, err := eaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExperimentAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExperimentAssignment entity is found.
+// Returns a *NotFoundError when no ExperimentAssignment entities are found.
+func (eaq *ExperimentAssignmentQuery) Only(ctx context.Context) (*ExperimentAssignment, error) {
+	nodes, err := eaq.Limit(2).All(setContextOp(ctx, eaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{experimentassignment.Label}
+	default:
+		return nil, &NotSingularError{experimentassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) OnlyX(ctx context.Context) *ExperimentAssignment {
+	node, err := eaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExperimentAssignment ID in the query.
+// Returns a *NotSingularError when more than one ExperimentAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eaq *ExperimentAssignmentQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eaq.Limit(2).IDs(setContextOp(ctx, eaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{experimentassignment.Label}
+	default:
+		err = &NotSingularError{experimentassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) OnlyIDX(ctx context.Context) string {
+	id, err := eaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExperimentAssignments.
+func (eaq *ExperimentAssignmentQuery) All(ctx context.Context) ([]*ExperimentAssignment, error) {
+	ctx = setContextOp(ctx, eaq.ctx, ent.OpQueryAll)
+	if err := eaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExperimentAssignment, *ExperimentAssignmentQuery]()
+	return withInterceptors[[]*ExperimentAssignment](ctx, eaq, qr, eaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) AllX(ctx context.Context) []*ExperimentAssignment {
+	nodes, err := eaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExperimentAssignment IDs.
+func (eaq *ExperimentAssignmentQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if eaq.ctx.Unique == nil && eaq.path != nil {
+		eaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eaq.ctx, ent.OpQueryIDs)
+	if err = eaq.Select(experimentassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) IDsX(ctx context.Context) []string {
+	ids, err := eaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eaq *ExperimentAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eaq.ctx, ent.OpQueryCount)
+	if err := eaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eaq, querierCount[*ExperimentAssignmentQuery](), eaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := eaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eaq *ExperimentAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eaq.ctx, ent.OpQueryExist)
+	switch _, err := eaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eaq *ExperimentAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := eaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExperimentAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eaq *ExperimentAssignmentQuery) Clone() *ExperimentAssignmentQuery {
+	if eaq == nil {
+		return nil
+	}
+	return &ExperimentAssignmentQuery{
+		config:     eaq.config,
+		ctx:        eaq.ctx.Clone(),
+		order:      append([]experimentassignment.OrderOption{}, eaq.order...),
+		inters:     append([]Interceptor{}, eaq.inters...),
+		predicates: append([]predicate.ExperimentAssignment{}, eaq.predicates...),
+		// clone intermediate query.
+		sql:  eaq.sql.Clone(),
+		path: eaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExperimentAssignment.Query().
+//		GroupBy(experimentassignment.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eaq *ExperimentAssignmentQuery) GroupBy(field string, fields ...string) *ExperimentAssignmentGroupBy {
+	eaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExperimentAssignmentGroupBy{build: eaq}
+	grbuild.flds = &eaq.ctx.Fields
+	grbuild.label = experimentassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ExperimentAssignment.Query().
+//		Select(experimentassignment.FieldTenantID).
+//		Scan(ctx, &v)
+func (eaq *ExperimentAssignmentQuery) Select(fields ...string) *ExperimentAssignmentSelect {
+	eaq.ctx.Fields = append(eaq.ctx.Fields, fields...)
+	sbuild := &ExperimentAssignmentSelect{ExperimentAssignmentQuery: eaq}
+	sbuild.label = experimentassignment.Label
+	sbuild.flds, sbuild.scan = &eaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExperimentAssignmentSelect configured with the given aggregations.
+func (eaq *ExperimentAssignmentQuery) Aggregate(fns ...AggregateFunc) *ExperimentAssignmentSelect {
+	return eaq.Select().Aggregate(fns...)
+}
+
+func (eaq *ExperimentAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eaq.ctx.Fields {
+		if !experimentassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eaq.path != nil {
+		prev, err := eaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eaq.sql = prev
+	}
+	return nil
+}
+
+func (eaq *ExperimentAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExperimentAssignment, error) {
+	var (
+		nodes = []*ExperimentAssignment{}
+		_spec = eaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExperimentAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExperimentAssignment{config: eaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (eaq *ExperimentAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eaq.querySpec()
+	_spec.Node.Columns = eaq.ctx.Fields
+	if len(eaq.ctx.Fields) > 0 {
+		_spec.Unique = eaq.ctx.Unique != nil && *eaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eaq.driver, _spec)
+}
+
+func (eaq *ExperimentAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(experimentassignment.Table, experimentassignment.Columns, sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeString))
+	_spec.From = eaq.sql
+	if unique := eaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, experimentassignment.FieldID)
+		for i := range fields {
+			if fields[i] != experimentassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eaq *ExperimentAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eaq.driver.Dialect())
+	t1 := builder.Table(experimentassignment.Table)
+	columns := eaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = experimentassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eaq.sql != nil {
+		selector = eaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eaq.ctx.Unique != nil && *eaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eaq.predicates {
+		p(selector)
+	}
+	for _, p := range eaq.order {
+		p(selector)
+	}
+	if offset := eaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExperimentAssignmentGroupBy is the group-by builder for ExperimentAssignment entities.
+type ExperimentAssignmentGroupBy struct {
+	selector
+	build *ExperimentAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eagb *ExperimentAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *ExperimentAssignmentGroupBy {
+	eagb.fns = append(eagb.fns, fns...)
+	return eagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eagb *ExperimentAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eagb.build.ctx, ent.OpQueryGroupBy)
+	if err := eagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExperimentAssignmentQuery, *ExperimentAssignmentGroupBy](ctx, eagb.build, eagb, eagb.build.inters, v)
+}
+
+func (eagb *ExperimentAssignmentGroupBy) sqlScan(ctx context.Context, root *ExperimentAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eagb.fns))
+	for _, fn := range eagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eagb.flds)+len(eagb.fns))
+		for _, f := range *eagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExperimentAssignmentSelect is the builder for selecting fields of ExperimentAssignment entities.
+type ExperimentAssignmentSelect struct {
+	*ExperimentAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eas *ExperimentAssignmentSelect) Aggregate(fns ...AggregateFunc) *ExperimentAssignmentSelect {
+	eas.fns = append(eas.fns, fns...)
+	return eas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eas *ExperimentAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eas.ctx, ent.OpQuerySelect)
+	if err := eas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExperimentAssignmentQuery, *ExperimentAssignmentSelect](ctx, eas.ExperimentAssignmentQuery, eas, eas.inters, v)
+}
+
+func (eas *ExperimentAssignmentSelect) sqlScan(ctx context.Context, root *ExperimentAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eas.fns))
+	for _, fn := range eas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+	"github.com/flexprice/flexprice/ent/predicate"
+)
+
+// ExperimentAssignmentUpdate is the builder for updating ExperimentAssignment entities.
+type ExperimentAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExperimentAssignmentMutation
+}
+
+// Where appends a list predicates to the ExperimentAssignmentUpdate builder.
+func (eau *ExperimentAssignmentUpdate) Where(ps ...predicate.ExperimentAssignment) *ExperimentAssignmentUpdate {
+	eau.mutation.Where(ps...)
+	return eau
+}
+
+// SetStatus sets the "status" field.
+func (eau *ExperimentAssignmentUpdate) SetStatus(s string) *ExperimentAssignmentUpdate {
+	eau.mutation.SetStatus(s)
+	return eau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eau *ExperimentAssignmentUpdate) SetNillableStatus(s *string) *ExperimentAssignmentUpdate {
+	if s != nil {
+		eau.SetStatus(*s)
+	}
+	return eau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eau *ExperimentAssignmentUpdate) SetUpdatedAt(t time.Time) *ExperimentAssignmentUpdate {
+	eau.mutation.SetUpdatedAt(t)
+	return eau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (eau *ExperimentAssignmentUpdate) SetUpdatedBy(s string) *ExperimentAssignmentUpdate {
+	eau.mutation.SetUpdatedBy(s)
+	return eau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (eau *ExperimentAssignmentUpdate) SetNillableUpdatedBy(s *string) *ExperimentAssignmentUpdate {
+	if s != nil {
+		eau.SetUpdatedBy(*s)
+	}
+	return eau
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (eau *ExperimentAssignmentUpdate) ClearUpdatedBy() *ExperimentAssignmentUpdate {
+	eau.mutation.ClearUpdatedBy()
+	return eau
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (eau *ExperimentAssignmentUpdate) SetSubscriptionID(s string) *ExperimentAssignmentUpdate {
+	eau.mutation.SetSubscriptionID(s)
+	return eau
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (eau *ExperimentAssignmentUpdate) SetNillableSubscriptionID(s *string) *ExperimentAssignmentUpdate {
+	if s != nil {
+		eau.SetSubscriptionID(*s)
+	}
+	return eau
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (eau *ExperimentAssignmentUpdate) ClearSubscriptionID() *ExperimentAssignmentUpdate {
+	eau.mutation.ClearSubscriptionID()
+	return eau
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (eau *ExperimentAssignmentUpdate) SetConvertedAt(t time.Time) *ExperimentAssignmentUpdate {
+	eau.mutation.SetConvertedAt(t)
+	return eau
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (eau *ExperimentAssignmentUpdate) SetNillableConvertedAt(t *time.Time) *ExperimentAssignmentUpdate {
+	if t != nil {
+		eau.SetConvertedAt(*t)
+	}
+	return eau
+}
+
+// ClearConvertedAt clears the value of the "converted_at" field.
+func (eau *ExperimentAssignmentUpdate) ClearConvertedAt() *ExperimentAssignmentUpdate {
+	eau.mutation.ClearConvertedAt()
+	return eau
+}
+
+// Mutation returns the ExperimentAssignmentMutation object of the builder.
+func (eau *ExperimentAssignmentUpdate) Mutation() *ExperimentAssignmentMutation {
+	return eau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eau *ExperimentAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	eau.defaults()
+	return withHooks(ctx, eau.sqlSave, eau.mutation, eau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eau *ExperimentAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := eau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eau *ExperimentAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := eau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eau *ExperimentAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := eau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eau *ExperimentAssignmentUpdate) defaults() {
+	if _, ok := eau.mutation.UpdatedAt(); !ok {
+		v := experimentassignment.UpdateDefaultUpdatedAt()
+		eau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (eau *ExperimentAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(experimentassignment.Table, experimentassignment.Columns, sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeString))
+	if ps := eau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eau.mutation.Status(); ok {
+		_spec.SetField(experimentassignment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := eau.mutation.UpdatedAt(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eau.mutation.CreatedByCleared() {
+		_spec.ClearField(experimentassignment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := eau.mutation.UpdatedBy(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if eau.mutation.UpdatedByCleared() {
+		_spec.ClearField(experimentassignment.FieldUpdatedBy, field.TypeString)
+	}
+	if eau.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(experimentassignment.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := eau.mutation.SubscriptionID(); ok {
+		_spec.SetField(experimentassignment.FieldSubscriptionID, field.TypeString, value)
+	}
+	if eau.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(experimentassignment.FieldSubscriptionID, field.TypeString)
+	}
+	if value, ok := eau.mutation.ConvertedAt(); ok {
+		_spec.SetField(experimentassignment.FieldConvertedAt, field.TypeTime, value)
+	}
+	if eau.mutation.ConvertedAtCleared() {
+		_spec.ClearField(experimentassignment.FieldConvertedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experimentassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eau.mutation.done = true
+	return n, nil
+}
+
+// ExperimentAssignmentUpdateOne is the builder for updating a single ExperimentAssignment entity.
+type ExperimentAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExperimentAssignmentMutation
+}
+
+// SetStatus sets the "status" field.
+func (eauo *ExperimentAssignmentUpdateOne) SetStatus(s string) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.SetStatus(s)
+	return eauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eauo *ExperimentAssignmentUpdateOne) SetNillableStatus(s *string) *ExperimentAssignmentUpdateOne {
+	if s != nil {
+		eauo.SetStatus(*s)
+	}
+	return eauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eauo *ExperimentAssignmentUpdateOne) SetUpdatedAt(t time.Time) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.SetUpdatedAt(t)
+	return eauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (eauo *ExperimentAssignmentUpdateOne) SetUpdatedBy(s string) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.SetUpdatedBy(s)
+	return eauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (eauo *ExperimentAssignmentUpdateOne) SetNillableUpdatedBy(s *string) *ExperimentAssignmentUpdateOne {
+	if s != nil {
+		eauo.SetUpdatedBy(*s)
+	}
+	return eauo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (eauo *ExperimentAssignmentUpdateOne) ClearUpdatedBy() *ExperimentAssignmentUpdateOne {
+	eauo.mutation.ClearUpdatedBy()
+	return eauo
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (eauo *ExperimentAssignmentUpdateOne) SetSubscriptionID(s string) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.SetSubscriptionID(s)
+	return eauo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (eauo *ExperimentAssignmentUpdateOne) SetNillableSubscriptionID(s *string) *ExperimentAssignmentUpdateOne {
+	if s != nil {
+		eauo.SetSubscriptionID(*s)
+	}
+	return eauo
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (eauo *ExperimentAssignmentUpdateOne) ClearSubscriptionID() *ExperimentAssignmentUpdateOne {
+	eauo.mutation.ClearSubscriptionID()
+	return eauo
+}
+
+// SetConvertedAt sets the "converted_at" field.
+func (eauo *ExperimentAssignmentUpdateOne) SetConvertedAt(t time.Time) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.SetConvertedAt(t)
+	return eauo
+}
+
+// SetNillableConvertedAt sets the "converted_at" field if the given value is not nil.
+func (eauo *ExperimentAssignmentUpdateOne) SetNillableConvertedAt(t *time.Time) *ExperimentAssignmentUpdateOne {
+	if t != nil {
+		eauo.SetConvertedAt(*t)
+	}
+	return eauo
+}
+
+// ClearConvertedAt clears the value of the "converted_at" field.
+func (eauo *ExperimentAssignmentUpdateOne) ClearConvertedAt() *ExperimentAssignmentUpdateOne {
+	eauo.mutation.ClearConvertedAt()
+	return eauo
+}
+
+// Mutation returns the ExperimentAssignmentMutation object of the builder.
+func (eauo *ExperimentAssignmentUpdateOne) Mutation() *ExperimentAssignmentMutation {
+	return eauo.mutation
+}
+
+// Where appends a list predicates to the ExperimentAssignmentUpdate builder.
+func (eauo *ExperimentAssignmentUpdateOne) Where(ps ...predicate.ExperimentAssignment) *ExperimentAssignmentUpdateOne {
+	eauo.mutation.Where(ps...)
+	return eauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eauo *ExperimentAssignmentUpdateOne) Select(field string, fields ...string) *ExperimentAssignmentUpdateOne {
+	eauo.fields = append([]string{field}, fields...)
+	return eauo
+}
+
+// Save executes the query and returns the updated ExperimentAssignment entity.
+func (eauo *ExperimentAssignmentUpdateOne) Save(ctx context.Context) (*ExperimentAssignment, error) {
+	eauo.defaults()
+	return withHooks(ctx, eauo.sqlSave, eauo.mutation, eauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eauo *ExperimentAssignmentUpdateOne) SaveX(ctx context.Context) *ExperimentAssignment {
+	node, err := eauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eauo *ExperimentAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := eauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eauo *ExperimentAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := eauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eauo *ExperimentAssignmentUpdateOne) defaults() {
+	if _, ok := eauo.mutation.UpdatedAt(); !ok {
+		v := experimentassignment.UpdateDefaultUpdatedAt()
+		eauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (eauo *ExperimentAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *ExperimentAssignment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(experimentassignment.Table, experimentassignment.Columns, sqlgraph.NewFieldSpec(experimentassignment.FieldID, field.TypeString))
+	id, ok := eauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExperimentAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, experimentassignment.FieldID)
+		for _, f := range fields {
+			if !experimentassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != experimentassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eauo.mutation.Status(); ok {
+		_spec.SetField(experimentassignment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := eauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eauo.mutation.CreatedByCleared() {
+		_spec.ClearField(experimentassignment.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := eauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(experimentassignment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if eauo.mutation.UpdatedByCleared() {
+		_spec.ClearField(experimentassignment.FieldUpdatedBy, field.TypeString)
+	}
+	if eauo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(experimentassignment.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := eauo.mutation.SubscriptionID(); ok {
+		_spec.SetField(experimentassignment.FieldSubscriptionID, field.TypeString, value)
+	}
+	if eauo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(experimentassignment.FieldSubscriptionID, field.TypeString)
+	}
+	if value, ok := eauo.mutation.ConvertedAt(); ok {
+		_spec.SetField(experimentassignment.FieldConvertedAt, field.TypeTime, value)
+	}
+	if eauo.mutation.ConvertedAtCleared() {
+		_spec.ClearField(experimentassignment.FieldConvertedAt, field.TypeTime)
+	}
+	_node = &ExperimentAssignment{config: eauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experimentassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eauo.mutation.done = true
+	return _node, nil
+}
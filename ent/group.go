@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/group"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // Group is the model entity for the Group schema.
@@ -39,7 +40,9 @@ type Group struct {
 	// EntityType holds the value of the "entity_type" field.
 	EntityType string `json:"entity_type,omitempty"`
 	// Idempotency key for group creation
-	LookupKey    string `json:"lookup_key,omitempty"`
+	LookupKey string `json:"lookup_key,omitempty"`
+	// Pooled tier ladder shared by all prices of the group
+	Pooling      *types.GroupPoolingConfig `json:"pooling,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -48,7 +51,7 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldMetadata:
+		case group.FieldMetadata, group.FieldPooling:
 			values[i] = new([]byte)
 		case group.FieldID, group.FieldTenantID, group.FieldStatus, group.FieldCreatedBy, group.FieldUpdatedBy, group.FieldEnvironmentID, group.FieldName, group.FieldEntityType, group.FieldLookupKey:
 			values[i] = new(sql.NullString)
@@ -143,6 +146,14 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gr.LookupKey = value.String
 			}
+		case group.FieldPooling:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pooling", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &gr.Pooling); err != nil {
+					return fmt.Errorf("unmarshal field pooling: %w", err)
+				}
+			}
 		default:
 			gr.selectValues.Set(columns[i], values[i])
 		}
@@ -211,6 +222,9 @@ func (gr *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("lookup_key=")
 	builder.WriteString(gr.LookupKey)
+	builder.WriteString(", ")
+	builder.WriteString("pooling=")
+	builder.WriteString(fmt.Sprintf("%v", gr.Pooling))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,8 @@ const (
 	FieldEntityType = "entity_type"
 	// FieldLookupKey holds the string denoting the lookup_key field in the database.
 	FieldLookupKey = "lookup_key"
+	// FieldPooling holds the string denoting the pooling field in the database.
+	FieldPooling = "pooling"
 	// Table holds the table name of the group in the database.
 	Table = "groups"
 )
@@ -53,6 +55,7 @@ var Columns = []string{
 	FieldName,
 	FieldEntityType,
 	FieldLookupKey,
+	FieldPooling,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -764,6 +764,16 @@ func LookupKeyContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldLookupKey, v))
 }
 
+// PoolingIsNil applies the IsNil predicate on the "pooling" field.
+func PoolingIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldPooling))
+}
+
+// PoolingNotNil applies the NotNil predicate on the "pooling" field.
+func PoolingNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldPooling))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/group"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // GroupCreate is the builder for creating a Group entity.
@@ -150,6 +151,12 @@ func (gc *GroupCreate) SetNillableLookupKey(s *string) *GroupCreate {
 	return gc
 }
 
+// SetPooling sets the "pooling" field.
+func (gc *GroupCreate) SetPooling(tpc *types.GroupPoolingConfig) *GroupCreate {
+	gc.mutation.SetPooling(tpc)
+	return gc
+}
+
 // SetID sets the "id" field.
 func (gc *GroupCreate) SetID(s string) *GroupCreate {
 	gc.mutation.SetID(s)
@@ -243,6 +250,11 @@ func (gc *GroupCreate) check() error {
 	if _, ok := gc.mutation.EntityType(); !ok {
 		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "Group.entity_type"`)}
 	}
+	if v, ok := gc.mutation.Pooling(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pooling", err: fmt.Errorf(`ent: validator failed for field "Group.pooling": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -322,6 +334,10 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldLookupKey, field.TypeString, value)
 		_node.LookupKey = value
 	}
+	if value, ok := gc.mutation.Pooling(); ok {
+		_spec.SetField(group.FieldPooling, field.TypeJSON, value)
+		_node.Pooling = value
+	}
 	return _node, _spec
 }
 
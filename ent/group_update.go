@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // GroupUpdate is the builder for updating Group entities.
@@ -114,6 +115,18 @@ func (gu *GroupUpdate) ClearLookupKey() *GroupUpdate {
 	return gu
 }
 
+// SetPooling sets the "pooling" field.
+func (gu *GroupUpdate) SetPooling(tpc *types.GroupPoolingConfig) *GroupUpdate {
+	gu.mutation.SetPooling(tpc)
+	return gu
+}
+
+// ClearPooling clears the value of the "pooling" field.
+func (gu *GroupUpdate) ClearPooling() *GroupUpdate {
+	gu.mutation.ClearPooling()
+	return gu
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -162,6 +175,11 @@ func (gu *GroupUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
+	if v, ok := gu.mutation.Pooling(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pooling", err: fmt.Errorf(`ent: validator failed for field "Group.pooling": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -210,6 +228,12 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if gu.mutation.LookupKeyCleared() {
 		_spec.ClearField(group.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := gu.mutation.Pooling(); ok {
+		_spec.SetField(group.FieldPooling, field.TypeJSON, value)
+	}
+	if gu.mutation.PoolingCleared() {
+		_spec.ClearField(group.FieldPooling, field.TypeJSON)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
@@ -316,6 +340,18 @@ func (guo *GroupUpdateOne) ClearLookupKey() *GroupUpdateOne {
 	return guo
 }
 
+// SetPooling sets the "pooling" field.
+func (guo *GroupUpdateOne) SetPooling(tpc *types.GroupPoolingConfig) *GroupUpdateOne {
+	guo.mutation.SetPooling(tpc)
+	return guo
+}
+
+// ClearPooling clears the value of the "pooling" field.
+func (guo *GroupUpdateOne) ClearPooling() *GroupUpdateOne {
+	guo.mutation.ClearPooling()
+	return guo
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -377,6 +413,11 @@ func (guo *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
+	if v, ok := guo.mutation.Pooling(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pooling", err: fmt.Errorf(`ent: validator failed for field "Group.pooling": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -442,6 +483,12 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	if guo.mutation.LookupKeyCleared() {
 		_spec.ClearField(group.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := guo.mutation.Pooling(); ok {
+		_spec.SetField(group.FieldPooling, field.TypeJSON, value)
+	}
+	if guo.mutation.PoolingCleared() {
+		_spec.ClearField(group.FieldPooling, field.TypeJSON)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -225,6 +225,30 @@ func (f EnvironmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvironmentMutation", m)
 }
 
+// The ExperimentFunc type is an adapter to allow the use of ordinary
+// function as Experiment mutator.
+type ExperimentFunc func(context.Context, *ent.ExperimentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExperimentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExperimentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExperimentMutation", m)
+}
+
+// The ExperimentAssignmentFunc type is an adapter to allow the use of ordinary
+// function as ExperimentAssignment mutator.
+type ExperimentAssignmentFunc func(context.Context, *ent.ExperimentAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExperimentAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExperimentAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExperimentAssignmentMutation", m)
+}
+
 // The FeatureFunc type is an adapter to allow the use of ordinary
 // function as Feature mutator.
 type FeatureFunc func(context.Context, *ent.FeatureMutation) (ent.Value, error)
@@ -357,6 +381,30 @@ func (f PriceUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceUnitMutation", m)
 }
 
+// The QuoteFunc type is an adapter to allow the use of ordinary
+// function as Quote mutator.
+type QuoteFunc func(context.Context, *ent.QuoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuoteMutation", m)
+}
+
+// The QuoteLineItemFunc type is an adapter to allow the use of ordinary
+// function as QuoteLineItem mutator.
+type QuoteLineItemFunc func(context.Context, *ent.QuoteLineItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuoteLineItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuoteLineItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuoteLineItemMutation", m)
+}
+
 // The ScheduledTaskFunc type is an adapter to allow the use of ordinary
 // function as ScheduledTask mutator.
 type ScheduledTaskFunc func(context.Context, *ent.ScheduledTaskMutation) (ent.Value, error)
@@ -417,6 +465,18 @@ func (f SubscriptionLineItemFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionLineItemMutation", m)
 }
 
+// The SubscriptionMigrationResultFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionMigrationResult mutator.
+type SubscriptionMigrationResultFunc func(context.Context, *ent.SubscriptionMigrationResultMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionMigrationResultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionMigrationResultMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMigrationResultMutation", m)
+}
+
 // The SubscriptionPauseFunc type is an adapter to allow the use of ordinary
 // function as SubscriptionPause mutator.
 type SubscriptionPauseFunc func(context.Context, *ent.SubscriptionPauseMutation) (ent.Value, error)
@@ -56,6 +56,10 @@ var (
 		{Name: "addon_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "start_date", Type: field.TypeTime, Nullable: true},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "billing_period", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "billing_period_count", Type: field.TypeInt, Default: 1},
+		{Name: "trial_end", Type: field.TypeTime, Nullable: true},
 		{Name: "addon_status", Type: field.TypeString, Default: "active", SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
@@ -685,6 +689,7 @@ var (
 		{Name: "address_postal_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "address_country", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(2)"}},
 		{Name: "parent_customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "consolidate_invoices", Type: field.TypeBool, Nullable: true},
 	}
 	// CustomersTable holds the schema information for the "customers" table.
 	CustomersTable = &schema.Table{
@@ -738,6 +743,7 @@ var (
 		{Name: "parent_entitlement_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "start_date", Type: field.TypeTime, Nullable: true},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "rollover_config", Type: field.TypeJSON, Nullable: true},
 		{Name: "addon_entitlements", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// EntitlementsTable holds the schema information for the "entitlements" table.
@@ -748,7 +754,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entitlements_addons_entitlements",
-				Columns:    []*schema.Column{EntitlementsColumns[21]},
+				Columns:    []*schema.Column{EntitlementsColumns[22]},
 				RefColumns: []*schema.Column{AddonsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -859,6 +865,84 @@ var (
 			},
 		},
 	}
+	// ExperimentsColumns holds the columns for the "experiments" table.
+	ExperimentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "plan_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "experiment_status", Type: field.TypeString, Default: "draft", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "variants", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ExperimentsTable holds the schema information for the "experiments" table.
+	ExperimentsTable = &schema.Table{
+		Name:       "experiments",
+		Columns:    ExperimentsColumns,
+		PrimaryKey: []*schema.Column{ExperimentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_experiment_tenant_environment_plan_running",
+				Unique:  true,
+				Columns: []*schema.Column{ExperimentsColumns[1], ExperimentsColumns[7], ExperimentsColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published' AND experiment_status = 'running'",
+				},
+			},
+			{
+				Name:    "experiment_tenant_id_environment_id_experiment_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExperimentsColumns[1], ExperimentsColumns[7], ExperimentsColumns[12]},
+			},
+		},
+	}
+	// ExperimentAssignmentsColumns holds the columns for the "experiment_assignments" table.
+	ExperimentAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "experiment_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "customer_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "variant_key", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "plan_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "subscription_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "converted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ExperimentAssignmentsTable holds the schema information for the "experiment_assignments" table.
+	ExperimentAssignmentsTable = &schema.Table{
+		Name:       "experiment_assignments",
+		Columns:    ExperimentAssignmentsColumns,
+		PrimaryKey: []*schema.Column{ExperimentAssignmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_experiment_assignment_experiment_customer",
+				Unique:  true,
+				Columns: []*schema.Column{ExperimentAssignmentsColumns[1], ExperimentAssignmentsColumns[7], ExperimentAssignmentsColumns[8], ExperimentAssignmentsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'published'",
+				},
+			},
+			{
+				Name:    "experimentassignment_tenant_id_environment_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExperimentAssignmentsColumns[1], ExperimentAssignmentsColumns[7], ExperimentAssignmentsColumns[9]},
+			},
+		},
+	}
 	// FeaturesColumns holds the columns for the "features" table.
 	FeaturesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -932,6 +1016,7 @@ var (
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "entity_type", Type: field.TypeString, Default: "price", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "lookup_key", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
+		{Name: "pooling", Type: field.TypeJSON, Nullable: true},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -1311,6 +1396,9 @@ var (
 		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "display_order", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "version_status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "base_plan_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// PlansTable holds the schema information for the "plans" table.
 	PlansTable = &schema.Table{
@@ -1331,6 +1419,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{PlansColumns[1], PlansColumns[7]},
 			},
+			{
+				Name:    "plan_tenant_id_environment_id_base_plan_id",
+				Unique:  false,
+				Columns: []*schema.Column{PlansColumns[1], PlansColumns[7], PlansColumns[15]},
+			},
 		},
 	}
 	// PricesColumns holds the columns for the "prices" table.
@@ -1366,6 +1459,10 @@ var (
 		{Name: "tiers", Type: field.TypeJSON, Nullable: true},
 		{Name: "price_unit_tiers", Type: field.TypeJSON, Nullable: true},
 		{Name: "transform_quantity", Type: field.TypeJSON, Nullable: true},
+		{Name: "time_windows", Type: field.TypeJSON, Nullable: true},
+		{Name: "cost_plus", Type: field.TypeJSON, Nullable: true},
+		{Name: "rollover_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "included_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
 		{Name: "lookup_key", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(255)"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
@@ -1385,7 +1482,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "prices_price_units_price_unit_edge",
-				Columns:    []*schema.Column{PricesColumns[40]},
+				Columns:    []*schema.Column{PricesColumns[44]},
 				RefColumns: []*schema.Column{PriceUnitsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1394,7 +1491,7 @@ var (
 			{
 				Name:    "price_tenant_id_environment_id_lookup_key",
 				Unique:  true,
-				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[31]},
+				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[35]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "status = 'published' AND lookup_key IS NOT NULL AND lookup_key != ''",
 				},
@@ -1407,12 +1504,12 @@ var (
 			{
 				Name:    "price_start_date_end_date",
 				Unique:  false,
-				Columns: []*schema.Column{PricesColumns[37], PricesColumns[38]},
+				Columns: []*schema.Column{PricesColumns[41], PricesColumns[42]},
 			},
 			{
 				Name:    "price_tenant_id_environment_id_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[39]},
+				Columns: []*schema.Column{PricesColumns[1], PricesColumns[7], PricesColumns[43]},
 			},
 		},
 	}
@@ -1449,6 +1546,101 @@ var (
 			},
 		},
 	}
+	// QuotesColumns holds the columns for the "quotes" table.
+	QuotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "quote_number", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "customer_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "plan_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "quote_status", Type: field.TypeString, Default: "draft", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "billing_cadence", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "billing_period", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "billing_period_count", Type: field.TypeInt, Default: 1},
+		{Name: "billing_cycle", Type: field.TypeString, Default: "anniversary", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime},
+		{Name: "coupons", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "phases", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "subtotal", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "total_discount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "finalized_at", Type: field.TypeTime, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "declined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "decline_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "subscription_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// QuotesTable holds the schema information for the "quotes" table.
+	QuotesTable = &schema.Table{
+		Name:       "quotes",
+		Columns:    QuotesColumns,
+		PrimaryKey: []*schema.Column{QuotesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_tenant_environment_quote_number_unique",
+				Unique:  true,
+				Columns: []*schema.Column{QuotesColumns[1], QuotesColumns[7], QuotesColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "quote_number IS NOT NULL AND quote_number != '' AND status = 'published'",
+				},
+			},
+			{
+				Name:    "quote_tenant_id_environment_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuotesColumns[1], QuotesColumns[7], QuotesColumns[10]},
+			},
+			{
+				Name:    "quote_tenant_id_environment_id_quote_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuotesColumns[1], QuotesColumns[7], QuotesColumns[13]},
+			},
+		},
+	}
+	// QuoteLineItemsColumns holds the columns for the "quote_line_items" table.
+	QuoteLineItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "price_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "display_name", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "unit_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(25,15)"}},
+		{Name: "discount_percentage", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(5,2)"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "phase_index", Type: field.TypeInt, Nullable: true},
+		{Name: "currency", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(10)"}},
+		{Name: "quote_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+	}
+	// QuoteLineItemsTable holds the schema information for the "quote_line_items" table.
+	QuoteLineItemsTable = &schema.Table{
+		Name:       "quote_line_items",
+		Columns:    QuoteLineItemsColumns,
+		PrimaryKey: []*schema.Column{QuoteLineItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quote_line_items_quotes_line_items",
+				Columns:    []*schema.Column{QuoteLineItemsColumns[17]},
+				RefColumns: []*schema.Column{QuotesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ScheduledTasksColumns holds the columns for the "scheduled_tasks" table.
 	ScheduledTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
@@ -1605,6 +1797,20 @@ var (
 		{Name: "customer_timezone", Type: field.TypeString, Default: "UTC"},
 		{Name: "proration_behavior", Type: field.TypeString, Default: "none"},
 		{Name: "enable_true_up", Type: field.TypeBool, Default: false},
+		{Name: "billing_threshold_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(20,6)"}},
+		{Name: "trial_end_action", Type: field.TypeString, Default: "convert", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "trial_reminder_days", Type: field.TypeInt, Default: 0},
+		{Name: "trial_usage_caps", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "backdated_billing_mode", Type: field.TypeString, Default: "per_period", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "backdated_billing_pending", Type: field.TypeBool, Default: false},
+		{Name: "spend_limit_state", Type: field.TypeString, Default: "ok", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "spend_limited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "term_months", Type: field.TypeInt, Default: 0},
+		{Name: "auto_renew", Type: field.TypeBool, Default: true},
+		{Name: "renewal_notice_days", Type: field.TypeInt, Default: 0},
+		{Name: "renewal_plan_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "current_term_start", Type: field.TypeTime, Nullable: true},
+		{Name: "current_term_end", Type: field.TypeTime, Nullable: true},
 		{Name: "invoicing_customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// SubscriptionsTable holds the schema information for the "subscriptions" table.
@@ -1615,7 +1821,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_customers_invoicing_customer",
-				Columns:    []*schema.Column{SubscriptionsColumns[39]},
+				Columns:    []*schema.Column{SubscriptionsColumns[53]},
 				RefColumns: []*schema.Column{CustomersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1682,6 +1888,9 @@ var (
 		{Name: "commitment_overage_factor", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(10,4)"}},
 		{Name: "commitment_true_up_enabled", Type: field.TypeBool, Default: false},
 		{Name: "commitment_windowed", Type: field.TypeBool, Default: false},
+		{Name: "quantity_changes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "included_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(20,8)"}},
+		{Name: "rollover", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// SubscriptionLineItemsTable holds the schema information for the "subscription_line_items" table.
@@ -1692,7 +1901,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscription_line_items_subscriptions_line_items",
-				Columns:    []*schema.Column{SubscriptionLineItemsColumns[34]},
+				Columns:    []*schema.Column{SubscriptionLineItemsColumns[37]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1701,7 +1910,7 @@ var (
 			{
 				Name:    "subscriptionlineitem_tenant_id_environment_id_subscription_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionLineItemsColumns[1], SubscriptionLineItemsColumns[7], SubscriptionLineItemsColumns[34], SubscriptionLineItemsColumns[2]},
+				Columns: []*schema.Column{SubscriptionLineItemsColumns[1], SubscriptionLineItemsColumns[7], SubscriptionLineItemsColumns[37], SubscriptionLineItemsColumns[2]},
 			},
 			{
 				Name:    "subscriptionlineitem_tenant_id_environment_id_customer_id_status",
@@ -1731,7 +1940,44 @@ var (
 			{
 				Name:    "subscriptionlineitem_subscription_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionLineItemsColumns[34], SubscriptionLineItemsColumns[2]},
+				Columns: []*schema.Column{SubscriptionLineItemsColumns[37], SubscriptionLineItemsColumns[2]},
+			},
+		},
+	}
+	// SubscriptionMigrationResultsColumns holds the columns for the "subscription_migration_results" table.
+	SubscriptionMigrationResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "status", Type: field.TypeString, Default: "published", SchemaType: map[string]string{"postgres": "varchar(20)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "task_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "customer_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "migration_status", Type: field.TypeString, Default: "pending", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "new_subscription_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "schedule_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SubscriptionMigrationResultsTable holds the schema information for the "subscription_migration_results" table.
+	SubscriptionMigrationResultsTable = &schema.Table{
+		Name:       "subscription_migration_results",
+		Columns:    SubscriptionMigrationResultsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionMigrationResultsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionmigrationresult_tenant_id_environment_id_task_id_subscription_id",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionMigrationResultsColumns[1], SubscriptionMigrationResultsColumns[7], SubscriptionMigrationResultsColumns[8], SubscriptionMigrationResultsColumns[9]},
+			},
+			{
+				Name:    "subscriptionmigrationresult_tenant_id_environment_id_task_id_migration_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionMigrationResultsColumns[1], SubscriptionMigrationResultsColumns[7], SubscriptionMigrationResultsColumns[8], SubscriptionMigrationResultsColumns[11]},
 			},
 		},
 	}
@@ -1747,6 +1993,7 @@ var (
 		{Name: "environment_id", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "pause_status", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "pause_mode", Type: field.TypeString, Default: "scheduled", SchemaType: map[string]string{"postgres": "varchar(50)"}},
+		{Name: "billing_mode", Type: field.TypeString, Default: "stop_all", SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "resume_mode", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 		{Name: "pause_start", Type: field.TypeTime},
 		{Name: "pause_end", Type: field.TypeTime, Nullable: true},
@@ -1765,7 +2012,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscription_pauses_subscriptions_pauses",
-				Columns:    []*schema.Column{SubscriptionPausesColumns[18]},
+				Columns:    []*schema.Column{SubscriptionPausesColumns[19]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1774,17 +2021,17 @@ var (
 			{
 				Name:    "subscriptionpause_tenant_id_environment_id_subscription_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[18], SubscriptionPausesColumns[2]},
+				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[19], SubscriptionPausesColumns[2]},
 			},
 			{
 				Name:    "subscriptionpause_tenant_id_environment_id_pause_start_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[11], SubscriptionPausesColumns[2]},
+				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[12], SubscriptionPausesColumns[2]},
 			},
 			{
 				Name:    "subscriptionpause_tenant_id_environment_id_pause_end_status",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[12], SubscriptionPausesColumns[2]},
+				Columns: []*schema.Column{SubscriptionPausesColumns[1], SubscriptionPausesColumns[7], SubscriptionPausesColumns[13], SubscriptionPausesColumns[2]},
 			},
 		},
 	}
@@ -1801,6 +2048,9 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "start_date", Type: field.TypeTime},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "commitment_amount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(20,6)"}},
+		{Name: "overage_factor", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(10,6)"}},
+		{Name: "commitment_true_up_cadence", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "varchar(20)"}},
 		{Name: "subscription_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(50)"}},
 	}
 	// SubscriptionPhasesTable holds the schema information for the "subscription_phases" table.
@@ -1811,7 +2061,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscription_phases_subscriptions_phases",
-				Columns:    []*schema.Column{SubscriptionPhasesColumns[11]},
+				Columns:    []*schema.Column{SubscriptionPhasesColumns[14]},
 				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -2353,6 +2603,8 @@ var (
 		EntitlementsTable,
 		EntityIntegrationMappingsTable,
 		EnvironmentsTable,
+		ExperimentsTable,
+		ExperimentAssignmentsTable,
 		FeaturesTable,
 		GroupsTable,
 		InvoicesTable,
@@ -2364,11 +2616,14 @@ var (
 		PlansTable,
 		PricesTable,
 		PriceUnitsTable,
+		QuotesTable,
+		QuoteLineItemsTable,
 		ScheduledTasksTable,
 		SecretsTable,
 		SettingsTable,
 		SubscriptionsTable,
 		SubscriptionLineItemsTable,
+		SubscriptionMigrationResultsTable,
 		SubscriptionPausesTable,
 		SubscriptionPhasesTable,
 		SubscriptionSchedulesTable,
@@ -2401,6 +2656,7 @@ func init() {
 	InvoiceLineItemsTable.ForeignKeys[0].RefTable = InvoicesTable
 	PaymentAttemptsTable.ForeignKeys[0].RefTable = PaymentsTable
 	PricesTable.ForeignKeys[0].RefTable = PriceUnitsTable
+	QuoteLineItemsTable.ForeignKeys[0].RefTable = QuotesTable
 	SubscriptionsTable.ForeignKeys[0].RefTable = CustomersTable
 	SubscriptionLineItemsTable.ForeignKeys[0].RefTable = SubscriptionsTable
 	SubscriptionPausesTable.ForeignKeys[0].RefTable = SubscriptionsTable
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/plan"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // Plan is the model entity for the Plan schema.
@@ -42,6 +43,12 @@ type Plan struct {
 	Description string `json:"description,omitempty"`
 	// DisplayOrder holds the value of the "display_order" field.
 	DisplayOrder int `json:"display_order,omitempty"`
+	// Version number of the plan within its version group
+	Version int `json:"version,omitempty"`
+	// VersionStatus holds the value of the "version_status" field.
+	VersionStatus types.PlanVersionStatus `json:"version_status,omitempty"`
+	// First version of the plan, empty for the first version itself
+	BasePlanID *string `json:"base_plan_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlanQuery when eager-loading is set.
 	Edges        PlanEdges `json:"edges"`
@@ -73,9 +80,9 @@ func (*Plan) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case plan.FieldMetadata:
 			values[i] = new([]byte)
-		case plan.FieldDisplayOrder:
+		case plan.FieldDisplayOrder, plan.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case plan.FieldID, plan.FieldTenantID, plan.FieldStatus, plan.FieldCreatedBy, plan.FieldUpdatedBy, plan.FieldEnvironmentID, plan.FieldLookupKey, plan.FieldName, plan.FieldDescription:
+		case plan.FieldID, plan.FieldTenantID, plan.FieldStatus, plan.FieldCreatedBy, plan.FieldUpdatedBy, plan.FieldEnvironmentID, plan.FieldLookupKey, plan.FieldName, plan.FieldDescription, plan.FieldVersionStatus, plan.FieldBasePlanID:
 			values[i] = new(sql.NullString)
 		case plan.FieldCreatedAt, plan.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -174,6 +181,25 @@ func (pl *Plan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pl.DisplayOrder = int(value.Int64)
 			}
+		case plan.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pl.Version = int(value.Int64)
+			}
+		case plan.FieldVersionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_status", values[i])
+			} else if value.Valid {
+				pl.VersionStatus = types.PlanVersionStatus(value.String)
+			}
+		case plan.FieldBasePlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_plan_id", values[i])
+			} else if value.Valid {
+				pl.BasePlanID = new(string)
+				*pl.BasePlanID = value.String
+			}
 		default:
 			pl.selectValues.Set(columns[i], values[i])
 		}
@@ -250,6 +276,17 @@ func (pl *Plan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("display_order=")
 	builder.WriteString(fmt.Sprintf("%v", pl.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Version))
+	builder.WriteString(", ")
+	builder.WriteString("version_status=")
+	builder.WriteString(fmt.Sprintf("%v", pl.VersionStatus))
+	builder.WriteString(", ")
+	if v := pl.BasePlanID; v != nil {
+		builder.WriteString("base_plan_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
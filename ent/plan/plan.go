@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 const (
@@ -38,6 +39,12 @@ const (
 	FieldDescription = "description"
 	// FieldDisplayOrder holds the string denoting the display_order field in the database.
 	FieldDisplayOrder = "display_order"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldVersionStatus holds the string denoting the version_status field in the database.
+	FieldVersionStatus = "version_status"
+	// FieldBasePlanID holds the string denoting the base_plan_id field in the database.
+	FieldBasePlanID = "base_plan_id"
 	// EdgeCreditGrants holds the string denoting the credit_grants edge name in mutations.
 	EdgeCreditGrants = "credit_grants"
 	// Table holds the table name of the plan in the database.
@@ -66,6 +73,9 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldDisplayOrder,
+	FieldVersion,
+	FieldVersionStatus,
+	FieldBasePlanID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,6 +105,10 @@ var (
 	NameValidator func(string) error
 	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
 	DefaultDisplayOrder int
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultVersionStatus holds the default value on creation for the "version_status" field.
+	DefaultVersionStatus types.PlanVersionStatus
 )
 
 // OrderOption defines the ordering options for the Plan queries.
@@ -160,6 +174,21 @@ func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByVersionStatus orders the results by the version_status field.
+func ByVersionStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionStatus, opts...).ToFunc()
+}
+
+// ByBasePlanID orders the results by the base_plan_id field.
+func ByBasePlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBasePlanID, opts...).ToFunc()
+}
+
 // ByCreditGrantsCount orders the results by credit_grants count.
 func ByCreditGrantsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
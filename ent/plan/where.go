@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // ID filters vertices based on their ID field.
@@ -120,6 +121,22 @@ func DisplayOrder(v int) predicate.Plan {
 	return predicate.Plan(sql.FieldEQ(FieldDisplayOrder, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionStatus applies equality check predicate on the "version_status" field. It's identical to VersionStatusEQ.
+func VersionStatus(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldEQ(FieldVersionStatus, vc))
+}
+
+// BasePlanID applies equality check predicate on the "base_plan_id" field. It's identical to BasePlanIDEQ.
+func BasePlanID(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldBasePlanID, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Plan {
 	return predicate.Plan(sql.FieldEQ(FieldTenantID, v))
@@ -820,6 +837,205 @@ func DisplayOrderLTE(v int) predicate.Plan {
 	return predicate.Plan(sql.FieldLTE(FieldDisplayOrder, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionStatusEQ applies the EQ predicate on the "version_status" field.
+func VersionStatusEQ(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldEQ(FieldVersionStatus, vc))
+}
+
+// VersionStatusNEQ applies the NEQ predicate on the "version_status" field.
+func VersionStatusNEQ(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldNEQ(FieldVersionStatus, vc))
+}
+
+// VersionStatusIn applies the In predicate on the "version_status" field.
+func VersionStatusIn(vs ...types.PlanVersionStatus) predicate.Plan {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Plan(sql.FieldIn(FieldVersionStatus, v...))
+}
+
+// VersionStatusNotIn applies the NotIn predicate on the "version_status" field.
+func VersionStatusNotIn(vs ...types.PlanVersionStatus) predicate.Plan {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Plan(sql.FieldNotIn(FieldVersionStatus, v...))
+}
+
+// VersionStatusGT applies the GT predicate on the "version_status" field.
+func VersionStatusGT(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldGT(FieldVersionStatus, vc))
+}
+
+// VersionStatusGTE applies the GTE predicate on the "version_status" field.
+func VersionStatusGTE(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldGTE(FieldVersionStatus, vc))
+}
+
+// VersionStatusLT applies the LT predicate on the "version_status" field.
+func VersionStatusLT(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldLT(FieldVersionStatus, vc))
+}
+
+// VersionStatusLTE applies the LTE predicate on the "version_status" field.
+func VersionStatusLTE(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldLTE(FieldVersionStatus, vc))
+}
+
+// VersionStatusContains applies the Contains predicate on the "version_status" field.
+func VersionStatusContains(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldContains(FieldVersionStatus, vc))
+}
+
+// VersionStatusHasPrefix applies the HasPrefix predicate on the "version_status" field.
+func VersionStatusHasPrefix(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldHasPrefix(FieldVersionStatus, vc))
+}
+
+// VersionStatusHasSuffix applies the HasSuffix predicate on the "version_status" field.
+func VersionStatusHasSuffix(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldHasSuffix(FieldVersionStatus, vc))
+}
+
+// VersionStatusEqualFold applies the EqualFold predicate on the "version_status" field.
+func VersionStatusEqualFold(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldEqualFold(FieldVersionStatus, vc))
+}
+
+// VersionStatusContainsFold applies the ContainsFold predicate on the "version_status" field.
+func VersionStatusContainsFold(v types.PlanVersionStatus) predicate.Plan {
+	vc := string(v)
+	return predicate.Plan(sql.FieldContainsFold(FieldVersionStatus, vc))
+}
+
+// BasePlanIDEQ applies the EQ predicate on the "base_plan_id" field.
+func BasePlanIDEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldBasePlanID, v))
+}
+
+// BasePlanIDNEQ applies the NEQ predicate on the "base_plan_id" field.
+func BasePlanIDNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldBasePlanID, v))
+}
+
+// BasePlanIDIn applies the In predicate on the "base_plan_id" field.
+func BasePlanIDIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldBasePlanID, vs...))
+}
+
+// BasePlanIDNotIn applies the NotIn predicate on the "base_plan_id" field.
+func BasePlanIDNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldBasePlanID, vs...))
+}
+
+// BasePlanIDGT applies the GT predicate on the "base_plan_id" field.
+func BasePlanIDGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldBasePlanID, v))
+}
+
+// BasePlanIDGTE applies the GTE predicate on the "base_plan_id" field.
+func BasePlanIDGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldBasePlanID, v))
+}
+
+// BasePlanIDLT applies the LT predicate on the "base_plan_id" field.
+func BasePlanIDLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldBasePlanID, v))
+}
+
+// BasePlanIDLTE applies the LTE predicate on the "base_plan_id" field.
+func BasePlanIDLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldBasePlanID, v))
+}
+
+// BasePlanIDContains applies the Contains predicate on the "base_plan_id" field.
+func BasePlanIDContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldBasePlanID, v))
+}
+
+// BasePlanIDHasPrefix applies the HasPrefix predicate on the "base_plan_id" field.
+func BasePlanIDHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldBasePlanID, v))
+}
+
+// BasePlanIDHasSuffix applies the HasSuffix predicate on the "base_plan_id" field.
+func BasePlanIDHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldBasePlanID, v))
+}
+
+// BasePlanIDIsNil applies the IsNil predicate on the "base_plan_id" field.
+func BasePlanIDIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldBasePlanID))
+}
+
+// BasePlanIDNotNil applies the NotNil predicate on the "base_plan_id" field.
+func BasePlanIDNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldBasePlanID))
+}
+
+// BasePlanIDEqualFold applies the EqualFold predicate on the "base_plan_id" field.
+func BasePlanIDEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldBasePlanID, v))
+}
+
+// BasePlanIDContainsFold applies the ContainsFold predicate on the "base_plan_id" field.
+func BasePlanIDContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldBasePlanID, v))
+}
+
 // HasCreditGrants applies the HasEdge predicate on the "credit_grants" edge.
 func HasCreditGrants() predicate.Plan {
 	return predicate.Plan(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/creditgrant"
 	"github.com/flexprice/flexprice/ent/plan"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // PlanCreate is the builder for creating a Plan entity.
@@ -165,6 +166,48 @@ func (pc *PlanCreate) SetNillableDisplayOrder(i *int) *PlanCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *PlanCreate) SetVersion(i int) *PlanCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableVersion(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
+// SetVersionStatus sets the "version_status" field.
+func (pc *PlanCreate) SetVersionStatus(tvs types.PlanVersionStatus) *PlanCreate {
+	pc.mutation.SetVersionStatus(tvs)
+	return pc
+}
+
+// SetNillableVersionStatus sets the "version_status" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableVersionStatus(tvs *types.PlanVersionStatus) *PlanCreate {
+	if tvs != nil {
+		pc.SetVersionStatus(*tvs)
+	}
+	return pc
+}
+
+// SetBasePlanID sets the "base_plan_id" field.
+func (pc *PlanCreate) SetBasePlanID(s string) *PlanCreate {
+	pc.mutation.SetBasePlanID(s)
+	return pc
+}
+
+// SetNillableBasePlanID sets the "base_plan_id" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableBasePlanID(s *string) *PlanCreate {
+	if s != nil {
+		pc.SetBasePlanID(*s)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *PlanCreate) SetID(s string) *PlanCreate {
 	pc.mutation.SetID(s)
@@ -241,6 +284,14 @@ func (pc *PlanCreate) defaults() {
 		v := plan.DefaultDisplayOrder
 		pc.mutation.SetDisplayOrder(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := plan.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
+	if _, ok := pc.mutation.VersionStatus(); !ok {
+		v := plan.DefaultVersionStatus
+		pc.mutation.SetVersionStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -273,6 +324,17 @@ func (pc *PlanCreate) check() error {
 	if _, ok := pc.mutation.DisplayOrder(); !ok {
 		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "Plan.display_order"`)}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Plan.version"`)}
+	}
+	if _, ok := pc.mutation.VersionStatus(); !ok {
+		return &ValidationError{Name: "version_status", err: errors.New(`ent: missing required field "Plan.version_status"`)}
+	}
+	if v, ok := pc.mutation.VersionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "version_status", err: fmt.Errorf(`ent: validator failed for field "Plan.version_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -356,6 +418,18 @@ func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
 		_spec.SetField(plan.FieldDisplayOrder, field.TypeInt, value)
 		_node.DisplayOrder = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(plan.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := pc.mutation.VersionStatus(); ok {
+		_spec.SetField(plan.FieldVersionStatus, field.TypeString, value)
+		_node.VersionStatus = value
+	}
+	if value, ok := pc.mutation.BasePlanID(); ok {
+		_spec.SetField(plan.FieldBasePlanID, field.TypeString, value)
+		_node.BasePlanID = &value
+	}
 	if nodes := pc.mutation.CreditGrantsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/ent/creditgrant"
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 // PlanUpdate is the builder for updating Plan entities.
@@ -156,6 +157,20 @@ func (pu *PlanUpdate) AddDisplayOrder(i int) *PlanUpdate {
 	return pu
 }
 
+// SetVersionStatus sets the "version_status" field.
+func (pu *PlanUpdate) SetVersionStatus(tvs types.PlanVersionStatus) *PlanUpdate {
+	pu.mutation.SetVersionStatus(tvs)
+	return pu
+}
+
+// SetNillableVersionStatus sets the "version_status" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableVersionStatus(tvs *types.PlanVersionStatus) *PlanUpdate {
+	if tvs != nil {
+		pu.SetVersionStatus(*tvs)
+	}
+	return pu
+}
+
 // AddCreditGrantIDs adds the "credit_grants" edge to the CreditGrant entity by IDs.
 func (pu *PlanUpdate) AddCreditGrantIDs(ids ...string) *PlanUpdate {
 	pu.mutation.AddCreditGrantIDs(ids...)
@@ -240,6 +255,11 @@ func (pu *PlanUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.VersionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "version_status", err: fmt.Errorf(`ent: validator failed for field "Plan.version_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -300,6 +320,12 @@ func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.AddedDisplayOrder(); ok {
 		_spec.AddField(plan.FieldDisplayOrder, field.TypeInt, value)
 	}
+	if value, ok := pu.mutation.VersionStatus(); ok {
+		_spec.SetField(plan.FieldVersionStatus, field.TypeString, value)
+	}
+	if pu.mutation.BasePlanIDCleared() {
+		_spec.ClearField(plan.FieldBasePlanID, field.TypeString)
+	}
 	if pu.mutation.CreditGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -492,6 +518,20 @@ func (puo *PlanUpdateOne) AddDisplayOrder(i int) *PlanUpdateOne {
 	return puo
 }
 
+// SetVersionStatus sets the "version_status" field.
+func (puo *PlanUpdateOne) SetVersionStatus(tvs types.PlanVersionStatus) *PlanUpdateOne {
+	puo.mutation.SetVersionStatus(tvs)
+	return puo
+}
+
+// SetNillableVersionStatus sets the "version_status" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableVersionStatus(tvs *types.PlanVersionStatus) *PlanUpdateOne {
+	if tvs != nil {
+		puo.SetVersionStatus(*tvs)
+	}
+	return puo
+}
+
 // AddCreditGrantIDs adds the "credit_grants" edge to the CreditGrant entity by IDs.
 func (puo *PlanUpdateOne) AddCreditGrantIDs(ids ...string) *PlanUpdateOne {
 	puo.mutation.AddCreditGrantIDs(ids...)
@@ -589,6 +629,11 @@ func (puo *PlanUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.VersionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "version_status", err: fmt.Errorf(`ent: validator failed for field "Plan.version_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -666,6 +711,12 @@ func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error)
 	if value, ok := puo.mutation.AddedDisplayOrder(); ok {
 		_spec.AddField(plan.FieldDisplayOrder, field.TypeInt, value)
 	}
+	if value, ok := puo.mutation.VersionStatus(); ok {
+		_spec.SetField(plan.FieldVersionStatus, field.TypeString, value)
+	}
+	if puo.mutation.BasePlanIDCleared() {
+		_spec.ClearField(plan.FieldBasePlanID, field.TypeString)
+	}
 	if puo.mutation.CreditGrantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
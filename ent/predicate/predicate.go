@@ -60,6 +60,12 @@ type EntityIntegrationMapping func(*sql.Selector)
 // Environment is the predicate function for environment builders.
 type Environment func(*sql.Selector)
 
+// Experiment is the predicate function for experiment builders.
+type Experiment func(*sql.Selector)
+
+// ExperimentAssignment is the predicate function for experimentassignment builders.
+type ExperimentAssignment func(*sql.Selector)
+
 // Feature is the predicate function for feature builders.
 type Feature func(*sql.Selector)
 
@@ -93,6 +99,12 @@ type Price func(*sql.Selector)
 // PriceUnit is the predicate function for priceunit builders.
 type PriceUnit func(*sql.Selector)
 
+// Quote is the predicate function for quote builders.
+type Quote func(*sql.Selector)
+
+// QuoteLineItem is the predicate function for quotelineitem builders.
+type QuoteLineItem func(*sql.Selector)
+
 // ScheduledTask is the predicate function for scheduledtask builders.
 type ScheduledTask func(*sql.Selector)
 
@@ -108,6 +120,9 @@ type Subscription func(*sql.Selector)
 // SubscriptionLineItem is the predicate function for subscriptionlineitem builders.
 type SubscriptionLineItem func(*sql.Selector)
 
+// SubscriptionMigrationResult is the predicate function for subscriptionmigrationresult builders.
+type SubscriptionMigrationResult func(*sql.Selector)
+
 // SubscriptionPause is the predicate function for subscriptionpause builders.
 type SubscriptionPause func(*sql.Selector)
 
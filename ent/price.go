@@ -83,6 +83,14 @@ type Price struct {
 	PriceUnitTiers []*types.PriceTier `json:"price_unit_tiers,omitempty"`
 	// TransformQuantity holds the value of the "transform_quantity" field.
 	TransformQuantity types.TransformQuantity `json:"transform_quantity,omitempty"`
+	// Time-of-use windows with their own unit amounts for usage prices
+	TimeWindows []types.PriceTimeWindow `json:"time_windows,omitempty"`
+	// Markup over the active costsheet for COST_PLUS prices
+	CostPlus *types.CostPlusConfig `json:"cost_plus,omitempty"`
+	// Carryover of unused free tier usage into the following billing periods
+	RolloverConfig *types.RolloverConfig `json:"rollover_config,omitempty"`
+	// Usage included for free in each billing period of a usage price
+	IncludedQuantity decimal.Decimal `json:"included_quantity,omitempty"`
 	// LookupKey holds the value of the "lookup_key" field.
 	LookupKey string `json:"lookup_key,omitempty"`
 	// Description holds the value of the "description" field.
@@ -145,9 +153,9 @@ func (*Price) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case price.FieldPriceUnitAmount, price.FieldConversionRate, price.FieldMinQuantity:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case price.FieldFilterValues, price.FieldTiers, price.FieldPriceUnitTiers, price.FieldTransformQuantity, price.FieldMetadata:
+		case price.FieldFilterValues, price.FieldTiers, price.FieldPriceUnitTiers, price.FieldTransformQuantity, price.FieldTimeWindows, price.FieldCostPlus, price.FieldRolloverConfig, price.FieldMetadata:
 			values[i] = new([]byte)
-		case price.FieldAmount:
+		case price.FieldAmount, price.FieldIncludedQuantity:
 			values[i] = new(decimal.Decimal)
 		case price.FieldBillingPeriodCount, price.FieldTrialPeriod:
 			values[i] = new(sql.NullInt64)
@@ -377,6 +385,36 @@ func (pr *Price) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field transform_quantity: %w", err)
 				}
 			}
+		case price.FieldTimeWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field time_windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.TimeWindows); err != nil {
+					return fmt.Errorf("unmarshal field time_windows: %w", err)
+				}
+			}
+		case price.FieldCostPlus:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_plus", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.CostPlus); err != nil {
+					return fmt.Errorf("unmarshal field cost_plus: %w", err)
+				}
+			}
+		case price.FieldRolloverConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.RolloverConfig); err != nil {
+					return fmt.Errorf("unmarshal field rollover_config: %w", err)
+				}
+			}
+		case price.FieldIncludedQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field included_quantity", values[i])
+			} else if value != nil {
+				pr.IncludedQuantity = *value
+			}
 		case price.FieldLookupKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field lookup_key", values[i])
@@ -590,6 +628,18 @@ func (pr *Price) String() string {
 	builder.WriteString("transform_quantity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TransformQuantity))
 	builder.WriteString(", ")
+	builder.WriteString("time_windows=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TimeWindows))
+	builder.WriteString(", ")
+	builder.WriteString("cost_plus=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CostPlus))
+	builder.WriteString(", ")
+	builder.WriteString("rollover_config=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RolloverConfig))
+	builder.WriteString(", ")
+	builder.WriteString("included_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pr.IncludedQuantity))
+	builder.WriteString(", ")
 	builder.WriteString("lookup_key=")
 	builder.WriteString(pr.LookupKey)
 	builder.WriteString(", ")
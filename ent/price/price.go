@@ -78,6 +78,14 @@ const (
 	FieldPriceUnitTiers = "price_unit_tiers"
 	// FieldTransformQuantity holds the string denoting the transform_quantity field in the database.
 	FieldTransformQuantity = "transform_quantity"
+	// FieldTimeWindows holds the string denoting the time_windows field in the database.
+	FieldTimeWindows = "time_windows"
+	// FieldCostPlus holds the string denoting the cost_plus field in the database.
+	FieldCostPlus = "cost_plus"
+	// FieldRolloverConfig holds the string denoting the rollover_config field in the database.
+	FieldRolloverConfig = "rollover_config"
+	// FieldIncludedQuantity holds the string denoting the included_quantity field in the database.
+	FieldIncludedQuantity = "included_quantity"
 	// FieldLookupKey holds the string denoting the lookup_key field in the database.
 	FieldLookupKey = "lookup_key"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -152,6 +160,10 @@ var Columns = []string{
 	FieldTiers,
 	FieldPriceUnitTiers,
 	FieldTransformQuantity,
+	FieldTimeWindows,
+	FieldCostPlus,
+	FieldRolloverConfig,
+	FieldIncludedQuantity,
 	FieldLookupKey,
 	FieldDescription,
 	FieldMetadata,
@@ -212,6 +224,8 @@ var (
 	BillingCadenceValidator func(string) error
 	// DefaultTrialPeriod holds the default value on creation for the "trial_period" field.
 	DefaultTrialPeriod int
+	// DefaultIncludedQuantity holds the default value on creation for the "included_quantity" field.
+	DefaultIncludedQuantity decimal.Decimal
 	// DefaultEntityType holds the default value on creation for the "entity_type" field.
 	DefaultEntityType types.PriceEntityType
 	// EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
@@ -365,6 +379,11 @@ func ByTierMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTierMode, opts...).ToFunc()
 }
 
+// ByIncludedQuantity orders the results by the included_quantity field.
+func ByIncludedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludedQuantity, opts...).ToFunc()
+}
+
 // ByLookupKey orders the results by the lookup_key field.
 func ByLookupKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLookupKey, opts...).ToFunc()
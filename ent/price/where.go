@@ -209,6 +209,11 @@ func TierMode(v types.BillingTier) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldTierMode, vc))
 }
 
+// IncludedQuantity applies equality check predicate on the "included_quantity" field. It's identical to IncludedQuantityEQ.
+func IncludedQuantity(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldIncludedQuantity, v))
+}
+
 // LookupKey applies equality check predicate on the "lookup_key" field. It's identical to LookupKeyEQ.
 func LookupKey(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
@@ -2118,6 +2123,76 @@ func TransformQuantityNotNil() predicate.Price {
 	return predicate.Price(sql.FieldNotNull(FieldTransformQuantity))
 }
 
+// TimeWindowsIsNil applies the IsNil predicate on the "time_windows" field.
+func TimeWindowsIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldTimeWindows))
+}
+
+// TimeWindowsNotNil applies the NotNil predicate on the "time_windows" field.
+func TimeWindowsNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldTimeWindows))
+}
+
+// CostPlusIsNil applies the IsNil predicate on the "cost_plus" field.
+func CostPlusIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldCostPlus))
+}
+
+// CostPlusNotNil applies the NotNil predicate on the "cost_plus" field.
+func CostPlusNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldCostPlus))
+}
+
+// RolloverConfigIsNil applies the IsNil predicate on the "rollover_config" field.
+func RolloverConfigIsNil() predicate.Price {
+	return predicate.Price(sql.FieldIsNull(FieldRolloverConfig))
+}
+
+// RolloverConfigNotNil applies the NotNil predicate on the "rollover_config" field.
+func RolloverConfigNotNil() predicate.Price {
+	return predicate.Price(sql.FieldNotNull(FieldRolloverConfig))
+}
+
+// IncludedQuantityEQ applies the EQ predicate on the "included_quantity" field.
+func IncludedQuantityEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldEQ(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityNEQ applies the NEQ predicate on the "included_quantity" field.
+func IncludedQuantityNEQ(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNEQ(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityIn applies the In predicate on the "included_quantity" field.
+func IncludedQuantityIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldIn(FieldIncludedQuantity, vs...))
+}
+
+// IncludedQuantityNotIn applies the NotIn predicate on the "included_quantity" field.
+func IncludedQuantityNotIn(vs ...decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldNotIn(FieldIncludedQuantity, vs...))
+}
+
+// IncludedQuantityGT applies the GT predicate on the "included_quantity" field.
+func IncludedQuantityGT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGT(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityGTE applies the GTE predicate on the "included_quantity" field.
+func IncludedQuantityGTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldGTE(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityLT applies the LT predicate on the "included_quantity" field.
+func IncludedQuantityLT(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLT(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityLTE applies the LTE predicate on the "included_quantity" field.
+func IncludedQuantityLTE(v decimal.Decimal) predicate.Price {
+	return predicate.Price(sql.FieldLTE(FieldIncludedQuantity, v))
+}
+
 // LookupKeyEQ applies the EQ predicate on the "lookup_key" field.
 func LookupKeyEQ(v string) predicate.Price {
 	return predicate.Price(sql.FieldEQ(FieldLookupKey, v))
@@ -378,6 +378,38 @@ func (pc *PriceCreate) SetNillableTransformQuantity(tq *types.TransformQuantity)
 	return pc
 }
 
+// SetTimeWindows sets the "time_windows" field.
+func (pc *PriceCreate) SetTimeWindows(ttw []types.PriceTimeWindow) *PriceCreate {
+	pc.mutation.SetTimeWindows(ttw)
+	return pc
+}
+
+// SetCostPlus sets the "cost_plus" field.
+func (pc *PriceCreate) SetCostPlus(tpc *types.CostPlusConfig) *PriceCreate {
+	pc.mutation.SetCostPlus(tpc)
+	return pc
+}
+
+// SetRolloverConfig sets the "rollover_config" field.
+func (pc *PriceCreate) SetRolloverConfig(tc *types.RolloverConfig) *PriceCreate {
+	pc.mutation.SetRolloverConfig(tc)
+	return pc
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (pc *PriceCreate) SetIncludedQuantity(d decimal.Decimal) *PriceCreate {
+	pc.mutation.SetIncludedQuantity(d)
+	return pc
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (pc *PriceCreate) SetNillableIncludedQuantity(d *decimal.Decimal) *PriceCreate {
+	if d != nil {
+		pc.SetIncludedQuantity(*d)
+	}
+	return pc
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pc *PriceCreate) SetLookupKey(s string) *PriceCreate {
 	pc.mutation.SetLookupKey(s)
@@ -599,6 +631,10 @@ func (pc *PriceCreate) defaults() {
 		v := price.DefaultTrialPeriod
 		pc.mutation.SetTrialPeriod(v)
 	}
+	if _, ok := pc.mutation.IncludedQuantity(); !ok {
+		v := price.DefaultIncludedQuantity
+		pc.mutation.SetIncludedQuantity(v)
+	}
 	if _, ok := pc.mutation.EntityType(); !ok {
 		v := price.DefaultEntityType
 		pc.mutation.SetEntityType(v)
@@ -710,6 +746,19 @@ func (pc *PriceCreate) check() error {
 			return &ValidationError{Name: "transform_quantity", err: fmt.Errorf(`ent: validator failed for field "Price.transform_quantity": %w`, err)}
 		}
 	}
+	if v, ok := pc.mutation.CostPlus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "cost_plus", err: fmt.Errorf(`ent: validator failed for field "Price.cost_plus": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.RolloverConfig(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rollover_config", err: fmt.Errorf(`ent: validator failed for field "Price.rollover_config": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.IncludedQuantity(); !ok {
+		return &ValidationError{Name: "included_quantity", err: errors.New(`ent: missing required field "Price.included_quantity"`)}
+	}
 	if _, ok := pc.mutation.EntityType(); !ok {
 		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "Price.entity_type"`)}
 	}
@@ -881,6 +930,22 @@ func (pc *PriceCreate) createSpec() (*Price, *sqlgraph.CreateSpec) {
 		_spec.SetField(price.FieldTransformQuantity, field.TypeJSON, value)
 		_node.TransformQuantity = value
 	}
+	if value, ok := pc.mutation.TimeWindows(); ok {
+		_spec.SetField(price.FieldTimeWindows, field.TypeJSON, value)
+		_node.TimeWindows = value
+	}
+	if value, ok := pc.mutation.CostPlus(); ok {
+		_spec.SetField(price.FieldCostPlus, field.TypeJSON, value)
+		_node.CostPlus = value
+	}
+	if value, ok := pc.mutation.RolloverConfig(); ok {
+		_spec.SetField(price.FieldRolloverConfig, field.TypeJSON, value)
+		_node.RolloverConfig = value
+	}
+	if value, ok := pc.mutation.IncludedQuantity(); ok {
+		_spec.SetField(price.FieldIncludedQuantity, field.TypeOther, value)
+		_node.IncludedQuantity = value
+	}
 	if value, ok := pc.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 		_node.LookupKey = value
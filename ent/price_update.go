@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/costsheet"
 	"github.com/flexprice/flexprice/ent/predicate"
 	"github.com/flexprice/flexprice/ent/price"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // PriceUpdate is the builder for updating Price entities.
@@ -101,6 +104,62 @@ func (pu *PriceUpdate) ClearFilterValues() *PriceUpdate {
 	return pu
 }
 
+// SetTimeWindows sets the "time_windows" field.
+func (pu *PriceUpdate) SetTimeWindows(ttw []types.PriceTimeWindow) *PriceUpdate {
+	pu.mutation.SetTimeWindows(ttw)
+	return pu
+}
+
+// AppendTimeWindows appends ttw to the "time_windows" field.
+func (pu *PriceUpdate) AppendTimeWindows(ttw []types.PriceTimeWindow) *PriceUpdate {
+	pu.mutation.AppendTimeWindows(ttw)
+	return pu
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (pu *PriceUpdate) ClearTimeWindows() *PriceUpdate {
+	pu.mutation.ClearTimeWindows()
+	return pu
+}
+
+// SetCostPlus sets the "cost_plus" field.
+func (pu *PriceUpdate) SetCostPlus(tpc *types.CostPlusConfig) *PriceUpdate {
+	pu.mutation.SetCostPlus(tpc)
+	return pu
+}
+
+// ClearCostPlus clears the value of the "cost_plus" field.
+func (pu *PriceUpdate) ClearCostPlus() *PriceUpdate {
+	pu.mutation.ClearCostPlus()
+	return pu
+}
+
+// SetRolloverConfig sets the "rollover_config" field.
+func (pu *PriceUpdate) SetRolloverConfig(tc *types.RolloverConfig) *PriceUpdate {
+	pu.mutation.SetRolloverConfig(tc)
+	return pu
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (pu *PriceUpdate) ClearRolloverConfig() *PriceUpdate {
+	pu.mutation.ClearRolloverConfig()
+	return pu
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (pu *PriceUpdate) SetIncludedQuantity(d decimal.Decimal) *PriceUpdate {
+	pu.mutation.SetIncludedQuantity(d)
+	return pu
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (pu *PriceUpdate) SetNillableIncludedQuantity(d *decimal.Decimal) *PriceUpdate {
+	if d != nil {
+		pu.SetIncludedQuantity(*d)
+	}
+	return pu
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (pu *PriceUpdate) SetLookupKey(s string) *PriceUpdate {
 	pu.mutation.SetLookupKey(s)
@@ -365,6 +424,32 @@ func (pu *PriceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := pu.mutation.TimeWindows(); ok {
+		_spec.SetField(price.FieldTimeWindows, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedTimeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, price.FieldTimeWindows, value)
+		})
+	}
+	if pu.mutation.TimeWindowsCleared() {
+		_spec.ClearField(price.FieldTimeWindows, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.CostPlus(); ok {
+		_spec.SetField(price.FieldCostPlus, field.TypeJSON, value)
+	}
+	if pu.mutation.CostPlusCleared() {
+		_spec.ClearField(price.FieldCostPlus, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.RolloverConfig(); ok {
+		_spec.SetField(price.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if pu.mutation.RolloverConfigCleared() {
+		_spec.ClearField(price.FieldRolloverConfig, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.IncludedQuantity(); ok {
+		_spec.SetField(price.FieldIncludedQuantity, field.TypeOther, value)
+	}
 	if value, ok := pu.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
@@ -541,6 +626,62 @@ func (puo *PriceUpdateOne) ClearFilterValues() *PriceUpdateOne {
 	return puo
 }
 
+// SetTimeWindows sets the "time_windows" field.
+func (puo *PriceUpdateOne) SetTimeWindows(ttw []types.PriceTimeWindow) *PriceUpdateOne {
+	puo.mutation.SetTimeWindows(ttw)
+	return puo
+}
+
+// AppendTimeWindows appends ttw to the "time_windows" field.
+func (puo *PriceUpdateOne) AppendTimeWindows(ttw []types.PriceTimeWindow) *PriceUpdateOne {
+	puo.mutation.AppendTimeWindows(ttw)
+	return puo
+}
+
+// ClearTimeWindows clears the value of the "time_windows" field.
+func (puo *PriceUpdateOne) ClearTimeWindows() *PriceUpdateOne {
+	puo.mutation.ClearTimeWindows()
+	return puo
+}
+
+// SetCostPlus sets the "cost_plus" field.
+func (puo *PriceUpdateOne) SetCostPlus(tpc *types.CostPlusConfig) *PriceUpdateOne {
+	puo.mutation.SetCostPlus(tpc)
+	return puo
+}
+
+// ClearCostPlus clears the value of the "cost_plus" field.
+func (puo *PriceUpdateOne) ClearCostPlus() *PriceUpdateOne {
+	puo.mutation.ClearCostPlus()
+	return puo
+}
+
+// SetRolloverConfig sets the "rollover_config" field.
+func (puo *PriceUpdateOne) SetRolloverConfig(tc *types.RolloverConfig) *PriceUpdateOne {
+	puo.mutation.SetRolloverConfig(tc)
+	return puo
+}
+
+// ClearRolloverConfig clears the value of the "rollover_config" field.
+func (puo *PriceUpdateOne) ClearRolloverConfig() *PriceUpdateOne {
+	puo.mutation.ClearRolloverConfig()
+	return puo
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (puo *PriceUpdateOne) SetIncludedQuantity(d decimal.Decimal) *PriceUpdateOne {
+	puo.mutation.SetIncludedQuantity(d)
+	return puo
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (puo *PriceUpdateOne) SetNillableIncludedQuantity(d *decimal.Decimal) *PriceUpdateOne {
+	if d != nil {
+		puo.SetIncludedQuantity(*d)
+	}
+	return puo
+}
+
 // SetLookupKey sets the "lookup_key" field.
 func (puo *PriceUpdateOne) SetLookupKey(s string) *PriceUpdateOne {
 	puo.mutation.SetLookupKey(s)
@@ -835,6 +976,32 @@ func (puo *PriceUpdateOne) sqlSave(ctx context.Context) (_node *Price, err error
 	if puo.mutation.TransformQuantityCleared() {
 		_spec.ClearField(price.FieldTransformQuantity, field.TypeJSON)
 	}
+	if value, ok := puo.mutation.TimeWindows(); ok {
+		_spec.SetField(price.FieldTimeWindows, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedTimeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, price.FieldTimeWindows, value)
+		})
+	}
+	if puo.mutation.TimeWindowsCleared() {
+		_spec.ClearField(price.FieldTimeWindows, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.CostPlus(); ok {
+		_spec.SetField(price.FieldCostPlus, field.TypeJSON, value)
+	}
+	if puo.mutation.CostPlusCleared() {
+		_spec.ClearField(price.FieldCostPlus, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.RolloverConfig(); ok {
+		_spec.SetField(price.FieldRolloverConfig, field.TypeJSON, value)
+	}
+	if puo.mutation.RolloverConfigCleared() {
+		_spec.ClearField(price.FieldRolloverConfig, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.IncludedQuantity(); ok {
+		_spec.SetField(price.FieldIncludedQuantity, field.TypeOther, value)
+	}
 	if value, ok := puo.mutation.LookupKey(); ok {
 		_spec.SetField(price.FieldLookupKey, field.TypeString, value)
 	}
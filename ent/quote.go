@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// Quote is the model entity for the Quote schema.
+type Quote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// QuoteNumber holds the value of the "quote_number" field.
+	QuoteNumber *string `json:"quote_number,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// QuoteStatus holds the value of the "quote_status" field.
+	QuoteStatus types.QuoteStatus `json:"quote_status,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// BillingCadence holds the value of the "billing_cadence" field.
+	BillingCadence types.BillingCadence `json:"billing_cadence,omitempty"`
+	// BillingPeriod holds the value of the "billing_period" field.
+	BillingPeriod types.BillingPeriod `json:"billing_period,omitempty"`
+	// BillingPeriodCount holds the value of the "billing_period_count" field.
+	BillingPeriodCount int `json:"billing_period_count,omitempty"`
+	// BillingCycle holds the value of the "billing_cycle" field.
+	BillingCycle types.BillingCycle `json:"billing_cycle,omitempty"`
+	// Proposed start of the subscription, the subscription starts on acceptance when not set
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil time.Time `json:"valid_until,omitempty"`
+	// Coupons holds the value of the "coupons" field.
+	Coupons []string `json:"coupons,omitempty"`
+	// Phases holds the value of the "phases" field.
+	Phases []types.QuotePhase `json:"phases,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// TotalDiscount holds the value of the "total_discount" field.
+	TotalDiscount decimal.Decimal `json:"total_discount,omitempty"`
+	// Total holds the value of the "total" field.
+	Total decimal.Decimal `json:"total,omitempty"`
+	// FinalizedAt holds the value of the "finalized_at" field.
+	FinalizedAt *time.Time `json:"finalized_at,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// DeclinedAt holds the value of the "declined_at" field.
+	DeclinedAt *time.Time `json:"declined_at,omitempty"`
+	// DeclineReason holds the value of the "decline_reason" field.
+	DeclineReason string `json:"decline_reason,omitempty"`
+	// Subscription the quote was converted into on acceptance
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuoteQuery when eager-loading is set.
+	Edges        QuoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuoteEdges holds the relations/edges for other nodes in the graph.
+type QuoteEdges struct {
+	// LineItems holds the value of the line_items edge.
+	LineItems []*QuoteLineItem `json:"line_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LineItemsOrErr returns the LineItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuoteEdges) LineItemsOrErr() ([]*QuoteLineItem, error) {
+	if e.loadedTypes[0] {
+		return e.LineItems, nil
+	}
+	return nil, &NotLoadedError{edge: "line_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quote.FieldMetadata, quote.FieldCoupons, quote.FieldPhases:
+			values[i] = new([]byte)
+		case quote.FieldSubtotal, quote.FieldTotalDiscount, quote.FieldTotal:
+			values[i] = new(decimal.Decimal)
+		case quote.FieldBillingPeriodCount:
+			values[i] = new(sql.NullInt64)
+		case quote.FieldID, quote.FieldTenantID, quote.FieldStatus, quote.FieldCreatedBy, quote.FieldUpdatedBy, quote.FieldEnvironmentID, quote.FieldQuoteNumber, quote.FieldCustomerID, quote.FieldPlanID, quote.FieldCurrency, quote.FieldQuoteStatus, quote.FieldDescription, quote.FieldBillingCadence, quote.FieldBillingPeriod, quote.FieldBillingCycle, quote.FieldDeclineReason, quote.FieldSubscriptionID:
+			values[i] = new(sql.NullString)
+		case quote.FieldCreatedAt, quote.FieldUpdatedAt, quote.FieldStartDate, quote.FieldValidUntil, quote.FieldFinalizedAt, quote.FieldAcceptedAt, quote.FieldDeclinedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quote fields.
+func (q *Quote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quote.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				q.ID = value.String
+			}
+		case quote.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				q.TenantID = value.String
+			}
+		case quote.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				q.Status = value.String
+			}
+		case quote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quote.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		case quote.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				q.CreatedBy = value.String
+			}
+		case quote.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				q.UpdatedBy = value.String
+			}
+		case quote.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				q.EnvironmentID = value.String
+			}
+		case quote.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case quote.FieldQuoteNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_number", values[i])
+			} else if value.Valid {
+				q.QuoteNumber = new(string)
+				*q.QuoteNumber = value.String
+			}
+		case quote.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				q.CustomerID = value.String
+			}
+		case quote.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				q.PlanID = value.String
+			}
+		case quote.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				q.Currency = value.String
+			}
+		case quote.FieldQuoteStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_status", values[i])
+			} else if value.Valid {
+				q.QuoteStatus = types.QuoteStatus(value.String)
+			}
+		case quote.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				q.Description = value.String
+			}
+		case quote.FieldBillingCadence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_cadence", values[i])
+			} else if value.Valid {
+				q.BillingCadence = types.BillingCadence(value.String)
+			}
+		case quote.FieldBillingPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_period", values[i])
+			} else if value.Valid {
+				q.BillingPeriod = types.BillingPeriod(value.String)
+			}
+		case quote.FieldBillingPeriodCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_period_count", values[i])
+			} else if value.Valid {
+				q.BillingPeriodCount = int(value.Int64)
+			}
+		case quote.FieldBillingCycle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_cycle", values[i])
+			} else if value.Valid {
+				q.BillingCycle = types.BillingCycle(value.String)
+			}
+		case quote.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				q.StartDate = new(time.Time)
+				*q.StartDate = value.Time
+			}
+		case quote.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				q.ValidUntil = value.Time
+			}
+		case quote.FieldCoupons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field coupons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Coupons); err != nil {
+					return fmt.Errorf("unmarshal field coupons: %w", err)
+				}
+			}
+		case quote.FieldPhases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field phases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Phases); err != nil {
+					return fmt.Errorf("unmarshal field phases: %w", err)
+				}
+			}
+		case quote.FieldSubtotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				q.Subtotal = *value
+			}
+		case quote.FieldTotalDiscount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_discount", values[i])
+			} else if value != nil {
+				q.TotalDiscount = *value
+			}
+		case quote.FieldTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				q.Total = *value
+			}
+		case quote.FieldFinalizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finalized_at", values[i])
+			} else if value.Valid {
+				q.FinalizedAt = new(time.Time)
+				*q.FinalizedAt = value.Time
+			}
+		case quote.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				q.AcceptedAt = new(time.Time)
+				*q.AcceptedAt = value.Time
+			}
+		case quote.FieldDeclinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field declined_at", values[i])
+			} else if value.Valid {
+				q.DeclinedAt = new(time.Time)
+				*q.DeclinedAt = value.Time
+			}
+		case quote.FieldDeclineReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decline_reason", values[i])
+			} else if value.Valid {
+				q.DeclineReason = value.String
+			}
+		case quote.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				q.SubscriptionID = new(string)
+				*q.SubscriptionID = value.String
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quote.
+// This includes values selected through modifiers, order, etc.
+func (q *Quote) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// QueryLineItems queries the "line_items" edge of the Quote entity.
+func (q *Quote) QueryLineItems() *QuoteLineItemQuery {
+	return NewQuoteClient(q.config).QueryLineItems(q)
+}
+
+// Update returns a builder for updating this Quote.
+// Note that you need to call Quote.Unwrap() before calling this method if this Quote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quote) Update() *QuoteUpdateOne {
+	return NewQuoteClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quote) Unwrap() *Quote {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quote is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quote) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(q.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(q.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(q.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(q.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(q.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", q.Metadata))
+	builder.WriteString(", ")
+	if v := q.QuoteNumber; v != nil {
+		builder.WriteString("quote_number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(q.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(q.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(q.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("quote_status=")
+	builder.WriteString(fmt.Sprintf("%v", q.QuoteStatus))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(q.Description)
+	builder.WriteString(", ")
+	builder.WriteString("billing_cadence=")
+	builder.WriteString(fmt.Sprintf("%v", q.BillingCadence))
+	builder.WriteString(", ")
+	builder.WriteString("billing_period=")
+	builder.WriteString(fmt.Sprintf("%v", q.BillingPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("billing_period_count=")
+	builder.WriteString(fmt.Sprintf("%v", q.BillingPeriodCount))
+	builder.WriteString(", ")
+	builder.WriteString("billing_cycle=")
+	builder.WriteString(fmt.Sprintf("%v", q.BillingCycle))
+	builder.WriteString(", ")
+	if v := q.StartDate; v != nil {
+		builder.WriteString("start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("valid_until=")
+	builder.WriteString(q.ValidUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("coupons=")
+	builder.WriteString(fmt.Sprintf("%v", q.Coupons))
+	builder.WriteString(", ")
+	builder.WriteString("phases=")
+	builder.WriteString(fmt.Sprintf("%v", q.Phases))
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", q.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("total_discount=")
+	builder.WriteString(fmt.Sprintf("%v", q.TotalDiscount))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", q.Total))
+	builder.WriteString(", ")
+	if v := q.FinalizedAt; v != nil {
+		builder.WriteString("finalized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := q.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := q.DeclinedAt; v != nil {
+		builder.WriteString("declined_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("decline_reason=")
+	builder.WriteString(q.DeclineReason)
+	builder.WriteString(", ")
+	if v := q.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Quotes is a parsable slice of Quote.
+type Quotes []*Quote
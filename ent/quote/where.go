@@ -0,0 +1,1941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quote
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// QuoteNumber applies equality check predicate on the "quote_number" field. It's identical to QuoteNumberEQ.
+func QuoteNumber(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldQuoteNumber, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldPlanID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCurrency, v))
+}
+
+// QuoteStatus applies equality check predicate on the "quote_status" field. It's identical to QuoteStatusEQ.
+func QuoteStatus(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldQuoteStatus, vc))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDescription, v))
+}
+
+// BillingCadence applies equality check predicate on the "billing_cadence" field. It's identical to BillingCadenceEQ.
+func BillingCadence(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingCadence, vc))
+}
+
+// BillingPeriod applies equality check predicate on the "billing_period" field. It's identical to BillingPeriodEQ.
+func BillingPeriod(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodCount applies equality check predicate on the "billing_period_count" field. It's identical to BillingPeriodCountEQ.
+func BillingPeriodCount(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldBillingPeriodCount, v))
+}
+
+// BillingCycle applies equality check predicate on the "billing_cycle" field. It's identical to BillingCycleEQ.
+func BillingCycle(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingCycle, vc))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldStartDate, v))
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// TotalDiscount applies equality check predicate on the "total_discount" field. It's identical to TotalDiscountEQ.
+func TotalDiscount(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTotalDiscount, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTotal, v))
+}
+
+// FinalizedAt applies equality check predicate on the "finalized_at" field. It's identical to FinalizedAtEQ.
+func FinalizedAt(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldFinalizedAt, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// DeclinedAt applies equality check predicate on the "declined_at" field. It's identical to DeclinedAtEQ.
+func DeclinedAt(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDeclinedAt, v))
+}
+
+// DeclineReason applies equality check predicate on the "decline_reason" field. It's identical to DeclineReasonEQ.
+func DeclineReason(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDeclineReason, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldMetadata))
+}
+
+// QuoteNumberEQ applies the EQ predicate on the "quote_number" field.
+func QuoteNumberEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldQuoteNumber, v))
+}
+
+// QuoteNumberNEQ applies the NEQ predicate on the "quote_number" field.
+func QuoteNumberNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldQuoteNumber, v))
+}
+
+// QuoteNumberIn applies the In predicate on the "quote_number" field.
+func QuoteNumberIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldQuoteNumber, vs...))
+}
+
+// QuoteNumberNotIn applies the NotIn predicate on the "quote_number" field.
+func QuoteNumberNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldQuoteNumber, vs...))
+}
+
+// QuoteNumberGT applies the GT predicate on the "quote_number" field.
+func QuoteNumberGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldQuoteNumber, v))
+}
+
+// QuoteNumberGTE applies the GTE predicate on the "quote_number" field.
+func QuoteNumberGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldQuoteNumber, v))
+}
+
+// QuoteNumberLT applies the LT predicate on the "quote_number" field.
+func QuoteNumberLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldQuoteNumber, v))
+}
+
+// QuoteNumberLTE applies the LTE predicate on the "quote_number" field.
+func QuoteNumberLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldQuoteNumber, v))
+}
+
+// QuoteNumberContains applies the Contains predicate on the "quote_number" field.
+func QuoteNumberContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldQuoteNumber, v))
+}
+
+// QuoteNumberHasPrefix applies the HasPrefix predicate on the "quote_number" field.
+func QuoteNumberHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldQuoteNumber, v))
+}
+
+// QuoteNumberHasSuffix applies the HasSuffix predicate on the "quote_number" field.
+func QuoteNumberHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldQuoteNumber, v))
+}
+
+// QuoteNumberIsNil applies the IsNil predicate on the "quote_number" field.
+func QuoteNumberIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldQuoteNumber))
+}
+
+// QuoteNumberNotNil applies the NotNil predicate on the "quote_number" field.
+func QuoteNumberNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldQuoteNumber))
+}
+
+// QuoteNumberEqualFold applies the EqualFold predicate on the "quote_number" field.
+func QuoteNumberEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldQuoteNumber, v))
+}
+
+// QuoteNumberContainsFold applies the ContainsFold predicate on the "quote_number" field.
+func QuoteNumberContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldQuoteNumber, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldPlanID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// QuoteStatusEQ applies the EQ predicate on the "quote_status" field.
+func QuoteStatusEQ(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusNEQ applies the NEQ predicate on the "quote_status" field.
+func QuoteStatusNEQ(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldNEQ(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusIn applies the In predicate on the "quote_status" field.
+func QuoteStatusIn(vs ...types.QuoteStatus) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldIn(FieldQuoteStatus, v...))
+}
+
+// QuoteStatusNotIn applies the NotIn predicate on the "quote_status" field.
+func QuoteStatusNotIn(vs ...types.QuoteStatus) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldNotIn(FieldQuoteStatus, v...))
+}
+
+// QuoteStatusGT applies the GT predicate on the "quote_status" field.
+func QuoteStatusGT(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGT(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusGTE applies the GTE predicate on the "quote_status" field.
+func QuoteStatusGTE(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGTE(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusLT applies the LT predicate on the "quote_status" field.
+func QuoteStatusLT(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLT(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusLTE applies the LTE predicate on the "quote_status" field.
+func QuoteStatusLTE(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLTE(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusContains applies the Contains predicate on the "quote_status" field.
+func QuoteStatusContains(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContains(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusHasPrefix applies the HasPrefix predicate on the "quote_status" field.
+func QuoteStatusHasPrefix(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasPrefix(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusHasSuffix applies the HasSuffix predicate on the "quote_status" field.
+func QuoteStatusHasSuffix(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasSuffix(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusEqualFold applies the EqualFold predicate on the "quote_status" field.
+func QuoteStatusEqualFold(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEqualFold(FieldQuoteStatus, vc))
+}
+
+// QuoteStatusContainsFold applies the ContainsFold predicate on the "quote_status" field.
+func QuoteStatusContainsFold(v types.QuoteStatus) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContainsFold(FieldQuoteStatus, vc))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// BillingCadenceEQ applies the EQ predicate on the "billing_cadence" field.
+func BillingCadenceEQ(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingCadence, vc))
+}
+
+// BillingCadenceNEQ applies the NEQ predicate on the "billing_cadence" field.
+func BillingCadenceNEQ(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldNEQ(FieldBillingCadence, vc))
+}
+
+// BillingCadenceIn applies the In predicate on the "billing_cadence" field.
+func BillingCadenceIn(vs ...types.BillingCadence) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldIn(FieldBillingCadence, v...))
+}
+
+// BillingCadenceNotIn applies the NotIn predicate on the "billing_cadence" field.
+func BillingCadenceNotIn(vs ...types.BillingCadence) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldNotIn(FieldBillingCadence, v...))
+}
+
+// BillingCadenceGT applies the GT predicate on the "billing_cadence" field.
+func BillingCadenceGT(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGT(FieldBillingCadence, vc))
+}
+
+// BillingCadenceGTE applies the GTE predicate on the "billing_cadence" field.
+func BillingCadenceGTE(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGTE(FieldBillingCadence, vc))
+}
+
+// BillingCadenceLT applies the LT predicate on the "billing_cadence" field.
+func BillingCadenceLT(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLT(FieldBillingCadence, vc))
+}
+
+// BillingCadenceLTE applies the LTE predicate on the "billing_cadence" field.
+func BillingCadenceLTE(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLTE(FieldBillingCadence, vc))
+}
+
+// BillingCadenceContains applies the Contains predicate on the "billing_cadence" field.
+func BillingCadenceContains(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContains(FieldBillingCadence, vc))
+}
+
+// BillingCadenceHasPrefix applies the HasPrefix predicate on the "billing_cadence" field.
+func BillingCadenceHasPrefix(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasPrefix(FieldBillingCadence, vc))
+}
+
+// BillingCadenceHasSuffix applies the HasSuffix predicate on the "billing_cadence" field.
+func BillingCadenceHasSuffix(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasSuffix(FieldBillingCadence, vc))
+}
+
+// BillingCadenceEqualFold applies the EqualFold predicate on the "billing_cadence" field.
+func BillingCadenceEqualFold(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEqualFold(FieldBillingCadence, vc))
+}
+
+// BillingCadenceContainsFold applies the ContainsFold predicate on the "billing_cadence" field.
+func BillingCadenceContainsFold(v types.BillingCadence) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContainsFold(FieldBillingCadence, vc))
+}
+
+// BillingPeriodEQ applies the EQ predicate on the "billing_period" field.
+func BillingPeriodEQ(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodNEQ applies the NEQ predicate on the "billing_period" field.
+func BillingPeriodNEQ(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldNEQ(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodIn applies the In predicate on the "billing_period" field.
+func BillingPeriodIn(vs ...types.BillingPeriod) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldIn(FieldBillingPeriod, v...))
+}
+
+// BillingPeriodNotIn applies the NotIn predicate on the "billing_period" field.
+func BillingPeriodNotIn(vs ...types.BillingPeriod) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldNotIn(FieldBillingPeriod, v...))
+}
+
+// BillingPeriodGT applies the GT predicate on the "billing_period" field.
+func BillingPeriodGT(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGT(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodGTE applies the GTE predicate on the "billing_period" field.
+func BillingPeriodGTE(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGTE(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodLT applies the LT predicate on the "billing_period" field.
+func BillingPeriodLT(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLT(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodLTE applies the LTE predicate on the "billing_period" field.
+func BillingPeriodLTE(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLTE(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodContains applies the Contains predicate on the "billing_period" field.
+func BillingPeriodContains(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContains(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodHasPrefix applies the HasPrefix predicate on the "billing_period" field.
+func BillingPeriodHasPrefix(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasPrefix(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodHasSuffix applies the HasSuffix predicate on the "billing_period" field.
+func BillingPeriodHasSuffix(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasSuffix(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodEqualFold applies the EqualFold predicate on the "billing_period" field.
+func BillingPeriodEqualFold(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEqualFold(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodContainsFold applies the ContainsFold predicate on the "billing_period" field.
+func BillingPeriodContainsFold(v types.BillingPeriod) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContainsFold(FieldBillingPeriod, vc))
+}
+
+// BillingPeriodCountEQ applies the EQ predicate on the "billing_period_count" field.
+func BillingPeriodCountEQ(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountNEQ applies the NEQ predicate on the "billing_period_count" field.
+func BillingPeriodCountNEQ(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountIn applies the In predicate on the "billing_period_count" field.
+func BillingPeriodCountIn(vs ...int) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldBillingPeriodCount, vs...))
+}
+
+// BillingPeriodCountNotIn applies the NotIn predicate on the "billing_period_count" field.
+func BillingPeriodCountNotIn(vs ...int) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldBillingPeriodCount, vs...))
+}
+
+// BillingPeriodCountGT applies the GT predicate on the "billing_period_count" field.
+func BillingPeriodCountGT(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountGTE applies the GTE predicate on the "billing_period_count" field.
+func BillingPeriodCountGTE(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountLT applies the LT predicate on the "billing_period_count" field.
+func BillingPeriodCountLT(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldBillingPeriodCount, v))
+}
+
+// BillingPeriodCountLTE applies the LTE predicate on the "billing_period_count" field.
+func BillingPeriodCountLTE(v int) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldBillingPeriodCount, v))
+}
+
+// BillingCycleEQ applies the EQ predicate on the "billing_cycle" field.
+func BillingCycleEQ(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEQ(FieldBillingCycle, vc))
+}
+
+// BillingCycleNEQ applies the NEQ predicate on the "billing_cycle" field.
+func BillingCycleNEQ(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldNEQ(FieldBillingCycle, vc))
+}
+
+// BillingCycleIn applies the In predicate on the "billing_cycle" field.
+func BillingCycleIn(vs ...types.BillingCycle) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldIn(FieldBillingCycle, v...))
+}
+
+// BillingCycleNotIn applies the NotIn predicate on the "billing_cycle" field.
+func BillingCycleNotIn(vs ...types.BillingCycle) predicate.Quote {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Quote(sql.FieldNotIn(FieldBillingCycle, v...))
+}
+
+// BillingCycleGT applies the GT predicate on the "billing_cycle" field.
+func BillingCycleGT(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGT(FieldBillingCycle, vc))
+}
+
+// BillingCycleGTE applies the GTE predicate on the "billing_cycle" field.
+func BillingCycleGTE(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldGTE(FieldBillingCycle, vc))
+}
+
+// BillingCycleLT applies the LT predicate on the "billing_cycle" field.
+func BillingCycleLT(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLT(FieldBillingCycle, vc))
+}
+
+// BillingCycleLTE applies the LTE predicate on the "billing_cycle" field.
+func BillingCycleLTE(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldLTE(FieldBillingCycle, vc))
+}
+
+// BillingCycleContains applies the Contains predicate on the "billing_cycle" field.
+func BillingCycleContains(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContains(FieldBillingCycle, vc))
+}
+
+// BillingCycleHasPrefix applies the HasPrefix predicate on the "billing_cycle" field.
+func BillingCycleHasPrefix(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasPrefix(FieldBillingCycle, vc))
+}
+
+// BillingCycleHasSuffix applies the HasSuffix predicate on the "billing_cycle" field.
+func BillingCycleHasSuffix(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldHasSuffix(FieldBillingCycle, vc))
+}
+
+// BillingCycleEqualFold applies the EqualFold predicate on the "billing_cycle" field.
+func BillingCycleEqualFold(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldEqualFold(FieldBillingCycle, vc))
+}
+
+// BillingCycleContainsFold applies the ContainsFold predicate on the "billing_cycle" field.
+func BillingCycleContainsFold(v types.BillingCycle) predicate.Quote {
+	vc := string(v)
+	return predicate.Quote(sql.FieldContainsFold(FieldBillingCycle, vc))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldStartDate, v))
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldStartDate))
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldStartDate))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// CouponsIsNil applies the IsNil predicate on the "coupons" field.
+func CouponsIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldCoupons))
+}
+
+// CouponsNotNil applies the NotNil predicate on the "coupons" field.
+func CouponsNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldCoupons))
+}
+
+// PhasesIsNil applies the IsNil predicate on the "phases" field.
+func PhasesIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldPhases))
+}
+
+// PhasesNotNil applies the NotNil predicate on the "phases" field.
+func PhasesNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldPhases))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// TotalDiscountEQ applies the EQ predicate on the "total_discount" field.
+func TotalDiscountEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTotalDiscount, v))
+}
+
+// TotalDiscountNEQ applies the NEQ predicate on the "total_discount" field.
+func TotalDiscountNEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldTotalDiscount, v))
+}
+
+// TotalDiscountIn applies the In predicate on the "total_discount" field.
+func TotalDiscountIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldTotalDiscount, vs...))
+}
+
+// TotalDiscountNotIn applies the NotIn predicate on the "total_discount" field.
+func TotalDiscountNotIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldTotalDiscount, vs...))
+}
+
+// TotalDiscountGT applies the GT predicate on the "total_discount" field.
+func TotalDiscountGT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldTotalDiscount, v))
+}
+
+// TotalDiscountGTE applies the GTE predicate on the "total_discount" field.
+func TotalDiscountGTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldTotalDiscount, v))
+}
+
+// TotalDiscountLT applies the LT predicate on the "total_discount" field.
+func TotalDiscountLT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldTotalDiscount, v))
+}
+
+// TotalDiscountLTE applies the LTE predicate on the "total_discount" field.
+func TotalDiscountLTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldTotalDiscount, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v decimal.Decimal) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldTotal, v))
+}
+
+// FinalizedAtEQ applies the EQ predicate on the "finalized_at" field.
+func FinalizedAtEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldFinalizedAt, v))
+}
+
+// FinalizedAtNEQ applies the NEQ predicate on the "finalized_at" field.
+func FinalizedAtNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldFinalizedAt, v))
+}
+
+// FinalizedAtIn applies the In predicate on the "finalized_at" field.
+func FinalizedAtIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldFinalizedAt, vs...))
+}
+
+// FinalizedAtNotIn applies the NotIn predicate on the "finalized_at" field.
+func FinalizedAtNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldFinalizedAt, vs...))
+}
+
+// FinalizedAtGT applies the GT predicate on the "finalized_at" field.
+func FinalizedAtGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldFinalizedAt, v))
+}
+
+// FinalizedAtGTE applies the GTE predicate on the "finalized_at" field.
+func FinalizedAtGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldFinalizedAt, v))
+}
+
+// FinalizedAtLT applies the LT predicate on the "finalized_at" field.
+func FinalizedAtLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldFinalizedAt, v))
+}
+
+// FinalizedAtLTE applies the LTE predicate on the "finalized_at" field.
+func FinalizedAtLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldFinalizedAt, v))
+}
+
+// FinalizedAtIsNil applies the IsNil predicate on the "finalized_at" field.
+func FinalizedAtIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldFinalizedAt))
+}
+
+// FinalizedAtNotNil applies the NotNil predicate on the "finalized_at" field.
+func FinalizedAtNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldFinalizedAt))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// DeclinedAtEQ applies the EQ predicate on the "declined_at" field.
+func DeclinedAtEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDeclinedAt, v))
+}
+
+// DeclinedAtNEQ applies the NEQ predicate on the "declined_at" field.
+func DeclinedAtNEQ(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldDeclinedAt, v))
+}
+
+// DeclinedAtIn applies the In predicate on the "declined_at" field.
+func DeclinedAtIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldDeclinedAt, vs...))
+}
+
+// DeclinedAtNotIn applies the NotIn predicate on the "declined_at" field.
+func DeclinedAtNotIn(vs ...time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldDeclinedAt, vs...))
+}
+
+// DeclinedAtGT applies the GT predicate on the "declined_at" field.
+func DeclinedAtGT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldDeclinedAt, v))
+}
+
+// DeclinedAtGTE applies the GTE predicate on the "declined_at" field.
+func DeclinedAtGTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldDeclinedAt, v))
+}
+
+// DeclinedAtLT applies the LT predicate on the "declined_at" field.
+func DeclinedAtLT(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldDeclinedAt, v))
+}
+
+// DeclinedAtLTE applies the LTE predicate on the "declined_at" field.
+func DeclinedAtLTE(v time.Time) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldDeclinedAt, v))
+}
+
+// DeclinedAtIsNil applies the IsNil predicate on the "declined_at" field.
+func DeclinedAtIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldDeclinedAt))
+}
+
+// DeclinedAtNotNil applies the NotNil predicate on the "declined_at" field.
+func DeclinedAtNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldDeclinedAt))
+}
+
+// DeclineReasonEQ applies the EQ predicate on the "decline_reason" field.
+func DeclineReasonEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldDeclineReason, v))
+}
+
+// DeclineReasonNEQ applies the NEQ predicate on the "decline_reason" field.
+func DeclineReasonNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldDeclineReason, v))
+}
+
+// DeclineReasonIn applies the In predicate on the "decline_reason" field.
+func DeclineReasonIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldDeclineReason, vs...))
+}
+
+// DeclineReasonNotIn applies the NotIn predicate on the "decline_reason" field.
+func DeclineReasonNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldDeclineReason, vs...))
+}
+
+// DeclineReasonGT applies the GT predicate on the "decline_reason" field.
+func DeclineReasonGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldDeclineReason, v))
+}
+
+// DeclineReasonGTE applies the GTE predicate on the "decline_reason" field.
+func DeclineReasonGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldDeclineReason, v))
+}
+
+// DeclineReasonLT applies the LT predicate on the "decline_reason" field.
+func DeclineReasonLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldDeclineReason, v))
+}
+
+// DeclineReasonLTE applies the LTE predicate on the "decline_reason" field.
+func DeclineReasonLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldDeclineReason, v))
+}
+
+// DeclineReasonContains applies the Contains predicate on the "decline_reason" field.
+func DeclineReasonContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldDeclineReason, v))
+}
+
+// DeclineReasonHasPrefix applies the HasPrefix predicate on the "decline_reason" field.
+func DeclineReasonHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldDeclineReason, v))
+}
+
+// DeclineReasonHasSuffix applies the HasSuffix predicate on the "decline_reason" field.
+func DeclineReasonHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldDeclineReason, v))
+}
+
+// DeclineReasonIsNil applies the IsNil predicate on the "decline_reason" field.
+func DeclineReasonIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldDeclineReason))
+}
+
+// DeclineReasonNotNil applies the NotNil predicate on the "decline_reason" field.
+func DeclineReasonNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldDeclineReason))
+}
+
+// DeclineReasonEqualFold applies the EqualFold predicate on the "decline_reason" field.
+func DeclineReasonEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldDeclineReason, v))
+}
+
+// DeclineReasonContainsFold applies the ContainsFold predicate on the "decline_reason" field.
+func DeclineReasonContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldDeclineReason, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.Quote {
+	return predicate.Quote(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.Quote {
+	return predicate.Quote(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.Quote {
+	return predicate.Quote(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.Quote {
+	return predicate.Quote(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// HasLineItems applies the HasEdge predicate on the "line_items" edge.
+func HasLineItems() predicate.Quote {
+	return predicate.Quote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LineItemsTable, LineItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLineItemsWith applies the HasEdge predicate on the "line_items" edge with a given conditions (other predicates).
+func HasLineItemsWith(preds ...predicate.QuoteLineItem) predicate.Quote {
+	return predicate.Quote(func(s *sql.Selector) {
+		step := newLineItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quote) predicate.Quote {
+	return predicate.Quote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quote) predicate.Quote {
+	return predicate.Quote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quote) predicate.Quote {
+	return predicate.Quote(sql.NotPredicates(p))
+}
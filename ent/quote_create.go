@@ -0,0 +1,815 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteCreate is the builder for creating a Quote entity.
+type QuoteCreate struct {
+	config
+	mutation *QuoteMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (qc *QuoteCreate) SetTenantID(s string) *QuoteCreate {
+	qc.mutation.SetTenantID(s)
+	return qc
+}
+
+// SetStatus sets the "status" field.
+func (qc *QuoteCreate) SetStatus(s string) *QuoteCreate {
+	qc.mutation.SetStatus(s)
+	return qc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableStatus(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetStatus(*s)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuoteCreate) SetCreatedAt(t time.Time) *QuoteCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableCreatedAt(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuoteCreate) SetUpdatedAt(t time.Time) *QuoteCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableUpdatedAt(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qc *QuoteCreate) SetCreatedBy(s string) *QuoteCreate {
+	qc.mutation.SetCreatedBy(s)
+	return qc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableCreatedBy(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetCreatedBy(*s)
+	}
+	return qc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qc *QuoteCreate) SetUpdatedBy(s string) *QuoteCreate {
+	qc.mutation.SetUpdatedBy(s)
+	return qc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableUpdatedBy(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetUpdatedBy(*s)
+	}
+	return qc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (qc *QuoteCreate) SetEnvironmentID(s string) *QuoteCreate {
+	qc.mutation.SetEnvironmentID(s)
+	return qc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableEnvironmentID(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetEnvironmentID(*s)
+	}
+	return qc
+}
+
+// SetMetadata sets the "metadata" field.
+func (qc *QuoteCreate) SetMetadata(m map[string]string) *QuoteCreate {
+	qc.mutation.SetMetadata(m)
+	return qc
+}
+
+// SetQuoteNumber sets the "quote_number" field.
+func (qc *QuoteCreate) SetQuoteNumber(s string) *QuoteCreate {
+	qc.mutation.SetQuoteNumber(s)
+	return qc
+}
+
+// SetNillableQuoteNumber sets the "quote_number" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableQuoteNumber(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetQuoteNumber(*s)
+	}
+	return qc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (qc *QuoteCreate) SetCustomerID(s string) *QuoteCreate {
+	qc.mutation.SetCustomerID(s)
+	return qc
+}
+
+// SetPlanID sets the "plan_id" field.
+func (qc *QuoteCreate) SetPlanID(s string) *QuoteCreate {
+	qc.mutation.SetPlanID(s)
+	return qc
+}
+
+// SetCurrency sets the "currency" field.
+func (qc *QuoteCreate) SetCurrency(s string) *QuoteCreate {
+	qc.mutation.SetCurrency(s)
+	return qc
+}
+
+// SetQuoteStatus sets the "quote_status" field.
+func (qc *QuoteCreate) SetQuoteStatus(ts types.QuoteStatus) *QuoteCreate {
+	qc.mutation.SetQuoteStatus(ts)
+	return qc
+}
+
+// SetNillableQuoteStatus sets the "quote_status" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableQuoteStatus(ts *types.QuoteStatus) *QuoteCreate {
+	if ts != nil {
+		qc.SetQuoteStatus(*ts)
+	}
+	return qc
+}
+
+// SetDescription sets the "description" field.
+func (qc *QuoteCreate) SetDescription(s string) *QuoteCreate {
+	qc.mutation.SetDescription(s)
+	return qc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableDescription(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetDescription(*s)
+	}
+	return qc
+}
+
+// SetBillingCadence sets the "billing_cadence" field.
+func (qc *QuoteCreate) SetBillingCadence(tc types.BillingCadence) *QuoteCreate {
+	qc.mutation.SetBillingCadence(tc)
+	return qc
+}
+
+// SetBillingPeriod sets the "billing_period" field.
+func (qc *QuoteCreate) SetBillingPeriod(tp types.BillingPeriod) *QuoteCreate {
+	qc.mutation.SetBillingPeriod(tp)
+	return qc
+}
+
+// SetBillingPeriodCount sets the "billing_period_count" field.
+func (qc *QuoteCreate) SetBillingPeriodCount(i int) *QuoteCreate {
+	qc.mutation.SetBillingPeriodCount(i)
+	return qc
+}
+
+// SetNillableBillingPeriodCount sets the "billing_period_count" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableBillingPeriodCount(i *int) *QuoteCreate {
+	if i != nil {
+		qc.SetBillingPeriodCount(*i)
+	}
+	return qc
+}
+
+// SetBillingCycle sets the "billing_cycle" field.
+func (qc *QuoteCreate) SetBillingCycle(tc types.BillingCycle) *QuoteCreate {
+	qc.mutation.SetBillingCycle(tc)
+	return qc
+}
+
+// SetNillableBillingCycle sets the "billing_cycle" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableBillingCycle(tc *types.BillingCycle) *QuoteCreate {
+	if tc != nil {
+		qc.SetBillingCycle(*tc)
+	}
+	return qc
+}
+
+// SetStartDate sets the "start_date" field.
+func (qc *QuoteCreate) SetStartDate(t time.Time) *QuoteCreate {
+	qc.mutation.SetStartDate(t)
+	return qc
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableStartDate(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetStartDate(*t)
+	}
+	return qc
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (qc *QuoteCreate) SetValidUntil(t time.Time) *QuoteCreate {
+	qc.mutation.SetValidUntil(t)
+	return qc
+}
+
+// SetCoupons sets the "coupons" field.
+func (qc *QuoteCreate) SetCoupons(s []string) *QuoteCreate {
+	qc.mutation.SetCoupons(s)
+	return qc
+}
+
+// SetPhases sets the "phases" field.
+func (qc *QuoteCreate) SetPhases(tp []types.QuotePhase) *QuoteCreate {
+	qc.mutation.SetPhases(tp)
+	return qc
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (qc *QuoteCreate) SetSubtotal(d decimal.Decimal) *QuoteCreate {
+	qc.mutation.SetSubtotal(d)
+	return qc
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableSubtotal(d *decimal.Decimal) *QuoteCreate {
+	if d != nil {
+		qc.SetSubtotal(*d)
+	}
+	return qc
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (qc *QuoteCreate) SetTotalDiscount(d decimal.Decimal) *QuoteCreate {
+	qc.mutation.SetTotalDiscount(d)
+	return qc
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableTotalDiscount(d *decimal.Decimal) *QuoteCreate {
+	if d != nil {
+		qc.SetTotalDiscount(*d)
+	}
+	return qc
+}
+
+// SetTotal sets the "total" field.
+func (qc *QuoteCreate) SetTotal(d decimal.Decimal) *QuoteCreate {
+	qc.mutation.SetTotal(d)
+	return qc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableTotal(d *decimal.Decimal) *QuoteCreate {
+	if d != nil {
+		qc.SetTotal(*d)
+	}
+	return qc
+}
+
+// SetFinalizedAt sets the "finalized_at" field.
+func (qc *QuoteCreate) SetFinalizedAt(t time.Time) *QuoteCreate {
+	qc.mutation.SetFinalizedAt(t)
+	return qc
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableFinalizedAt(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetFinalizedAt(*t)
+	}
+	return qc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (qc *QuoteCreate) SetAcceptedAt(t time.Time) *QuoteCreate {
+	qc.mutation.SetAcceptedAt(t)
+	return qc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableAcceptedAt(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetAcceptedAt(*t)
+	}
+	return qc
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (qc *QuoteCreate) SetDeclinedAt(t time.Time) *QuoteCreate {
+	qc.mutation.SetDeclinedAt(t)
+	return qc
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableDeclinedAt(t *time.Time) *QuoteCreate {
+	if t != nil {
+		qc.SetDeclinedAt(*t)
+	}
+	return qc
+}
+
+// SetDeclineReason sets the "decline_reason" field.
+func (qc *QuoteCreate) SetDeclineReason(s string) *QuoteCreate {
+	qc.mutation.SetDeclineReason(s)
+	return qc
+}
+
+// SetNillableDeclineReason sets the "decline_reason" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableDeclineReason(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetDeclineReason(*s)
+	}
+	return qc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (qc *QuoteCreate) SetSubscriptionID(s string) *QuoteCreate {
+	qc.mutation.SetSubscriptionID(s)
+	return qc
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (qc *QuoteCreate) SetNillableSubscriptionID(s *string) *QuoteCreate {
+	if s != nil {
+		qc.SetSubscriptionID(*s)
+	}
+	return qc
+}
+
+// SetID sets the "id" field.
+func (qc *QuoteCreate) SetID(s string) *QuoteCreate {
+	qc.mutation.SetID(s)
+	return qc
+}
+
+// AddLineItemIDs adds the "line_items" edge to the QuoteLineItem entity by IDs.
+func (qc *QuoteCreate) AddLineItemIDs(ids ...string) *QuoteCreate {
+	qc.mutation.AddLineItemIDs(ids...)
+	return qc
+}
+
+// AddLineItems adds the "line_items" edges to the QuoteLineItem entity.
+func (qc *QuoteCreate) AddLineItems(q ...*QuoteLineItem) *QuoteCreate {
+	ids := make([]string, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qc.AddLineItemIDs(ids...)
+}
+
+// Mutation returns the QuoteMutation object of the builder.
+func (qc *QuoteCreate) Mutation() *QuoteMutation {
+	return qc.mutation
+}
+
+// Save creates the Quote in the database.
+func (qc *QuoteCreate) Save(ctx context.Context) (*Quote, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuoteCreate) SaveX(ctx context.Context) *Quote {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuoteCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuoteCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuoteCreate) defaults() {
+	if _, ok := qc.mutation.Status(); !ok {
+		v := quote.DefaultStatus
+		qc.mutation.SetStatus(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quote.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quote.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qc.mutation.EnvironmentID(); !ok {
+		v := quote.DefaultEnvironmentID
+		qc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := qc.mutation.QuoteStatus(); !ok {
+		v := quote.DefaultQuoteStatus
+		qc.mutation.SetQuoteStatus(v)
+	}
+	if _, ok := qc.mutation.BillingPeriodCount(); !ok {
+		v := quote.DefaultBillingPeriodCount
+		qc.mutation.SetBillingPeriodCount(v)
+	}
+	if _, ok := qc.mutation.BillingCycle(); !ok {
+		v := quote.DefaultBillingCycle
+		qc.mutation.SetBillingCycle(v)
+	}
+	if _, ok := qc.mutation.Subtotal(); !ok {
+		v := quote.DefaultSubtotal
+		qc.mutation.SetSubtotal(v)
+	}
+	if _, ok := qc.mutation.TotalDiscount(); !ok {
+		v := quote.DefaultTotalDiscount
+		qc.mutation.SetTotalDiscount(v)
+	}
+	if _, ok := qc.mutation.Total(); !ok {
+		v := quote.DefaultTotal
+		qc.mutation.SetTotal(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuoteCreate) check() error {
+	if _, ok := qc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Quote.tenant_id"`)}
+	}
+	if v, ok := qc.mutation.TenantID(); ok {
+		if err := quote.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Quote.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Quote.status"`)}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quote.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quote.updated_at"`)}
+	}
+	if _, ok := qc.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "Quote.customer_id"`)}
+	}
+	if v, ok := qc.mutation.CustomerID(); ok {
+		if err := quote.CustomerIDValidator(v); err != nil {
+			return &ValidationError{Name: "customer_id", err: fmt.Errorf(`ent: validator failed for field "Quote.customer_id": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "Quote.plan_id"`)}
+	}
+	if v, ok := qc.mutation.PlanID(); ok {
+		if err := quote.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Quote.plan_id": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Quote.currency"`)}
+	}
+	if v, ok := qc.mutation.Currency(); ok {
+		if err := quote.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Quote.currency": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.QuoteStatus(); !ok {
+		return &ValidationError{Name: "quote_status", err: errors.New(`ent: missing required field "Quote.quote_status"`)}
+	}
+	if v, ok := qc.mutation.QuoteStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "quote_status", err: fmt.Errorf(`ent: validator failed for field "Quote.quote_status": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.BillingCadence(); !ok {
+		return &ValidationError{Name: "billing_cadence", err: errors.New(`ent: missing required field "Quote.billing_cadence"`)}
+	}
+	if v, ok := qc.mutation.BillingCadence(); ok {
+		if err := quote.BillingCadenceValidator(string(v)); err != nil {
+			return &ValidationError{Name: "billing_cadence", err: fmt.Errorf(`ent: validator failed for field "Quote.billing_cadence": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.BillingPeriod(); !ok {
+		return &ValidationError{Name: "billing_period", err: errors.New(`ent: missing required field "Quote.billing_period"`)}
+	}
+	if v, ok := qc.mutation.BillingPeriod(); ok {
+		if err := quote.BillingPeriodValidator(string(v)); err != nil {
+			return &ValidationError{Name: "billing_period", err: fmt.Errorf(`ent: validator failed for field "Quote.billing_period": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.BillingPeriodCount(); !ok {
+		return &ValidationError{Name: "billing_period_count", err: errors.New(`ent: missing required field "Quote.billing_period_count"`)}
+	}
+	if v, ok := qc.mutation.BillingPeriodCount(); ok {
+		if err := quote.BillingPeriodCountValidator(v); err != nil {
+			return &ValidationError{Name: "billing_period_count", err: fmt.Errorf(`ent: validator failed for field "Quote.billing_period_count": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.BillingCycle(); !ok {
+		return &ValidationError{Name: "billing_cycle", err: errors.New(`ent: missing required field "Quote.billing_cycle"`)}
+	}
+	if v, ok := qc.mutation.BillingCycle(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "billing_cycle", err: fmt.Errorf(`ent: validator failed for field "Quote.billing_cycle": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.ValidUntil(); !ok {
+		return &ValidationError{Name: "valid_until", err: errors.New(`ent: missing required field "Quote.valid_until"`)}
+	}
+	if _, ok := qc.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Quote.subtotal"`)}
+	}
+	if _, ok := qc.mutation.TotalDiscount(); !ok {
+		return &ValidationError{Name: "total_discount", err: errors.New(`ent: missing required field "Quote.total_discount"`)}
+	}
+	if _, ok := qc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Quote.total"`)}
+	}
+	return nil
+}
+
+func (qc *QuoteCreate) sqlSave(ctx context.Context) (*Quote, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Quote.ID type: %T", _spec.ID.Value)
+		}
+	}
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuoteCreate) createSpec() (*Quote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quote{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quote.Table, sqlgraph.NewFieldSpec(quote.FieldID, field.TypeString))
+	)
+	if id, ok := qc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qc.mutation.TenantID(); ok {
+		_spec.SetField(quote.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := qc.mutation.Status(); ok {
+		_spec.SetField(quote.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quote.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qc.mutation.CreatedBy(); ok {
+		_spec.SetField(quote.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := qc.mutation.UpdatedBy(); ok {
+		_spec.SetField(quote.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := qc.mutation.EnvironmentID(); ok {
+		_spec.SetField(quote.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := qc.mutation.Metadata(); ok {
+		_spec.SetField(quote.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := qc.mutation.QuoteNumber(); ok {
+		_spec.SetField(quote.FieldQuoteNumber, field.TypeString, value)
+		_node.QuoteNumber = &value
+	}
+	if value, ok := qc.mutation.CustomerID(); ok {
+		_spec.SetField(quote.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := qc.mutation.PlanID(); ok {
+		_spec.SetField(quote.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
+	if value, ok := qc.mutation.Currency(); ok {
+		_spec.SetField(quote.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := qc.mutation.QuoteStatus(); ok {
+		_spec.SetField(quote.FieldQuoteStatus, field.TypeString, value)
+		_node.QuoteStatus = value
+	}
+	if value, ok := qc.mutation.Description(); ok {
+		_spec.SetField(quote.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := qc.mutation.BillingCadence(); ok {
+		_spec.SetField(quote.FieldBillingCadence, field.TypeString, value)
+		_node.BillingCadence = value
+	}
+	if value, ok := qc.mutation.BillingPeriod(); ok {
+		_spec.SetField(quote.FieldBillingPeriod, field.TypeString, value)
+		_node.BillingPeriod = value
+	}
+	if value, ok := qc.mutation.BillingPeriodCount(); ok {
+		_spec.SetField(quote.FieldBillingPeriodCount, field.TypeInt, value)
+		_node.BillingPeriodCount = value
+	}
+	if value, ok := qc.mutation.BillingCycle(); ok {
+		_spec.SetField(quote.FieldBillingCycle, field.TypeString, value)
+		_node.BillingCycle = value
+	}
+	if value, ok := qc.mutation.StartDate(); ok {
+		_spec.SetField(quote.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = &value
+	}
+	if value, ok := qc.mutation.ValidUntil(); ok {
+		_spec.SetField(quote.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = value
+	}
+	if value, ok := qc.mutation.Coupons(); ok {
+		_spec.SetField(quote.FieldCoupons, field.TypeJSON, value)
+		_node.Coupons = value
+	}
+	if value, ok := qc.mutation.Phases(); ok {
+		_spec.SetField(quote.FieldPhases, field.TypeJSON, value)
+		_node.Phases = value
+	}
+	if value, ok := qc.mutation.Subtotal(); ok {
+		_spec.SetField(quote.FieldSubtotal, field.TypeOther, value)
+		_node.Subtotal = value
+	}
+	if value, ok := qc.mutation.TotalDiscount(); ok {
+		_spec.SetField(quote.FieldTotalDiscount, field.TypeOther, value)
+		_node.TotalDiscount = value
+	}
+	if value, ok := qc.mutation.Total(); ok {
+		_spec.SetField(quote.FieldTotal, field.TypeOther, value)
+		_node.Total = value
+	}
+	if value, ok := qc.mutation.FinalizedAt(); ok {
+		_spec.SetField(quote.FieldFinalizedAt, field.TypeTime, value)
+		_node.FinalizedAt = &value
+	}
+	if value, ok := qc.mutation.AcceptedAt(); ok {
+		_spec.SetField(quote.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := qc.mutation.DeclinedAt(); ok {
+		_spec.SetField(quote.FieldDeclinedAt, field.TypeTime, value)
+		_node.DeclinedAt = &value
+	}
+	if value, ok := qc.mutation.DeclineReason(); ok {
+		_spec.SetField(quote.FieldDeclineReason, field.TypeString, value)
+		_node.DeclineReason = value
+	}
+	if value, ok := qc.mutation.SubscriptionID(); ok {
+		_spec.SetField(quote.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = &value
+	}
+	if nodes := qc.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuoteCreateBulk is the builder for creating many Quote entities in bulk.
+type QuoteCreateBulk struct {
+	config
+	err      error
+	builders []*QuoteCreate
+}
+
+// Save creates the Quote entities in the database.
+func (qcb *QuoteCreateBulk) Save(ctx context.Context) ([]*Quote, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quote, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuoteCreateBulk) SaveX(ctx context.Context) []*Quote {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuoteCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteUpdate is the builder for updating Quote entities.
+type QuoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuoteMutation
+}
+
+// Where appends a list predicates to the QuoteUpdate builder.
+func (qu *QuoteUpdate) Where(ps ...predicate.Quote) *QuoteUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetStatus sets the "status" field.
+func (qu *QuoteUpdate) SetStatus(s string) *QuoteUpdate {
+	qu.mutation.SetStatus(s)
+	return qu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableStatus(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetStatus(*s)
+	}
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuoteUpdate) SetUpdatedAt(t time.Time) *QuoteUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qu *QuoteUpdate) SetUpdatedBy(s string) *QuoteUpdate {
+	qu.mutation.SetUpdatedBy(s)
+	return qu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableUpdatedBy(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetUpdatedBy(*s)
+	}
+	return qu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qu *QuoteUpdate) ClearUpdatedBy() *QuoteUpdate {
+	qu.mutation.ClearUpdatedBy()
+	return qu
+}
+
+// SetMetadata sets the "metadata" field.
+func (qu *QuoteUpdate) SetMetadata(m map[string]string) *QuoteUpdate {
+	qu.mutation.SetMetadata(m)
+	return qu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (qu *QuoteUpdate) ClearMetadata() *QuoteUpdate {
+	qu.mutation.ClearMetadata()
+	return qu
+}
+
+// SetQuoteNumber sets the "quote_number" field.
+func (qu *QuoteUpdate) SetQuoteNumber(s string) *QuoteUpdate {
+	qu.mutation.SetQuoteNumber(s)
+	return qu
+}
+
+// SetNillableQuoteNumber sets the "quote_number" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableQuoteNumber(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetQuoteNumber(*s)
+	}
+	return qu
+}
+
+// ClearQuoteNumber clears the value of the "quote_number" field.
+func (qu *QuoteUpdate) ClearQuoteNumber() *QuoteUpdate {
+	qu.mutation.ClearQuoteNumber()
+	return qu
+}
+
+// SetQuoteStatus sets the "quote_status" field.
+func (qu *QuoteUpdate) SetQuoteStatus(ts types.QuoteStatus) *QuoteUpdate {
+	qu.mutation.SetQuoteStatus(ts)
+	return qu
+}
+
+// SetNillableQuoteStatus sets the "quote_status" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableQuoteStatus(ts *types.QuoteStatus) *QuoteUpdate {
+	if ts != nil {
+		qu.SetQuoteStatus(*ts)
+	}
+	return qu
+}
+
+// SetDescription sets the "description" field.
+func (qu *QuoteUpdate) SetDescription(s string) *QuoteUpdate {
+	qu.mutation.SetDescription(s)
+	return qu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableDescription(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetDescription(*s)
+	}
+	return qu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (qu *QuoteUpdate) ClearDescription() *QuoteUpdate {
+	qu.mutation.ClearDescription()
+	return qu
+}
+
+// SetStartDate sets the "start_date" field.
+func (qu *QuoteUpdate) SetStartDate(t time.Time) *QuoteUpdate {
+	qu.mutation.SetStartDate(t)
+	return qu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableStartDate(t *time.Time) *QuoteUpdate {
+	if t != nil {
+		qu.SetStartDate(*t)
+	}
+	return qu
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (qu *QuoteUpdate) ClearStartDate() *QuoteUpdate {
+	qu.mutation.ClearStartDate()
+	return qu
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (qu *QuoteUpdate) SetValidUntil(t time.Time) *QuoteUpdate {
+	qu.mutation.SetValidUntil(t)
+	return qu
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableValidUntil(t *time.Time) *QuoteUpdate {
+	if t != nil {
+		qu.SetValidUntil(*t)
+	}
+	return qu
+}
+
+// SetCoupons sets the "coupons" field.
+func (qu *QuoteUpdate) SetCoupons(s []string) *QuoteUpdate {
+	qu.mutation.SetCoupons(s)
+	return qu
+}
+
+// AppendCoupons appends s to the "coupons" field.
+func (qu *QuoteUpdate) AppendCoupons(s []string) *QuoteUpdate {
+	qu.mutation.AppendCoupons(s)
+	return qu
+}
+
+// ClearCoupons clears the value of the "coupons" field.
+func (qu *QuoteUpdate) ClearCoupons() *QuoteUpdate {
+	qu.mutation.ClearCoupons()
+	return qu
+}
+
+// SetPhases sets the "phases" field.
+func (qu *QuoteUpdate) SetPhases(tp []types.QuotePhase) *QuoteUpdate {
+	qu.mutation.SetPhases(tp)
+	return qu
+}
+
+// AppendPhases appends tp to the "phases" field.
+func (qu *QuoteUpdate) AppendPhases(tp []types.QuotePhase) *QuoteUpdate {
+	qu.mutation.AppendPhases(tp)
+	return qu
+}
+
+// ClearPhases clears the value of the "phases" field.
+func (qu *QuoteUpdate) ClearPhases() *QuoteUpdate {
+	qu.mutation.ClearPhases()
+	return qu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (qu *QuoteUpdate) SetSubtotal(d decimal.Decimal) *QuoteUpdate {
+	qu.mutation.SetSubtotal(d)
+	return qu
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableSubtotal(d *decimal.Decimal) *QuoteUpdate {
+	if d != nil {
+		qu.SetSubtotal(*d)
+	}
+	return qu
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (qu *QuoteUpdate) SetTotalDiscount(d decimal.Decimal) *QuoteUpdate {
+	qu.mutation.SetTotalDiscount(d)
+	return qu
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableTotalDiscount(d *decimal.Decimal) *QuoteUpdate {
+	if d != nil {
+		qu.SetTotalDiscount(*d)
+	}
+	return qu
+}
+
+// SetTotal sets the "total" field.
+func (qu *QuoteUpdate) SetTotal(d decimal.Decimal) *QuoteUpdate {
+	qu.mutation.SetTotal(d)
+	return qu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableTotal(d *decimal.Decimal) *QuoteUpdate {
+	if d != nil {
+		qu.SetTotal(*d)
+	}
+	return qu
+}
+
+// SetFinalizedAt sets the "finalized_at" field.
+func (qu *QuoteUpdate) SetFinalizedAt(t time.Time) *QuoteUpdate {
+	qu.mutation.SetFinalizedAt(t)
+	return qu
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableFinalizedAt(t *time.Time) *QuoteUpdate {
+	if t != nil {
+		qu.SetFinalizedAt(*t)
+	}
+	return qu
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (qu *QuoteUpdate) ClearFinalizedAt() *QuoteUpdate {
+	qu.mutation.ClearFinalizedAt()
+	return qu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (qu *QuoteUpdate) SetAcceptedAt(t time.Time) *QuoteUpdate {
+	qu.mutation.SetAcceptedAt(t)
+	return qu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableAcceptedAt(t *time.Time) *QuoteUpdate {
+	if t != nil {
+		qu.SetAcceptedAt(*t)
+	}
+	return qu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (qu *QuoteUpdate) ClearAcceptedAt() *QuoteUpdate {
+	qu.mutation.ClearAcceptedAt()
+	return qu
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (qu *QuoteUpdate) SetDeclinedAt(t time.Time) *QuoteUpdate {
+	qu.mutation.SetDeclinedAt(t)
+	return qu
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableDeclinedAt(t *time.Time) *QuoteUpdate {
+	if t != nil {
+		qu.SetDeclinedAt(*t)
+	}
+	return qu
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (qu *QuoteUpdate) ClearDeclinedAt() *QuoteUpdate {
+	qu.mutation.ClearDeclinedAt()
+	return qu
+}
+
+// SetDeclineReason sets the "decline_reason" field.
+func (qu *QuoteUpdate) SetDeclineReason(s string) *QuoteUpdate {
+	qu.mutation.SetDeclineReason(s)
+	return qu
+}
+
+// SetNillableDeclineReason sets the "decline_reason" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableDeclineReason(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetDeclineReason(*s)
+	}
+	return qu
+}
+
+// ClearDeclineReason clears the value of the "decline_reason" field.
+func (qu *QuoteUpdate) ClearDeclineReason() *QuoteUpdate {
+	qu.mutation.ClearDeclineReason()
+	return qu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (qu *QuoteUpdate) SetSubscriptionID(s string) *QuoteUpdate {
+	qu.mutation.SetSubscriptionID(s)
+	return qu
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (qu *QuoteUpdate) SetNillableSubscriptionID(s *string) *QuoteUpdate {
+	if s != nil {
+		qu.SetSubscriptionID(*s)
+	}
+	return qu
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (qu *QuoteUpdate) ClearSubscriptionID() *QuoteUpdate {
+	qu.mutation.ClearSubscriptionID()
+	return qu
+}
+
+// AddLineItemIDs adds the "line_items" edge to the QuoteLineItem entity by IDs.
+func (qu *QuoteUpdate) AddLineItemIDs(ids ...string) *QuoteUpdate {
+	qu.mutation.AddLineItemIDs(ids...)
+	return qu
+}
+
+// AddLineItems adds the "line_items" edges to the QuoteLineItem entity.
+func (qu *QuoteUpdate) AddLineItems(q ...*QuoteLineItem) *QuoteUpdate {
+	ids := make([]string, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.AddLineItemIDs(ids...)
+}
+
+// Mutation returns the QuoteMutation object of the builder.
+func (qu *QuoteUpdate) Mutation() *QuoteMutation {
+	return qu.mutation
+}
+
+// ClearLineItems clears all "line_items" edges to the QuoteLineItem entity.
+func (qu *QuoteUpdate) ClearLineItems() *QuoteUpdate {
+	qu.mutation.ClearLineItems()
+	return qu
+}
+
+// RemoveLineItemIDs removes the "line_items" edge to QuoteLineItem entities by IDs.
+func (qu *QuoteUpdate) RemoveLineItemIDs(ids ...string) *QuoteUpdate {
+	qu.mutation.RemoveLineItemIDs(ids...)
+	return qu
+}
+
+// RemoveLineItems removes "line_items" edges to QuoteLineItem entities.
+func (qu *QuoteUpdate) RemoveLineItems(q ...*QuoteLineItem) *QuoteUpdate {
+	ids := make([]string, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.RemoveLineItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuoteUpdate) Save(ctx context.Context) (int, error) {
+	qu.defaults()
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuoteUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuoteUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuoteUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quote.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (qu *QuoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quote.Table, quote.Columns, sqlgraph.NewFieldSpec(quote.FieldID, field.TypeString))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.Status(); ok {
+		_spec.SetField(quote.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qu.mutation.CreatedByCleared() {
+		_spec.ClearField(quote.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qu.mutation.UpdatedBy(); ok {
+		_spec.SetField(quote.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qu.mutation.UpdatedByCleared() {
+		_spec.ClearField(quote.FieldUpdatedBy, field.TypeString)
+	}
+	if qu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(quote.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := qu.mutation.Metadata(); ok {
+		_spec.SetField(quote.FieldMetadata, field.TypeJSON, value)
+	}
+	if qu.mutation.MetadataCleared() {
+		_spec.ClearField(quote.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := qu.mutation.QuoteNumber(); ok {
+		_spec.SetField(quote.FieldQuoteNumber, field.TypeString, value)
+	}
+	if qu.mutation.QuoteNumberCleared() {
+		_spec.ClearField(quote.FieldQuoteNumber, field.TypeString)
+	}
+	if value, ok := qu.mutation.QuoteStatus(); ok {
+		_spec.SetField(quote.FieldQuoteStatus, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Description(); ok {
+		_spec.SetField(quote.FieldDescription, field.TypeString, value)
+	}
+	if qu.mutation.DescriptionCleared() {
+		_spec.ClearField(quote.FieldDescription, field.TypeString)
+	}
+	if value, ok := qu.mutation.StartDate(); ok {
+		_spec.SetField(quote.FieldStartDate, field.TypeTime, value)
+	}
+	if qu.mutation.StartDateCleared() {
+		_spec.ClearField(quote.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := qu.mutation.ValidUntil(); ok {
+		_spec.SetField(quote.FieldValidUntil, field.TypeTime, value)
+	}
+	if value, ok := qu.mutation.Coupons(); ok {
+		_spec.SetField(quote.FieldCoupons, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedCoupons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quote.FieldCoupons, value)
+		})
+	}
+	if qu.mutation.CouponsCleared() {
+		_spec.ClearField(quote.FieldCoupons, field.TypeJSON)
+	}
+	if value, ok := qu.mutation.Phases(); ok {
+		_spec.SetField(quote.FieldPhases, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedPhases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quote.FieldPhases, value)
+		})
+	}
+	if qu.mutation.PhasesCleared() {
+		_spec.ClearField(quote.FieldPhases, field.TypeJSON)
+	}
+	if value, ok := qu.mutation.Subtotal(); ok {
+		_spec.SetField(quote.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := qu.mutation.TotalDiscount(); ok {
+		_spec.SetField(quote.FieldTotalDiscount, field.TypeOther, value)
+	}
+	if value, ok := qu.mutation.Total(); ok {
+		_spec.SetField(quote.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := qu.mutation.FinalizedAt(); ok {
+		_spec.SetField(quote.FieldFinalizedAt, field.TypeTime, value)
+	}
+	if qu.mutation.FinalizedAtCleared() {
+		_spec.ClearField(quote.FieldFinalizedAt, field.TypeTime)
+	}
+	if value, ok := qu.mutation.AcceptedAt(); ok {
+		_spec.SetField(quote.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if qu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(quote.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := qu.mutation.DeclinedAt(); ok {
+		_spec.SetField(quote.FieldDeclinedAt, field.TypeTime, value)
+	}
+	if qu.mutation.DeclinedAtCleared() {
+		_spec.ClearField(quote.FieldDeclinedAt, field.TypeTime)
+	}
+	if value, ok := qu.mutation.DeclineReason(); ok {
+		_spec.SetField(quote.FieldDeclineReason, field.TypeString, value)
+	}
+	if qu.mutation.DeclineReasonCleared() {
+		_spec.ClearField(quote.FieldDeclineReason, field.TypeString)
+	}
+	if value, ok := qu.mutation.SubscriptionID(); ok {
+		_spec.SetField(quote.FieldSubscriptionID, field.TypeString, value)
+	}
+	if qu.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(quote.FieldSubscriptionID, field.TypeString)
+	}
+	if qu.mutation.LineItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedLineItemsIDs(); len(nodes) > 0 && !qu.mutation.LineItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuoteUpdateOne is the builder for updating a single Quote entity.
+type QuoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuoteMutation
+}
+
+// SetStatus sets the "status" field.
+func (quo *QuoteUpdateOne) SetStatus(s string) *QuoteUpdateOne {
+	quo.mutation.SetStatus(s)
+	return quo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableStatus(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetStatus(*s)
+	}
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuoteUpdateOne) SetUpdatedAt(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (quo *QuoteUpdateOne) SetUpdatedBy(s string) *QuoteUpdateOne {
+	quo.mutation.SetUpdatedBy(s)
+	return quo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableUpdatedBy(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetUpdatedBy(*s)
+	}
+	return quo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (quo *QuoteUpdateOne) ClearUpdatedBy() *QuoteUpdateOne {
+	quo.mutation.ClearUpdatedBy()
+	return quo
+}
+
+// SetMetadata sets the "metadata" field.
+func (quo *QuoteUpdateOne) SetMetadata(m map[string]string) *QuoteUpdateOne {
+	quo.mutation.SetMetadata(m)
+	return quo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (quo *QuoteUpdateOne) ClearMetadata() *QuoteUpdateOne {
+	quo.mutation.ClearMetadata()
+	return quo
+}
+
+// SetQuoteNumber sets the "quote_number" field.
+func (quo *QuoteUpdateOne) SetQuoteNumber(s string) *QuoteUpdateOne {
+	quo.mutation.SetQuoteNumber(s)
+	return quo
+}
+
+// SetNillableQuoteNumber sets the "quote_number" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableQuoteNumber(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetQuoteNumber(*s)
+	}
+	return quo
+}
+
+// ClearQuoteNumber clears the value of the "quote_number" field.
+func (quo *QuoteUpdateOne) ClearQuoteNumber() *QuoteUpdateOne {
+	quo.mutation.ClearQuoteNumber()
+	return quo
+}
+
+// SetQuoteStatus sets the "quote_status" field.
+func (quo *QuoteUpdateOne) SetQuoteStatus(ts types.QuoteStatus) *QuoteUpdateOne {
+	quo.mutation.SetQuoteStatus(ts)
+	return quo
+}
+
+// SetNillableQuoteStatus sets the "quote_status" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableQuoteStatus(ts *types.QuoteStatus) *QuoteUpdateOne {
+	if ts != nil {
+		quo.SetQuoteStatus(*ts)
+	}
+	return quo
+}
+
+// SetDescription sets the "description" field.
+func (quo *QuoteUpdateOne) SetDescription(s string) *QuoteUpdateOne {
+	quo.mutation.SetDescription(s)
+	return quo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableDescription(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetDescription(*s)
+	}
+	return quo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (quo *QuoteUpdateOne) ClearDescription() *QuoteUpdateOne {
+	quo.mutation.ClearDescription()
+	return quo
+}
+
+// SetStartDate sets the "start_date" field.
+func (quo *QuoteUpdateOne) SetStartDate(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetStartDate(t)
+	return quo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableStartDate(t *time.Time) *QuoteUpdateOne {
+	if t != nil {
+		quo.SetStartDate(*t)
+	}
+	return quo
+}
+
+// ClearStartDate clears the value of the "start_date" field.
+func (quo *QuoteUpdateOne) ClearStartDate() *QuoteUpdateOne {
+	quo.mutation.ClearStartDate()
+	return quo
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (quo *QuoteUpdateOne) SetValidUntil(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetValidUntil(t)
+	return quo
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableValidUntil(t *time.Time) *QuoteUpdateOne {
+	if t != nil {
+		quo.SetValidUntil(*t)
+	}
+	return quo
+}
+
+// SetCoupons sets the "coupons" field.
+func (quo *QuoteUpdateOne) SetCoupons(s []string) *QuoteUpdateOne {
+	quo.mutation.SetCoupons(s)
+	return quo
+}
+
+// AppendCoupons appends s to the "coupons" field.
+func (quo *QuoteUpdateOne) AppendCoupons(s []string) *QuoteUpdateOne {
+	quo.mutation.AppendCoupons(s)
+	return quo
+}
+
+// ClearCoupons clears the value of the "coupons" field.
+func (quo *QuoteUpdateOne) ClearCoupons() *QuoteUpdateOne {
+	quo.mutation.ClearCoupons()
+	return quo
+}
+
+// SetPhases sets the "phases" field.
+func (quo *QuoteUpdateOne) SetPhases(tp []types.QuotePhase) *QuoteUpdateOne {
+	quo.mutation.SetPhases(tp)
+	return quo
+}
+
+// AppendPhases appends tp to the "phases" field.
+func (quo *QuoteUpdateOne) AppendPhases(tp []types.QuotePhase) *QuoteUpdateOne {
+	quo.mutation.AppendPhases(tp)
+	return quo
+}
+
+// ClearPhases clears the value of the "phases" field.
+func (quo *QuoteUpdateOne) ClearPhases() *QuoteUpdateOne {
+	quo.mutation.ClearPhases()
+	return quo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (quo *QuoteUpdateOne) SetSubtotal(d decimal.Decimal) *QuoteUpdateOne {
+	quo.mutation.SetSubtotal(d)
+	return quo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableSubtotal(d *decimal.Decimal) *QuoteUpdateOne {
+	if d != nil {
+		quo.SetSubtotal(*d)
+	}
+	return quo
+}
+
+// SetTotalDiscount sets the "total_discount" field.
+func (quo *QuoteUpdateOne) SetTotalDiscount(d decimal.Decimal) *QuoteUpdateOne {
+	quo.mutation.SetTotalDiscount(d)
+	return quo
+}
+
+// SetNillableTotalDiscount sets the "total_discount" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableTotalDiscount(d *decimal.Decimal) *QuoteUpdateOne {
+	if d != nil {
+		quo.SetTotalDiscount(*d)
+	}
+	return quo
+}
+
+// SetTotal sets the "total" field.
+func (quo *QuoteUpdateOne) SetTotal(d decimal.Decimal) *QuoteUpdateOne {
+	quo.mutation.SetTotal(d)
+	return quo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableTotal(d *decimal.Decimal) *QuoteUpdateOne {
+	if d != nil {
+		quo.SetTotal(*d)
+	}
+	return quo
+}
+
+// SetFinalizedAt sets the "finalized_at" field.
+func (quo *QuoteUpdateOne) SetFinalizedAt(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetFinalizedAt(t)
+	return quo
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableFinalizedAt(t *time.Time) *QuoteUpdateOne {
+	if t != nil {
+		quo.SetFinalizedAt(*t)
+	}
+	return quo
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (quo *QuoteUpdateOne) ClearFinalizedAt() *QuoteUpdateOne {
+	quo.mutation.ClearFinalizedAt()
+	return quo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (quo *QuoteUpdateOne) SetAcceptedAt(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetAcceptedAt(t)
+	return quo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableAcceptedAt(t *time.Time) *QuoteUpdateOne {
+	if t != nil {
+		quo.SetAcceptedAt(*t)
+	}
+	return quo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (quo *QuoteUpdateOne) ClearAcceptedAt() *QuoteUpdateOne {
+	quo.mutation.ClearAcceptedAt()
+	return quo
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (quo *QuoteUpdateOne) SetDeclinedAt(t time.Time) *QuoteUpdateOne {
+	quo.mutation.SetDeclinedAt(t)
+	return quo
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableDeclinedAt(t *time.Time) *QuoteUpdateOne {
+	if t != nil {
+		quo.SetDeclinedAt(*t)
+	}
+	return quo
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (quo *QuoteUpdateOne) ClearDeclinedAt() *QuoteUpdateOne {
+	quo.mutation.ClearDeclinedAt()
+	return quo
+}
+
+// SetDeclineReason sets the "decline_reason" field.
+func (quo *QuoteUpdateOne) SetDeclineReason(s string) *QuoteUpdateOne {
+	quo.mutation.SetDeclineReason(s)
+	return quo
+}
+
+// SetNillableDeclineReason sets the "decline_reason" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableDeclineReason(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetDeclineReason(*s)
+	}
+	return quo
+}
+
+// ClearDeclineReason clears the value of the "decline_reason" field.
+func (quo *QuoteUpdateOne) ClearDeclineReason() *QuoteUpdateOne {
+	quo.mutation.ClearDeclineReason()
+	return quo
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (quo *QuoteUpdateOne) SetSubscriptionID(s string) *QuoteUpdateOne {
+	quo.mutation.SetSubscriptionID(s)
+	return quo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (quo *QuoteUpdateOne) SetNillableSubscriptionID(s *string) *QuoteUpdateOne {
+	if s != nil {
+		quo.SetSubscriptionID(*s)
+	}
+	return quo
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (quo *QuoteUpdateOne) ClearSubscriptionID() *QuoteUpdateOne {
+	quo.mutation.ClearSubscriptionID()
+	return quo
+}
+
+// AddLineItemIDs adds the "line_items" edge to the QuoteLineItem entity by IDs.
+func (quo *QuoteUpdateOne) AddLineItemIDs(ids ...string) *QuoteUpdateOne {
+	quo.mutation.AddLineItemIDs(ids...)
+	return quo
+}
+
+// AddLineItems adds the "line_items" edges to the QuoteLineItem entity.
+func (quo *QuoteUpdateOne) AddLineItems(q ...*QuoteLineItem) *QuoteUpdateOne {
+	ids := make([]string, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.AddLineItemIDs(ids...)
+}
+
+// Mutation returns the QuoteMutation object of the builder.
+func (quo *QuoteUpdateOne) Mutation() *QuoteMutation {
+	return quo.mutation
+}
+
+// ClearLineItems clears all "line_items" edges to the QuoteLineItem entity.
+func (quo *QuoteUpdateOne) ClearLineItems() *QuoteUpdateOne {
+	quo.mutation.ClearLineItems()
+	return quo
+}
+
+// RemoveLineItemIDs removes the "line_items" edge to QuoteLineItem entities by IDs.
+func (quo *QuoteUpdateOne) RemoveLineItemIDs(ids ...string) *QuoteUpdateOne {
+	quo.mutation.RemoveLineItemIDs(ids...)
+	return quo
+}
+
+// RemoveLineItems removes "line_items" edges to QuoteLineItem entities.
+func (quo *QuoteUpdateOne) RemoveLineItems(q ...*QuoteLineItem) *QuoteUpdateOne {
+	ids := make([]string, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.RemoveLineItemIDs(ids...)
+}
+
+// Where appends a list predicates to the QuoteUpdate builder.
+func (quo *QuoteUpdateOne) Where(ps ...predicate.Quote) *QuoteUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuoteUpdateOne) Select(field string, fields ...string) *QuoteUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quote entity.
+func (quo *QuoteUpdateOne) Save(ctx context.Context) (*Quote, error) {
+	quo.defaults()
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuoteUpdateOne) SaveX(ctx context.Context) *Quote {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuoteUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuoteUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quote.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (quo *QuoteUpdateOne) sqlSave(ctx context.Context) (_node *Quote, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quote.Table, quote.Columns, sqlgraph.NewFieldSpec(quote.FieldID, field.TypeString))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quote.FieldID)
+		for _, f := range fields {
+			if !quote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.Status(); ok {
+		_spec.SetField(quote.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if quo.mutation.CreatedByCleared() {
+		_spec.ClearField(quote.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := quo.mutation.UpdatedBy(); ok {
+		_spec.SetField(quote.FieldUpdatedBy, field.TypeString, value)
+	}
+	if quo.mutation.UpdatedByCleared() {
+		_spec.ClearField(quote.FieldUpdatedBy, field.TypeString)
+	}
+	if quo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(quote.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := quo.mutation.Metadata(); ok {
+		_spec.SetField(quote.FieldMetadata, field.TypeJSON, value)
+	}
+	if quo.mutation.MetadataCleared() {
+		_spec.ClearField(quote.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := quo.mutation.QuoteNumber(); ok {
+		_spec.SetField(quote.FieldQuoteNumber, field.TypeString, value)
+	}
+	if quo.mutation.QuoteNumberCleared() {
+		_spec.ClearField(quote.FieldQuoteNumber, field.TypeString)
+	}
+	if value, ok := quo.mutation.QuoteStatus(); ok {
+		_spec.SetField(quote.FieldQuoteStatus, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Description(); ok {
+		_spec.SetField(quote.FieldDescription, field.TypeString, value)
+	}
+	if quo.mutation.DescriptionCleared() {
+		_spec.ClearField(quote.FieldDescription, field.TypeString)
+	}
+	if value, ok := quo.mutation.StartDate(); ok {
+		_spec.SetField(quote.FieldStartDate, field.TypeTime, value)
+	}
+	if quo.mutation.StartDateCleared() {
+		_spec.ClearField(quote.FieldStartDate, field.TypeTime)
+	}
+	if value, ok := quo.mutation.ValidUntil(); ok {
+		_spec.SetField(quote.FieldValidUntil, field.TypeTime, value)
+	}
+	if value, ok := quo.mutation.Coupons(); ok {
+		_spec.SetField(quote.FieldCoupons, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedCoupons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quote.FieldCoupons, value)
+		})
+	}
+	if quo.mutation.CouponsCleared() {
+		_spec.ClearField(quote.FieldCoupons, field.TypeJSON)
+	}
+	if value, ok := quo.mutation.Phases(); ok {
+		_spec.SetField(quote.FieldPhases, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedPhases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quote.FieldPhases, value)
+		})
+	}
+	if quo.mutation.PhasesCleared() {
+		_spec.ClearField(quote.FieldPhases, field.TypeJSON)
+	}
+	if value, ok := quo.mutation.Subtotal(); ok {
+		_spec.SetField(quote.FieldSubtotal, field.TypeOther, value)
+	}
+	if value, ok := quo.mutation.TotalDiscount(); ok {
+		_spec.SetField(quote.FieldTotalDiscount, field.TypeOther, value)
+	}
+	if value, ok := quo.mutation.Total(); ok {
+		_spec.SetField(quote.FieldTotal, field.TypeOther, value)
+	}
+	if value, ok := quo.mutation.FinalizedAt(); ok {
+		_spec.SetField(quote.FieldFinalizedAt, field.TypeTime, value)
+	}
+	if quo.mutation.FinalizedAtCleared() {
+		_spec.ClearField(quote.FieldFinalizedAt, field.TypeTime)
+	}
+	if value, ok := quo.mutation.AcceptedAt(); ok {
+		_spec.SetField(quote.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if quo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(quote.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := quo.mutation.DeclinedAt(); ok {
+		_spec.SetField(quote.FieldDeclinedAt, field.TypeTime, value)
+	}
+	if quo.mutation.DeclinedAtCleared() {
+		_spec.ClearField(quote.FieldDeclinedAt, field.TypeTime)
+	}
+	if value, ok := quo.mutation.DeclineReason(); ok {
+		_spec.SetField(quote.FieldDeclineReason, field.TypeString, value)
+	}
+	if quo.mutation.DeclineReasonCleared() {
+		_spec.ClearField(quote.FieldDeclineReason, field.TypeString)
+	}
+	if value, ok := quo.mutation.SubscriptionID(); ok {
+		_spec.SetField(quote.FieldSubscriptionID, field.TypeString, value)
+	}
+	if quo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(quote.FieldSubscriptionID, field.TypeString)
+	}
+	if quo.mutation.LineItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedLineItemsIDs(); len(nodes) > 0 && !quo.mutation.LineItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quote.LineItemsTable,
+			Columns: []string{quote.LineItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Quote{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
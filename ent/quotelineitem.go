@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteLineItem is the model entity for the QuoteLineItem schema.
+type QuoteLineItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// QuoteID holds the value of the "quote_id" field.
+	QuoteID string `json:"quote_id,omitempty"`
+	// PriceID holds the value of the "price_id" field.
+	PriceID string `json:"price_id,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Quoted amount per unit, the amount of the price applies when not set
+	UnitAmount *decimal.Decimal `json:"unit_amount,omitempty"`
+	// DiscountPercentage holds the value of the "discount_percentage" field.
+	DiscountPercentage decimal.Decimal `json:"discount_percentage,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Phase of the quote the line item applies to, it applies to the whole subscription when not set
+	PhaseIndex *int `json:"phase_index,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuoteLineItemQuery when eager-loading is set.
+	Edges        QuoteLineItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuoteLineItemEdges holds the relations/edges for other nodes in the graph.
+type QuoteLineItemEdges struct {
+	// Quote holds the value of the quote edge.
+	Quote *Quote `json:"quote,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QuoteOrErr returns the Quote value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuoteLineItemEdges) QuoteOrErr() (*Quote, error) {
+	if e.Quote != nil {
+		return e.Quote, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quote.Label}
+	}
+	return nil, &NotLoadedError{edge: "quote"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuoteLineItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quotelineitem.FieldUnitAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case quotelineitem.FieldMetadata:
+			values[i] = new([]byte)
+		case quotelineitem.FieldQuantity, quotelineitem.FieldDiscountPercentage, quotelineitem.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case quotelineitem.FieldPhaseIndex:
+			values[i] = new(sql.NullInt64)
+		case quotelineitem.FieldID, quotelineitem.FieldTenantID, quotelineitem.FieldStatus, quotelineitem.FieldCreatedBy, quotelineitem.FieldUpdatedBy, quotelineitem.FieldEnvironmentID, quotelineitem.FieldQuoteID, quotelineitem.FieldPriceID, quotelineitem.FieldDisplayName, quotelineitem.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case quotelineitem.FieldCreatedAt, quotelineitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuoteLineItem fields.
+func (qli *QuoteLineItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quotelineitem.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				qli.ID = value.String
+			}
+		case quotelineitem.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				qli.TenantID = value.String
+			}
+		case quotelineitem.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				qli.Status = value.String
+			}
+		case quotelineitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qli.CreatedAt = value.Time
+			}
+		case quotelineitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qli.UpdatedAt = value.Time
+			}
+		case quotelineitem.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				qli.CreatedBy = value.String
+			}
+		case quotelineitem.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				qli.UpdatedBy = value.String
+			}
+		case quotelineitem.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				qli.EnvironmentID = value.String
+			}
+		case quotelineitem.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qli.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case quotelineitem.FieldQuoteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_id", values[i])
+			} else if value.Valid {
+				qli.QuoteID = value.String
+			}
+		case quotelineitem.FieldPriceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price_id", values[i])
+			} else if value.Valid {
+				qli.PriceID = value.String
+			}
+		case quotelineitem.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				qli.DisplayName = value.String
+			}
+		case quotelineitem.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				qli.Quantity = *value
+			}
+		case quotelineitem.FieldUnitAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_amount", values[i])
+			} else if value.Valid {
+				qli.UnitAmount = new(decimal.Decimal)
+				*qli.UnitAmount = *value.S.(*decimal.Decimal)
+			}
+		case quotelineitem.FieldDiscountPercentage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_percentage", values[i])
+			} else if value != nil {
+				qli.DiscountPercentage = *value
+			}
+		case quotelineitem.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				qli.Amount = *value
+			}
+		case quotelineitem.FieldPhaseIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field phase_index", values[i])
+			} else if value.Valid {
+				qli.PhaseIndex = new(int)
+				*qli.PhaseIndex = int(value.Int64)
+			}
+		case quotelineitem.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				qli.Currency = value.String
+			}
+		default:
+			qli.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuoteLineItem.
+// This includes values selected through modifiers, order, etc.
+func (qli *QuoteLineItem) Value(name string) (ent.Value, error) {
+	return qli.selectValues.Get(name)
+}
+
+// QueryQuote queries the "quote" edge of the QuoteLineItem entity.
+func (qli *QuoteLineItem) QueryQuote() *QuoteQuery {
+	return NewQuoteLineItemClient(qli.config).QueryQuote(qli)
+}
+
+// Update returns a builder for updating this QuoteLineItem.
+// Note that you need to call QuoteLineItem.Unwrap() before calling this method if this QuoteLineItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qli *QuoteLineItem) Update() *QuoteLineItemUpdateOne {
+	return NewQuoteLineItemClient(qli.config).UpdateOne(qli)
+}
+
+// Unwrap unwraps the QuoteLineItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qli *QuoteLineItem) Unwrap() *QuoteLineItem {
+	_tx, ok := qli.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuoteLineItem is not a transactional entity")
+	}
+	qli.config.driver = _tx.drv
+	return qli
+}
+
+// String implements the fmt.Stringer.
+func (qli *QuoteLineItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuoteLineItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qli.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(qli.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(qli.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(qli.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(qli.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(qli.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(qli.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(qli.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", qli.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("quote_id=")
+	builder.WriteString(qli.QuoteID)
+	builder.WriteString(", ")
+	builder.WriteString("price_id=")
+	builder.WriteString(qli.PriceID)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(qli.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", qli.Quantity))
+	builder.WriteString(", ")
+	if v := qli.UnitAmount; v != nil {
+		builder.WriteString("unit_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_percentage=")
+	builder.WriteString(fmt.Sprintf("%v", qli.DiscountPercentage))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", qli.Amount))
+	builder.WriteString(", ")
+	if v := qli.PhaseIndex; v != nil {
+		builder.WriteString("phase_index=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(qli.Currency)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuoteLineItems is a parsable slice of QuoteLineItem.
+type QuoteLineItems []*QuoteLineItem
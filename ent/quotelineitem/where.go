@@ -0,0 +1,1110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quotelineitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// QuoteID applies equality check predicate on the "quote_id" field. It's identical to QuoteIDEQ.
+func QuoteID(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldQuoteID, v))
+}
+
+// PriceID applies equality check predicate on the "price_id" field. It's identical to PriceIDEQ.
+func PriceID(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldPriceID, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitAmount applies equality check predicate on the "unit_amount" field. It's identical to UnitAmountEQ.
+func UnitAmount(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUnitAmount, v))
+}
+
+// DiscountPercentage applies equality check predicate on the "discount_percentage" field. It's identical to DiscountPercentageEQ.
+func DiscountPercentage(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldDiscountPercentage, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// PhaseIndex applies equality check predicate on the "phase_index" field. It's identical to PhaseIndexEQ.
+func PhaseIndex(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldPhaseIndex, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldMetadata))
+}
+
+// QuoteIDEQ applies the EQ predicate on the "quote_id" field.
+func QuoteIDEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldQuoteID, v))
+}
+
+// QuoteIDNEQ applies the NEQ predicate on the "quote_id" field.
+func QuoteIDNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldQuoteID, v))
+}
+
+// QuoteIDIn applies the In predicate on the "quote_id" field.
+func QuoteIDIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldQuoteID, vs...))
+}
+
+// QuoteIDNotIn applies the NotIn predicate on the "quote_id" field.
+func QuoteIDNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldQuoteID, vs...))
+}
+
+// QuoteIDGT applies the GT predicate on the "quote_id" field.
+func QuoteIDGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldQuoteID, v))
+}
+
+// QuoteIDGTE applies the GTE predicate on the "quote_id" field.
+func QuoteIDGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldQuoteID, v))
+}
+
+// QuoteIDLT applies the LT predicate on the "quote_id" field.
+func QuoteIDLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldQuoteID, v))
+}
+
+// QuoteIDLTE applies the LTE predicate on the "quote_id" field.
+func QuoteIDLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldQuoteID, v))
+}
+
+// QuoteIDContains applies the Contains predicate on the "quote_id" field.
+func QuoteIDContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldQuoteID, v))
+}
+
+// QuoteIDHasPrefix applies the HasPrefix predicate on the "quote_id" field.
+func QuoteIDHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldQuoteID, v))
+}
+
+// QuoteIDHasSuffix applies the HasSuffix predicate on the "quote_id" field.
+func QuoteIDHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldQuoteID, v))
+}
+
+// QuoteIDEqualFold applies the EqualFold predicate on the "quote_id" field.
+func QuoteIDEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldQuoteID, v))
+}
+
+// QuoteIDContainsFold applies the ContainsFold predicate on the "quote_id" field.
+func QuoteIDContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldQuoteID, v))
+}
+
+// PriceIDEQ applies the EQ predicate on the "price_id" field.
+func PriceIDEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldPriceID, v))
+}
+
+// PriceIDNEQ applies the NEQ predicate on the "price_id" field.
+func PriceIDNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldPriceID, v))
+}
+
+// PriceIDIn applies the In predicate on the "price_id" field.
+func PriceIDIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldPriceID, vs...))
+}
+
+// PriceIDNotIn applies the NotIn predicate on the "price_id" field.
+func PriceIDNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldPriceID, vs...))
+}
+
+// PriceIDGT applies the GT predicate on the "price_id" field.
+func PriceIDGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldPriceID, v))
+}
+
+// PriceIDGTE applies the GTE predicate on the "price_id" field.
+func PriceIDGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldPriceID, v))
+}
+
+// PriceIDLT applies the LT predicate on the "price_id" field.
+func PriceIDLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldPriceID, v))
+}
+
+// PriceIDLTE applies the LTE predicate on the "price_id" field.
+func PriceIDLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldPriceID, v))
+}
+
+// PriceIDContains applies the Contains predicate on the "price_id" field.
+func PriceIDContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldPriceID, v))
+}
+
+// PriceIDHasPrefix applies the HasPrefix predicate on the "price_id" field.
+func PriceIDHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldPriceID, v))
+}
+
+// PriceIDHasSuffix applies the HasSuffix predicate on the "price_id" field.
+func PriceIDHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldPriceID, v))
+}
+
+// PriceIDEqualFold applies the EqualFold predicate on the "price_id" field.
+func PriceIDEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldPriceID, v))
+}
+
+// PriceIDContainsFold applies the ContainsFold predicate on the "price_id" field.
+func PriceIDContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldPriceID, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitAmountEQ applies the EQ predicate on the "unit_amount" field.
+func UnitAmountEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldUnitAmount, v))
+}
+
+// UnitAmountNEQ applies the NEQ predicate on the "unit_amount" field.
+func UnitAmountNEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldUnitAmount, v))
+}
+
+// UnitAmountIn applies the In predicate on the "unit_amount" field.
+func UnitAmountIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldUnitAmount, vs...))
+}
+
+// UnitAmountNotIn applies the NotIn predicate on the "unit_amount" field.
+func UnitAmountNotIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldUnitAmount, vs...))
+}
+
+// UnitAmountGT applies the GT predicate on the "unit_amount" field.
+func UnitAmountGT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldUnitAmount, v))
+}
+
+// UnitAmountGTE applies the GTE predicate on the "unit_amount" field.
+func UnitAmountGTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldUnitAmount, v))
+}
+
+// UnitAmountLT applies the LT predicate on the "unit_amount" field.
+func UnitAmountLT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldUnitAmount, v))
+}
+
+// UnitAmountLTE applies the LTE predicate on the "unit_amount" field.
+func UnitAmountLTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldUnitAmount, v))
+}
+
+// UnitAmountIsNil applies the IsNil predicate on the "unit_amount" field.
+func UnitAmountIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldUnitAmount))
+}
+
+// UnitAmountNotNil applies the NotNil predicate on the "unit_amount" field.
+func UnitAmountNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldUnitAmount))
+}
+
+// DiscountPercentageEQ applies the EQ predicate on the "discount_percentage" field.
+func DiscountPercentageEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldDiscountPercentage, v))
+}
+
+// DiscountPercentageNEQ applies the NEQ predicate on the "discount_percentage" field.
+func DiscountPercentageNEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldDiscountPercentage, v))
+}
+
+// DiscountPercentageIn applies the In predicate on the "discount_percentage" field.
+func DiscountPercentageIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldDiscountPercentage, vs...))
+}
+
+// DiscountPercentageNotIn applies the NotIn predicate on the "discount_percentage" field.
+func DiscountPercentageNotIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldDiscountPercentage, vs...))
+}
+
+// DiscountPercentageGT applies the GT predicate on the "discount_percentage" field.
+func DiscountPercentageGT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldDiscountPercentage, v))
+}
+
+// DiscountPercentageGTE applies the GTE predicate on the "discount_percentage" field.
+func DiscountPercentageGTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldDiscountPercentage, v))
+}
+
+// DiscountPercentageLT applies the LT predicate on the "discount_percentage" field.
+func DiscountPercentageLT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldDiscountPercentage, v))
+}
+
+// DiscountPercentageLTE applies the LTE predicate on the "discount_percentage" field.
+func DiscountPercentageLTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldDiscountPercentage, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldAmount, v))
+}
+
+// PhaseIndexEQ applies the EQ predicate on the "phase_index" field.
+func PhaseIndexEQ(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldPhaseIndex, v))
+}
+
+// PhaseIndexNEQ applies the NEQ predicate on the "phase_index" field.
+func PhaseIndexNEQ(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldPhaseIndex, v))
+}
+
+// PhaseIndexIn applies the In predicate on the "phase_index" field.
+func PhaseIndexIn(vs ...int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldPhaseIndex, vs...))
+}
+
+// PhaseIndexNotIn applies the NotIn predicate on the "phase_index" field.
+func PhaseIndexNotIn(vs ...int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldPhaseIndex, vs...))
+}
+
+// PhaseIndexGT applies the GT predicate on the "phase_index" field.
+func PhaseIndexGT(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldPhaseIndex, v))
+}
+
+// PhaseIndexGTE applies the GTE predicate on the "phase_index" field.
+func PhaseIndexGTE(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldPhaseIndex, v))
+}
+
+// PhaseIndexLT applies the LT predicate on the "phase_index" field.
+func PhaseIndexLT(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldPhaseIndex, v))
+}
+
+// PhaseIndexLTE applies the LTE predicate on the "phase_index" field.
+func PhaseIndexLTE(v int) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldPhaseIndex, v))
+}
+
+// PhaseIndexIsNil applies the IsNil predicate on the "phase_index" field.
+func PhaseIndexIsNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIsNull(FieldPhaseIndex))
+}
+
+// PhaseIndexNotNil applies the NotNil predicate on the "phase_index" field.
+func PhaseIndexNotNil() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotNull(FieldPhaseIndex))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// HasQuote applies the HasEdge predicate on the "quote" edge.
+func HasQuote() predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuoteTable, QuoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuoteWith applies the HasEdge predicate on the "quote" edge with a given conditions (other predicates).
+func HasQuoteWith(preds ...predicate.Quote) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(func(s *sql.Selector) {
+		step := newQuoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuoteLineItem) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuoteLineItem) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuoteLineItem) predicate.QuoteLineItem {
+	return predicate.QuoteLineItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteLineItemCreate is the builder for creating a QuoteLineItem entity.
+type QuoteLineItemCreate struct {
+	config
+	mutation *QuoteLineItemMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (qlic *QuoteLineItemCreate) SetTenantID(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetTenantID(s)
+	return qlic
+}
+
+// SetStatus sets the "status" field.
+func (qlic *QuoteLineItemCreate) SetStatus(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetStatus(s)
+	return qlic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableStatus(s *string) *QuoteLineItemCreate {
+	if s != nil {
+		qlic.SetStatus(*s)
+	}
+	return qlic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qlic *QuoteLineItemCreate) SetCreatedAt(t time.Time) *QuoteLineItemCreate {
+	qlic.mutation.SetCreatedAt(t)
+	return qlic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableCreatedAt(t *time.Time) *QuoteLineItemCreate {
+	if t != nil {
+		qlic.SetCreatedAt(*t)
+	}
+	return qlic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qlic *QuoteLineItemCreate) SetUpdatedAt(t time.Time) *QuoteLineItemCreate {
+	qlic.mutation.SetUpdatedAt(t)
+	return qlic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableUpdatedAt(t *time.Time) *QuoteLineItemCreate {
+	if t != nil {
+		qlic.SetUpdatedAt(*t)
+	}
+	return qlic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qlic *QuoteLineItemCreate) SetCreatedBy(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetCreatedBy(s)
+	return qlic
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableCreatedBy(s *string) *QuoteLineItemCreate {
+	if s != nil {
+		qlic.SetCreatedBy(*s)
+	}
+	return qlic
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qlic *QuoteLineItemCreate) SetUpdatedBy(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetUpdatedBy(s)
+	return qlic
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableUpdatedBy(s *string) *QuoteLineItemCreate {
+	if s != nil {
+		qlic.SetUpdatedBy(*s)
+	}
+	return qlic
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (qlic *QuoteLineItemCreate) SetEnvironmentID(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetEnvironmentID(s)
+	return qlic
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableEnvironmentID(s *string) *QuoteLineItemCreate {
+	if s != nil {
+		qlic.SetEnvironmentID(*s)
+	}
+	return qlic
+}
+
+// SetMetadata sets the "metadata" field.
+func (qlic *QuoteLineItemCreate) SetMetadata(m map[string]string) *QuoteLineItemCreate {
+	qlic.mutation.SetMetadata(m)
+	return qlic
+}
+
+// SetQuoteID sets the "quote_id" field.
+func (qlic *QuoteLineItemCreate) SetQuoteID(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetQuoteID(s)
+	return qlic
+}
+
+// SetPriceID sets the "price_id" field.
+func (qlic *QuoteLineItemCreate) SetPriceID(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetPriceID(s)
+	return qlic
+}
+
+// SetDisplayName sets the "display_name" field.
+func (qlic *QuoteLineItemCreate) SetDisplayName(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetDisplayName(s)
+	return qlic
+}
+
+// SetQuantity sets the "quantity" field.
+func (qlic *QuoteLineItemCreate) SetQuantity(d decimal.Decimal) *QuoteLineItemCreate {
+	qlic.mutation.SetQuantity(d)
+	return qlic
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableQuantity(d *decimal.Decimal) *QuoteLineItemCreate {
+	if d != nil {
+		qlic.SetQuantity(*d)
+	}
+	return qlic
+}
+
+// SetUnitAmount sets the "unit_amount" field.
+func (qlic *QuoteLineItemCreate) SetUnitAmount(d decimal.Decimal) *QuoteLineItemCreate {
+	qlic.mutation.SetUnitAmount(d)
+	return qlic
+}
+
+// SetNillableUnitAmount sets the "unit_amount" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableUnitAmount(d *decimal.Decimal) *QuoteLineItemCreate {
+	if d != nil {
+		qlic.SetUnitAmount(*d)
+	}
+	return qlic
+}
+
+// SetDiscountPercentage sets the "discount_percentage" field.
+func (qlic *QuoteLineItemCreate) SetDiscountPercentage(d decimal.Decimal) *QuoteLineItemCreate {
+	qlic.mutation.SetDiscountPercentage(d)
+	return qlic
+}
+
+// SetNillableDiscountPercentage sets the "discount_percentage" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableDiscountPercentage(d *decimal.Decimal) *QuoteLineItemCreate {
+	if d != nil {
+		qlic.SetDiscountPercentage(*d)
+	}
+	return qlic
+}
+
+// SetAmount sets the "amount" field.
+func (qlic *QuoteLineItemCreate) SetAmount(d decimal.Decimal) *QuoteLineItemCreate {
+	qlic.mutation.SetAmount(d)
+	return qlic
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillableAmount(d *decimal.Decimal) *QuoteLineItemCreate {
+	if d != nil {
+		qlic.SetAmount(*d)
+	}
+	return qlic
+}
+
+// SetPhaseIndex sets the "phase_index" field.
+func (qlic *QuoteLineItemCreate) SetPhaseIndex(i int) *QuoteLineItemCreate {
+	qlic.mutation.SetPhaseIndex(i)
+	return qlic
+}
+
+// SetNillablePhaseIndex sets the "phase_index" field if the given value is not nil.
+func (qlic *QuoteLineItemCreate) SetNillablePhaseIndex(i *int) *QuoteLineItemCreate {
+	if i != nil {
+		qlic.SetPhaseIndex(*i)
+	}
+	return qlic
+}
+
+// SetCurrency sets the "currency" field.
+func (qlic *QuoteLineItemCreate) SetCurrency(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetCurrency(s)
+	return qlic
+}
+
+// SetID sets the "id" field.
+func (qlic *QuoteLineItemCreate) SetID(s string) *QuoteLineItemCreate {
+	qlic.mutation.SetID(s)
+	return qlic
+}
+
+// SetQuote sets the "quote" edge to the Quote entity.
+func (qlic *QuoteLineItemCreate) SetQuote(q *Quote) *QuoteLineItemCreate {
+	return qlic.SetQuoteID(q.ID)
+}
+
+// Mutation returns the QuoteLineItemMutation object of the builder.
+func (qlic *QuoteLineItemCreate) Mutation() *QuoteLineItemMutation {
+	return qlic.mutation
+}
+
+// Save creates the QuoteLineItem in the database.
+func (qlic *QuoteLineItemCreate) Save(ctx context.Context) (*QuoteLineItem, error) {
+	qlic.defaults()
+	return withHooks(ctx, qlic.sqlSave, qlic.mutation, qlic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qlic *QuoteLineItemCreate) SaveX(ctx context.Context) *QuoteLineItem {
+	v, err := qlic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlic *QuoteLineItemCreate) Exec(ctx context.Context) error {
+	_, err := qlic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlic *QuoteLineItemCreate) ExecX(ctx context.Context) {
+	if err := qlic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qlic *QuoteLineItemCreate) defaults() {
+	if _, ok := qlic.mutation.Status(); !ok {
+		v := quotelineitem.DefaultStatus
+		qlic.mutation.SetStatus(v)
+	}
+	if _, ok := qlic.mutation.CreatedAt(); !ok {
+		v := quotelineitem.DefaultCreatedAt()
+		qlic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qlic.mutation.UpdatedAt(); !ok {
+		v := quotelineitem.DefaultUpdatedAt()
+		qlic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qlic.mutation.EnvironmentID(); !ok {
+		v := quotelineitem.DefaultEnvironmentID
+		qlic.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := qlic.mutation.Quantity(); !ok {
+		v := quotelineitem.DefaultQuantity
+		qlic.mutation.SetQuantity(v)
+	}
+	if _, ok := qlic.mutation.DiscountPercentage(); !ok {
+		v := quotelineitem.DefaultDiscountPercentage
+		qlic.mutation.SetDiscountPercentage(v)
+	}
+	if _, ok := qlic.mutation.Amount(); !ok {
+		v := quotelineitem.DefaultAmount
+		qlic.mutation.SetAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlic *QuoteLineItemCreate) check() error {
+	if _, ok := qlic.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "QuoteLineItem.tenant_id"`)}
+	}
+	if v, ok := qlic.mutation.TenantID(); ok {
+		if err := quotelineitem.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := qlic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QuoteLineItem.status"`)}
+	}
+	if _, ok := qlic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuoteLineItem.created_at"`)}
+	}
+	if _, ok := qlic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuoteLineItem.updated_at"`)}
+	}
+	if _, ok := qlic.mutation.QuoteID(); !ok {
+		return &ValidationError{Name: "quote_id", err: errors.New(`ent: missing required field "QuoteLineItem.quote_id"`)}
+	}
+	if v, ok := qlic.mutation.QuoteID(); ok {
+		if err := quotelineitem.QuoteIDValidator(v); err != nil {
+			return &ValidationError{Name: "quote_id", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.quote_id": %w`, err)}
+		}
+	}
+	if _, ok := qlic.mutation.PriceID(); !ok {
+		return &ValidationError{Name: "price_id", err: errors.New(`ent: missing required field "QuoteLineItem.price_id"`)}
+	}
+	if v, ok := qlic.mutation.PriceID(); ok {
+		if err := quotelineitem.PriceIDValidator(v); err != nil {
+			return &ValidationError{Name: "price_id", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.price_id": %w`, err)}
+		}
+	}
+	if _, ok := qlic.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "QuoteLineItem.display_name"`)}
+	}
+	if v, ok := qlic.mutation.DisplayName(); ok {
+		if err := quotelineitem.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.display_name": %w`, err)}
+		}
+	}
+	if _, ok := qlic.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "QuoteLineItem.quantity"`)}
+	}
+	if _, ok := qlic.mutation.DiscountPercentage(); !ok {
+		return &ValidationError{Name: "discount_percentage", err: errors.New(`ent: missing required field "QuoteLineItem.discount_percentage"`)}
+	}
+	if _, ok := qlic.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "QuoteLineItem.amount"`)}
+	}
+	if _, ok := qlic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "QuoteLineItem.currency"`)}
+	}
+	if v, ok := qlic.mutation.Currency(); ok {
+		if err := quotelineitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.currency": %w`, err)}
+		}
+	}
+	if len(qlic.mutation.QuoteIDs()) == 0 {
+		return &ValidationError{Name: "quote", err: errors.New(`ent: missing required edge "QuoteLineItem.quote"`)}
+	}
+	return nil
+}
+
+func (qlic *QuoteLineItemCreate) sqlSave(ctx context.Context) (*QuoteLineItem, error) {
+	if err := qlic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qlic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qlic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected QuoteLineItem.ID type: %T", _spec.ID.Value)
+		}
+	}
+	qlic.mutation.id = &_node.ID
+	qlic.mutation.done = true
+	return _node, nil
+}
+
+func (qlic *QuoteLineItemCreate) createSpec() (*QuoteLineItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuoteLineItem{config: qlic.config}
+		_spec = sqlgraph.NewCreateSpec(quotelineitem.Table, sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString))
+	)
+	if id, ok := qlic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qlic.mutation.TenantID(); ok {
+		_spec.SetField(quotelineitem.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := qlic.mutation.Status(); ok {
+		_spec.SetField(quotelineitem.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := qlic.mutation.CreatedAt(); ok {
+		_spec.SetField(quotelineitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qlic.mutation.UpdatedAt(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qlic.mutation.CreatedBy(); ok {
+		_spec.SetField(quotelineitem.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := qlic.mutation.UpdatedBy(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := qlic.mutation.EnvironmentID(); ok {
+		_spec.SetField(quotelineitem.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := qlic.mutation.Metadata(); ok {
+		_spec.SetField(quotelineitem.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := qlic.mutation.PriceID(); ok {
+		_spec.SetField(quotelineitem.FieldPriceID, field.TypeString, value)
+		_node.PriceID = value
+	}
+	if value, ok := qlic.mutation.DisplayName(); ok {
+		_spec.SetField(quotelineitem.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := qlic.mutation.Quantity(); ok {
+		_spec.SetField(quotelineitem.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := qlic.mutation.UnitAmount(); ok {
+		_spec.SetField(quotelineitem.FieldUnitAmount, field.TypeOther, value)
+		_node.UnitAmount = &value
+	}
+	if value, ok := qlic.mutation.DiscountPercentage(); ok {
+		_spec.SetField(quotelineitem.FieldDiscountPercentage, field.TypeOther, value)
+		_node.DiscountPercentage = value
+	}
+	if value, ok := qlic.mutation.Amount(); ok {
+		_spec.SetField(quotelineitem.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := qlic.mutation.PhaseIndex(); ok {
+		_spec.SetField(quotelineitem.FieldPhaseIndex, field.TypeInt, value)
+		_node.PhaseIndex = &value
+	}
+	if value, ok := qlic.mutation.Currency(); ok {
+		_spec.SetField(quotelineitem.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if nodes := qlic.mutation.QuoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quotelineitem.QuoteTable,
+			Columns: []string{quotelineitem.QuoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quote.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuoteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuoteLineItemCreateBulk is the builder for creating many QuoteLineItem entities in bulk.
+type QuoteLineItemCreateBulk struct {
+	config
+	err      error
+	builders []*QuoteLineItemCreate
+}
+
+// Save creates the QuoteLineItem entities in the database.
+func (qlicb *QuoteLineItemCreateBulk) Save(ctx context.Context) ([]*QuoteLineItem, error) {
+	if qlicb.err != nil {
+		return nil, qlicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qlicb.builders))
+	nodes := make([]*QuoteLineItem, len(qlicb.builders))
+	mutators := make([]Mutator, len(qlicb.builders))
+	for i := range qlicb.builders {
+		func(i int, root context.Context) {
+			builder := qlicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuoteLineItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qlicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qlicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qlicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlicb *QuoteLineItemCreateBulk) SaveX(ctx context.Context) []*QuoteLineItem {
+	v, err := qlicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlicb *QuoteLineItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := qlicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlicb *QuoteLineItemCreateBulk) ExecX(ctx context.Context) {
+	if err := qlicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
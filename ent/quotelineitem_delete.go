@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+)
+
+// QuoteLineItemDelete is the builder for deleting a QuoteLineItem entity.
+type QuoteLineItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuoteLineItemMutation
+}
+
+// Where appends a list predicates to the QuoteLineItemDelete builder.
+func (qlid *QuoteLineItemDelete) Where(ps ...predicate.QuoteLineItem) *QuoteLineItemDelete {
+	qlid.mutation.Where(ps...)
+	return qlid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qlid *QuoteLineItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qlid.sqlExec, qlid.mutation, qlid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlid *QuoteLineItemDelete) ExecX(ctx context.Context) int {
+	n, err := qlid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qlid *QuoteLineItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(quotelineitem.Table, sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString))
+	if ps := qlid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qlid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qlid.mutation.done = true
+	return affected, err
+}
+
+// QuoteLineItemDeleteOne is the builder for deleting a single QuoteLineItem entity.
+type QuoteLineItemDeleteOne struct {
+	qlid *QuoteLineItemDelete
+}
+
+// Where appends a list predicates to the QuoteLineItemDelete builder.
+func (qlido *QuoteLineItemDeleteOne) Where(ps ...predicate.QuoteLineItem) *QuoteLineItemDeleteOne {
+	qlido.qlid.mutation.Where(ps...)
+	return qlido
+}
+
+// Exec executes the deletion query.
+func (qlido *QuoteLineItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := qlido.qlid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{quotelineitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlido *QuoteLineItemDeleteOne) ExecX(ctx context.Context) {
+	if err := qlido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+)
+
+// QuoteLineItemQuery is the builder for querying QuoteLineItem entities.
+type QuoteLineItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []quotelineitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QuoteLineItem
+	withQuote  *QuoteQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuoteLineItemQuery builder.
+func (qliq *QuoteLineItemQuery) Where(ps ...predicate.QuoteLineItem) *QuoteLineItemQuery {
+	qliq.predicates = append(qliq.predicates, ps...)
+	return qliq
+}
+
+// Limit the number of records to be returned by this query.
+func (qliq *QuoteLineItemQuery) Limit(limit int) *QuoteLineItemQuery {
+	qliq.ctx.Limit = &limit
+	return qliq
+}
+
+// Offset to start from.
+func (qliq *QuoteLineItemQuery) Offset(offset int) *QuoteLineItemQuery {
+	qliq.ctx.Offset = &offset
+	return qliq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qliq *QuoteLineItemQuery) Unique(unique bool) *QuoteLineItemQuery {
+	qliq.ctx.Unique = &unique
+	return qliq
+}
+
+// Order specifies how the records should be ordered.
+func (qliq *QuoteLineItemQuery) Order(o ...quotelineitem.OrderOption) *QuoteLineItemQuery {
+	qliq.order = append(qliq.order, o...)
+	return qliq
+}
+
+// QueryQuote chains the current query on the "quote" edge.
+func (qliq *QuoteLineItemQuery) QueryQuote() *QuoteQuery {
+	query := (&QuoteClient{config: qliq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qliq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qliq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quotelineitem.Table, quotelineitem.FieldID, selector),
+			sqlgraph.To(quote.Table, quote.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quotelineitem.QuoteTable, quotelineitem.QuoteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qliq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuoteLineItem entity from the query.
+// Returns a *NotFoundError when no QuoteLineItem was found.
+func (qliq *QuoteLineItemQuery) First(ctx context.Context) (*QuoteLineItem, error) {
+	nodes, err := qliq.Limit(1).All(setContextOp(ctx, qliq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{quotelineitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) FirstX(ctx context.Context) *QuoteLineItem {
+	node, err := qliq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuoteLineItem ID from the query.
+// Returns a *NotFoundError when no QuoteLineItem ID was found.
+func (qliq *QuoteLineItemQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = qliq.Limit(1).IDs(setContextOp(ctx, qliq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{quotelineitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) FirstIDX(ctx context.Context) string {
+	id, err := qliq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuoteLineItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuoteLineItem entity is found.
+// Returns a *NotFoundError when no QuoteLineItem entities are found.
+func (qliq *QuoteLineItemQuery) Only(ctx context.Context) (*QuoteLineItem, error) {
+	nodes, err := qliq.Limit(2).All(setContextOp(ctx, qliq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{quotelineitem.Label}
+	default:
+		return nil, &NotSingularError{quotelineitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) OnlyX(ctx context.Context) *QuoteLineItem {
+	node, err := qliq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuoteLineItem ID in the query.
+// Returns a *NotSingularError when more than one QuoteLineItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qliq *QuoteLineItemQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = qliq.Limit(2).IDs(setContextOp(ctx, qliq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{quotelineitem.Label}
+	default:
+		err = &NotSingularError{quotelineitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) OnlyIDX(ctx context.Context) string {
+	id, err := qliq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuoteLineItems.
+func (qliq *QuoteLineItemQuery) All(ctx context.Context) ([]*QuoteLineItem, error) {
+	ctx = setContextOp(ctx, qliq.ctx, ent.OpQueryAll)
+	if err := qliq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuoteLineItem, *QuoteLineItemQuery]()
+	return withInterceptors[[]*QuoteLineItem](ctx, qliq, qr, qliq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) AllX(ctx context.Context) []*QuoteLineItem {
+	nodes, err := qliq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuoteLineItem IDs.
+func (qliq *QuoteLineItemQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if qliq.ctx.Unique == nil && qliq.path != nil {
+		qliq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qliq.ctx, ent.OpQueryIDs)
+	if err = qliq.Select(quotelineitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) IDsX(ctx context.Context) []string {
+	ids, err := qliq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qliq *QuoteLineItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qliq.ctx, ent.OpQueryCount)
+	if err := qliq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qliq, querierCount[*QuoteLineItemQuery](), qliq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) CountX(ctx context.Context) int {
+	count, err := qliq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qliq *QuoteLineItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qliq.ctx, ent.OpQueryExist)
+	switch _, err := qliq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qliq *QuoteLineItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := qliq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuoteLineItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qliq *QuoteLineItemQuery) Clone() *QuoteLineItemQuery {
+	if qliq == nil {
+		return nil
+	}
+	return &QuoteLineItemQuery{
+		config:     qliq.config,
+		ctx:        qliq.ctx.Clone(),
+		order:      append([]quotelineitem.OrderOption{}, qliq.order...),
+		inters:     append([]Interceptor{}, qliq.inters...),
+		predicates: append([]predicate.QuoteLineItem{}, qliq.predicates...),
+		withQuote:  qliq.withQuote.Clone(),
+		// clone intermediate query.
+		sql:  qliq.sql.Clone(),
+		path: qliq.path,
+	}
+}
+
+// WithQuote tells the query-builder to eager-load the nodes that are connected to
+// the "quote" edge. The optional arguments are used to configure the query builder of the edge.
+func (qliq *QuoteLineItemQuery) WithQuote(opts ...func(*QuoteQuery)) *QuoteLineItemQuery {
+	query := (&QuoteClient{config: qliq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qliq.withQuote = query
+	return qliq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuoteLineItem.Query().
+//		GroupBy(quotelineitem.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qliq *QuoteLineItemQuery) GroupBy(field string, fields ...string) *QuoteLineItemGroupBy {
+	qliq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuoteLineItemGroupBy{build: qliq}
+	grbuild.flds = &qliq.ctx.Fields
+	grbuild.label = quotelineitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.QuoteLineItem.Query().
+//		Select(quotelineitem.FieldTenantID).
+//		Scan(ctx, &v)
+func (qliq *QuoteLineItemQuery) Select(fields ...string) *QuoteLineItemSelect {
+	qliq.ctx.Fields = append(qliq.ctx.Fields, fields...)
+	sbuild := &QuoteLineItemSelect{QuoteLineItemQuery: qliq}
+	sbuild.label = quotelineitem.Label
+	sbuild.flds, sbuild.scan = &qliq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuoteLineItemSelect configured with the given aggregations.
+func (qliq *QuoteLineItemQuery) Aggregate(fns ...AggregateFunc) *QuoteLineItemSelect {
+	return qliq.Select().Aggregate(fns...)
+}
+
+func (qliq *QuoteLineItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qliq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qliq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qliq.ctx.Fields {
+		if !quotelineitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qliq.path != nil {
+		prev, err := qliq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qliq.sql = prev
+	}
+	return nil
+}
+
+func (qliq *QuoteLineItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuoteLineItem, error) {
+	var (
+		nodes       = []*QuoteLineItem{}
+		_spec       = qliq.querySpec()
+		loadedTypes = [1]bool{
+			qliq.withQuote != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuoteLineItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuoteLineItem{config: qliq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qliq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qliq.withQuote; query != nil {
+		if err := qliq.loadQuote(ctx, query, nodes, nil,
+			func(n *QuoteLineItem, e *Quote) { n.Edges.Quote = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qliq *QuoteLineItemQuery) loadQuote(ctx context.Context, query *QuoteQuery, nodes []*QuoteLineItem, init func(*QuoteLineItem), assign func(*QuoteLineItem, *Quote)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*QuoteLineItem)
+	for i := range nodes {
+		fk := nodes[i].QuoteID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(quote.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "quote_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (qliq *QuoteLineItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qliq.querySpec()
+	_spec.Node.Columns = qliq.ctx.Fields
+	if len(qliq.ctx.Fields) > 0 {
+		_spec.Unique = qliq.ctx.Unique != nil && *qliq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qliq.driver, _spec)
+}
+
+func (qliq *QuoteLineItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(quotelineitem.Table, quotelineitem.Columns, sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString))
+	_spec.From = qliq.sql
+	if unique := qliq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qliq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qliq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quotelineitem.FieldID)
+		for i := range fields {
+			if fields[i] != quotelineitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if qliq.withQuote != nil {
+			_spec.Node.AddColumnOnce(quotelineitem.FieldQuoteID)
+		}
+	}
+	if ps := qliq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qliq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qliq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qliq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qliq *QuoteLineItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qliq.driver.Dialect())
+	t1 := builder.Table(quotelineitem.Table)
+	columns := qliq.ctx.Fields
+	if len(columns) == 0 {
+		columns = quotelineitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qliq.sql != nil {
+		selector = qliq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qliq.ctx.Unique != nil && *qliq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range qliq.predicates {
+		p(selector)
+	}
+	for _, p := range qliq.order {
+		p(selector)
+	}
+	if offset := qliq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qliq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QuoteLineItemGroupBy is the group-by builder for QuoteLineItem entities.
+type QuoteLineItemGroupBy struct {
+	selector
+	build *QuoteLineItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qligb *QuoteLineItemGroupBy) Aggregate(fns ...AggregateFunc) *QuoteLineItemGroupBy {
+	qligb.fns = append(qligb.fns, fns...)
+	return qligb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qligb *QuoteLineItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qligb.build.ctx, ent.OpQueryGroupBy)
+	if err := qligb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuoteLineItemQuery, *QuoteLineItemGroupBy](ctx, qligb.build, qligb, qligb.build.inters, v)
+}
+
+func (qligb *QuoteLineItemGroupBy) sqlScan(ctx context.Context, root *QuoteLineItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qligb.fns))
+	for _, fn := range qligb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qligb.flds)+len(qligb.fns))
+		for _, f := range *qligb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qligb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qligb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuoteLineItemSelect is the builder for selecting fields of QuoteLineItem entities.
+type QuoteLineItemSelect struct {
+	*QuoteLineItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qlis *QuoteLineItemSelect) Aggregate(fns ...AggregateFunc) *QuoteLineItemSelect {
+	qlis.fns = append(qlis.fns, fns...)
+	return qlis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qlis *QuoteLineItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qlis.ctx, ent.OpQuerySelect)
+	if err := qlis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuoteLineItemQuery, *QuoteLineItemSelect](ctx, qlis.QuoteLineItemQuery, qlis, qlis.inters, v)
+}
+
+func (qlis *QuoteLineItemSelect) sqlScan(ctx context.Context, root *QuoteLineItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qlis.fns))
+	for _, fn := range qlis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qlis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qlis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteLineItemUpdate is the builder for updating QuoteLineItem entities.
+type QuoteLineItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuoteLineItemMutation
+}
+
+// Where appends a list predicates to the QuoteLineItemUpdate builder.
+func (qliu *QuoteLineItemUpdate) Where(ps ...predicate.QuoteLineItem) *QuoteLineItemUpdate {
+	qliu.mutation.Where(ps...)
+	return qliu
+}
+
+// SetStatus sets the "status" field.
+func (qliu *QuoteLineItemUpdate) SetStatus(s string) *QuoteLineItemUpdate {
+	qliu.mutation.SetStatus(s)
+	return qliu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableStatus(s *string) *QuoteLineItemUpdate {
+	if s != nil {
+		qliu.SetStatus(*s)
+	}
+	return qliu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qliu *QuoteLineItemUpdate) SetUpdatedAt(t time.Time) *QuoteLineItemUpdate {
+	qliu.mutation.SetUpdatedAt(t)
+	return qliu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qliu *QuoteLineItemUpdate) SetUpdatedBy(s string) *QuoteLineItemUpdate {
+	qliu.mutation.SetUpdatedBy(s)
+	return qliu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableUpdatedBy(s *string) *QuoteLineItemUpdate {
+	if s != nil {
+		qliu.SetUpdatedBy(*s)
+	}
+	return qliu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qliu *QuoteLineItemUpdate) ClearUpdatedBy() *QuoteLineItemUpdate {
+	qliu.mutation.ClearUpdatedBy()
+	return qliu
+}
+
+// SetMetadata sets the "metadata" field.
+func (qliu *QuoteLineItemUpdate) SetMetadata(m map[string]string) *QuoteLineItemUpdate {
+	qliu.mutation.SetMetadata(m)
+	return qliu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (qliu *QuoteLineItemUpdate) ClearMetadata() *QuoteLineItemUpdate {
+	qliu.mutation.ClearMetadata()
+	return qliu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (qliu *QuoteLineItemUpdate) SetDisplayName(s string) *QuoteLineItemUpdate {
+	qliu.mutation.SetDisplayName(s)
+	return qliu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableDisplayName(s *string) *QuoteLineItemUpdate {
+	if s != nil {
+		qliu.SetDisplayName(*s)
+	}
+	return qliu
+}
+
+// SetQuantity sets the "quantity" field.
+func (qliu *QuoteLineItemUpdate) SetQuantity(d decimal.Decimal) *QuoteLineItemUpdate {
+	qliu.mutation.SetQuantity(d)
+	return qliu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableQuantity(d *decimal.Decimal) *QuoteLineItemUpdate {
+	if d != nil {
+		qliu.SetQuantity(*d)
+	}
+	return qliu
+}
+
+// SetUnitAmount sets the "unit_amount" field.
+func (qliu *QuoteLineItemUpdate) SetUnitAmount(d decimal.Decimal) *QuoteLineItemUpdate {
+	qliu.mutation.SetUnitAmount(d)
+	return qliu
+}
+
+// SetNillableUnitAmount sets the "unit_amount" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableUnitAmount(d *decimal.Decimal) *QuoteLineItemUpdate {
+	if d != nil {
+		qliu.SetUnitAmount(*d)
+	}
+	return qliu
+}
+
+// ClearUnitAmount clears the value of the "unit_amount" field.
+func (qliu *QuoteLineItemUpdate) ClearUnitAmount() *QuoteLineItemUpdate {
+	qliu.mutation.ClearUnitAmount()
+	return qliu
+}
+
+// SetDiscountPercentage sets the "discount_percentage" field.
+func (qliu *QuoteLineItemUpdate) SetDiscountPercentage(d decimal.Decimal) *QuoteLineItemUpdate {
+	qliu.mutation.SetDiscountPercentage(d)
+	return qliu
+}
+
+// SetNillableDiscountPercentage sets the "discount_percentage" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableDiscountPercentage(d *decimal.Decimal) *QuoteLineItemUpdate {
+	if d != nil {
+		qliu.SetDiscountPercentage(*d)
+	}
+	return qliu
+}
+
+// SetAmount sets the "amount" field.
+func (qliu *QuoteLineItemUpdate) SetAmount(d decimal.Decimal) *QuoteLineItemUpdate {
+	qliu.mutation.SetAmount(d)
+	return qliu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableAmount(d *decimal.Decimal) *QuoteLineItemUpdate {
+	if d != nil {
+		qliu.SetAmount(*d)
+	}
+	return qliu
+}
+
+// SetPhaseIndex sets the "phase_index" field.
+func (qliu *QuoteLineItemUpdate) SetPhaseIndex(i int) *QuoteLineItemUpdate {
+	qliu.mutation.ResetPhaseIndex()
+	qliu.mutation.SetPhaseIndex(i)
+	return qliu
+}
+
+// SetNillablePhaseIndex sets the "phase_index" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillablePhaseIndex(i *int) *QuoteLineItemUpdate {
+	if i != nil {
+		qliu.SetPhaseIndex(*i)
+	}
+	return qliu
+}
+
+// AddPhaseIndex adds i to the "phase_index" field.
+func (qliu *QuoteLineItemUpdate) AddPhaseIndex(i int) *QuoteLineItemUpdate {
+	qliu.mutation.AddPhaseIndex(i)
+	return qliu
+}
+
+// ClearPhaseIndex clears the value of the "phase_index" field.
+func (qliu *QuoteLineItemUpdate) ClearPhaseIndex() *QuoteLineItemUpdate {
+	qliu.mutation.ClearPhaseIndex()
+	return qliu
+}
+
+// SetCurrency sets the "currency" field.
+func (qliu *QuoteLineItemUpdate) SetCurrency(s string) *QuoteLineItemUpdate {
+	qliu.mutation.SetCurrency(s)
+	return qliu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (qliu *QuoteLineItemUpdate) SetNillableCurrency(s *string) *QuoteLineItemUpdate {
+	if s != nil {
+		qliu.SetCurrency(*s)
+	}
+	return qliu
+}
+
+// Mutation returns the QuoteLineItemMutation object of the builder.
+func (qliu *QuoteLineItemUpdate) Mutation() *QuoteLineItemMutation {
+	return qliu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qliu *QuoteLineItemUpdate) Save(ctx context.Context) (int, error) {
+	qliu.defaults()
+	return withHooks(ctx, qliu.sqlSave, qliu.mutation, qliu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qliu *QuoteLineItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := qliu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qliu *QuoteLineItemUpdate) Exec(ctx context.Context) error {
+	_, err := qliu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qliu *QuoteLineItemUpdate) ExecX(ctx context.Context) {
+	if err := qliu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qliu *QuoteLineItemUpdate) defaults() {
+	if _, ok := qliu.mutation.UpdatedAt(); !ok {
+		v := quotelineitem.UpdateDefaultUpdatedAt()
+		qliu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qliu *QuoteLineItemUpdate) check() error {
+	if v, ok := qliu.mutation.DisplayName(); ok {
+		if err := quotelineitem.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.display_name": %w`, err)}
+		}
+	}
+	if v, ok := qliu.mutation.Currency(); ok {
+		if err := quotelineitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.currency": %w`, err)}
+		}
+	}
+	if qliu.mutation.QuoteCleared() && len(qliu.mutation.QuoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuoteLineItem.quote"`)
+	}
+	return nil
+}
+
+func (qliu *QuoteLineItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qliu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quotelineitem.Table, quotelineitem.Columns, sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString))
+	if ps := qliu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qliu.mutation.Status(); ok {
+		_spec.SetField(quotelineitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qliu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qliu.mutation.CreatedByCleared() {
+		_spec.ClearField(quotelineitem.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qliu.mutation.UpdatedBy(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qliu.mutation.UpdatedByCleared() {
+		_spec.ClearField(quotelineitem.FieldUpdatedBy, field.TypeString)
+	}
+	if qliu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(quotelineitem.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := qliu.mutation.Metadata(); ok {
+		_spec.SetField(quotelineitem.FieldMetadata, field.TypeJSON, value)
+	}
+	if qliu.mutation.MetadataCleared() {
+		_spec.ClearField(quotelineitem.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := qliu.mutation.DisplayName(); ok {
+		_spec.SetField(quotelineitem.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := qliu.mutation.Quantity(); ok {
+		_spec.SetField(quotelineitem.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := qliu.mutation.UnitAmount(); ok {
+		_spec.SetField(quotelineitem.FieldUnitAmount, field.TypeOther, value)
+	}
+	if qliu.mutation.UnitAmountCleared() {
+		_spec.ClearField(quotelineitem.FieldUnitAmount, field.TypeOther)
+	}
+	if value, ok := qliu.mutation.DiscountPercentage(); ok {
+		_spec.SetField(quotelineitem.FieldDiscountPercentage, field.TypeOther, value)
+	}
+	if value, ok := qliu.mutation.Amount(); ok {
+		_spec.SetField(quotelineitem.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := qliu.mutation.PhaseIndex(); ok {
+		_spec.SetField(quotelineitem.FieldPhaseIndex, field.TypeInt, value)
+	}
+	if value, ok := qliu.mutation.AddedPhaseIndex(); ok {
+		_spec.AddField(quotelineitem.FieldPhaseIndex, field.TypeInt, value)
+	}
+	if qliu.mutation.PhaseIndexCleared() {
+		_spec.ClearField(quotelineitem.FieldPhaseIndex, field.TypeInt)
+	}
+	if value, ok := qliu.mutation.Currency(); ok {
+		_spec.SetField(quotelineitem.FieldCurrency, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qliu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quotelineitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qliu.mutation.done = true
+	return n, nil
+}
+
+// QuoteLineItemUpdateOne is the builder for updating a single QuoteLineItem entity.
+type QuoteLineItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuoteLineItemMutation
+}
+
+// SetStatus sets the "status" field.
+func (qliuo *QuoteLineItemUpdateOne) SetStatus(s string) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetStatus(s)
+	return qliuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableStatus(s *string) *QuoteLineItemUpdateOne {
+	if s != nil {
+		qliuo.SetStatus(*s)
+	}
+	return qliuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qliuo *QuoteLineItemUpdateOne) SetUpdatedAt(t time.Time) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetUpdatedAt(t)
+	return qliuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (qliuo *QuoteLineItemUpdateOne) SetUpdatedBy(s string) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetUpdatedBy(s)
+	return qliuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableUpdatedBy(s *string) *QuoteLineItemUpdateOne {
+	if s != nil {
+		qliuo.SetUpdatedBy(*s)
+	}
+	return qliuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (qliuo *QuoteLineItemUpdateOne) ClearUpdatedBy() *QuoteLineItemUpdateOne {
+	qliuo.mutation.ClearUpdatedBy()
+	return qliuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (qliuo *QuoteLineItemUpdateOne) SetMetadata(m map[string]string) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetMetadata(m)
+	return qliuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (qliuo *QuoteLineItemUpdateOne) ClearMetadata() *QuoteLineItemUpdateOne {
+	qliuo.mutation.ClearMetadata()
+	return qliuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (qliuo *QuoteLineItemUpdateOne) SetDisplayName(s string) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetDisplayName(s)
+	return qliuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableDisplayName(s *string) *QuoteLineItemUpdateOne {
+	if s != nil {
+		qliuo.SetDisplayName(*s)
+	}
+	return qliuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (qliuo *QuoteLineItemUpdateOne) SetQuantity(d decimal.Decimal) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetQuantity(d)
+	return qliuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableQuantity(d *decimal.Decimal) *QuoteLineItemUpdateOne {
+	if d != nil {
+		qliuo.SetQuantity(*d)
+	}
+	return qliuo
+}
+
+// SetUnitAmount sets the "unit_amount" field.
+func (qliuo *QuoteLineItemUpdateOne) SetUnitAmount(d decimal.Decimal) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetUnitAmount(d)
+	return qliuo
+}
+
+// SetNillableUnitAmount sets the "unit_amount" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableUnitAmount(d *decimal.Decimal) *QuoteLineItemUpdateOne {
+	if d != nil {
+		qliuo.SetUnitAmount(*d)
+	}
+	return qliuo
+}
+
+// ClearUnitAmount clears the value of the "unit_amount" field.
+func (qliuo *QuoteLineItemUpdateOne) ClearUnitAmount() *QuoteLineItemUpdateOne {
+	qliuo.mutation.ClearUnitAmount()
+	return qliuo
+}
+
+// SetDiscountPercentage sets the "discount_percentage" field.
+func (qliuo *QuoteLineItemUpdateOne) SetDiscountPercentage(d decimal.Decimal) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetDiscountPercentage(d)
+	return qliuo
+}
+
+// SetNillableDiscountPercentage sets the "discount_percentage" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableDiscountPercentage(d *decimal.Decimal) *QuoteLineItemUpdateOne {
+	if d != nil {
+		qliuo.SetDiscountPercentage(*d)
+	}
+	return qliuo
+}
+
+// SetAmount sets the "amount" field.
+func (qliuo *QuoteLineItemUpdateOne) SetAmount(d decimal.Decimal) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetAmount(d)
+	return qliuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableAmount(d *decimal.Decimal) *QuoteLineItemUpdateOne {
+	if d != nil {
+		qliuo.SetAmount(*d)
+	}
+	return qliuo
+}
+
+// SetPhaseIndex sets the "phase_index" field.
+func (qliuo *QuoteLineItemUpdateOne) SetPhaseIndex(i int) *QuoteLineItemUpdateOne {
+	qliuo.mutation.ResetPhaseIndex()
+	qliuo.mutation.SetPhaseIndex(i)
+	return qliuo
+}
+
+// SetNillablePhaseIndex sets the "phase_index" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillablePhaseIndex(i *int) *QuoteLineItemUpdateOne {
+	if i != nil {
+		qliuo.SetPhaseIndex(*i)
+	}
+	return qliuo
+}
+
+// AddPhaseIndex adds i to the "phase_index" field.
+func (qliuo *QuoteLineItemUpdateOne) AddPhaseIndex(i int) *QuoteLineItemUpdateOne {
+	qliuo.mutation.AddPhaseIndex(i)
+	return qliuo
+}
+
+// ClearPhaseIndex clears the value of the "phase_index" field.
+func (qliuo *QuoteLineItemUpdateOne) ClearPhaseIndex() *QuoteLineItemUpdateOne {
+	qliuo.mutation.ClearPhaseIndex()
+	return qliuo
+}
+
+// SetCurrency sets the "currency" field.
+func (qliuo *QuoteLineItemUpdateOne) SetCurrency(s string) *QuoteLineItemUpdateOne {
+	qliuo.mutation.SetCurrency(s)
+	return qliuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (qliuo *QuoteLineItemUpdateOne) SetNillableCurrency(s *string) *QuoteLineItemUpdateOne {
+	if s != nil {
+		qliuo.SetCurrency(*s)
+	}
+	return qliuo
+}
+
+// Mutation returns the QuoteLineItemMutation object of the builder.
+func (qliuo *QuoteLineItemUpdateOne) Mutation() *QuoteLineItemMutation {
+	return qliuo.mutation
+}
+
+// Where appends a list predicates to the QuoteLineItemUpdate builder.
+func (qliuo *QuoteLineItemUpdateOne) Where(ps ...predicate.QuoteLineItem) *QuoteLineItemUpdateOne {
+	qliuo.mutation.Where(ps...)
+	return qliuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qliuo *QuoteLineItemUpdateOne) Select(field string, fields ...string) *QuoteLineItemUpdateOne {
+	qliuo.fields = append([]string{field}, fields...)
+	return qliuo
+}
+
+// Save executes the query and returns the updated QuoteLineItem entity.
+func (qliuo *QuoteLineItemUpdateOne) Save(ctx context.Context) (*QuoteLineItem, error) {
+	qliuo.defaults()
+	return withHooks(ctx, qliuo.sqlSave, qliuo.mutation, qliuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qliuo *QuoteLineItemUpdateOne) SaveX(ctx context.Context) *QuoteLineItem {
+	node, err := qliuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qliuo *QuoteLineItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := qliuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qliuo *QuoteLineItemUpdateOne) ExecX(ctx context.Context) {
+	if err := qliuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qliuo *QuoteLineItemUpdateOne) defaults() {
+	if _, ok := qliuo.mutation.UpdatedAt(); !ok {
+		v := quotelineitem.UpdateDefaultUpdatedAt()
+		qliuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qliuo *QuoteLineItemUpdateOne) check() error {
+	if v, ok := qliuo.mutation.DisplayName(); ok {
+		if err := quotelineitem.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.display_name": %w`, err)}
+		}
+	}
+	if v, ok := qliuo.mutation.Currency(); ok {
+		if err := quotelineitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "QuoteLineItem.currency": %w`, err)}
+		}
+	}
+	if qliuo.mutation.QuoteCleared() && len(qliuo.mutation.QuoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuoteLineItem.quote"`)
+	}
+	return nil
+}
+
+func (qliuo *QuoteLineItemUpdateOne) sqlSave(ctx context.Context) (_node *QuoteLineItem, err error) {
+	if err := qliuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quotelineitem.Table, quotelineitem.Columns, sqlgraph.NewFieldSpec(quotelineitem.FieldID, field.TypeString))
+	id, ok := qliuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuoteLineItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qliuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quotelineitem.FieldID)
+		for _, f := range fields {
+			if !quotelineitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quotelineitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qliuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qliuo.mutation.Status(); ok {
+		_spec.SetField(quotelineitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qliuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qliuo.mutation.CreatedByCleared() {
+		_spec.ClearField(quotelineitem.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := qliuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(quotelineitem.FieldUpdatedBy, field.TypeString, value)
+	}
+	if qliuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(quotelineitem.FieldUpdatedBy, field.TypeString)
+	}
+	if qliuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(quotelineitem.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := qliuo.mutation.Metadata(); ok {
+		_spec.SetField(quotelineitem.FieldMetadata, field.TypeJSON, value)
+	}
+	if qliuo.mutation.MetadataCleared() {
+		_spec.ClearField(quotelineitem.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := qliuo.mutation.DisplayName(); ok {
+		_spec.SetField(quotelineitem.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := qliuo.mutation.Quantity(); ok {
+		_spec.SetField(quotelineitem.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := qliuo.mutation.UnitAmount(); ok {
+		_spec.SetField(quotelineitem.FieldUnitAmount, field.TypeOther, value)
+	}
+	if qliuo.mutation.UnitAmountCleared() {
+		_spec.ClearField(quotelineitem.FieldUnitAmount, field.TypeOther)
+	}
+	if value, ok := qliuo.mutation.DiscountPercentage(); ok {
+		_spec.SetField(quotelineitem.FieldDiscountPercentage, field.TypeOther, value)
+	}
+	if value, ok := qliuo.mutation.Amount(); ok {
+		_spec.SetField(quotelineitem.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := qliuo.mutation.PhaseIndex(); ok {
+		_spec.SetField(quotelineitem.FieldPhaseIndex, field.TypeInt, value)
+	}
+	if value, ok := qliuo.mutation.AddedPhaseIndex(); ok {
+		_spec.AddField(quotelineitem.FieldPhaseIndex, field.TypeInt, value)
+	}
+	if qliuo.mutation.PhaseIndexCleared() {
+		_spec.ClearField(quotelineitem.FieldPhaseIndex, field.TypeInt)
+	}
+	if value, ok := qliuo.mutation.Currency(); ok {
+		_spec.SetField(quotelineitem.FieldCurrency, field.TypeString, value)
+	}
+	_node = &QuoteLineItem{config: qliuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qliuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quotelineitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qliuo.mutation.done = true
+	return _node, nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/flexprice/flexprice/ent/entitlement"
 	"github.com/flexprice/flexprice/ent/entityintegrationmapping"
 	"github.com/flexprice/flexprice/ent/environment"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
 	"github.com/flexprice/flexprice/ent/feature"
 	"github.com/flexprice/flexprice/ent/group"
 	"github.com/flexprice/flexprice/ent/invoice"
@@ -34,12 +36,15 @@ import (
 	"github.com/flexprice/flexprice/ent/plan"
 	"github.com/flexprice/flexprice/ent/price"
 	"github.com/flexprice/flexprice/ent/priceunit"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
 	"github.com/flexprice/flexprice/ent/scheduledtask"
 	"github.com/flexprice/flexprice/ent/schema"
 	"github.com/flexprice/flexprice/ent/secret"
 	"github.com/flexprice/flexprice/ent/settings"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionlineitem"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
 	"github.com/flexprice/flexprice/ent/subscriptionpause"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
 	"github.com/flexprice/flexprice/ent/subscriptionschedule"
@@ -146,8 +151,16 @@ func init() {
 	addonassociationDescStartDate := addonassociationFields[4].Descriptor()
 	// addonassociation.DefaultStartDate holds the default value on creation for the start_date field.
 	addonassociation.DefaultStartDate = addonassociationDescStartDate.Default.(func() time.Time)
+	// addonassociationDescQuantity is the schema descriptor for quantity field.
+	addonassociationDescQuantity := addonassociationFields[6].Descriptor()
+	// addonassociation.DefaultQuantity holds the default value on creation for the quantity field.
+	addonassociation.DefaultQuantity = addonassociationDescQuantity.Default.(decimal.Decimal)
+	// addonassociationDescBillingPeriodCount is the schema descriptor for billing_period_count field.
+	addonassociationDescBillingPeriodCount := addonassociationFields[8].Descriptor()
+	// addonassociation.DefaultBillingPeriodCount holds the default value on creation for the billing_period_count field.
+	addonassociation.DefaultBillingPeriodCount = addonassociationDescBillingPeriodCount.Default.(int)
 	// addonassociationDescAddonStatus is the schema descriptor for addon_status field.
-	addonassociationDescAddonStatus := addonassociationFields[6].Descriptor()
+	addonassociationDescAddonStatus := addonassociationFields[10].Descriptor()
 	// addonassociation.DefaultAddonStatus holds the default value on creation for the addon_status field.
 	addonassociation.DefaultAddonStatus = addonassociationDescAddonStatus.Default.(string)
 	// addonassociation.AddonStatusValidator is a validator for the "addon_status" field. It is called by the builders before save.
@@ -840,6 +853,92 @@ func init() {
 	environmentDescType := environmentFields[2].Descriptor()
 	// environment.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	environment.TypeValidator = environmentDescType.Validators[0].(func(string) error)
+	experimentMixin := schema.Experiment{}.Mixin()
+	experimentMixinFields0 := experimentMixin[0].Fields()
+	_ = experimentMixinFields0
+	experimentMixinFields1 := experimentMixin[1].Fields()
+	_ = experimentMixinFields1
+	experimentFields := schema.Experiment{}.Fields()
+	_ = experimentFields
+	// experimentDescTenantID is the schema descriptor for tenant_id field.
+	experimentDescTenantID := experimentMixinFields0[0].Descriptor()
+	// experiment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	experiment.TenantIDValidator = experimentDescTenantID.Validators[0].(func(string) error)
+	// experimentDescStatus is the schema descriptor for status field.
+	experimentDescStatus := experimentMixinFields0[1].Descriptor()
+	// experiment.DefaultStatus holds the default value on creation for the status field.
+	experiment.DefaultStatus = experimentDescStatus.Default.(string)
+	// experimentDescCreatedAt is the schema descriptor for created_at field.
+	experimentDescCreatedAt := experimentMixinFields0[2].Descriptor()
+	// experiment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	experiment.DefaultCreatedAt = experimentDescCreatedAt.Default.(func() time.Time)
+	// experimentDescUpdatedAt is the schema descriptor for updated_at field.
+	experimentDescUpdatedAt := experimentMixinFields0[3].Descriptor()
+	// experiment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	experiment.DefaultUpdatedAt = experimentDescUpdatedAt.Default.(func() time.Time)
+	// experiment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	experiment.UpdateDefaultUpdatedAt = experimentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// experimentDescEnvironmentID is the schema descriptor for environment_id field.
+	experimentDescEnvironmentID := experimentMixinFields1[0].Descriptor()
+	// experiment.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	experiment.DefaultEnvironmentID = experimentDescEnvironmentID.Default.(string)
+	// experimentDescName is the schema descriptor for name field.
+	experimentDescName := experimentFields[1].Descriptor()
+	// experiment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	experiment.NameValidator = experimentDescName.Validators[0].(func(string) error)
+	// experimentDescPlanID is the schema descriptor for plan_id field.
+	experimentDescPlanID := experimentFields[3].Descriptor()
+	// experiment.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	experiment.PlanIDValidator = experimentDescPlanID.Validators[0].(func(string) error)
+	// experimentDescExperimentStatus is the schema descriptor for experiment_status field.
+	experimentDescExperimentStatus := experimentFields[4].Descriptor()
+	// experiment.DefaultExperimentStatus holds the default value on creation for the experiment_status field.
+	experiment.DefaultExperimentStatus = types.ExperimentStatus(experimentDescExperimentStatus.Default.(string))
+	experimentassignmentMixin := schema.ExperimentAssignment{}.Mixin()
+	experimentassignmentMixinFields0 := experimentassignmentMixin[0].Fields()
+	_ = experimentassignmentMixinFields0
+	experimentassignmentMixinFields1 := experimentassignmentMixin[1].Fields()
+	_ = experimentassignmentMixinFields1
+	experimentassignmentFields := schema.ExperimentAssignment{}.Fields()
+	_ = experimentassignmentFields
+	// experimentassignmentDescTenantID is the schema descriptor for tenant_id field.
+	experimentassignmentDescTenantID := experimentassignmentMixinFields0[0].Descriptor()
+	// experimentassignment.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	experimentassignment.TenantIDValidator = experimentassignmentDescTenantID.Validators[0].(func(string) error)
+	// experimentassignmentDescStatus is the schema descriptor for status field.
+	experimentassignmentDescStatus := experimentassignmentMixinFields0[1].Descriptor()
+	// experimentassignment.DefaultStatus holds the default value on creation for the status field.
+	experimentassignment.DefaultStatus = experimentassignmentDescStatus.Default.(string)
+	// experimentassignmentDescCreatedAt is the schema descriptor for created_at field.
+	experimentassignmentDescCreatedAt := experimentassignmentMixinFields0[2].Descriptor()
+	// experimentassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	experimentassignment.DefaultCreatedAt = experimentassignmentDescCreatedAt.Default.(func() time.Time)
+	// experimentassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	experimentassignmentDescUpdatedAt := experimentassignmentMixinFields0[3].Descriptor()
+	// experimentassignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	experimentassignment.DefaultUpdatedAt = experimentassignmentDescUpdatedAt.Default.(func() time.Time)
+	// experimentassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	experimentassignment.UpdateDefaultUpdatedAt = experimentassignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// experimentassignmentDescEnvironmentID is the schema descriptor for environment_id field.
+	experimentassignmentDescEnvironmentID := experimentassignmentMixinFields1[0].Descriptor()
+	// experimentassignment.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	experimentassignment.DefaultEnvironmentID = experimentassignmentDescEnvironmentID.Default.(string)
+	// experimentassignmentDescExperimentID is the schema descriptor for experiment_id field.
+	experimentassignmentDescExperimentID := experimentassignmentFields[1].Descriptor()
+	// experimentassignment.ExperimentIDValidator is a validator for the "experiment_id" field. It is called by the builders before save.
+	experimentassignment.ExperimentIDValidator = experimentassignmentDescExperimentID.Validators[0].(func(string) error)
+	// experimentassignmentDescCustomerID is the schema descriptor for customer_id field.
+	experimentassignmentDescCustomerID := experimentassignmentFields[2].Descriptor()
+	// experimentassignment.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	experimentassignment.CustomerIDValidator = experimentassignmentDescCustomerID.Validators[0].(func(string) error)
+	// experimentassignmentDescVariantKey is the schema descriptor for variant_key field.
+	experimentassignmentDescVariantKey := experimentassignmentFields[3].Descriptor()
+	// experimentassignment.VariantKeyValidator is a validator for the "variant_key" field. It is called by the builders before save.
+	experimentassignment.VariantKeyValidator = experimentassignmentDescVariantKey.Validators[0].(func(string) error)
+	// experimentassignmentDescPlanID is the schema descriptor for plan_id field.
+	experimentassignmentDescPlanID := experimentassignmentFields[4].Descriptor()
+	// experimentassignment.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	experimentassignment.PlanIDValidator = experimentassignmentDescPlanID.Validators[0].(func(string) error)
 	featureMixin := schema.Feature{}.Mixin()
 	featureMixinFields0 := featureMixin[0].Fields()
 	_ = featureMixinFields0
@@ -1278,6 +1377,14 @@ func init() {
 	planDescDisplayOrder := planFields[4].Descriptor()
 	// plan.DefaultDisplayOrder holds the default value on creation for the display_order field.
 	plan.DefaultDisplayOrder = planDescDisplayOrder.Default.(int)
+	// planDescVersion is the schema descriptor for version field.
+	planDescVersion := planFields[5].Descriptor()
+	// plan.DefaultVersion holds the default value on creation for the version field.
+	plan.DefaultVersion = planDescVersion.Default.(int)
+	// planDescVersionStatus is the schema descriptor for version_status field.
+	planDescVersionStatus := planFields[6].Descriptor()
+	// plan.DefaultVersionStatus holds the default value on creation for the version_status field.
+	plan.DefaultVersionStatus = types.PlanVersionStatus(planDescVersionStatus.Default.(string))
 	priceMixin := schema.Price{}.Mixin()
 	priceMixinFields0 := priceMixin[0].Fields()
 	_ = priceMixinFields0
@@ -1357,18 +1464,22 @@ func init() {
 	priceDescTrialPeriod := priceFields[18].Descriptor()
 	// price.DefaultTrialPeriod holds the default value on creation for the trial_period field.
 	price.DefaultTrialPeriod = priceDescTrialPeriod.Default.(int)
+	// priceDescIncludedQuantity is the schema descriptor for included_quantity field.
+	priceDescIncludedQuantity := priceFields[28].Descriptor()
+	// price.DefaultIncludedQuantity holds the default value on creation for the included_quantity field.
+	price.DefaultIncludedQuantity = priceDescIncludedQuantity.Default.(decimal.Decimal)
 	// priceDescEntityType is the schema descriptor for entity_type field.
-	priceDescEntityType := priceFields[28].Descriptor()
+	priceDescEntityType := priceFields[32].Descriptor()
 	// price.DefaultEntityType holds the default value on creation for the entity_type field.
 	price.DefaultEntityType = types.PriceEntityType(priceDescEntityType.Default.(string))
 	// price.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
 	price.EntityTypeValidator = priceDescEntityType.Validators[0].(func(string) error)
 	// priceDescEntityID is the schema descriptor for entity_id field.
-	priceDescEntityID := priceFields[29].Descriptor()
+	priceDescEntityID := priceFields[33].Descriptor()
 	// price.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
 	price.EntityIDValidator = priceDescEntityID.Validators[0].(func(string) error)
 	// priceDescStartDate is the schema descriptor for start_date field.
-	priceDescStartDate := priceFields[31].Descriptor()
+	priceDescStartDate := priceFields[35].Descriptor()
 	// price.DefaultStartDate holds the default value on creation for the start_date field.
 	price.DefaultStartDate = priceDescStartDate.Default.(func() time.Time)
 	priceunitMixin := schema.PriceUnit{}.Mixin()
@@ -1420,6 +1531,138 @@ func init() {
 	priceunitDescConversionRate := priceunitFields[5].Descriptor()
 	// priceunit.DefaultConversionRate holds the default value on creation for the conversion_rate field.
 	priceunit.DefaultConversionRate = priceunitDescConversionRate.Default.(decimal.Decimal)
+	quoteMixin := schema.Quote{}.Mixin()
+	quoteMixinFields0 := quoteMixin[0].Fields()
+	_ = quoteMixinFields0
+	quoteMixinFields1 := quoteMixin[1].Fields()
+	_ = quoteMixinFields1
+	quoteFields := schema.Quote{}.Fields()
+	_ = quoteFields
+	// quoteDescTenantID is the schema descriptor for tenant_id field.
+	quoteDescTenantID := quoteMixinFields0[0].Descriptor()
+	// quote.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	quote.TenantIDValidator = quoteDescTenantID.Validators[0].(func(string) error)
+	// quoteDescStatus is the schema descriptor for status field.
+	quoteDescStatus := quoteMixinFields0[1].Descriptor()
+	// quote.DefaultStatus holds the default value on creation for the status field.
+	quote.DefaultStatus = quoteDescStatus.Default.(string)
+	// quoteDescCreatedAt is the schema descriptor for created_at field.
+	quoteDescCreatedAt := quoteMixinFields0[2].Descriptor()
+	// quote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quote.DefaultCreatedAt = quoteDescCreatedAt.Default.(func() time.Time)
+	// quoteDescUpdatedAt is the schema descriptor for updated_at field.
+	quoteDescUpdatedAt := quoteMixinFields0[3].Descriptor()
+	// quote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quote.DefaultUpdatedAt = quoteDescUpdatedAt.Default.(func() time.Time)
+	// quote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quote.UpdateDefaultUpdatedAt = quoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quoteDescEnvironmentID is the schema descriptor for environment_id field.
+	quoteDescEnvironmentID := quoteMixinFields1[0].Descriptor()
+	// quote.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	quote.DefaultEnvironmentID = quoteDescEnvironmentID.Default.(string)
+	// quoteDescCustomerID is the schema descriptor for customer_id field.
+	quoteDescCustomerID := quoteFields[2].Descriptor()
+	// quote.CustomerIDValidator is a validator for the "customer_id" field. It is called by the builders before save.
+	quote.CustomerIDValidator = quoteDescCustomerID.Validators[0].(func(string) error)
+	// quoteDescPlanID is the schema descriptor for plan_id field.
+	quoteDescPlanID := quoteFields[3].Descriptor()
+	// quote.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	quote.PlanIDValidator = quoteDescPlanID.Validators[0].(func(string) error)
+	// quoteDescCurrency is the schema descriptor for currency field.
+	quoteDescCurrency := quoteFields[4].Descriptor()
+	// quote.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	quote.CurrencyValidator = quoteDescCurrency.Validators[0].(func(string) error)
+	// quoteDescQuoteStatus is the schema descriptor for quote_status field.
+	quoteDescQuoteStatus := quoteFields[5].Descriptor()
+	// quote.DefaultQuoteStatus holds the default value on creation for the quote_status field.
+	quote.DefaultQuoteStatus = types.QuoteStatus(quoteDescQuoteStatus.Default.(string))
+	// quoteDescBillingCadence is the schema descriptor for billing_cadence field.
+	quoteDescBillingCadence := quoteFields[7].Descriptor()
+	// quote.BillingCadenceValidator is a validator for the "billing_cadence" field. It is called by the builders before save.
+	quote.BillingCadenceValidator = quoteDescBillingCadence.Validators[0].(func(string) error)
+	// quoteDescBillingPeriod is the schema descriptor for billing_period field.
+	quoteDescBillingPeriod := quoteFields[8].Descriptor()
+	// quote.BillingPeriodValidator is a validator for the "billing_period" field. It is called by the builders before save.
+	quote.BillingPeriodValidator = quoteDescBillingPeriod.Validators[0].(func(string) error)
+	// quoteDescBillingPeriodCount is the schema descriptor for billing_period_count field.
+	quoteDescBillingPeriodCount := quoteFields[9].Descriptor()
+	// quote.DefaultBillingPeriodCount holds the default value on creation for the billing_period_count field.
+	quote.DefaultBillingPeriodCount = quoteDescBillingPeriodCount.Default.(int)
+	// quote.BillingPeriodCountValidator is a validator for the "billing_period_count" field. It is called by the builders before save.
+	quote.BillingPeriodCountValidator = quoteDescBillingPeriodCount.Validators[0].(func(int) error)
+	// quoteDescBillingCycle is the schema descriptor for billing_cycle field.
+	quoteDescBillingCycle := quoteFields[10].Descriptor()
+	// quote.DefaultBillingCycle holds the default value on creation for the billing_cycle field.
+	quote.DefaultBillingCycle = types.BillingCycle(quoteDescBillingCycle.Default.(string))
+	// quoteDescSubtotal is the schema descriptor for subtotal field.
+	quoteDescSubtotal := quoteFields[15].Descriptor()
+	// quote.DefaultSubtotal holds the default value on creation for the subtotal field.
+	quote.DefaultSubtotal = quoteDescSubtotal.Default.(decimal.Decimal)
+	// quoteDescTotalDiscount is the schema descriptor for total_discount field.
+	quoteDescTotalDiscount := quoteFields[16].Descriptor()
+	// quote.DefaultTotalDiscount holds the default value on creation for the total_discount field.
+	quote.DefaultTotalDiscount = quoteDescTotalDiscount.Default.(decimal.Decimal)
+	// quoteDescTotal is the schema descriptor for total field.
+	quoteDescTotal := quoteFields[17].Descriptor()
+	// quote.DefaultTotal holds the default value on creation for the total field.
+	quote.DefaultTotal = quoteDescTotal.Default.(decimal.Decimal)
+	quotelineitemMixin := schema.QuoteLineItem{}.Mixin()
+	quotelineitemMixinFields0 := quotelineitemMixin[0].Fields()
+	_ = quotelineitemMixinFields0
+	quotelineitemMixinFields1 := quotelineitemMixin[1].Fields()
+	_ = quotelineitemMixinFields1
+	quotelineitemFields := schema.QuoteLineItem{}.Fields()
+	_ = quotelineitemFields
+	// quotelineitemDescTenantID is the schema descriptor for tenant_id field.
+	quotelineitemDescTenantID := quotelineitemMixinFields0[0].Descriptor()
+	// quotelineitem.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	quotelineitem.TenantIDValidator = quotelineitemDescTenantID.Validators[0].(func(string) error)
+	// quotelineitemDescStatus is the schema descriptor for status field.
+	quotelineitemDescStatus := quotelineitemMixinFields0[1].Descriptor()
+	// quotelineitem.DefaultStatus holds the default value on creation for the status field.
+	quotelineitem.DefaultStatus = quotelineitemDescStatus.Default.(string)
+	// quotelineitemDescCreatedAt is the schema descriptor for created_at field.
+	quotelineitemDescCreatedAt := quotelineitemMixinFields0[2].Descriptor()
+	// quotelineitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quotelineitem.DefaultCreatedAt = quotelineitemDescCreatedAt.Default.(func() time.Time)
+	// quotelineitemDescUpdatedAt is the schema descriptor for updated_at field.
+	quotelineitemDescUpdatedAt := quotelineitemMixinFields0[3].Descriptor()
+	// quotelineitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quotelineitem.DefaultUpdatedAt = quotelineitemDescUpdatedAt.Default.(func() time.Time)
+	// quotelineitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quotelineitem.UpdateDefaultUpdatedAt = quotelineitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quotelineitemDescEnvironmentID is the schema descriptor for environment_id field.
+	quotelineitemDescEnvironmentID := quotelineitemMixinFields1[0].Descriptor()
+	// quotelineitem.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	quotelineitem.DefaultEnvironmentID = quotelineitemDescEnvironmentID.Default.(string)
+	// quotelineitemDescQuoteID is the schema descriptor for quote_id field.
+	quotelineitemDescQuoteID := quotelineitemFields[1].Descriptor()
+	// quotelineitem.QuoteIDValidator is a validator for the "quote_id" field. It is called by the builders before save.
+	quotelineitem.QuoteIDValidator = quotelineitemDescQuoteID.Validators[0].(func(string) error)
+	// quotelineitemDescPriceID is the schema descriptor for price_id field.
+	quotelineitemDescPriceID := quotelineitemFields[2].Descriptor()
+	// quotelineitem.PriceIDValidator is a validator for the "price_id" field. It is called by the builders before save.
+	quotelineitem.PriceIDValidator = quotelineitemDescPriceID.Validators[0].(func(string) error)
+	// quotelineitemDescDisplayName is the schema descriptor for display_name field.
+	quotelineitemDescDisplayName := quotelineitemFields[3].Descriptor()
+	// quotelineitem.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	quotelineitem.DisplayNameValidator = quotelineitemDescDisplayName.Validators[0].(func(string) error)
+	// quotelineitemDescQuantity is the schema descriptor for quantity field.
+	quotelineitemDescQuantity := quotelineitemFields[4].Descriptor()
+	// quotelineitem.DefaultQuantity holds the default value on creation for the quantity field.
+	quotelineitem.DefaultQuantity = quotelineitemDescQuantity.Default.(decimal.Decimal)
+	// quotelineitemDescDiscountPercentage is the schema descriptor for discount_percentage field.
+	quotelineitemDescDiscountPercentage := quotelineitemFields[6].Descriptor()
+	// quotelineitem.DefaultDiscountPercentage holds the default value on creation for the discount_percentage field.
+	quotelineitem.DefaultDiscountPercentage = quotelineitemDescDiscountPercentage.Default.(decimal.Decimal)
+	// quotelineitemDescAmount is the schema descriptor for amount field.
+	quotelineitemDescAmount := quotelineitemFields[7].Descriptor()
+	// quotelineitem.DefaultAmount holds the default value on creation for the amount field.
+	quotelineitem.DefaultAmount = quotelineitemDescAmount.Default.(decimal.Decimal)
+	// quotelineitemDescCurrency is the schema descriptor for currency field.
+	quotelineitemDescCurrency := quotelineitemFields[9].Descriptor()
+	// quotelineitem.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	quotelineitem.CurrencyValidator = quotelineitemDescCurrency.Validators[0].(func(string) error)
 	scheduledtaskMixin := schema.ScheduledTask{}.Mixin()
 	scheduledtaskMixinFields0 := scheduledtaskMixin[0].Fields()
 	_ = scheduledtaskMixinFields0
@@ -1668,6 +1911,38 @@ func init() {
 	subscriptionDescEnableTrueUp := subscriptionFields[31].Descriptor()
 	// subscription.DefaultEnableTrueUp holds the default value on creation for the enable_true_up field.
 	subscription.DefaultEnableTrueUp = subscriptionDescEnableTrueUp.Default.(bool)
+	// subscriptionDescTrialEndAction is the schema descriptor for trial_end_action field.
+	subscriptionDescTrialEndAction := subscriptionFields[34].Descriptor()
+	// subscription.DefaultTrialEndAction holds the default value on creation for the trial_end_action field.
+	subscription.DefaultTrialEndAction = types.TrialEndAction(subscriptionDescTrialEndAction.Default.(string))
+	// subscriptionDescTrialReminderDays is the schema descriptor for trial_reminder_days field.
+	subscriptionDescTrialReminderDays := subscriptionFields[35].Descriptor()
+	// subscription.DefaultTrialReminderDays holds the default value on creation for the trial_reminder_days field.
+	subscription.DefaultTrialReminderDays = subscriptionDescTrialReminderDays.Default.(int)
+	// subscriptionDescBackdatedBillingMode is the schema descriptor for backdated_billing_mode field.
+	subscriptionDescBackdatedBillingMode := subscriptionFields[37].Descriptor()
+	// subscription.DefaultBackdatedBillingMode holds the default value on creation for the backdated_billing_mode field.
+	subscription.DefaultBackdatedBillingMode = types.BackdatedBillingMode(subscriptionDescBackdatedBillingMode.Default.(string))
+	// subscriptionDescBackdatedBillingPending is the schema descriptor for backdated_billing_pending field.
+	subscriptionDescBackdatedBillingPending := subscriptionFields[38].Descriptor()
+	// subscription.DefaultBackdatedBillingPending holds the default value on creation for the backdated_billing_pending field.
+	subscription.DefaultBackdatedBillingPending = subscriptionDescBackdatedBillingPending.Default.(bool)
+	// subscriptionDescSpendLimitState is the schema descriptor for spend_limit_state field.
+	subscriptionDescSpendLimitState := subscriptionFields[39].Descriptor()
+	// subscription.DefaultSpendLimitState holds the default value on creation for the spend_limit_state field.
+	subscription.DefaultSpendLimitState = types.SpendLimitState(subscriptionDescSpendLimitState.Default.(string))
+	// subscriptionDescTermMonths is the schema descriptor for term_months field.
+	subscriptionDescTermMonths := subscriptionFields[41].Descriptor()
+	// subscription.DefaultTermMonths holds the default value on creation for the term_months field.
+	subscription.DefaultTermMonths = subscriptionDescTermMonths.Default.(int)
+	// subscriptionDescAutoRenew is the schema descriptor for auto_renew field.
+	subscriptionDescAutoRenew := subscriptionFields[42].Descriptor()
+	// subscription.DefaultAutoRenew holds the default value on creation for the auto_renew field.
+	subscription.DefaultAutoRenew = subscriptionDescAutoRenew.Default.(bool)
+	// subscriptionDescRenewalNoticeDays is the schema descriptor for renewal_notice_days field.
+	subscriptionDescRenewalNoticeDays := subscriptionFields[43].Descriptor()
+	// subscription.DefaultRenewalNoticeDays holds the default value on creation for the renewal_notice_days field.
+	subscription.DefaultRenewalNoticeDays = subscriptionDescRenewalNoticeDays.Default.(int)
 	subscriptionlineitemMixin := schema.SubscriptionLineItem{}.Mixin()
 	subscriptionlineitemMixinFields0 := subscriptionlineitemMixin[0].Fields()
 	_ = subscriptionlineitemMixinFields0
@@ -1737,6 +2012,51 @@ func init() {
 	subscriptionlineitemDescCommitmentWindowed := subscriptionlineitemFields[27].Descriptor()
 	// subscriptionlineitem.DefaultCommitmentWindowed holds the default value on creation for the commitment_windowed field.
 	subscriptionlineitem.DefaultCommitmentWindowed = subscriptionlineitemDescCommitmentWindowed.Default.(bool)
+	// subscriptionlineitemDescIncludedQuantity is the schema descriptor for included_quantity field.
+	subscriptionlineitemDescIncludedQuantity := subscriptionlineitemFields[29].Descriptor()
+	// subscriptionlineitem.DefaultIncludedQuantity holds the default value on creation for the included_quantity field.
+	subscriptionlineitem.DefaultIncludedQuantity = subscriptionlineitemDescIncludedQuantity.Default.(decimal.Decimal)
+	subscriptionmigrationresultMixin := schema.SubscriptionMigrationResult{}.Mixin()
+	subscriptionmigrationresultMixinFields0 := subscriptionmigrationresultMixin[0].Fields()
+	_ = subscriptionmigrationresultMixinFields0
+	subscriptionmigrationresultMixinFields1 := subscriptionmigrationresultMixin[1].Fields()
+	_ = subscriptionmigrationresultMixinFields1
+	subscriptionmigrationresultFields := schema.SubscriptionMigrationResult{}.Fields()
+	_ = subscriptionmigrationresultFields
+	// subscriptionmigrationresultDescTenantID is the schema descriptor for tenant_id field.
+	subscriptionmigrationresultDescTenantID := subscriptionmigrationresultMixinFields0[0].Descriptor()
+	// subscriptionmigrationresult.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	subscriptionmigrationresult.TenantIDValidator = subscriptionmigrationresultDescTenantID.Validators[0].(func(string) error)
+	// subscriptionmigrationresultDescStatus is the schema descriptor for status field.
+	subscriptionmigrationresultDescStatus := subscriptionmigrationresultMixinFields0[1].Descriptor()
+	// subscriptionmigrationresult.DefaultStatus holds the default value on creation for the status field.
+	subscriptionmigrationresult.DefaultStatus = subscriptionmigrationresultDescStatus.Default.(string)
+	// subscriptionmigrationresultDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionmigrationresultDescCreatedAt := subscriptionmigrationresultMixinFields0[2].Descriptor()
+	// subscriptionmigrationresult.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionmigrationresult.DefaultCreatedAt = subscriptionmigrationresultDescCreatedAt.Default.(func() time.Time)
+	// subscriptionmigrationresultDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionmigrationresultDescUpdatedAt := subscriptionmigrationresultMixinFields0[3].Descriptor()
+	// subscriptionmigrationresult.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionmigrationresult.DefaultUpdatedAt = subscriptionmigrationresultDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionmigrationresult.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionmigrationresult.UpdateDefaultUpdatedAt = subscriptionmigrationresultDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionmigrationresultDescEnvironmentID is the schema descriptor for environment_id field.
+	subscriptionmigrationresultDescEnvironmentID := subscriptionmigrationresultMixinFields1[0].Descriptor()
+	// subscriptionmigrationresult.DefaultEnvironmentID holds the default value on creation for the environment_id field.
+	subscriptionmigrationresult.DefaultEnvironmentID = subscriptionmigrationresultDescEnvironmentID.Default.(string)
+	// subscriptionmigrationresultDescTaskID is the schema descriptor for task_id field.
+	subscriptionmigrationresultDescTaskID := subscriptionmigrationresultFields[1].Descriptor()
+	// subscriptionmigrationresult.TaskIDValidator is a validator for the "task_id" field. It is called by the builders before save.
+	subscriptionmigrationresult.TaskIDValidator = subscriptionmigrationresultDescTaskID.Validators[0].(func(string) error)
+	// subscriptionmigrationresultDescSubscriptionID is the schema descriptor for subscription_id field.
+	subscriptionmigrationresultDescSubscriptionID := subscriptionmigrationresultFields[2].Descriptor()
+	// subscriptionmigrationresult.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	subscriptionmigrationresult.SubscriptionIDValidator = subscriptionmigrationresultDescSubscriptionID.Validators[0].(func(string) error)
+	// subscriptionmigrationresultDescMigrationStatus is the schema descriptor for migration_status field.
+	subscriptionmigrationresultDescMigrationStatus := subscriptionmigrationresultFields[4].Descriptor()
+	// subscriptionmigrationresult.DefaultMigrationStatus holds the default value on creation for the migration_status field.
+	subscriptionmigrationresult.DefaultMigrationStatus = types.SubscriptionMigrationStatus(subscriptionmigrationresultDescMigrationStatus.Default.(string))
 	subscriptionpauseMixin := schema.SubscriptionPause{}.Mixin()
 	subscriptionpauseMixinFields0 := subscriptionpauseMixin[0].Fields()
 	_ = subscriptionpauseMixinFields0
@@ -1780,16 +2100,22 @@ func init() {
 	subscriptionpause.DefaultPauseMode = subscriptionpauseDescPauseMode.Default.(string)
 	// subscriptionpause.PauseModeValidator is a validator for the "pause_mode" field. It is called by the builders before save.
 	subscriptionpause.PauseModeValidator = subscriptionpauseDescPauseMode.Validators[0].(func(string) error)
+	// subscriptionpauseDescBillingMode is the schema descriptor for billing_mode field.
+	subscriptionpauseDescBillingMode := subscriptionpauseFields[4].Descriptor()
+	// subscriptionpause.DefaultBillingMode holds the default value on creation for the billing_mode field.
+	subscriptionpause.DefaultBillingMode = subscriptionpauseDescBillingMode.Default.(string)
+	// subscriptionpause.BillingModeValidator is a validator for the "billing_mode" field. It is called by the builders before save.
+	subscriptionpause.BillingModeValidator = subscriptionpauseDescBillingMode.Validators[0].(func(string) error)
 	// subscriptionpauseDescPauseStart is the schema descriptor for pause_start field.
-	subscriptionpauseDescPauseStart := subscriptionpauseFields[5].Descriptor()
+	subscriptionpauseDescPauseStart := subscriptionpauseFields[6].Descriptor()
 	// subscriptionpause.DefaultPauseStart holds the default value on creation for the pause_start field.
 	subscriptionpause.DefaultPauseStart = subscriptionpauseDescPauseStart.Default.(func() time.Time)
 	// subscriptionpauseDescOriginalPeriodStart is the schema descriptor for original_period_start field.
-	subscriptionpauseDescOriginalPeriodStart := subscriptionpauseFields[8].Descriptor()
+	subscriptionpauseDescOriginalPeriodStart := subscriptionpauseFields[9].Descriptor()
 	// subscriptionpause.DefaultOriginalPeriodStart holds the default value on creation for the original_period_start field.
 	subscriptionpause.DefaultOriginalPeriodStart = subscriptionpauseDescOriginalPeriodStart.Default.(func() time.Time)
 	// subscriptionpauseDescOriginalPeriodEnd is the schema descriptor for original_period_end field.
-	subscriptionpauseDescOriginalPeriodEnd := subscriptionpauseFields[9].Descriptor()
+	subscriptionpauseDescOriginalPeriodEnd := subscriptionpauseFields[10].Descriptor()
 	// subscriptionpause.DefaultOriginalPeriodEnd holds the default value on creation for the original_period_end field.
 	subscriptionpause.DefaultOriginalPeriodEnd = subscriptionpauseDescOriginalPeriodEnd.Default.(func() time.Time)
 	subscriptionphaseMixin := schema.SubscriptionPhase{}.Mixin()
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // AddonAssociation holds the schema definition for the AddonAssociation entity.
@@ -63,6 +64,29 @@ func (AddonAssociation) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// Quantity of the addon, fixed prices of the addon are charged per unit
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.NewFromInt(1)),
+
+		// Billing period of the addon, empty when the addon is billed with the subscription
+		field.String("billing_period").
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.BillingPeriod("")),
+
+		field.Int("billing_period_count").
+			Default(1),
+
+		// End of the trial of the addon, the addon is billed from this date
+		field.Time("trial_end").
+			Optional().
+			Nillable(),
+
 		// Lifecycle management
 		field.String("addon_status").
 			SchemaType(map[string]string{
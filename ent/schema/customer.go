@@ -85,6 +85,10 @@ func (Customer) Fields() []ent.Field {
 			}).
 			Nillable().
 			Optional(),
+		// Consolidated invoicing, the environment's invoice config applies when not set
+		field.Bool("consolidate_invoices").
+			Nillable().
+			Optional(),
 	}
 }
 
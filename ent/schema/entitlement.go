@@ -88,6 +88,9 @@ func (Entitlement) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("End date for time-bound entitlements (subscription-scoped only)"),
+		field.JSON("rollover_config", &types.RolloverConfig{}).
+			Optional().
+			Comment("Carryover of the unused usage limit into the following billing periods"),
 	}
 }
 
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+const (
+	// ExperimentRunningPlanConstraint allows a single running experiment per plan
+	ExperimentRunningPlanConstraint = "idx_experiment_tenant_environment_plan_running"
+)
+
+// Experiment holds the schema definition for the Experiment entity.
+// An experiment splits the customers subscribing to a public plan between weighted plan variants.
+type Experiment struct {
+	ent.Schema
+}
+
+// Mixin of the Experiment.
+func (Experiment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the Experiment.
+func (Experiment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("name").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty(),
+		field.Text("description").
+			Optional(),
+		field.String("plan_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Public plan whose subscriptions are split between the variants"),
+		field.String("experiment_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.ExperimentStatus("")).
+			Default(string(types.ExperimentStatusDraft)),
+		field.JSON("variants", []types.ExperimentVariant{}).
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Experiment.
+func (Experiment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Experiment.
+func (Experiment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "plan_id").
+			Unique().
+			StorageKey(ExperimentRunningPlanConstraint).
+			Annotations(entsql.IndexWhere("status = 'published' AND experiment_status = 'running'")),
+		index.Fields("tenant_id", "environment_id", "experiment_status"),
+	}
+}
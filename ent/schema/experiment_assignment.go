@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+)
+
+const (
+	// ExperimentAssignmentCustomerConstraint keeps assignments sticky, a customer is assigned once per experiment
+	ExperimentAssignmentCustomerConstraint = "idx_experiment_assignment_experiment_customer"
+)
+
+// ExperimentAssignment holds the schema definition for the ExperimentAssignment entity.
+// An assignment records the variant a customer was assigned to in an experiment.
+type ExperimentAssignment struct {
+	ent.Schema
+}
+
+// Mixin of the ExperimentAssignment.
+func (ExperimentAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the ExperimentAssignment.
+func (ExperimentAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("experiment_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("variant_key").
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("plan_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable().
+			Comment("Plan of the variant at the time of the assignment"),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Subscription created for the assignment"),
+		field.Time("converted_at").
+			Optional().
+			Nillable().
+			Comment("When the subscription of the assignment was first activated"),
+	}
+}
+
+// Edges of the ExperimentAssignment.
+func (ExperimentAssignment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExperimentAssignment.
+func (ExperimentAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "experiment_id", "customer_id").
+			Unique().
+			StorageKey(ExperimentAssignmentCustomerConstraint).
+			Annotations(entsql.IndexWhere("status = 'published'")),
+		index.Fields("tenant_id", "environment_id", "customer_id"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 var Idx_group_tenant_environment_lookup_key = "idx_group_tenant_environment_lookup_key"
@@ -50,6 +51,9 @@ func (Group) Fields() []ent.Field {
 			}).
 			Optional().
 			Comment("Idempotency key for group creation"),
+		field.JSON("pooling", &types.GroupPoolingConfig{}).
+			Optional().
+			Comment("Pooled tier ladder shared by all prices of the group"),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
 )
 
 var Idx_tenant_environment_lookup_key = "idx_tenant_environment_lookup_key"
@@ -48,6 +49,24 @@ func (Plan) Fields() []ent.Field {
 			Optional(),
 		field.Int("display_order").
 			Default(0),
+		field.Int("version").
+			Default(1).
+			Immutable().
+			Comment("Version number of the plan within its version group"),
+		field.String("version_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Default(string(types.PlanVersionStatusPublished)).
+			GoType(types.PlanVersionStatus("")),
+		field.String("base_plan_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("First version of the plan, empty for the first version itself"),
 	}
 }
 
@@ -66,5 +85,6 @@ func (Plan) Indexes() []ent.Index {
 			StorageKey(Idx_tenant_environment_lookup_key).
 			Annotations(entsql.IndexWhere("status = 'published'" + " AND lookup_key IS NOT NULL AND lookup_key != ''")),
 		index.Fields("tenant_id", "environment_id"),
+		index.Fields("tenant_id", "environment_id", "base_plan_id"),
 	}
 }
@@ -213,6 +213,25 @@ func (Price) Fields() []ent.Field {
 			Immutable().
 			Optional(),
 
+		field.JSON("time_windows", []types.PriceTimeWindow{}).
+			Optional().
+			Comment("Time-of-use windows with their own unit amounts for usage prices"),
+
+		field.JSON("cost_plus", &types.CostPlusConfig{}).
+			Optional().
+			Comment("Markup over the active costsheet for COST_PLUS prices"),
+
+		field.JSON("rollover_config", &types.RolloverConfig{}).
+			Optional().
+			Comment("Carryover of unused free tier usage into the following billing periods"),
+
+		field.Other("included_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero).
+			Comment("Usage included for free in each billing period of a usage price"),
+
 		field.String("lookup_key").
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	Idx_tenant_environment_quote_number_unique = "idx_tenant_environment_quote_number_unique"
+)
+
+// Quote holds the schema definition for the Quote entity.
+// A quote proposes a subscription to a customer and is converted into it once accepted.
+type Quote struct {
+	ent.Schema
+}
+
+// Mixin of the Quote.
+func (Quote) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the Quote.
+func (Quote) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("quote_number").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("plan_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("quote_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.QuoteStatus("")).
+			Default(string(types.QuoteStatusDraft)),
+		field.Text("description").
+			Optional(),
+		field.String("billing_cadence").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.BillingCadence("")).
+			NotEmpty().
+			Immutable(),
+		field.String("billing_period").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.BillingPeriod("")).
+			NotEmpty().
+			Immutable(),
+		field.Int("billing_period_count").
+			Default(1).
+			Positive().
+			Immutable(),
+		field.String("billing_cycle").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			GoType(types.BillingCycle("")).
+			Default(string(types.BillingCycleAnniversary)).
+			Immutable(),
+		field.Time("start_date").
+			Optional().
+			Nillable().
+			Comment("Proposed start of the subscription, the subscription starts on acceptance when not set"),
+		field.Time("valid_until"),
+		field.JSON("coupons", []string{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.JSON("phases", []types.QuotePhase{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		field.Other("subtotal", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("total_discount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("total", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Time("finalized_at").
+			Optional().
+			Nillable(),
+		field.Time("accepted_at").
+			Optional().
+			Nillable(),
+		field.Time("declined_at").
+			Optional().
+			Nillable(),
+		field.Text("decline_reason").
+			Optional(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Subscription the quote was converted into on acceptance"),
+	}
+}
+
+// Edges of the Quote.
+func (Quote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("line_items", QuoteLineItem.Type),
+	}
+}
+
+// Indexes of the Quote.
+func (Quote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "quote_number").
+			Unique().
+			StorageKey(Idx_tenant_environment_quote_number_unique).
+			Annotations(entsql.IndexWhere("quote_number IS NOT NULL AND quote_number != '' AND status = 'published'")),
+		index.Fields("tenant_id", "environment_id", "customer_id"),
+		index.Fields("tenant_id", "environment_id", "quote_status"),
+	}
+}
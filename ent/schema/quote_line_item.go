@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// QuoteLineItem holds the schema definition for the QuoteLineItem entity.
+type QuoteLineItem struct {
+	ent.Schema
+}
+
+// Mixin of the QuoteLineItem.
+func (QuoteLineItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+		baseMixin.MetadataMixin{},
+	}
+}
+
+// Fields of the QuoteLineItem.
+func (QuoteLineItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("quote_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("price_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("display_name").
+			NotEmpty(),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Other("unit_amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(25,15)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Quoted amount per unit, the amount of the price applies when not set"),
+		field.Other("discount_percentage", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(5,2)",
+			}).
+			Default(decimal.Zero),
+		field.Other("amount", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		field.Int("phase_index").
+			Optional().
+			Nillable().
+			Comment("Phase of the quote the line item applies to, it applies to the whole subscription when not set"),
+		field.String("currency").
+			SchemaType(map[string]string{
+				"postgres": "varchar(10)",
+			}).
+			NotEmpty(),
+	}
+}
+
+// Edges of the QuoteLineItem.
+func (QuoteLineItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("quote", Quote.Type).
+			Ref("line_items").
+			Field("quote_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -48,7 +48,7 @@ func (Subscription) Fields() []ent.Field {
 				"postgres": "varchar(50)",
 			}).
 			NotEmpty().
-			Immutable(),
+			Comment("Plan version the subscription is pinned to"),
 		field.String("subscription_status").
 			SchemaType(map[string]string{
 				"postgres": "varchar(50)",
@@ -172,6 +172,74 @@ func (Subscription) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Customer ID to use for invoicing (can differ from the subscription customer)"),
+		field.Other("billing_threshold_amount", decimal.Decimal{}).
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				"postgres": "decimal(20,6)",
+			}).
+			Comment("Accrued current period charges above which an interim invoice is generated"),
+		field.String("trial_end_action").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.TrialEndActionConvert)).
+			GoType(types.TrialEndAction("")).
+			Comment("Action taken when the trial of the subscription ends"),
+		field.Int("trial_reminder_days").
+			Default(0).
+			Comment("Days before the end of the trial the trial will end webhook is sent, 0 disables the reminder"),
+		field.JSON("trial_usage_caps", map[string]decimal.Decimal{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}).
+			Comment("Usage allowed per meter during the trial, the trial ends early when a cap is reached"),
+		field.String("backdated_billing_mode").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.BackdatedBillingModePerPeriod)).
+			GoType(types.BackdatedBillingMode("")).
+			Comment("How the billing periods that ended before the subscription was created are invoiced"),
+		field.Bool("backdated_billing_pending").
+			Default(false).
+			Comment("Whether the missed periods of a backdated subscription are being billed, the billing cron skips the subscription until they are"),
+		field.String("spend_limit_state").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.SpendLimitStateOk)).
+			GoType(types.SpendLimitState("")).
+			Comment("Whether features are denied because the prepaid wallet balance of the customer reached the spend limit floor"),
+		field.Time("spend_limited_at").
+			Optional().
+			Nillable().
+			Comment("Time the subscription was last limited by the spend limit of its customer"),
+		field.Int("term_months").
+			Default(0).
+			Comment("Length in months of the contract term, 0 for a subscription without a term"),
+		field.Bool("auto_renew").
+			Default(true).
+			Comment("Whether a new term starts when the current term ends, the subscription is cancelled otherwise"),
+		field.Int("renewal_notice_days").
+			Default(0).
+			Comment("Days before the end of the term after which the renewal can no longer be turned off"),
+		field.String("renewal_plan_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Plan the subscription renews to at the end of the current term, the current plan when null"),
+		field.Time("current_term_start").
+			Optional().
+			Nillable().
+			Comment("Start of the current contract term"),
+		field.Time("current_term_end").
+			Optional().
+			Nillable().
+			Comment("End of the current contract term"),
 	}
 }
 
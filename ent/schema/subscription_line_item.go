@@ -169,6 +169,24 @@ func (SubscriptionLineItem) Fields() []ent.Field {
 			Default(false),
 		field.Bool("commitment_windowed").
 			Default(false),
+		// Quantity history used to prorate quantity changes within a billing period
+		field.JSON("quantity_changes", []types.LineItemQuantityChange{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
+		// Usage included for free in each billing period before the usage is rated
+		field.Other("included_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(20,8)",
+			}).
+			Default(decimal.Zero),
+		// Rollover balances of unused included usage carried over from previous periods
+		field.JSON("rollover", &types.LineItemRollover{}).
+			Optional().
+			SchemaType(map[string]string{
+				"postgres": "jsonb",
+			}),
 	}
 }
 
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionMigrationResult holds the schema definition for the SubscriptionMigrationResult entity.
+// A result records the outcome of the migration of a single subscription of a bulk subscription
+// migration task, results are created as pending when the subscriptions of the migration are selected.
+type SubscriptionMigrationResult struct {
+	ent.Schema
+}
+
+// Mixin of the SubscriptionMigrationResult.
+func (SubscriptionMigrationResult) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		baseMixin.BaseMixin{},
+		baseMixin.EnvironmentMixin{},
+	}
+}
+
+// Fields of the SubscriptionMigrationResult.
+func (SubscriptionMigrationResult) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Unique().
+			Immutable(),
+		field.String("task_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			NotEmpty().
+			Immutable(),
+		field.String("customer_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional(),
+		field.String("migration_status").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default(string(types.SubscriptionMigrationStatusPending)).
+			GoType(types.SubscriptionMigrationStatus("")),
+		field.String("new_subscription_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Subscription on the target plan of immediate migrations"),
+		field.String("schedule_id").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Optional().
+			Nillable().
+			Comment("Plan change schedule of end of period migrations"),
+		field.Text("error").
+			Optional().
+			Nillable(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SubscriptionMigrationResult.
+func (SubscriptionMigrationResult) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SubscriptionMigrationResult.
+func (SubscriptionMigrationResult) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "environment_id", "task_id", "subscription_id").
+			Unique(),
+		index.Fields("tenant_id", "environment_id", "task_id", "migration_status"),
+	}
+}
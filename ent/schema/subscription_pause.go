@@ -48,6 +48,12 @@ func (SubscriptionPause) Fields() []ent.Field {
 			}).
 			Default("scheduled").
 			NotEmpty(),
+		field.String("billing_mode").
+			SchemaType(map[string]string{
+				"postgres": "varchar(50)",
+			}).
+			Default("stop_all").
+			NotEmpty(),
 		field.String("resume_mode").
 			SchemaType(map[string]string{
 				"postgres": "varchar(50)",
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	baseMixin "github.com/flexprice/flexprice/ent/schema/mixin"
+	"github.com/shopspring/decimal"
 )
 
 type SubscriptionPhase struct {
@@ -42,6 +43,25 @@ func (SubscriptionPhase) Fields() []ent.Field {
 		field.Time("end_date").
 			Optional().
 			Nillable(),
+		field.Other("commitment_amount", decimal.Decimal{}).
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				"postgres": "decimal(20,6)",
+			}).
+			Comment("Annual commitment amount of the phase"),
+		field.Other("overage_factor", decimal.Decimal{}).
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				"postgres": "decimal(10,6)",
+			}),
+		field.String("commitment_true_up_cadence").
+			SchemaType(map[string]string{
+				"postgres": "varchar(20)",
+			}).
+			Optional().
+			Nillable(),
 	}
 }
 
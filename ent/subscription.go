@@ -39,7 +39,7 @@ type Subscription struct {
 	LookupKey string `json:"lookup_key,omitempty"`
 	// CustomerID holds the value of the "customer_id" field.
 	CustomerID string `json:"customer_id,omitempty"`
-	// PlanID holds the value of the "plan_id" field.
+	// Plan version the subscription is pinned to
 	PlanID string `json:"plan_id,omitempty"`
 	// SubscriptionStatus holds the value of the "subscription_status" field.
 	SubscriptionStatus types.SubscriptionStatus `json:"subscription_status,omitempty"`
@@ -99,6 +99,34 @@ type Subscription struct {
 	EnableTrueUp bool `json:"enable_true_up,omitempty"`
 	// Customer ID to use for invoicing (can differ from the subscription customer)
 	InvoicingCustomerID *string `json:"invoicing_customer_id,omitempty"`
+	// Accrued current period charges above which an interim invoice is generated
+	BillingThresholdAmount *decimal.Decimal `json:"billing_threshold_amount,omitempty"`
+	// Action taken when the trial of the subscription ends
+	TrialEndAction types.TrialEndAction `json:"trial_end_action,omitempty"`
+	// Days before the end of the trial the trial will end webhook is sent, 0 disables the reminder
+	TrialReminderDays int `json:"trial_reminder_days,omitempty"`
+	// Usage allowed per meter during the trial, the trial ends early when a cap is reached
+	TrialUsageCaps map[string]decimal.Decimal `json:"trial_usage_caps,omitempty"`
+	// How the billing periods that ended before the subscription was created are invoiced
+	BackdatedBillingMode types.BackdatedBillingMode `json:"backdated_billing_mode,omitempty"`
+	// Whether the missed periods of a backdated subscription are being billed, the billing cron skips the subscription until they are
+	BackdatedBillingPending bool `json:"backdated_billing_pending,omitempty"`
+	// Whether features are denied because the prepaid wallet balance of the customer reached the spend limit floor
+	SpendLimitState types.SpendLimitState `json:"spend_limit_state,omitempty"`
+	// Time the subscription was last limited by the spend limit of its customer
+	SpendLimitedAt *time.Time `json:"spend_limited_at,omitempty"`
+	// Length in months of the contract term, 0 for a subscription without a term
+	TermMonths int `json:"term_months,omitempty"`
+	// Whether a new term starts when the current term ends, the subscription is cancelled otherwise
+	AutoRenew bool `json:"auto_renew,omitempty"`
+	// Days before the end of the term after which the renewal can no longer be turned off
+	RenewalNoticeDays int `json:"renewal_notice_days,omitempty"`
+	// Plan the subscription renews to at the end of the current term, the current plan when null
+	RenewalPlanID *string `json:"renewal_plan_id,omitempty"`
+	// Start of the current contract term
+	CurrentTermStart *time.Time `json:"current_term_start,omitempty"`
+	// End of the current contract term
+	CurrentTermEnd *time.Time `json:"current_term_end,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionQuery when eager-loading is set.
 	Edges        SubscriptionEdges `json:"edges"`
@@ -207,17 +235,17 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case subscription.FieldCommitmentAmount, subscription.FieldOverageFactor:
+		case subscription.FieldCommitmentAmount, subscription.FieldOverageFactor, subscription.FieldBillingThresholdAmount:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case subscription.FieldMetadata:
+		case subscription.FieldMetadata, subscription.FieldTrialUsageCaps:
 			values[i] = new([]byte)
-		case subscription.FieldCancelAtPeriodEnd, subscription.FieldEnableTrueUp:
+		case subscription.FieldCancelAtPeriodEnd, subscription.FieldEnableTrueUp, subscription.FieldBackdatedBillingPending, subscription.FieldAutoRenew:
 			values[i] = new(sql.NullBool)
-		case subscription.FieldBillingPeriodCount, subscription.FieldVersion:
+		case subscription.FieldBillingPeriodCount, subscription.FieldVersion, subscription.FieldTrialReminderDays, subscription.FieldTermMonths, subscription.FieldRenewalNoticeDays:
 			values[i] = new(sql.NullInt64)
-		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID, subscription.FieldBillingCycle, subscription.FieldPaymentBehavior, subscription.FieldCollectionMethod, subscription.FieldGatewayPaymentMethodID, subscription.FieldCustomerTimezone, subscription.FieldProrationBehavior, subscription.FieldInvoicingCustomerID:
+		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldStatus, subscription.FieldCreatedBy, subscription.FieldUpdatedBy, subscription.FieldEnvironmentID, subscription.FieldLookupKey, subscription.FieldCustomerID, subscription.FieldPlanID, subscription.FieldSubscriptionStatus, subscription.FieldCurrency, subscription.FieldBillingCadence, subscription.FieldBillingPeriod, subscription.FieldPauseStatus, subscription.FieldActivePauseID, subscription.FieldBillingCycle, subscription.FieldPaymentBehavior, subscription.FieldCollectionMethod, subscription.FieldGatewayPaymentMethodID, subscription.FieldCustomerTimezone, subscription.FieldProrationBehavior, subscription.FieldInvoicingCustomerID, subscription.FieldTrialEndAction, subscription.FieldBackdatedBillingMode, subscription.FieldSpendLimitState, subscription.FieldRenewalPlanID:
 			values[i] = new(sql.NullString)
-		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd:
+		case subscription.FieldCreatedAt, subscription.FieldUpdatedAt, subscription.FieldBillingAnchor, subscription.FieldStartDate, subscription.FieldEndDate, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldCancelledAt, subscription.FieldCancelAt, subscription.FieldTrialStart, subscription.FieldTrialEnd, subscription.FieldSpendLimitedAt, subscription.FieldCurrentTermStart, subscription.FieldCurrentTermEnd:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -485,6 +513,97 @@ func (s *Subscription) assignValues(columns []string, values []any) error {
 				s.InvoicingCustomerID = new(string)
 				*s.InvoicingCustomerID = value.String
 			}
+		case subscription.FieldBillingThresholdAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_threshold_amount", values[i])
+			} else if value.Valid {
+				s.BillingThresholdAmount = new(decimal.Decimal)
+				*s.BillingThresholdAmount = *value.S.(*decimal.Decimal)
+			}
+		case subscription.FieldTrialEndAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_end_action", values[i])
+			} else if value.Valid {
+				s.TrialEndAction = types.TrialEndAction(value.String)
+			}
+		case subscription.FieldTrialReminderDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_reminder_days", values[i])
+			} else if value.Valid {
+				s.TrialReminderDays = int(value.Int64)
+			}
+		case subscription.FieldTrialUsageCaps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_usage_caps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.TrialUsageCaps); err != nil {
+					return fmt.Errorf("unmarshal field trial_usage_caps: %w", err)
+				}
+			}
+		case subscription.FieldBackdatedBillingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backdated_billing_mode", values[i])
+			} else if value.Valid {
+				s.BackdatedBillingMode = types.BackdatedBillingMode(value.String)
+			}
+		case subscription.FieldBackdatedBillingPending:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backdated_billing_pending", values[i])
+			} else if value.Valid {
+				s.BackdatedBillingPending = value.Bool
+			}
+		case subscription.FieldSpendLimitState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spend_limit_state", values[i])
+			} else if value.Valid {
+				s.SpendLimitState = types.SpendLimitState(value.String)
+			}
+		case subscription.FieldSpendLimitedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field spend_limited_at", values[i])
+			} else if value.Valid {
+				s.SpendLimitedAt = new(time.Time)
+				*s.SpendLimitedAt = value.Time
+			}
+		case subscription.FieldTermMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field term_months", values[i])
+			} else if value.Valid {
+				s.TermMonths = int(value.Int64)
+			}
+		case subscription.FieldAutoRenew:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_renew", values[i])
+			} else if value.Valid {
+				s.AutoRenew = value.Bool
+			}
+		case subscription.FieldRenewalNoticeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_notice_days", values[i])
+			} else if value.Valid {
+				s.RenewalNoticeDays = int(value.Int64)
+			}
+		case subscription.FieldRenewalPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_plan_id", values[i])
+			} else if value.Valid {
+				s.RenewalPlanID = new(string)
+				*s.RenewalPlanID = value.String
+			}
+		case subscription.FieldCurrentTermStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_term_start", values[i])
+			} else if value.Valid {
+				s.CurrentTermStart = new(time.Time)
+				*s.CurrentTermStart = value.Time
+			}
+		case subscription.FieldCurrentTermEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_term_end", values[i])
+			} else if value.Valid {
+				s.CurrentTermEnd = new(time.Time)
+				*s.CurrentTermEnd = value.Time
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -695,6 +814,58 @@ func (s *Subscription) String() string {
 		builder.WriteString("invoicing_customer_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := s.BillingThresholdAmount; v != nil {
+		builder.WriteString("billing_threshold_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trial_end_action=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrialEndAction))
+	builder.WriteString(", ")
+	builder.WriteString("trial_reminder_days=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrialReminderDays))
+	builder.WriteString(", ")
+	builder.WriteString("trial_usage_caps=")
+	builder.WriteString(fmt.Sprintf("%v", s.TrialUsageCaps))
+	builder.WriteString(", ")
+	builder.WriteString("backdated_billing_mode=")
+	builder.WriteString(fmt.Sprintf("%v", s.BackdatedBillingMode))
+	builder.WriteString(", ")
+	builder.WriteString("backdated_billing_pending=")
+	builder.WriteString(fmt.Sprintf("%v", s.BackdatedBillingPending))
+	builder.WriteString(", ")
+	builder.WriteString("spend_limit_state=")
+	builder.WriteString(fmt.Sprintf("%v", s.SpendLimitState))
+	builder.WriteString(", ")
+	if v := s.SpendLimitedAt; v != nil {
+		builder.WriteString("spend_limited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("term_months=")
+	builder.WriteString(fmt.Sprintf("%v", s.TermMonths))
+	builder.WriteString(", ")
+	builder.WriteString("auto_renew=")
+	builder.WriteString(fmt.Sprintf("%v", s.AutoRenew))
+	builder.WriteString(", ")
+	builder.WriteString("renewal_notice_days=")
+	builder.WriteString(fmt.Sprintf("%v", s.RenewalNoticeDays))
+	builder.WriteString(", ")
+	if v := s.RenewalPlanID; v != nil {
+		builder.WriteString("renewal_plan_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.CurrentTermStart; v != nil {
+		builder.WriteString("current_term_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.CurrentTermEnd; v != nil {
+		builder.WriteString("current_term_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
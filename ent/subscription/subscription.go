@@ -94,6 +94,34 @@ const (
 	FieldEnableTrueUp = "enable_true_up"
 	// FieldInvoicingCustomerID holds the string denoting the invoicing_customer_id field in the database.
 	FieldInvoicingCustomerID = "invoicing_customer_id"
+	// FieldBillingThresholdAmount holds the string denoting the billing_threshold_amount field in the database.
+	FieldBillingThresholdAmount = "billing_threshold_amount"
+	// FieldTrialEndAction holds the string denoting the trial_end_action field in the database.
+	FieldTrialEndAction = "trial_end_action"
+	// FieldTrialReminderDays holds the string denoting the trial_reminder_days field in the database.
+	FieldTrialReminderDays = "trial_reminder_days"
+	// FieldTrialUsageCaps holds the string denoting the trial_usage_caps field in the database.
+	FieldTrialUsageCaps = "trial_usage_caps"
+	// FieldBackdatedBillingMode holds the string denoting the backdated_billing_mode field in the database.
+	FieldBackdatedBillingMode = "backdated_billing_mode"
+	// FieldBackdatedBillingPending holds the string denoting the backdated_billing_pending field in the database.
+	FieldBackdatedBillingPending = "backdated_billing_pending"
+	// FieldSpendLimitState holds the string denoting the spend_limit_state field in the database.
+	FieldSpendLimitState = "spend_limit_state"
+	// FieldSpendLimitedAt holds the string denoting the spend_limited_at field in the database.
+	FieldSpendLimitedAt = "spend_limited_at"
+	// FieldTermMonths holds the string denoting the term_months field in the database.
+	FieldTermMonths = "term_months"
+	// FieldAutoRenew holds the string denoting the auto_renew field in the database.
+	FieldAutoRenew = "auto_renew"
+	// FieldRenewalNoticeDays holds the string denoting the renewal_notice_days field in the database.
+	FieldRenewalNoticeDays = "renewal_notice_days"
+	// FieldRenewalPlanID holds the string denoting the renewal_plan_id field in the database.
+	FieldRenewalPlanID = "renewal_plan_id"
+	// FieldCurrentTermStart holds the string denoting the current_term_start field in the database.
+	FieldCurrentTermStart = "current_term_start"
+	// FieldCurrentTermEnd holds the string denoting the current_term_end field in the database.
+	FieldCurrentTermEnd = "current_term_end"
 	// EdgeLineItems holds the string denoting the line_items edge name in mutations.
 	EdgeLineItems = "line_items"
 	// EdgePauses holds the string denoting the pauses edge name in mutations.
@@ -212,6 +240,20 @@ var Columns = []string{
 	FieldProrationBehavior,
 	FieldEnableTrueUp,
 	FieldInvoicingCustomerID,
+	FieldBillingThresholdAmount,
+	FieldTrialEndAction,
+	FieldTrialReminderDays,
+	FieldTrialUsageCaps,
+	FieldBackdatedBillingMode,
+	FieldBackdatedBillingPending,
+	FieldSpendLimitState,
+	FieldSpendLimitedAt,
+	FieldTermMonths,
+	FieldAutoRenew,
+	FieldRenewalNoticeDays,
+	FieldRenewalPlanID,
+	FieldCurrentTermStart,
+	FieldCurrentTermEnd,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -283,6 +325,22 @@ var (
 	ProrationBehaviorValidator func(string) error
 	// DefaultEnableTrueUp holds the default value on creation for the "enable_true_up" field.
 	DefaultEnableTrueUp bool
+	// DefaultTrialEndAction holds the default value on creation for the "trial_end_action" field.
+	DefaultTrialEndAction types.TrialEndAction
+	// DefaultTrialReminderDays holds the default value on creation for the "trial_reminder_days" field.
+	DefaultTrialReminderDays int
+	// DefaultBackdatedBillingMode holds the default value on creation for the "backdated_billing_mode" field.
+	DefaultBackdatedBillingMode types.BackdatedBillingMode
+	// DefaultBackdatedBillingPending holds the default value on creation for the "backdated_billing_pending" field.
+	DefaultBackdatedBillingPending bool
+	// DefaultSpendLimitState holds the default value on creation for the "spend_limit_state" field.
+	DefaultSpendLimitState types.SpendLimitState
+	// DefaultTermMonths holds the default value on creation for the "term_months" field.
+	DefaultTermMonths int
+	// DefaultAutoRenew holds the default value on creation for the "auto_renew" field.
+	DefaultAutoRenew bool
+	// DefaultRenewalNoticeDays holds the default value on creation for the "renewal_notice_days" field.
+	DefaultRenewalNoticeDays int
 )
 
 // OrderOption defines the ordering options for the Subscription queries.
@@ -483,6 +541,71 @@ func ByInvoicingCustomerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvoicingCustomerID, opts...).ToFunc()
 }
 
+// ByBillingThresholdAmount orders the results by the billing_threshold_amount field.
+func ByBillingThresholdAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingThresholdAmount, opts...).ToFunc()
+}
+
+// ByTrialEndAction orders the results by the trial_end_action field.
+func ByTrialEndAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialEndAction, opts...).ToFunc()
+}
+
+// ByTrialReminderDays orders the results by the trial_reminder_days field.
+func ByTrialReminderDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialReminderDays, opts...).ToFunc()
+}
+
+// ByBackdatedBillingMode orders the results by the backdated_billing_mode field.
+func ByBackdatedBillingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackdatedBillingMode, opts...).ToFunc()
+}
+
+// ByBackdatedBillingPending orders the results by the backdated_billing_pending field.
+func ByBackdatedBillingPending(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackdatedBillingPending, opts...).ToFunc()
+}
+
+// BySpendLimitState orders the results by the spend_limit_state field.
+func BySpendLimitState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpendLimitState, opts...).ToFunc()
+}
+
+// BySpendLimitedAt orders the results by the spend_limited_at field.
+func BySpendLimitedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpendLimitedAt, opts...).ToFunc()
+}
+
+// ByTermMonths orders the results by the term_months field.
+func ByTermMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTermMonths, opts...).ToFunc()
+}
+
+// ByAutoRenew orders the results by the auto_renew field.
+func ByAutoRenew(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoRenew, opts...).ToFunc()
+}
+
+// ByRenewalNoticeDays orders the results by the renewal_notice_days field.
+func ByRenewalNoticeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewalNoticeDays, opts...).ToFunc()
+}
+
+// ByRenewalPlanID orders the results by the renewal_plan_id field.
+func ByRenewalPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewalPlanID, opts...).ToFunc()
+}
+
+// ByCurrentTermStart orders the results by the current_term_start field.
+func ByCurrentTermStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentTermStart, opts...).ToFunc()
+}
+
+// ByCurrentTermEnd orders the results by the current_term_end field.
+func ByCurrentTermEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentTermEnd, opts...).ToFunc()
+}
+
 // ByLineItemsCount orders the results by line_items count.
 func ByLineItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -265,6 +265,74 @@ func InvoicingCustomerID(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldInvoicingCustomerID, v))
 }
 
+// BillingThresholdAmount applies equality check predicate on the "billing_threshold_amount" field. It's identical to BillingThresholdAmountEQ.
+func BillingThresholdAmount(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingThresholdAmount, v))
+}
+
+// TrialEndAction applies equality check predicate on the "trial_end_action" field. It's identical to TrialEndActionEQ.
+func TrialEndAction(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldTrialEndAction, vc))
+}
+
+// TrialReminderDays applies equality check predicate on the "trial_reminder_days" field. It's identical to TrialReminderDaysEQ.
+func TrialReminderDays(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialReminderDays, v))
+}
+
+// BackdatedBillingMode applies equality check predicate on the "backdated_billing_mode" field. It's identical to BackdatedBillingModeEQ.
+func BackdatedBillingMode(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingPending applies equality check predicate on the "backdated_billing_pending" field. It's identical to BackdatedBillingPendingEQ.
+func BackdatedBillingPending(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBackdatedBillingPending, v))
+}
+
+// SpendLimitState applies equality check predicate on the "spend_limit_state" field. It's identical to SpendLimitStateEQ.
+func SpendLimitState(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldSpendLimitState, vc))
+}
+
+// SpendLimitedAt applies equality check predicate on the "spend_limited_at" field. It's identical to SpendLimitedAtEQ.
+func SpendLimitedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldSpendLimitedAt, v))
+}
+
+// TermMonths applies equality check predicate on the "term_months" field. It's identical to TermMonthsEQ.
+func TermMonths(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTermMonths, v))
+}
+
+// AutoRenew applies equality check predicate on the "auto_renew" field. It's identical to AutoRenewEQ.
+func AutoRenew(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldAutoRenew, v))
+}
+
+// RenewalNoticeDays applies equality check predicate on the "renewal_notice_days" field. It's identical to RenewalNoticeDaysEQ.
+func RenewalNoticeDays(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRenewalNoticeDays, v))
+}
+
+// RenewalPlanID applies equality check predicate on the "renewal_plan_id" field. It's identical to RenewalPlanIDEQ.
+func RenewalPlanID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRenewalPlanID, v))
+}
+
+// CurrentTermStart applies equality check predicate on the "current_term_start" field. It's identical to CurrentTermStartEQ.
+func CurrentTermStart(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentTermStart, v))
+}
+
+// CurrentTermEnd applies equality check predicate on the "current_term_end" field. It's identical to CurrentTermEndEQ.
+func CurrentTermEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentTermEnd, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldTenantID, v))
@@ -2552,6 +2620,683 @@ func InvoicingCustomerIDContainsFold(v string) predicate.Subscription {
 	return predicate.Subscription(sql.FieldContainsFold(FieldInvoicingCustomerID, v))
 }
 
+// BillingThresholdAmountEQ applies the EQ predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountNEQ applies the NEQ predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountNEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountIn applies the In predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldBillingThresholdAmount, vs...))
+}
+
+// BillingThresholdAmountNotIn applies the NotIn predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountNotIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldBillingThresholdAmount, vs...))
+}
+
+// BillingThresholdAmountGT applies the GT predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountGT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountGTE applies the GTE predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountGTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountLT applies the LT predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountLT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountLTE applies the LTE predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountLTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldBillingThresholdAmount, v))
+}
+
+// BillingThresholdAmountIsNil applies the IsNil predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldBillingThresholdAmount))
+}
+
+// BillingThresholdAmountNotNil applies the NotNil predicate on the "billing_threshold_amount" field.
+func BillingThresholdAmountNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldBillingThresholdAmount))
+}
+
+// TrialEndActionEQ applies the EQ predicate on the "trial_end_action" field.
+func TrialEndActionEQ(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionNEQ applies the NEQ predicate on the "trial_end_action" field.
+func TrialEndActionNEQ(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionIn applies the In predicate on the "trial_end_action" field.
+func TrialEndActionIn(vs ...types.TrialEndAction) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldIn(FieldTrialEndAction, v...))
+}
+
+// TrialEndActionNotIn applies the NotIn predicate on the "trial_end_action" field.
+func TrialEndActionNotIn(vs ...types.TrialEndAction) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldNotIn(FieldTrialEndAction, v...))
+}
+
+// TrialEndActionGT applies the GT predicate on the "trial_end_action" field.
+func TrialEndActionGT(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGT(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionGTE applies the GTE predicate on the "trial_end_action" field.
+func TrialEndActionGTE(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGTE(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionLT applies the LT predicate on the "trial_end_action" field.
+func TrialEndActionLT(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLT(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionLTE applies the LTE predicate on the "trial_end_action" field.
+func TrialEndActionLTE(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLTE(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionContains applies the Contains predicate on the "trial_end_action" field.
+func TrialEndActionContains(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContains(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionHasPrefix applies the HasPrefix predicate on the "trial_end_action" field.
+func TrialEndActionHasPrefix(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasPrefix(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionHasSuffix applies the HasSuffix predicate on the "trial_end_action" field.
+func TrialEndActionHasSuffix(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasSuffix(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionEqualFold applies the EqualFold predicate on the "trial_end_action" field.
+func TrialEndActionEqualFold(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEqualFold(FieldTrialEndAction, vc))
+}
+
+// TrialEndActionContainsFold applies the ContainsFold predicate on the "trial_end_action" field.
+func TrialEndActionContainsFold(v types.TrialEndAction) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContainsFold(FieldTrialEndAction, vc))
+}
+
+// TrialReminderDaysEQ applies the EQ predicate on the "trial_reminder_days" field.
+func TrialReminderDaysEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialReminderDays, v))
+}
+
+// TrialReminderDaysNEQ applies the NEQ predicate on the "trial_reminder_days" field.
+func TrialReminderDaysNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialReminderDays, v))
+}
+
+// TrialReminderDaysIn applies the In predicate on the "trial_reminder_days" field.
+func TrialReminderDaysIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTrialReminderDays, vs...))
+}
+
+// TrialReminderDaysNotIn applies the NotIn predicate on the "trial_reminder_days" field.
+func TrialReminderDaysNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTrialReminderDays, vs...))
+}
+
+// TrialReminderDaysGT applies the GT predicate on the "trial_reminder_days" field.
+func TrialReminderDaysGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTrialReminderDays, v))
+}
+
+// TrialReminderDaysGTE applies the GTE predicate on the "trial_reminder_days" field.
+func TrialReminderDaysGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTrialReminderDays, v))
+}
+
+// TrialReminderDaysLT applies the LT predicate on the "trial_reminder_days" field.
+func TrialReminderDaysLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTrialReminderDays, v))
+}
+
+// TrialReminderDaysLTE applies the LTE predicate on the "trial_reminder_days" field.
+func TrialReminderDaysLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTrialReminderDays, v))
+}
+
+// TrialUsageCapsIsNil applies the IsNil predicate on the "trial_usage_caps" field.
+func TrialUsageCapsIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldTrialUsageCaps))
+}
+
+// TrialUsageCapsNotNil applies the NotNil predicate on the "trial_usage_caps" field.
+func TrialUsageCapsNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldTrialUsageCaps))
+}
+
+// BackdatedBillingModeEQ applies the EQ predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeEQ(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeNEQ applies the NEQ predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeNEQ(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldNEQ(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeIn applies the In predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeIn(vs ...types.BackdatedBillingMode) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldIn(FieldBackdatedBillingMode, v...))
+}
+
+// BackdatedBillingModeNotIn applies the NotIn predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeNotIn(vs ...types.BackdatedBillingMode) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldNotIn(FieldBackdatedBillingMode, v...))
+}
+
+// BackdatedBillingModeGT applies the GT predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeGT(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGT(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeGTE applies the GTE predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeGTE(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGTE(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeLT applies the LT predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeLT(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLT(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeLTE applies the LTE predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeLTE(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLTE(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeContains applies the Contains predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeContains(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContains(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeHasPrefix applies the HasPrefix predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeHasPrefix(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasPrefix(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeHasSuffix applies the HasSuffix predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeHasSuffix(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasSuffix(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeEqualFold applies the EqualFold predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeEqualFold(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEqualFold(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingModeContainsFold applies the ContainsFold predicate on the "backdated_billing_mode" field.
+func BackdatedBillingModeContainsFold(v types.BackdatedBillingMode) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContainsFold(FieldBackdatedBillingMode, vc))
+}
+
+// BackdatedBillingPendingEQ applies the EQ predicate on the "backdated_billing_pending" field.
+func BackdatedBillingPendingEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBackdatedBillingPending, v))
+}
+
+// BackdatedBillingPendingNEQ applies the NEQ predicate on the "backdated_billing_pending" field.
+func BackdatedBillingPendingNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldBackdatedBillingPending, v))
+}
+
+// SpendLimitStateEQ applies the EQ predicate on the "spend_limit_state" field.
+func SpendLimitStateEQ(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEQ(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateNEQ applies the NEQ predicate on the "spend_limit_state" field.
+func SpendLimitStateNEQ(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldNEQ(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateIn applies the In predicate on the "spend_limit_state" field.
+func SpendLimitStateIn(vs ...types.SpendLimitState) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldIn(FieldSpendLimitState, v...))
+}
+
+// SpendLimitStateNotIn applies the NotIn predicate on the "spend_limit_state" field.
+func SpendLimitStateNotIn(vs ...types.SpendLimitState) predicate.Subscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Subscription(sql.FieldNotIn(FieldSpendLimitState, v...))
+}
+
+// SpendLimitStateGT applies the GT predicate on the "spend_limit_state" field.
+func SpendLimitStateGT(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGT(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateGTE applies the GTE predicate on the "spend_limit_state" field.
+func SpendLimitStateGTE(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldGTE(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateLT applies the LT predicate on the "spend_limit_state" field.
+func SpendLimitStateLT(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLT(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateLTE applies the LTE predicate on the "spend_limit_state" field.
+func SpendLimitStateLTE(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldLTE(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateContains applies the Contains predicate on the "spend_limit_state" field.
+func SpendLimitStateContains(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContains(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateHasPrefix applies the HasPrefix predicate on the "spend_limit_state" field.
+func SpendLimitStateHasPrefix(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasPrefix(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateHasSuffix applies the HasSuffix predicate on the "spend_limit_state" field.
+func SpendLimitStateHasSuffix(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldHasSuffix(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateEqualFold applies the EqualFold predicate on the "spend_limit_state" field.
+func SpendLimitStateEqualFold(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldEqualFold(FieldSpendLimitState, vc))
+}
+
+// SpendLimitStateContainsFold applies the ContainsFold predicate on the "spend_limit_state" field.
+func SpendLimitStateContainsFold(v types.SpendLimitState) predicate.Subscription {
+	vc := string(v)
+	return predicate.Subscription(sql.FieldContainsFold(FieldSpendLimitState, vc))
+}
+
+// SpendLimitedAtEQ applies the EQ predicate on the "spend_limited_at" field.
+func SpendLimitedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtNEQ applies the NEQ predicate on the "spend_limited_at" field.
+func SpendLimitedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtIn applies the In predicate on the "spend_limited_at" field.
+func SpendLimitedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldSpendLimitedAt, vs...))
+}
+
+// SpendLimitedAtNotIn applies the NotIn predicate on the "spend_limited_at" field.
+func SpendLimitedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldSpendLimitedAt, vs...))
+}
+
+// SpendLimitedAtGT applies the GT predicate on the "spend_limited_at" field.
+func SpendLimitedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtGTE applies the GTE predicate on the "spend_limited_at" field.
+func SpendLimitedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtLT applies the LT predicate on the "spend_limited_at" field.
+func SpendLimitedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtLTE applies the LTE predicate on the "spend_limited_at" field.
+func SpendLimitedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldSpendLimitedAt, v))
+}
+
+// SpendLimitedAtIsNil applies the IsNil predicate on the "spend_limited_at" field.
+func SpendLimitedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldSpendLimitedAt))
+}
+
+// SpendLimitedAtNotNil applies the NotNil predicate on the "spend_limited_at" field.
+func SpendLimitedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldSpendLimitedAt))
+}
+
+// TermMonthsEQ applies the EQ predicate on the "term_months" field.
+func TermMonthsEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTermMonths, v))
+}
+
+// TermMonthsNEQ applies the NEQ predicate on the "term_months" field.
+func TermMonthsNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTermMonths, v))
+}
+
+// TermMonthsIn applies the In predicate on the "term_months" field.
+func TermMonthsIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTermMonths, vs...))
+}
+
+// TermMonthsNotIn applies the NotIn predicate on the "term_months" field.
+func TermMonthsNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTermMonths, vs...))
+}
+
+// TermMonthsGT applies the GT predicate on the "term_months" field.
+func TermMonthsGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTermMonths, v))
+}
+
+// TermMonthsGTE applies the GTE predicate on the "term_months" field.
+func TermMonthsGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTermMonths, v))
+}
+
+// TermMonthsLT applies the LT predicate on the "term_months" field.
+func TermMonthsLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTermMonths, v))
+}
+
+// TermMonthsLTE applies the LTE predicate on the "term_months" field.
+func TermMonthsLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTermMonths, v))
+}
+
+// AutoRenewEQ applies the EQ predicate on the "auto_renew" field.
+func AutoRenewEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldAutoRenew, v))
+}
+
+// AutoRenewNEQ applies the NEQ predicate on the "auto_renew" field.
+func AutoRenewNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldAutoRenew, v))
+}
+
+// RenewalNoticeDaysEQ applies the EQ predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRenewalNoticeDays, v))
+}
+
+// RenewalNoticeDaysNEQ applies the NEQ predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldRenewalNoticeDays, v))
+}
+
+// RenewalNoticeDaysIn applies the In predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldRenewalNoticeDays, vs...))
+}
+
+// RenewalNoticeDaysNotIn applies the NotIn predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldRenewalNoticeDays, vs...))
+}
+
+// RenewalNoticeDaysGT applies the GT predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldRenewalNoticeDays, v))
+}
+
+// RenewalNoticeDaysGTE applies the GTE predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldRenewalNoticeDays, v))
+}
+
+// RenewalNoticeDaysLT applies the LT predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldRenewalNoticeDays, v))
+}
+
+// RenewalNoticeDaysLTE applies the LTE predicate on the "renewal_notice_days" field.
+func RenewalNoticeDaysLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldRenewalNoticeDays, v))
+}
+
+// RenewalPlanIDEQ applies the EQ predicate on the "renewal_plan_id" field.
+func RenewalPlanIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDNEQ applies the NEQ predicate on the "renewal_plan_id" field.
+func RenewalPlanIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDIn applies the In predicate on the "renewal_plan_id" field.
+func RenewalPlanIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldRenewalPlanID, vs...))
+}
+
+// RenewalPlanIDNotIn applies the NotIn predicate on the "renewal_plan_id" field.
+func RenewalPlanIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldRenewalPlanID, vs...))
+}
+
+// RenewalPlanIDGT applies the GT predicate on the "renewal_plan_id" field.
+func RenewalPlanIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDGTE applies the GTE predicate on the "renewal_plan_id" field.
+func RenewalPlanIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDLT applies the LT predicate on the "renewal_plan_id" field.
+func RenewalPlanIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDLTE applies the LTE predicate on the "renewal_plan_id" field.
+func RenewalPlanIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDContains applies the Contains predicate on the "renewal_plan_id" field.
+func RenewalPlanIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDHasPrefix applies the HasPrefix predicate on the "renewal_plan_id" field.
+func RenewalPlanIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDHasSuffix applies the HasSuffix predicate on the "renewal_plan_id" field.
+func RenewalPlanIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDIsNil applies the IsNil predicate on the "renewal_plan_id" field.
+func RenewalPlanIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldRenewalPlanID))
+}
+
+// RenewalPlanIDNotNil applies the NotNil predicate on the "renewal_plan_id" field.
+func RenewalPlanIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldRenewalPlanID))
+}
+
+// RenewalPlanIDEqualFold applies the EqualFold predicate on the "renewal_plan_id" field.
+func RenewalPlanIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldRenewalPlanID, v))
+}
+
+// RenewalPlanIDContainsFold applies the ContainsFold predicate on the "renewal_plan_id" field.
+func RenewalPlanIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldRenewalPlanID, v))
+}
+
+// CurrentTermStartEQ applies the EQ predicate on the "current_term_start" field.
+func CurrentTermStartEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartNEQ applies the NEQ predicate on the "current_term_start" field.
+func CurrentTermStartNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartIn applies the In predicate on the "current_term_start" field.
+func CurrentTermStartIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentTermStart, vs...))
+}
+
+// CurrentTermStartNotIn applies the NotIn predicate on the "current_term_start" field.
+func CurrentTermStartNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentTermStart, vs...))
+}
+
+// CurrentTermStartGT applies the GT predicate on the "current_term_start" field.
+func CurrentTermStartGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartGTE applies the GTE predicate on the "current_term_start" field.
+func CurrentTermStartGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartLT applies the LT predicate on the "current_term_start" field.
+func CurrentTermStartLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartLTE applies the LTE predicate on the "current_term_start" field.
+func CurrentTermStartLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentTermStart, v))
+}
+
+// CurrentTermStartIsNil applies the IsNil predicate on the "current_term_start" field.
+func CurrentTermStartIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentTermStart))
+}
+
+// CurrentTermStartNotNil applies the NotNil predicate on the "current_term_start" field.
+func CurrentTermStartNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentTermStart))
+}
+
+// CurrentTermEndEQ applies the EQ predicate on the "current_term_end" field.
+func CurrentTermEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndNEQ applies the NEQ predicate on the "current_term_end" field.
+func CurrentTermEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndIn applies the In predicate on the "current_term_end" field.
+func CurrentTermEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentTermEnd, vs...))
+}
+
+// CurrentTermEndNotIn applies the NotIn predicate on the "current_term_end" field.
+func CurrentTermEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentTermEnd, vs...))
+}
+
+// CurrentTermEndGT applies the GT predicate on the "current_term_end" field.
+func CurrentTermEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndGTE applies the GTE predicate on the "current_term_end" field.
+func CurrentTermEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndLT applies the LT predicate on the "current_term_end" field.
+func CurrentTermEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndLTE applies the LTE predicate on the "current_term_end" field.
+func CurrentTermEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentTermEnd, v))
+}
+
+// CurrentTermEndIsNil applies the IsNil predicate on the "current_term_end" field.
+func CurrentTermEndIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCurrentTermEnd))
+}
+
+// CurrentTermEndNotNil applies the NotNil predicate on the "current_term_end" field.
+func CurrentTermEndNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCurrentTermEnd))
+}
+
 // HasLineItems applies the HasEdge predicate on the "line_items" edge.
 func HasLineItems() predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
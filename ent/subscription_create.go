@@ -520,6 +520,194 @@ func (sc *SubscriptionCreate) SetNillableInvoicingCustomerID(s *string) *Subscri
 	return sc
 }
 
+// SetBillingThresholdAmount sets the "billing_threshold_amount" field.
+func (sc *SubscriptionCreate) SetBillingThresholdAmount(d decimal.Decimal) *SubscriptionCreate {
+	sc.mutation.SetBillingThresholdAmount(d)
+	return sc
+}
+
+// SetNillableBillingThresholdAmount sets the "billing_threshold_amount" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableBillingThresholdAmount(d *decimal.Decimal) *SubscriptionCreate {
+	if d != nil {
+		sc.SetBillingThresholdAmount(*d)
+	}
+	return sc
+}
+
+// SetTrialEndAction sets the "trial_end_action" field.
+func (sc *SubscriptionCreate) SetTrialEndAction(tea types.TrialEndAction) *SubscriptionCreate {
+	sc.mutation.SetTrialEndAction(tea)
+	return sc
+}
+
+// SetNillableTrialEndAction sets the "trial_end_action" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTrialEndAction(tea *types.TrialEndAction) *SubscriptionCreate {
+	if tea != nil {
+		sc.SetTrialEndAction(*tea)
+	}
+	return sc
+}
+
+// SetTrialReminderDays sets the "trial_reminder_days" field.
+func (sc *SubscriptionCreate) SetTrialReminderDays(i int) *SubscriptionCreate {
+	sc.mutation.SetTrialReminderDays(i)
+	return sc
+}
+
+// SetNillableTrialReminderDays sets the "trial_reminder_days" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTrialReminderDays(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetTrialReminderDays(*i)
+	}
+	return sc
+}
+
+// SetTrialUsageCaps sets the "trial_usage_caps" field.
+func (sc *SubscriptionCreate) SetTrialUsageCaps(m map[string]decimal.Decimal) *SubscriptionCreate {
+	sc.mutation.SetTrialUsageCaps(m)
+	return sc
+}
+
+// SetBackdatedBillingMode sets the "backdated_billing_mode" field.
+func (sc *SubscriptionCreate) SetBackdatedBillingMode(tbm types.BackdatedBillingMode) *SubscriptionCreate {
+	sc.mutation.SetBackdatedBillingMode(tbm)
+	return sc
+}
+
+// SetNillableBackdatedBillingMode sets the "backdated_billing_mode" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableBackdatedBillingMode(tbm *types.BackdatedBillingMode) *SubscriptionCreate {
+	if tbm != nil {
+		sc.SetBackdatedBillingMode(*tbm)
+	}
+	return sc
+}
+
+// SetBackdatedBillingPending sets the "backdated_billing_pending" field.
+func (sc *SubscriptionCreate) SetBackdatedBillingPending(b bool) *SubscriptionCreate {
+	sc.mutation.SetBackdatedBillingPending(b)
+	return sc
+}
+
+// SetNillableBackdatedBillingPending sets the "backdated_billing_pending" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableBackdatedBillingPending(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetBackdatedBillingPending(*b)
+	}
+	return sc
+}
+
+// SetSpendLimitState sets the "spend_limit_state" field.
+func (sc *SubscriptionCreate) SetSpendLimitState(tls types.SpendLimitState) *SubscriptionCreate {
+	sc.mutation.SetSpendLimitState(tls)
+	return sc
+}
+
+// SetNillableSpendLimitState sets the "spend_limit_state" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableSpendLimitState(tls *types.SpendLimitState) *SubscriptionCreate {
+	if tls != nil {
+		sc.SetSpendLimitState(*tls)
+	}
+	return sc
+}
+
+// SetSpendLimitedAt sets the "spend_limited_at" field.
+func (sc *SubscriptionCreate) SetSpendLimitedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetSpendLimitedAt(t)
+	return sc
+}
+
+// SetNillableSpendLimitedAt sets the "spend_limited_at" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableSpendLimitedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetSpendLimitedAt(*t)
+	}
+	return sc
+}
+
+// SetTermMonths sets the "term_months" field.
+func (sc *SubscriptionCreate) SetTermMonths(i int) *SubscriptionCreate {
+	sc.mutation.SetTermMonths(i)
+	return sc
+}
+
+// SetNillableTermMonths sets the "term_months" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTermMonths(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetTermMonths(*i)
+	}
+	return sc
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (sc *SubscriptionCreate) SetAutoRenew(b bool) *SubscriptionCreate {
+	sc.mutation.SetAutoRenew(b)
+	return sc
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableAutoRenew(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetAutoRenew(*b)
+	}
+	return sc
+}
+
+// SetRenewalNoticeDays sets the "renewal_notice_days" field.
+func (sc *SubscriptionCreate) SetRenewalNoticeDays(i int) *SubscriptionCreate {
+	sc.mutation.SetRenewalNoticeDays(i)
+	return sc
+}
+
+// SetNillableRenewalNoticeDays sets the "renewal_notice_days" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableRenewalNoticeDays(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetRenewalNoticeDays(*i)
+	}
+	return sc
+}
+
+// SetRenewalPlanID sets the "renewal_plan_id" field.
+func (sc *SubscriptionCreate) SetRenewalPlanID(s string) *SubscriptionCreate {
+	sc.mutation.SetRenewalPlanID(s)
+	return sc
+}
+
+// SetNillableRenewalPlanID sets the "renewal_plan_id" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableRenewalPlanID(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetRenewalPlanID(*s)
+	}
+	return sc
+}
+
+// SetCurrentTermStart sets the "current_term_start" field.
+func (sc *SubscriptionCreate) SetCurrentTermStart(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCurrentTermStart(t)
+	return sc
+}
+
+// SetNillableCurrentTermStart sets the "current_term_start" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCurrentTermStart(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCurrentTermStart(*t)
+	}
+	return sc
+}
+
+// SetCurrentTermEnd sets the "current_term_end" field.
+func (sc *SubscriptionCreate) SetCurrentTermEnd(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCurrentTermEnd(t)
+	return sc
+}
+
+// SetNillableCurrentTermEnd sets the "current_term_end" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCurrentTermEnd(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCurrentTermEnd(*t)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SubscriptionCreate) SetID(s string) *SubscriptionCreate {
 	sc.mutation.SetID(s)
@@ -751,6 +939,38 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultEnableTrueUp
 		sc.mutation.SetEnableTrueUp(v)
 	}
+	if _, ok := sc.mutation.TrialEndAction(); !ok {
+		v := subscription.DefaultTrialEndAction
+		sc.mutation.SetTrialEndAction(v)
+	}
+	if _, ok := sc.mutation.TrialReminderDays(); !ok {
+		v := subscription.DefaultTrialReminderDays
+		sc.mutation.SetTrialReminderDays(v)
+	}
+	if _, ok := sc.mutation.BackdatedBillingMode(); !ok {
+		v := subscription.DefaultBackdatedBillingMode
+		sc.mutation.SetBackdatedBillingMode(v)
+	}
+	if _, ok := sc.mutation.BackdatedBillingPending(); !ok {
+		v := subscription.DefaultBackdatedBillingPending
+		sc.mutation.SetBackdatedBillingPending(v)
+	}
+	if _, ok := sc.mutation.SpendLimitState(); !ok {
+		v := subscription.DefaultSpendLimitState
+		sc.mutation.SetSpendLimitState(v)
+	}
+	if _, ok := sc.mutation.TermMonths(); !ok {
+		v := subscription.DefaultTermMonths
+		sc.mutation.SetTermMonths(v)
+	}
+	if _, ok := sc.mutation.AutoRenew(); !ok {
+		v := subscription.DefaultAutoRenew
+		sc.mutation.SetAutoRenew(v)
+	}
+	if _, ok := sc.mutation.RenewalNoticeDays(); !ok {
+		v := subscription.DefaultRenewalNoticeDays
+		sc.mutation.SetRenewalNoticeDays(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -887,6 +1107,45 @@ func (sc *SubscriptionCreate) check() error {
 	if _, ok := sc.mutation.EnableTrueUp(); !ok {
 		return &ValidationError{Name: "enable_true_up", err: errors.New(`ent: missing required field "Subscription.enable_true_up"`)}
 	}
+	if _, ok := sc.mutation.TrialEndAction(); !ok {
+		return &ValidationError{Name: "trial_end_action", err: errors.New(`ent: missing required field "Subscription.trial_end_action"`)}
+	}
+	if v, ok := sc.mutation.TrialEndAction(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "trial_end_action", err: fmt.Errorf(`ent: validator failed for field "Subscription.trial_end_action": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.TrialReminderDays(); !ok {
+		return &ValidationError{Name: "trial_reminder_days", err: errors.New(`ent: missing required field "Subscription.trial_reminder_days"`)}
+	}
+	if _, ok := sc.mutation.BackdatedBillingMode(); !ok {
+		return &ValidationError{Name: "backdated_billing_mode", err: errors.New(`ent: missing required field "Subscription.backdated_billing_mode"`)}
+	}
+	if v, ok := sc.mutation.BackdatedBillingMode(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "backdated_billing_mode", err: fmt.Errorf(`ent: validator failed for field "Subscription.backdated_billing_mode": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.BackdatedBillingPending(); !ok {
+		return &ValidationError{Name: "backdated_billing_pending", err: errors.New(`ent: missing required field "Subscription.backdated_billing_pending"`)}
+	}
+	if _, ok := sc.mutation.SpendLimitState(); !ok {
+		return &ValidationError{Name: "spend_limit_state", err: errors.New(`ent: missing required field "Subscription.spend_limit_state"`)}
+	}
+	if v, ok := sc.mutation.SpendLimitState(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "spend_limit_state", err: fmt.Errorf(`ent: validator failed for field "Subscription.spend_limit_state": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.TermMonths(); !ok {
+		return &ValidationError{Name: "term_months", err: errors.New(`ent: missing required field "Subscription.term_months"`)}
+	}
+	if _, ok := sc.mutation.AutoRenew(); !ok {
+		return &ValidationError{Name: "auto_renew", err: errors.New(`ent: missing required field "Subscription.auto_renew"`)}
+	}
+	if _, ok := sc.mutation.RenewalNoticeDays(); !ok {
+		return &ValidationError{Name: "renewal_notice_days", err: errors.New(`ent: missing required field "Subscription.renewal_notice_days"`)}
+	}
 	return nil
 }
 
@@ -1074,6 +1333,62 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldEnableTrueUp, field.TypeBool, value)
 		_node.EnableTrueUp = value
 	}
+	if value, ok := sc.mutation.BillingThresholdAmount(); ok {
+		_spec.SetField(subscription.FieldBillingThresholdAmount, field.TypeOther, value)
+		_node.BillingThresholdAmount = &value
+	}
+	if value, ok := sc.mutation.TrialEndAction(); ok {
+		_spec.SetField(subscription.FieldTrialEndAction, field.TypeString, value)
+		_node.TrialEndAction = value
+	}
+	if value, ok := sc.mutation.TrialReminderDays(); ok {
+		_spec.SetField(subscription.FieldTrialReminderDays, field.TypeInt, value)
+		_node.TrialReminderDays = value
+	}
+	if value, ok := sc.mutation.TrialUsageCaps(); ok {
+		_spec.SetField(subscription.FieldTrialUsageCaps, field.TypeJSON, value)
+		_node.TrialUsageCaps = value
+	}
+	if value, ok := sc.mutation.BackdatedBillingMode(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingMode, field.TypeString, value)
+		_node.BackdatedBillingMode = value
+	}
+	if value, ok := sc.mutation.BackdatedBillingPending(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingPending, field.TypeBool, value)
+		_node.BackdatedBillingPending = value
+	}
+	if value, ok := sc.mutation.SpendLimitState(); ok {
+		_spec.SetField(subscription.FieldSpendLimitState, field.TypeString, value)
+		_node.SpendLimitState = value
+	}
+	if value, ok := sc.mutation.SpendLimitedAt(); ok {
+		_spec.SetField(subscription.FieldSpendLimitedAt, field.TypeTime, value)
+		_node.SpendLimitedAt = &value
+	}
+	if value, ok := sc.mutation.TermMonths(); ok {
+		_spec.SetField(subscription.FieldTermMonths, field.TypeInt, value)
+		_node.TermMonths = value
+	}
+	if value, ok := sc.mutation.AutoRenew(); ok {
+		_spec.SetField(subscription.FieldAutoRenew, field.TypeBool, value)
+		_node.AutoRenew = value
+	}
+	if value, ok := sc.mutation.RenewalNoticeDays(); ok {
+		_spec.SetField(subscription.FieldRenewalNoticeDays, field.TypeInt, value)
+		_node.RenewalNoticeDays = value
+	}
+	if value, ok := sc.mutation.RenewalPlanID(); ok {
+		_spec.SetField(subscription.FieldRenewalPlanID, field.TypeString, value)
+		_node.RenewalPlanID = &value
+	}
+	if value, ok := sc.mutation.CurrentTermStart(); ok {
+		_spec.SetField(subscription.FieldCurrentTermStart, field.TypeTime, value)
+		_node.CurrentTermStart = &value
+	}
+	if value, ok := sc.mutation.CurrentTermEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentTermEnd, field.TypeTime, value)
+		_node.CurrentTermEnd = &value
+	}
 	if nodes := sc.mutation.LineItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -98,6 +98,20 @@ func (su *SubscriptionUpdate) ClearLookupKey() *SubscriptionUpdate {
 	return su
 }
 
+// SetPlanID sets the "plan_id" field.
+func (su *SubscriptionUpdate) SetPlanID(s string) *SubscriptionUpdate {
+	su.mutation.SetPlanID(s)
+	return su
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillablePlanID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetPlanID(*s)
+	}
+	return su
+}
+
 // SetSubscriptionStatus sets the "subscription_status" field.
 func (su *SubscriptionUpdate) SetSubscriptionStatus(ts types.SubscriptionStatus) *SubscriptionUpdate {
 	su.mutation.SetSubscriptionStatus(ts)
@@ -485,6 +499,251 @@ func (su *SubscriptionUpdate) ClearInvoicingCustomerID() *SubscriptionUpdate {
 	return su
 }
 
+// SetBillingThresholdAmount sets the "billing_threshold_amount" field.
+func (su *SubscriptionUpdate) SetBillingThresholdAmount(d decimal.Decimal) *SubscriptionUpdate {
+	su.mutation.SetBillingThresholdAmount(d)
+	return su
+}
+
+// SetNillableBillingThresholdAmount sets the "billing_threshold_amount" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableBillingThresholdAmount(d *decimal.Decimal) *SubscriptionUpdate {
+	if d != nil {
+		su.SetBillingThresholdAmount(*d)
+	}
+	return su
+}
+
+// ClearBillingThresholdAmount clears the value of the "billing_threshold_amount" field.
+func (su *SubscriptionUpdate) ClearBillingThresholdAmount() *SubscriptionUpdate {
+	su.mutation.ClearBillingThresholdAmount()
+	return su
+}
+
+// SetTrialEndAction sets the "trial_end_action" field.
+func (su *SubscriptionUpdate) SetTrialEndAction(tea types.TrialEndAction) *SubscriptionUpdate {
+	su.mutation.SetTrialEndAction(tea)
+	return su
+}
+
+// SetNillableTrialEndAction sets the "trial_end_action" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTrialEndAction(tea *types.TrialEndAction) *SubscriptionUpdate {
+	if tea != nil {
+		su.SetTrialEndAction(*tea)
+	}
+	return su
+}
+
+// SetTrialReminderDays sets the "trial_reminder_days" field.
+func (su *SubscriptionUpdate) SetTrialReminderDays(i int) *SubscriptionUpdate {
+	su.mutation.ResetTrialReminderDays()
+	su.mutation.SetTrialReminderDays(i)
+	return su
+}
+
+// SetNillableTrialReminderDays sets the "trial_reminder_days" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTrialReminderDays(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetTrialReminderDays(*i)
+	}
+	return su
+}
+
+// AddTrialReminderDays adds i to the "trial_reminder_days" field.
+func (su *SubscriptionUpdate) AddTrialReminderDays(i int) *SubscriptionUpdate {
+	su.mutation.AddTrialReminderDays(i)
+	return su
+}
+
+// SetTrialUsageCaps sets the "trial_usage_caps" field.
+func (su *SubscriptionUpdate) SetTrialUsageCaps(m map[string]decimal.Decimal) *SubscriptionUpdate {
+	su.mutation.SetTrialUsageCaps(m)
+	return su
+}
+
+// ClearTrialUsageCaps clears the value of the "trial_usage_caps" field.
+func (su *SubscriptionUpdate) ClearTrialUsageCaps() *SubscriptionUpdate {
+	su.mutation.ClearTrialUsageCaps()
+	return su
+}
+
+// SetBackdatedBillingMode sets the "backdated_billing_mode" field.
+func (su *SubscriptionUpdate) SetBackdatedBillingMode(tbm types.BackdatedBillingMode) *SubscriptionUpdate {
+	su.mutation.SetBackdatedBillingMode(tbm)
+	return su
+}
+
+// SetNillableBackdatedBillingMode sets the "backdated_billing_mode" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableBackdatedBillingMode(tbm *types.BackdatedBillingMode) *SubscriptionUpdate {
+	if tbm != nil {
+		su.SetBackdatedBillingMode(*tbm)
+	}
+	return su
+}
+
+// SetBackdatedBillingPending sets the "backdated_billing_pending" field.
+func (su *SubscriptionUpdate) SetBackdatedBillingPending(b bool) *SubscriptionUpdate {
+	su.mutation.SetBackdatedBillingPending(b)
+	return su
+}
+
+// SetNillableBackdatedBillingPending sets the "backdated_billing_pending" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableBackdatedBillingPending(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetBackdatedBillingPending(*b)
+	}
+	return su
+}
+
+// SetSpendLimitState sets the "spend_limit_state" field.
+func (su *SubscriptionUpdate) SetSpendLimitState(tls types.SpendLimitState) *SubscriptionUpdate {
+	su.mutation.SetSpendLimitState(tls)
+	return su
+}
+
+// SetNillableSpendLimitState sets the "spend_limit_state" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableSpendLimitState(tls *types.SpendLimitState) *SubscriptionUpdate {
+	if tls != nil {
+		su.SetSpendLimitState(*tls)
+	}
+	return su
+}
+
+// SetSpendLimitedAt sets the "spend_limited_at" field.
+func (su *SubscriptionUpdate) SetSpendLimitedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetSpendLimitedAt(t)
+	return su
+}
+
+// SetNillableSpendLimitedAt sets the "spend_limited_at" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableSpendLimitedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetSpendLimitedAt(*t)
+	}
+	return su
+}
+
+// ClearSpendLimitedAt clears the value of the "spend_limited_at" field.
+func (su *SubscriptionUpdate) ClearSpendLimitedAt() *SubscriptionUpdate {
+	su.mutation.ClearSpendLimitedAt()
+	return su
+}
+
+// SetTermMonths sets the "term_months" field.
+func (su *SubscriptionUpdate) SetTermMonths(i int) *SubscriptionUpdate {
+	su.mutation.ResetTermMonths()
+	su.mutation.SetTermMonths(i)
+	return su
+}
+
+// SetNillableTermMonths sets the "term_months" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTermMonths(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetTermMonths(*i)
+	}
+	return su
+}
+
+// AddTermMonths adds i to the "term_months" field.
+func (su *SubscriptionUpdate) AddTermMonths(i int) *SubscriptionUpdate {
+	su.mutation.AddTermMonths(i)
+	return su
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (su *SubscriptionUpdate) SetAutoRenew(b bool) *SubscriptionUpdate {
+	su.mutation.SetAutoRenew(b)
+	return su
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableAutoRenew(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetAutoRenew(*b)
+	}
+	return su
+}
+
+// SetRenewalNoticeDays sets the "renewal_notice_days" field.
+func (su *SubscriptionUpdate) SetRenewalNoticeDays(i int) *SubscriptionUpdate {
+	su.mutation.ResetRenewalNoticeDays()
+	su.mutation.SetRenewalNoticeDays(i)
+	return su
+}
+
+// SetNillableRenewalNoticeDays sets the "renewal_notice_days" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableRenewalNoticeDays(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetRenewalNoticeDays(*i)
+	}
+	return su
+}
+
+// AddRenewalNoticeDays adds i to the "renewal_notice_days" field.
+func (su *SubscriptionUpdate) AddRenewalNoticeDays(i int) *SubscriptionUpdate {
+	su.mutation.AddRenewalNoticeDays(i)
+	return su
+}
+
+// SetRenewalPlanID sets the "renewal_plan_id" field.
+func (su *SubscriptionUpdate) SetRenewalPlanID(s string) *SubscriptionUpdate {
+	su.mutation.SetRenewalPlanID(s)
+	return su
+}
+
+// SetNillableRenewalPlanID sets the "renewal_plan_id" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableRenewalPlanID(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetRenewalPlanID(*s)
+	}
+	return su
+}
+
+// ClearRenewalPlanID clears the value of the "renewal_plan_id" field.
+func (su *SubscriptionUpdate) ClearRenewalPlanID() *SubscriptionUpdate {
+	su.mutation.ClearRenewalPlanID()
+	return su
+}
+
+// SetCurrentTermStart sets the "current_term_start" field.
+func (su *SubscriptionUpdate) SetCurrentTermStart(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentTermStart(t)
+	return su
+}
+
+// SetNillableCurrentTermStart sets the "current_term_start" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentTermStart(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentTermStart(*t)
+	}
+	return su
+}
+
+// ClearCurrentTermStart clears the value of the "current_term_start" field.
+func (su *SubscriptionUpdate) ClearCurrentTermStart() *SubscriptionUpdate {
+	su.mutation.ClearCurrentTermStart()
+	return su
+}
+
+// SetCurrentTermEnd sets the "current_term_end" field.
+func (su *SubscriptionUpdate) SetCurrentTermEnd(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCurrentTermEnd(t)
+	return su
+}
+
+// SetNillableCurrentTermEnd sets the "current_term_end" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCurrentTermEnd(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCurrentTermEnd(*t)
+	}
+	return su
+}
+
+// ClearCurrentTermEnd clears the value of the "current_term_end" field.
+func (su *SubscriptionUpdate) ClearCurrentTermEnd() *SubscriptionUpdate {
+	su.mutation.ClearCurrentTermEnd()
+	return su
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (su *SubscriptionUpdate) AddLineItemIDs(ids ...string) *SubscriptionUpdate {
 	su.mutation.AddLineItemIDs(ids...)
@@ -789,7 +1048,55 @@ func (su *SubscriptionUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if v, ok := su.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.SubscriptionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "subscription_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_status": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.PauseStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pause_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.pause_status": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.PaymentBehavior(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "payment_behavior", err: fmt.Errorf(`ent: validator failed for field "Subscription.payment_behavior": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.CollectionMethod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "collection_method", err: fmt.Errorf(`ent: validator failed for field "Subscription.collection_method": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TrialEndAction(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "trial_end_action", err: fmt.Errorf(`ent: validator failed for field "Subscription.trial_end_action": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.BackdatedBillingMode(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "backdated_billing_mode", err: fmt.Errorf(`ent: validator failed for field "Subscription.backdated_billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.SpendLimitState(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "spend_limit_state", err: fmt.Errorf(`ent: validator failed for field "Subscription.spend_limit_state": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	if ps := su.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -822,6 +1129,9 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.LookupKeyCleared() {
 		_spec.ClearField(subscription.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := su.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
+	}
 	if value, ok := su.mutation.SubscriptionStatus(); ok {
 		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
 	}
@@ -921,6 +1231,75 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.EnableTrueUp(); ok {
 		_spec.SetField(subscription.FieldEnableTrueUp, field.TypeBool, value)
 	}
+	if value, ok := su.mutation.BillingThresholdAmount(); ok {
+		_spec.SetField(subscription.FieldBillingThresholdAmount, field.TypeOther, value)
+	}
+	if su.mutation.BillingThresholdAmountCleared() {
+		_spec.ClearField(subscription.FieldBillingThresholdAmount, field.TypeOther)
+	}
+	if value, ok := su.mutation.TrialEndAction(); ok {
+		_spec.SetField(subscription.FieldTrialEndAction, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TrialReminderDays(); ok {
+		_spec.SetField(subscription.FieldTrialReminderDays, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTrialReminderDays(); ok {
+		_spec.AddField(subscription.FieldTrialReminderDays, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.TrialUsageCaps(); ok {
+		_spec.SetField(subscription.FieldTrialUsageCaps, field.TypeJSON, value)
+	}
+	if su.mutation.TrialUsageCapsCleared() {
+		_spec.ClearField(subscription.FieldTrialUsageCaps, field.TypeJSON)
+	}
+	if value, ok := su.mutation.BackdatedBillingMode(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingMode, field.TypeString, value)
+	}
+	if value, ok := su.mutation.BackdatedBillingPending(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingPending, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.SpendLimitState(); ok {
+		_spec.SetField(subscription.FieldSpendLimitState, field.TypeString, value)
+	}
+	if value, ok := su.mutation.SpendLimitedAt(); ok {
+		_spec.SetField(subscription.FieldSpendLimitedAt, field.TypeTime, value)
+	}
+	if su.mutation.SpendLimitedAtCleared() {
+		_spec.ClearField(subscription.FieldSpendLimitedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.TermMonths(); ok {
+		_spec.SetField(subscription.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTermMonths(); ok {
+		_spec.AddField(subscription.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AutoRenew(); ok {
+		_spec.SetField(subscription.FieldAutoRenew, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.RenewalNoticeDays(); ok {
+		_spec.SetField(subscription.FieldRenewalNoticeDays, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedRenewalNoticeDays(); ok {
+		_spec.AddField(subscription.FieldRenewalNoticeDays, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.RenewalPlanID(); ok {
+		_spec.SetField(subscription.FieldRenewalPlanID, field.TypeString, value)
+	}
+	if su.mutation.RenewalPlanIDCleared() {
+		_spec.ClearField(subscription.FieldRenewalPlanID, field.TypeString)
+	}
+	if value, ok := su.mutation.CurrentTermStart(); ok {
+		_spec.SetField(subscription.FieldCurrentTermStart, field.TypeTime, value)
+	}
+	if su.mutation.CurrentTermStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentTermStart, field.TypeTime)
+	}
+	if value, ok := su.mutation.CurrentTermEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentTermEnd, field.TypeTime, value)
+	}
+	if su.mutation.CurrentTermEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentTermEnd, field.TypeTime)
+	}
 	if su.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1345,6 +1724,20 @@ func (suo *SubscriptionUpdateOne) ClearLookupKey() *SubscriptionUpdateOne {
 	return suo
 }
 
+// SetPlanID sets the "plan_id" field.
+func (suo *SubscriptionUpdateOne) SetPlanID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetPlanID(s)
+	return suo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillablePlanID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetPlanID(*s)
+	}
+	return suo
+}
+
 // SetSubscriptionStatus sets the "subscription_status" field.
 func (suo *SubscriptionUpdateOne) SetSubscriptionStatus(ts types.SubscriptionStatus) *SubscriptionUpdateOne {
 	suo.mutation.SetSubscriptionStatus(ts)
@@ -1732,6 +2125,251 @@ func (suo *SubscriptionUpdateOne) ClearInvoicingCustomerID() *SubscriptionUpdate
 	return suo
 }
 
+// SetBillingThresholdAmount sets the "billing_threshold_amount" field.
+func (suo *SubscriptionUpdateOne) SetBillingThresholdAmount(d decimal.Decimal) *SubscriptionUpdateOne {
+	suo.mutation.SetBillingThresholdAmount(d)
+	return suo
+}
+
+// SetNillableBillingThresholdAmount sets the "billing_threshold_amount" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableBillingThresholdAmount(d *decimal.Decimal) *SubscriptionUpdateOne {
+	if d != nil {
+		suo.SetBillingThresholdAmount(*d)
+	}
+	return suo
+}
+
+// ClearBillingThresholdAmount clears the value of the "billing_threshold_amount" field.
+func (suo *SubscriptionUpdateOne) ClearBillingThresholdAmount() *SubscriptionUpdateOne {
+	suo.mutation.ClearBillingThresholdAmount()
+	return suo
+}
+
+// SetTrialEndAction sets the "trial_end_action" field.
+func (suo *SubscriptionUpdateOne) SetTrialEndAction(tea types.TrialEndAction) *SubscriptionUpdateOne {
+	suo.mutation.SetTrialEndAction(tea)
+	return suo
+}
+
+// SetNillableTrialEndAction sets the "trial_end_action" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTrialEndAction(tea *types.TrialEndAction) *SubscriptionUpdateOne {
+	if tea != nil {
+		suo.SetTrialEndAction(*tea)
+	}
+	return suo
+}
+
+// SetTrialReminderDays sets the "trial_reminder_days" field.
+func (suo *SubscriptionUpdateOne) SetTrialReminderDays(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetTrialReminderDays()
+	suo.mutation.SetTrialReminderDays(i)
+	return suo
+}
+
+// SetNillableTrialReminderDays sets the "trial_reminder_days" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTrialReminderDays(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetTrialReminderDays(*i)
+	}
+	return suo
+}
+
+// AddTrialReminderDays adds i to the "trial_reminder_days" field.
+func (suo *SubscriptionUpdateOne) AddTrialReminderDays(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddTrialReminderDays(i)
+	return suo
+}
+
+// SetTrialUsageCaps sets the "trial_usage_caps" field.
+func (suo *SubscriptionUpdateOne) SetTrialUsageCaps(m map[string]decimal.Decimal) *SubscriptionUpdateOne {
+	suo.mutation.SetTrialUsageCaps(m)
+	return suo
+}
+
+// ClearTrialUsageCaps clears the value of the "trial_usage_caps" field.
+func (suo *SubscriptionUpdateOne) ClearTrialUsageCaps() *SubscriptionUpdateOne {
+	suo.mutation.ClearTrialUsageCaps()
+	return suo
+}
+
+// SetBackdatedBillingMode sets the "backdated_billing_mode" field.
+func (suo *SubscriptionUpdateOne) SetBackdatedBillingMode(tbm types.BackdatedBillingMode) *SubscriptionUpdateOne {
+	suo.mutation.SetBackdatedBillingMode(tbm)
+	return suo
+}
+
+// SetNillableBackdatedBillingMode sets the "backdated_billing_mode" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableBackdatedBillingMode(tbm *types.BackdatedBillingMode) *SubscriptionUpdateOne {
+	if tbm != nil {
+		suo.SetBackdatedBillingMode(*tbm)
+	}
+	return suo
+}
+
+// SetBackdatedBillingPending sets the "backdated_billing_pending" field.
+func (suo *SubscriptionUpdateOne) SetBackdatedBillingPending(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetBackdatedBillingPending(b)
+	return suo
+}
+
+// SetNillableBackdatedBillingPending sets the "backdated_billing_pending" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableBackdatedBillingPending(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetBackdatedBillingPending(*b)
+	}
+	return suo
+}
+
+// SetSpendLimitState sets the "spend_limit_state" field.
+func (suo *SubscriptionUpdateOne) SetSpendLimitState(tls types.SpendLimitState) *SubscriptionUpdateOne {
+	suo.mutation.SetSpendLimitState(tls)
+	return suo
+}
+
+// SetNillableSpendLimitState sets the "spend_limit_state" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableSpendLimitState(tls *types.SpendLimitState) *SubscriptionUpdateOne {
+	if tls != nil {
+		suo.SetSpendLimitState(*tls)
+	}
+	return suo
+}
+
+// SetSpendLimitedAt sets the "spend_limited_at" field.
+func (suo *SubscriptionUpdateOne) SetSpendLimitedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetSpendLimitedAt(t)
+	return suo
+}
+
+// SetNillableSpendLimitedAt sets the "spend_limited_at" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableSpendLimitedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetSpendLimitedAt(*t)
+	}
+	return suo
+}
+
+// ClearSpendLimitedAt clears the value of the "spend_limited_at" field.
+func (suo *SubscriptionUpdateOne) ClearSpendLimitedAt() *SubscriptionUpdateOne {
+	suo.mutation.ClearSpendLimitedAt()
+	return suo
+}
+
+// SetTermMonths sets the "term_months" field.
+func (suo *SubscriptionUpdateOne) SetTermMonths(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetTermMonths()
+	suo.mutation.SetTermMonths(i)
+	return suo
+}
+
+// SetNillableTermMonths sets the "term_months" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTermMonths(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetTermMonths(*i)
+	}
+	return suo
+}
+
+// AddTermMonths adds i to the "term_months" field.
+func (suo *SubscriptionUpdateOne) AddTermMonths(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddTermMonths(i)
+	return suo
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (suo *SubscriptionUpdateOne) SetAutoRenew(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetAutoRenew(b)
+	return suo
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableAutoRenew(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetAutoRenew(*b)
+	}
+	return suo
+}
+
+// SetRenewalNoticeDays sets the "renewal_notice_days" field.
+func (suo *SubscriptionUpdateOne) SetRenewalNoticeDays(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetRenewalNoticeDays()
+	suo.mutation.SetRenewalNoticeDays(i)
+	return suo
+}
+
+// SetNillableRenewalNoticeDays sets the "renewal_notice_days" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableRenewalNoticeDays(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetRenewalNoticeDays(*i)
+	}
+	return suo
+}
+
+// AddRenewalNoticeDays adds i to the "renewal_notice_days" field.
+func (suo *SubscriptionUpdateOne) AddRenewalNoticeDays(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddRenewalNoticeDays(i)
+	return suo
+}
+
+// SetRenewalPlanID sets the "renewal_plan_id" field.
+func (suo *SubscriptionUpdateOne) SetRenewalPlanID(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetRenewalPlanID(s)
+	return suo
+}
+
+// SetNillableRenewalPlanID sets the "renewal_plan_id" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableRenewalPlanID(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetRenewalPlanID(*s)
+	}
+	return suo
+}
+
+// ClearRenewalPlanID clears the value of the "renewal_plan_id" field.
+func (suo *SubscriptionUpdateOne) ClearRenewalPlanID() *SubscriptionUpdateOne {
+	suo.mutation.ClearRenewalPlanID()
+	return suo
+}
+
+// SetCurrentTermStart sets the "current_term_start" field.
+func (suo *SubscriptionUpdateOne) SetCurrentTermStart(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentTermStart(t)
+	return suo
+}
+
+// SetNillableCurrentTermStart sets the "current_term_start" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentTermStart(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentTermStart(*t)
+	}
+	return suo
+}
+
+// ClearCurrentTermStart clears the value of the "current_term_start" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentTermStart() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentTermStart()
+	return suo
+}
+
+// SetCurrentTermEnd sets the "current_term_end" field.
+func (suo *SubscriptionUpdateOne) SetCurrentTermEnd(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCurrentTermEnd(t)
+	return suo
+}
+
+// SetNillableCurrentTermEnd sets the "current_term_end" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCurrentTermEnd(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCurrentTermEnd(*t)
+	}
+	return suo
+}
+
+// ClearCurrentTermEnd clears the value of the "current_term_end" field.
+func (suo *SubscriptionUpdateOne) ClearCurrentTermEnd() *SubscriptionUpdateOne {
+	suo.mutation.ClearCurrentTermEnd()
+	return suo
+}
+
 // AddLineItemIDs adds the "line_items" edge to the SubscriptionLineItem entity by IDs.
 func (suo *SubscriptionUpdateOne) AddLineItemIDs(ids ...string) *SubscriptionUpdateOne {
 	suo.mutation.AddLineItemIDs(ids...)
@@ -2049,7 +2687,55 @@ func (suo *SubscriptionUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if v, ok := suo.mutation.PlanID(); ok {
+		if err := subscription.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.SubscriptionStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "subscription_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.subscription_status": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.PauseStatus(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "pause_status", err: fmt.Errorf(`ent: validator failed for field "Subscription.pause_status": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.PaymentBehavior(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "payment_behavior", err: fmt.Errorf(`ent: validator failed for field "Subscription.payment_behavior": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.CollectionMethod(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "collection_method", err: fmt.Errorf(`ent: validator failed for field "Subscription.collection_method": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TrialEndAction(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "trial_end_action", err: fmt.Errorf(`ent: validator failed for field "Subscription.trial_end_action": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.BackdatedBillingMode(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "backdated_billing_mode", err: fmt.Errorf(`ent: validator failed for field "Subscription.backdated_billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.SpendLimitState(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "spend_limit_state", err: fmt.Errorf(`ent: validator failed for field "Subscription.spend_limit_state": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeString))
 	id, ok := suo.mutation.ID()
 	if !ok {
@@ -2099,6 +2785,9 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if suo.mutation.LookupKeyCleared() {
 		_spec.ClearField(subscription.FieldLookupKey, field.TypeString)
 	}
+	if value, ok := suo.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeString, value)
+	}
 	if value, ok := suo.mutation.SubscriptionStatus(); ok {
 		_spec.SetField(subscription.FieldSubscriptionStatus, field.TypeString, value)
 	}
@@ -2198,6 +2887,75 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 	if value, ok := suo.mutation.EnableTrueUp(); ok {
 		_spec.SetField(subscription.FieldEnableTrueUp, field.TypeBool, value)
 	}
+	if value, ok := suo.mutation.BillingThresholdAmount(); ok {
+		_spec.SetField(subscription.FieldBillingThresholdAmount, field.TypeOther, value)
+	}
+	if suo.mutation.BillingThresholdAmountCleared() {
+		_spec.ClearField(subscription.FieldBillingThresholdAmount, field.TypeOther)
+	}
+	if value, ok := suo.mutation.TrialEndAction(); ok {
+		_spec.SetField(subscription.FieldTrialEndAction, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TrialReminderDays(); ok {
+		_spec.SetField(subscription.FieldTrialReminderDays, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTrialReminderDays(); ok {
+		_spec.AddField(subscription.FieldTrialReminderDays, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.TrialUsageCaps(); ok {
+		_spec.SetField(subscription.FieldTrialUsageCaps, field.TypeJSON, value)
+	}
+	if suo.mutation.TrialUsageCapsCleared() {
+		_spec.ClearField(subscription.FieldTrialUsageCaps, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.BackdatedBillingMode(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingMode, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.BackdatedBillingPending(); ok {
+		_spec.SetField(subscription.FieldBackdatedBillingPending, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.SpendLimitState(); ok {
+		_spec.SetField(subscription.FieldSpendLimitState, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.SpendLimitedAt(); ok {
+		_spec.SetField(subscription.FieldSpendLimitedAt, field.TypeTime, value)
+	}
+	if suo.mutation.SpendLimitedAtCleared() {
+		_spec.ClearField(subscription.FieldSpendLimitedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.TermMonths(); ok {
+		_spec.SetField(subscription.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTermMonths(); ok {
+		_spec.AddField(subscription.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AutoRenew(); ok {
+		_spec.SetField(subscription.FieldAutoRenew, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.RenewalNoticeDays(); ok {
+		_spec.SetField(subscription.FieldRenewalNoticeDays, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedRenewalNoticeDays(); ok {
+		_spec.AddField(subscription.FieldRenewalNoticeDays, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.RenewalPlanID(); ok {
+		_spec.SetField(subscription.FieldRenewalPlanID, field.TypeString, value)
+	}
+	if suo.mutation.RenewalPlanIDCleared() {
+		_spec.ClearField(subscription.FieldRenewalPlanID, field.TypeString)
+	}
+	if value, ok := suo.mutation.CurrentTermStart(); ok {
+		_spec.SetField(subscription.FieldCurrentTermStart, field.TypeTime, value)
+	}
+	if suo.mutation.CurrentTermStartCleared() {
+		_spec.ClearField(subscription.FieldCurrentTermStart, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CurrentTermEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentTermEnd, field.TypeTime, value)
+	}
+	if suo.mutation.CurrentTermEndCleared() {
+		_spec.ClearField(subscription.FieldCurrentTermEnd, field.TypeTime)
+	}
 	if suo.mutation.LineItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -89,6 +89,12 @@ type SubscriptionLineItem struct {
 	CommitmentTrueUpEnabled bool `json:"commitment_true_up_enabled,omitempty"`
 	// CommitmentWindowed holds the value of the "commitment_windowed" field.
 	CommitmentWindowed bool `json:"commitment_windowed,omitempty"`
+	// QuantityChanges holds the value of the "quantity_changes" field.
+	QuantityChanges []types.LineItemQuantityChange `json:"quantity_changes,omitempty"`
+	// IncludedQuantity holds the value of the "included_quantity" field.
+	IncludedQuantity decimal.Decimal `json:"included_quantity,omitempty"`
+	// Rollover holds the value of the "rollover" field.
+	Rollover *types.LineItemRollover `json:"rollover,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionLineItemQuery when eager-loading is set.
 	Edges        SubscriptionLineItemEdges `json:"edges"`
@@ -133,9 +139,9 @@ func (*SubscriptionLineItem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscriptionlineitem.FieldCommitmentAmount, subscriptionlineitem.FieldCommitmentQuantity, subscriptionlineitem.FieldCommitmentOverageFactor:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case subscriptionlineitem.FieldMetadata:
+		case subscriptionlineitem.FieldMetadata, subscriptionlineitem.FieldQuantityChanges, subscriptionlineitem.FieldRollover:
 			values[i] = new([]byte)
-		case subscriptionlineitem.FieldQuantity:
+		case subscriptionlineitem.FieldQuantity, subscriptionlineitem.FieldIncludedQuantity:
 			values[i] = new(decimal.Decimal)
 		case subscriptionlineitem.FieldCommitmentTrueUpEnabled, subscriptionlineitem.FieldCommitmentWindowed:
 			values[i] = new(sql.NullBool)
@@ -387,6 +393,28 @@ func (sli *SubscriptionLineItem) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				sli.CommitmentWindowed = value.Bool
 			}
+		case subscriptionlineitem.FieldQuantityChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sli.QuantityChanges); err != nil {
+					return fmt.Errorf("unmarshal field quantity_changes: %w", err)
+				}
+			}
+		case subscriptionlineitem.FieldIncludedQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field included_quantity", values[i])
+			} else if value != nil {
+				sli.IncludedQuantity = *value
+			}
+		case subscriptionlineitem.FieldRollover:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sli.Rollover); err != nil {
+					return fmt.Errorf("unmarshal field rollover: %w", err)
+				}
+			}
 		default:
 			sli.selectValues.Set(columns[i], values[i])
 		}
@@ -564,6 +592,15 @@ func (sli *SubscriptionLineItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("commitment_windowed=")
 	builder.WriteString(fmt.Sprintf("%v", sli.CommitmentWindowed))
+	builder.WriteString(", ")
+	builder.WriteString("quantity_changes=")
+	builder.WriteString(fmt.Sprintf("%v", sli.QuantityChanges))
+	builder.WriteString(", ")
+	builder.WriteString("included_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", sli.IncludedQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("rollover=")
+	builder.WriteString(fmt.Sprintf("%v", sli.Rollover))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -84,6 +84,12 @@ const (
 	FieldCommitmentTrueUpEnabled = "commitment_true_up_enabled"
 	// FieldCommitmentWindowed holds the string denoting the commitment_windowed field in the database.
 	FieldCommitmentWindowed = "commitment_windowed"
+	// FieldQuantityChanges holds the string denoting the quantity_changes field in the database.
+	FieldQuantityChanges = "quantity_changes"
+	// FieldIncludedQuantity holds the string denoting the included_quantity field in the database.
+	FieldIncludedQuantity = "included_quantity"
+	// FieldRollover holds the string denoting the rollover field in the database.
+	FieldRollover = "rollover"
 	// EdgeSubscription holds the string denoting the subscription edge name in mutations.
 	EdgeSubscription = "subscription"
 	// EdgeCouponAssociations holds the string denoting the coupon_associations edge name in mutations.
@@ -143,6 +149,9 @@ var Columns = []string{
 	FieldCommitmentOverageFactor,
 	FieldCommitmentTrueUpEnabled,
 	FieldCommitmentWindowed,
+	FieldQuantityChanges,
+	FieldIncludedQuantity,
+	FieldRollover,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -188,6 +197,8 @@ var (
 	DefaultCommitmentTrueUpEnabled bool
 	// DefaultCommitmentWindowed holds the default value on creation for the "commitment_windowed" field.
 	DefaultCommitmentWindowed bool
+	// DefaultIncludedQuantity holds the default value on creation for the "included_quantity" field.
+	DefaultIncludedQuantity decimal.Decimal
 )
 
 // OrderOption defines the ordering options for the SubscriptionLineItem queries.
@@ -363,6 +374,11 @@ func ByCommitmentWindowed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCommitmentWindowed, opts...).ToFunc()
 }
 
+// ByIncludedQuantity orders the results by the included_quantity field.
+func ByIncludedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludedQuantity, opts...).ToFunc()
+}
+
 // BySubscriptionField orders the results by subscription field.
 func BySubscriptionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
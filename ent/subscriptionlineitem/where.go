@@ -236,6 +236,11 @@ func CommitmentWindowed(v bool) predicate.SubscriptionLineItem {
 	return predicate.SubscriptionLineItem(sql.FieldEQ(FieldCommitmentWindowed, v))
 }
 
+// IncludedQuantity applies equality check predicate on the "included_quantity" field. It's identical to IncludedQuantityEQ.
+func IncludedQuantity(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldEQ(FieldIncludedQuantity, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.SubscriptionLineItem {
 	return predicate.SubscriptionLineItem(sql.FieldEQ(FieldTenantID, v))
@@ -2322,6 +2327,66 @@ func CommitmentWindowedNEQ(v bool) predicate.SubscriptionLineItem {
 	return predicate.SubscriptionLineItem(sql.FieldNEQ(FieldCommitmentWindowed, v))
 }
 
+// QuantityChangesIsNil applies the IsNil predicate on the "quantity_changes" field.
+func QuantityChangesIsNil() predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldIsNull(FieldQuantityChanges))
+}
+
+// QuantityChangesNotNil applies the NotNil predicate on the "quantity_changes" field.
+func QuantityChangesNotNil() predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldNotNull(FieldQuantityChanges))
+}
+
+// IncludedQuantityEQ applies the EQ predicate on the "included_quantity" field.
+func IncludedQuantityEQ(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldEQ(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityNEQ applies the NEQ predicate on the "included_quantity" field.
+func IncludedQuantityNEQ(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldNEQ(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityIn applies the In predicate on the "included_quantity" field.
+func IncludedQuantityIn(vs ...decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldIn(FieldIncludedQuantity, vs...))
+}
+
+// IncludedQuantityNotIn applies the NotIn predicate on the "included_quantity" field.
+func IncludedQuantityNotIn(vs ...decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldNotIn(FieldIncludedQuantity, vs...))
+}
+
+// IncludedQuantityGT applies the GT predicate on the "included_quantity" field.
+func IncludedQuantityGT(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldGT(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityGTE applies the GTE predicate on the "included_quantity" field.
+func IncludedQuantityGTE(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldGTE(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityLT applies the LT predicate on the "included_quantity" field.
+func IncludedQuantityLT(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldLT(FieldIncludedQuantity, v))
+}
+
+// IncludedQuantityLTE applies the LTE predicate on the "included_quantity" field.
+func IncludedQuantityLTE(v decimal.Decimal) predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldLTE(FieldIncludedQuantity, v))
+}
+
+// RolloverIsNil applies the IsNil predicate on the "rollover" field.
+func RolloverIsNil() predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldIsNull(FieldRollover))
+}
+
+// RolloverNotNil applies the NotNil predicate on the "rollover" field.
+func RolloverNotNil() predicate.SubscriptionLineItem {
+	return predicate.SubscriptionLineItem(sql.FieldNotNull(FieldRollover))
+}
+
 // HasSubscription applies the HasEdge predicate on the "subscription" edge.
 func HasSubscription() predicate.SubscriptionLineItem {
 	return predicate.SubscriptionLineItem(func(s *sql.Selector) {
@@ -444,6 +444,32 @@ func (slic *SubscriptionLineItemCreate) SetNillableCommitmentWindowed(b *bool) *
 	return slic
 }
 
+// SetQuantityChanges sets the "quantity_changes" field.
+func (slic *SubscriptionLineItemCreate) SetQuantityChanges(tiqc []types.LineItemQuantityChange) *SubscriptionLineItemCreate {
+	slic.mutation.SetQuantityChanges(tiqc)
+	return slic
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (slic *SubscriptionLineItemCreate) SetIncludedQuantity(d decimal.Decimal) *SubscriptionLineItemCreate {
+	slic.mutation.SetIncludedQuantity(d)
+	return slic
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (slic *SubscriptionLineItemCreate) SetNillableIncludedQuantity(d *decimal.Decimal) *SubscriptionLineItemCreate {
+	if d != nil {
+		slic.SetIncludedQuantity(*d)
+	}
+	return slic
+}
+
+// SetRollover sets the "rollover" field.
+func (slic *SubscriptionLineItemCreate) SetRollover(tir *types.LineItemRollover) *SubscriptionLineItemCreate {
+	slic.mutation.SetRollover(tir)
+	return slic
+}
+
 // SetID sets the "id" field.
 func (slic *SubscriptionLineItemCreate) SetID(s string) *SubscriptionLineItemCreate {
 	slic.mutation.SetID(s)
@@ -541,6 +567,10 @@ func (slic *SubscriptionLineItemCreate) defaults() {
 		v := subscriptionlineitem.DefaultCommitmentWindowed
 		slic.mutation.SetCommitmentWindowed(v)
 	}
+	if _, ok := slic.mutation.IncludedQuantity(); !ok {
+		v := subscriptionlineitem.DefaultIncludedQuantity
+		slic.mutation.SetIncludedQuantity(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -627,6 +657,9 @@ func (slic *SubscriptionLineItemCreate) check() error {
 	if _, ok := slic.mutation.CommitmentWindowed(); !ok {
 		return &ValidationError{Name: "commitment_windowed", err: errors.New(`ent: missing required field "SubscriptionLineItem.commitment_windowed"`)}
 	}
+	if _, ok := slic.mutation.IncludedQuantity(); !ok {
+		return &ValidationError{Name: "included_quantity", err: errors.New(`ent: missing required field "SubscriptionLineItem.included_quantity"`)}
+	}
 	if len(slic.mutation.SubscriptionIDs()) == 0 {
 		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "SubscriptionLineItem.subscription"`)}
 	}
@@ -797,6 +830,18 @@ func (slic *SubscriptionLineItemCreate) createSpec() (*SubscriptionLineItem, *sq
 		_spec.SetField(subscriptionlineitem.FieldCommitmentWindowed, field.TypeBool, value)
 		_node.CommitmentWindowed = value
 	}
+	if value, ok := slic.mutation.QuantityChanges(); ok {
+		_spec.SetField(subscriptionlineitem.FieldQuantityChanges, field.TypeJSON, value)
+		_node.QuantityChanges = value
+	}
+	if value, ok := slic.mutation.IncludedQuantity(); ok {
+		_spec.SetField(subscriptionlineitem.FieldIncludedQuantity, field.TypeOther, value)
+		_node.IncludedQuantity = value
+	}
+	if value, ok := slic.mutation.Rollover(); ok {
+		_spec.SetField(subscriptionlineitem.FieldRollover, field.TypeJSON, value)
+		_node.Rollover = value
+	}
 	if nodes := slic.mutation.SubscriptionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/couponassociation"
 	"github.com/flexprice/flexprice/ent/predicate"
@@ -468,6 +469,50 @@ func (sliu *SubscriptionLineItemUpdate) SetNillableCommitmentWindowed(b *bool) *
 	return sliu
 }
 
+// SetQuantityChanges sets the "quantity_changes" field.
+func (sliu *SubscriptionLineItemUpdate) SetQuantityChanges(tiqc []types.LineItemQuantityChange) *SubscriptionLineItemUpdate {
+	sliu.mutation.SetQuantityChanges(tiqc)
+	return sliu
+}
+
+// AppendQuantityChanges appends tiqc to the "quantity_changes" field.
+func (sliu *SubscriptionLineItemUpdate) AppendQuantityChanges(tiqc []types.LineItemQuantityChange) *SubscriptionLineItemUpdate {
+	sliu.mutation.AppendQuantityChanges(tiqc)
+	return sliu
+}
+
+// ClearQuantityChanges clears the value of the "quantity_changes" field.
+func (sliu *SubscriptionLineItemUpdate) ClearQuantityChanges() *SubscriptionLineItemUpdate {
+	sliu.mutation.ClearQuantityChanges()
+	return sliu
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (sliu *SubscriptionLineItemUpdate) SetIncludedQuantity(d decimal.Decimal) *SubscriptionLineItemUpdate {
+	sliu.mutation.SetIncludedQuantity(d)
+	return sliu
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (sliu *SubscriptionLineItemUpdate) SetNillableIncludedQuantity(d *decimal.Decimal) *SubscriptionLineItemUpdate {
+	if d != nil {
+		sliu.SetIncludedQuantity(*d)
+	}
+	return sliu
+}
+
+// SetRollover sets the "rollover" field.
+func (sliu *SubscriptionLineItemUpdate) SetRollover(tir *types.LineItemRollover) *SubscriptionLineItemUpdate {
+	sliu.mutation.SetRollover(tir)
+	return sliu
+}
+
+// ClearRollover clears the value of the "rollover" field.
+func (sliu *SubscriptionLineItemUpdate) ClearRollover() *SubscriptionLineItemUpdate {
+	sliu.mutation.ClearRollover()
+	return sliu
+}
+
 // AddCouponAssociationIDs adds the "coupon_associations" edge to the CouponAssociation entity by IDs.
 func (sliu *SubscriptionLineItemUpdate) AddCouponAssociationIDs(ids ...string) *SubscriptionLineItemUpdate {
 	sliu.mutation.AddCouponAssociationIDs(ids...)
@@ -723,6 +768,26 @@ func (sliu *SubscriptionLineItemUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := sliu.mutation.CommitmentWindowed(); ok {
 		_spec.SetField(subscriptionlineitem.FieldCommitmentWindowed, field.TypeBool, value)
 	}
+	if value, ok := sliu.mutation.QuantityChanges(); ok {
+		_spec.SetField(subscriptionlineitem.FieldQuantityChanges, field.TypeJSON, value)
+	}
+	if value, ok := sliu.mutation.AppendedQuantityChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionlineitem.FieldQuantityChanges, value)
+		})
+	}
+	if sliu.mutation.QuantityChangesCleared() {
+		_spec.ClearField(subscriptionlineitem.FieldQuantityChanges, field.TypeJSON)
+	}
+	if value, ok := sliu.mutation.IncludedQuantity(); ok {
+		_spec.SetField(subscriptionlineitem.FieldIncludedQuantity, field.TypeOther, value)
+	}
+	if value, ok := sliu.mutation.Rollover(); ok {
+		_spec.SetField(subscriptionlineitem.FieldRollover, field.TypeJSON, value)
+	}
+	if sliu.mutation.RolloverCleared() {
+		_spec.ClearField(subscriptionlineitem.FieldRollover, field.TypeJSON)
+	}
 	if sliu.mutation.CouponAssociationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1225,6 +1290,50 @@ func (sliuo *SubscriptionLineItemUpdateOne) SetNillableCommitmentWindowed(b *boo
 	return sliuo
 }
 
+// SetQuantityChanges sets the "quantity_changes" field.
+func (sliuo *SubscriptionLineItemUpdateOne) SetQuantityChanges(tiqc []types.LineItemQuantityChange) *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.SetQuantityChanges(tiqc)
+	return sliuo
+}
+
+// AppendQuantityChanges appends tiqc to the "quantity_changes" field.
+func (sliuo *SubscriptionLineItemUpdateOne) AppendQuantityChanges(tiqc []types.LineItemQuantityChange) *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.AppendQuantityChanges(tiqc)
+	return sliuo
+}
+
+// ClearQuantityChanges clears the value of the "quantity_changes" field.
+func (sliuo *SubscriptionLineItemUpdateOne) ClearQuantityChanges() *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.ClearQuantityChanges()
+	return sliuo
+}
+
+// SetIncludedQuantity sets the "included_quantity" field.
+func (sliuo *SubscriptionLineItemUpdateOne) SetIncludedQuantity(d decimal.Decimal) *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.SetIncludedQuantity(d)
+	return sliuo
+}
+
+// SetNillableIncludedQuantity sets the "included_quantity" field if the given value is not nil.
+func (sliuo *SubscriptionLineItemUpdateOne) SetNillableIncludedQuantity(d *decimal.Decimal) *SubscriptionLineItemUpdateOne {
+	if d != nil {
+		sliuo.SetIncludedQuantity(*d)
+	}
+	return sliuo
+}
+
+// SetRollover sets the "rollover" field.
+func (sliuo *SubscriptionLineItemUpdateOne) SetRollover(tir *types.LineItemRollover) *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.SetRollover(tir)
+	return sliuo
+}
+
+// ClearRollover clears the value of the "rollover" field.
+func (sliuo *SubscriptionLineItemUpdateOne) ClearRollover() *SubscriptionLineItemUpdateOne {
+	sliuo.mutation.ClearRollover()
+	return sliuo
+}
+
 // AddCouponAssociationIDs adds the "coupon_associations" edge to the CouponAssociation entity by IDs.
 func (sliuo *SubscriptionLineItemUpdateOne) AddCouponAssociationIDs(ids ...string) *SubscriptionLineItemUpdateOne {
 	sliuo.mutation.AddCouponAssociationIDs(ids...)
@@ -1510,6 +1619,26 @@ func (sliuo *SubscriptionLineItemUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := sliuo.mutation.CommitmentWindowed(); ok {
 		_spec.SetField(subscriptionlineitem.FieldCommitmentWindowed, field.TypeBool, value)
 	}
+	if value, ok := sliuo.mutation.QuantityChanges(); ok {
+		_spec.SetField(subscriptionlineitem.FieldQuantityChanges, field.TypeJSON, value)
+	}
+	if value, ok := sliuo.mutation.AppendedQuantityChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionlineitem.FieldQuantityChanges, value)
+		})
+	}
+	if sliuo.mutation.QuantityChangesCleared() {
+		_spec.ClearField(subscriptionlineitem.FieldQuantityChanges, field.TypeJSON)
+	}
+	if value, ok := sliuo.mutation.IncludedQuantity(); ok {
+		_spec.SetField(subscriptionlineitem.FieldIncludedQuantity, field.TypeOther, value)
+	}
+	if value, ok := sliuo.mutation.Rollover(); ok {
+		_spec.SetField(subscriptionlineitem.FieldRollover, field.TypeJSON, value)
+	}
+	if sliuo.mutation.RolloverCleared() {
+		_spec.ClearField(subscriptionlineitem.FieldRollover, field.TypeJSON)
+	}
 	if sliuo.mutation.CouponAssociationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
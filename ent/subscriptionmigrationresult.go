@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionMigrationResult is the model entity for the SubscriptionMigrationResult schema.
+type SubscriptionMigrationResult struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID string `json:"environment_id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID string `json:"customer_id,omitempty"`
+	// MigrationStatus holds the value of the "migration_status" field.
+	MigrationStatus types.SubscriptionMigrationStatus `json:"migration_status,omitempty"`
+	// Subscription on the target plan of immediate migrations
+	NewSubscriptionID *string `json:"new_subscription_id,omitempty"`
+	// Plan change schedule of end of period migrations
+	ScheduleID *string `json:"schedule_id,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt  *time.Time `json:"processed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionMigrationResult) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionmigrationresult.FieldID, subscriptionmigrationresult.FieldTenantID, subscriptionmigrationresult.FieldStatus, subscriptionmigrationresult.FieldCreatedBy, subscriptionmigrationresult.FieldUpdatedBy, subscriptionmigrationresult.FieldEnvironmentID, subscriptionmigrationresult.FieldTaskID, subscriptionmigrationresult.FieldSubscriptionID, subscriptionmigrationresult.FieldCustomerID, subscriptionmigrationresult.FieldMigrationStatus, subscriptionmigrationresult.FieldNewSubscriptionID, subscriptionmigrationresult.FieldScheduleID, subscriptionmigrationresult.FieldError:
+			values[i] = new(sql.NullString)
+		case subscriptionmigrationresult.FieldCreatedAt, subscriptionmigrationresult.FieldUpdatedAt, subscriptionmigrationresult.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionMigrationResult fields.
+func (smr *SubscriptionMigrationResult) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionmigrationresult.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				smr.ID = value.String
+			}
+		case subscriptionmigrationresult.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				smr.TenantID = value.String
+			}
+		case subscriptionmigrationresult.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				smr.Status = value.String
+			}
+		case subscriptionmigrationresult.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				smr.CreatedAt = value.Time
+			}
+		case subscriptionmigrationresult.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				smr.UpdatedAt = value.Time
+			}
+		case subscriptionmigrationresult.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				smr.CreatedBy = value.String
+			}
+		case subscriptionmigrationresult.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				smr.UpdatedBy = value.String
+			}
+		case subscriptionmigrationresult.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				smr.EnvironmentID = value.String
+			}
+		case subscriptionmigrationresult.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				smr.TaskID = value.String
+			}
+		case subscriptionmigrationresult.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				smr.SubscriptionID = value.String
+			}
+		case subscriptionmigrationresult.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				smr.CustomerID = value.String
+			}
+		case subscriptionmigrationresult.FieldMigrationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field migration_status", values[i])
+			} else if value.Valid {
+				smr.MigrationStatus = types.SubscriptionMigrationStatus(value.String)
+			}
+		case subscriptionmigrationresult.FieldNewSubscriptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_subscription_id", values[i])
+			} else if value.Valid {
+				smr.NewSubscriptionID = new(string)
+				*smr.NewSubscriptionID = value.String
+			}
+		case subscriptionmigrationresult.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				smr.ScheduleID = new(string)
+				*smr.ScheduleID = value.String
+			}
+		case subscriptionmigrationresult.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				smr.Error = new(string)
+				*smr.Error = value.String
+			}
+		case subscriptionmigrationresult.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				smr.ProcessedAt = new(time.Time)
+				*smr.ProcessedAt = value.Time
+			}
+		default:
+			smr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionMigrationResult.
+// This includes values selected through modifiers, order, etc.
+func (smr *SubscriptionMigrationResult) Value(name string) (ent.Value, error) {
+	return smr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SubscriptionMigrationResult.
+// Note that you need to call SubscriptionMigrationResult.Unwrap() before calling this method if this SubscriptionMigrationResult
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (smr *SubscriptionMigrationResult) Update() *SubscriptionMigrationResultUpdateOne {
+	return NewSubscriptionMigrationResultClient(smr.config).UpdateOne(smr)
+}
+
+// Unwrap unwraps the SubscriptionMigrationResult entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (smr *SubscriptionMigrationResult) Unwrap() *SubscriptionMigrationResult {
+	_tx, ok := smr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionMigrationResult is not a transactional entity")
+	}
+	smr.config.driver = _tx.drv
+	return smr
+}
+
+// String implements the fmt.Stringer.
+func (smr *SubscriptionMigrationResult) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionMigrationResult(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", smr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(smr.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(smr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(smr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(smr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(smr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(smr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(smr.EnvironmentID)
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(smr.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(smr.SubscriptionID)
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(smr.CustomerID)
+	builder.WriteString(", ")
+	builder.WriteString("migration_status=")
+	builder.WriteString(fmt.Sprintf("%v", smr.MigrationStatus))
+	builder.WriteString(", ")
+	if v := smr.NewSubscriptionID; v != nil {
+		builder.WriteString("new_subscription_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := smr.ScheduleID; v != nil {
+		builder.WriteString("schedule_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := smr.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := smr.ProcessedAt; v != nil {
+		builder.WriteString("processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionMigrationResults is a parsable slice of SubscriptionMigrationResult.
+type SubscriptionMigrationResults []*SubscriptionMigrationResult
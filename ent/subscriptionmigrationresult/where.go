@@ -0,0 +1,1156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionmigrationresult
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldTaskID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// MigrationStatus applies equality check predicate on the "migration_status" field. It's identical to MigrationStatusEQ.
+func MigrationStatus(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldMigrationStatus, vc))
+}
+
+// NewSubscriptionID applies equality check predicate on the "new_subscription_id" field. It's identical to NewSubscriptionIDEQ.
+func NewSubscriptionID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldNewSubscriptionID, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldError, v))
+}
+
+// ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
+func ProcessedAt(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldEnvironmentID, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContains applies the Contains predicate on the "subscription_id" field.
+func SubscriptionIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasPrefix applies the HasPrefix predicate on the "subscription_id" field.
+func SubscriptionIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDHasSuffix applies the HasSuffix predicate on the "subscription_id" field.
+func SubscriptionIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDEqualFold applies the EqualFold predicate on the "subscription_id" field.
+func SubscriptionIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDContainsFold applies the ContainsFold predicate on the "subscription_id" field.
+func SubscriptionIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldSubscriptionID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDContains applies the Contains predicate on the "customer_id" field.
+func CustomerIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldCustomerID, v))
+}
+
+// CustomerIDHasPrefix applies the HasPrefix predicate on the "customer_id" field.
+func CustomerIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldCustomerID, v))
+}
+
+// CustomerIDHasSuffix applies the HasSuffix predicate on the "customer_id" field.
+func CustomerIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldCustomerID, v))
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldCustomerID))
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldCustomerID))
+}
+
+// CustomerIDEqualFold applies the EqualFold predicate on the "customer_id" field.
+func CustomerIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldCustomerID, v))
+}
+
+// CustomerIDContainsFold applies the ContainsFold predicate on the "customer_id" field.
+func CustomerIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldCustomerID, v))
+}
+
+// MigrationStatusEQ applies the EQ predicate on the "migration_status" field.
+func MigrationStatusEQ(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusNEQ applies the NEQ predicate on the "migration_status" field.
+func MigrationStatusNEQ(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusIn applies the In predicate on the "migration_status" field.
+func MigrationStatusIn(vs ...types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldMigrationStatus, v...))
+}
+
+// MigrationStatusNotIn applies the NotIn predicate on the "migration_status" field.
+func MigrationStatusNotIn(vs ...types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldMigrationStatus, v...))
+}
+
+// MigrationStatusGT applies the GT predicate on the "migration_status" field.
+func MigrationStatusGT(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusGTE applies the GTE predicate on the "migration_status" field.
+func MigrationStatusGTE(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusLT applies the LT predicate on the "migration_status" field.
+func MigrationStatusLT(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusLTE applies the LTE predicate on the "migration_status" field.
+func MigrationStatusLTE(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusContains applies the Contains predicate on the "migration_status" field.
+func MigrationStatusContains(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusHasPrefix applies the HasPrefix predicate on the "migration_status" field.
+func MigrationStatusHasPrefix(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusHasSuffix applies the HasSuffix predicate on the "migration_status" field.
+func MigrationStatusHasSuffix(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusEqualFold applies the EqualFold predicate on the "migration_status" field.
+func MigrationStatusEqualFold(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldMigrationStatus, vc))
+}
+
+// MigrationStatusContainsFold applies the ContainsFold predicate on the "migration_status" field.
+func MigrationStatusContainsFold(v types.SubscriptionMigrationStatus) predicate.SubscriptionMigrationResult {
+	vc := string(v)
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldMigrationStatus, vc))
+}
+
+// NewSubscriptionIDEQ applies the EQ predicate on the "new_subscription_id" field.
+func NewSubscriptionIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDNEQ applies the NEQ predicate on the "new_subscription_id" field.
+func NewSubscriptionIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDIn applies the In predicate on the "new_subscription_id" field.
+func NewSubscriptionIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldNewSubscriptionID, vs...))
+}
+
+// NewSubscriptionIDNotIn applies the NotIn predicate on the "new_subscription_id" field.
+func NewSubscriptionIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldNewSubscriptionID, vs...))
+}
+
+// NewSubscriptionIDGT applies the GT predicate on the "new_subscription_id" field.
+func NewSubscriptionIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDGTE applies the GTE predicate on the "new_subscription_id" field.
+func NewSubscriptionIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDLT applies the LT predicate on the "new_subscription_id" field.
+func NewSubscriptionIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDLTE applies the LTE predicate on the "new_subscription_id" field.
+func NewSubscriptionIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDContains applies the Contains predicate on the "new_subscription_id" field.
+func NewSubscriptionIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDHasPrefix applies the HasPrefix predicate on the "new_subscription_id" field.
+func NewSubscriptionIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDHasSuffix applies the HasSuffix predicate on the "new_subscription_id" field.
+func NewSubscriptionIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDIsNil applies the IsNil predicate on the "new_subscription_id" field.
+func NewSubscriptionIDIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldNewSubscriptionID))
+}
+
+// NewSubscriptionIDNotNil applies the NotNil predicate on the "new_subscription_id" field.
+func NewSubscriptionIDNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldNewSubscriptionID))
+}
+
+// NewSubscriptionIDEqualFold applies the EqualFold predicate on the "new_subscription_id" field.
+func NewSubscriptionIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldNewSubscriptionID, v))
+}
+
+// NewSubscriptionIDContainsFold applies the ContainsFold predicate on the "new_subscription_id" field.
+func NewSubscriptionIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldNewSubscriptionID, v))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDGT applies the GT predicate on the "schedule_id" field.
+func ScheduleIDGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldScheduleID, v))
+}
+
+// ScheduleIDGTE applies the GTE predicate on the "schedule_id" field.
+func ScheduleIDGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldScheduleID, v))
+}
+
+// ScheduleIDLT applies the LT predicate on the "schedule_id" field.
+func ScheduleIDLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldScheduleID, v))
+}
+
+// ScheduleIDLTE applies the LTE predicate on the "schedule_id" field.
+func ScheduleIDLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldScheduleID, v))
+}
+
+// ScheduleIDContains applies the Contains predicate on the "schedule_id" field.
+func ScheduleIDContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldScheduleID, v))
+}
+
+// ScheduleIDHasPrefix applies the HasPrefix predicate on the "schedule_id" field.
+func ScheduleIDHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldScheduleID, v))
+}
+
+// ScheduleIDHasSuffix applies the HasSuffix predicate on the "schedule_id" field.
+func ScheduleIDHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldScheduleID, v))
+}
+
+// ScheduleIDIsNil applies the IsNil predicate on the "schedule_id" field.
+func ScheduleIDIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldScheduleID))
+}
+
+// ScheduleIDNotNil applies the NotNil predicate on the "schedule_id" field.
+func ScheduleIDNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldScheduleID))
+}
+
+// ScheduleIDEqualFold applies the EqualFold predicate on the "schedule_id" field.
+func ScheduleIDEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldScheduleID, v))
+}
+
+// ScheduleIDContainsFold applies the ContainsFold predicate on the "schedule_id" field.
+func ScheduleIDContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldScheduleID, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldContainsFold(FieldError, v))
+}
+
+// ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
+func ProcessedAtEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtNEQ applies the NEQ predicate on the "processed_at" field.
+func ProcessedAtNEQ(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtIn applies the In predicate on the "processed_at" field.
+func ProcessedAtIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtNotIn applies the NotIn predicate on the "processed_at" field.
+func ProcessedAtNotIn(vs ...time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtGT applies the GT predicate on the "processed_at" field.
+func ProcessedAtGT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGT(FieldProcessedAt, v))
+}
+
+// ProcessedAtGTE applies the GTE predicate on the "processed_at" field.
+func ProcessedAtGTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldGTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtLT applies the LT predicate on the "processed_at" field.
+func ProcessedAtLT(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLT(FieldProcessedAt, v))
+}
+
+// ProcessedAtLTE applies the LTE predicate on the "processed_at" field.
+func ProcessedAtLTE(v time.Time) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldLTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtIsNil applies the IsNil predicate on the "processed_at" field.
+func ProcessedAtIsNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldIsNull(FieldProcessedAt))
+}
+
+// ProcessedAtNotNil applies the NotNil predicate on the "processed_at" field.
+func ProcessedAtNotNil() predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.FieldNotNull(FieldProcessedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionMigrationResult) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionMigrationResult) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionMigrationResult) predicate.SubscriptionMigrationResult {
+	return predicate.SubscriptionMigrationResult(sql.NotPredicates(p))
+}
@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionMigrationResultCreate is the builder for creating a SubscriptionMigrationResult entity.
+type SubscriptionMigrationResultCreate struct {
+	config
+	mutation *SubscriptionMigrationResultMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetTenantID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetTenantID(s)
+	return smrc
+}
+
+// SetStatus sets the "status" field.
+func (smrc *SubscriptionMigrationResultCreate) SetStatus(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetStatus(s)
+	return smrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableStatus(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetStatus(*s)
+	}
+	return smrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smrc *SubscriptionMigrationResultCreate) SetCreatedAt(t time.Time) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetCreatedAt(t)
+	return smrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionMigrationResultCreate {
+	if t != nil {
+		smrc.SetCreatedAt(*t)
+	}
+	return smrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smrc *SubscriptionMigrationResultCreate) SetUpdatedAt(t time.Time) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetUpdatedAt(t)
+	return smrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableUpdatedAt(t *time.Time) *SubscriptionMigrationResultCreate {
+	if t != nil {
+		smrc.SetUpdatedAt(*t)
+	}
+	return smrc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (smrc *SubscriptionMigrationResultCreate) SetCreatedBy(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetCreatedBy(s)
+	return smrc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableCreatedBy(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetCreatedBy(*s)
+	}
+	return smrc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (smrc *SubscriptionMigrationResultCreate) SetUpdatedBy(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetUpdatedBy(s)
+	return smrc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableUpdatedBy(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetUpdatedBy(*s)
+	}
+	return smrc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetEnvironmentID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetEnvironmentID(s)
+	return smrc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableEnvironmentID(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetEnvironmentID(*s)
+	}
+	return smrc
+}
+
+// SetTaskID sets the "task_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetTaskID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetTaskID(s)
+	return smrc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetSubscriptionID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetSubscriptionID(s)
+	return smrc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetCustomerID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetCustomerID(s)
+	return smrc
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableCustomerID(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetCustomerID(*s)
+	}
+	return smrc
+}
+
+// SetMigrationStatus sets the "migration_status" field.
+func (smrc *SubscriptionMigrationResultCreate) SetMigrationStatus(tms types.SubscriptionMigrationStatus) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetMigrationStatus(tms)
+	return smrc
+}
+
+// SetNillableMigrationStatus sets the "migration_status" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableMigrationStatus(tms *types.SubscriptionMigrationStatus) *SubscriptionMigrationResultCreate {
+	if tms != nil {
+		smrc.SetMigrationStatus(*tms)
+	}
+	return smrc
+}
+
+// SetNewSubscriptionID sets the "new_subscription_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetNewSubscriptionID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetNewSubscriptionID(s)
+	return smrc
+}
+
+// SetNillableNewSubscriptionID sets the "new_subscription_id" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableNewSubscriptionID(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetNewSubscriptionID(*s)
+	}
+	return smrc
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetScheduleID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetScheduleID(s)
+	return smrc
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableScheduleID(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetScheduleID(*s)
+	}
+	return smrc
+}
+
+// SetError sets the "error" field.
+func (smrc *SubscriptionMigrationResultCreate) SetError(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetError(s)
+	return smrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableError(s *string) *SubscriptionMigrationResultCreate {
+	if s != nil {
+		smrc.SetError(*s)
+	}
+	return smrc
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (smrc *SubscriptionMigrationResultCreate) SetProcessedAt(t time.Time) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetProcessedAt(t)
+	return smrc
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (smrc *SubscriptionMigrationResultCreate) SetNillableProcessedAt(t *time.Time) *SubscriptionMigrationResultCreate {
+	if t != nil {
+		smrc.SetProcessedAt(*t)
+	}
+	return smrc
+}
+
+// SetID sets the "id" field.
+func (smrc *SubscriptionMigrationResultCreate) SetID(s string) *SubscriptionMigrationResultCreate {
+	smrc.mutation.SetID(s)
+	return smrc
+}
+
+// Mutation returns the SubscriptionMigrationResultMutation object of the builder.
+func (smrc *SubscriptionMigrationResultCreate) Mutation() *SubscriptionMigrationResultMutation {
+	return smrc.mutation
+}
+
+// Save creates the SubscriptionMigrationResult in the database.
+func (smrc *SubscriptionMigrationResultCreate) Save(ctx context.Context) (*SubscriptionMigrationResult, error) {
+	smrc.defaults()
+	return withHooks(ctx, smrc.sqlSave, smrc.mutation, smrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smrc *SubscriptionMigrationResultCreate) SaveX(ctx context.Context) *SubscriptionMigrationResult {
+	v, err := smrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smrc *SubscriptionMigrationResultCreate) Exec(ctx context.Context) error {
+	_, err := smrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smrc *SubscriptionMigrationResultCreate) ExecX(ctx context.Context) {
+	if err := smrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smrc *SubscriptionMigrationResultCreate) defaults() {
+	if _, ok := smrc.mutation.Status(); !ok {
+		v := subscriptionmigrationresult.DefaultStatus
+		smrc.mutation.SetStatus(v)
+	}
+	if _, ok := smrc.mutation.CreatedAt(); !ok {
+		v := subscriptionmigrationresult.DefaultCreatedAt()
+		smrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := smrc.mutation.UpdatedAt(); !ok {
+		v := subscriptionmigrationresult.DefaultUpdatedAt()
+		smrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := smrc.mutation.EnvironmentID(); !ok {
+		v := subscriptionmigrationresult.DefaultEnvironmentID
+		smrc.mutation.SetEnvironmentID(v)
+	}
+	if _, ok := smrc.mutation.MigrationStatus(); !ok {
+		v := subscriptionmigrationresult.DefaultMigrationStatus
+		smrc.mutation.SetMigrationStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smrc *SubscriptionMigrationResultCreate) check() error {
+	if _, ok := smrc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.tenant_id"`)}
+	}
+	if v, ok := smrc.mutation.TenantID(); ok {
+		if err := subscriptionmigrationresult.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMigrationResult.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := smrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.status"`)}
+	}
+	if _, ok := smrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.created_at"`)}
+	}
+	if _, ok := smrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.updated_at"`)}
+	}
+	if _, ok := smrc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.task_id"`)}
+	}
+	if v, ok := smrc.mutation.TaskID(); ok {
+		if err := subscriptionmigrationresult.TaskIDValidator(v); err != nil {
+			return &ValidationError{Name: "task_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMigrationResult.task_id": %w`, err)}
+		}
+	}
+	if _, ok := smrc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.subscription_id"`)}
+	}
+	if v, ok := smrc.mutation.SubscriptionID(); ok {
+		if err := subscriptionmigrationresult.SubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMigrationResult.subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := smrc.mutation.MigrationStatus(); !ok {
+		return &ValidationError{Name: "migration_status", err: errors.New(`ent: missing required field "SubscriptionMigrationResult.migration_status"`)}
+	}
+	return nil
+}
+
+func (smrc *SubscriptionMigrationResultCreate) sqlSave(ctx context.Context) (*SubscriptionMigrationResult, error) {
+	if err := smrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubscriptionMigrationResult.ID type: %T", _spec.ID.Value)
+		}
+	}
+	smrc.mutation.id = &_node.ID
+	smrc.mutation.done = true
+	return _node, nil
+}
+
+func (smrc *SubscriptionMigrationResultCreate) createSpec() (*SubscriptionMigrationResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionMigrationResult{config: smrc.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionmigrationresult.Table, sqlgraph.NewFieldSpec(subscriptionmigrationresult.FieldID, field.TypeString))
+	)
+	if id, ok := smrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := smrc.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := smrc.mutation.Status(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := smrc.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := smrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := smrc.mutation.CreatedBy(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := smrc.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := smrc.mutation.EnvironmentID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := smrc.mutation.TaskID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldTaskID, field.TypeString, value)
+		_node.TaskID = value
+	}
+	if value, ok := smrc.mutation.SubscriptionID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldSubscriptionID, field.TypeString, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := smrc.mutation.CustomerID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldCustomerID, field.TypeString, value)
+		_node.CustomerID = value
+	}
+	if value, ok := smrc.mutation.MigrationStatus(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldMigrationStatus, field.TypeString, value)
+		_node.MigrationStatus = value
+	}
+	if value, ok := smrc.mutation.NewSubscriptionID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldNewSubscriptionID, field.TypeString, value)
+		_node.NewSubscriptionID = &value
+	}
+	if value, ok := smrc.mutation.ScheduleID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldScheduleID, field.TypeString, value)
+		_node.ScheduleID = &value
+	}
+	if value, ok := smrc.mutation.Error(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := smrc.mutation.ProcessedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// SubscriptionMigrationResultCreateBulk is the builder for creating many SubscriptionMigrationResult entities in bulk.
+type SubscriptionMigrationResultCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionMigrationResultCreate
+}
+
+// Save creates the SubscriptionMigrationResult entities in the database.
+func (smrcb *SubscriptionMigrationResultCreateBulk) Save(ctx context.Context) ([]*SubscriptionMigrationResult, error) {
+	if smrcb.err != nil {
+		return nil, smrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(smrcb.builders))
+	nodes := make([]*SubscriptionMigrationResult, len(smrcb.builders))
+	mutators := make([]Mutator, len(smrcb.builders))
+	for i := range smrcb.builders {
+		func(i int, root context.Context) {
+			builder := smrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMigrationResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smrcb *SubscriptionMigrationResultCreateBulk) SaveX(ctx context.Context) []*SubscriptionMigrationResult {
+	v, err := smrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smrcb *SubscriptionMigrationResultCreateBulk) Exec(ctx context.Context) error {
+	_, err := smrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smrcb *SubscriptionMigrationResultCreateBulk) ExecX(ctx context.Context) {
+	if err := smrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+)
+
+// SubscriptionMigrationResultDelete is the builder for deleting a SubscriptionMigrationResult entity.
+type SubscriptionMigrationResultDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMigrationResultMutation
+}
+
+// Where appends a list predicates to the SubscriptionMigrationResultDelete builder.
+func (smrd *SubscriptionMigrationResultDelete) Where(ps ...predicate.SubscriptionMigrationResult) *SubscriptionMigrationResultDelete {
+	smrd.mutation.Where(ps...)
+	return smrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (smrd *SubscriptionMigrationResultDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, smrd.sqlExec, smrd.mutation, smrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smrd *SubscriptionMigrationResultDelete) ExecX(ctx context.Context) int {
+	n, err := smrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (smrd *SubscriptionMigrationResultDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subscriptionmigrationresult.Table, sqlgraph.NewFieldSpec(subscriptionmigrationresult.FieldID, field.TypeString))
+	if ps := smrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, smrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	smrd.mutation.done = true
+	return affected, err
+}
+
+// SubscriptionMigrationResultDeleteOne is the builder for deleting a single SubscriptionMigrationResult entity.
+type SubscriptionMigrationResultDeleteOne struct {
+	smrd *SubscriptionMigrationResultDelete
+}
+
+// Where appends a list predicates to the SubscriptionMigrationResultDelete builder.
+func (smrdo *SubscriptionMigrationResultDeleteOne) Where(ps ...predicate.SubscriptionMigrationResult) *SubscriptionMigrationResultDeleteOne {
+	smrdo.smrd.mutation.Where(ps...)
+	return smrdo
+}
+
+// Exec executes the deletion query.
+func (smrdo *SubscriptionMigrationResultDeleteOne) Exec(ctx context.Context) error {
+	n, err := smrdo.smrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subscriptionmigrationresult.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smrdo *SubscriptionMigrationResultDeleteOne) ExecX(ctx context.Context) {
+	if err := smrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+)
+
+// SubscriptionMigrationResultQuery is the builder for querying SubscriptionMigrationResult entities.
+type SubscriptionMigrationResultQuery struct {
+	config
+	ctx        *QueryContext
+	order      []subscriptionmigrationresult.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SubscriptionMigrationResult
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubscriptionMigrationResultQuery builder.
+func (smrq *SubscriptionMigrationResultQuery) Where(ps ...predicate.SubscriptionMigrationResult) *SubscriptionMigrationResultQuery {
+	smrq.predicates = append(smrq.predicates, ps...)
+	return smrq
+}
+
+// Limit the number of records to be returned by this query.
+func (smrq *SubscriptionMigrationResultQuery) Limit(limit int) *SubscriptionMigrationResultQuery {
+	smrq.ctx.Limit = &limit
+	return smrq
+}
+
+// Offset to start from.
+func (smrq *SubscriptionMigrationResultQuery) Offset(offset int) *SubscriptionMigrationResultQuery {
+	smrq.ctx.Offset = &offset
+	return smrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (smrq *SubscriptionMigrationResultQuery) Unique(unique bool) *SubscriptionMigrationResultQuery {
+	smrq.ctx.Unique = &unique
+	return smrq
+}
+
+// Order specifies how the records should be ordered.
+func (smrq *SubscriptionMigrationResultQuery) Order(o ...subscriptionmigrationresult.OrderOption) *SubscriptionMigrationResultQuery {
+	smrq.order = append(smrq.order, o...)
+	return smrq
+}
+
+// First returns the first SubscriptionMigrationResult entity from the query.
+// Returns a *NotFoundError when no SubscriptionMigrationResult was found.
+func (smrq *SubscriptionMigrationResultQuery) First(ctx context.Context) (*SubscriptionMigrationResult, error) {
+	nodes, err := smrq.Limit(1).All(setContextOp(ctx, smrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subscriptionmigrationresult.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) FirstX(ctx context.Context) *SubscriptionMigrationResult {
+	node, err := smrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SubscriptionMigrationResult ID from the query.
+// Returns a *NotFoundError when no SubscriptionMigrationResult ID was found.
+func (smrq *SubscriptionMigrationResultQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = smrq.Limit(1).IDs(setContextOp(ctx, smrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subscriptionmigrationresult.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) FirstIDX(ctx context.Context) string {
+	id, err := smrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SubscriptionMigrationResult entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SubscriptionMigrationResult entity is found.
+// Returns a *NotFoundError when no SubscriptionMigrationResult entities are found.
+func (smrq *SubscriptionMigrationResultQuery) Only(ctx context.Context) (*SubscriptionMigrationResult, error) {
+	nodes, err := smrq.Limit(2).All(setContextOp(ctx, smrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subscriptionmigrationresult.Label}
+	default:
+		return nil, &NotSingularError{subscriptionmigrationresult.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) OnlyX(ctx context.Context) *SubscriptionMigrationResult {
+	node, err := smrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SubscriptionMigrationResult ID in the query.
+// Returns a *NotSingularError when more than one SubscriptionMigrationResult ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (smrq *SubscriptionMigrationResultQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = smrq.Limit(2).IDs(setContextOp(ctx, smrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subscriptionmigrationresult.Label}
+	default:
+		err = &NotSingularError{subscriptionmigrationresult.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) OnlyIDX(ctx context.Context) string {
+	id, err := smrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SubscriptionMigrationResults.
+func (smrq *SubscriptionMigrationResultQuery) All(ctx context.Context) ([]*SubscriptionMigrationResult, error) {
+	ctx = setContextOp(ctx, smrq.ctx, ent.OpQueryAll)
+	if err := smrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SubscriptionMigrationResult, *SubscriptionMigrationResultQuery]()
+	return withInterceptors[[]*SubscriptionMigrationResult](ctx, smrq, qr, smrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) AllX(ctx context.Context) []*SubscriptionMigrationResult {
+	nodes, err := smrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SubscriptionMigrationResult IDs.
+func (smrq *SubscriptionMigrationResultQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if smrq.ctx.Unique == nil && smrq.path != nil {
+		smrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, smrq.ctx, ent.OpQueryIDs)
+	if err = smrq.Select(subscriptionmigrationresult.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) IDsX(ctx context.Context) []string {
+	ids, err := smrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smrq *SubscriptionMigrationResultQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, smrq.ctx, ent.OpQueryCount)
+	if err := smrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, smrq, querierCount[*SubscriptionMigrationResultQuery](), smrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) CountX(ctx context.Context) int {
+	count, err := smrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smrq *SubscriptionMigrationResultQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, smrq.ctx, ent.OpQueryExist)
+	switch _, err := smrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smrq *SubscriptionMigrationResultQuery) ExistX(ctx context.Context) bool {
+	exist, err := smrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubscriptionMigrationResultQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smrq *SubscriptionMigrationResultQuery) Clone() *SubscriptionMigrationResultQuery {
+	if smrq == nil {
+		return nil
+	}
+	return &SubscriptionMigrationResultQuery{
+		config:     smrq.config,
+		ctx:        smrq.ctx.Clone(),
+		order:      append([]subscriptionmigrationresult.OrderOption{}, smrq.order...),
+		inters:     append([]Interceptor{}, smrq.inters...),
+		predicates: append([]predicate.SubscriptionMigrationResult{}, smrq.predicates...),
+		// clone intermediate query.
+		sql:  smrq.sql.Clone(),
+		path: smrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SubscriptionMigrationResult.Query().
+//		GroupBy(subscriptionmigrationresult.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (smrq *SubscriptionMigrationResultQuery) GroupBy(field string, fields ...string) *SubscriptionMigrationResultGroupBy {
+	smrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SubscriptionMigrationResultGroupBy{build: smrq}
+	grbuild.flds = &smrq.ctx.Fields
+	grbuild.label = subscriptionmigrationresult.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID string `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.SubscriptionMigrationResult.Query().
+//		Select(subscriptionmigrationresult.FieldTenantID).
+//		Scan(ctx, &v)
+func (smrq *SubscriptionMigrationResultQuery) Select(fields ...string) *SubscriptionMigrationResultSelect {
+	smrq.ctx.Fields = append(smrq.ctx.Fields, fields...)
+	sbuild := &SubscriptionMigrationResultSelect{SubscriptionMigrationResultQuery: smrq}
+	sbuild.label = subscriptionmigrationresult.Label
+	sbuild.flds, sbuild.scan = &smrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SubscriptionMigrationResultSelect configured with the given aggregations.
+func (smrq *SubscriptionMigrationResultQuery) Aggregate(fns ...AggregateFunc) *SubscriptionMigrationResultSelect {
+	return smrq.Select().Aggregate(fns...)
+}
+
+func (smrq *SubscriptionMigrationResultQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range smrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, smrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range smrq.ctx.Fields {
+		if !subscriptionmigrationresult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if smrq.path != nil {
+		prev, err := smrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smrq.sql = prev
+	}
+	return nil
+}
+
+func (smrq *SubscriptionMigrationResultQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SubscriptionMigrationResult, error) {
+	var (
+		nodes = []*SubscriptionMigrationResult{}
+		_spec = smrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SubscriptionMigrationResult).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SubscriptionMigrationResult{config: smrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (smrq *SubscriptionMigrationResultQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smrq.querySpec()
+	_spec.Node.Columns = smrq.ctx.Fields
+	if len(smrq.ctx.Fields) > 0 {
+		_spec.Unique = smrq.ctx.Unique != nil && *smrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, smrq.driver, _spec)
+}
+
+func (smrq *SubscriptionMigrationResultQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(subscriptionmigrationresult.Table, subscriptionmigrationresult.Columns, sqlgraph.NewFieldSpec(subscriptionmigrationresult.FieldID, field.TypeString))
+	_spec.From = smrq.sql
+	if unique := smrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if smrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := smrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionmigrationresult.FieldID)
+		for i := range fields {
+			if fields[i] != subscriptionmigrationresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := smrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smrq *SubscriptionMigrationResultQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(smrq.driver.Dialect())
+	t1 := builder.Table(subscriptionmigrationresult.Table)
+	columns := smrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = subscriptionmigrationresult.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if smrq.sql != nil {
+		selector = smrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if smrq.ctx.Unique != nil && *smrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range smrq.predicates {
+		p(selector)
+	}
+	for _, p := range smrq.order {
+		p(selector)
+	}
+	if offset := smrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SubscriptionMigrationResultGroupBy is the group-by builder for SubscriptionMigrationResult entities.
+type SubscriptionMigrationResultGroupBy struct {
+	selector
+	build *SubscriptionMigrationResultQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smrgb *SubscriptionMigrationResultGroupBy) Aggregate(fns ...AggregateFunc) *SubscriptionMigrationResultGroupBy {
+	smrgb.fns = append(smrgb.fns, fns...)
+	return smrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (smrgb *SubscriptionMigrationResultGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, smrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := smrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionMigrationResultQuery, *SubscriptionMigrationResultGroupBy](ctx, smrgb.build, smrgb, smrgb.build.inters, v)
+}
+
+func (smrgb *SubscriptionMigrationResultGroupBy) sqlScan(ctx context.Context, root *SubscriptionMigrationResultQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(smrgb.fns))
+	for _, fn := range smrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*smrgb.flds)+len(smrgb.fns))
+		for _, f := range *smrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*smrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SubscriptionMigrationResultSelect is the builder for selecting fields of SubscriptionMigrationResult entities.
+type SubscriptionMigrationResultSelect struct {
+	*SubscriptionMigrationResultQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (smrs *SubscriptionMigrationResultSelect) Aggregate(fns ...AggregateFunc) *SubscriptionMigrationResultSelect {
+	smrs.fns = append(smrs.fns, fns...)
+	return smrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (smrs *SubscriptionMigrationResultSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, smrs.ctx, ent.OpQuerySelect)
+	if err := smrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubscriptionMigrationResultQuery, *SubscriptionMigrationResultSelect](ctx, smrs.SubscriptionMigrationResultQuery, smrs, smrs.inters, v)
+}
+
+func (smrs *SubscriptionMigrationResultSelect) sqlScan(ctx context.Context, root *SubscriptionMigrationResultQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(smrs.fns))
+	for _, fn := range smrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*smrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionMigrationResultUpdate is the builder for updating SubscriptionMigrationResult entities.
+type SubscriptionMigrationResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMigrationResultMutation
+}
+
+// Where appends a list predicates to the SubscriptionMigrationResultUpdate builder.
+func (smru *SubscriptionMigrationResultUpdate) Where(ps ...predicate.SubscriptionMigrationResult) *SubscriptionMigrationResultUpdate {
+	smru.mutation.Where(ps...)
+	return smru
+}
+
+// SetStatus sets the "status" field.
+func (smru *SubscriptionMigrationResultUpdate) SetStatus(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetStatus(s)
+	return smru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableStatus(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetStatus(*s)
+	}
+	return smru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smru *SubscriptionMigrationResultUpdate) SetUpdatedAt(t time.Time) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetUpdatedAt(t)
+	return smru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (smru *SubscriptionMigrationResultUpdate) SetUpdatedBy(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetUpdatedBy(s)
+	return smru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableUpdatedBy(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetUpdatedBy(*s)
+	}
+	return smru
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearUpdatedBy() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearUpdatedBy()
+	return smru
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (smru *SubscriptionMigrationResultUpdate) SetCustomerID(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetCustomerID(s)
+	return smru
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableCustomerID(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetCustomerID(*s)
+	}
+	return smru
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearCustomerID() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearCustomerID()
+	return smru
+}
+
+// SetMigrationStatus sets the "migration_status" field.
+func (smru *SubscriptionMigrationResultUpdate) SetMigrationStatus(tms types.SubscriptionMigrationStatus) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetMigrationStatus(tms)
+	return smru
+}
+
+// SetNillableMigrationStatus sets the "migration_status" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableMigrationStatus(tms *types.SubscriptionMigrationStatus) *SubscriptionMigrationResultUpdate {
+	if tms != nil {
+		smru.SetMigrationStatus(*tms)
+	}
+	return smru
+}
+
+// SetNewSubscriptionID sets the "new_subscription_id" field.
+func (smru *SubscriptionMigrationResultUpdate) SetNewSubscriptionID(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetNewSubscriptionID(s)
+	return smru
+}
+
+// SetNillableNewSubscriptionID sets the "new_subscription_id" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableNewSubscriptionID(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetNewSubscriptionID(*s)
+	}
+	return smru
+}
+
+// ClearNewSubscriptionID clears the value of the "new_subscription_id" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearNewSubscriptionID() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearNewSubscriptionID()
+	return smru
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (smru *SubscriptionMigrationResultUpdate) SetScheduleID(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetScheduleID(s)
+	return smru
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableScheduleID(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetScheduleID(*s)
+	}
+	return smru
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearScheduleID() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearScheduleID()
+	return smru
+}
+
+// SetError sets the "error" field.
+func (smru *SubscriptionMigrationResultUpdate) SetError(s string) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetError(s)
+	return smru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableError(s *string) *SubscriptionMigrationResultUpdate {
+	if s != nil {
+		smru.SetError(*s)
+	}
+	return smru
+}
+
+// ClearError clears the value of the "error" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearError() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearError()
+	return smru
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (smru *SubscriptionMigrationResultUpdate) SetProcessedAt(t time.Time) *SubscriptionMigrationResultUpdate {
+	smru.mutation.SetProcessedAt(t)
+	return smru
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (smru *SubscriptionMigrationResultUpdate) SetNillableProcessedAt(t *time.Time) *SubscriptionMigrationResultUpdate {
+	if t != nil {
+		smru.SetProcessedAt(*t)
+	}
+	return smru
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (smru *SubscriptionMigrationResultUpdate) ClearProcessedAt() *SubscriptionMigrationResultUpdate {
+	smru.mutation.ClearProcessedAt()
+	return smru
+}
+
+// Mutation returns the SubscriptionMigrationResultMutation object of the builder.
+func (smru *SubscriptionMigrationResultUpdate) Mutation() *SubscriptionMigrationResultMutation {
+	return smru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smru *SubscriptionMigrationResultUpdate) Save(ctx context.Context) (int, error) {
+	smru.defaults()
+	return withHooks(ctx, smru.sqlSave, smru.mutation, smru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smru *SubscriptionMigrationResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := smru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smru *SubscriptionMigrationResultUpdate) Exec(ctx context.Context) error {
+	_, err := smru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smru *SubscriptionMigrationResultUpdate) ExecX(ctx context.Context) {
+	if err := smru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smru *SubscriptionMigrationResultUpdate) defaults() {
+	if _, ok := smru.mutation.UpdatedAt(); !ok {
+		v := subscriptionmigrationresult.UpdateDefaultUpdatedAt()
+		smru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (smru *SubscriptionMigrationResultUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionmigrationresult.Table, subscriptionmigrationresult.Columns, sqlgraph.NewFieldSpec(subscriptionmigrationresult.FieldID, field.TypeString))
+	if ps := smru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smru.mutation.Status(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := smru.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if smru.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := smru.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedBy, field.TypeString, value)
+	}
+	if smru.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldUpdatedBy, field.TypeString)
+	}
+	if smru.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := smru.mutation.CustomerID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldCustomerID, field.TypeString, value)
+	}
+	if smru.mutation.CustomerIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldCustomerID, field.TypeString)
+	}
+	if value, ok := smru.mutation.MigrationStatus(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldMigrationStatus, field.TypeString, value)
+	}
+	if value, ok := smru.mutation.NewSubscriptionID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldNewSubscriptionID, field.TypeString, value)
+	}
+	if smru.mutation.NewSubscriptionIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldNewSubscriptionID, field.TypeString)
+	}
+	if value, ok := smru.mutation.ScheduleID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldScheduleID, field.TypeString, value)
+	}
+	if smru.mutation.ScheduleIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldScheduleID, field.TypeString)
+	}
+	if value, ok := smru.mutation.Error(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldError, field.TypeString, value)
+	}
+	if smru.mutation.ErrorCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldError, field.TypeString)
+	}
+	if value, ok := smru.mutation.ProcessedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldProcessedAt, field.TypeTime, value)
+	}
+	if smru.mutation.ProcessedAtCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldProcessedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionmigrationresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smru.mutation.done = true
+	return n, nil
+}
+
+// SubscriptionMigrationResultUpdateOne is the builder for updating a single SubscriptionMigrationResult entity.
+type SubscriptionMigrationResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMigrationResultMutation
+}
+
+// SetStatus sets the "status" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetStatus(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetStatus(s)
+	return smruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableStatus(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetStatus(*s)
+	}
+	return smruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetUpdatedAt(t time.Time) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetUpdatedAt(t)
+	return smruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetUpdatedBy(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetUpdatedBy(s)
+	return smruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableUpdatedBy(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetUpdatedBy(*s)
+	}
+	return smruo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearUpdatedBy() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearUpdatedBy()
+	return smruo
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetCustomerID(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetCustomerID(s)
+	return smruo
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableCustomerID(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetCustomerID(*s)
+	}
+	return smruo
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearCustomerID() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearCustomerID()
+	return smruo
+}
+
+// SetMigrationStatus sets the "migration_status" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetMigrationStatus(tms types.SubscriptionMigrationStatus) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetMigrationStatus(tms)
+	return smruo
+}
+
+// SetNillableMigrationStatus sets the "migration_status" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableMigrationStatus(tms *types.SubscriptionMigrationStatus) *SubscriptionMigrationResultUpdateOne {
+	if tms != nil {
+		smruo.SetMigrationStatus(*tms)
+	}
+	return smruo
+}
+
+// SetNewSubscriptionID sets the "new_subscription_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNewSubscriptionID(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetNewSubscriptionID(s)
+	return smruo
+}
+
+// SetNillableNewSubscriptionID sets the "new_subscription_id" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableNewSubscriptionID(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetNewSubscriptionID(*s)
+	}
+	return smruo
+}
+
+// ClearNewSubscriptionID clears the value of the "new_subscription_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearNewSubscriptionID() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearNewSubscriptionID()
+	return smruo
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetScheduleID(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetScheduleID(s)
+	return smruo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableScheduleID(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetScheduleID(*s)
+	}
+	return smruo
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearScheduleID() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearScheduleID()
+	return smruo
+}
+
+// SetError sets the "error" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetError(s string) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetError(s)
+	return smruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableError(s *string) *SubscriptionMigrationResultUpdateOne {
+	if s != nil {
+		smruo.SetError(*s)
+	}
+	return smruo
+}
+
+// ClearError clears the value of the "error" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearError() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearError()
+	return smruo
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetProcessedAt(t time.Time) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.SetProcessedAt(t)
+	return smruo
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (smruo *SubscriptionMigrationResultUpdateOne) SetNillableProcessedAt(t *time.Time) *SubscriptionMigrationResultUpdateOne {
+	if t != nil {
+		smruo.SetProcessedAt(*t)
+	}
+	return smruo
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (smruo *SubscriptionMigrationResultUpdateOne) ClearProcessedAt() *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.ClearProcessedAt()
+	return smruo
+}
+
+// Mutation returns the SubscriptionMigrationResultMutation object of the builder.
+func (smruo *SubscriptionMigrationResultUpdateOne) Mutation() *SubscriptionMigrationResultMutation {
+	return smruo.mutation
+}
+
+// Where appends a list predicates to the SubscriptionMigrationResultUpdate builder.
+func (smruo *SubscriptionMigrationResultUpdateOne) Where(ps ...predicate.SubscriptionMigrationResult) *SubscriptionMigrationResultUpdateOne {
+	smruo.mutation.Where(ps...)
+	return smruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smruo *SubscriptionMigrationResultUpdateOne) Select(field string, fields ...string) *SubscriptionMigrationResultUpdateOne {
+	smruo.fields = append([]string{field}, fields...)
+	return smruo
+}
+
+// Save executes the query and returns the updated SubscriptionMigrationResult entity.
+func (smruo *SubscriptionMigrationResultUpdateOne) Save(ctx context.Context) (*SubscriptionMigrationResult, error) {
+	smruo.defaults()
+	return withHooks(ctx, smruo.sqlSave, smruo.mutation, smruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smruo *SubscriptionMigrationResultUpdateOne) SaveX(ctx context.Context) *SubscriptionMigrationResult {
+	node, err := smruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smruo *SubscriptionMigrationResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := smruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smruo *SubscriptionMigrationResultUpdateOne) ExecX(ctx context.Context) {
+	if err := smruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smruo *SubscriptionMigrationResultUpdateOne) defaults() {
+	if _, ok := smruo.mutation.UpdatedAt(); !ok {
+		v := subscriptionmigrationresult.UpdateDefaultUpdatedAt()
+		smruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (smruo *SubscriptionMigrationResultUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionMigrationResult, err error) {
+	_spec := sqlgraph.NewUpdateSpec(subscriptionmigrationresult.Table, subscriptionmigrationresult.Columns, sqlgraph.NewFieldSpec(subscriptionmigrationresult.FieldID, field.TypeString))
+	id, ok := smruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionMigrationResult.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionmigrationresult.FieldID)
+		for _, f := range fields {
+			if !subscriptionmigrationresult.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionmigrationresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smruo.mutation.Status(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := smruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if smruo.mutation.CreatedByCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := smruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldUpdatedBy, field.TypeString, value)
+	}
+	if smruo.mutation.UpdatedByCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldUpdatedBy, field.TypeString)
+	}
+	if smruo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldEnvironmentID, field.TypeString)
+	}
+	if value, ok := smruo.mutation.CustomerID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldCustomerID, field.TypeString, value)
+	}
+	if smruo.mutation.CustomerIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldCustomerID, field.TypeString)
+	}
+	if value, ok := smruo.mutation.MigrationStatus(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldMigrationStatus, field.TypeString, value)
+	}
+	if value, ok := smruo.mutation.NewSubscriptionID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldNewSubscriptionID, field.TypeString, value)
+	}
+	if smruo.mutation.NewSubscriptionIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldNewSubscriptionID, field.TypeString)
+	}
+	if value, ok := smruo.mutation.ScheduleID(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldScheduleID, field.TypeString, value)
+	}
+	if smruo.mutation.ScheduleIDCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldScheduleID, field.TypeString)
+	}
+	if value, ok := smruo.mutation.Error(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldError, field.TypeString, value)
+	}
+	if smruo.mutation.ErrorCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldError, field.TypeString)
+	}
+	if value, ok := smruo.mutation.ProcessedAt(); ok {
+		_spec.SetField(subscriptionmigrationresult.FieldProcessedAt, field.TypeTime, value)
+	}
+	if smruo.mutation.ProcessedAtCleared() {
+		_spec.ClearField(subscriptionmigrationresult.FieldProcessedAt, field.TypeTime)
+	}
+	_node = &SubscriptionMigrationResult{config: smruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionmigrationresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smruo.mutation.done = true
+	return _node, nil
+}
@@ -39,6 +39,8 @@ type SubscriptionPause struct {
 	PauseStatus string `json:"pause_status,omitempty"`
 	// PauseMode holds the value of the "pause_mode" field.
 	PauseMode string `json:"pause_mode,omitempty"`
+	// BillingMode holds the value of the "billing_mode" field.
+	BillingMode string `json:"billing_mode,omitempty"`
 	// ResumeMode holds the value of the "resume_mode" field.
 	ResumeMode string `json:"resume_mode,omitempty"`
 	// PauseStart holds the value of the "pause_start" field.
@@ -88,7 +90,7 @@ func (*SubscriptionPause) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case subscriptionpause.FieldMetadata:
 			values[i] = new([]byte)
-		case subscriptionpause.FieldID, subscriptionpause.FieldTenantID, subscriptionpause.FieldStatus, subscriptionpause.FieldCreatedBy, subscriptionpause.FieldUpdatedBy, subscriptionpause.FieldEnvironmentID, subscriptionpause.FieldSubscriptionID, subscriptionpause.FieldPauseStatus, subscriptionpause.FieldPauseMode, subscriptionpause.FieldResumeMode, subscriptionpause.FieldReason:
+		case subscriptionpause.FieldID, subscriptionpause.FieldTenantID, subscriptionpause.FieldStatus, subscriptionpause.FieldCreatedBy, subscriptionpause.FieldUpdatedBy, subscriptionpause.FieldEnvironmentID, subscriptionpause.FieldSubscriptionID, subscriptionpause.FieldPauseStatus, subscriptionpause.FieldPauseMode, subscriptionpause.FieldBillingMode, subscriptionpause.FieldResumeMode, subscriptionpause.FieldReason:
 			values[i] = new(sql.NullString)
 		case subscriptionpause.FieldCreatedAt, subscriptionpause.FieldUpdatedAt, subscriptionpause.FieldPauseStart, subscriptionpause.FieldPauseEnd, subscriptionpause.FieldResumedAt, subscriptionpause.FieldOriginalPeriodStart, subscriptionpause.FieldOriginalPeriodEnd:
 			values[i] = new(sql.NullTime)
@@ -173,6 +175,12 @@ func (sp *SubscriptionPause) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				sp.PauseMode = value.String
 			}
+		case subscriptionpause.FieldBillingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_mode", values[i])
+			} else if value.Valid {
+				sp.BillingMode = value.String
+			}
 		case subscriptionpause.FieldResumeMode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field resume_mode", values[i])
@@ -296,6 +304,9 @@ func (sp *SubscriptionPause) String() string {
 	builder.WriteString("pause_mode=")
 	builder.WriteString(sp.PauseMode)
 	builder.WriteString(", ")
+	builder.WriteString("billing_mode=")
+	builder.WriteString(sp.BillingMode)
+	builder.WriteString(", ")
 	builder.WriteString("resume_mode=")
 	builder.WriteString(sp.ResumeMode)
 	builder.WriteString(", ")
@@ -34,6 +34,8 @@ const (
 	FieldPauseStatus = "pause_status"
 	// FieldPauseMode holds the string denoting the pause_mode field in the database.
 	FieldPauseMode = "pause_mode"
+	// FieldBillingMode holds the string denoting the billing_mode field in the database.
+	FieldBillingMode = "billing_mode"
 	// FieldResumeMode holds the string denoting the resume_mode field in the database.
 	FieldResumeMode = "resume_mode"
 	// FieldPauseStart holds the string denoting the pause_start field in the database.
@@ -76,6 +78,7 @@ var Columns = []string{
 	FieldSubscriptionID,
 	FieldPauseStatus,
 	FieldPauseMode,
+	FieldBillingMode,
 	FieldResumeMode,
 	FieldPauseStart,
 	FieldPauseEnd,
@@ -117,6 +120,10 @@ var (
 	DefaultPauseMode string
 	// PauseModeValidator is a validator for the "pause_mode" field. It is called by the builders before save.
 	PauseModeValidator func(string) error
+	// DefaultBillingMode holds the default value on creation for the "billing_mode" field.
+	DefaultBillingMode string
+	// BillingModeValidator is a validator for the "billing_mode" field. It is called by the builders before save.
+	BillingModeValidator func(string) error
 	// DefaultPauseStart holds the default value on creation for the "pause_start" field.
 	DefaultPauseStart func() time.Time
 	// DefaultOriginalPeriodStart holds the default value on creation for the "original_period_start" field.
@@ -183,6 +190,11 @@ func ByPauseMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPauseMode, opts...).ToFunc()
 }
 
+// ByBillingMode orders the results by the billing_mode field.
+func ByBillingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingMode, opts...).ToFunc()
+}
+
 // ByResumeMode orders the results by the resume_mode field.
 func ByResumeMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResumeMode, opts...).ToFunc()
@@ -115,6 +115,11 @@ func PauseMode(v string) predicate.SubscriptionPause {
 	return predicate.SubscriptionPause(sql.FieldEQ(FieldPauseMode, v))
 }
 
+// BillingMode applies equality check predicate on the "billing_mode" field. It's identical to BillingModeEQ.
+func BillingMode(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldEQ(FieldBillingMode, v))
+}
+
 // ResumeMode applies equality check predicate on the "resume_mode" field. It's identical to ResumeModeEQ.
 func ResumeMode(v string) predicate.SubscriptionPause {
 	return predicate.SubscriptionPause(sql.FieldEQ(FieldResumeMode, v))
@@ -780,6 +785,71 @@ func PauseModeContainsFold(v string) predicate.SubscriptionPause {
 	return predicate.SubscriptionPause(sql.FieldContainsFold(FieldPauseMode, v))
 }
 
+// BillingModeEQ applies the EQ predicate on the "billing_mode" field.
+func BillingModeEQ(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldEQ(FieldBillingMode, v))
+}
+
+// BillingModeNEQ applies the NEQ predicate on the "billing_mode" field.
+func BillingModeNEQ(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldNEQ(FieldBillingMode, v))
+}
+
+// BillingModeIn applies the In predicate on the "billing_mode" field.
+func BillingModeIn(vs ...string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldIn(FieldBillingMode, vs...))
+}
+
+// BillingModeNotIn applies the NotIn predicate on the "billing_mode" field.
+func BillingModeNotIn(vs ...string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldNotIn(FieldBillingMode, vs...))
+}
+
+// BillingModeGT applies the GT predicate on the "billing_mode" field.
+func BillingModeGT(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldGT(FieldBillingMode, v))
+}
+
+// BillingModeGTE applies the GTE predicate on the "billing_mode" field.
+func BillingModeGTE(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldGTE(FieldBillingMode, v))
+}
+
+// BillingModeLT applies the LT predicate on the "billing_mode" field.
+func BillingModeLT(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldLT(FieldBillingMode, v))
+}
+
+// BillingModeLTE applies the LTE predicate on the "billing_mode" field.
+func BillingModeLTE(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldLTE(FieldBillingMode, v))
+}
+
+// BillingModeContains applies the Contains predicate on the "billing_mode" field.
+func BillingModeContains(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldContains(FieldBillingMode, v))
+}
+
+// BillingModeHasPrefix applies the HasPrefix predicate on the "billing_mode" field.
+func BillingModeHasPrefix(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldHasPrefix(FieldBillingMode, v))
+}
+
+// BillingModeHasSuffix applies the HasSuffix predicate on the "billing_mode" field.
+func BillingModeHasSuffix(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldHasSuffix(FieldBillingMode, v))
+}
+
+// BillingModeEqualFold applies the EqualFold predicate on the "billing_mode" field.
+func BillingModeEqualFold(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldEqualFold(FieldBillingMode, v))
+}
+
+// BillingModeContainsFold applies the ContainsFold predicate on the "billing_mode" field.
+func BillingModeContainsFold(v string) predicate.SubscriptionPause {
+	return predicate.SubscriptionPause(sql.FieldContainsFold(FieldBillingMode, v))
+}
+
 // ResumeModeEQ applies the EQ predicate on the "resume_mode" field.
 func ResumeModeEQ(v string) predicate.SubscriptionPause {
 	return predicate.SubscriptionPause(sql.FieldEQ(FieldResumeMode, v))
@@ -137,6 +137,20 @@ func (spc *SubscriptionPauseCreate) SetNillablePauseMode(s *string) *Subscriptio
 	return spc
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (spc *SubscriptionPauseCreate) SetBillingMode(s string) *SubscriptionPauseCreate {
+	spc.mutation.SetBillingMode(s)
+	return spc
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (spc *SubscriptionPauseCreate) SetNillableBillingMode(s *string) *SubscriptionPauseCreate {
+	if s != nil {
+		spc.SetBillingMode(*s)
+	}
+	return spc
+}
+
 // SetResumeMode sets the "resume_mode" field.
 func (spc *SubscriptionPauseCreate) SetResumeMode(s string) *SubscriptionPauseCreate {
 	spc.mutation.SetResumeMode(s)
@@ -307,6 +321,10 @@ func (spc *SubscriptionPauseCreate) defaults() {
 		v := subscriptionpause.DefaultPauseMode
 		spc.mutation.SetPauseMode(v)
 	}
+	if _, ok := spc.mutation.BillingMode(); !ok {
+		v := subscriptionpause.DefaultBillingMode
+		spc.mutation.SetBillingMode(v)
+	}
 	if _, ok := spc.mutation.PauseStart(); !ok {
 		v := subscriptionpause.DefaultPauseStart()
 		spc.mutation.SetPauseStart(v)
@@ -364,6 +382,14 @@ func (spc *SubscriptionPauseCreate) check() error {
 			return &ValidationError{Name: "pause_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.pause_mode": %w`, err)}
 		}
 	}
+	if _, ok := spc.mutation.BillingMode(); !ok {
+		return &ValidationError{Name: "billing_mode", err: errors.New(`ent: missing required field "SubscriptionPause.billing_mode"`)}
+	}
+	if v, ok := spc.mutation.BillingMode(); ok {
+		if err := subscriptionpause.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.billing_mode": %w`, err)}
+		}
+	}
 	if _, ok := spc.mutation.PauseStart(); !ok {
 		return &ValidationError{Name: "pause_start", err: errors.New(`ent: missing required field "SubscriptionPause.pause_start"`)}
 	}
@@ -447,6 +473,10 @@ func (spc *SubscriptionPauseCreate) createSpec() (*SubscriptionPause, *sqlgraph.
 		_spec.SetField(subscriptionpause.FieldPauseMode, field.TypeString, value)
 		_node.PauseMode = value
 	}
+	if value, ok := spc.mutation.BillingMode(); ok {
+		_spec.SetField(subscriptionpause.FieldBillingMode, field.TypeString, value)
+		_node.BillingMode = value
+	}
 	if value, ok := spc.mutation.ResumeMode(); ok {
 		_spec.SetField(subscriptionpause.FieldResumeMode, field.TypeString, value)
 		_node.ResumeMode = value
@@ -111,6 +111,20 @@ func (spu *SubscriptionPauseUpdate) SetNillablePauseMode(s *string) *Subscriptio
 	return spu
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (spu *SubscriptionPauseUpdate) SetBillingMode(s string) *SubscriptionPauseUpdate {
+	spu.mutation.SetBillingMode(s)
+	return spu
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (spu *SubscriptionPauseUpdate) SetNillableBillingMode(s *string) *SubscriptionPauseUpdate {
+	if s != nil {
+		spu.SetBillingMode(*s)
+	}
+	return spu
+}
+
 // SetResumeMode sets the "resume_mode" field.
 func (spu *SubscriptionPauseUpdate) SetResumeMode(s string) *SubscriptionPauseUpdate {
 	spu.mutation.SetResumeMode(s)
@@ -314,6 +328,11 @@ func (spu *SubscriptionPauseUpdate) check() error {
 			return &ValidationError{Name: "pause_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.pause_mode": %w`, err)}
 		}
 	}
+	if v, ok := spu.mutation.BillingMode(); ok {
+		if err := subscriptionpause.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.billing_mode": %w`, err)}
+		}
+	}
 	if spu.mutation.SubscriptionCleared() && len(spu.mutation.SubscriptionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SubscriptionPause.subscription"`)
 	}
@@ -356,6 +375,9 @@ func (spu *SubscriptionPauseUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := spu.mutation.PauseMode(); ok {
 		_spec.SetField(subscriptionpause.FieldPauseMode, field.TypeString, value)
 	}
+	if value, ok := spu.mutation.BillingMode(); ok {
+		_spec.SetField(subscriptionpause.FieldBillingMode, field.TypeString, value)
+	}
 	if value, ok := spu.mutation.ResumeMode(); ok {
 		_spec.SetField(subscriptionpause.FieldResumeMode, field.TypeString, value)
 	}
@@ -526,6 +548,20 @@ func (spuo *SubscriptionPauseUpdateOne) SetNillablePauseMode(s *string) *Subscri
 	return spuo
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (spuo *SubscriptionPauseUpdateOne) SetBillingMode(s string) *SubscriptionPauseUpdateOne {
+	spuo.mutation.SetBillingMode(s)
+	return spuo
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (spuo *SubscriptionPauseUpdateOne) SetNillableBillingMode(s *string) *SubscriptionPauseUpdateOne {
+	if s != nil {
+		spuo.SetBillingMode(*s)
+	}
+	return spuo
+}
+
 // SetResumeMode sets the "resume_mode" field.
 func (spuo *SubscriptionPauseUpdateOne) SetResumeMode(s string) *SubscriptionPauseUpdateOne {
 	spuo.mutation.SetResumeMode(s)
@@ -742,6 +778,11 @@ func (spuo *SubscriptionPauseUpdateOne) check() error {
 			return &ValidationError{Name: "pause_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.pause_mode": %w`, err)}
 		}
 	}
+	if v, ok := spuo.mutation.BillingMode(); ok {
+		if err := subscriptionpause.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPause.billing_mode": %w`, err)}
+		}
+	}
 	if spuo.mutation.SubscriptionCleared() && len(spuo.mutation.SubscriptionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SubscriptionPause.subscription"`)
 	}
@@ -801,6 +842,9 @@ func (spuo *SubscriptionPauseUpdateOne) sqlSave(ctx context.Context) (_node *Sub
 	if value, ok := spuo.mutation.PauseMode(); ok {
 		_spec.SetField(subscriptionpause.FieldPauseMode, field.TypeString, value)
 	}
+	if value, ok := spuo.mutation.BillingMode(); ok {
+		_spec.SetField(subscriptionpause.FieldBillingMode, field.TypeString, value)
+	}
 	if value, ok := spuo.mutation.ResumeMode(); ok {
 		_spec.SetField(subscriptionpause.FieldResumeMode, field.TypeString, value)
 	}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionPhase is the model entity for the SubscriptionPhase schema.
@@ -41,6 +42,12 @@ type SubscriptionPhase struct {
 	StartDate time.Time `json:"start_date,omitempty"`
 	// EndDate holds the value of the "end_date" field.
 	EndDate *time.Time `json:"end_date,omitempty"`
+	// Annual commitment amount of the phase
+	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty"`
+	// OverageFactor holds the value of the "overage_factor" field.
+	OverageFactor *decimal.Decimal `json:"overage_factor,omitempty"`
+	// CommitmentTrueUpCadence holds the value of the "commitment_true_up_cadence" field.
+	CommitmentTrueUpCadence *string `json:"commitment_true_up_cadence,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubscriptionPhaseQuery when eager-loading is set.
 	Edges        SubscriptionPhaseEdges `json:"edges"`
@@ -72,9 +79,11 @@ func (*SubscriptionPhase) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case subscriptionphase.FieldCommitmentAmount, subscriptionphase.FieldOverageFactor:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case subscriptionphase.FieldMetadata:
 			values[i] = new([]byte)
-		case subscriptionphase.FieldID, subscriptionphase.FieldTenantID, subscriptionphase.FieldStatus, subscriptionphase.FieldCreatedBy, subscriptionphase.FieldUpdatedBy, subscriptionphase.FieldEnvironmentID, subscriptionphase.FieldSubscriptionID:
+		case subscriptionphase.FieldID, subscriptionphase.FieldTenantID, subscriptionphase.FieldStatus, subscriptionphase.FieldCreatedBy, subscriptionphase.FieldUpdatedBy, subscriptionphase.FieldEnvironmentID, subscriptionphase.FieldSubscriptionID, subscriptionphase.FieldCommitmentTrueUpCadence:
 			values[i] = new(sql.NullString)
 		case subscriptionphase.FieldCreatedAt, subscriptionphase.FieldUpdatedAt, subscriptionphase.FieldStartDate, subscriptionphase.FieldEndDate:
 			values[i] = new(sql.NullTime)
@@ -168,6 +177,27 @@ func (sp *SubscriptionPhase) assignValues(columns []string, values []any) error
 				sp.EndDate = new(time.Time)
 				*sp.EndDate = value.Time
 			}
+		case subscriptionphase.FieldCommitmentAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_amount", values[i])
+			} else if value.Valid {
+				sp.CommitmentAmount = new(decimal.Decimal)
+				*sp.CommitmentAmount = *value.S.(*decimal.Decimal)
+			}
+		case subscriptionphase.FieldOverageFactor:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_factor", values[i])
+			} else if value.Valid {
+				sp.OverageFactor = new(decimal.Decimal)
+				*sp.OverageFactor = *value.S.(*decimal.Decimal)
+			}
+		case subscriptionphase.FieldCommitmentTrueUpCadence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commitment_true_up_cadence", values[i])
+			} else if value.Valid {
+				sp.CommitmentTrueUpCadence = new(string)
+				*sp.CommitmentTrueUpCadence = value.String
+			}
 		default:
 			sp.selectValues.Set(columns[i], values[i])
 		}
@@ -243,6 +273,21 @@ func (sp *SubscriptionPhase) String() string {
 		builder.WriteString("end_date=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := sp.CommitmentAmount; v != nil {
+		builder.WriteString("commitment_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sp.OverageFactor; v != nil {
+		builder.WriteString("overage_factor=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sp.CommitmentTrueUpCadence; v != nil {
+		builder.WriteString("commitment_true_up_cadence=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
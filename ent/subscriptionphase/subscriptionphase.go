@@ -36,6 +36,12 @@ const (
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
+	// FieldCommitmentAmount holds the string denoting the commitment_amount field in the database.
+	FieldCommitmentAmount = "commitment_amount"
+	// FieldOverageFactor holds the string denoting the overage_factor field in the database.
+	FieldOverageFactor = "overage_factor"
+	// FieldCommitmentTrueUpCadence holds the string denoting the commitment_true_up_cadence field in the database.
+	FieldCommitmentTrueUpCadence = "commitment_true_up_cadence"
 	// EdgeSubscription holds the string denoting the subscription edge name in mutations.
 	EdgeSubscription = "subscription"
 	// Table holds the table name of the subscriptionphase in the database.
@@ -63,6 +69,9 @@ var Columns = []string{
 	FieldSubscriptionID,
 	FieldStartDate,
 	FieldEndDate,
+	FieldCommitmentAmount,
+	FieldOverageFactor,
+	FieldCommitmentTrueUpCadence,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -152,6 +161,21 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
 }
 
+// ByCommitmentAmount orders the results by the commitment_amount field.
+func ByCommitmentAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentAmount, opts...).ToFunc()
+}
+
+// ByOverageFactor orders the results by the overage_factor field.
+func ByOverageFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageFactor, opts...).ToFunc()
+}
+
+// ByCommitmentTrueUpCadence orders the results by the commitment_true_up_cadence field.
+func ByCommitmentTrueUpCadence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitmentTrueUpCadence, opts...).ToFunc()
+}
+
 // BySubscriptionField orders the results by subscription field.
 func BySubscriptionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
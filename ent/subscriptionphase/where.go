@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -115,6 +116,21 @@ func EndDate(v time.Time) predicate.SubscriptionPhase {
 	return predicate.SubscriptionPhase(sql.FieldEQ(FieldEndDate, v))
 }
 
+// CommitmentAmount applies equality check predicate on the "commitment_amount" field. It's identical to CommitmentAmountEQ.
+func CommitmentAmount(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// OverageFactor applies equality check predicate on the "overage_factor" field. It's identical to OverageFactorEQ.
+func OverageFactor(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldOverageFactor, v))
+}
+
+// CommitmentTrueUpCadence applies equality check predicate on the "commitment_true_up_cadence" field. It's identical to CommitmentTrueUpCadenceEQ.
+func CommitmentTrueUpCadence(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldCommitmentTrueUpCadence, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v string) predicate.SubscriptionPhase {
 	return predicate.SubscriptionPhase(sql.FieldEQ(FieldTenantID, v))
@@ -715,6 +731,181 @@ func EndDateNotNil() predicate.SubscriptionPhase {
 	return predicate.SubscriptionPhase(sql.FieldNotNull(FieldEndDate))
 }
 
+// CommitmentAmountEQ applies the EQ predicate on the "commitment_amount" field.
+func CommitmentAmountEQ(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountNEQ applies the NEQ predicate on the "commitment_amount" field.
+func CommitmentAmountNEQ(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNEQ(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIn applies the In predicate on the "commitment_amount" field.
+func CommitmentAmountIn(vs ...decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountNotIn applies the NotIn predicate on the "commitment_amount" field.
+func CommitmentAmountNotIn(vs ...decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotIn(FieldCommitmentAmount, vs...))
+}
+
+// CommitmentAmountGT applies the GT predicate on the "commitment_amount" field.
+func CommitmentAmountGT(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountGTE applies the GTE predicate on the "commitment_amount" field.
+func CommitmentAmountGTE(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLT applies the LT predicate on the "commitment_amount" field.
+func CommitmentAmountLT(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLT(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountLTE applies the LTE predicate on the "commitment_amount" field.
+func CommitmentAmountLTE(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLTE(FieldCommitmentAmount, v))
+}
+
+// CommitmentAmountIsNil applies the IsNil predicate on the "commitment_amount" field.
+func CommitmentAmountIsNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIsNull(FieldCommitmentAmount))
+}
+
+// CommitmentAmountNotNil applies the NotNil predicate on the "commitment_amount" field.
+func CommitmentAmountNotNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotNull(FieldCommitmentAmount))
+}
+
+// OverageFactorEQ applies the EQ predicate on the "overage_factor" field.
+func OverageFactorEQ(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldOverageFactor, v))
+}
+
+// OverageFactorNEQ applies the NEQ predicate on the "overage_factor" field.
+func OverageFactorNEQ(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNEQ(FieldOverageFactor, v))
+}
+
+// OverageFactorIn applies the In predicate on the "overage_factor" field.
+func OverageFactorIn(vs ...decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIn(FieldOverageFactor, vs...))
+}
+
+// OverageFactorNotIn applies the NotIn predicate on the "overage_factor" field.
+func OverageFactorNotIn(vs ...decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotIn(FieldOverageFactor, vs...))
+}
+
+// OverageFactorGT applies the GT predicate on the "overage_factor" field.
+func OverageFactorGT(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGT(FieldOverageFactor, v))
+}
+
+// OverageFactorGTE applies the GTE predicate on the "overage_factor" field.
+func OverageFactorGTE(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGTE(FieldOverageFactor, v))
+}
+
+// OverageFactorLT applies the LT predicate on the "overage_factor" field.
+func OverageFactorLT(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLT(FieldOverageFactor, v))
+}
+
+// OverageFactorLTE applies the LTE predicate on the "overage_factor" field.
+func OverageFactorLTE(v decimal.Decimal) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLTE(FieldOverageFactor, v))
+}
+
+// OverageFactorIsNil applies the IsNil predicate on the "overage_factor" field.
+func OverageFactorIsNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIsNull(FieldOverageFactor))
+}
+
+// OverageFactorNotNil applies the NotNil predicate on the "overage_factor" field.
+func OverageFactorNotNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotNull(FieldOverageFactor))
+}
+
+// CommitmentTrueUpCadenceEQ applies the EQ predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceEQ(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEQ(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceNEQ applies the NEQ predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceNEQ(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNEQ(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceIn applies the In predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceIn(vs ...string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIn(FieldCommitmentTrueUpCadence, vs...))
+}
+
+// CommitmentTrueUpCadenceNotIn applies the NotIn predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceNotIn(vs ...string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotIn(FieldCommitmentTrueUpCadence, vs...))
+}
+
+// CommitmentTrueUpCadenceGT applies the GT predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceGT(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGT(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceGTE applies the GTE predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceGTE(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldGTE(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceLT applies the LT predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceLT(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLT(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceLTE applies the LTE predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceLTE(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldLTE(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceContains applies the Contains predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceContains(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldContains(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceHasPrefix applies the HasPrefix predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceHasPrefix(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldHasPrefix(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceHasSuffix applies the HasSuffix predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceHasSuffix(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldHasSuffix(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceIsNil applies the IsNil predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceIsNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldIsNull(FieldCommitmentTrueUpCadence))
+}
+
+// CommitmentTrueUpCadenceNotNil applies the NotNil predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceNotNil() predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldNotNull(FieldCommitmentTrueUpCadence))
+}
+
+// CommitmentTrueUpCadenceEqualFold applies the EqualFold predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceEqualFold(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldEqualFold(FieldCommitmentTrueUpCadence, v))
+}
+
+// CommitmentTrueUpCadenceContainsFold applies the ContainsFold predicate on the "commitment_true_up_cadence" field.
+func CommitmentTrueUpCadenceContainsFold(v string) predicate.SubscriptionPhase {
+	return predicate.SubscriptionPhase(sql.FieldContainsFold(FieldCommitmentTrueUpCadence, v))
+}
+
 // HasSubscription applies the HasEdge predicate on the "subscription" edge.
 func HasSubscription() predicate.SubscriptionPhase {
 	return predicate.SubscriptionPhase(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionPhaseCreate is the builder for creating a SubscriptionPhase entity.
@@ -151,6 +152,48 @@ func (spc *SubscriptionPhaseCreate) SetNillableEndDate(t *time.Time) *Subscripti
 	return spc
 }
 
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (spc *SubscriptionPhaseCreate) SetCommitmentAmount(d decimal.Decimal) *SubscriptionPhaseCreate {
+	spc.mutation.SetCommitmentAmount(d)
+	return spc
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (spc *SubscriptionPhaseCreate) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionPhaseCreate {
+	if d != nil {
+		spc.SetCommitmentAmount(*d)
+	}
+	return spc
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (spc *SubscriptionPhaseCreate) SetOverageFactor(d decimal.Decimal) *SubscriptionPhaseCreate {
+	spc.mutation.SetOverageFactor(d)
+	return spc
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (spc *SubscriptionPhaseCreate) SetNillableOverageFactor(d *decimal.Decimal) *SubscriptionPhaseCreate {
+	if d != nil {
+		spc.SetOverageFactor(*d)
+	}
+	return spc
+}
+
+// SetCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field.
+func (spc *SubscriptionPhaseCreate) SetCommitmentTrueUpCadence(s string) *SubscriptionPhaseCreate {
+	spc.mutation.SetCommitmentTrueUpCadence(s)
+	return spc
+}
+
+// SetNillableCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field if the given value is not nil.
+func (spc *SubscriptionPhaseCreate) SetNillableCommitmentTrueUpCadence(s *string) *SubscriptionPhaseCreate {
+	if s != nil {
+		spc.SetCommitmentTrueUpCadence(*s)
+	}
+	return spc
+}
+
 // SetID sets the "id" field.
 func (spc *SubscriptionPhaseCreate) SetID(s string) *SubscriptionPhaseCreate {
 	spc.mutation.SetID(s)
@@ -327,6 +370,18 @@ func (spc *SubscriptionPhaseCreate) createSpec() (*SubscriptionPhase, *sqlgraph.
 		_spec.SetField(subscriptionphase.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = &value
 	}
+	if value, ok := spc.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentAmount, field.TypeOther, value)
+		_node.CommitmentAmount = &value
+	}
+	if value, ok := spc.mutation.OverageFactor(); ok {
+		_spec.SetField(subscriptionphase.FieldOverageFactor, field.TypeOther, value)
+		_node.OverageFactor = &value
+	}
+	if value, ok := spc.mutation.CommitmentTrueUpCadence(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentTrueUpCadence, field.TypeString, value)
+		_node.CommitmentTrueUpCadence = &value
+	}
 	if nodes := spc.mutation.SubscriptionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
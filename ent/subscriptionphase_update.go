@@ -14,6 +14,7 @@ import (
 	"github.com/flexprice/flexprice/ent/predicate"
 	"github.com/flexprice/flexprice/ent/subscription"
 	"github.com/flexprice/flexprice/ent/subscriptionphase"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionPhaseUpdate is the builder for updating SubscriptionPhase entities.
@@ -115,6 +116,66 @@ func (spu *SubscriptionPhaseUpdate) ClearEndDate() *SubscriptionPhaseUpdate {
 	return spu
 }
 
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (spu *SubscriptionPhaseUpdate) SetCommitmentAmount(d decimal.Decimal) *SubscriptionPhaseUpdate {
+	spu.mutation.SetCommitmentAmount(d)
+	return spu
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (spu *SubscriptionPhaseUpdate) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionPhaseUpdate {
+	if d != nil {
+		spu.SetCommitmentAmount(*d)
+	}
+	return spu
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (spu *SubscriptionPhaseUpdate) ClearCommitmentAmount() *SubscriptionPhaseUpdate {
+	spu.mutation.ClearCommitmentAmount()
+	return spu
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (spu *SubscriptionPhaseUpdate) SetOverageFactor(d decimal.Decimal) *SubscriptionPhaseUpdate {
+	spu.mutation.SetOverageFactor(d)
+	return spu
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (spu *SubscriptionPhaseUpdate) SetNillableOverageFactor(d *decimal.Decimal) *SubscriptionPhaseUpdate {
+	if d != nil {
+		spu.SetOverageFactor(*d)
+	}
+	return spu
+}
+
+// ClearOverageFactor clears the value of the "overage_factor" field.
+func (spu *SubscriptionPhaseUpdate) ClearOverageFactor() *SubscriptionPhaseUpdate {
+	spu.mutation.ClearOverageFactor()
+	return spu
+}
+
+// SetCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field.
+func (spu *SubscriptionPhaseUpdate) SetCommitmentTrueUpCadence(s string) *SubscriptionPhaseUpdate {
+	spu.mutation.SetCommitmentTrueUpCadence(s)
+	return spu
+}
+
+// SetNillableCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field if the given value is not nil.
+func (spu *SubscriptionPhaseUpdate) SetNillableCommitmentTrueUpCadence(s *string) *SubscriptionPhaseUpdate {
+	if s != nil {
+		spu.SetCommitmentTrueUpCadence(*s)
+	}
+	return spu
+}
+
+// ClearCommitmentTrueUpCadence clears the value of the "commitment_true_up_cadence" field.
+func (spu *SubscriptionPhaseUpdate) ClearCommitmentTrueUpCadence() *SubscriptionPhaseUpdate {
+	spu.mutation.ClearCommitmentTrueUpCadence()
+	return spu
+}
+
 // SetSubscription sets the "subscription" edge to the Subscription entity.
 func (spu *SubscriptionPhaseUpdate) SetSubscription(s *Subscription) *SubscriptionPhaseUpdate {
 	return spu.SetSubscriptionID(s.ID)
@@ -222,6 +283,24 @@ func (spu *SubscriptionPhaseUpdate) sqlSave(ctx context.Context) (n int, err err
 	if spu.mutation.EndDateCleared() {
 		_spec.ClearField(subscriptionphase.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := spu.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if spu.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(subscriptionphase.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := spu.mutation.OverageFactor(); ok {
+		_spec.SetField(subscriptionphase.FieldOverageFactor, field.TypeOther, value)
+	}
+	if spu.mutation.OverageFactorCleared() {
+		_spec.ClearField(subscriptionphase.FieldOverageFactor, field.TypeOther)
+	}
+	if value, ok := spu.mutation.CommitmentTrueUpCadence(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentTrueUpCadence, field.TypeString, value)
+	}
+	if spu.mutation.CommitmentTrueUpCadenceCleared() {
+		_spec.ClearField(subscriptionphase.FieldCommitmentTrueUpCadence, field.TypeString)
+	}
 	if spu.mutation.SubscriptionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -357,6 +436,66 @@ func (spuo *SubscriptionPhaseUpdateOne) ClearEndDate() *SubscriptionPhaseUpdateO
 	return spuo
 }
 
+// SetCommitmentAmount sets the "commitment_amount" field.
+func (spuo *SubscriptionPhaseUpdateOne) SetCommitmentAmount(d decimal.Decimal) *SubscriptionPhaseUpdateOne {
+	spuo.mutation.SetCommitmentAmount(d)
+	return spuo
+}
+
+// SetNillableCommitmentAmount sets the "commitment_amount" field if the given value is not nil.
+func (spuo *SubscriptionPhaseUpdateOne) SetNillableCommitmentAmount(d *decimal.Decimal) *SubscriptionPhaseUpdateOne {
+	if d != nil {
+		spuo.SetCommitmentAmount(*d)
+	}
+	return spuo
+}
+
+// ClearCommitmentAmount clears the value of the "commitment_amount" field.
+func (spuo *SubscriptionPhaseUpdateOne) ClearCommitmentAmount() *SubscriptionPhaseUpdateOne {
+	spuo.mutation.ClearCommitmentAmount()
+	return spuo
+}
+
+// SetOverageFactor sets the "overage_factor" field.
+func (spuo *SubscriptionPhaseUpdateOne) SetOverageFactor(d decimal.Decimal) *SubscriptionPhaseUpdateOne {
+	spuo.mutation.SetOverageFactor(d)
+	return spuo
+}
+
+// SetNillableOverageFactor sets the "overage_factor" field if the given value is not nil.
+func (spuo *SubscriptionPhaseUpdateOne) SetNillableOverageFactor(d *decimal.Decimal) *SubscriptionPhaseUpdateOne {
+	if d != nil {
+		spuo.SetOverageFactor(*d)
+	}
+	return spuo
+}
+
+// ClearOverageFactor clears the value of the "overage_factor" field.
+func (spuo *SubscriptionPhaseUpdateOne) ClearOverageFactor() *SubscriptionPhaseUpdateOne {
+	spuo.mutation.ClearOverageFactor()
+	return spuo
+}
+
+// SetCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field.
+func (spuo *SubscriptionPhaseUpdateOne) SetCommitmentTrueUpCadence(s string) *SubscriptionPhaseUpdateOne {
+	spuo.mutation.SetCommitmentTrueUpCadence(s)
+	return spuo
+}
+
+// SetNillableCommitmentTrueUpCadence sets the "commitment_true_up_cadence" field if the given value is not nil.
+func (spuo *SubscriptionPhaseUpdateOne) SetNillableCommitmentTrueUpCadence(s *string) *SubscriptionPhaseUpdateOne {
+	if s != nil {
+		spuo.SetCommitmentTrueUpCadence(*s)
+	}
+	return spuo
+}
+
+// ClearCommitmentTrueUpCadence clears the value of the "commitment_true_up_cadence" field.
+func (spuo *SubscriptionPhaseUpdateOne) ClearCommitmentTrueUpCadence() *SubscriptionPhaseUpdateOne {
+	spuo.mutation.ClearCommitmentTrueUpCadence()
+	return spuo
+}
+
 // SetSubscription sets the "subscription" edge to the Subscription entity.
 func (spuo *SubscriptionPhaseUpdateOne) SetSubscription(s *Subscription) *SubscriptionPhaseUpdateOne {
 	return spuo.SetSubscriptionID(s.ID)
@@ -494,6 +633,24 @@ func (spuo *SubscriptionPhaseUpdateOne) sqlSave(ctx context.Context) (_node *Sub
 	if spuo.mutation.EndDateCleared() {
 		_spec.ClearField(subscriptionphase.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := spuo.mutation.CommitmentAmount(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentAmount, field.TypeOther, value)
+	}
+	if spuo.mutation.CommitmentAmountCleared() {
+		_spec.ClearField(subscriptionphase.FieldCommitmentAmount, field.TypeOther)
+	}
+	if value, ok := spuo.mutation.OverageFactor(); ok {
+		_spec.SetField(subscriptionphase.FieldOverageFactor, field.TypeOther, value)
+	}
+	if spuo.mutation.OverageFactorCleared() {
+		_spec.ClearField(subscriptionphase.FieldOverageFactor, field.TypeOther)
+	}
+	if value, ok := spuo.mutation.CommitmentTrueUpCadence(); ok {
+		_spec.SetField(subscriptionphase.FieldCommitmentTrueUpCadence, field.TypeString, value)
+	}
+	if spuo.mutation.CommitmentTrueUpCadenceCleared() {
+		_spec.ClearField(subscriptionphase.FieldCommitmentTrueUpCadence, field.TypeString)
+	}
 	if spuo.mutation.SubscriptionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
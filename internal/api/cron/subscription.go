@@ -55,7 +55,7 @@ func (h *SubscriptionHandler) ProcessAutoCancellationSubscriptions(c *gin.Contex
 	c.JSON(http.StatusOK, gin.H{"status": "completed"})
 }
 
-// ProcessSubscriptionRenewalDueAlerts processes subscriptions that are due for renewal in 24 hours
+// ProcessSubscriptionRenewalDueAlerts processes subscriptions whose renewal is due in 24 hours
 // and sends webhook notifications
 func (h *SubscriptionHandler) ProcessSubscriptionRenewalDueAlerts(c *gin.Context) {
 	h.logger.Infow("starting subscription renewal due alerts cron job")
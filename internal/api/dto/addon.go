@@ -9,6 +9,8 @@ import (
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // CreateAddonRequest represents the request to create an addon
@@ -83,8 +85,25 @@ type ListAddonsResponse = types.ListResponse[*AddonResponse]
 type AddAddonToSubscriptionRequest struct {
 	AddonID   string                 `json:"addon_id" validate:"required"`
 	StartDate *time.Time             `json:"start_date,omitempty"`
+	EndDate   *time.Time             `json:"end_date,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata"`
 
+	// Quantity of the addon, fixed prices of the addon are charged per unit. Defaults to 1.
+	Quantity *decimal.Decimal `json:"quantity,omitempty" swaggertype:"string"`
+
+	// BillingPeriod of the addon prices, defaults to the billing period of the subscription.
+	// Addons with their own billing period are billed on their own cycle starting at the
+	// start date and can only have fixed prices.
+	BillingPeriod      types.BillingPeriod `json:"billing_period,omitempty"`
+	BillingPeriodCount int                 `json:"billing_period_count,omitempty"`
+
+	// TrialPeriodDays is the number of days the addon is free, it is billed after the trial
+	TrialPeriodDays int `json:"trial_period_days,omitempty" validate:"omitempty,min=0"`
+
+	// ProrationBehavior controls whether the unused part of the current period is charged when
+	// the addon is added. Defaults to create_prorations.
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior,omitempty"`
+
 	// LineItemCommitments allows setting commitment configuration per addon line item (keyed by price_id)
 	LineItemCommitments map[string]*LineItemCommitmentConfig `json:"line_item_commitments,omitempty" validate:"omitempty,dive"`
 
@@ -102,16 +121,25 @@ func (a *AddAddonToSubscriptionRequest) ToAddonAssociation(ctx context.Context,
 	if a.StartDate != nil {
 		startDate = *a.StartDate
 	}
+	var trialEnd *time.Time
+	if a.TrialPeriodDays > 0 {
+		trialEnd = lo.ToPtr(startDate.AddDate(0, 0, a.TrialPeriodDays))
+	}
 	return &addonassociation.AddonAssociation{
-		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ADDON_ASSOCIATION),
-		EntityID:      enitiyId,
-		EntityType:    enitityType,
-		AddonID:       a.AddonID,
-		AddonStatus:   types.AddonStatusActive,
-		StartDate:     &startDate,
-		Metadata:      a.Metadata,
-		EnvironmentID: types.GetEnvironmentID(ctx),
-		BaseModel:     types.GetDefaultBaseModel(ctx),
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ADDON_ASSOCIATION),
+		EntityID:           enitiyId,
+		EntityType:         enitityType,
+		AddonID:            a.AddonID,
+		AddonStatus:        types.AddonStatusActive,
+		StartDate:          &startDate,
+		EndDate:            a.EndDate,
+		Quantity:           lo.FromPtrOr(a.Quantity, decimal.NewFromInt(1)),
+		BillingPeriod:      a.BillingPeriod,
+		BillingPeriodCount: lo.Ternary(a.BillingPeriodCount > 0, a.BillingPeriodCount, 1),
+		TrialEnd:           trialEnd,
+		Metadata:           a.Metadata,
+		EnvironmentID:      types.GetEnvironmentID(ctx),
+		BaseModel:          types.GetDefaultBaseModel(ctx),
 	}
 }
 
@@ -125,6 +153,43 @@ func (r *AddAddonToSubscriptionRequest) Validate() error {
 		return err
 	}
 
+	if r.Quantity != nil && !r.Quantity.IsPositive() {
+		return ierr.NewError("quantity must be greater than zero").
+			WithHint("Please provide a positive addon quantity").
+			WithReportableDetails(map[string]interface{}{
+				"quantity": r.Quantity,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.BillingPeriod != "" {
+		if err := r.BillingPeriod.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.BillingPeriodCount < 0 {
+		return ierr.NewError("billing_period_count cannot be negative").
+			WithHint("Please provide a positive billing period count").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EndDate != nil && r.StartDate != nil && !r.EndDate.After(*r.StartDate) {
+		return ierr.NewError("end_date must be after start_date").
+			WithHint("The addon must end after it starts").
+			WithReportableDetails(map[string]interface{}{
+				"start_date": r.StartDate,
+				"end_date":   r.EndDate,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ProrationBehavior == "" {
+		r.ProrationBehavior = types.ProrationBehaviorCreateProrations
+	} else if err := r.ProrationBehavior.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
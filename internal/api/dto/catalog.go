@@ -0,0 +1,654 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/flexprice/flexprice/internal/domain/addon"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/creditgrant"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CatalogDocumentVersion is the current version of the catalog document format
+const CatalogDocumentVersion = "v1"
+
+// CatalogDocument is the declarative representation of the catalog of an environment.
+// Entities are identified by their lookup key, credit grants and coupons which do not
+// have a lookup key are identified by their name.
+type CatalogDocument struct {
+	Version  string           `json:"version"`
+	Features []CatalogFeature `json:"features,omitempty"`
+	Plans    []CatalogPlan    `json:"plans,omitempty"`
+	Addons   []CatalogAddon   `json:"addons,omitempty"`
+	Coupons  []CatalogCoupon  `json:"coupons,omitempty"`
+
+	// Skipped lists the entities that could not be exported, e.g. because they do not have
+	// a lookup key. It is informational only and ignored when the document is applied.
+	Skipped []CatalogSkippedEntity `json:"skipped,omitempty"`
+}
+
+// CatalogFeature is a feature of a catalog document, metered features embed their meter
+type CatalogFeature struct {
+	LookupKey     string               `json:"lookup_key" validate:"required"`
+	Name          string               `json:"name" validate:"required"`
+	Description   string               `json:"description,omitempty"`
+	Type          types.FeatureType    `json:"type" validate:"required"`
+	UnitSingular  string               `json:"unit_singular,omitempty"`
+	UnitPlural    string               `json:"unit_plural,omitempty"`
+	Metadata      types.Metadata       `json:"metadata,omitempty"`
+	AlertSettings *types.AlertSettings `json:"alert_settings,omitempty"`
+	Meter         *CreateMeterRequest  `json:"meter,omitempty"`
+}
+
+// CatalogPlan is a plan of a catalog document with its prices, entitlements and credit grants
+type CatalogPlan struct {
+	LookupKey    string               `json:"lookup_key" validate:"required"`
+	Name         string               `json:"name" validate:"required"`
+	Description  string               `json:"description,omitempty"`
+	DisplayOrder *int                 `json:"display_order,omitempty"`
+	Metadata     types.Metadata       `json:"metadata,omitempty"`
+	Prices       []CatalogPrice       `json:"prices,omitempty"`
+	Entitlements []CatalogEntitlement `json:"entitlements,omitempty"`
+	CreditGrants []CatalogCreditGrant `json:"credit_grants,omitempty"`
+}
+
+// CatalogAddon is an addon of a catalog document with its prices and entitlements
+type CatalogAddon struct {
+	LookupKey    string                 `json:"lookup_key" validate:"required"`
+	Name         string                 `json:"name" validate:"required"`
+	Description  string                 `json:"description,omitempty"`
+	Type         types.AddonType        `json:"type" validate:"required"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Prices       []CatalogPrice         `json:"prices,omitempty"`
+	Entitlements []CatalogEntitlement   `json:"entitlements,omitempty"`
+}
+
+// CatalogPrice is a price of a catalog document. Usage prices reference their meter through
+// the lookup key of the metered feature instead of the environment specific meter id.
+type CatalogPrice struct {
+	LookupKey          string                   `json:"lookup_key" validate:"required"`
+	DisplayName        string                   `json:"display_name,omitempty"`
+	Description        string                   `json:"description,omitempty"`
+	Type               types.PriceType          `json:"type" validate:"required"`
+	Currency           string                   `json:"currency" validate:"required,len=3"`
+	PriceUnitType      types.PriceUnitType      `json:"price_unit_type,omitempty"`
+	PriceUnitConfig    *PriceUnitConfig         `json:"price_unit_config,omitempty"`
+	Amount             *decimal.Decimal         `json:"amount,omitempty" swaggertype:"string"`
+	BillingPeriod      types.BillingPeriod      `json:"billing_period" validate:"required"`
+	BillingPeriodCount int                      `json:"billing_period_count,omitempty"`
+	BillingModel       types.BillingModel       `json:"billing_model" validate:"required"`
+	BillingCadence     types.BillingCadence     `json:"billing_cadence" validate:"required"`
+	InvoiceCadence     types.InvoiceCadence     `json:"invoice_cadence" validate:"required"`
+	TrialPeriod        int                      `json:"trial_period,omitempty"`
+	TierMode           types.BillingTier        `json:"tier_mode,omitempty"`
+	Tiers              []CreatePriceTier        `json:"tiers,omitempty"`
+	TransformQuantity  *price.TransformQuantity `json:"transform_quantity,omitempty"`
+	FeatureLookupKey   string                   `json:"feature_lookup_key,omitempty"`
+	MinQuantity        *int64                   `json:"min_quantity,omitempty"`
+	TimeWindows        []types.PriceTimeWindow  `json:"time_windows,omitempty"`
+	CostPlus           *types.CostPlusConfig    `json:"cost_plus,omitempty"`
+	RolloverConfig     *types.RolloverConfig    `json:"rollover_config,omitempty"`
+	IncludedQuantity   *decimal.Decimal         `json:"included_quantity,omitempty" swaggertype:"string"`
+	Metadata           map[string]string        `json:"metadata,omitempty"`
+}
+
+// CatalogEntitlement is an entitlement of a plan or addon, keyed by the lookup key of its feature
+type CatalogEntitlement struct {
+	FeatureLookupKey string                            `json:"feature_lookup_key" validate:"required"`
+	IsEnabled        bool                              `json:"is_enabled"`
+	UsageLimit       *int64                            `json:"usage_limit,omitempty"`
+	UsageResetPeriod types.EntitlementUsageResetPeriod `json:"usage_reset_period,omitempty"`
+	IsSoftLimit      bool                              `json:"is_soft_limit,omitempty"`
+	StaticValue      string                            `json:"static_value,omitempty"`
+	RolloverConfig   *types.RolloverConfig             `json:"rollover_config,omitempty"`
+}
+
+// CatalogCreditGrant is a plan scoped credit grant, keyed by its name within the plan
+type CatalogCreditGrant struct {
+	Name                   string                               `json:"name" validate:"required"`
+	Credits                decimal.Decimal                      `json:"credits" swaggertype:"string"`
+	Cadence                types.CreditGrantCadence             `json:"cadence" validate:"required"`
+	Period                 *types.CreditGrantPeriod             `json:"period,omitempty"`
+	PeriodCount            *int                                 `json:"period_count,omitempty"`
+	ExpirationType         types.CreditGrantExpiryType          `json:"expiration_type,omitempty"`
+	ExpirationDuration     *int                                 `json:"expiration_duration,omitempty"`
+	ExpirationDurationUnit *types.CreditGrantExpiryDurationUnit `json:"expiration_duration_unit,omitempty"`
+	Priority               *int                                 `json:"priority,omitempty"`
+	ConversionRate         *decimal.Decimal                     `json:"conversion_rate,omitempty" swaggertype:"string"`
+	TopupConversionRate    *decimal.Decimal                     `json:"topup_conversion_rate,omitempty" swaggertype:"string"`
+	Metadata               types.Metadata                       `json:"metadata,omitempty"`
+}
+
+// CatalogCoupon is a coupon of a catalog document, keyed by its name
+type CatalogCoupon struct {
+	Name              string                  `json:"name" validate:"required"`
+	Type              types.CouponType        `json:"type" validate:"required"`
+	Cadence           types.CouponCadence     `json:"cadence" validate:"required"`
+	AmountOff         *decimal.Decimal        `json:"amount_off,omitempty" swaggertype:"string"`
+	PercentageOff     *decimal.Decimal        `json:"percentage_off,omitempty" swaggertype:"string"`
+	DurationInPeriods *int                    `json:"duration_in_periods,omitempty"`
+	MaxRedemptions    *int                    `json:"max_redemptions,omitempty"`
+	Currency          string                  `json:"currency,omitempty"`
+	Rules             *map[string]interface{} `json:"rules,omitempty"`
+	Metadata          map[string]string       `json:"metadata,omitempty"`
+}
+
+// CatalogSkippedEntity is an entity that was left out of an exported catalog document
+type CatalogSkippedEntity struct {
+	EntityType types.CatalogEntityType `json:"entity_type"`
+	ID         string                  `json:"id"`
+	Name       string                  `json:"name,omitempty"`
+	Reason     string                  `json:"reason"`
+}
+
+// ApplyCatalogRequest is the request to apply a catalog document to the current environment
+type ApplyCatalogRequest struct {
+	Document CatalogDocument
+	// DryRun only computes the changes without applying them
+	DryRun bool
+}
+
+// CatalogChange is a change applying a catalog document makes, or would make, to an entity
+type CatalogChange struct {
+	Action     types.CatalogChangeAction `json:"action"`
+	EntityType types.CatalogEntityType   `json:"entity_type"`
+	// Key identifies the entity in the document, entities nested in a plan or addon are
+	// prefixed with the lookup key of their parent e.g. "pro/api_calls"
+	Key string `json:"key"`
+	// ID is the id of the existing entity, or of the created entity once applied
+	ID string `json:"id,omitempty"`
+	// Fields are the changed fields of updated, replaced and conflicting entities
+	Fields []string `json:"fields,omitempty"`
+	// Reason explains why a change conflicts
+	Reason string `json:"reason,omitempty"`
+}
+
+// ApplyCatalogResponse is the plan of changes of a catalog document
+type ApplyCatalogResponse struct {
+	DryRun  bool                              `json:"dry_run"`
+	Applied bool                              `json:"applied"`
+	Changes []CatalogChange                   `json:"changes"`
+	Summary map[types.CatalogChangeAction]int `json:"summary"`
+}
+
+// Validate validates the catalog document and the uniqueness of its keys
+func (d *CatalogDocument) Validate() error {
+	if d.Version != "" && d.Version != CatalogDocumentVersion {
+		return ierr.NewError("unsupported catalog document version").
+			WithHintf("Catalog document version must be %s", CatalogDocumentVersion).
+			WithReportableDetails(map[string]interface{}{
+				"version": d.Version,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	featureKeys := make(map[string]bool, len(d.Features))
+	for _, f := range d.Features {
+		if err := validator.ValidateRequest(f); err != nil {
+			return err
+		}
+		if err := f.Type.Validate(); err != nil {
+			return err
+		}
+		if f.Type == types.FeatureTypeMetered && f.Meter == nil {
+			return ierr.NewError("meter is required for metered features").
+				WithHint("Please provide the meter of the metered feature").
+				WithReportableDetails(map[string]interface{}{
+					"feature": f.LookupKey,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if f.Type != types.FeatureTypeMetered && f.Meter != nil {
+			return ierr.NewError("meter is only allowed for metered features").
+				WithHint("Please remove the meter of the feature or change its type to metered").
+				WithReportableDetails(map[string]interface{}{
+					"feature": f.LookupKey,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if err := checkUniqueCatalogKey(featureKeys, types.CatalogEntityTypeFeature, f.LookupKey); err != nil {
+			return err
+		}
+	}
+
+	planKeys := make(map[string]bool, len(d.Plans))
+	priceKeys := make(map[string]bool)
+	for _, p := range d.Plans {
+		if err := validator.ValidateRequest(p); err != nil {
+			return err
+		}
+		if err := checkUniqueCatalogKey(planKeys, types.CatalogEntityTypePlan, p.LookupKey); err != nil {
+			return err
+		}
+		if err := validateCatalogPrices(priceKeys, p.Prices); err != nil {
+			return err
+		}
+		if err := validateCatalogEntitlements(p.LookupKey, p.Entitlements); err != nil {
+			return err
+		}
+
+		grantKeys := make(map[string]bool, len(p.CreditGrants))
+		for _, g := range p.CreditGrants {
+			if err := validator.ValidateRequest(g); err != nil {
+				return err
+			}
+			if err := checkUniqueCatalogKey(grantKeys, types.CatalogEntityTypeCreditGrant, CatalogChildKey(p.LookupKey, g.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	addonKeys := make(map[string]bool, len(d.Addons))
+	for _, a := range d.Addons {
+		if err := validator.ValidateRequest(a); err != nil {
+			return err
+		}
+		if err := a.Type.Validate(); err != nil {
+			return err
+		}
+		if err := checkUniqueCatalogKey(addonKeys, types.CatalogEntityTypeAddon, a.LookupKey); err != nil {
+			return err
+		}
+		if err := validateCatalogPrices(priceKeys, a.Prices); err != nil {
+			return err
+		}
+		if err := validateCatalogEntitlements(a.LookupKey, a.Entitlements); err != nil {
+			return err
+		}
+	}
+
+	couponKeys := make(map[string]bool, len(d.Coupons))
+	for _, c := range d.Coupons {
+		if err := validator.ValidateRequest(c); err != nil {
+			return err
+		}
+		if err := checkUniqueCatalogKey(couponKeys, types.CatalogEntityTypeCoupon, c.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCatalogPrices(keys map[string]bool, prices []CatalogPrice) error {
+	for _, p := range prices {
+		if err := validator.ValidateRequest(p); err != nil {
+			return err
+		}
+		if p.Type == types.PRICE_TYPE_USAGE && p.FeatureLookupKey == "" {
+			return ierr.NewError("feature_lookup_key is required for usage prices").
+				WithHint("Please provide the lookup key of the metered feature of the usage price").
+				WithReportableDetails(map[string]interface{}{
+					"price": p.LookupKey,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		// Price lookup keys are unique across all plans and addons of an environment
+		if err := checkUniqueCatalogKey(keys, types.CatalogEntityTypePrice, p.LookupKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCatalogEntitlements(parentKey string, entitlements []CatalogEntitlement) error {
+	keys := make(map[string]bool, len(entitlements))
+	for _, e := range entitlements {
+		if err := validator.ValidateRequest(e); err != nil {
+			return err
+		}
+		if err := checkUniqueCatalogKey(keys, types.CatalogEntityTypeEntitlement, CatalogChildKey(parentKey, e.FeatureLookupKey)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkUniqueCatalogKey(keys map[string]bool, entityType types.CatalogEntityType, key string) error {
+	if keys[key] {
+		return ierr.NewErrorf("duplicate %s %s in catalog document", entityType, key).
+			WithHint("Every entity of a catalog document must have a unique key").
+			WithReportableDetails(map[string]interface{}{
+				"entity_type": entityType,
+				"key":         key,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	keys[key] = true
+	return nil
+}
+
+// CatalogChildKey is the key of an entity nested in a plan or addon of a catalog document
+func CatalogChildKey(parentKey, key string) string {
+	return fmt.Sprintf("%s/%s", parentKey, key)
+}
+
+// Normalize fills the defaults the price has once created so that it compares equal to its
+// exported representation
+func (p CatalogPrice) Normalize() CatalogPrice {
+	if p.PriceUnitType == "" {
+		p.PriceUnitType = types.PRICE_UNIT_TYPE_FIAT
+	}
+	if p.BillingPeriodCount == 0 {
+		p.BillingPeriodCount = 1
+	}
+	if p.IncludedQuantity != nil && p.IncludedQuantity.IsZero() {
+		p.IncludedQuantity = nil
+	}
+	return p
+}
+
+// NewCatalogFeature creates the catalog representation of a feature and its meter
+func NewCatalogFeature(f *feature.Feature, m *meter.Meter) CatalogFeature {
+	cf := CatalogFeature{
+		LookupKey:     f.LookupKey,
+		Name:          f.Name,
+		Description:   f.Description,
+		Type:          f.Type,
+		UnitSingular:  f.UnitSingular,
+		UnitPlural:    f.UnitPlural,
+		Metadata:      f.Metadata,
+		AlertSettings: f.AlertSettings,
+	}
+	if m != nil {
+		cf.Meter = &CreateMeterRequest{
+			Name:        m.Name,
+			EventName:   m.EventName,
+			Aggregation: m.Aggregation,
+			Filters:     m.Filters,
+			ResetUsage:  m.ResetUsage,
+		}
+	}
+	return cf
+}
+
+// NewCatalogPlan creates the catalog representation of a plan without its children
+func NewCatalogPlan(p *plan.Plan) CatalogPlan {
+	return CatalogPlan{
+		LookupKey:    p.LookupKey,
+		Name:         p.Name,
+		Description:  p.Description,
+		DisplayOrder: p.DisplayOrder,
+		Metadata:     p.Metadata,
+	}
+}
+
+// NewCatalogAddon creates the catalog representation of an addon without its children
+func NewCatalogAddon(a *addon.Addon) CatalogAddon {
+	return CatalogAddon{
+		LookupKey:   a.LookupKey,
+		Name:        a.Name,
+		Description: a.Description,
+		Type:        a.Type,
+		Metadata:    a.Metadata,
+	}
+}
+
+// NewCatalogPrice creates the catalog representation of a price, featureLookupKey is the
+// lookup key of the metered feature of a usage price
+func NewCatalogPrice(p *price.Price, featureLookupKey string) CatalogPrice {
+	cp := CatalogPrice{
+		LookupKey:          p.LookupKey,
+		DisplayName:        p.DisplayName,
+		Description:        p.Description,
+		Type:               p.Type,
+		Currency:           p.Currency,
+		PriceUnitType:      p.PriceUnitType,
+		BillingPeriod:      p.BillingPeriod,
+		BillingPeriodCount: p.BillingPeriodCount,
+		BillingModel:       p.BillingModel,
+		BillingCadence:     p.BillingCadence,
+		InvoiceCadence:     p.InvoiceCadence,
+		TrialPeriod:        p.TrialPeriod,
+		FeatureLookupKey:   featureLookupKey,
+		TimeWindows:        p.TimeWindows,
+		CostPlus:           p.CostPlus,
+		RolloverConfig:     p.RolloverConfig,
+		Metadata:           p.Metadata,
+	}
+
+	isTiered := p.BillingModel == types.BILLING_MODEL_TIERED
+	if isTiered {
+		cp.TierMode = p.TierMode
+	}
+
+	if p.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
+		cp.PriceUnitConfig = &PriceUnitConfig{
+			PriceUnit: lo.FromPtr(p.PriceUnit),
+		}
+		if isTiered {
+			cp.PriceUnitConfig.PriceUnitTiers = newCatalogPriceTiers(p.PriceUnitTiers)
+		} else {
+			cp.PriceUnitConfig.Amount = p.PriceUnitAmount
+		}
+	} else if isTiered {
+		cp.Tiers = newCatalogPriceTiers(p.Tiers)
+	} else if p.BillingModel != types.BILLING_MODEL_COST_PLUS {
+		cp.Amount = lo.ToPtr(p.Amount)
+	}
+
+	if p.BillingModel == types.BILLING_MODEL_PACKAGE && p.TransformQuantity != (price.JSONBTransformQuantity{}) {
+		cp.TransformQuantity = lo.ToPtr(price.TransformQuantity(p.TransformQuantity))
+	}
+
+	if p.IncludedQuantity.IsPositive() {
+		cp.IncludedQuantity = lo.ToPtr(p.IncludedQuantity)
+	}
+
+	if p.MinQuantity != nil {
+		cp.MinQuantity = lo.ToPtr(p.MinQuantity.IntPart())
+	}
+
+	return cp
+}
+
+func newCatalogPriceTiers(tiers price.JSONBTiers) []CreatePriceTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+	return lo.Map(tiers, func(t price.PriceTier, _ int) CreatePriceTier {
+		return CreatePriceTier{
+			UpTo:       t.UpTo,
+			UnitAmount: t.UnitAmount,
+			FlatAmount: t.FlatAmount,
+		}
+	})
+}
+
+// NewCatalogEntitlement creates the catalog representation of an entitlement
+func NewCatalogEntitlement(e *entitlement.Entitlement, featureLookupKey string) CatalogEntitlement {
+	return CatalogEntitlement{
+		FeatureLookupKey: featureLookupKey,
+		IsEnabled:        e.IsEnabled,
+		UsageLimit:       e.UsageLimit,
+		UsageResetPeriod: e.UsageResetPeriod,
+		IsSoftLimit:      e.IsSoftLimit,
+		StaticValue:      e.StaticValue,
+		RolloverConfig:   e.RolloverConfig,
+	}
+}
+
+// NewCatalogCreditGrant creates the catalog representation of a plan scoped credit grant
+func NewCatalogCreditGrant(g *creditgrant.CreditGrant) CatalogCreditGrant {
+	return CatalogCreditGrant{
+		Name:                   g.Name,
+		Credits:                g.Credits,
+		Cadence:                g.Cadence,
+		Period:                 g.Period,
+		PeriodCount:            g.PeriodCount,
+		ExpirationType:         g.ExpirationType,
+		ExpirationDuration:     g.ExpirationDuration,
+		ExpirationDurationUnit: g.ExpirationDurationUnit,
+		Priority:               g.Priority,
+		ConversionRate:         g.ConversionRate,
+		TopupConversionRate:    g.TopupConversionRate,
+		Metadata:               g.Metadata,
+	}
+}
+
+// NewCatalogCoupon creates the catalog representation of a coupon
+func NewCatalogCoupon(c *coupon.Coupon) CatalogCoupon {
+	return CatalogCoupon{
+		Name:              c.Name,
+		Type:              c.Type,
+		Cadence:           c.Cadence,
+		AmountOff:         c.AmountOff,
+		PercentageOff:     c.PercentageOff,
+		DurationInPeriods: c.DurationInPeriods,
+		MaxRedemptions:    c.MaxRedemptions,
+		Currency:          c.Currency,
+		Rules:             c.Rules,
+		Metadata:          lo.FromPtr(c.Metadata),
+	}
+}
+
+// ToCreateFeatureRequest converts the catalog feature to a create feature request
+func (f CatalogFeature) ToCreateFeatureRequest() CreateFeatureRequest {
+	return CreateFeatureRequest{
+		Name:          f.Name,
+		Description:   f.Description,
+		LookupKey:     f.LookupKey,
+		Type:          f.Type,
+		Meter:         f.Meter,
+		Metadata:      f.Metadata,
+		UnitSingular:  f.UnitSingular,
+		UnitPlural:    f.UnitPlural,
+		AlertSettings: f.AlertSettings,
+	}
+}
+
+// ToCreatePlanRequest converts the catalog plan to a create plan request
+func (p CatalogPlan) ToCreatePlanRequest() CreatePlanRequest {
+	return CreatePlanRequest{
+		Name:         p.Name,
+		LookupKey:    p.LookupKey,
+		Description:  p.Description,
+		DisplayOrder: p.DisplayOrder,
+		Metadata:     p.Metadata,
+	}
+}
+
+// ToCreateAddonRequest converts the catalog addon to a create addon request
+func (a CatalogAddon) ToCreateAddonRequest() CreateAddonRequest {
+	return CreateAddonRequest{
+		Name:        a.Name,
+		LookupKey:   a.LookupKey,
+		Description: a.Description,
+		Type:        a.Type,
+		Metadata:    a.Metadata,
+	}
+}
+
+// ToCreatePriceRequest converts the catalog price to a create price request of the given
+// plan or addon, meterID is the id of the meter of a usage price
+func (p CatalogPrice) ToCreatePriceRequest(entityType types.PriceEntityType, entityID, meterID string) CreatePriceRequest {
+	p = p.Normalize()
+	return CreatePriceRequest{
+		Amount:             p.Amount,
+		Currency:           p.Currency,
+		EntityType:         entityType,
+		EntityID:           entityID,
+		Type:               p.Type,
+		PriceUnitType:      p.PriceUnitType,
+		BillingPeriod:      p.BillingPeriod,
+		BillingPeriodCount: p.BillingPeriodCount,
+		BillingModel:       p.BillingModel,
+		BillingCadence:     p.BillingCadence,
+		MeterID:            meterID,
+		LookupKey:          p.LookupKey,
+		InvoiceCadence:     p.InvoiceCadence,
+		TrialPeriod:        p.TrialPeriod,
+		Description:        p.Description,
+		Metadata:           p.Metadata,
+		TierMode:           p.TierMode,
+		Tiers:              p.Tiers,
+		TransformQuantity:  p.TransformQuantity,
+		PriceUnitConfig:    p.PriceUnitConfig,
+		DisplayName:        p.DisplayName,
+		MinQuantity:        p.MinQuantity,
+		TimeWindows:        p.TimeWindows,
+		CostPlus:           p.CostPlus,
+		RolloverConfig:     p.RolloverConfig,
+		IncludedQuantity:   p.IncludedQuantity,
+	}
+}
+
+// ToCreateEntitlementRequest converts the catalog entitlement to a create entitlement request
+// of the given plan or addon
+func (e CatalogEntitlement) ToCreateEntitlementRequest(entityType types.EntitlementEntityType, entityID, featureID string, featureType types.FeatureType) CreateEntitlementRequest {
+	return CreateEntitlementRequest{
+		FeatureID:        featureID,
+		FeatureType:      featureType,
+		IsEnabled:        e.IsEnabled,
+		UsageLimit:       e.UsageLimit,
+		UsageResetPeriod: e.UsageResetPeriod,
+		IsSoftLimit:      e.IsSoftLimit,
+		StaticValue:      e.StaticValue,
+		RolloverConfig:   e.RolloverConfig,
+		EntityType:       entityType,
+		EntityID:         entityID,
+	}
+}
+
+// ToUpdateEntitlementRequest converts the catalog entitlement to an update entitlement request
+func (e CatalogEntitlement) ToUpdateEntitlementRequest() UpdateEntitlementRequest {
+	return UpdateEntitlementRequest{
+		IsEnabled: lo.ToPtr(e.IsEnabled),
+		// A zero usage limit removes the limit of the entitlement
+		UsageLimit:       lo.ToPtr(lo.FromPtr(e.UsageLimit)),
+		UsageResetPeriod: e.UsageResetPeriod,
+		IsSoftLimit:      lo.ToPtr(e.IsSoftLimit),
+		StaticValue:      e.StaticValue,
+		RolloverConfig:   e.RolloverConfig,
+	}
+}
+
+// ToCreateCreditGrantRequest converts the catalog credit grant to a create request of the given plan
+func (g CatalogCreditGrant) ToCreateCreditGrantRequest(planID string) CreateCreditGrantRequest {
+	return CreateCreditGrantRequest{
+		Name:                   g.Name,
+		Scope:                  types.CreditGrantScopePlan,
+		PlanID:                 lo.ToPtr(planID),
+		Credits:                g.Credits,
+		Cadence:                g.Cadence,
+		Period:                 g.Period,
+		PeriodCount:            g.PeriodCount,
+		ExpirationType:         g.ExpirationType,
+		ExpirationDuration:     g.ExpirationDuration,
+		ExpirationDurationUnit: g.ExpirationDurationUnit,
+		Priority:               g.Priority,
+		Metadata:               g.Metadata,
+		ConversionRate:         g.ConversionRate,
+		TopupConversionRate:    g.TopupConversionRate,
+	}
+}
+
+// ToCreateCouponRequest converts the catalog coupon to a create coupon request
+func (c CatalogCoupon) ToCreateCouponRequest() CreateCouponRequest {
+	req := CreateCouponRequest{
+		Name:              c.Name,
+		MaxRedemptions:    c.MaxRedemptions,
+		Rules:             c.Rules,
+		AmountOff:         c.AmountOff,
+		PercentageOff:     c.PercentageOff,
+		Type:              c.Type,
+		Cadence:           c.Cadence,
+		DurationInPeriods: c.DurationInPeriods,
+	}
+	if c.Metadata != nil {
+		req.Metadata = lo.ToPtr(c.Metadata)
+	}
+	if c.Currency != "" {
+		req.Currency = lo.ToPtr(c.Currency)
+	}
+	return req
+}
@@ -70,6 +70,10 @@ type CreateCustomerRequest struct {
 	// parent_customer_external_id is the external ID of the parent customer from your system
 	// Exactly one of parent_customer_id or parent_customer_external_id may be provided
 	ParentCustomerExternalID *string `json:"parent_customer_external_id,omitempty"`
+
+	// consolidate_invoices when true, subscriptions of the customer sharing a billing anchor and currency
+	// are invoiced together. Overrides the consolidate_subscription_invoices invoice config of the environment
+	ConsolidateInvoices *bool `json:"consolidate_invoices,omitempty"`
 }
 
 // UpdateCustomerRequest represents the request to update an existing customer
@@ -115,6 +119,9 @@ type UpdateCustomerRequest struct {
 	// Exactly one of parent_customer_id or parent_customer_external_id may be provided
 	// If you provide the external ID, the parent customer value will be ignored
 	ParentCustomerExternalID *string `json:"parent_customer_external_id,omitempty"`
+
+	// consolidate_invoices is the updated consolidated invoicing setting of the customer
+	ConsolidateInvoices *bool `json:"consolidate_invoices,omitempty"`
 }
 
 // CustomerResponse represents the response for customer operations
@@ -167,20 +174,21 @@ func (r *CreateCustomerRequest) Validate() error {
 
 func (r *CreateCustomerRequest) ToCustomer(ctx context.Context) *customer.Customer {
 	return &customer.Customer{
-		ID:                types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CUSTOMER),
-		ExternalID:        r.ExternalID,
-		Name:              r.Name,
-		Email:             r.Email,
-		AddressLine1:      r.AddressLine1,
-		AddressLine2:      r.AddressLine2,
-		AddressCity:       r.AddressCity,
-		AddressState:      r.AddressState,
-		AddressPostalCode: r.AddressPostalCode,
-		AddressCountry:    r.AddressCountry,
-		Metadata:          r.Metadata,
-		ParentCustomerID:  r.ParentCustomerID,
-		EnvironmentID:     types.GetEnvironmentID(ctx),
-		BaseModel:         types.GetDefaultBaseModel(ctx),
+		ID:                  types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CUSTOMER),
+		ExternalID:          r.ExternalID,
+		Name:                r.Name,
+		Email:               r.Email,
+		AddressLine1:        r.AddressLine1,
+		AddressLine2:        r.AddressLine2,
+		AddressCity:         r.AddressCity,
+		AddressState:        r.AddressState,
+		AddressPostalCode:   r.AddressPostalCode,
+		AddressCountry:      r.AddressCountry,
+		Metadata:            r.Metadata,
+		ParentCustomerID:    r.ParentCustomerID,
+		ConsolidateInvoices: r.ConsolidateInvoices,
+		EnvironmentID:       types.GetEnvironmentID(ctx),
+		BaseModel:           types.GetDefaultBaseModel(ctx),
 	}
 }
 
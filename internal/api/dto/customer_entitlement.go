@@ -53,6 +53,7 @@ type AggregatedEntitlement struct {
 	IsSoftLimit      bool                              `json:"is_soft_limit"`
 	UsageResetPeriod types.EntitlementUsageResetPeriod `json:"usage_reset_period,omitempty"`
 	StaticValues     []string                          `json:"static_values,omitempty"` // For static/SLA features
+	RolloverConfig   *types.RolloverConfig             `json:"rollover_config,omitempty"`
 }
 
 // EntitlementSourceType defines the type of entitlement source
@@ -134,4 +135,10 @@ type FeatureUsageSummary struct {
 	IsSoftLimit      bool                 `json:"is_soft_limit"`
 	NextUsageResetAt *time.Time           `json:"next_usage_reset_at"`
 	Sources          []*EntitlementSource `json:"sources"`
+	// RolloverBalance is the rolled over usage still available in the current period
+	RolloverBalance decimal.Decimal `json:"rollover_balance" swaggertype:"string"`
+	// IncludedQuantity is the usage included for free in each billing period by the usage prices
+	IncludedQuantity decimal.Decimal `json:"included_quantity" swaggertype:"string"`
+	// IncludedRemaining is the included usage still available in the current period
+	IncludedRemaining decimal.Decimal `json:"included_remaining" swaggertype:"string"`
 }
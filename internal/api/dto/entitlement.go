@@ -26,6 +26,7 @@ type CreateEntitlementRequest struct {
 	ParentEntitlementID *string                           `json:"parent_entitlement_id,omitempty"`
 	StartDate           *time.Time                        `json:"start_date,omitempty"`
 	EndDate             *time.Time                        `json:"end_date,omitempty"`
+	RolloverConfig      *types.RolloverConfig             `json:"rollover_config,omitempty"`
 }
 
 func (r *CreateEntitlementRequest) Validate() error {
@@ -51,6 +52,9 @@ func (r *CreateEntitlementRequest) Validate() error {
 				return err
 			}
 		}
+		if err := validateEntitlementRollover(r.RolloverConfig, r.UsageLimit, r.UsageResetPeriod); err != nil {
+			return err
+		}
 	case types.FeatureTypeStatic:
 		if r.StaticValue == "" {
 			return ierr.NewError("static_value is required for static features").
@@ -59,6 +63,12 @@ func (r *CreateEntitlementRequest) Validate() error {
 		}
 	}
 
+	if r.RolloverConfig != nil && r.FeatureType != types.FeatureTypeMetered {
+		return ierr.NewError("rollover_config is only supported for metered features").
+			WithHint("Only usage limits of metered features can roll over").
+			Mark(ierr.ErrValidation)
+	}
+
 	// either you pass planId or entityType and entityId
 	if r.PlanID == "" && (r.EntityType == "" || r.EntityID == "") {
 		return ierr.NewError("either plan_id or entity_type and entity_id is required").
@@ -101,6 +111,7 @@ func (r *CreateEntitlementRequest) ToEntitlement(ctx context.Context) *entitleme
 		ParentEntitlementID: r.ParentEntitlementID,
 		StartDate:           r.StartDate,
 		EndDate:             r.EndDate,
+		RolloverConfig:      r.RolloverConfig,
 		EnvironmentID:       types.GetEnvironmentID(ctx),
 		BaseModel:           types.GetDefaultBaseModel(ctx),
 	}
@@ -113,6 +124,7 @@ type UpdateEntitlementRequest struct {
 	UsageResetPeriod types.EntitlementUsageResetPeriod `json:"usage_reset_period"`
 	IsSoftLimit      *bool                             `json:"is_soft_limit"`
 	StaticValue      string                            `json:"static_value"`
+	RolloverConfig   *types.RolloverConfig             `json:"rollover_config,omitempty"`
 }
 
 // EntitlementResponse represents the response for an entitlement
@@ -200,3 +212,32 @@ func EntitlementsToResponse(entitlements []*entitlement.Entitlement) []*Entitlem
 	}
 	return responses
 }
+
+// validateEntitlementRollover validates the rollover of a metered entitlement. Only a limited
+// usage that resets can roll over.
+func validateEntitlementRollover(config *types.RolloverConfig, usageLimit *int64, resetPeriod types.EntitlementUsageResetPeriod) error {
+	if config == nil {
+		return nil
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	if usageLimit == nil {
+		return ierr.NewError("rollover_config requires a usage limit").
+			WithHint("Unlimited entitlements have no unused usage to roll over").
+			Mark(ierr.ErrValidation)
+	}
+
+	if resetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER {
+		return ierr.NewError("rollover_config is not supported for entitlements that never reset").
+			WithHint("Unused usage only rolls over for entitlements that reset every period").
+			WithReportableDetails(map[string]interface{}{
+				"usage_reset_period": resetPeriod,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
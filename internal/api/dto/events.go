@@ -86,7 +86,8 @@ type GetUsageRequest struct {
 	// - "2024-03-05T14:30:45.123456789Z" (5th of each month at 2:30:45 PM)
 	// - "2024-01-15T00:00:00Z" (15th of each month at midnight)
 	// - "2024-02-29T12:00:00Z" (29th of each month at noon - handles leap years)
-	BillingAnchor *time.Time `form:"billing_anchor" json:"billing_anchor,omitempty" example:"2024-03-05T14:30:45.123456789Z"`
+	BillingAnchor *time.Time              `form:"billing_anchor" json:"billing_anchor,omitempty" example:"2024-03-05T14:30:45.123456789Z"`
+	TimeWindow    *types.TimeWindowFilter `form:"-" json:"-"` // this is just for internal use to split usage by time-of-use windows
 }
 
 type GetUsageByMeterRequest struct {
@@ -116,7 +117,8 @@ type GetUsageByMeterRequest struct {
 	// Example: If BillingAnchor = "2024-03-05T14:30:45Z" and WindowSize = "MONTH":
 	//   - March period: 2024-03-05 14:30:45 to 2024-04-05 14:30:45
 	//   - April period: 2024-04-05 14:30:45 to 2024-05-05 14:30:45
-	BillingAnchor *time.Time `form:"billing_anchor" json:"billing_anchor,omitempty" example:"2024-03-05T14:30:45Z"`
+	BillingAnchor *time.Time              `form:"billing_anchor" json:"billing_anchor,omitempty" example:"2024-03-05T14:30:45Z"`
+	TimeWindow    *types.TimeWindowFilter `form:"-" json:"-"` // this is just for internal use to split usage by time-of-use windows
 }
 
 type GetEventsRequest struct {
@@ -230,6 +232,7 @@ func (r *GetUsageRequest) ToUsageParams() *events.UsageParams {
 		Filters:            r.Filters,
 		Multiplier:         r.Multiplier,
 		BillingAnchor:      r.BillingAnchor,
+		TimeWindow:         r.TimeWindow,
 	}
 }
 
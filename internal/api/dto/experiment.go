@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/experiment"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// CreateExperimentRequest represents the request to create a price experiment
+type CreateExperimentRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+	// plan_id is the public plan, subscriptions created through its lookup key resolve to a variant
+	PlanID string `json:"plan_id" validate:"required"`
+	// variants are the plans customers are split between, weights are relative shares
+	Variants []types.ExperimentVariant `json:"variants" validate:"required,dive"`
+	Metadata map[string]string         `json:"metadata,omitempty"`
+}
+
+func (r *CreateExperimentRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	return types.ValidateExperimentVariants(r.Variants)
+}
+
+func (r *CreateExperimentRequest) ToExperiment(ctx context.Context) *experiment.Experiment {
+	return &experiment.Experiment{
+		ID:               types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EXPERIMENT),
+		Name:             r.Name,
+		Description:      r.Description,
+		PlanID:           r.PlanID,
+		ExperimentStatus: types.ExperimentStatusDraft,
+		Variants:         r.Variants,
+		Metadata:         r.Metadata,
+		EnvironmentID:    types.GetEnvironmentID(ctx),
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateExperimentRequest represents the request to update a price experiment.
+// Variants can only be changed while the experiment is a draft.
+type UpdateExperimentRequest struct {
+	Name        *string                   `json:"name,omitempty"`
+	Description *string                   `json:"description,omitempty"`
+	Variants    []types.ExperimentVariant `json:"variants,omitempty" validate:"omitempty,dive"`
+	Metadata    map[string]string         `json:"metadata,omitempty"`
+}
+
+func (r *UpdateExperimentRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name can not be empty").
+			WithHint("Provide a name for the experiment").
+			Mark(ierr.ErrValidation)
+	}
+	if r.Variants != nil {
+		return types.ValidateExperimentVariants(r.Variants)
+	}
+	return nil
+}
+
+// ExperimentResponse represents a price experiment in responses
+type ExperimentResponse struct {
+	*experiment.Experiment
+}
+
+// ListExperimentsResponse represents the response for listing experiments
+type ListExperimentsResponse = types.ListResponse[*ExperimentResponse]
+
+// AssignExperimentVariantRequest represents the request to assign a customer to a variant
+type AssignExperimentVariantRequest struct {
+	CustomerID string `json:"customer_id" validate:"required"`
+}
+
+func (r *AssignExperimentVariantRequest) Validate() error {
+	return validator.ValidateRequest(r)
+}
+
+// ExperimentAssignmentResponse represents an experiment assignment in responses
+type ExperimentAssignmentResponse struct {
+	*experiment.Assignment
+}
+
+// ListExperimentAssignmentsResponse represents the response for listing experiment assignments
+type ListExperimentAssignmentsResponse = types.ListResponse[*ExperimentAssignmentResponse]
+
+// ExperimentVariantReport reports the assignments, conversions and revenue of a variant
+type ExperimentVariantReport struct {
+	Key         string `json:"key"`
+	PlanID      string `json:"plan_id"`
+	Weight      int    `json:"weight"`
+	Assignments int    `json:"assignments"`
+	// Conversions counts assigned customers whose subscription has been activated
+	Conversions    int             `json:"conversions"`
+	ConversionRate decimal.Decimal `json:"conversion_rate" swaggertype:"string"`
+	// Revenue is the total of the finalized invoices of the converted subscriptions per currency
+	Revenue map[string]decimal.Decimal `json:"revenue"`
+	// RevenuePerAssignment is the revenue divided by the assignments per currency
+	RevenuePerAssignment map[string]decimal.Decimal `json:"revenue_per_assignment"`
+}
+
+// ExperimentReportResponse represents the report of a price experiment
+type ExperimentReportResponse struct {
+	ExperimentID     string                    `json:"experiment_id"`
+	Name             string                    `json:"name"`
+	ExperimentStatus types.ExperimentStatus    `json:"experiment_status"`
+	Variants         []ExperimentVariantReport `json:"variants"`
+}
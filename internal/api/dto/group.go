@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/group"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/flexprice/flexprice/internal/validator"
 )
@@ -14,6 +15,10 @@ type CreateGroupRequest struct {
 	Name       string `json:"name" validate:"required"`
 	EntityType string `json:"entity_type" validate:"required"`
 	LookupKey  string `json:"lookup_key" validate:"required"`
+
+	// Pooling optionally accumulates the quantities or amounts of all prices in the group
+	// into a single tier ladder. Only supported for groups of entity type price.
+	Pooling *types.GroupPoolingConfig `json:"pooling,omitempty"`
 }
 
 func (r *CreateGroupRequest) Validate() error {
@@ -27,6 +32,20 @@ func (r *CreateGroupRequest) Validate() error {
 		return err
 	}
 
+	if r.Pooling != nil {
+		if entityType != types.GroupEntityTypePrice {
+			return ierr.NewError("pooling is only supported for price groups").
+				WithHint("Pooled tiers can only be configured on groups of entity type price").
+				WithReportableDetails(map[string]interface{}{
+					"entity_type": entityType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if err := r.Pooling.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -38,21 +57,50 @@ func (r *CreateGroupRequest) ToGroup(ctx context.Context) (*group.Group, error)
 		EntityType:    entityType,
 		EnvironmentID: types.GetEnvironmentID(ctx),
 		LookupKey:     r.LookupKey,
+		Pooling:       r.Pooling,
 		BaseModel:     types.GetDefaultBaseModel(ctx),
 	}, nil
 }
 
+// UpdateGroupRequest represents the request to update a group
+type UpdateGroupRequest struct {
+	Name *string `json:"name,omitempty"`
+
+	// Pooling replaces the pooled tier configuration of the group
+	Pooling *types.GroupPoolingConfig `json:"pooling,omitempty"`
+
+	// RemovePooling clears the pooled tier configuration of the group
+	RemovePooling bool `json:"remove_pooling,omitempty"`
+}
+
+func (r *UpdateGroupRequest) Validate() error {
+	if r.Name != nil && *r.Name == "" {
+		return ierr.NewError("name cannot be empty").
+			WithHint("Please provide a non-empty group name").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.Pooling != nil && r.RemovePooling {
+		return ierr.NewError("cannot set and remove pooling at the same time").
+			WithHint("Provide either pooling or remove_pooling").
+			Mark(ierr.ErrValidation)
+	}
+
+	return r.Pooling.Validate()
+}
+
 // GroupResponse represents the group response
 type GroupResponse struct {
-	ID         string            `json:"id"`
-	Name       string            `json:"name"`
-	LookupKey  string            `json:"lookup_key"`
-	EntityType string            `json:"entity_type"`
-	EntityIDs  []string          `json:"entity_ids"`
-	Status     string            `json:"status"`
-	Metadata   map[string]string `json:"metadata"`
-	CreatedAt  time.Time         `json:"created_at"`
-	UpdatedAt  time.Time         `json:"updated_at"`
+	ID         string                    `json:"id"`
+	Name       string                    `json:"name"`
+	LookupKey  string                    `json:"lookup_key"`
+	EntityType string                    `json:"entity_type"`
+	EntityIDs  []string                  `json:"entity_ids"`
+	Status     string                    `json:"status"`
+	Metadata   map[string]string         `json:"metadata"`
+	Pooling    *types.GroupPoolingConfig `json:"pooling,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	UpdatedAt  time.Time                 `json:"updated_at"`
 }
 
 // ListGroupsResponse represents the response for listing groups
@@ -67,6 +115,7 @@ func ToGroupResponse(group *group.Group) *GroupResponse {
 		EntityIDs:  []string{},
 		Status:     string(group.Status),
 		Metadata:   group.Metadata,
+		Pooling:    group.Pooling,
 		CreatedAt:  group.CreatedAt,
 		UpdatedAt:  group.UpdatedAt,
 	}
@@ -81,6 +130,7 @@ func ToGroupResponseWithEntities(group *group.Group, entityIDs []string) *GroupR
 		EntityIDs:  entityIDs,
 		Status:     string(group.Status),
 		Metadata:   group.Metadata,
+		Pooling:    group.Pooling,
 		CreatedAt:  group.CreatedAt,
 		UpdatedAt:  group.UpdatedAt,
 	}
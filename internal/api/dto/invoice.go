@@ -223,7 +223,8 @@ func (r *CreateInvoiceRequest) Validate() error {
 	}
 
 	if r.InvoiceType == types.InvoiceTypeSubscription {
-		if r.SubscriptionID == nil {
+		// Consolidated invoices list their subscriptions in the metadata instead
+		if r.SubscriptionID == nil && r.Metadata[invoice.MetadataKeyConsolidatedSubscriptionIDs] == "" {
 			return ierr.NewError("subscription_id is required for subscription invoice").
 				WithHint("subscription_id is required for subscription invoice").
 				Mark(ierr.ErrValidation)
@@ -497,6 +498,10 @@ type DiscountResult struct {
 
 // CreateInvoiceLineItemRequest represents a single line item in an invoice creation request
 type CreateInvoiceLineItemRequest struct {
+	// subscription_id is the optional unique identifier of the subscription this line item bills,
+	// defaults to the subscription of the invoice
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+
 	// entity_id is the optional unique identifier of the entity associated with this line item
 	EntityID *string `json:"entity_id,omitempty"`
 
@@ -630,12 +635,18 @@ func (r *CreateInvoiceLineItemRequest) Validate(invoiceType types.InvoiceType) e
 	return nil
 }
 
+// ToInvoiceLineItem converts the request to a line item. The amount is rounded with the rounding
+// policy carried by the context, falling back to the default policy.
 func (r *CreateInvoiceLineItemRequest) ToInvoiceLineItem(ctx context.Context, inv *invoice.Invoice) *invoice.InvoiceLineItem {
+	rounding, ok := types.GetRoundingConfigFromContext(ctx)
+	if !ok {
+		rounding = types.DefaultRoundingConfig()
+	}
 	return &invoice.InvoiceLineItem{
 		ID:                    types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INVOICE_LINE_ITEM),
 		InvoiceID:             inv.ID,
 		CustomerID:            inv.CustomerID,
-		SubscriptionID:        inv.SubscriptionID,
+		SubscriptionID:        lo.CoalesceOrEmpty(r.SubscriptionID, inv.SubscriptionID),
 		PriceID:               r.PriceID,
 		EntityID:              r.EntityID,
 		EntityType:            r.EntityType,
@@ -646,7 +657,7 @@ func (r *CreateInvoiceLineItemRequest) ToInvoiceLineItem(ctx context.Context, in
 		PriceUnit:             r.PriceUnit,
 		PriceUnitAmount:       r.PriceUnitAmount,
 		DisplayName:           r.DisplayName,
-		Amount:                types.RoundToCurrencyPrecision(r.Amount, inv.Currency),
+		Amount:                rounding.RoundLineItemAmount(r.Amount, inv.Currency),
 		Quantity:              r.Quantity,
 		Currency:              inv.Currency,
 		PeriodStart:           r.PeriodStart,
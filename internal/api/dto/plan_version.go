@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
+// MigratePlanVersionRequest moves the subscriptions pinned to a plan version to another version of the same plan
+type MigratePlanVersionRequest struct {
+	// target_plan_id is the published version the subscriptions are moved to
+	TargetPlanID string `json:"target_plan_id" validate:"required"`
+
+	// subscription_ids limits the migration to the given subscriptions, all active subscriptions
+	// of the version are migrated when empty
+	SubscriptionIDs []string `json:"subscription_ids,omitempty"`
+
+	// effective_date is when the prices of the target version start to apply, defaults to now
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+}
+
+func (r *MigratePlanVersionRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if lo.Contains(r.SubscriptionIDs, "") {
+		return ierr.NewError("subscription id can not be empty").
+			WithHint("Provide valid subscription IDs or leave subscription_ids empty to migrate all subscriptions").
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// PlanVersionMigrationResult is the outcome of the migration of a single subscription
+type PlanVersionMigrationResult struct {
+	SubscriptionID      string `json:"subscription_id"`
+	Migrated            bool   `json:"migrated"`
+	LineItemsTerminated int    `json:"line_items_terminated"`
+	LineItemsCreated    int    `json:"line_items_created"`
+	Error               string `json:"error,omitempty"`
+}
+
+type MigratePlanVersionResponse struct {
+	SourcePlanID  string                       `json:"source_plan_id"`
+	TargetPlanID  string                       `json:"target_plan_id"`
+	EffectiveDate time.Time                    `json:"effective_date"`
+	Migrated      int                          `json:"migrated"`
+	Failed        int                          `json:"failed"`
+	Results       []PlanVersionMigrationResult `json:"results"`
+}
+
+// PlanVersionPriceDiff describes how a price differs between two plan versions. Prices are
+// matched through their root price, which versions keep when they copy the prices of a plan.
+type PlanVersionPriceDiff struct {
+	Change        types.PlanVersionChange `json:"change"`
+	SourcePriceID string                  `json:"source_price_id,omitempty"`
+	TargetPriceID string                  `json:"target_price_id,omitempty"`
+	// Fields lists the changed fields of a changed price
+	Fields []string       `json:"fields,omitempty"`
+	Source *PriceResponse `json:"source,omitempty"`
+	Target *PriceResponse `json:"target,omitempty"`
+}
+
+// PlanVersionEntitlementDiff describes how the entitlement to a feature differs between two plan versions
+type PlanVersionEntitlementDiff struct {
+	Change              types.PlanVersionChange `json:"change"`
+	FeatureID           string                  `json:"feature_id"`
+	SourceEntitlementID string                  `json:"source_entitlement_id,omitempty"`
+	TargetEntitlementID string                  `json:"target_entitlement_id,omitempty"`
+	// Fields lists the changed fields of a changed entitlement
+	Fields []string             `json:"fields,omitempty"`
+	Source *EntitlementResponse `json:"source,omitempty"`
+	Target *EntitlementResponse `json:"target,omitempty"`
+}
+
+type PlanVersionDiffResponse struct {
+	SourcePlanID  string                       `json:"source_plan_id"`
+	SourceVersion int                          `json:"source_version"`
+	TargetPlanID  string                       `json:"target_plan_id"`
+	TargetVersion int                          `json:"target_version"`
+	Prices        []PlanVersionPriceDiff       `json:"prices"`
+	Entitlements  []PlanVersionEntitlementDiff `json:"entitlements"`
+}
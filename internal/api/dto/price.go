@@ -53,6 +53,25 @@ type CreatePriceRequest struct {
 
 	// GroupID is the id of the group to add the price to
 	GroupID string `json:"group_id,omitempty"`
+
+	// TimeWindows configures time-of-use rates for usage prices. Usage within a window is
+	// charged at the window's unit_amount, the first matching window wins and usage outside
+	// of all windows is charged using the price's billing model.
+	TimeWindows []types.PriceTimeWindow `json:"time_windows,omitempty"`
+
+	// CostPlus configures COST_PLUS usage prices. The charge is derived from the cost of the
+	// usage under the meter's price in the active costsheet at rating time.
+	CostPlus *types.CostPlusConfig `json:"cost_plus,omitempty"`
+
+	// RolloverConfig carries the unused included usage of a billing period over into the
+	// following periods. It requires an included_quantity or a SLAB or STAIR_STEP price with a
+	// free leading tier.
+	RolloverConfig *types.RolloverConfig `json:"rollover_config,omitempty"`
+
+	// IncludedQuantity is the usage included for free in each billing period of a usage price,
+	// e.g. the first 10,000 API calls each month. It is deducted from the usage before the
+	// billing model is applied.
+	IncludedQuantity *decimal.Decimal `json:"included_quantity,omitempty" swaggertype:"string"`
 }
 
 type PriceUnitConfig struct {
@@ -107,6 +126,15 @@ type UpdatePriceRequest struct {
 
 	// GroupID is the id of the group to update the price in
 	GroupID string `json:"group_id,omitempty"`
+
+	// CostPlus is the new markup of a COST_PLUS price
+	CostPlus *types.CostPlusConfig `json:"cost_plus,omitempty"`
+
+	// RolloverConfig is the new rollover of the included usage of a usage price
+	RolloverConfig *types.RolloverConfig `json:"rollover_config,omitempty"`
+
+	// IncludedQuantity is the new usage included for free in each billing period of a usage price
+	IncludedQuantity *decimal.Decimal `json:"included_quantity,omitempty" swaggertype:"string"`
 }
 
 type PriceResponse struct {
@@ -283,6 +311,11 @@ func (r *CreatePriceRequest) Validate() error {
 			if err := validateTiers(r.PriceUnitConfig.PriceUnitTiers, "price_unit_tiers"); err != nil {
 				return err
 			}
+			if r.TierMode == types.BILLING_TIER_STAIR_STEP {
+				if err := validateStairStepTiers(r.PriceUnitConfig.PriceUnitTiers, "price_unit_tiers"); err != nil {
+					return err
+				}
+			}
 		} else {
 			// FIAT: require regular tiers
 			if len(r.Tiers) == 0 {
@@ -294,6 +327,11 @@ func (r *CreatePriceRequest) Validate() error {
 			if err := validateTiers(r.Tiers, "tiers"); err != nil {
 				return err
 			}
+			if r.TierMode == types.BILLING_TIER_STAIR_STEP {
+				if err := validateStairStepTiers(r.Tiers, "tiers"); err != nil {
+					return err
+				}
+			}
 		}
 
 	case types.BILLING_MODEL_PACKAGE:
@@ -335,6 +373,51 @@ func (r *CreatePriceRequest) Validate() error {
 			}
 		}
 
+	case types.BILLING_MODEL_COST_PLUS:
+		if r.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("billing model COST_PLUS requires type USAGE").
+				WithHint("Cost plus pricing derives the amount from the cost of a meter and is only supported on usage prices").
+				Mark(ierr.ErrValidation)
+		}
+		if r.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
+			return ierr.NewError("billing model COST_PLUS does not support custom pricing units").
+				WithHint("Cost plus prices are charged in the currency of the costsheet").
+				Mark(ierr.ErrValidation)
+		}
+		if len(r.TimeWindows) > 0 {
+			return ierr.NewError("time_windows cannot be set for COST_PLUS prices").
+				WithHint("Cost plus prices are charged from the costsheet and can not have time-of-use rates").
+				Mark(ierr.ErrValidation)
+		}
+		if err := r.CostPlus.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.CostPlus != nil && r.BillingModel != types.BILLING_MODEL_COST_PLUS {
+		return ierr.NewError("cost_plus can only be set when billing model is COST_PLUS").
+			WithHint("Set billing_model to COST_PLUS to use cost plus pricing").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.IncludedQuantity != nil {
+		if r.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("included_quantity is only supported for usage prices").
+				WithHint("Only usage prices can include usage for free").
+				Mark(ierr.ErrValidation)
+		}
+		if r.IncludedQuantity.IsNegative() {
+			return ierr.NewError("included_quantity cannot be negative").
+				WithHint("Please provide a non-negative included quantity").
+				WithReportableDetails(map[string]interface{}{
+					"included_quantity": r.IncludedQuantity.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if err := r.validateRolloverConfig(); err != nil {
+		return err
 	}
 
 	// 8. Validate price type specific requirements
@@ -352,6 +435,18 @@ func (r *CreatePriceRequest) Validate() error {
 		}
 	}
 
+	// 10. Validate time-of-use windows
+	if len(r.TimeWindows) > 0 {
+		if r.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("time_windows can only be set for usage pricing").
+				WithHint("Time-of-use windows are only supported on usage prices").
+				Mark(ierr.ErrValidation)
+		}
+		if err := types.ValidateTimeWindows(r.TimeWindows); err != nil {
+			return err
+		}
+	}
+
 	// 9. Validate billing cadence specific requirements
 	switch r.BillingCadence {
 	case types.BILLING_CADENCE_RECURRING:
@@ -453,6 +548,10 @@ func (r *CreatePriceRequest) ToPrice(ctx context.Context) (*priceDomain.Price, e
 		EnvironmentID:      types.GetEnvironmentID(ctx),
 		BaseModel:          types.GetDefaultBaseModel(ctx),
 		GroupID:            r.GroupID,
+		TimeWindows:        r.TimeWindows,
+		CostPlus:           r.CostPlus,
+		RolloverConfig:     r.RolloverConfig,
+		IncludedQuantity:   lo.FromPtr(r.IncludedQuantity),
 	}
 
 	// Set type-specific fields
@@ -537,7 +636,7 @@ func (r *UpdatePriceRequest) Validate() error {
 	// If EffectiveFrom is provided, at least one critical field must be present
 	if r.EffectiveFrom != nil && !r.ShouldCreateNewPrice() {
 		return ierr.NewError("effective_from requires at least one critical field").
-			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, transform_quantity, price_unit_amount, or price_unit_tiers").
+			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, transform_quantity, price_unit_amount, price_unit_tiers, cost_plus, rollover_config, or included_quantity").
 			Mark(ierr.ErrValidation)
 	}
 
@@ -552,7 +651,10 @@ func (r *UpdatePriceRequest) ShouldCreateNewPrice() bool {
 		len(r.Tiers) > 0 ||
 		r.TransformQuantity != nil ||
 		r.PriceUnitAmount != nil ||
-		len(r.PriceUnitTiers) > 0
+		len(r.PriceUnitTiers) > 0 ||
+		r.CostPlus != nil ||
+		r.RolloverConfig != nil ||
+		r.IncludedQuantity != nil
 }
 
 // ToCreatePriceRequest converts the update request to a create request for the new price
@@ -576,6 +678,7 @@ func (r *UpdatePriceRequest) ToCreatePriceRequest(existingPrice *price.Price) Cr
 	createReq.MeterID = lo.Ternary(existingPrice.Type == types.PRICE_TYPE_USAGE, existingPrice.MeterID, "")
 	createReq.ParentPriceID = existingPrice.GetRootPriceID()
 	createReq.DisplayName = existingPrice.DisplayName
+	createReq.TimeWindows = existingPrice.TimeWindows
 
 	if existingPrice.MinQuantity != nil {
 		createReq.MinQuantity = lo.ToPtr(existingPrice.MinQuantity.IntPart())
@@ -661,6 +764,15 @@ func (r *UpdatePriceRequest) ToCreatePriceRequest(existingPrice *price.Price) Cr
 
 		// Handle TierMode for both types
 		createReq.TierMode = lo.Ternary(r.TierMode != "", r.TierMode, existingPrice.TierMode)
+
+	case types.BILLING_MODEL_COST_PLUS:
+		createReq.CostPlus = lo.Ternary(r.CostPlus != nil, r.CostPlus, existingPrice.CostPlus)
+	}
+
+	createReq.RolloverConfig = lo.Ternary(r.RolloverConfig != nil, r.RolloverConfig, existingPrice.RolloverConfig)
+	createReq.IncludedQuantity = r.IncludedQuantity
+	if createReq.IncludedQuantity == nil && existingPrice.IncludedQuantity.IsPositive() {
+		createReq.IncludedQuantity = lo.ToPtr(existingPrice.IncludedQuantity)
 	}
 
 	// Apply non-critical field updates from request (use request value if provided, otherwise use existing)
@@ -710,6 +822,46 @@ func (r *DeletePriceRequest) Validate() error {
 	return nil
 }
 
+// validateRolloverConfig validates that the included usage of the price can roll over
+func (r *CreatePriceRequest) validateRolloverConfig() error {
+	if r.RolloverConfig == nil {
+		return nil
+	}
+
+	if r.Type != types.PRICE_TYPE_USAGE {
+		return ierr.NewError("rollover_config is only supported for usage prices").
+			WithHint("Only usage included by a usage price can roll over").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := r.RolloverConfig.Validate(); err != nil {
+		return err
+	}
+
+	tiers := r.Tiers
+	if r.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM && r.PriceUnitConfig != nil {
+		tiers = r.PriceUnitConfig.PriceUnitTiers
+	}
+	p := &priceDomain.Price{
+		BillingModel: r.BillingModel,
+		TierMode:     r.TierMode,
+		Tiers: lo.Map(tiers, func(t CreatePriceTier, _ int) priceDomain.PriceTier {
+			return priceDomain.PriceTier{UpTo: t.UpTo, UnitAmount: t.UnitAmount, FlatAmount: t.FlatAmount}
+		}),
+	}
+	if !p.FreeTierQuantity().IsPositive() && !lo.FromPtr(r.IncludedQuantity).IsPositive() {
+		return ierr.NewError("rollover_config requires included usage").
+			WithHint("Set an included_quantity or use a SLAB or STAIR_STEP tiered price whose first tier is free to roll over its unused usage").
+			WithReportableDetails(map[string]interface{}{
+				"billing_model": r.BillingModel,
+				"tier_mode":     r.TierMode,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
 // validateTiers validates an array of tiers and returns an error if any tier is invalid
 func validateTiers(tiers []CreatePriceTier, fieldName string) error {
 	if len(tiers) == 0 {
@@ -730,3 +882,70 @@ func validateTiers(tiers []CreatePriceTier, fieldName string) error {
 
 	return nil
 }
+
+// validateStairStepTiers validates that stair-step tiers form contiguous brackets. Every bracket
+// starts right after the up_to of the previous one, so up_to must be strictly ascending and the
+// last bracket must be open ended for every quantity to fall into a bracket. Brackets are priced
+// by their flat amount only.
+func validateStairStepTiers(tiers []CreatePriceTier, fieldName string) error {
+	for i, tier := range tiers {
+		if tier.FlatAmount == nil {
+			return ierr.NewError("flat_amount is required for stair step tiers").
+				WithHint("Every stair step bracket needs a flat amount charged for the whole bracket").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index": i,
+					"field_name": fieldName,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if !tier.UnitAmount.IsZero() {
+			return ierr.NewError("unit_amount is not supported for stair step tiers").
+				WithHint("Stair step brackets are charged their flat amount, set unit_amount to zero").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index":  i,
+					"field_name":  fieldName,
+					"unit_amount": tier.UnitAmount.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if i == len(tiers)-1 {
+			if tier.UpTo != nil {
+				return ierr.NewError("last stair step tier must not have an up_to").
+					WithHint("Leave up_to empty on the last bracket so that every quantity falls into a bracket").
+					WithReportableDetails(map[string]interface{}{
+						"tier_index": i,
+						"field_name": fieldName,
+						"up_to":      *tier.UpTo,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+			continue
+		}
+
+		if tier.UpTo == nil || *tier.UpTo == 0 {
+			return ierr.NewError("stair step tiers must have an up_to greater than zero").
+				WithHint("Only the last bracket can be open ended").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index": i,
+					"field_name": fieldName,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if i > 0 && *tier.UpTo <= *tiers[i-1].UpTo {
+			return ierr.NewError("stair step tiers must be in ascending order of up_to").
+				WithHint("Each bracket must start right after the previous one, order brackets by up_to").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index":     i,
+					"field_name":     fieldName,
+					"up_to":          *tier.UpTo,
+					"previous_up_to": *tiers[i-1].UpTo,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
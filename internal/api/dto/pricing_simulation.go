@@ -0,0 +1,193 @@
+package dto
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/shopspring/decimal"
+)
+
+// candidatePlanEntityID is the placeholder entity ID used to validate prices of an inline candidate plan
+const candidatePlanEntityID = "candidate_plan"
+
+// MaxInlinePricingSimulationCustomers is the number of customers a simulation without an S3
+// connection can replay. Such simulations run within the request and return their results.
+const MaxInlinePricingSimulationCustomers = 25
+
+// CreatePricingSimulationRequest re-rates historical usage of existing subscriptions against a
+// candidate plan and exports the revenue delta per customer as a CSV file.
+type CreatePricingSimulationRequest struct {
+	// PlanID of an existing (typically unpublished) plan to simulate. Mutually exclusive with CandidatePlan.
+	PlanID string `json:"plan_id,omitempty"`
+
+	// CandidatePlan is an inline plan definition to simulate. Mutually exclusive with PlanID.
+	CandidatePlan *SimulationCandidatePlan `json:"candidate_plan,omitempty"`
+
+	// StartTime and EndTime bound the billing periods that are replayed. A billing period is
+	// included when it starts within the range.
+	StartTime time.Time `json:"start_time" binding:"required" validate:"required"`
+	EndTime   time.Time `json:"end_time" binding:"required" validate:"required"`
+
+	// CustomerIDs limits the simulation to the given customers, all customers are simulated when empty
+	CustomerIDs []string `json:"customer_ids,omitempty"`
+
+	// ConnectionID of the S3 connection the result file is uploaded to. Without a connection the
+	// simulation runs within the request for at most MaxInlinePricingSimulationCustomers customers
+	// and returns its results.
+	ConnectionID string `json:"connection_id,omitempty"`
+
+	// JobConfig of the S3 upload, only compression and encryption are used for Flexprice-managed connections
+	JobConfig *types.S3JobConfig `json:"job_config,omitempty"`
+}
+
+// IsInline returns true when the simulation runs within the request instead of uploading its result file
+func (r *CreatePricingSimulationRequest) IsInline() bool {
+	return r.ConnectionID == ""
+}
+
+// SimulationCandidatePlan is an inline plan definition used by a pricing simulation. The prices
+// and entitlements are never persisted, their entity_type and entity_id are ignored.
+type SimulationCandidatePlan struct {
+	Name         string                     `json:"name" validate:"required"`
+	Prices       []CreatePriceRequest       `json:"prices" validate:"required,min=1"`
+	Entitlements []CreateEntitlementRequest `json:"entitlements,omitempty"`
+}
+
+// ToPlan converts the candidate plan into an in-memory plan
+func (p *SimulationCandidatePlan) ToPlan(ctx context.Context) *plan.Plan {
+	return &plan.Plan{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLAN),
+		Name:          p.Name,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// Validate validates the create pricing simulation request
+func (r *CreatePricingSimulationRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if (r.PlanID == "") == (r.CandidatePlan == nil) {
+		return ierr.NewError("exactly one of plan_id or candidate_plan is required").
+			WithHint("Provide either an existing plan to simulate or an inline candidate plan").
+			Mark(ierr.ErrValidation)
+	}
+
+	if !r.StartTime.Before(r.EndTime) {
+		return ierr.NewError("start_time must be before end_time").
+			WithHint("Please provide a valid simulation date range").
+			WithReportableDetails(map[string]interface{}{
+				"start_time": r.StartTime,
+				"end_time":   r.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.EndTime.After(time.Now().UTC()) {
+		return ierr.NewError("end_time cannot be in the future").
+			WithHint("Pricing simulations replay historical usage only").
+			WithReportableDetails(map[string]interface{}{
+				"end_time": r.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.IsInline() {
+		if r.JobConfig != nil {
+			return ierr.NewError("job_config requires connection_id").
+				WithHint("Provide the S3 connection the result file is uploaded to").
+				Mark(ierr.ErrValidation)
+		}
+		if len(r.CustomerIDs) == 0 || len(r.CustomerIDs) > MaxInlinePricingSimulationCustomers {
+			return ierr.NewError("customer_ids are required without connection_id").
+				WithHintf("Simulations without an S3 connection can replay between 1 and %d customers", MaxInlinePricingSimulationCustomers).
+				WithReportableDetails(map[string]interface{}{
+					"customer_ids": len(r.CustomerIDs),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	} else if r.JobConfig == nil {
+		return ierr.NewError("job_config is required with connection_id").
+			WithHint("Provide the S3 job config of the result upload").
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.CandidatePlan != nil {
+		if err := validator.ValidateRequest(r.CandidatePlan); err != nil {
+			return err
+		}
+		for i := range r.CandidatePlan.Entitlements {
+			// Candidate entitlements always belong to the simulated plan
+			e := r.CandidatePlan.Entitlements[i]
+			e.PlanID = ""
+			e.EntityType = types.ENTITLEMENT_ENTITY_TYPE_PLAN
+			e.EntityID = candidatePlanEntityID
+			if err := e.Validate(); err != nil {
+				return ierr.WithError(err).
+					WithHint("Invalid candidate plan entitlement").
+					WithReportableDetails(map[string]interface{}{
+						"entitlement_index": i,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+		for i := range r.CandidatePlan.Prices {
+			// Candidate prices always belong to the simulated plan
+			p := r.CandidatePlan.Prices[i]
+			p.EntityType = types.PRICE_ENTITY_TYPE_PLAN
+			p.EntityID = candidatePlanEntityID
+			if err := p.Validate(); err != nil {
+				return ierr.WithError(err).
+					WithHint("Invalid candidate plan price").
+					WithReportableDetails(map[string]interface{}{
+						"price_index": i,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
+	}
+
+	return nil
+}
+
+// PricingSimulationResponse is returned when a pricing simulation is started. Simulations without
+// an S3 connection return their results instead of a workflow.
+type PricingSimulationResponse struct {
+	Task       *TaskResponse                               `json:"task"`
+	WorkflowID string                                      `json:"workflow_id,omitempty"`
+	RunID      string                                      `json:"run_id,omitempty"`
+	Results    []*PricingSimulationCustomerResult          `json:"results,omitempty"`
+	Summary    map[string]*PricingSimulationCustomerResult `json:"summary,omitempty"`
+}
+
+// PricingSimulationCustomerResult is the simulated revenue of a customer in one currency
+type PricingSimulationCustomerResult struct {
+	CustomerID         string          `json:"customer_id"`
+	ExternalCustomerID string          `json:"external_customer_id"`
+	CustomerName       string          `json:"customer_name"`
+	Currency           string          `json:"currency"`
+	Subscriptions      int             `json:"subscriptions"`
+	BillingPeriods     int             `json:"billing_periods"`
+	CurrentAmount      decimal.Decimal `json:"current_amount" swaggertype:"string"`
+	SimulatedAmount    decimal.Decimal `json:"simulated_amount" swaggertype:"string"`
+}
+
+// Delta returns the revenue change of the candidate plan
+func (r *PricingSimulationCustomerResult) Delta() decimal.Decimal {
+	return r.SimulatedAmount.Sub(r.CurrentAmount)
+}
+
+// DeltaPercent returns the revenue change in percent of the current revenue, zero when
+// there is no current revenue
+func (r *PricingSimulationCustomerResult) DeltaPercent() decimal.Decimal {
+	if r.CurrentAmount.IsZero() {
+		return decimal.Zero
+	}
+	return r.Delta().Div(r.CurrentAmount).Mul(decimal.NewFromInt(100)).Round(2)
+}
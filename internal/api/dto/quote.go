@@ -0,0 +1,229 @@
+package dto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/quote"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CreateQuoteRequest represents the request to create a draft quote
+type CreateQuoteRequest struct {
+	CustomerID  string `json:"customer_id" validate:"required"`
+	PlanID      string `json:"plan_id" validate:"required"`
+	Currency    string `json:"currency" validate:"required,len=3"`
+	Description string `json:"description,omitempty"`
+
+	BillingCadence     types.BillingCadence `json:"billing_cadence" validate:"required"`
+	BillingPeriod      types.BillingPeriod  `json:"billing_period" validate:"required"`
+	BillingPeriodCount int                  `json:"billing_period_count,omitempty" validate:"omitempty,gt=0"`
+	BillingCycle       types.BillingCycle   `json:"billing_cycle,omitempty"`
+
+	// start_date backdates the subscription created on acceptance, it starts on acceptance when not set
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// valid_until is the last moment the quote can be accepted
+	ValidUntil time.Time `json:"valid_until" validate:"required"`
+	// coupons are applied to the subscription for its whole duration
+	Coupons []string `json:"coupons,omitempty"`
+	// phases split the subscription into consecutive phases, e.g. a discounted ramp-up
+	Phases []types.QuotePhase `json:"phases,omitempty" validate:"omitempty,dive"`
+	// line_items quote the prices of the plan, prices which are not listed are quoted at their plan amount
+	LineItems []QuoteLineItemRequest `json:"line_items,omitempty" validate:"omitempty,dive"`
+	Metadata  map[string]string      `json:"metadata,omitempty"`
+}
+
+// QuoteLineItemRequest quotes a price of the plan
+type QuoteLineItemRequest struct {
+	PriceID     string `json:"price_id" validate:"required"`
+	DisplayName string `json:"display_name,omitempty"`
+	// quantity of fixed prices, defaults to 1. For usage prices it is the estimated usage of a period.
+	Quantity *decimal.Decimal `json:"quantity,omitempty" swaggertype:"string"`
+	// unit_amount replaces the amount of a flat fee or package price
+	UnitAmount *decimal.Decimal `json:"unit_amount,omitempty" swaggertype:"string"`
+	// discount_percentage discounts the amount of a flat fee or package price
+	DiscountPercentage *decimal.Decimal `json:"discount_percentage,omitempty" swaggertype:"string"`
+	// phase_index limits the line item to a phase of the quote, it applies to all phases when not set
+	PhaseIndex *int              `json:"phase_index,omitempty" validate:"omitempty,gte=0"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+func (r *QuoteLineItemRequest) Validate() error {
+	if r.Quantity != nil && r.Quantity.IsNegative() {
+		return ierr.NewError("quantity must be non-negative").
+			WithHint("Quoted quantities can not be negative").
+			WithReportableDetails(map[string]any{
+				"price_id": r.PriceID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if r.UnitAmount != nil && r.UnitAmount.IsNegative() {
+		return ierr.NewError("unit_amount must be non-negative").
+			WithHint("Quoted amounts can not be negative").
+			WithReportableDetails(map[string]any{
+				"price_id": r.PriceID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if r.DiscountPercentage != nil &&
+		(r.DiscountPercentage.IsNegative() || r.DiscountPercentage.GreaterThan(decimal.NewFromInt(100))) {
+		return ierr.NewError("discount_percentage must be between 0 and 100").
+			WithHint("Provide a discount percentage between 0 and 100").
+			WithReportableDetails(map[string]any{
+				"price_id":            r.PriceID,
+				"discount_percentage": r.DiscountPercentage.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+func (r *CreateQuoteRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if err := r.BillingCadence.Validate(); err != nil {
+		return err
+	}
+	if err := r.BillingPeriod.Validate(); err != nil {
+		return err
+	}
+	if r.BillingCycle != "" {
+		if err := r.BillingCycle.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateQuoteStartDate(r.StartDate); err != nil {
+		return err
+	}
+
+	if err := types.ValidateQuotePhases(r.Phases); err != nil {
+		return err
+	}
+
+	return ValidateQuoteLineItems(r.LineItems, r.Phases)
+}
+
+// validateQuoteStartDate validates the start date of a quote, subscriptions can not start in the future
+func validateQuoteStartDate(startDate *time.Time) error {
+	if startDate != nil && startDate.After(time.Now().UTC()) {
+		return ierr.NewError("start_date cannot be in the future").
+			WithHint("Leave start_date empty to start the subscription on acceptance").
+			WithReportableDetails(map[string]any{
+				"start_date": *startDate,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ValidateQuoteLineItems validates the line items of a quote against its phases
+func ValidateQuoteLineItems(items []QuoteLineItemRequest, phases []types.QuotePhase) error {
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+
+		if item.PhaseIndex != nil && *item.PhaseIndex >= len(phases) {
+			return ierr.NewError(fmt.Sprintf("line item at index %d refers to an unknown phase", i)).
+				WithHint("phase_index must refer to a phase of the quote").
+				WithReportableDetails(map[string]any{
+					"price_id":    item.PriceID,
+					"phase_index": *item.PhaseIndex,
+					"phases":      len(phases),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		key := fmt.Sprintf("%s:%d", item.PriceID, lo.FromPtrOr(item.PhaseIndex, -1))
+		if seen[key] {
+			return ierr.NewError(fmt.Sprintf("duplicate price_id in line items at index %d", i)).
+				WithHint("Each price can only be quoted once per phase").
+				WithReportableDetails(map[string]any{
+					"price_id": item.PriceID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func (r *CreateQuoteRequest) ToQuote(ctx context.Context) *quote.Quote {
+	return &quote.Quote{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUOTE),
+		CustomerID:         r.CustomerID,
+		PlanID:             r.PlanID,
+		Currency:           strings.ToLower(r.Currency),
+		QuoteStatus:        types.QuoteStatusDraft,
+		Description:        r.Description,
+		BillingCadence:     r.BillingCadence,
+		BillingPeriod:      r.BillingPeriod,
+		BillingPeriodCount: lo.Ternary(r.BillingPeriodCount == 0, 1, r.BillingPeriodCount),
+		BillingCycle:       lo.Ternary(r.BillingCycle == "", types.BillingCycleAnniversary, r.BillingCycle),
+		StartDate:          r.StartDate,
+		ValidUntil:         r.ValidUntil,
+		Coupons:            r.Coupons,
+		Phases:             r.Phases,
+		Metadata:           r.Metadata,
+		EnvironmentID:      types.GetEnvironmentID(ctx),
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+}
+
+// UpdateQuoteRequest represents the request to update a draft quote. Line items replace the line
+// items of the quote when provided.
+type UpdateQuoteRequest struct {
+	Description *string                `json:"description,omitempty"`
+	StartDate   *time.Time             `json:"start_date,omitempty"`
+	ValidUntil  *time.Time             `json:"valid_until,omitempty"`
+	Coupons     []string               `json:"coupons,omitempty"`
+	Phases      []types.QuotePhase     `json:"phases,omitempty" validate:"omitempty,dive"`
+	LineItems   []QuoteLineItemRequest `json:"line_items,omitempty" validate:"omitempty,dive"`
+	Metadata    map[string]string      `json:"metadata,omitempty"`
+}
+
+func (r *UpdateQuoteRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+	if err := validateQuoteStartDate(r.StartDate); err != nil {
+		return err
+	}
+	if r.Phases != nil {
+		if err := types.ValidateQuotePhases(r.Phases); err != nil {
+			return err
+		}
+	}
+	// Phases of line items are validated against the phases of the quote by the service
+	for _, item := range r.LineItems {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeclineQuoteRequest represents the request to decline an open quote
+type DeclineQuoteRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// QuoteResponse represents a quote in responses
+type QuoteResponse struct {
+	*quote.Quote
+	// Subscription is the subscription the quote was converted into on acceptance
+	Subscription *SubscriptionResponse `json:"subscription,omitempty"`
+}
+
+// ListQuotesResponse represents the response for listing quotes
+type ListQuotesResponse = types.ListResponse[*QuoteResponse]
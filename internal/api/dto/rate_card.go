@@ -0,0 +1,136 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// CreateRateCardPriceRequest adds a negotiated price to the rate card of a customer. The rate is
+// keyed either by the plan or addon price it replaces or by a metered feature, in which case it
+// replaces the usage prices of the meter of the feature in any plan. Rate card prices take
+// precedence over plan prices in all subscriptions of the customer while they are valid.
+type CreateRateCardPriceRequest struct {
+	// PriceID is the plan or addon price replaced by the rate
+	PriceID string `json:"price_id,omitempty"`
+
+	// FeatureID is the metered feature whose usage prices are replaced by the rate
+	FeatureID string `json:"feature_id,omitempty"`
+
+	// Currency, BillingPeriod and BillingPeriodCount are required for rates keyed by feature,
+	// rates keyed by price use those of the price
+	Currency           string              `json:"currency,omitempty"`
+	BillingPeriod      types.BillingPeriod `json:"billing_period,omitempty"`
+	BillingPeriodCount int                 `json:"billing_period_count,omitempty"`
+
+	// BillingModel defaults to the billing model of the price for rates keyed by price
+	BillingModel types.BillingModel `json:"billing_model,omitempty"`
+
+	// Amount is the negotiated amount for FLAT_FEE and PACKAGE billing models
+	Amount *decimal.Decimal `json:"amount,omitempty" swaggertype:"string"`
+
+	// TierMode and Tiers are the negotiated tiers for the TIERED billing model
+	TierMode types.BillingTier `json:"tier_mode,omitempty"`
+	Tiers    []CreatePriceTier `json:"tiers,omitempty"`
+
+	// TransformQuantity is the negotiated package for the PACKAGE billing model
+	TransformQuantity *price.TransformQuantity `json:"transform_quantity,omitempty"`
+
+	// StartDate is the date the rate is valid from, defaults to now
+	StartDate *time.Time `json:"start_date,omitempty"`
+
+	// EndDate is the date the rate is valid until, the rate does not expire when empty
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Validate validates the create rate card price request
+func (r *CreateRateCardPriceRequest) Validate() error {
+	if (r.PriceID == "") == (r.FeatureID == "") {
+		return ierr.NewError("exactly one of price_id or feature_id is required").
+			WithHint("A rate card price is keyed either by the price it replaces or by a metered feature").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":   r.PriceID,
+				"feature_id": r.FeatureID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.FeatureID != "" {
+		if len(r.Currency) != 3 {
+			return ierr.NewError("currency is required for rates keyed by feature").
+				WithHint("Please provide a three letter currency code").
+				WithReportableDetails(map[string]interface{}{
+					"currency": r.Currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if r.BillingPeriod == "" {
+			return ierr.NewError("billing_period is required for rates keyed by feature").
+				WithHint("Please provide the billing period of the usage prices the rate replaces").
+				Mark(ierr.ErrValidation)
+		}
+		if r.BillingModel == "" {
+			return ierr.NewError("billing_model is required for rates keyed by feature").
+				WithHint("Please provide the billing model of the negotiated rate").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.BillingPeriod != "" {
+		if err := r.BillingPeriod.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.BillingPeriodCount < 0 {
+		return ierr.NewError("billing_period_count cannot be negative").
+			WithHint("Please provide a positive billing period count").
+			WithReportableDetails(map[string]interface{}{
+				"billing_period_count": r.BillingPeriodCount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.BillingModel != "" {
+		if err := r.BillingModel.Validate(); err != nil {
+			return err
+		}
+		if r.BillingModel == types.BILLING_MODEL_COST_PLUS {
+			return ierr.NewError("cost plus billing model is not supported for rate card prices").
+				WithHint("Negotiated rates must be flat fee, package or tiered").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if r.Amount != nil && r.Amount.IsNegative() {
+		return ierr.NewError("amount must be non-negative").
+			WithHint("The negotiated amount cannot be negative").
+			WithReportableDetails(map[string]interface{}{
+				"amount": r.Amount.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, tier := range r.Tiers {
+		if err := tier.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.StartDate != nil && r.EndDate != nil && !r.EndDate.After(*r.StartDate) {
+		return ierr.NewError("end date must be after start date").
+			WithHint("The rate must be valid for some time").
+			WithReportableDetails(map[string]interface{}{
+				"start_date": r.StartDate,
+				"end_date":   r.EndDate,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
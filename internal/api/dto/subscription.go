@@ -30,6 +30,15 @@ type SubscriptionPhaseCreateRequest struct {
 	// If not provided, phase will use the same line items as the subscription (plan prices)
 	OverrideLineItems []OverrideLineItemRequest `json:"override_line_items,omitempty" validate:"omitempty,dive"`
 
+	// CommitmentAmount is the minimum spend of the phase per year, a ramped contract sets one per phase
+	CommitmentAmount *decimal.Decimal `json:"commitment_amount,omitempty" swaggertype:"string"`
+
+	// OverageFactor is a multiplier applied to spend beyond the commitment, defaults to 1
+	OverageFactor *decimal.Decimal `json:"overage_factor,omitempty" swaggertype:"string"`
+
+	// CommitmentTrueUpCadence is how often spend below the commitment is trued up, required with a commitment
+	CommitmentTrueUpCadence *types.CommitmentTrueUpCadence `json:"commitment_true_up_cadence,omitempty"`
+
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
@@ -45,6 +54,47 @@ func (r *SubscriptionPhaseCreateRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	return r.validateCommitment()
+}
+
+// validateCommitment validates the commitment of the phase
+func (r *SubscriptionPhaseCreateRequest) validateCommitment() error {
+	if r.CommitmentAmount == nil {
+		if r.OverageFactor != nil || r.CommitmentTrueUpCadence != nil {
+			return ierr.NewError("commitment_amount is required with overage_factor or commitment_true_up_cadence").
+				WithHint("Set the commitment amount of the phase").
+				Mark(ierr.ErrValidation)
+		}
+		return nil
+	}
+
+	if !r.CommitmentAmount.IsPositive() {
+		return ierr.NewError("commitment_amount must be greater than 0").
+			WithHint("Phase commitment amount must be greater than 0").
+			WithReportableDetails(map[string]interface{}{
+				"commitment_amount": r.CommitmentAmount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.CommitmentTrueUpCadence == nil {
+		return ierr.NewError("commitment_true_up_cadence is required with commitment_amount").
+			WithHint("Set the true-up cadence of the phase commitment to 'monthly' or 'annual'").
+			Mark(ierr.ErrValidation)
+	}
+	if err := r.CommitmentTrueUpCadence.Validate(); err != nil {
+		return err
+	}
+
+	if r.OverageFactor != nil && r.OverageFactor.LessThan(decimal.NewFromInt(1)) {
+		return ierr.NewError("overage_factor must be greater than or equal to 1").
+			WithHint("Overage factor is a multiplier on spend beyond the commitment").
+			WithReportableDetails(map[string]interface{}{
+				"overage_factor": r.OverageFactor,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
 	return nil
 }
 
@@ -52,13 +102,16 @@ func (r *SubscriptionPhaseCreateRequest) Validate() error {
 
 func (r *SubscriptionPhaseCreateRequest) ToSubscriptionPhase(ctx context.Context, subscriptionID string) *subscription.SubscriptionPhase {
 	return &subscription.SubscriptionPhase{
-		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_PHASE),
-		SubscriptionID: subscriptionID,
-		StartDate:      r.StartDate,
-		EndDate:        r.EndDate,
-		Metadata:       r.Metadata,
-		EnvironmentID:  types.GetEnvironmentID(ctx),
-		BaseModel:      types.GetDefaultBaseModel(ctx),
+		ID:                      types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_PHASE),
+		SubscriptionID:          subscriptionID,
+		StartDate:               r.StartDate,
+		EndDate:                 r.EndDate,
+		CommitmentAmount:        r.CommitmentAmount,
+		OverageFactor:           r.OverageFactor,
+		CommitmentTrueUpCadence: r.CommitmentTrueUpCadence,
+		Metadata:                r.Metadata,
+		EnvironmentID:           types.GetEnvironmentID(ctx),
+		BaseModel:               types.GetDefaultBaseModel(ctx),
 	}
 }
 
@@ -269,7 +322,10 @@ type CreateSubscriptionRequest struct {
 	// "invoice_to_self" - Invoices are sent to the subscription's customer
 	InvoiceBilling *types.InvoiceBilling `json:"invoice_billing,omitempty"`
 
-	PlanID             string               `json:"plan_id" validate:"required"`
+	PlanID string `json:"plan_id" validate:"required_without=PlanLookupKey"`
+	// plan_lookup_key creates the subscription on the published plan with this lookup key instead of plan_id.
+	// If the plan has a running price experiment, the subscription is created on the variant the customer is assigned to.
+	PlanLookupKey      string               `json:"plan_lookup_key,omitempty"`
 	Currency           string               `json:"currency" validate:"required,len=3"`
 	LookupKey          string               `json:"lookup_key"`
 	StartDate          *time.Time           `json:"start_date,omitempty"`
@@ -351,6 +407,257 @@ type CreateSubscriptionRequest struct {
 
 	// Enable Commitment True Up Fee
 	EnableTrueUp bool `json:"enable_true_up"`
+
+	// BillingThresholdAmount generates an interim invoice whenever the accrued current period
+	// charges exceed this amount. Period end invoices only bill what was not already invoiced.
+	BillingThresholdAmount *decimal.Decimal `json:"billing_threshold_amount,omitempty" swaggertype:"string"`
+
+	// TrialEndAction is the action taken when the trial ends, defaults to convert.
+	// pause_if_no_payment_method converts the subscription when the customer has a payment method
+	// and pauses it otherwise. When trial_end is not set the trial is derived from the longest
+	// trial period of the plan prices.
+	TrialEndAction types.TrialEndAction `json:"trial_end_action,omitempty"`
+
+	// TrialReminderDays sends the subscription.trial.will_end webhook this many days before the trial ends
+	TrialReminderDays int `json:"trial_reminder_days,omitempty"`
+
+	// TrialUsageCaps is the usage allowed per meter ID during the trial. The trial ends early,
+	// with the trial end action, when the usage of any meter reaches its cap.
+	TrialUsageCaps map[string]decimal.Decimal `json:"trial_usage_caps,omitempty" swaggertype:"object,string"`
+
+	// BackdatedBillingMode determines how the billing periods that ended before the subscription
+	// was created are invoiced when start_date is in the past, defaults to per_period.
+	// per_period generates one invoice for each missed period, catch_up generates a single
+	// invoice with the charges of all missed periods.
+	BackdatedBillingMode types.BackdatedBillingMode `json:"backdated_billing_mode,omitempty"`
+
+	// TermMonths makes the subscription a fixed-term contract of this many months, starting at
+	// start_date. Subscriptions without a term renew indefinitely.
+	TermMonths int `json:"term_months,omitempty"`
+
+	// AutoRenew starts a new term when the current term ends, defaults to true. The subscription
+	// is cancelled at the end of its term otherwise.
+	AutoRenew *bool `json:"auto_renew,omitempty"`
+
+	// RenewalNoticeDays is the number of days before the end of the term after which the
+	// renewal can no longer be turned off
+	RenewalNoticeDays int `json:"renewal_notice_days,omitempty"`
+
+	// RenewalPlanID is the plan the subscription renews to at the end of the term, the
+	// subscription renews on its current plan when not set
+	RenewalPlanID *string `json:"renewal_plan_id,omitempty"`
+}
+
+// ExtendTrialRequest extends the trial of a trialing subscription
+type ExtendTrialRequest struct {
+	// TrialEnd is the new end of the trial, it must be after the current end of the trial.
+	// Cannot be used together with days.
+	TrialEnd *time.Time `json:"trial_end,omitempty"`
+
+	// Days is the number of days the trial is extended by. Cannot be used together with trial_end.
+	Days int `json:"days,omitempty"`
+}
+
+func (r *ExtendTrialRequest) Validate() error {
+	if (r.TrialEnd == nil) == (r.Days == 0) {
+		return ierr.NewError("either trial_end or days is required").
+			WithHint("Provide either the new trial end or the number of days to extend the trial by").
+			Mark(ierr.ErrValidation)
+	}
+	if r.Days < 0 {
+		return ierr.NewError("days must be positive").
+			WithHint("The number of days to extend the trial by must be greater than 0").
+			WithReportableDetails(map[string]interface{}{
+				"days": r.Days,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// NewTrialEnd returns the end of the trial after extending a trial ending at currentTrialEnd
+func (r *ExtendTrialRequest) NewTrialEnd(currentTrialEnd time.Time) time.Time {
+	if r.TrialEnd != nil {
+		return r.TrialEnd.UTC()
+	}
+	return currentTrialEnd.AddDate(0, 0, r.Days)
+}
+
+// SubscriptionTrialState is the trial of a subscription as seen by the trial workflow
+type SubscriptionTrialState struct {
+	IsTrialing bool       `json:"is_trialing"`
+	TrialEnd   *time.Time `json:"trial_end,omitempty"`
+	ReminderAt *time.Time `json:"reminder_at,omitempty"`
+
+	HasUsageCaps    bool   `json:"has_usage_caps"`
+	UsageCapReached bool   `json:"usage_cap_reached"`
+	ExceededMeterID string `json:"exceeded_meter_id,omitempty"`
+}
+
+func validateTrialSettings(action types.TrialEndAction, reminderDays int, usageCaps map[string]decimal.Decimal) error {
+	if err := action.Validate(); err != nil {
+		return err
+	}
+	if reminderDays < 0 {
+		return ierr.NewError("trial_reminder_days must be non-negative").
+			WithHint("Trial reminder days must be greater than or equal to 0").
+			WithReportableDetails(map[string]interface{}{
+				"trial_reminder_days": reminderDays,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	for meterID, usageCap := range usageCaps {
+		if meterID == "" || !usageCap.IsPositive() {
+			return ierr.NewError("invalid trial usage cap").
+				WithHint("Trial usage caps must be keyed by meter ID and be greater than 0").
+				WithReportableDetails(map[string]interface{}{
+					"meter_id":  meterID,
+					"usage_cap": usageCap,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+func validateTermSettings(termMonths int, noticeDays int, renewalPlanID *string) error {
+	if termMonths < 0 || termMonths > types.MAX_SUBSCRIPTION_TERM_MONTHS {
+		return ierr.NewError("invalid term_months").
+			WithHintf("Term months must be between 0 and %d", types.MAX_SUBSCRIPTION_TERM_MONTHS).
+			WithReportableDetails(map[string]interface{}{
+				"term_months": termMonths,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if noticeDays < 0 {
+		return ierr.NewError("renewal_notice_days must be non-negative").
+			WithHint("Renewal notice days must be greater than or equal to 0").
+			WithReportableDetails(map[string]interface{}{
+				"renewal_notice_days": noticeDays,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if termMonths == 0 && (noticeDays > 0 || lo.FromPtr(renewalPlanID) != "") {
+		return ierr.NewError("renewal settings require a term").
+			WithHint("Renewal notice days and renewal plan can only be set on subscriptions with term_months").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// UpdateSubscriptionRenewalRequest updates the renewal of a fixed-term subscription.
+// Only the fields that are set are updated.
+type UpdateSubscriptionRenewalRequest struct {
+	// AutoRenew turns the renewal at the end of the current term on or off. Turning it off
+	// after the notice deadline of the term is not allowed.
+	AutoRenew *bool `json:"auto_renew,omitempty"`
+
+	// RenewalPlanID is the plan the subscription renews to, an empty string renews on the current plan
+	RenewalPlanID *string `json:"renewal_plan_id,omitempty"`
+
+	// RenewalNoticeDays is the number of days before the end of the term after which the
+	// renewal can no longer be turned off
+	RenewalNoticeDays *int `json:"renewal_notice_days,omitempty"`
+}
+
+func (r *UpdateSubscriptionRenewalRequest) Validate() error {
+	if r.AutoRenew == nil && r.RenewalPlanID == nil && r.RenewalNoticeDays == nil {
+		return ierr.NewError("no renewal settings to update").
+			WithHint("Provide at least one of auto_renew, renewal_plan_id or renewal_notice_days").
+			Mark(ierr.ErrValidation)
+	}
+	if r.RenewalNoticeDays != nil && *r.RenewalNoticeDays < 0 {
+		return ierr.NewError("renewal_notice_days must be non-negative").
+			WithHint("Renewal notice days must be greater than or equal to 0").
+			WithReportableDetails(map[string]interface{}{
+				"renewal_notice_days": *r.RenewalNoticeDays,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ListUpcomingRenewalsRequest lists the fixed-term subscriptions whose current term ends soon
+type ListUpcomingRenewalsRequest struct {
+	*types.QueryFilter
+
+	// Days is the number of days from now in which the term of the listed subscriptions ends, defaults to 30
+	Days int `form:"days" json:"days,omitempty"`
+}
+
+func (r *ListUpcomingRenewalsRequest) Validate() error {
+	if r.QueryFilter == nil {
+		r.QueryFilter = types.NewDefaultQueryFilter()
+	}
+	if err := r.QueryFilter.Validate(); err != nil {
+		return err
+	}
+	if r.Days == 0 {
+		r.Days = 30
+	}
+	if r.Days < 0 || r.Days > 366 {
+		return ierr.NewError("invalid days").
+			WithHint("Days must be between 1 and 366").
+			WithReportableDetails(map[string]interface{}{
+				"days": r.Days,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// UpcomingRenewalResponse is a fixed-term subscription whose current term ends soon
+type UpcomingRenewalResponse struct {
+	SubscriptionID string `json:"subscription_id"`
+	CustomerID     string `json:"customer_id"`
+	PlanID         string `json:"plan_id"`
+	Currency       string `json:"currency"`
+
+	TermMonths       int        `json:"term_months"`
+	CurrentTermStart *time.Time `json:"current_term_start,omitempty"`
+	CurrentTermEnd   *time.Time `json:"current_term_end,omitempty"`
+
+	AutoRenew     bool    `json:"auto_renew"`
+	RenewalPlanID *string `json:"renewal_plan_id,omitempty"`
+
+	// NonRenewalDeadline is the last time the renewal can be turned off
+	NonRenewalDeadline *time.Time `json:"non_renewal_deadline,omitempty"`
+
+	// RenewalAction is what happens at the end of the term: renew, renew_to_plan or cancel
+	RenewalAction types.SubscriptionRenewalAction `json:"renewal_action"`
+}
+
+// NewUpcomingRenewalResponse creates the upcoming renewal of a fixed-term subscription
+func NewUpcomingRenewalResponse(sub *subscription.Subscription) *UpcomingRenewalResponse {
+	return &UpcomingRenewalResponse{
+		SubscriptionID:     sub.ID,
+		CustomerID:         sub.CustomerID,
+		PlanID:             sub.PlanID,
+		Currency:           sub.Currency,
+		TermMonths:         sub.TermMonths,
+		CurrentTermStart:   sub.CurrentTermStart,
+		CurrentTermEnd:     sub.CurrentTermEnd,
+		AutoRenew:          sub.AutoRenew,
+		RenewalPlanID:      sub.RenewalPlanID,
+		NonRenewalDeadline: sub.NonRenewalDeadline(),
+		RenewalAction:      sub.RenewalAction(),
+	}
+}
+
+// SubscriptionTermRenewalResult is the outcome of the term renewal of a subscription by the billing workflow
+type SubscriptionTermRenewalResult struct {
+	// SubscriptionID is the subscription after the renewal, a new subscription when it renewed to a different plan
+	SubscriptionID      string `json:"subscription_id"`
+	Renewed             bool   `json:"renewed"`
+	NonRenewalScheduled bool   `json:"non_renewal_scheduled"`
+}
+
+// ListUpcomingRenewalsResponse lists the fixed-term subscriptions whose current term ends soon
+type ListUpcomingRenewalsResponse struct {
+	Items      []*UpcomingRenewalResponse `json:"items"`
+	Pagination types.PaginationResponse   `json:"pagination"`
+	// Until is the end of the window of the listed renewals
+	Until time.Time `json:"until"`
 }
 
 // AddAddonRequest is used by body-based endpoint /subscriptions/addon
@@ -363,12 +670,31 @@ type AddAddonRequest struct {
 type RemoveAddonRequest struct {
 	AddonAssociationID string `json:"addon_association_id" validate:"required"`
 	Reason             string `json:"reason,omitempty"`
+
+	// CancellationType is when the addon is removed, end_of_period (default) or immediate
+	CancellationType types.CancellationType `json:"cancellation_type,omitempty"`
+
+	// ProrationBehavior controls whether the unused part of the current period is credited when
+	// the addon is removed immediately. Defaults to create_prorations.
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior,omitempty"`
 }
 
 func (r *RemoveAddonRequest) Validate() error {
 	if err := validator.ValidateRequest(r); err != nil {
 		return err
 	}
+
+	if r.CancellationType == "" {
+		r.CancellationType = types.CancellationTypeEndOfPeriod
+	} else if err := r.CancellationType.Validate(); err != nil {
+		return err
+	}
+
+	if r.ProrationBehavior == "" {
+		r.ProrationBehavior = types.ProrationBehaviorCreateProrations
+	} else if err := r.ProrationBehavior.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -378,6 +704,29 @@ type UpdateSubscriptionRequest struct {
 	CancelAtPeriodEnd bool                     `json:"cancel_at_period_end,omitempty"`
 }
 
+// UpdateBillingThresholdRequest sets or removes the billing threshold of a subscription
+type UpdateBillingThresholdRequest struct {
+	// Amount of accrued current period charges above which an interim invoice is generated.
+	// Omit or set to null to remove the threshold.
+	Amount *decimal.Decimal `json:"amount" swaggertype:"string"`
+}
+
+func (r *UpdateBillingThresholdRequest) Validate() error {
+	return validateBillingThresholdAmount(r.Amount)
+}
+
+func validateBillingThresholdAmount(amount *decimal.Decimal) error {
+	if amount != nil && !amount.IsPositive() {
+		return ierr.NewError("billing_threshold_amount must be positive").
+			WithHint("Billing threshold amount must be greater than 0").
+			WithReportableDetails(map[string]interface{}{
+				"billing_threshold_amount": *amount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 // CancelSubscriptionRequest represents the enhanced cancellation request
 type CancelSubscriptionRequest struct {
 
@@ -602,6 +951,18 @@ func (r *CreateSubscriptionRequest) Validate() error {
 		return err
 	}
 
+	if err := r.BackdatedBillingMode.Validate(); err != nil {
+		return err
+	}
+	if r.BackdatedBillingMode != "" && r.StartDate == nil {
+		return ierr.NewError("start_date is required with backdated_billing_mode").
+			WithHint("Backdated billing only applies to subscriptions starting in the past").
+			WithReportableDetails(map[string]interface{}{
+				"backdated_billing_mode": r.BackdatedBillingMode,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
 	// Set default start date if not provided
 	if r.StartDate == nil {
 		now := time.Now().UTC()
@@ -655,9 +1016,9 @@ func (r *CreateSubscriptionRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
-	if r.PlanID == "" {
-		return ierr.NewError("plan_id is required").
-			WithHint("Plan ID is required").
+	if r.PlanID == "" && r.PlanLookupKey == "" {
+		return ierr.NewError("plan_id or plan_lookup_key is required").
+			WithHint("Provide either plan_id or plan_lookup_key").
 			Mark(ierr.ErrValidation)
 	}
 
@@ -718,6 +1079,18 @@ func (r *CreateSubscriptionRequest) Validate() error {
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := validateBillingThresholdAmount(r.BillingThresholdAmount); err != nil {
+		return err
+	}
+
+	if err := validateTrialSettings(r.TrialEndAction, r.TrialReminderDays, r.TrialUsageCaps); err != nil {
+		return err
+	}
+
+	if err := validateTermSettings(r.TermMonths, r.RenewalNoticeDays, r.RenewalPlanID); err != nil {
+		return err
+	}
+
 	// Validate credit grants if provided
 	if len(r.CreditGrants) > 0 {
 		for i, grant := range r.CreditGrants {
@@ -833,6 +1206,10 @@ func (r *CreateSubscriptionRequest) Validate() error {
 				Mark(ierr.ErrValidation)
 		}
 
+		if err := r.validatePhaseCommitments(); err != nil {
+			return err
+		}
+
 		// Check last phase end date validation
 		lastPhase := r.Phases[len(r.Phases)-1]
 		if r.EndDate != nil && lastPhase.EndDate != nil && !r.EndDate.Equal(*lastPhase.EndDate) {
@@ -849,6 +1226,56 @@ func (r *CreateSubscriptionRequest) Validate() error {
 	return nil
 }
 
+// validatePhaseCommitments validates the commitments of the phases of a ramped contract against
+// the subscription. Every invoice has to fall into a single commitment period, so the billing
+// period can not be longer than the true-up cadence.
+func (r *CreateSubscriptionRequest) validatePhaseCommitments() error {
+	hasPhaseCommitment := lo.SomeBy(r.Phases, func(phase SubscriptionPhaseCreateRequest) bool {
+		return phase.CommitmentAmount != nil
+	})
+	if !hasPhaseCommitment {
+		return nil
+	}
+
+	if r.CommitmentAmount != nil && r.CommitmentAmount.IsPositive() {
+		return ierr.NewError("phase commitments cannot be combined with a subscription commitment").
+			WithHint("Set the commitment either on the subscription or on its phases").
+			Mark(ierr.ErrValidation)
+	}
+
+	billingPeriodCount := lo.Ternary(r.BillingPeriodCount == 0, 1, r.BillingPeriodCount)
+	reference := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	billingPeriodEnd, err := types.NextBillingDate(reference, reference, billingPeriodCount, r.BillingPeriod, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, phase := range r.Phases {
+		if phase.CommitmentTrueUpCadence == nil {
+			continue
+		}
+
+		cadence := *phase.CommitmentTrueUpCadence
+		commitmentPeriodEnd, err := types.NextBillingDate(reference, reference, 1, cadence.BillingPeriod(), nil)
+		if err != nil {
+			return err
+		}
+		if billingPeriodEnd.After(commitmentPeriodEnd) {
+			return ierr.NewError("billing period is longer than the commitment true-up cadence").
+				WithHint(fmt.Sprintf("Phase at index %d trues up %s, bill the subscription at least as often", i, cadence)).
+				WithReportableDetails(map[string]interface{}{
+					"phase_index":                i,
+					"commitment_true_up_cadence": cadence,
+					"billing_period":             r.BillingPeriod,
+					"billing_period_count":       billingPeriodCount,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
 // validatePaymentBehaviorForCollectionMethod validates that payment behavior is compatible with collection method
 func (r *CreateSubscriptionRequest) validatePaymentBehaviorForCollectionMethod(collectionMethod types.CollectionMethod, paymentBehavior types.PaymentBehavior) error {
 	switch collectionMethod {
@@ -1031,6 +1458,27 @@ func (r *CreateSubscriptionRequest) ToSubscription(ctx context.Context) *subscri
 		sub.OverageFactor = lo.ToPtr(decimal.NewFromInt(1)) // Default value
 	}
 
+	sub.BillingThresholdAmount = r.BillingThresholdAmount
+
+	sub.TrialEndAction = r.TrialEndAction
+	if sub.TrialEndAction == "" {
+		sub.TrialEndAction = types.TrialEndActionConvert
+	}
+	sub.TrialReminderDays = r.TrialReminderDays
+	sub.TrialUsageCaps = r.TrialUsageCaps
+
+	sub.BackdatedBillingMode = r.BackdatedBillingMode
+	if sub.BackdatedBillingMode == "" {
+		sub.BackdatedBillingMode = types.BackdatedBillingModePerPeriod
+	}
+
+	sub.TermMonths = r.TermMonths
+	sub.AutoRenew = lo.FromPtrOr(r.AutoRenew, true)
+	sub.RenewalNoticeDays = r.RenewalNoticeDays
+	if lo.FromPtr(r.RenewalPlanID) != "" {
+		sub.RenewalPlanID = r.RenewalPlanID
+	}
+
 	return sub
 }
 
@@ -1083,6 +1531,9 @@ type OverrideLineItemRequest struct {
 
 	// PriceUnitTiers are the tiers for the price unit (for CUSTOM type, TIERED billing model)
 	PriceUnitTiers []CreatePriceTier `json:"price_unit_tiers,omitempty"`
+
+	// IncludedQuantity is the usage included for free in each billing period (for usage prices)
+	IncludedQuantity *decimal.Decimal `json:"included_quantity,omitempty" swaggertype:"string"`
 }
 
 // OverrideEntitlementRequest allows overriding entitlement values for a subscription
@@ -1126,9 +1577,9 @@ func (r *OverrideLineItemRequest) Validate(
 	}
 
 	// At least one override field must be provided
-	if r.Quantity == nil && r.Amount == nil && r.BillingModel == "" && r.TierMode == "" && len(r.Tiers) == 0 && r.TransformQuantity == nil && r.PriceUnitAmount == nil && len(r.PriceUnitTiers) == 0 {
+	if r.Quantity == nil && r.Amount == nil && r.BillingModel == "" && r.TierMode == "" && len(r.Tiers) == 0 && r.TransformQuantity == nil && r.PriceUnitAmount == nil && len(r.PriceUnitTiers) == 0 && r.IncludedQuantity == nil {
 		return ierr.NewError("at least one override field must be provided").
-			WithHint("Specify at least one of: quantity, amount, billing_model, tier_mode, tiers, transform_quantity, price_unit_amount, or price_unit_tiers for price override").
+			WithHint("Specify at least one of: quantity, amount, billing_model, tier_mode, tiers, transform_quantity, price_unit_amount, price_unit_tiers, or included_quantity for price override").
 			Mark(ierr.ErrValidation)
 	}
 
@@ -1203,6 +1654,27 @@ func (r *OverrideLineItemRequest) Validate(
 			Mark(ierr.ErrValidation)
 	}
 
+	// Included usage can only be set for usage-based prices
+	if r.IncludedQuantity != nil {
+		if originalPrice.Type != types.PRICE_TYPE_USAGE {
+			return ierr.NewError("included_quantity can only be set for usage-based prices").
+				WithHint("Only usage prices can include usage for free").
+				WithReportableDetails(map[string]interface{}{
+					"price_id":   r.PriceID,
+					"price_type": originalPrice.Type,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if r.IncludedQuantity.IsNegative() {
+			return ierr.NewError("included_quantity must be non-negative").
+				WithHint("Override included quantity cannot be negative").
+				WithReportableDetails(map[string]interface{}{
+					"included_quantity": r.IncludedQuantity.String(),
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
 	// Validate billing model if provided
 	if r.BillingModel != "" {
 		if err := r.BillingModel.Validate(); err != nil {
@@ -1388,6 +1860,34 @@ func (r *OverrideLineItemRequest) Validate(
 		}
 	}
 
+	// Stair step tiers must form contiguous brackets. Tiers that are not overridden are inherited
+	// from the price, so they are checked when only the tier mode is switched to stair step.
+	if lo.Ternary(r.TierMode != "", r.TierMode, originalPrice.TierMode) == types.BILLING_TIER_STAIR_STEP {
+		tiers, priceUnitTiers := r.Tiers, r.PriceUnitTiers
+		if len(tiers) == 0 && len(priceUnitTiers) == 0 {
+			tiers = newCatalogPriceTiers(originalPrice.Tiers)
+			priceUnitTiers = newCatalogPriceTiers(originalPrice.PriceUnitTiers)
+		}
+		if len(tiers) == 0 && len(priceUnitTiers) == 0 {
+			return ierr.NewError("tiers are required when tier mode is STAIR_STEP").
+				WithHint("Provide the stair step brackets, the price does not have tiers to inherit").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": r.PriceID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if len(tiers) > 0 {
+			if err := validateStairStepTiers(tiers, "tiers"); err != nil {
+				return err
+			}
+		}
+		if len(priceUnitTiers) > 0 {
+			if err := validateStairStepTiers(priceUnitTiers, "price_unit_tiers"); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Validate transform quantity if provided (independent of billing model)
 	if r.TransformQuantity != nil {
 		if err := r.TransformQuantity.Validate(); err != nil {
@@ -1499,6 +1999,7 @@ type SubscriptionUsageByMetersResponse struct {
 	Price            *price.Price       `json:"price"`
 	IsOverage        bool               `json:"is_overage"`               // Whether this charge is at overage rate
 	OverageFactor    float64            `json:"overage_factor,omitempty"` // Factor applied to this charge if in overage
+	TimeWindow       string             `json:"time_window,omitempty"`    // Name of the time-of-use window the charge was rated in
 }
 
 type SubscriptionUpdatePeriodResponse struct {
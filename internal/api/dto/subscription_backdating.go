@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// BackdatedInvoicesPreviewResponse lists the invoices the subscription billing workflow generates
+// for the billing periods of a subscription that already ended
+type BackdatedInvoicesPreviewResponse struct {
+	SubscriptionID       string                     `json:"subscription_id"`
+	Currency             string                     `json:"currency"`
+	BackdatedBillingMode types.BackdatedBillingMode `json:"backdated_billing_mode"`
+	Invoices             []*BackdatedInvoicePreview `json:"invoices"`
+	// total is the sum of the totals of the previewed invoices
+	Total decimal.Decimal `json:"total" swaggertype:"string"`
+}
+
+// BackdatedInvoicePreview is an invoice that will be generated for one or more ended billing periods
+type BackdatedInvoicePreview struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// billing_periods are the billing periods billed on the invoice, catch-up invoices bill several
+	BillingPeriods []BackdatedBillingPeriod `json:"billing_periods"`
+	IsCatchUp      bool                     `json:"is_catch_up"`
+	Invoice        *InvoiceResponse         `json:"invoice"`
+}
+
+// BackdatedBillingPeriod is a billing period billed on a backdated invoice
+type BackdatedBillingPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
@@ -41,6 +41,10 @@ type SubscriptionChangeRequest struct {
 	// If "immediate": change executes immediately (explicit)
 	// If "period_end": change is scheduled for the end of the current billing period
 	ChangeAt *types.ScheduleType `json:"change_at,omitempty"`
+
+	// EffectiveDate starts the new subscription at the given time instead of now. It is set
+	// internally when a subscription renews to another plan at the end of its term.
+	EffectiveDate *time.Time `json:"-"`
 }
 
 // Validate validates the subscription change request
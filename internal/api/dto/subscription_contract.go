@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionContractSummaryResponse summarizes the phase commitments of a ramped contract
+type SubscriptionContractSummaryResponse struct {
+	SubscriptionID string                  `json:"subscription_id"`
+	Currency       string                  `json:"currency"`
+	Phases         []*ContractPhaseSummary `json:"phases"`
+}
+
+// ContractPhaseSummary summarizes the commitment of a phase and its drawdown so far
+type ContractPhaseSummary struct {
+	PhaseID   string     `json:"phase_id"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+
+	// commitment_amount is the annual commitment of the phase
+	CommitmentAmount        decimal.Decimal               `json:"commitment_amount" swaggertype:"string"`
+	OverageFactor           decimal.Decimal               `json:"overage_factor" swaggertype:"string"`
+	CommitmentTrueUpCadence types.CommitmentTrueUpCadence `json:"commitment_true_up_cadence"`
+
+	// committed_amount is the commitment of the commitment periods started so far
+	CommittedAmount decimal.Decimal `json:"committed_amount" swaggertype:"string"`
+	// utilized_amount is the billed usage drawing down the commitment
+	UtilizedAmount decimal.Decimal `json:"utilized_amount" swaggertype:"string"`
+	// true_up_amount is the unused commitment billed so far
+	TrueUpAmount decimal.Decimal `json:"true_up_amount" swaggertype:"string"`
+	// overage_amount is the overage billed so far on top of the usage beyond the commitment
+	OverageAmount decimal.Decimal `json:"overage_amount" swaggertype:"string"`
+
+	Periods []*ContractCommitmentPeriodSummary `json:"periods"`
+}
+
+// ContractCommitmentPeriodSummary summarizes the drawdown of the commitment of a commitment period
+type ContractCommitmentPeriodSummary struct {
+	StartDate        time.Time       `json:"start_date"`
+	EndDate          time.Time       `json:"end_date"`
+	CommitmentAmount decimal.Decimal `json:"commitment_amount" swaggertype:"string"`
+	UtilizedAmount   decimal.Decimal `json:"utilized_amount" swaggertype:"string"`
+	RemainingAmount  decimal.Decimal `json:"remaining_amount" swaggertype:"string"`
+	TrueUpAmount     decimal.Decimal `json:"true_up_amount" swaggertype:"string"`
+	OverageAmount    decimal.Decimal `json:"overage_amount" swaggertype:"string"`
+	IsCurrent        bool            `json:"is_current"`
+}
@@ -30,6 +30,10 @@ type CreateSubscriptionLineItemRequest struct {
 	CommitmentOverageFactor *decimal.Decimal     `json:"commitment_overage_factor,omitempty"`
 	CommitmentTrueUpEnabled bool                 `json:"commitment_true_up_enabled,omitempty"`
 	CommitmentWindowed      bool                 `json:"commitment_windowed,omitempty"`
+
+	// IncludedQuantity is the usage included for free in each billing period of a usage price,
+	// defaults to the included quantity of the price
+	IncludedQuantity *decimal.Decimal `json:"included_quantity,omitempty" swaggertype:"string"`
 }
 
 // DeleteSubscriptionLineItemRequest represents the request to delete a subscription line item
@@ -65,6 +69,9 @@ type UpdateSubscriptionLineItemRequest struct {
 	CommitmentOverageFactor *decimal.Decimal     `json:"commitment_overage_factor,omitempty"`
 	CommitmentTrueUpEnabled *bool                `json:"commitment_true_up_enabled,omitempty"`
 	CommitmentWindowed      *bool                `json:"commitment_windowed,omitempty"`
+
+	// IncludedQuantity is the new usage included for free in each billing period of a usage line item
+	IncludedQuantity *decimal.Decimal `json:"included_quantity,omitempty" swaggertype:"string"`
 }
 
 // LineItemParams contains all necessary parameters for creating a line item
@@ -120,6 +127,19 @@ func (r *CreateSubscriptionLineItemRequest) Validate(price *price.Price) error {
 		return err
 	}
 
+	if err := validateIncludedQuantity(r.IncludedQuantity); err != nil {
+		return err
+	}
+	if r.IncludedQuantity != nil && price != nil && price.Type != types.PRICE_TYPE_USAGE {
+		return ierr.NewError("included_quantity can only be set for usage prices").
+			WithHint("Only usage prices can include usage for free").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":   price.ID,
+				"price_type": price.Type,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
 	if price != nil && price.Type == types.PRICE_TYPE_FIXED && price.MinQuantity != nil {
 		finalQuantity := r.Quantity
 		if finalQuantity.IsZero() {
@@ -140,6 +160,19 @@ func (r *CreateSubscriptionLineItemRequest) Validate(price *price.Price) error {
 	return nil
 }
 
+// validateIncludedQuantity validates the included usage of a line item if provided
+func validateIncludedQuantity(includedQuantity *decimal.Decimal) error {
+	if includedQuantity != nil && includedQuantity.IsNegative() {
+		return ierr.NewError("included_quantity must be non-negative").
+			WithHint("Included quantity cannot be negative").
+			WithReportableDetails(map[string]interface{}{
+				"included_quantity": includedQuantity.String(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
 // validateCommitmentFieldsCommon contains shared commitment validation logic for both Create and Update requests
 // isCreateRequest determines whether auto-setting of commitment type is allowed
 func validateCommitmentFieldsCommon(
@@ -312,6 +345,7 @@ func (r *CreateSubscriptionLineItemRequest) ToSubscriptionLineItem(ctx context.C
 				lineItem.MeterDisplayName = params.Price.Meter.Name
 			}
 			lineItem.Quantity = decimal.Zero
+			lineItem.IncludedQuantity = lo.FromPtrOr(r.IncludedQuantity, params.Price.IncludedQuantity)
 		} else {
 			// For fixed prices, use MinQuantity if quantity not provided and MinQuantity exists
 			if !r.Quantity.IsZero() {
@@ -392,7 +426,7 @@ func (r *UpdateSubscriptionLineItemRequest) Validate() error {
 	// If EffectiveFrom is provided, at least one critical field must be present
 	if r.EffectiveFrom != nil && !r.ShouldCreateNewLineItem() {
 		return ierr.NewError("effective_from requires at least one critical field").
-			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, transform_quantity, or commitment fields").
+			WithHint("When providing effective_from, you must also provide one of: amount, billing_model, tier_mode, tiers, transform_quantity, included_quantity, or commitment fields").
 			Mark(ierr.ErrValidation)
 	}
 
@@ -401,6 +435,10 @@ func (r *UpdateSubscriptionLineItemRequest) Validate() error {
 		return err
 	}
 
+	if err := validateIncludedQuantity(r.IncludedQuantity); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -426,7 +464,8 @@ func (r *UpdateSubscriptionLineItemRequest) ShouldCreateNewLineItem() bool {
 		r.HasCommitment() ||
 		r.CommitmentOverageFactor != nil ||
 		r.CommitmentTrueUpEnabled != nil ||
-		r.CommitmentWindowed != nil
+		r.CommitmentWindowed != nil ||
+		r.IncludedQuantity != nil
 }
 
 // ToSubscriptionLineItem converts the update request to a domain subscription line item
@@ -454,6 +493,11 @@ func (r *UpdateSubscriptionLineItemRequest) ToSubscriptionLineItem(ctx context.C
 		BaseModel:        types.GetDefaultBaseModel(ctx),
 	}
 
+	// Scheduled quantity changes carry over to the new line item
+	newLineItem.QuantityChanges = lo.Filter(existingLineItem.QuantityChanges, func(c types.LineItemQuantityChange, _ int) bool {
+		return c.Status == types.QuantityChangeStatusScheduled
+	})
+
 	// Set metadata - use provided metadata or keep existing
 	if r.Metadata != nil {
 		newLineItem.Metadata = r.Metadata
@@ -498,5 +542,86 @@ func (r *UpdateSubscriptionLineItemRequest) ToSubscriptionLineItem(ctx context.C
 		newLineItem.CommitmentWindowed = existingLineItem.CommitmentWindowed
 	}
 
+	newLineItem.IncludedQuantity = lo.FromPtrOr(r.IncludedQuantity, existingLineItem.IncludedQuantity)
+
 	return newLineItem
 }
+
+// UpdateLineItemQuantityRequest represents the request to change the quantity of a fixed subscription line item
+type UpdateLineItemQuantityRequest struct {
+	// Quantity is the new quantity of the line item
+	Quantity decimal.Decimal `json:"quantity" swaggertype:"string"`
+
+	// EffectiveDate of the change (if not provided, defaults to now). It must be within the current billing period
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+
+	// ProrationBehavior controls whether the change is prorated for the rest of the current period.
+	// Defaults to create_prorations, which invoices increases and credits immediate decreases of advance billed items
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior,omitempty"`
+
+	// DecreaseBehavior controls when a decrease takes effect, defaults to next_period
+	DecreaseBehavior types.QuantityDecreaseBehavior `json:"decrease_behavior,omitempty"`
+}
+
+// Validate validates the update line item quantity request and sets the defaults
+func (r *UpdateLineItemQuantityRequest) Validate() error {
+	if r.Quantity.IsNegative() {
+		return ierr.NewError("quantity must not be negative").
+			WithHint("Please provide a quantity of zero or more").
+			WithReportableDetails(map[string]interface{}{
+				"quantity": r.Quantity,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ProrationBehavior == "" {
+		r.ProrationBehavior = types.ProrationBehaviorCreateProrations
+	}
+	if err := r.ProrationBehavior.Validate(); err != nil {
+		return err
+	}
+
+	if r.DecreaseBehavior == "" {
+		r.DecreaseBehavior = types.QuantityDecreaseBehaviorNextPeriod
+	}
+	return r.DecreaseBehavior.Validate()
+}
+
+// LineItemQuantityChangeResponse represents the result or the preview of a line item quantity change
+type LineItemQuantityChangeResponse struct {
+	// Preview is true when nothing was applied
+	Preview bool `json:"preview"`
+
+	// LineItem is the line item with the change applied
+	LineItem *subscription.SubscriptionLineItem `json:"line_item"`
+
+	// Change is the quantity history entry of the change, it is empty when the request only
+	// cancelled a scheduled change
+	Change *types.LineItemQuantityChange `json:"change,omitempty"`
+
+	// CreditAmount is the prorated credit for the previous quantity
+	CreditAmount decimal.Decimal `json:"credit_amount" swaggertype:"string"`
+
+	// ChargeAmount is the prorated charge for the new quantity
+	ChargeAmount decimal.Decimal `json:"charge_amount" swaggertype:"string"`
+
+	// NetAmount is the charge minus the credit, invoiced immediately when positive and
+	// credited to the customer's wallet when negative
+	NetAmount decimal.Decimal `json:"net_amount" swaggertype:"string"`
+
+	// InvoiceID is the invoice created for the prorated charge
+	InvoiceID string `json:"invoice_id,omitempty"`
+
+	// Timeline is the quantity of the line item over the current billing period
+	Timeline []types.QuantitySegment `json:"timeline"`
+}
+
+// LineItemQuantityHistoryResponse represents the quantity history of a subscription line item
+type LineItemQuantityHistoryResponse struct {
+	LineItemID string                         `json:"line_item_id"`
+	Quantity   decimal.Decimal                `json:"quantity" swaggertype:"string"`
+	Changes    []types.LineItemQuantityChange `json:"changes"`
+
+	// Timeline is the quantity of the line item over the current billing period
+	Timeline []types.QuantitySegment `json:"timeline"`
+}
@@ -0,0 +1,198 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/flexprice/flexprice/internal/validator"
+	"github.com/samber/lo"
+)
+
+// SubscriptionMigrationFilter selects the subscriptions of a bulk migration. Only active and
+// trialing subscriptions are migrated and every given criterion must match.
+type SubscriptionMigrationFilter struct {
+	// plan_ids matches subscriptions on any of the given plans
+	PlanIDs []string `json:"plan_ids,omitempty"`
+
+	// customer_ids matches subscriptions of any of the given customers
+	CustomerIDs []string `json:"customer_ids,omitempty"`
+
+	// metadata matches subscriptions having all of the given metadata key/value pairs
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// IsEmpty returns true when the filter has no criteria
+func (f SubscriptionMigrationFilter) IsEmpty() bool {
+	return len(f.PlanIDs) == 0 && len(f.CustomerIDs) == 0 && len(f.Metadata) == 0
+}
+
+// Matches returns true when the subscription matches all criteria of the filter
+func (f SubscriptionMigrationFilter) Matches(sub *subscription.Subscription) bool {
+	if len(f.PlanIDs) > 0 && !lo.Contains(f.PlanIDs, sub.PlanID) {
+		return false
+	}
+	if len(f.CustomerIDs) > 0 && !lo.Contains(f.CustomerIDs, sub.CustomerID) {
+		return false
+	}
+	for key, value := range f.Metadata {
+		if v, ok := sub.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// SubscriptionMigrationRequest moves the subscriptions matching the filter to the target plan.
+// Subscriptions keep their billing cadence, period and cycle.
+type SubscriptionMigrationRequest struct {
+	// filter selects the subscriptions to migrate, at least one criterion is required
+	Filter SubscriptionMigrationFilter `json:"filter"`
+
+	// target_plan_id is the ID of the plan the subscriptions are moved to
+	TargetPlanID string `json:"target_plan_id" validate:"required" binding:"required"`
+
+	// change_at determines when the change takes effect for each subscription, immediate or
+	// end_of_period. Defaults to immediate.
+	ChangeAt types.ScheduleType `json:"change_at,omitempty"`
+
+	// proration_behavior controls how the change of each subscription is prorated
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior" validate:"required" binding:"required"`
+
+	// batch_size is the number of subscriptions migrated between progress updates, defaults to 50
+	BatchSize int `json:"batch_size,omitempty"`
+}
+
+// Validate validates the subscription migration request and applies defaults
+func (r *SubscriptionMigrationRequest) Validate() error {
+	if err := validator.ValidateRequest(r); err != nil {
+		return err
+	}
+
+	if r.Filter.IsEmpty() {
+		return ierr.NewError("filter is required").
+			WithHint("Provide at least one of plan_ids, customer_ids or metadata to select the subscriptions to migrate").
+			Mark(ierr.ErrValidation)
+	}
+
+	if lo.Contains(r.Filter.PlanIDs, r.TargetPlanID) {
+		return ierr.NewError("target plan cannot be one of the filtered plans").
+			WithHint("Subscriptions are migrated away from the filtered plans, choose a different target plan").
+			WithReportableDetails(map[string]interface{}{
+				"target_plan_id": r.TargetPlanID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if r.ChangeAt == "" {
+		r.ChangeAt = types.ScheduleTypeImmediate
+	}
+	if err := r.ChangeAt.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.ProrationBehavior.Validate(); err != nil {
+		return err
+	}
+
+	if r.BatchSize == 0 {
+		r.BatchSize = types.DefaultSubscriptionMigrationBatchSize
+	}
+	if r.BatchSize < 0 || r.BatchSize > types.MaxSubscriptionMigrationBatchSize {
+		return ierr.NewError("invalid batch_size").
+			WithHint("Batch size must be between 1 and 500").
+			WithReportableDetails(map[string]interface{}{
+				"batch_size": r.BatchSize,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ToSubscriptionChangeRequest builds the plan change of a subscription of the migration. The
+// subscription keeps its billing cadence, period, cycle and metadata.
+func (r *SubscriptionMigrationRequest) ToSubscriptionChangeRequest(sub *subscription.Subscription) SubscriptionChangeRequest {
+	return SubscriptionChangeRequest{
+		TargetPlanID:       r.TargetPlanID,
+		ProrationBehavior:  r.ProrationBehavior,
+		Metadata:           sub.Metadata,
+		BillingCadence:     sub.BillingCadence,
+		BillingPeriod:      sub.BillingPeriod,
+		BillingPeriodCount: sub.BillingPeriodCount,
+		BillingCycle:       sub.BillingCycle,
+		ChangeAt:           lo.ToPtr(r.ChangeAt),
+	}
+}
+
+// SubscriptionMigrationPreviewResponse is the dry-run report of a subscription migration
+type SubscriptionMigrationPreviewResponse struct {
+	TargetPlanID      string                  `json:"target_plan_id"`
+	ChangeAt          types.ScheduleType      `json:"change_at"`
+	ProrationBehavior types.ProrationBehavior `json:"proration_behavior"`
+
+	// total_subscriptions is the number of subscriptions matching the filter
+	TotalSubscriptions int `json:"total_subscriptions"`
+
+	// eligible_subscriptions is the number of subscriptions that can be migrated
+	EligibleSubscriptions int `json:"eligible_subscriptions"`
+
+	Subscriptions []*SubscriptionMigrationPreviewItem `json:"subscriptions"`
+}
+
+// SubscriptionMigrationPreviewItem is the dry-run result of a single subscription
+type SubscriptionMigrationPreviewItem struct {
+	SubscriptionID string `json:"subscription_id"`
+	CustomerID     string `json:"customer_id"`
+	CurrentPlanID  string `json:"current_plan_id"`
+	Eligible       bool   `json:"eligible"`
+
+	// preview is the impact of the plan change, only set for eligible subscriptions
+	Preview *SubscriptionChangePreviewResponse `json:"preview,omitempty"`
+
+	// error explains why the subscription cannot be migrated
+	Error string `json:"error,omitempty"`
+}
+
+// SubscriptionMigrationResult is the outcome of the migration of a single subscription, it is
+// pending until the subscription is processed
+type SubscriptionMigrationResult struct {
+	ID             string                            `json:"id"`
+	SubscriptionID string                            `json:"subscription_id"`
+	CustomerID     string                            `json:"customer_id,omitempty"`
+	Status         types.SubscriptionMigrationStatus `json:"status"`
+
+	// new_subscription_id is the subscription on the target plan of immediate migrations
+	NewSubscriptionID string `json:"new_subscription_id,omitempty"`
+
+	// schedule_id is the plan change schedule of end_of_period migrations
+	ScheduleID string `json:"schedule_id,omitempty"`
+
+	Error       string     `json:"error,omitempty"`
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+}
+
+// NewSubscriptionMigrationResult converts a domain migration result to a response
+func NewSubscriptionMigrationResult(r *subscription.MigrationResult) *SubscriptionMigrationResult {
+	return &SubscriptionMigrationResult{
+		ID:                r.ID,
+		SubscriptionID:    r.SubscriptionID,
+		CustomerID:        r.CustomerID,
+		Status:            r.MigrationStatus,
+		NewSubscriptionID: lo.FromPtr(r.NewSubscriptionID),
+		ScheduleID:        lo.FromPtr(r.ScheduleID),
+		Error:             lo.FromPtr(r.Error),
+		ProcessedAt:       r.ProcessedAt,
+	}
+}
+
+// ListSubscriptionMigrationResultsResponse is a page of the per subscription results of a migration
+type ListSubscriptionMigrationResultsResponse = types.ListResponse[*SubscriptionMigrationResult]
+
+// SubscriptionMigrationResponse is a bulk subscription migration along with its progress, the per
+// subscription results are listed separately
+type SubscriptionMigrationResponse struct {
+	Task    *TaskResponse                 `json:"task"`
+	Request *SubscriptionMigrationRequest `json:"request"`
+}
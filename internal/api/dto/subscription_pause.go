@@ -12,10 +12,15 @@ import (
 // @Description Request object for pausing an active subscription with various pause modes and options
 type PauseSubscriptionRequest struct {
 	// Mode for pausing the subscription
-	// @Description Determines when the pause takes effect. "immediate" pauses right away, "scheduled" pauses at a specified time
-	// @Enum immediate,scheduled
+	// @Description Determines when the pause takes effect. "immediate" pauses right away, "scheduled" pauses at a specified time, "period_end" pauses at the end of the current billing period
+	// @Enum immediate,scheduled,period_end
 	PauseMode types.PauseMode `json:"pause_mode" validate:"required"`
 
+	// What is billed while the subscription is paused
+	// @Description "stop_all" bills nothing during the pause and moves the billing period by the length of the pause, "fixed_fees_only" keeps billing fixed fees every period but not usage recorded during the pause. Defaults to "stop_all"
+	// @Enum stop_all,fixed_fees_only
+	BillingMode types.PauseBillingMode `json:"billing_mode,omitempty"`
+
 	// Start date for the subscription pause
 	// @Description ISO 8601 timestamp when the pause should begin. Required when pause_mode is "scheduled"
 	// @Example "2024-01-15T00:00:00Z"
@@ -53,6 +58,12 @@ func (r *PauseSubscriptionRequest) Validate() error {
 		return err
 	}
 
+	if r.BillingMode != "" {
+		if err := r.BillingMode.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if r.PauseMode == types.PauseModeScheduled && r.PauseStart == nil {
 		return ierr.NewError("pause_start is required when pause_mode is scheduled").
 			WithHint("Please provide a valid date to start the pause").
@@ -207,3 +218,22 @@ func NewListSubscriptionPausesResponse(pauses []*subscription.SubscriptionPause)
 		Total: len(items),
 	}
 }
+
+// SubscriptionPauseProcessingResult is the outcome of activating or ending the pause of a
+// subscription before its billing period is processed
+type SubscriptionPauseProcessingResult struct {
+	// SkipBilling is true when all billing of the subscription is paused
+	SkipBilling bool `json:"skip_billing"`
+
+	// IsPaused is true when the subscription is paused after processing its pause
+	IsPaused bool `json:"is_paused"`
+
+	// WasResumed is true when the pause ended and the subscription was resumed
+	WasResumed bool `json:"was_resumed"`
+
+	// CurrentPeriodEnd is the end of the current billing period of the subscription after processing its pause
+	CurrentPeriodEnd time.Time `json:"current_period_end"`
+
+	// PauseID is the pause that was processed
+	PauseID *string `json:"pause_id,omitempty"`
+}
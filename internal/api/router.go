@@ -56,6 +56,11 @@ type Handlers struct {
 	OAuth                    *v1.OAuthHandler
 	Dashboard                *v1.DashboardHandler
 	Workflow                 *v1.WorkflowHandler
+	PricingSimulation        *v1.PricingSimulationHandler
+	SubscriptionMigration    *v1.SubscriptionMigrationHandler
+	Catalog                  *v1.CatalogHandler
+	Experiment               *v1.ExperimentHandler
+	Quote                    *v1.QuoteHandler
 
 	// Portal handlers
 	Onboarding     *v1.OnboardingHandler
@@ -218,6 +223,8 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 
 			// other routes for customer
 			customer.GET("/:id/wallets", handlers.Wallet.GetWalletsByCustomerID)
+			customer.POST("/:id/rate-card", handlers.Price.CreateRateCardPrice)
+			customer.GET("/:id/rate-card", handlers.Price.GetCustomerRateCard)
 			customer.GET("/:id/invoices/summary", handlers.Invoice.GetCustomerInvoiceSummary)
 			customer.GET("/wallets", handlers.Wallet.GetCustomerWallets)
 
@@ -239,11 +246,45 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			plan.POST("/:id/sync/subscriptions", handlers.Plan.SyncPlanPrices)
 			plan.POST("/:id/sync/subscriptions/v2", handlers.Plan.SyncPlanPricesV2)
 
+			// plan version routes
+			plan.POST("/:id/versions", handlers.Plan.CreatePlanVersion)
+			plan.GET("/:id/versions", handlers.Plan.ListPlanVersions)
+			plan.GET("/:id/versions/diff", handlers.Plan.DiffPlanVersions)
+			plan.POST("/:id/versions/migrate", handlers.Plan.MigratePlanVersion)
+			plan.POST("/:id/publish", handlers.Plan.PublishPlanVersion)
+			plan.POST("/:id/archive", handlers.Plan.ArchivePlanVersion)
+
 			// entitlement routes
 			plan.GET("/:id/entitlements", handlers.Plan.GetPlanEntitlements)
 			plan.GET("/:id/creditgrants", handlers.Plan.GetPlanCreditGrants)
 		}
 
+		experiments := v1Private.Group("/experiments")
+		{
+			experiments.POST("", handlers.Experiment.CreateExperiment)
+			experiments.GET("", handlers.Experiment.ListExperiments)
+			experiments.GET("/:id", handlers.Experiment.GetExperiment)
+			experiments.PUT("/:id", handlers.Experiment.UpdateExperiment)
+			experiments.DELETE("/:id", handlers.Experiment.DeleteExperiment)
+			experiments.POST("/:id/start", handlers.Experiment.StartExperiment)
+			experiments.POST("/:id/complete", handlers.Experiment.CompleteExperiment)
+			experiments.POST("/:id/assign", handlers.Experiment.AssignVariant)
+			experiments.GET("/:id/assignments", handlers.Experiment.ListAssignments)
+		}
+
+		quotes := v1Private.Group("/quotes")
+		{
+			quotes.POST("", handlers.Quote.CreateQuote)
+			quotes.GET("", handlers.Quote.ListQuotes)
+			quotes.GET("/:id", handlers.Quote.GetQuote)
+			quotes.PUT("/:id", handlers.Quote.UpdateQuote)
+			quotes.DELETE("/:id", handlers.Quote.DeleteQuote)
+			quotes.POST("/:id/finalize", handlers.Quote.FinalizeQuote)
+			quotes.POST("/:id/accept", handlers.Quote.AcceptQuote)
+			quotes.POST("/:id/decline", handlers.Quote.DeclineQuote)
+			quotes.GET("/:id/pdf", handlers.Quote.GetQuotePDF)
+		}
+
 		addon := v1Private.Group("/addons")
 		{
 			// list addons by filter
@@ -263,6 +304,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			group.POST("", handlers.Group.CreateGroup)
 			group.POST("/search", handlers.Group.ListGroups)
 			group.GET("/:id", handlers.Group.GetGroup)
+			group.PUT("/:id", handlers.Group.UpdateGroup)
 			group.DELETE("/:id", handlers.Group.DeleteGroup)
 		}
 
@@ -275,6 +317,12 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.GET("/:id/v2", handlers.Subscription.GetSubscriptionV2)
 			subscription.POST("/:id/activate", handlers.Subscription.ActivateDraftSubscription)
 			subscription.POST("/:id/cancel", handlers.Subscription.CancelSubscription)
+			subscription.PUT("/:id/billing-threshold", handlers.Subscription.UpdateBillingThreshold)
+			subscription.POST("/:id/trial/extend", handlers.Subscription.ExtendTrial)
+			subscription.GET("/:id/contract", handlers.Subscription.GetContractSummary)
+			subscription.GET("/:id/backdated-invoices/preview", handlers.Subscription.PreviewBackdatedInvoices)
+			subscription.PUT("/:id/renewal", handlers.Subscription.UpdateSubscriptionRenewal)
+			subscription.GET("/renewals/upcoming", handlers.Subscription.ListUpcomingRenewals)
 			subscription.POST("/usage", handlers.Subscription.GetUsageBySubscription)
 
 			subscription.POST("/:id/pause", handlers.SubscriptionPause.PauseSubscription)
@@ -292,9 +340,23 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			subscription.POST("/:id/change/preview", handlers.SubscriptionChange.PreviewSubscriptionChange)
 			subscription.POST("/:id/change/execute", handlers.SubscriptionChange.ExecuteSubscriptionChange)
 
+			// Bulk plan migrations, executed in the background
+			migrations := subscription.Group("/migrations")
+			{
+				migrations.POST("/preview", handlers.SubscriptionMigration.PreviewSubscriptionMigration)
+				migrations.POST("", handlers.SubscriptionMigration.CreateSubscriptionMigration)
+				migrations.GET("/:id", handlers.SubscriptionMigration.GetSubscriptionMigration)
+				migrations.GET("/:id/results", handlers.SubscriptionMigration.ListSubscriptionMigrationResults)
+				migrations.POST("/:id/pause", handlers.SubscriptionMigration.PauseSubscriptionMigration)
+				migrations.POST("/:id/resume", handlers.SubscriptionMigration.ResumeSubscriptionMigration)
+			}
+
 			// Subscription line item management
 			subscription.PUT("/lineitems/:id", handlers.Subscription.UpdateSubscriptionLineItem)
 			subscription.DELETE("/lineitems/:id", handlers.Subscription.DeleteSubscriptionLineItem)
+			subscription.POST("/lineitems/:id/quantity", handlers.Subscription.UpdateLineItemQuantity)
+			subscription.POST("/lineitems/:id/quantity/preview", handlers.Subscription.PreviewLineItemQuantityChange)
+			subscription.GET("/lineitems/:id/quantity/history", handlers.Subscription.GetLineItemQuantityHistory)
 
 			subscription.POST("/temporal/schedule-update-billing-period", handlers.ScheduledTask.ScheduleUpdateBillingPeriod)
 
@@ -423,6 +485,19 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 			}
 		}
 
+		// Simulation routes, results are downloaded through the task download API
+		simulations := v1Private.Group("/simulations")
+		{
+			simulations.POST("/pricing", handlers.PricingSimulation.CreatePricingSimulation)
+		}
+
+		// Catalog routes for managing the catalog of an environment as a document
+		catalog := v1Private.Group("/catalog")
+		{
+			catalog.GET("/export", handlers.Catalog.ExportCatalog)
+			catalog.POST("/apply", handlers.Catalog.ApplyCatalog)
+		}
+
 		// Tax rate routes
 		tax := v1Private.Group("/taxes")
 		taxRates := tax.Group("/rates")
@@ -661,6 +736,7 @@ func NewRouter(handlers Handlers, cfg *config.Configuration, logger *logger.Logg
 	dashboardRoutes := v1Private.Group("/dashboard")
 	{
 		dashboardRoutes.POST("/revenues", handlers.Dashboard.GetRevenues)
+		dashboardRoutes.GET("/experiments/:id", handlers.Dashboard.GetExperimentReport)
 	}
 
 	// Workflow monitoring routes
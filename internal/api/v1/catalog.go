@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+type CatalogHandler struct {
+	service service.CatalogService
+	log     *logger.Logger
+}
+
+func NewCatalogHandler(service service.CatalogService, log *logger.Logger) *CatalogHandler {
+	return &CatalogHandler{service: service, log: log}
+}
+
+// @Summary Export catalog
+// @Description Export the features, meters, plans, prices, entitlements, credit grants, addons and coupons of the current environment as a declarative catalog document
+// @Tags Catalog
+// @Produce json
+// @Produce application/yaml
+// @Security ApiKeyAuth
+// @Param format query string false "Document format, json (default) or yaml"
+// @Success 200 {object} dto.CatalogDocument
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /catalog/export [get]
+func (h *CatalogHandler) ExportCatalog(c *gin.Context) {
+	format := types.CatalogFormat(c.DefaultQuery("format", string(types.CatalogFormatJSON)))
+	if err := format.Validate(); err != nil {
+		c.Error(err)
+		return
+	}
+
+	doc, err := h.service.ExportCatalog(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if format == types.CatalogFormatJSON {
+		c.JSON(http.StatusOK, doc)
+		return
+	}
+
+	data, err := marshalCatalogYAML(doc)
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Failed to encode catalog document").
+			Mark(ierr.ErrInternal))
+		return
+	}
+	c.Data(http.StatusOK, "application/yaml", data)
+}
+
+// @Summary Apply catalog
+// @Description Apply a catalog document to the current environment. Entities are upserted by lookup key in a single transaction, entities missing from the document are left untouched. Changes to immutable fields are reported as conflicts and nothing is applied. Use dry_run to review the changes without applying them. The document can be sent as JSON or, with a YAML content type, as YAML.
+// @Tags Catalog
+// @Accept json
+// @Accept application/yaml
+// @Produce json
+// @Security ApiKeyAuth
+// @Param dry_run query bool false "Only compute the changes"
+// @Param document body dto.CatalogDocument true "Catalog document"
+// @Success 200 {object} dto.ApplyCatalogResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /catalog/apply [post]
+func (h *CatalogHandler) ApplyCatalog(c *gin.Context) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("dry_run must be a boolean").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if strings.Contains(c.ContentType(), "yaml") {
+		body, err = yamlToJSON(body)
+		if err != nil {
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid YAML catalog document").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	var doc dto.CatalogDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ApplyCatalog(c.Request.Context(), dto.ApplyCatalogRequest{
+		Document: doc,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// marshalCatalogYAML encodes the document as YAML through its JSON representation so that
+// the json tags of the document apply and the field order is preserved
+func marshalCatalogYAML(doc *dto.CatalogDocument) ([]byte, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML, decoding it into a node keeps the order of the keys
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	clearYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// clearYAMLStyle switches the flow style of nodes decoded from JSON to the block style
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = node.Style &^ (yaml.FlowStyle | yaml.DoubleQuotedStyle)
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
+
+// yamlToJSON converts a YAML document to JSON so that it is decoded using the json tags
+func yamlToJSON(data []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
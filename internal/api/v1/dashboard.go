@@ -43,3 +43,32 @@ func (h *DashboardHandler) GetRevenues(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// @Summary Get price experiment report
+// @Description Get the assignments, conversions and revenue of each variant of a price experiment
+// @Tags Dashboard
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} dto.ExperimentReportResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /dashboard/experiments/{id} [get]
+func (h *DashboardHandler) GetExperimentReport(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	response, err := h.dashboardService.GetExperimentReport(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
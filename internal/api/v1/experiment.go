@@ -0,0 +1,336 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type ExperimentHandler struct {
+	service service.ExperimentService
+	log     *logger.Logger
+}
+
+func NewExperimentHandler(service service.ExperimentService, log *logger.Logger) *ExperimentHandler {
+	return &ExperimentHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+// @Summary Create price experiment
+// @Description Create a draft price experiment that splits customers of a public plan between weighted plan variants
+// @Tags Experiments
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param experiment body dto.CreateExperimentRequest true "Experiment Request"
+// @Success 201 {object} dto.ExperimentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments [post]
+func (h *ExperimentHandler) CreateExperiment(c *gin.Context) {
+	var req dto.CreateExperimentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreateExperiment(c.Request.Context(), req)
+	if err != nil {
+		h.log.Error("Failed to create experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Get price experiment
+// @Description Get a price experiment by ID
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} dto.ExperimentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id} [get]
+func (h *ExperimentHandler) GetExperiment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetExperiment(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to get experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List price experiments
+// @Description Get price experiments with optional filtering
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.ExperimentFilter false "Filter"
+// @Success 200 {object} dto.ListExperimentsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments [get]
+func (h *ExperimentHandler) ListExperiments(c *gin.Context) {
+	var filter types.ExperimentFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.log.Error("Failed to bind query", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.service.ListExperiments(c.Request.Context(), &filter)
+	if err != nil {
+		h.log.Error("Failed to list experiments", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update price experiment
+// @Description Update a price experiment. Variants can only be changed while the experiment is a draft.
+// @Tags Experiments
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Param experiment body dto.UpdateExperimentRequest true "Update Experiment Request"
+// @Success 200 {object} dto.ExperimentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id} [put]
+func (h *ExperimentHandler) UpdateExperiment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateExperimentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateExperiment(c.Request.Context(), id, req)
+	if err != nil {
+		h.log.Error("Failed to update experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Delete price experiment
+// @Description Delete a price experiment that is not running
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id} [delete]
+func (h *ExperimentHandler) DeleteExperiment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.service.DeleteExperiment(c.Request.Context(), id); err != nil {
+		h.log.Error("Failed to delete experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "experiment deleted successfully"})
+}
+
+// @Summary Start price experiment
+// @Description Start a draft price experiment, subscriptions created through the plan lookup key are assigned to its variants
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} dto.ExperimentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id}/start [post]
+func (h *ExperimentHandler) StartExperiment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.StartExperiment(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to start experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Complete price experiment
+// @Description Complete a running price experiment, existing assignments stay sticky
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} dto.ExperimentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id}/complete [post]
+func (h *ExperimentHandler) CompleteExperiment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CompleteExperiment(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to complete experiment", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Assign customer to a variant
+// @Description Assign a customer to a variant of a price experiment. Assignments are deterministic and sticky.
+// @Tags Experiments
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Param request body dto.AssignExperimentVariantRequest true "Assign Variant Request"
+// @Success 200 {object} dto.ExperimentAssignmentResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id}/assign [post]
+func (h *ExperimentHandler) AssignVariant(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.AssignExperimentVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.AssignVariant(c.Request.Context(), id, req)
+	if err != nil {
+		h.log.Error("Failed to assign experiment variant", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List experiment assignments
+// @Description List the customer assignments of a price experiment
+// @Tags Experiments
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Experiment ID"
+// @Param filter query types.ExperimentAssignmentFilter false "Filter"
+// @Success 200 {object} dto.ListExperimentAssignmentsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /experiments/{id}/assignments [get]
+func (h *ExperimentHandler) ListAssignments(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("experiment ID is required").
+			WithHint("Please provide a valid experiment ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var filter types.ExperimentAssignmentFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.log.Error("Failed to bind query", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+	filter.ExperimentIDs = []string{id}
+
+	resp, err := h.service.ListAssignments(c.Request.Context(), &filter)
+	if err != nil {
+		h.log.Error("Failed to list experiment assignments", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -53,6 +53,74 @@ func (h *PriceHandler) CreatePrice(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// @Summary Add a price to the rate card of a customer
+// @Description Add a negotiated price keyed by a plan or addon price or by a metered feature to the rate card of a customer. Rate card prices take precedence over plan prices in all subscriptions of the customer while they are valid. Use DELETE /prices/{id} to end a rate.
+// @Tags Prices
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Customer ID"
+// @Param price body dto.CreateRateCardPriceRequest true "Rate card price"
+// @Success 201 {object} dto.PriceResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 409 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /customers/{id}/rate-card [post]
+func (h *PriceHandler) CreateRateCardPrice(c *gin.Context) {
+	customerID := c.Param("id")
+	if customerID == "" {
+		c.Error(ierr.NewError("customer_id is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.CreateRateCardPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreateRateCardPrice(c.Request.Context(), customerID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Get the rate card of a customer
+// @Description Get the negotiated prices of a customer, including expired rates
+// @Tags Prices
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Customer ID"
+// @Success 200 {object} dto.ListPricesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /customers/{id}/rate-card [get]
+func (h *PriceHandler) GetCustomerRateCard(c *gin.Context) {
+	customerID := c.Param("id")
+	if customerID == "" {
+		c.Error(ierr.NewError("customer_id is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetPricesByCustomerID(c.Request.Context(), customerID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Create multiple prices in bulk
 // @Description Create multiple prices with the specified configurations. Supports both regular and price unit configurations.
 // @Tags Prices
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type PricingSimulationHandler struct {
+	service service.PricingSimulationService
+	log     *logger.Logger
+}
+
+func NewPricingSimulationHandler(service service.PricingSimulationService, log *logger.Logger) *PricingSimulationHandler {
+	return &PricingSimulationHandler{service: service, log: log}
+}
+
+// @Summary Run a pricing simulation
+// @Description Replay historical usage of existing subscriptions against a candidate plan without creating invoices. The per customer and aggregate revenue delta is exported as a CSV file that can be downloaded through the task download API once the task completes. Simulations of a few customers without a connection_id run within the request and return their results.
+// @Tags Simulations
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param simulation body dto.CreatePricingSimulationRequest true "Pricing simulation configuration"
+// @Success 200 {object} dto.PricingSimulationResponse
+// @Success 202 {object} dto.PricingSimulationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /simulations/pricing [post]
+func (h *PricingSimulationHandler) CreatePricingSimulation(c *gin.Context) {
+	var req dto.CreatePricingSimulationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreatePricingSimulation(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if resp.WorkflowID == "" {
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+	c.JSON(http.StatusAccepted, resp)
+}
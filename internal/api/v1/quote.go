@@ -0,0 +1,322 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type QuoteHandler struct {
+	service service.QuoteService
+	log     *logger.Logger
+}
+
+func NewQuoteHandler(service service.QuoteService, log *logger.Logger) *QuoteHandler {
+	return &QuoteHandler{
+		service: service,
+		log:     log,
+	}
+}
+
+// @Summary Create quote
+// @Description Create a draft quote proposing a subscription to a plan with quoted line items, coupons and phases
+// @Tags Quotes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param quote body dto.CreateQuoteRequest true "Quote Request"
+// @Success 201 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes [post]
+func (h *QuoteHandler) CreateQuote(c *gin.Context) {
+	var req dto.CreateQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreateQuote(c.Request.Context(), req)
+	if err != nil {
+		h.log.Error("Failed to create quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Get quote
+// @Description Get a quote with its line items by ID
+// @Tags Quotes
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id} [get]
+func (h *QuoteHandler) GetQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetQuote(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to get quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List quotes
+// @Description Get quotes with optional filtering
+// @Tags Quotes
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query types.QuoteFilter false "Filter"
+// @Success 200 {object} dto.ListQuotesResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes [get]
+func (h *QuoteHandler) ListQuotes(c *gin.Context) {
+	var filter types.QuoteFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.log.Error("Failed to bind query", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if filter.GetLimit() == 0 {
+		filter.Limit = lo.ToPtr(types.GetDefaultFilter().Limit)
+	}
+
+	resp, err := h.service.ListQuotes(c.Request.Context(), &filter)
+	if err != nil {
+		h.log.Error("Failed to list quotes", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update quote
+// @Description Update a draft quote. Line items replace the line items of the quote when provided.
+// @Tags Quotes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Param quote body dto.UpdateQuoteRequest true "Update Quote Request"
+// @Success 200 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id} [put]
+func (h *QuoteHandler) UpdateQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Failed to bind JSON", "error", err)
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateQuote(c.Request.Context(), id, req)
+	if err != nil {
+		h.log.Error("Failed to update quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Delete quote
+// @Description Delete a draft quote
+// @Tags Quotes
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id} [delete]
+func (h *QuoteHandler) DeleteQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	if err := h.service.DeleteQuote(c.Request.Context(), id); err != nil {
+		h.log.Error("Failed to delete quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "quote deleted successfully"})
+}
+
+// @Summary Finalize quote
+// @Description Finalize a draft quote, assigning its quote number and opening it for acceptance
+// @Tags Quotes
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id}/finalize [post]
+func (h *QuoteHandler) FinalizeQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.FinalizeQuote(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to finalize quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Accept quote
+// @Description Accept an open quote, converting it into a subscription with the quoted line items, coupons and phases
+// @Tags Quotes
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id}/accept [post]
+func (h *QuoteHandler) AcceptQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.AcceptQuote(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to accept quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Decline quote
+// @Description Decline an open quote
+// @Tags Quotes
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Param request body dto.DeclineQuoteRequest false "Decline Quote Request"
+// @Success 200 {object} dto.QuoteResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id}/decline [post]
+func (h *QuoteHandler) DeclineQuote(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.DeclineQuoteRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.log.Error("Failed to bind JSON", "error", err)
+			c.Error(ierr.WithError(err).
+				WithHint("Invalid request format").
+				Mark(ierr.ErrValidation))
+			return
+		}
+	}
+
+	resp, err := h.service.DeclineQuote(c.Request.Context(), id, req)
+	if err != nil {
+		h.log.Error("Failed to decline quote", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get quote PDF
+// @Description Render the PDF document of a quote
+// @Tags Quotes
+// @Security ApiKeyAuth
+// @Param id path string true "Quote ID"
+// @Success 200 {file} application/pdf
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /quotes/{id}/pdf [get]
+func (h *QuoteHandler) GetQuotePDF(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("quote ID is required").
+			WithHint("Please provide a valid quote ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	pdf, err := h.service.GetQuotePDF(c.Request.Context(), id)
+	if err != nil {
+		h.log.Error("Failed to generate quote pdf", "error", err)
+		c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
@@ -188,6 +188,211 @@ func (h *SubscriptionHandler) CancelSubscription(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary Update subscription billing threshold
+// @Description Set or remove the amount of accrued current period charges above which an interim invoice is generated
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateBillingThresholdRequest true "Update Billing Threshold Request"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/billing-threshold [put]
+func (h *SubscriptionHandler) UpdateBillingThreshold(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateBillingThresholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateBillingThreshold(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Extend subscription trial
+// @Description Move the end of the trial of a trialing subscription to a later date
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.ExtendTrialRequest true "Extend Trial Request"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/trial/extend [post]
+func (h *SubscriptionHandler) ExtendTrial(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.ExtendTrialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ExtendTrial(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Update subscription renewal
+// @Description Turn the auto-renewal of a fixed-term subscription on or off, or change the plan it renews to
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Param request body dto.UpdateSubscriptionRenewalRequest true "Update Subscription Renewal Request"
+// @Success 200 {object} dto.SubscriptionResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/renewal [put]
+func (h *SubscriptionHandler) UpdateSubscriptionRenewal(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateSubscriptionRenewalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateSubscriptionRenewal(c.Request.Context(), id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List upcoming subscription renewals
+// @Description List the fixed-term subscriptions whose current term ends within the given number of days, ordered by the end of their term
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param days query int false "Number of days from now, defaults to 30"
+// @Param limit query int false "Limit results"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} dto.ListUpcomingRenewalsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/renewals/upcoming [get]
+func (h *SubscriptionHandler) ListUpcomingRenewals(c *gin.Context) {
+	var req dto.ListUpcomingRenewalsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ListUpcomingRenewals(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get subscription contract summary
+// @Description Get the phase commitments of a ramped contract and the commitment drawn down by billed usage so far
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionContractSummaryResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/contract [get]
+func (h *SubscriptionHandler) GetContractSummary(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetContractSummary(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Preview backdated invoices
+// @Description Preview the invoices generated for the billing periods of a backdated subscription that already ended
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} dto.BackdatedInvoicesPreviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/{id}/backdated-invoices/preview [get]
+func (h *SubscriptionHandler) PreviewBackdatedInvoices(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.Error(ierr.NewError("subscription ID is required").
+			WithHint("Please provide a valid subscription ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.PreviewBackdatedInvoices(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Activate draft subscription
 // @Description Activate a draft subscription with a new start date
 // @Tags Subscriptions
@@ -481,6 +686,113 @@ func (h *SubscriptionHandler) DeleteSubscriptionLineItem(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// @Summary Update subscription line item quantity
+// @Description Change the quantity of a fixed price line item. Increases of items billed in advance are invoiced immediately for the rest of the period. Decreases take effect at the end of the period or immediately with the unused time credited to the customer's wallet. Requesting the current quantity cancels a scheduled decrease.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Line Item ID"
+// @Param request body dto.UpdateLineItemQuantityRequest true "Update Line Item Quantity Request"
+// @Success 200 {object} dto.LineItemQuantityChangeResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/lineitems/{id}/quantity [post]
+func (h *SubscriptionHandler) UpdateLineItemQuantity(c *gin.Context) {
+	lineItemID := c.Param("id")
+	if lineItemID == "" {
+		c.Error(ierr.NewError("line item ID is required").
+			WithHint("Please provide a valid line item ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLineItemQuantityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.UpdateLineItemQuantity(c.Request.Context(), lineItemID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Preview subscription line item quantity change
+// @Description Preview the proration and the quantity timeline of a line item quantity change without applying it
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Line Item ID"
+// @Param request body dto.UpdateLineItemQuantityRequest true "Update Line Item Quantity Request"
+// @Success 200 {object} dto.LineItemQuantityChangeResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/lineitems/{id}/quantity/preview [post]
+func (h *SubscriptionHandler) PreviewLineItemQuantityChange(c *gin.Context) {
+	lineItemID := c.Param("id")
+	if lineItemID == "" {
+		c.Error(ierr.NewError("line item ID is required").
+			WithHint("Please provide a valid line item ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	var req dto.UpdateLineItemQuantityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.PreviewLineItemQuantityChange(c.Request.Context(), lineItemID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get subscription line item quantity history
+// @Description Get the quantity changes of a line item and its quantity timeline over the current billing period
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Line Item ID"
+// @Success 200 {object} dto.LineItemQuantityHistoryResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/lineitems/{id}/quantity/history [get]
+func (h *SubscriptionHandler) GetLineItemQuantityHistory(c *gin.Context) {
+	lineItemID := c.Param("id")
+	if lineItemID == "" {
+		c.Error(ierr.NewError("line item ID is required").
+			WithHint("Please provide a valid line item ID").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.GetLineItemQuantityHistory(c.Request.Context(), lineItemID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary Get upcoming credit grant applications
 // @Description Get upcoming credit grant applications for a subscription
 // @Tags Subscriptions
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionMigrationHandler handles API requests for bulk subscription migrations
+type SubscriptionMigrationHandler struct {
+	service service.SubscriptionMigrationService
+	log     *logger.Logger
+}
+
+// NewSubscriptionMigrationHandler creates a new subscription migration handler
+func NewSubscriptionMigrationHandler(service service.SubscriptionMigrationService, log *logger.Logger) *SubscriptionMigrationHandler {
+	return &SubscriptionMigrationHandler{service: service, log: log}
+}
+
+// @Summary Preview a bulk subscription migration
+// @Description Dry run of a bulk subscription migration. Reports for every subscription matching the filter whether it can be moved to the target plan along with the impact of the change. No subscription is changed.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.SubscriptionMigrationRequest true "Subscription migration request"
+// @Success 200 {object} dto.SubscriptionMigrationPreviewResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations/preview [post]
+func (h *SubscriptionMigrationHandler) PreviewSubscriptionMigration(c *gin.Context) {
+	var req dto.SubscriptionMigrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.PreviewSubscriptionMigration(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Start a bulk subscription migration
+// @Description Move every subscription matching the filter to the target plan. The migration runs in the background in batches, its progress and per subscription results are available through the get migration API.
+// @Tags Subscriptions
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body dto.SubscriptionMigrationRequest true "Subscription migration request"
+// @Success 202 {object} dto.SubscriptionMigrationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations [post]
+func (h *SubscriptionMigrationHandler) CreateSubscriptionMigration(c *gin.Context) {
+	var req dto.SubscriptionMigrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid request format").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.CreateSubscriptionMigration(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// @Summary Get a bulk subscription migration
+// @Description Get the request and progress of a bulk subscription migration
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Migration task ID"
+// @Success 200 {object} dto.SubscriptionMigrationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations/{id} [get]
+func (h *SubscriptionMigrationHandler) GetSubscriptionMigration(c *gin.Context) {
+	resp, err := h.service.GetSubscriptionMigration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary List the results of a bulk subscription migration
+// @Description List the per subscription results of a bulk subscription migration, subscriptions not processed yet are pending
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Migration task ID"
+// @Param filter query types.SubscriptionMigrationResultFilter false "Filter"
+// @Success 200 {object} dto.ListSubscriptionMigrationResultsResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations/{id}/results [get]
+func (h *SubscriptionMigrationHandler) ListSubscriptionMigrationResults(c *gin.Context) {
+	filter := types.NewSubscriptionMigrationResultFilter()
+	if err := c.ShouldBindQuery(filter); err != nil {
+		c.Error(ierr.WithError(err).
+			WithHint("Invalid filter parameters").
+			Mark(ierr.ErrValidation))
+		return
+	}
+
+	resp, err := h.service.ListSubscriptionMigrationResults(c.Request.Context(), c.Param("id"), filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Pause a bulk subscription migration
+// @Description Pause a running bulk subscription migration. The batch in flight completes before the migration pauses.
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Migration task ID"
+// @Success 200 {object} dto.SubscriptionMigrationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations/{id}/pause [post]
+func (h *SubscriptionMigrationHandler) PauseSubscriptionMigration(c *gin.Context) {
+	resp, err := h.service.PauseSubscriptionMigration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Resume a bulk subscription migration
+// @Description Resume a paused bulk subscription migration
+// @Tags Subscriptions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "Migration task ID"
+// @Success 200 {object} dto.SubscriptionMigrationResponse
+// @Failure 400 {object} ierr.ErrorResponse
+// @Failure 404 {object} ierr.ErrorResponse
+// @Failure 500 {object} ierr.ErrorResponse
+// @Router /subscriptions/migrations/{id}/resume [post]
+func (h *SubscriptionMigrationHandler) ResumeSubscriptionMigration(c *gin.Context) {
+	resp, err := h.service.ResumeSubscriptionMigration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
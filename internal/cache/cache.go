@@ -68,6 +68,8 @@ const (
 	PrefixPriceUnit                = "price_unit:v1:"
 	PrefixWalletRealTimeBalance    = "wallet_realtime_balance:v1:"
 	PrefixWorkflowExecution        = "workflow_execution:v1:"
+	PrefixBillingThresholdCheck    = "billing_threshold_check:v1:"
+	PrefixSpendLimitCheck          = "spend_limit_check:v1:"
 )
 
 // GenerateKey creates a cache key from a prefix and a set of parameters
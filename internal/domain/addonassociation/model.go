@@ -6,6 +6,7 @@ import (
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // AddonAssociation is the model entity for the AddonAssociation schema.
@@ -21,6 +22,10 @@ type AddonAssociation struct {
 	CancellationReason string                           `json:"cancellation_reason,omitempty"`
 	CancelledAt        *time.Time                       `json:"cancelled_at,omitempty"`
 	Metadata           map[string]interface{}           `json:"metadata,omitempty"`
+	Quantity           decimal.Decimal                  `json:"quantity" swaggertype:"string"`
+	BillingPeriod      types.BillingPeriod              `json:"billing_period,omitempty"`
+	BillingPeriodCount int                              `json:"billing_period_count,omitempty"`
+	TrialEnd           *time.Time                       `json:"trial_end,omitempty"`
 
 	types.BaseModel
 }
@@ -38,6 +43,10 @@ func FromEnt(ent *ent.AddonAssociation) *AddonAssociation {
 		CancellationReason: ent.CancellationReason,
 		CancelledAt:        ent.CancelledAt,
 		Metadata:           ent.Metadata,
+		Quantity:           ent.Quantity,
+		BillingPeriod:      ent.BillingPeriod,
+		BillingPeriodCount: ent.BillingPeriodCount,
+		TrialEnd:           ent.TrialEnd,
 		BaseModel: types.BaseModel{
 			CreatedAt: ent.CreatedAt,
 			UpdatedAt: ent.UpdatedAt,
@@ -77,3 +86,23 @@ func (aa *AddonAssociation) GetPeriodEnd(defaultPeriodEnd time.Time) time.Time {
 	}
 	return defaultPeriodEnd
 }
+
+// IsInTrial returns true if the addon is in trial at t
+func (aa *AddonAssociation) IsInTrial(t time.Time) bool {
+	return aa.TrialEnd != nil && aa.TrialEnd.After(t)
+}
+
+// BillingStartDate returns the date the addon is billed from, which is the end of the trial
+// for addons with a trial
+func (aa *AddonAssociation) BillingStartDate() time.Time {
+	if aa.TrialEnd != nil {
+		return *aa.TrialEnd
+	}
+	return lo.FromPtr(aa.StartDate)
+}
+
+// HasOwnBillingPeriod returns true if the addon is billed on a period different from the given
+// subscription billing period
+func (aa *AddonAssociation) HasOwnBillingPeriod(period types.BillingPeriod, periodCount int) bool {
+	return aa.BillingPeriod != "" && (aa.BillingPeriod != period || aa.BillingPeriodCount != periodCount)
+}
@@ -63,9 +63,14 @@ type DiscountResult struct {
 
 // ApplyDiscount calculates and applies the discount in a single operation,
 // returning both the discount amount and final price. This is more efficient than
-// separate calculations. The discount is rounded to currency precision immediately
-// to ensure all monetary values respect currency precision at their source.
+// separate calculations. The discount is rounded with the default rounding policy.
 func (c *Coupon) ApplyDiscount(originalPrice decimal.Decimal, currency string) DiscountResult {
+	return c.ApplyDiscountWithRounding(originalPrice, currency, types.DefaultRoundingConfig())
+}
+
+// ApplyDiscountWithRounding applies the discount like ApplyDiscount and rounds it with the
+// given rounding policy. With invoice level rounding the discount is kept unrounded.
+func (c *Coupon) ApplyDiscountWithRounding(originalPrice decimal.Decimal, currency string, rounding types.RoundingConfig) DiscountResult {
 	var discount decimal.Decimal
 
 	switch c.Type {
@@ -77,8 +82,7 @@ func (c *Coupon) ApplyDiscount(originalPrice decimal.Decimal, currency string) D
 		discount = decimal.Zero
 	}
 
-	// Round discount immediately at source to ensure currency precision
-	discount = types.RoundToCurrencyPrecision(discount, currency)
+	discount = rounding.RoundLineItemAmount(discount, currency)
 
 	finalPrice := originalPrice.Sub(discount)
 
@@ -41,6 +41,9 @@ type Customer struct {
 	// AddressCountry is the country of the customer's address (ISO 3166-1 alpha-2)
 	AddressCountry string `db:"address_country" json:"address_country"`
 
+	// ConsolidateInvoices overrides the consolidated invoicing setting of the environment for the customer
+	ConsolidateInvoices *bool `db:"consolidate_invoices" json:"consolidate_invoices,omitempty"`
+
 	// Metadata
 	Metadata map[string]string `db:"metadata" json:"metadata"`
 
@@ -56,19 +59,20 @@ func FromEnt(c *ent.Customer) *Customer {
 		return nil
 	}
 	return &Customer{
-		ID:                c.ID,
-		ExternalID:        c.ExternalID,
-		Name:              c.Name,
-		Email:             c.Email,
-		AddressLine1:      c.AddressLine1,
-		ParentCustomerID:  c.ParentCustomerID,
-		AddressLine2:      c.AddressLine2,
-		AddressCity:       c.AddressCity,
-		AddressState:      c.AddressState,
-		AddressPostalCode: c.AddressPostalCode,
-		AddressCountry:    c.AddressCountry,
-		Metadata:          c.Metadata,
-		EnvironmentID:     c.EnvironmentID,
+		ID:                  c.ID,
+		ExternalID:          c.ExternalID,
+		Name:                c.Name,
+		Email:               c.Email,
+		AddressLine1:        c.AddressLine1,
+		ParentCustomerID:    c.ParentCustomerID,
+		AddressLine2:        c.AddressLine2,
+		AddressCity:         c.AddressCity,
+		AddressState:        c.AddressState,
+		AddressPostalCode:   c.AddressPostalCode,
+		AddressCountry:      c.AddressCountry,
+		Metadata:            c.Metadata,
+		ConsolidateInvoices: c.ConsolidateInvoices,
+		EnvironmentID:       c.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  c.TenantID,
 			Status:    types.Status(c.Status),
@@ -25,6 +25,7 @@ type Entitlement struct {
 	ParentEntitlementID *string                           `json:"parent_entitlement_id,omitempty"`
 	StartDate           *time.Time                        `json:"start_date,omitempty"`
 	EndDate             *time.Time                        `json:"end_date,omitempty"`
+	RolloverConfig      *types.RolloverConfig             `json:"rollover_config,omitempty"`
 	types.BaseModel
 }
 
@@ -64,6 +65,19 @@ func (e *Entitlement) Validate() error {
 					Mark(ierr.ErrValidation)
 			}
 		}
+		if e.RolloverConfig != nil {
+			if err := e.RolloverConfig.Validate(); err != nil {
+				return err
+			}
+			if e.UsageLimit == nil || e.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER {
+				return ierr.NewError("rollover requires a usage limit that resets").
+					WithHint("Only limited usage that resets every period can roll over").
+					WithReportableDetails(map[string]interface{}{
+						"usage_reset_period": e.UsageResetPeriod,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+		}
 	case types.FeatureTypeStatic:
 		if e.StaticValue == "" {
 			return ierr.NewError("static_value is required for static features").
@@ -100,6 +114,7 @@ func FromEnt(e *ent.Entitlement) *Entitlement {
 		ParentEntitlementID: e.ParentEntitlementID,
 		StartDate:           e.StartDate,
 		EndDate:             e.EndDate,
+		RolloverConfig:      e.RolloverConfig,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
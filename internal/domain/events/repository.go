@@ -105,6 +105,8 @@ type UsageParams struct {
 	// - Custom business cycles (fiscal months, quarterly periods)
 	// - Multi-tenant billing with different anchor dates per customer
 	BillingAnchor *time.Time `json:"billing_anchor,omitempty"`
+	// TimeWindow restricts the aggregation to events within (or outside of) time-of-use windows
+	TimeWindow *types.TimeWindowFilter `json:"time_window,omitempty"`
 }
 
 // UsageSummaryParams defines parameters for querying pre-computed usage
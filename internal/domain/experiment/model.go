@@ -0,0 +1,145 @@
+package experiment
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// Experiment splits the customers subscribing to a public plan between weighted plan variants
+type Experiment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// PlanID is the public plan, subscriptions created through its lookup key resolve to a variant
+	PlanID           string                    `json:"plan_id"`
+	ExperimentStatus types.ExperimentStatus    `json:"experiment_status"`
+	Variants         []types.ExperimentVariant `json:"variants"`
+	StartedAt        *time.Time                `json:"started_at,omitempty"`
+	EndedAt          *time.Time                `json:"ended_at,omitempty"`
+	Metadata         map[string]string         `json:"metadata,omitempty"`
+	EnvironmentID    string                    `json:"environment_id"`
+
+	types.BaseModel
+}
+
+// IsRunning returns true if the experiment assigns customers to its variants
+func (e *Experiment) IsRunning() bool {
+	return e.ExperimentStatus == types.ExperimentStatusRunning
+}
+
+// GetVariant returns the variant with the given key
+func (e *Experiment) GetVariant(key string) (types.ExperimentVariant, bool) {
+	return lo.Find(e.Variants, func(v types.ExperimentVariant) bool {
+		return v.Key == key
+	})
+}
+
+// AssignVariant deterministically picks the variant of a customer. The customer is hashed together
+// with the experiment into a bucket of the total weight, so the same customer always lands in the
+// same variant as long as the variants do not change, and different experiments split customers
+// independently of each other.
+func (e *Experiment) AssignVariant(customerID string) types.ExperimentVariant {
+	totalWeight := lo.SumBy(e.Variants, func(v types.ExperimentVariant) int { return v.Weight })
+	if totalWeight <= 0 {
+		return e.Variants[0]
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(e.ID + ":" + customerID))
+	bucket := int(h.Sum64() % uint64(totalWeight))
+
+	for _, v := range e.Variants {
+		if bucket < v.Weight {
+			return v
+		}
+		bucket -= v.Weight
+	}
+
+	return e.Variants[len(e.Variants)-1]
+}
+
+// Assignment records the variant a customer was assigned to. Assignments are sticky: once a
+// customer is assigned, later subscriptions through the experiment use the same variant.
+type Assignment struct {
+	ID           string `json:"id"`
+	ExperimentID string `json:"experiment_id"`
+	CustomerID   string `json:"customer_id"`
+	VariantKey   string `json:"variant_key"`
+	// PlanID is the plan of the variant at the time of the assignment
+	PlanID string `json:"plan_id"`
+	// SubscriptionID is the subscription created for the assignment
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+	// ConvertedAt is when the subscription of the assignment was first activated
+	ConvertedAt   *time.Time `json:"converted_at,omitempty"`
+	EnvironmentID string     `json:"environment_id"`
+
+	types.BaseModel
+}
+
+func FromEnt(e *ent.Experiment) *Experiment {
+	if e == nil {
+		return nil
+	}
+
+	return &Experiment{
+		ID:               e.ID,
+		Name:             e.Name,
+		Description:      e.Description,
+		PlanID:           e.PlanID,
+		ExperimentStatus: e.ExperimentStatus,
+		Variants:         e.Variants,
+		StartedAt:        e.StartedAt,
+		EndedAt:          e.EndedAt,
+		Metadata:         e.Metadata,
+		EnvironmentID:    e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func FromEntList(ents []*ent.Experiment) []*Experiment {
+	return lo.Map(ents, func(e *ent.Experiment, _ int) *Experiment {
+		return FromEnt(e)
+	})
+}
+
+func AssignmentFromEnt(e *ent.ExperimentAssignment) *Assignment {
+	if e == nil {
+		return nil
+	}
+
+	return &Assignment{
+		ID:             e.ID,
+		ExperimentID:   e.ExperimentID,
+		CustomerID:     e.CustomerID,
+		VariantKey:     e.VariantKey,
+		PlanID:         e.PlanID,
+		SubscriptionID: e.SubscriptionID,
+		ConvertedAt:    e.ConvertedAt,
+		EnvironmentID:  e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func AssignmentFromEntList(ents []*ent.ExperimentAssignment) []*Assignment {
+	return lo.Map(ents, func(e *ent.ExperimentAssignment, _ int) *Assignment {
+		return AssignmentFromEnt(e)
+	})
+}
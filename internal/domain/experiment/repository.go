@@ -0,0 +1,39 @@
+package experiment
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for experiment repository operations
+type Repository interface {
+	Create(ctx context.Context, experiment *Experiment) error
+	Get(ctx context.Context, id string) (*Experiment, error)
+	// GetRunningByPlan returns the running experiment of a plan, if any
+	GetRunningByPlan(ctx context.Context, planID string) (*Experiment, error)
+	Update(ctx context.Context, experiment *Experiment) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.ExperimentFilter) ([]*Experiment, error)
+	Count(ctx context.Context, filter *types.ExperimentFilter) (int, error)
+}
+
+// AssignmentRepository defines the interface for experiment assignment repository operations
+type AssignmentRepository interface {
+	Create(ctx context.Context, assignment *Assignment) error
+	// GetByExperimentAndCustomer returns the assignment of a customer in an experiment, if any
+	GetByExperimentAndCustomer(ctx context.Context, experimentID, customerID string) (*Assignment, error)
+	// SetSubscription links the subscription created for an assignment
+	SetSubscription(ctx context.Context, id string, subscriptionID string) error
+	// MarkConverted records the first activation of the subscription of an assignment, later
+	// activations of the subscription keep the first one
+	MarkConverted(ctx context.Context, subscriptionID string, convertedAt time.Time) error
+	List(ctx context.Context, filter *types.ExperimentAssignmentFilter) ([]*Assignment, error)
+	Count(ctx context.Context, filter *types.ExperimentAssignmentFilter) (int, error)
+
+	// GetVariantStats returns the assignments and conversions of the variants of an experiment
+	GetVariantStats(ctx context.Context, experimentID string) ([]types.ExperimentVariantStats, error)
+	// GetVariantRevenue returns the finalized invoice revenue of the variants of an experiment per currency
+	GetVariantRevenue(ctx context.Context, experimentID string) ([]types.ExperimentVariantRevenue, error)
+}
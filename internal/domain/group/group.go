@@ -15,6 +15,8 @@ type Group struct {
 	EnvironmentID string                `json:"environment_id"`
 	LookupKey     string                `json:"lookup_key,omitempty"`
 	Metadata      map[string]string     `json:"metadata,omitempty"`
+	// Pooling is the tier ladder shared by all prices of the group, nil when the group is not pooled
+	Pooling *types.GroupPoolingConfig `json:"pooling,omitempty"`
 	types.BaseModel
 }
 
@@ -30,6 +32,7 @@ func FromEnt(e *ent.Group) *Group {
 		EnvironmentID: e.EnvironmentID,
 		LookupKey:     e.LookupKey,
 		Metadata:      e.Metadata,
+		Pooling:       e.Pooling,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -41,6 +44,11 @@ func FromEnt(e *ent.Group) *Group {
 	}
 }
 
+// IsPooled returns true if the group accumulates its prices into a shared tier ladder
+func (g *Group) IsPooled() bool {
+	return g.Pooling != nil && len(g.Pooling.Tiers) > 0
+}
+
 // FromEntList converts a list of Ent Groups to domain Groups
 func FromEntList(list []*ent.Group) []*Group {
 	if list == nil {
@@ -1,6 +1,7 @@
 package invoice
 
 import (
+	"strings"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
@@ -191,8 +192,25 @@ func FromEnt(e *ent.Invoice) *Invoice {
 	}
 }
 
+// MetadataKeyConsolidatedSubscriptionIDs lists the subscriptions billed on a consolidated invoice,
+// which has no subscription of its own
+const MetadataKeyConsolidatedSubscriptionIDs = "consolidated_subscription_ids"
+
 // Default helper methods
 
+// GetSubscriptionIDs returns the subscriptions billed on the invoice, all subscriptions of the
+// group for consolidated invoices
+func (i *Invoice) GetSubscriptionIDs() []string {
+	if i.SubscriptionID != nil {
+		return []string{*i.SubscriptionID}
+	}
+	ids := i.Metadata[MetadataKeyConsolidatedSubscriptionIDs]
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
+
 func (i *Invoice) GetRemainingAmount() decimal.Decimal {
 	return i.AmountDue.Sub(i.AmountPaid)
 }
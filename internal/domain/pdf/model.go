@@ -108,6 +108,48 @@ type AppliedDiscountData struct {
 	LineItemRef    string  `json:"line_item_ref"`   // Line item reference if discount applied to specific line item, empty if invoice-level
 }
 
+// QuoteData represents the data model for quote PDF generation
+type QuoteData struct {
+	ID            string     `json:"id"`
+	QuoteNumber   string     `json:"quote_number"`
+	QuoteStatus   string     `json:"quote_status"`
+	Currency      string     `json:"currency"`
+	Precision     int32      `json:"precision"`
+	IssuingDate   CustomTime `json:"issuing_date"`
+	ValidUntil    CustomTime `json:"valid_until"`
+	StartDate     CustomTime `json:"start_date"`
+	BillingPeriod string     `json:"billing_period"`
+	Description   string     `json:"description,omitempty"`
+	Subtotal      float64    `json:"subtotal"`       // Recurring amount of the first billing period
+	TotalDiscount float64    `json:"total_discount"` // Coupon discounts of the first billing period
+	Total         float64    `json:"total"`          // Subtotal - discount
+
+	Biller    *BillerInfo    `json:"biller"`
+	Recipient *RecipientInfo `json:"recipient"`
+
+	LineItems []QuoteLineItemData `json:"line_items"`
+	Phases    []QuotePhaseData    `json:"phases,omitempty"`
+}
+
+// QuoteLineItemData represents a quote line item for PDF generation
+type QuoteLineItemData struct {
+	DisplayName        string  `json:"display_name"`
+	Phase              string  `json:"phase,omitempty"` // Empty if the line item applies to all phases
+	Quantity           float64 `json:"quantity"`
+	DiscountPercentage float64 `json:"discount_percentage"`
+	Amount             float64 `json:"amount"` // Amount per billing period after the discount
+	Usage              bool    `json:"usage"`  // Usage line items are billed on the actual usage
+}
+
+// QuotePhaseData represents a phase of the quoted subscription
+type QuotePhaseData struct {
+	Name            string     `json:"name"`
+	DurationPeriods int        `json:"duration_periods"` // Zero if the phase is open ended
+	StartDate       CustomTime `json:"start_date"`       // Zero if the subscription starts on acceptance
+	EndDate         CustomTime `json:"end_date"`
+	Amount          float64    `json:"amount"` // Recurring amount per billing period before coupons
+}
+
 type CustomTime struct {
 	time.Time
 }
@@ -3,6 +3,7 @@ package plan
 import (
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 type Plan struct {
@@ -13,9 +14,42 @@ type Plan struct {
 	EnvironmentID string         `db:"environment_id" json:"environment_id"`
 	Metadata      types.Metadata `db:"metadata" json:"metadata"`
 	DisplayOrder  *int           `db:"display_order" json:"display_order,omitempty"`
+
+	// Version is the number of the version within the versions of the plan
+	Version int `db:"version" json:"version"`
+	// VersionStatus is the lifecycle status of the version
+	VersionStatus types.PlanVersionStatus `db:"version_status" json:"version_status"`
+	// BasePlanID is the first version of the plan, nil for the first version itself
+	BasePlanID *string `db:"base_plan_id" json:"base_plan_id,omitempty"`
+
 	types.BaseModel
 }
 
+// GetBasePlanID returns the ID of the first version of the plan
+func (p *Plan) GetBasePlanID() string {
+	return lo.FromPtrOr(p.BasePlanID, p.ID)
+}
+
+// GetVersion returns the version number, plans created before versioning are version 1
+func (p *Plan) GetVersion() int {
+	return lo.Ternary(p.Version > 0, p.Version, 1)
+}
+
+// GetVersionStatus returns the version status, plans created before versioning are published
+func (p *Plan) GetVersionStatus() types.PlanVersionStatus {
+	return lo.Ternary(p.VersionStatus != "", p.VersionStatus, types.PlanVersionStatusPublished)
+}
+
+// IsDraft reports whether the version is a draft
+func (p *Plan) IsDraft() bool {
+	return p.GetVersionStatus() == types.PlanVersionStatusDraft
+}
+
+// IsSubscribable reports whether new subscriptions can be created on the version
+func (p *Plan) IsSubscribable() bool {
+	return p.GetVersionStatus() == types.PlanVersionStatusPublished
+}
+
 // FromEnt converts an Ent Plan to a domain Plan
 func FromEnt(e *ent.Plan) *Plan {
 	if e == nil {
@@ -29,6 +63,9 @@ func FromEnt(e *ent.Plan) *Plan {
 		EnvironmentID: e.EnvironmentID,
 		Metadata:      types.Metadata(e.Metadata),
 		DisplayOrder:  &e.DisplayOrder,
+		Version:       e.Version,
+		VersionStatus: e.VersionStatus,
+		BasePlanID:    e.BasePlanID,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
@@ -105,6 +106,20 @@ type Price struct {
 
 	TransformQuantity JSONBTransformQuantity `db:"transform_quantity,jsonb" json:"transform_quantity"`
 
+	// TimeWindows are the time-of-use windows of a usage price. Usage within a window is
+	// charged at the window's unit amount, usage outside of all windows at the price itself.
+	TimeWindows []types.PriceTimeWindow `db:"time_windows,jsonb" json:"time_windows,omitempty"`
+
+	// CostPlus is the markup of a COST_PLUS price over the cost of its meter in the active costsheet
+	CostPlus *types.CostPlusConfig `db:"cost_plus,jsonb" json:"cost_plus,omitempty"`
+
+	// RolloverConfig carries the unused free tier usage of a billing period over into the following periods
+	RolloverConfig *types.RolloverConfig `db:"rollover_config,jsonb" json:"rollover_config,omitempty"`
+
+	// IncludedQuantity is the usage included for free in each billing period of a usage price. It is
+	// deducted from the usage before the billing model is applied.
+	IncludedQuantity decimal.Decimal `db:"included_quantity" json:"included_quantity"`
+
 	Metadata JSONBMetadata `db:"metadata,jsonb" json:"metadata"`
 
 	// EnvironmentID is the environment identifier for the price
@@ -210,6 +225,63 @@ func (pt *PriceTier) CalculateTierAmount(quantity decimal.Decimal, currency stri
 	return tierCost
 }
 
+// IsStairStep returns true for tiered prices that charge a flat amount per bracket
+func (p *Price) IsStairStep() bool {
+	return p.BillingModel == types.BILLING_MODEL_TIERED && p.TierMode == types.BILLING_TIER_STAIR_STEP
+}
+
+// StairStepBracketIndex returns the index of the tier the quantity falls into for stair-step
+// pricing, i.e. the tier with the lowest inclusive up_to covering the quantity. It does not
+// depend on the order of the tiers and returns -1 when no tier covers the quantity.
+func (p *Price) StairStepBracketIndex(quantity decimal.Decimal) int {
+	selected := -1
+	for i, tier := range p.Tiers {
+		if tier.UpTo != nil && quantity.GreaterThan(decimal.NewFromUint64(*tier.UpTo)) {
+			continue
+		}
+		if selected == -1 || tier.GetTierUpTo() < p.Tiers[selected].GetTierUpTo() {
+			selected = i
+		}
+	}
+	return selected
+}
+
+// CalculateStairStepAmount returns the flat amount of the bracket the quantity falls into.
+// A zero quantity is not charged.
+func (p *Price) CalculateStairStepAmount(quantity decimal.Decimal) decimal.Decimal {
+	if !quantity.IsPositive() {
+		return decimal.Zero
+	}
+	index := p.StairStepBracketIndex(quantity)
+	if index < 0 || p.Tiers[index].FlatAmount == nil {
+		return decimal.Zero
+	}
+	return *p.Tiers[index].FlatAmount
+}
+
+// FreeTierQuantity returns the usage included for free by the leading tiers of a SLAB or
+// STAIR_STEP price, i.e. the up_to of the last leading tier without unit and flat amount.
+// Other prices include no usage in their tiers.
+func (p *Price) FreeTierQuantity() decimal.Decimal {
+	included := decimal.Zero
+	if p.BillingModel != types.BILLING_MODEL_TIERED ||
+		(p.TierMode != types.BILLING_TIER_SLAB && p.TierMode != types.BILLING_TIER_STAIR_STEP) {
+		return included
+	}
+
+	tiers := append([]PriceTier(nil), p.Tiers...)
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].GetTierUpTo() < tiers[j].GetTierUpTo()
+	})
+	for _, tier := range tiers {
+		if tier.UpTo == nil || !tier.UnitAmount.IsZero() || (tier.FlatAmount != nil && !tier.FlatAmount.IsZero()) {
+			break
+		}
+		included = decimal.NewFromUint64(*tier.UpTo)
+	}
+	return included
+}
+
 func (pt *PriceTier) GetPerUnitCost() decimal.Decimal {
 	return pt.UnitAmount
 }
@@ -265,7 +337,7 @@ type PriceTier struct {
 	// up_to is the quantity up to which this tier applies. It is null for the last tier.
 	// IMPORTANT: Tier boundaries are INCLUSIVE.
 	// - If up_to is 1000, then quantity less than or equal to 1000 belongs to this tier
-	// - This behavior is consistent across both VOLUME and SLAB tier modes
+	// - This behavior is consistent across VOLUME, SLAB and STAIR_STEP tier modes
 	UpTo *uint64 `json:"up_to"`
 
 	// unit_amount is the amount per unit for the given tier
@@ -273,6 +345,7 @@ type PriceTier struct {
 
 	// flat_amount is the flat amount for the given tier (optional)
 	// Applied on top of unit_amount*quantity. Useful for cases like "2.7$ + 5c"
+	// For STAIR_STEP it is the price of the whole bracket and is required
 	FlatAmount *decimal.Decimal `json:"flat_amount,omitempty" swaggertype:"string"`
 }
 
@@ -429,6 +502,10 @@ func FromEnt(e *ent.Price) *Price {
 		LookupKey:              e.LookupKey,
 		Description:            e.Description,
 		TransformQuantity:      JSONBTransformQuantity(e.TransformQuantity),
+		TimeWindows:            e.TimeWindows,
+		CostPlus:               e.CostPlus,
+		RolloverConfig:         e.RolloverConfig,
+		IncludedQuantity:       e.IncludedQuantity,
 		Metadata:               JSONBMetadata(e.Metadata),
 		EnvironmentID:          e.EnvironmentID,
 		PriceUnitID:            e.PriceUnitID,
@@ -579,6 +656,72 @@ func (p *Price) IsAddonScoped() bool {
 	return p.EntityType == types.PRICE_ENTITY_TYPE_ADDON
 }
 
+// IsCustomerScoped checks if this price is a negotiated price from the rate card of a customer
+func (p *Price) IsCustomerScoped() bool {
+	return p.EntityType == types.PRICE_ENTITY_TYPE_CUSTOMER
+}
+
+// IsFeatureRate checks if this rate card price is keyed by a feature rather than by a price.
+// Feature rates replace the usage prices of the meter of the feature in any plan.
+func (p *Price) IsFeatureRate() bool {
+	return p.IsCustomerScoped() && p.ParentPriceID == ""
+}
+
+// ReplacesPrice checks if this rate card price replaces the given price. Rates keyed by price
+// replace that price, rates keyed by feature replace the usage prices of the same meter, currency
+// and billing period.
+func (p *Price) ReplacesPrice(other *Price) bool {
+	if !p.IsCustomerScoped() || !types.IsMatchingCurrency(p.Currency, other.Currency) {
+		return false
+	}
+	if !p.IsFeatureRate() {
+		return p.ParentPriceID == other.GetRootPriceID()
+	}
+	return other.Type == types.PRICE_TYPE_USAGE &&
+		other.MeterID == p.MeterID &&
+		other.BillingPeriod == p.BillingPeriod &&
+		other.BillingPeriodCount == p.BillingPeriodCount
+}
+
+// RateCardPriceFor returns p rated at the price from the rate card of a customer that replaces it
+// at t, or p when no rate card price applies. The returned price keeps the identity of p, so that
+// charges still match their line items, and records the rate in its metadata. Rates keyed by price
+// take precedence over rates keyed by feature and later rates over earlier ones. Subscription-scoped
+// prices are overrides negotiated for a single subscription and are not replaced.
+func RateCardPriceFor(rateCard []*Price, p *Price, t time.Time) *Price {
+	if p == nil || p.EntityType == types.PRICE_ENTITY_TYPE_SUBSCRIPTION || p.IsCustomerScoped() {
+		return p
+	}
+
+	var match *Price
+	for _, rate := range rateCard {
+		if !rate.IsActive(&t) || !rate.ReplacesPrice(p) {
+			continue
+		}
+		if match == nil ||
+			(match.IsFeatureRate() && !rate.IsFeatureRate()) ||
+			(match.IsFeatureRate() == rate.IsFeatureRate() && lo.FromPtr(rate.StartDate).After(lo.FromPtr(match.StartDate))) {
+			match = rate
+		}
+	}
+
+	if match == nil {
+		return p
+	}
+
+	rated := *p
+	rated.Amount = match.Amount
+	rated.DisplayAmount = match.DisplayAmount
+	rated.BillingModel = match.BillingModel
+	rated.TierMode = match.TierMode
+	rated.Tiers = match.Tiers
+	rated.TransformQuantity = match.TransformQuantity
+	rated.TimeWindows = match.TimeWindows
+	rated.CostPlus = match.CostPlus
+	rated.Metadata = lo.Assign(JSONBMetadata{}, p.Metadata, JSONBMetadata{"rate_card_price_id": match.ID})
+	return &rated
+}
+
 // HasParentPrice checks if this price has a parent price (for overrides)
 func (p *Price) HasParentPrice() bool {
 	return p.ParentPriceID != ""
@@ -91,6 +91,9 @@ func (c *calculatorImpl) Calculate(ctx context.Context, params ProrationParams)
 	shouldIssueCredit := c.shouldIssueCreditForAction(params, billingMode)
 
 	precision := types.GetCurrencyPrecision(params.Currency)
+	round := func(amount decimal.Decimal) decimal.Decimal {
+		return types.RoundDecimal(amount, precision, params.RoundingMode)
+	}
 
 	if shouldIssueCredit {
 		oldItemTotal := params.OldPricePerUnit.Mul(params.OldQuantity)
@@ -107,7 +110,7 @@ func (c *calculatorImpl) Calculate(ctx context.Context, params ProrationParams)
 		if creditAmount.GreaterThan(decimal.Zero) {
 			creditItem := ProrationLineItem{
 				Description: c.generateCreditDescription(params),
-				Amount:      creditAmount.Neg().Round(precision),
+				Amount:      round(creditAmount.Neg()),
 				StartDate:   params.ProrationDate,
 				EndDate:     params.CurrentPeriodEnd,
 				Quantity:    params.OldQuantity,
@@ -131,7 +134,7 @@ func (c *calculatorImpl) Calculate(ctx context.Context, params ProrationParams)
 		if proratedCharge.GreaterThan(decimal.Zero) {
 			chargeItem := ProrationLineItem{
 				Description: c.generateChargeDescription(params),
-				Amount:      proratedCharge.Round(precision),
+				Amount:      round(proratedCharge),
 				StartDate:   params.ProrationDate,
 				EndDate:     params.CurrentPeriodEnd,
 				Quantity:    params.NewQuantity,
@@ -144,7 +147,7 @@ func (c *calculatorImpl) Calculate(ctx context.Context, params ProrationParams)
 	}
 
 	// Round the final net amount according to currency precision
-	result.NetAmount = result.NetAmount.Round(precision)
+	result.NetAmount = round(result.NetAmount)
 
 	c.logger.Infof("proration net amount: %s", result.NetAmount)
 
@@ -42,6 +42,7 @@ type ProrationParams struct {
 	PreviousCreditsIssued decimal.Decimal         `swaggertype:"string"` // Sum of credits already issued against OriginalAmountPaid in this period
 	ProrationStrategy     types.ProrationStrategy // Strategy to use for proration
 	Currency              string                  // Currency of the proration
+	RoundingMode          types.RoundingMode      // Rounding mode of prorated amounts (half_up when empty)
 
 	// Cancellation-specific fields
 	CancellationType   types.CancellationType // immediate vs end_of_period
@@ -8,6 +8,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // Service defines the operations for handling proration.
@@ -37,6 +38,19 @@ type Service interface {
 		behavior types.ProrationBehavior,
 	) (ProrationParams, error)
 
+	// CalculateQuantityChangeProration calculates the prorated credit for the previous quantity
+	// and the prorated charge for the new quantity of a line item from the effective date
+	// until the end of the current period. Credits are only issued for items billed in advance.
+	CalculateQuantityChangeProration(
+		ctx context.Context,
+		subscription *subscription.Subscription,
+		item *subscription.SubscriptionLineItem,
+		price *price.Price,
+		newQuantity decimal.Decimal,
+		effectiveDate time.Time,
+		behavior types.ProrationBehavior,
+	) (*ProrationResult, error)
+
 	// CalculateSubscriptionProration handles proration for an entire subscription.
 	// This is used when creating or modifying a subscription that needs proration
 	// (e.g., calendar billing with proration enabled).
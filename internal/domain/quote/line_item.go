@@ -0,0 +1,78 @@
+package quote
+
+import (
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// LineItem is a price of the plan quoted to the customer
+type LineItem struct {
+	ID          string          `json:"id"`
+	QuoteID     string          `json:"quote_id"`
+	PriceID     string          `json:"price_id"`
+	DisplayName string          `json:"display_name"`
+	Quantity    decimal.Decimal `json:"quantity" swaggertype:"string"`
+	// UnitAmount is the quoted amount per unit, the amount of the price applies when not set
+	UnitAmount         *decimal.Decimal `json:"unit_amount,omitempty" swaggertype:"string"`
+	DiscountPercentage decimal.Decimal  `json:"discount_percentage" swaggertype:"string"`
+	// Amount is the amount of the line item for a billing period after its discount
+	Amount decimal.Decimal `json:"amount" swaggertype:"string"`
+	// PhaseIndex is the phase of the quote the line item applies to, it applies to all phases when not set
+	PhaseIndex    *int              `json:"phase_index,omitempty"`
+	Currency      string            `json:"currency"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	EnvironmentID string            `json:"environment_id"`
+
+	types.BaseModel
+}
+
+// HasOverride returns true if the line item changes the amount of its price
+func (li *LineItem) HasOverride() bool {
+	return li.UnitAmount != nil || !li.DiscountPercentage.IsZero()
+}
+
+// DiscountedUnitAmount returns the amount per unit after the discount of the line item
+func (li *LineItem) DiscountedUnitAmount(unitAmount decimal.Decimal) decimal.Decimal {
+	if li.DiscountPercentage.IsZero() {
+		return unitAmount
+	}
+	hundred := decimal.NewFromInt(100)
+	return unitAmount.Mul(hundred.Sub(li.DiscountPercentage)).Div(hundred)
+}
+
+func LineItemFromEnt(e *ent.QuoteLineItem) *LineItem {
+	if e == nil {
+		return nil
+	}
+
+	return &LineItem{
+		ID:                 e.ID,
+		QuoteID:            e.QuoteID,
+		PriceID:            e.PriceID,
+		DisplayName:        e.DisplayName,
+		Quantity:           e.Quantity,
+		UnitAmount:         e.UnitAmount,
+		DiscountPercentage: e.DiscountPercentage,
+		Amount:             e.Amount,
+		PhaseIndex:         e.PhaseIndex,
+		Currency:           e.Currency,
+		Metadata:           e.Metadata,
+		EnvironmentID:      e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+func LineItemsFromEnt(ents []*ent.QuoteLineItem) []*LineItem {
+	return lo.Map(ents, func(e *ent.QuoteLineItem, _ int) *LineItem {
+		return LineItemFromEnt(e)
+	})
+}
@@ -0,0 +1,144 @@
+package quote
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// Quote proposes a subscription to a customer. Once the customer accepts it, the quote is converted
+// into a subscription on its plan with the quoted line items, coupons and phases.
+type Quote struct {
+	ID          string            `json:"id"`
+	QuoteNumber *string           `json:"quote_number,omitempty"`
+	CustomerID  string            `json:"customer_id"`
+	PlanID      string            `json:"plan_id"`
+	Currency    string            `json:"currency"`
+	QuoteStatus types.QuoteStatus `json:"quote_status"`
+	Description string            `json:"description,omitempty"`
+
+	BillingCadence     types.BillingCadence `json:"billing_cadence"`
+	BillingPeriod      types.BillingPeriod  `json:"billing_period"`
+	BillingPeriodCount int                  `json:"billing_period_count"`
+	BillingCycle       types.BillingCycle   `json:"billing_cycle"`
+
+	// StartDate backdates the subscription, it starts on acceptance when not set
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// ValidUntil is the last moment the quote can be accepted
+	ValidUntil time.Time          `json:"valid_until"`
+	Coupons    []string           `json:"coupons,omitempty"`
+	Phases     []types.QuotePhase `json:"phases,omitempty"`
+
+	// Subtotal, TotalDiscount and Total are the recurring amounts of the first billing period
+	Subtotal      decimal.Decimal `json:"subtotal" swaggertype:"string"`
+	TotalDiscount decimal.Decimal `json:"total_discount" swaggertype:"string"`
+	Total         decimal.Decimal `json:"total" swaggertype:"string"`
+
+	FinalizedAt   *time.Time `json:"finalized_at,omitempty"`
+	AcceptedAt    *time.Time `json:"accepted_at,omitempty"`
+	DeclinedAt    *time.Time `json:"declined_at,omitempty"`
+	DeclineReason string     `json:"decline_reason,omitempty"`
+	// SubscriptionID is the subscription the quote was converted into
+	SubscriptionID *string `json:"subscription_id,omitempty"`
+
+	LineItems     []*LineItem       `json:"line_items,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	EnvironmentID string            `json:"environment_id"`
+
+	types.BaseModel
+}
+
+// IsExpired returns true if the quote can no longer be accepted at the given time
+func (q *Quote) IsExpired(at time.Time) bool {
+	return q.QuoteStatus == types.QuoteStatusExpired ||
+		(q.QuoteStatus == types.QuoteStatusOpen && at.After(q.ValidUntil))
+}
+
+// LineItemsForPhase returns the line items billed during a phase of the quote. Line items assigned
+// to the phase replace the line item of the same price which applies to all phases.
+func (q *Quote) LineItemsForPhase(index int) []*LineItem {
+	phaseItems := lo.SliceToMap(lo.Filter(q.LineItems, func(item *LineItem, _ int) bool {
+		return item.PhaseIndex != nil && *item.PhaseIndex == index
+	}), func(item *LineItem) (string, *LineItem) {
+		return item.PriceID, item
+	})
+
+	items := make([]*LineItem, 0, len(q.LineItems))
+	for _, item := range q.LineItems {
+		if item.PhaseIndex != nil {
+			continue
+		}
+		if phaseItem, ok := phaseItems[item.PriceID]; ok {
+			items = append(items, phaseItem)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// AmountForPhase returns the amount of the line items of a phase for a billing period
+func (q *Quote) AmountForPhase(index int) decimal.Decimal {
+	amount := decimal.Zero
+	for _, item := range q.LineItemsForPhase(index) {
+		amount = amount.Add(item.Amount)
+	}
+	return amount
+}
+
+func FromEnt(e *ent.Quote) *Quote {
+	if e == nil {
+		return nil
+	}
+
+	q := &Quote{
+		ID:                 e.ID,
+		QuoteNumber:        e.QuoteNumber,
+		CustomerID:         e.CustomerID,
+		PlanID:             e.PlanID,
+		Currency:           e.Currency,
+		QuoteStatus:        e.QuoteStatus,
+		Description:        e.Description,
+		BillingCadence:     e.BillingCadence,
+		BillingPeriod:      e.BillingPeriod,
+		BillingPeriodCount: e.BillingPeriodCount,
+		BillingCycle:       e.BillingCycle,
+		StartDate:          e.StartDate,
+		ValidUntil:         e.ValidUntil,
+		Coupons:            e.Coupons,
+		Phases:             e.Phases,
+		Subtotal:           e.Subtotal,
+		TotalDiscount:      e.TotalDiscount,
+		Total:              e.Total,
+		FinalizedAt:        e.FinalizedAt,
+		AcceptedAt:         e.AcceptedAt,
+		DeclinedAt:         e.DeclinedAt,
+		DeclineReason:      e.DeclineReason,
+		SubscriptionID:     e.SubscriptionID,
+		Metadata:           e.Metadata,
+		EnvironmentID:      e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+
+	if e.Edges.LineItems != nil {
+		q.LineItems = LineItemsFromEnt(e.Edges.LineItems)
+	}
+
+	return q
+}
+
+func FromEntList(ents []*ent.Quote) []*Quote {
+	return lo.Map(ents, func(e *ent.Quote, _ int) *Quote {
+		return FromEnt(e)
+	})
+}
@@ -0,0 +1,22 @@
+package quote
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// Repository defines the interface for quote repository operations
+type Repository interface {
+	// CreateWithLineItems creates a quote together with its line items
+	CreateWithLineItems(ctx context.Context, quote *Quote) error
+	// Get returns a quote with its line items
+	Get(ctx context.Context, id string) (*Quote, error)
+	// Update updates a quote without its line items
+	Update(ctx context.Context, quote *Quote) error
+	// ReplaceLineItems replaces the line items of a quote with the line items set on it
+	ReplaceLineItems(ctx context.Context, quote *Quote) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *types.QuoteFilter) ([]*Quote, error)
+	Count(ctx context.Context, filter *types.QuoteFilter) (int, error)
+}
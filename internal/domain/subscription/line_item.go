@@ -44,6 +44,16 @@ type SubscriptionLineItem struct {
 	CommitmentTrueUpEnabled bool                 `db:"commitment_true_up_enabled" json:"commitment_true_up_enabled"`
 	CommitmentWindowed      bool                 `db:"commitment_windowed" json:"commitment_windowed"`
 
+	// QuantityChanges is the quantity history of the line item
+	QuantityChanges []types.LineItemQuantityChange `db:"quantity_changes" json:"quantity_changes,omitempty"`
+
+	// IncludedQuantity is the usage included for free in each billing period, deducted before the
+	// usage is rated
+	IncludedQuantity decimal.Decimal `db:"included_quantity" json:"included_quantity"`
+
+	// Rollover tracks the unused included usage carried over from previous periods
+	Rollover *types.LineItemRollover `db:"rollover" json:"rollover,omitempty"`
+
 	Price *price.Price `json:"price,omitempty"`
 
 	types.BaseModel
@@ -86,6 +96,32 @@ func (li *SubscriptionLineItem) GetCommitmentType() types.CommitmentType {
 	return li.CommitmentType
 }
 
+// HasQuantityChanges returns true if the quantity of the line item changed or is scheduled to change
+func (li *SubscriptionLineItem) HasQuantityChanges() bool {
+	return len(li.QuantityChanges) > 0
+}
+
+// QuantityAt returns the quantity of the line item in effect at t
+func (li *SubscriptionLineItem) QuantityAt(t time.Time) decimal.Decimal {
+	return types.QuantityAt(li.Quantity, li.QuantityChanges, t)
+}
+
+// QuantitySegments splits the given period into segments of constant quantity
+func (li *SubscriptionLineItem) QuantitySegments(periodStart, periodEnd time.Time) []types.QuantitySegment {
+	return types.QuantitySegments(li.Quantity, li.QuantityChanges, periodStart, periodEnd)
+}
+
+// ScheduledQuantityChanges returns the indexes of the scheduled quantity changes
+func (li *SubscriptionLineItem) ScheduledQuantityChanges() []int {
+	indexes := make([]int, 0)
+	for i, change := range li.QuantityChanges {
+		if change.Status == types.QuantityChangeStatusScheduled {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
 // FromEntList converts a list of Ent SubscriptionLineItems to domain SubscriptionLineItems
 func GetLineItemFromEntList(list []*ent.SubscriptionLineItem) []*SubscriptionLineItem {
 	if list == nil {
@@ -165,6 +201,9 @@ func SubscriptionLineItemFromEnt(e *ent.SubscriptionLineItem) *SubscriptionLineI
 		CommitmentOverageFactor: e.CommitmentOverageFactor,
 		CommitmentTrueUpEnabled: e.CommitmentTrueUpEnabled,
 		CommitmentWindowed:      e.CommitmentWindowed,
+		QuantityChanges:         e.QuantityChanges,
+		Rollover:                e.Rollover,
+		IncludedQuantity:        e.IncludedQuantity,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -0,0 +1,85 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// MigrationResult is the outcome of the migration of a single subscription of a bulk subscription
+// migration, it is pending until the subscription is processed
+type MigrationResult struct {
+	// ID is the unique identifier of the result
+	ID string `db:"id" json:"id"`
+
+	// TaskID is the migration task of the result
+	TaskID string `db:"task_id" json:"task_id"`
+
+	// SubscriptionID is the subscription selected for the migration
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+
+	// CustomerID is the customer of the subscription
+	CustomerID string `db:"customer_id" json:"customer_id,omitempty"`
+
+	// MigrationStatus is the outcome of the migration of the subscription
+	MigrationStatus types.SubscriptionMigrationStatus `db:"migration_status" json:"migration_status"`
+
+	// NewSubscriptionID is the subscription on the target plan of immediate migrations
+	NewSubscriptionID *string `db:"new_subscription_id" json:"new_subscription_id,omitempty"`
+
+	// ScheduleID is the plan change schedule of end of period migrations
+	ScheduleID *string `db:"schedule_id" json:"schedule_id,omitempty"`
+
+	// Error is why the subscription could not be migrated
+	Error *string `db:"error" json:"error,omitempty"`
+
+	// ProcessedAt is when the subscription was processed
+	ProcessedAt *time.Time `db:"processed_at" json:"processed_at,omitempty"`
+
+	// EnvironmentID is the environment identifier of the result
+	EnvironmentID string `db:"environment_id" json:"environment_id"`
+
+	types.BaseModel
+}
+
+// IsProcessed returns true once the subscription of the result has been processed
+func (r *MigrationResult) IsProcessed() bool {
+	return r.MigrationStatus != types.SubscriptionMigrationStatusPending
+}
+
+// MigrationResultFromEnt converts an ent.SubscriptionMigrationResult to domain MigrationResult
+func MigrationResultFromEnt(e *ent.SubscriptionMigrationResult) *MigrationResult {
+	if e == nil {
+		return nil
+	}
+
+	return &MigrationResult{
+		ID:                e.ID,
+		TaskID:            e.TaskID,
+		SubscriptionID:    e.SubscriptionID,
+		CustomerID:        e.CustomerID,
+		MigrationStatus:   e.MigrationStatus,
+		NewSubscriptionID: e.NewSubscriptionID,
+		ScheduleID:        e.ScheduleID,
+		Error:             e.Error,
+		ProcessedAt:       e.ProcessedAt,
+		EnvironmentID:     e.EnvironmentID,
+		BaseModel: types.BaseModel{
+			TenantID:  e.TenantID,
+			Status:    types.Status(e.Status),
+			CreatedAt: e.CreatedAt,
+			UpdatedAt: e.UpdatedAt,
+			CreatedBy: e.CreatedBy,
+			UpdatedBy: e.UpdatedBy,
+		},
+	}
+}
+
+// MigrationResultFromEntList converts a list of ent.SubscriptionMigrationResult to domain MigrationResult
+func MigrationResultFromEntList(ents []*ent.SubscriptionMigrationResult) []*MigrationResult {
+	return lo.Map(ents, func(e *ent.SubscriptionMigrationResult, _ int) *MigrationResult {
+		return MigrationResultFromEnt(e)
+	})
+}
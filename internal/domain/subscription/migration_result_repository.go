@@ -0,0 +1,22 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// MigrationResultRepository defines the interface for subscription migration result operations
+type MigrationResultRepository interface {
+	// CreateBulk creates the pending results of the subscriptions selected for a migration
+	CreateBulk(ctx context.Context, results []*MigrationResult) error
+
+	// Update saves the outcome of the migration of a subscription
+	Update(ctx context.Context, result *MigrationResult) error
+
+	// List retrieves the results of a migration based on filter
+	List(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) ([]*MigrationResult, error)
+
+	// Count returns the count of the results of a migration matching the filter
+	Count(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) (int, error)
+}
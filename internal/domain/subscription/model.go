@@ -1,6 +1,7 @@
 package subscription
 
 import (
+	"sort"
 	"time"
 
 	"github.com/flexprice/flexprice/ent"
@@ -124,9 +125,165 @@ type Subscription struct {
 	// This can differ from the subscription customer (e.g., parent company invoicing for child company)
 	InvoicingCustomerID *string `db:"invoicing_customer_id" json:"invoicing_customer_id,omitempty"`
 
+	// BillingThresholdAmount is the amount of accrued current period charges above which an
+	// interim invoice is generated. Thresholds are disabled when nil.
+	BillingThresholdAmount *decimal.Decimal `db:"billing_threshold_amount" json:"billing_threshold_amount,omitempty" swaggertype:"string"`
+
+	// TrialEndAction is the action taken when the trial of the subscription ends
+	TrialEndAction types.TrialEndAction `db:"trial_end_action" json:"trial_end_action,omitempty"`
+
+	// TrialReminderDays is the number of days before the end of the trial the trial will end
+	// webhook is sent. Reminders are disabled when 0.
+	TrialReminderDays int `db:"trial_reminder_days" json:"trial_reminder_days,omitempty"`
+
+	// TrialUsageCaps is the usage allowed per meter ID during the trial. The trial ends early
+	// when the usage of any meter reaches its cap.
+	TrialUsageCaps map[string]decimal.Decimal `db:"trial_usage_caps" json:"trial_usage_caps,omitempty" swaggertype:"object,string"`
+
+	// BackdatedBillingMode determines how the billing periods that ended before the subscription
+	// was created are invoiced
+	BackdatedBillingMode types.BackdatedBillingMode `db:"backdated_billing_mode" json:"backdated_billing_mode,omitempty"`
+
+	// BackdatedBillingPending tells whether the missed periods of a backdated subscription are
+	// being billed, the billing cron skips the subscription until they are
+	BackdatedBillingPending bool `db:"backdated_billing_pending" json:"backdated_billing_pending,omitempty"`
+
+	// SpendLimitState tells whether features of the subscription are denied because the prepaid
+	// wallet balance of the customer reached the spend limit floor
+	SpendLimitState types.SpendLimitState `db:"spend_limit_state" json:"spend_limit_state,omitempty"`
+
+	// SpendLimitedAt is the time the subscription was last limited by the spend limit
+	SpendLimitedAt *time.Time `db:"spend_limited_at" json:"spend_limited_at,omitempty"`
+
+	// TermMonths is the length in months of the contract term. Subscriptions without a term,
+	// the default, renew indefinitely.
+	TermMonths int `db:"term_months" json:"term_months,omitempty"`
+
+	// AutoRenew starts a new term when the current term ends, the subscription is cancelled at
+	// the end of its term otherwise
+	AutoRenew bool `db:"auto_renew" json:"auto_renew"`
+
+	// RenewalNoticeDays is the number of days before the end of the term after which the
+	// renewal can no longer be turned off
+	RenewalNoticeDays int `db:"renewal_notice_days" json:"renewal_notice_days,omitempty"`
+
+	// RenewalPlanID is the plan the subscription renews to at the end of the current term,
+	// the subscription renews on its current plan when nil
+	RenewalPlanID *string `db:"renewal_plan_id" json:"renewal_plan_id,omitempty"`
+
+	// CurrentTermStart is the start of the current contract term
+	CurrentTermStart *time.Time `db:"current_term_start" json:"current_term_start,omitempty"`
+
+	// CurrentTermEnd is the end of the current contract term
+	CurrentTermEnd *time.Time `db:"current_term_end" json:"current_term_end,omitempty"`
+
 	types.BaseModel
 }
 
+// IsTrialing returns true if the subscription is in its trial
+func (s *Subscription) IsTrialing() bool {
+	return s.SubscriptionStatus == types.SubscriptionStatusTrialing && s.TrialEnd != nil
+}
+
+// IsDelinquent returns true if the subscription is past due or unpaid because of a failed payment
+func (s *Subscription) IsDelinquent() bool {
+	return s.SubscriptionStatus == types.SubscriptionStatusPastDue || s.SubscriptionStatus == types.SubscriptionStatusUnpaid
+}
+
+// IsSpendLimited returns true if features of the subscription are denied by the spend limit of its customer
+func (s *Subscription) IsSpendLimited() bool {
+	return s.SpendLimitState.IsLimited()
+}
+
+// HasTerm returns true if the subscription is a fixed-term contract
+func (s *Subscription) HasTerm() bool {
+	return s.TermMonths > 0 && s.CurrentTermEnd != nil
+}
+
+// StartTerm starts a new contract term at the given time, it clears the term of subscriptions
+// without a term length. The end of the term is computed like the end of a monthly billing period
+// anchored at the start of the term, so that terms line up with anniversary billing periods.
+func (s *Subscription) StartTerm(start time.Time) {
+	if s.TermMonths <= 0 {
+		s.CurrentTermStart = nil
+		s.CurrentTermEnd = nil
+		return
+	}
+	end, err := types.NextBillingDate(start, start, s.TermMonths, types.BILLING_PERIOD_MONTHLY, nil)
+	if err != nil {
+		end = start.AddDate(0, s.TermMonths, 0)
+	}
+	s.CurrentTermStart = lo.ToPtr(start)
+	s.CurrentTermEnd = lo.ToPtr(end)
+}
+
+// NonRenewalDeadline returns the last time the renewal of the current term can be turned off,
+// nil for subscriptions without a term
+func (s *Subscription) NonRenewalDeadline() *time.Time {
+	if !s.HasTerm() {
+		return nil
+	}
+	return lo.ToPtr(s.CurrentTermEnd.AddDate(0, 0, -s.RenewalNoticeDays))
+}
+
+// RenewalDueAt returns when the renewal of the subscription is due, the non-renewal deadline
+// for subscriptions with a term and the end of the current period otherwise
+func (s *Subscription) RenewalDueAt() time.Time {
+	if deadline := s.NonRenewalDeadline(); deadline != nil {
+		return *deadline
+	}
+	return s.CurrentPeriodEnd
+}
+
+// IsInFinalTermPeriod returns true if the current billing period is the last one of the term
+func (s *Subscription) IsInFinalTermPeriod() bool {
+	return s.HasTerm() && !s.CurrentPeriodEnd.Before(*s.CurrentTermEnd)
+}
+
+// IsTermEnded returns true if the current billing period starts at or after the end of the term
+func (s *Subscription) IsTermEnded() bool {
+	return s.HasTerm() && !s.CurrentPeriodStart.Before(*s.CurrentTermEnd)
+}
+
+// RenewsToPlanAt returns true if the subscription is replaced by the subscription on its renewal
+// plan at the given time, which bills the periods starting then
+func (s *Subscription) RenewsToPlanAt(t time.Time) bool {
+	return s.HasTerm() && s.RenewalAction() == types.SubscriptionRenewalActionRenewToPlan && !t.Before(*s.CurrentTermEnd)
+}
+
+// RenewalAction returns what happens to the subscription at the end of its current term
+func (s *Subscription) RenewalAction() types.SubscriptionRenewalAction {
+	switch {
+	case !s.AutoRenew:
+		return types.SubscriptionRenewalActionCancel
+	case s.RenewalPlanID != nil && *s.RenewalPlanID != s.PlanID:
+		return types.SubscriptionRenewalActionRenewToPlan
+	default:
+		return types.SubscriptionRenewalActionRenew
+	}
+}
+
+// TrialReminderAt returns the time the trial will end reminder is due, nil if reminders are disabled
+func (s *Subscription) TrialReminderAt() *time.Time {
+	if s.TrialEnd == nil || s.TrialReminderDays <= 0 {
+		return nil
+	}
+	return lo.ToPtr(s.TrialEnd.AddDate(0, 0, -s.TrialReminderDays))
+}
+
+// ExceededTrialUsageCap returns the ID of the first meter, in meter ID order, whose trial usage
+// reached its cap and false if no cap was reached
+func (s *Subscription) ExceededTrialUsageCap(usage map[string]decimal.Decimal) (string, bool) {
+	meterIDs := lo.Keys(s.TrialUsageCaps)
+	sort.Strings(meterIDs)
+	for _, meterID := range meterIDs {
+		if quantity, ok := usage[meterID]; ok && quantity.GreaterThanOrEqual(s.TrialUsageCaps[meterID]) {
+			return meterID, true
+		}
+	}
+	return "", false
+}
+
 // GetInvoicingCustomerID returns the invoicing customer ID if available, otherwise falls back to the subscription customer ID.
 // This provides backward compatibility for subscriptions that don't have an invoicing customer ID set.
 func (s *Subscription) GetInvoicingCustomerID() string {
@@ -206,6 +363,21 @@ func GetSubscriptionFromEnt(sub *ent.Subscription) *Subscription {
 		ProrationBehavior:   types.ProrationBehavior(sub.ProrationBehavior),
 		EnableTrueUp:        sub.EnableTrueUp,
 		InvoicingCustomerID: sub.InvoicingCustomerID,
+
+		BillingThresholdAmount:  sub.BillingThresholdAmount,
+		TrialEndAction:          sub.TrialEndAction,
+		TrialReminderDays:       sub.TrialReminderDays,
+		TrialUsageCaps:          sub.TrialUsageCaps,
+		BackdatedBillingMode:    sub.BackdatedBillingMode,
+		BackdatedBillingPending: sub.BackdatedBillingPending,
+		SpendLimitState:         sub.SpendLimitState,
+		SpendLimitedAt:          sub.SpendLimitedAt,
+		TermMonths:              sub.TermMonths,
+		AutoRenew:               sub.AutoRenew,
+		RenewalNoticeDays:       sub.RenewalNoticeDays,
+		RenewalPlanID:           sub.RenewalPlanID,
+		CurrentTermStart:        sub.CurrentTermStart,
+		CurrentTermEnd:          sub.CurrentTermEnd,
 		BaseModel: types.BaseModel{
 			TenantID:  sub.TenantID,
 			Status:    types.Status(sub.Status),
@@ -19,6 +19,9 @@ type SubscriptionPause struct {
 	PauseMode   types.PauseMode   `db:"pause_mode" json:"pause_mode"`
 	ResumeMode  types.ResumeMode  `db:"resume_mode" json:"resume_mode,omitempty"`
 
+	// BillingMode is what is billed while the subscription is paused
+	BillingMode types.PauseBillingMode `db:"billing_mode" json:"billing_mode"`
+
 	// PauseStart is when the pause actually started
 	PauseStart time.Time `db:"pause_start" json:"pause_start"`
 
@@ -57,6 +60,7 @@ func SubscriptionPauseFromEnt(p *ent.SubscriptionPause) *SubscriptionPause {
 		PauseStatus:         types.PauseStatus(p.PauseStatus),
 		PauseMode:           types.PauseMode(p.PauseMode),
 		ResumeMode:          types.ResumeMode(p.ResumeMode),
+		BillingMode:         types.PauseBillingMode(p.BillingMode),
 		PauseStart:          p.PauseStart,
 		PauseEnd:            p.PauseEnd,
 		ResumedAt:           p.ResumedAt,
@@ -88,3 +92,26 @@ func SubscriptionPauseListFromEnt(pauses []*ent.SubscriptionPause) []*Subscripti
 	}
 	return result
 }
+
+// BillsFixedFees returns true if the fixed fees of the subscription keep being billed during the pause
+func (p *SubscriptionPause) BillsFixedFees() bool {
+	return p.BillingMode == types.PauseBillingModeFixedFeesOnly
+}
+
+// EffectiveEnd returns when the pause stops being in effect, the time it was resumed at or its
+// scheduled end otherwise. Nil means the pause has no end yet.
+func (p *SubscriptionPause) EffectiveEnd() *time.Time {
+	if p.ResumedAt != nil {
+		return p.ResumedAt
+	}
+	return p.PauseEnd
+}
+
+// Covers returns true if the pause is in effect at t
+func (p *SubscriptionPause) Covers(t time.Time) bool {
+	if t.Before(p.PauseStart) {
+		return false
+	}
+	end := p.EffectiveEnd()
+	return end == nil || t.Before(*end)
+}
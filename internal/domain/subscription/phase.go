@@ -5,6 +5,8 @@ import (
 
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // SubscriptionPhase represents a phase in a subscription lifecycle
@@ -21,6 +23,15 @@ type SubscriptionPhase struct {
 	// EndDate is when the phase ends (nil if phase is still active or indefinite)
 	EndDate *time.Time `db:"end_date" json:"end_date,omitempty"`
 
+	// CommitmentAmount is the minimum spend of the phase per year, nil when the phase has no commitment
+	CommitmentAmount *decimal.Decimal `db:"commitment_amount" json:"commitment_amount,omitempty" swaggertype:"string"`
+
+	// OverageFactor is a multiplier applied to spend beyond the commitment of a commitment period
+	OverageFactor *decimal.Decimal `db:"overage_factor" json:"overage_factor,omitempty" swaggertype:"string"`
+
+	// CommitmentTrueUpCadence is how often spend below the commitment is trued up
+	CommitmentTrueUpCadence *types.CommitmentTrueUpCadence `db:"commitment_true_up_cadence" json:"commitment_true_up_cadence,omitempty"`
+
 	// Metadata contains additional key-value pairs
 	Metadata types.Metadata `db:"metadata" json:"metadata,omitempty"`
 
@@ -37,12 +48,15 @@ func SubscriptionPhaseFromEnt(e *ent.SubscriptionPhase) *SubscriptionPhase {
 	}
 
 	return &SubscriptionPhase{
-		ID:             e.ID,
-		SubscriptionID: e.SubscriptionID,
-		StartDate:      e.StartDate,
-		EndDate:        e.EndDate,
-		Metadata:       e.Metadata,
-		EnvironmentID:  e.EnvironmentID,
+		ID:                      e.ID,
+		SubscriptionID:          e.SubscriptionID,
+		StartDate:               e.StartDate,
+		EndDate:                 e.EndDate,
+		Metadata:                e.Metadata,
+		CommitmentAmount:        e.CommitmentAmount,
+		OverageFactor:           e.OverageFactor,
+		CommitmentTrueUpCadence: (*types.CommitmentTrueUpCadence)(e.CommitmentTrueUpCadence),
+		EnvironmentID:           e.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  e.TenantID,
 			Status:    types.Status(e.Status),
@@ -84,3 +98,81 @@ func (sp *SubscriptionPhase) IsActive(t time.Time) bool {
 	}
 	return true
 }
+
+// CommitmentPeriod is a period over which the commitment of a phase is drawn down and trued up
+type CommitmentPeriod struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// Amount is the commitment of the period, prorated when the phase ends before the period does
+	Amount decimal.Decimal
+}
+
+// HasCommitment returns true if the phase carries a commitment
+func (sp *SubscriptionPhase) HasCommitment() bool {
+	return sp.CommitmentAmount != nil && sp.CommitmentAmount.IsPositive() && sp.CommitmentTrueUpCadence != nil
+}
+
+// GetOverageFactor returns the overage factor of the phase, 1 when it is not set
+func (sp *SubscriptionPhase) GetOverageFactor() decimal.Decimal {
+	if sp.OverageFactor == nil {
+		return decimal.NewFromInt(1)
+	}
+	return *sp.OverageFactor
+}
+
+// CommitmentPeriods returns the commitment periods of the phase starting on or before until.
+// Periods follow the true-up cadence from the start of the phase, each committing to the
+// annual commitment divided by the number of periods per year.
+func (sp *SubscriptionPhase) CommitmentPeriods(until time.Time) ([]*CommitmentPeriod, error) {
+	if !sp.HasCommitment() {
+		return nil, nil
+	}
+
+	cadence := lo.FromPtr(sp.CommitmentTrueUpCadence)
+	fullAmount := sp.CommitmentAmount.Div(decimal.NewFromInt(cadence.PeriodsPerYear()))
+
+	periods := make([]*CommitmentPeriod, 0)
+	start := sp.StartDate
+	for !start.After(until) && (sp.EndDate == nil || start.Before(*sp.EndDate)) {
+		fullEnd, err := types.NextBillingDate(start, sp.StartDate, 1, cadence.BillingPeriod(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		period := &CommitmentPeriod{
+			StartDate: start,
+			EndDate:   fullEnd,
+			Amount:    fullAmount,
+		}
+		if sp.EndDate != nil && sp.EndDate.Before(fullEnd) {
+			// The last period of the phase commits to the share of the period it covers
+			covered := decimal.NewFromFloat(sp.EndDate.Sub(start).Seconds())
+			full := decimal.NewFromFloat(fullEnd.Sub(start).Seconds())
+			period.EndDate = *sp.EndDate
+			period.Amount = fullAmount.Mul(covered).Div(full)
+		}
+
+		periods = append(periods, period)
+		start = period.EndDate
+	}
+	return periods, nil
+}
+
+// CommitmentPeriodAt returns the commitment period of the phase containing t, nil when the
+// phase has no commitment or t is outside of the phase
+func (sp *SubscriptionPhase) CommitmentPeriodAt(t time.Time) (*CommitmentPeriod, error) {
+	if t.Before(sp.StartDate) {
+		return nil, nil
+	}
+
+	periods, err := sp.CommitmentPeriods(t)
+	if err != nil || len(periods) == 0 {
+		return nil, err
+	}
+
+	period := periods[len(periods)-1]
+	if !t.Before(period.EndDate) {
+		return nil, nil
+	}
+	return period, nil
+}
@@ -14,6 +14,7 @@ type Scope string
 const (
 	ScopeSubscriptionInvoice Scope = "subscription_invoice"
 	ScopeOneOffInvoice       Scope = "one_off_invoice"
+	ScopeThresholdInvoice    Scope = "threshold_invoice"
 
 	// Credit Grant
 	ScopeCreditGrant Scope = "credit_grant"
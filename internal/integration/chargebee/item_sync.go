@@ -21,6 +21,7 @@ import (
 	"github.com/flexprice/flexprice/internal/logger"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // ChargebeeItemFamilyService defines the interface for Chargebee item family operations
@@ -547,9 +548,13 @@ func mapPricingModel(p *price.Price) string {
 
 	case types.BILLING_MODEL_TIERED:
 		// Tiered with VOLUME mode → Chargebee "volume"
+		// Tiered with STAIR_STEP mode → Chargebee "stairstep"
 		// Tiered with SLAB mode → Chargebee "tiered"
-		if p.TierMode == types.BILLING_TIER_VOLUME {
+		switch p.TierMode {
+		case types.BILLING_TIER_VOLUME:
 			return "volume"
+		case types.BILLING_TIER_STAIR_STEP:
+			return "stairstep"
 		}
 		return "tiered"
 
@@ -566,8 +571,9 @@ func mapPricingModel(p *price.Price) string {
 	}
 }
 
-// convertTiersForChargebee converts FlexPrice tiers to Chargebee tier format
-func convertTiersForChargebee(flexPriceTiers []*types.PriceTier, currency string) []ChargebeeTier {
+// convertTiersForChargebee converts FlexPrice tiers to Chargebee tier format.
+// A Chargebee stairstep tier is priced with the flat amount of the whole step.
+func convertTiersForChargebee(flexPriceTiers []*types.PriceTier, tierMode types.BillingTier, currency string) []ChargebeeTier {
 	if len(flexPriceTiers) == 0 {
 		return nil
 	}
@@ -576,8 +582,12 @@ func convertTiersForChargebee(flexPriceTiers []*types.PriceTier, currency string
 	startingUnit := int64(1) // Chargebee requires starting_unit to be at least 1
 
 	for i, tier := range flexPriceTiers {
-		// Convert unit amount to smallest currency unit
-		priceInt := convertAmountToSmallestUnit(tier.UnitAmount.InexactFloat64(), currency)
+		// Convert unit amount (flat amount for stair steps) to smallest currency unit
+		amount := tier.UnitAmount
+		if tierMode == types.BILLING_TIER_STAIR_STEP {
+			amount = lo.FromPtrOr(tier.FlatAmount, decimal.Zero)
+		}
+		priceInt := convertAmountToSmallestUnit(amount.InexactFloat64(), currency)
 
 		chargebeeTier := ChargebeeTier{
 			StartingUnit: startingUnit,
@@ -752,7 +762,7 @@ func (s *PlanSyncService) SyncPlanToChargebee(ctx context.Context, plan *plan.Pl
 					FlatAmount: p.Tiers[i].FlatAmount,
 				}
 			}
-			itemPriceReq.Tiers = convertTiersForChargebee(tiers, p.Currency)
+			itemPriceReq.Tiers = convertTiersForChargebee(tiers, p.TierMode, p.Currency)
 			s.Logger.Infow("syncing tiered pricing to Chargebee",
 				"price_id", p.ID,
 				"tier_mode", p.TierMode,
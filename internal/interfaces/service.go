@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
@@ -56,6 +57,14 @@ type PlanService interface {
 	SyncPlanPrices(ctx context.Context, id string) (*dto.SyncPlanPricesResponse, error)
 	SyncPlanPricesV2(ctx context.Context, id string) (*dto.SyncPlanPricesV2Response, error)
 	ReprocessEventsForMissingPairs(ctx context.Context, missingPairs []planpricesync.PlanLineItemCreationDelta) error
+
+	// Plan versions
+	CreatePlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error)
+	PublishPlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error)
+	ArchivePlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error)
+	ListPlanVersions(ctx context.Context, planID string) (*dto.ListPlansResponse, error)
+	DiffPlanVersions(ctx context.Context, planID string, targetPlanID string) (*dto.PlanVersionDiffResponse, error)
+	MigratePlanVersion(ctx context.Context, planID string, req dto.MigratePlanVersionRequest) (*dto.MigratePlanVersionResponse, error)
 }
 
 type EntityIntegrationMappingService interface {
@@ -91,6 +100,7 @@ type SubscriptionService interface {
 	ListPauses(ctx context.Context, subscriptionID string) (*dto.ListSubscriptionPausesResponse, error)
 	CalculatePauseImpact(ctx context.Context, subscriptionID string, req *dto.PauseSubscriptionRequest) (*types.BillingImpactDetails, error)
 	CalculateResumeImpact(ctx context.Context, subscriptionID string, req *dto.ResumeSubscriptionRequest) (*types.BillingImpactDetails, error)
+	ProcessSubscriptionPause(ctx context.Context, subscriptionID string, now time.Time) (*dto.SubscriptionPauseProcessingResult, error)
 
 	ValidateAndFilterPricesForSubscription(ctx context.Context, entityID string, entityType types.PriceEntityType, subscription *subscription.Subscription, workflowType *types.TemporalWorkflowType) ([]*dto.PriceResponse, error)
 
@@ -103,6 +113,32 @@ type SubscriptionService interface {
 	DeleteSubscriptionLineItem(ctx context.Context, lineItemID string, req dto.DeleteSubscriptionLineItemRequest) (*dto.SubscriptionLineItemResponse, error)
 	UpdateSubscriptionLineItem(ctx context.Context, lineItemID string, req dto.UpdateSubscriptionLineItemRequest) (*dto.SubscriptionLineItemResponse, error)
 
+	// Line item quantity changes
+	UpdateLineItemQuantity(ctx context.Context, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.LineItemQuantityChangeResponse, error)
+	PreviewLineItemQuantityChange(ctx context.Context, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.LineItemQuantityChangeResponse, error)
+	GetLineItemQuantityHistory(ctx context.Context, lineItemID string) (*dto.LineItemQuantityHistoryResponse, error)
+
+	// Billing thresholds
+	UpdateBillingThreshold(ctx context.Context, subscriptionID string, req dto.UpdateBillingThresholdRequest) (*dto.SubscriptionResponse, error)
+
+	// Trials
+	ExtendTrial(ctx context.Context, subscriptionID string, req dto.ExtendTrialRequest) (*dto.SubscriptionResponse, error)
+	GetTrialState(ctx context.Context, subscriptionID string) (*dto.SubscriptionTrialState, error)
+	SendTrialReminder(ctx context.Context, subscriptionID string) error
+	EndTrial(ctx context.Context, subscriptionID string) (*dto.SubscriptionResponse, error)
+
+	// Ramped contracts
+	GetContractSummary(ctx context.Context, subscriptionID string) (*dto.SubscriptionContractSummaryResponse, error)
+
+	// Fixed-term contracts
+	ProcessTermRenewal(ctx context.Context, subscriptionID string) (*dto.SubscriptionTermRenewalResult, error)
+	UpdateSubscriptionRenewal(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionRenewalRequest) (*dto.SubscriptionResponse, error)
+	ListUpcomingRenewals(ctx context.Context, req dto.ListUpcomingRenewalsRequest) (*dto.ListUpcomingRenewalsResponse, error)
+
+	// Backdated subscriptions
+	PreviewBackdatedInvoices(ctx context.Context, subscriptionID string) (*dto.BackdatedInvoicesPreviewResponse, error)
+	CompleteBackdatedBilling(ctx context.Context, subscriptionID string) error
+
 	// Auto-cancellation methods
 	ProcessAutoCancellationSubscriptions(ctx context.Context) error
 	// Renewal due alert methods
@@ -136,9 +172,14 @@ type SubscriptionService interface {
 	// Calculate Billing Periods for the subscription
 	CalculateBillingPeriods(ctx context.Context, subscriptionID string) ([]dto.Period, error)
 
-	// Create Draft Invoice for the subscription
+	// Create Draft Invoice for the subscription, or the consolidated invoice of the customer's
+	// subscriptions sharing its billing period when the customer consolidates invoices
 	CreateDraftInvoiceForSubscription(ctx context.Context, subscriptionID string, period dto.Period) (*dto.InvoiceResponse, error)
 
+	// Create Draft Invoices for the ended billing periods of the subscription, the missed periods of
+	// backdated subscriptions are billed on a single catch-up invoice with the catch_up billing mode
+	CreateDraftInvoicesForPeriods(ctx context.Context, subscriptionID string, periods []dto.Period) ([]*dto.InvoiceResponse, error)
+
 	// Mark cancellation schedule as executed (used by cron and Temporal workflows)
 	MarkCancellationScheduleAsExecuted(ctx context.Context, subscriptionID string) error
 }
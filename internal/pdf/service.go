@@ -16,6 +16,7 @@ import (
 // Generator defines the interface for PDF generation operations
 type Generator interface {
 	RenderInvoicePdf(ctx context.Context, data *pdf.InvoiceData, templateName *types.TemplateName) ([]byte, error)
+	RenderQuotePdf(ctx context.Context, data *pdf.QuoteData) ([]byte, error)
 }
 
 type Config struct {
@@ -63,3 +64,27 @@ func (s *service) RenderInvoicePdf(ctx context.Context, data *pdf.InvoiceData, t
 
 	return pdf, nil
 }
+
+// RenderQuotePdf renders a quote with the default quote template
+func (s *service) RenderQuotePdf(ctx context.Context, data *pdf.QuoteData) ([]byte, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("failed to marshal quote data").
+			Mark(ierr.ErrSystem)
+	}
+
+	pdf, err := s.typst.CompileTemplate(
+		types.TemplateQuoteDefault,
+		jsonData,
+		typst.WithOutputFile(fmt.Sprintf("quote-%s.pdf", data.ID)),
+	)
+
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("failed to compile quote template").
+			Mark(ierr.ErrSystem)
+	}
+
+	return pdf, nil
+}
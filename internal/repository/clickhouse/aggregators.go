@@ -42,6 +42,7 @@ import (
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/repository/clickhouse/builder"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/shopspring/decimal"
 )
@@ -207,6 +208,10 @@ func parseTimeConditions(params *events.UsageParams) ([]string, []interface{}) {
 		args = append(args, params.EndTime)
 	}
 
+	windowConditions, windowArgs := builder.TimeWindowConditions(params.TimeWindow)
+	conditions = append(conditions, windowConditions...)
+	args = append(args, windowArgs...)
+
 	return conditions, args
 }
 
@@ -254,9 +254,71 @@ func parseTimeConditions(params *events.UsageParams) ([]string, []interface{}) {
 		args = append(args, params.EndTime)
 	}
 
+	windowConditions, windowArgs := TimeWindowConditions(params.TimeWindow)
+	conditions = append(conditions, windowConditions...)
+	args = append(args, windowArgs...)
+
 	return conditions, args
 }
 
+// TimeWindowConditions returns the conditions restricting events to a time-of-use window filter.
+// Timestamps are converted to the filter timezone before the day of week and the time of day
+// are compared against the windows.
+func TimeWindowConditions(filter *types.TimeWindowFilter) ([]string, []interface{}) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	var conditions []string
+	var args []interface{}
+
+	timezone := filter.Location().String()
+
+	if filter.Include != nil {
+		condition, conditionArgs := timeWindowCondition(*filter.Include, timezone)
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+	}
+
+	for _, w := range filter.Exclude {
+		condition, conditionArgs := timeWindowCondition(w, timezone)
+		conditions = append(conditions, "NOT "+condition)
+		args = append(args, conditionArgs...)
+	}
+
+	return conditions, args
+}
+
+// timeWindowCondition returns a single condition matching events within the window
+func timeWindowCondition(w types.PriceTimeWindow, timezone string) (string, []interface{}) {
+	parts := []string{}
+	var args []interface{}
+
+	if len(w.DaysOfWeek) > 0 {
+		// toDayOfWeek uses ISO-8601 numbering (1 = Monday ... 7 = Sunday) by default
+		placeholders := make([]string, len(w.DaysOfWeek))
+		args = append(args, timezone)
+		for i, day := range w.DaysOfWeek {
+			placeholders[i] = "?"
+			args = append(args, day)
+		}
+		parts = append(parts, fmt.Sprintf("toDayOfWeek(toTimeZone(timestamp, ?)) IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if !w.CoversFullDay() {
+		parts = append(parts, "(toHour(toTimeZone(timestamp, ?)) * 60 + toMinute(toTimeZone(timestamp, ?))) >= ?")
+		args = append(args, timezone, timezone, w.StartMinute())
+		parts = append(parts, "(toHour(toTimeZone(timestamp, ?)) * 60 + toMinute(toTimeZone(timestamp, ?))) < ?")
+		args = append(args, timezone, timezone, w.EndMinute())
+	}
+
+	if len(parts) == 0 {
+		return "1 = 1", nil
+	}
+
+	return "(" + strings.Join(parts, " AND ") + ")", args
+}
+
 /*
 
 ---------Sample Query with Filter Groups---------------------------------------------
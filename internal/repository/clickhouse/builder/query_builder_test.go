@@ -295,3 +295,26 @@ func TestQueryBuilder_InjectionPayloadsStayInArgs(t *testing.T) {
 	assert.Contains(t, sql, "COUNT(DISTINCT JSONExtractString(properties, ?))")
 	assert.Contains(t, args, payload)
 }
+
+func TestTimeWindowConditions(t *testing.T) {
+	offPeak := types.PriceTimeWindow{Name: "off_peak", StartTime: "00:00", EndTime: "06:00"}
+	weekend := types.PriceTimeWindow{Name: "weekend", DaysOfWeek: []int{6, 7}}
+
+	conditions, args := TimeWindowConditions(nil)
+	assert.Empty(t, conditions)
+	assert.Empty(t, args)
+
+	conditions, args = TimeWindowConditions(&types.TimeWindowFilter{
+		Timezone: "IST",
+		Include:  &weekend,
+		Exclude:  []types.PriceTimeWindow{offPeak},
+	})
+	assert.Equal(t, []string{
+		"(toDayOfWeek(toTimeZone(timestamp, ?)) IN (?, ?))",
+		"NOT ((toHour(toTimeZone(timestamp, ?)) * 60 + toMinute(toTimeZone(timestamp, ?))) >= ? AND (toHour(toTimeZone(timestamp, ?)) * 60 + toMinute(toTimeZone(timestamp, ?))) < ?)",
+	}, conditions)
+	assert.Equal(t, []interface{}{
+		"Asia/Kolkata", 6, 7,
+		"Asia/Kolkata", "Asia/Kolkata", 0, "Asia/Kolkata", "Asia/Kolkata", 360,
+	}, args)
+}
@@ -111,6 +111,10 @@ func (r *addonAssociationRepository) Create(ctx context.Context, a *domainAddonA
 		SetCancellationReason(a.CancellationReason).
 		SetNillableCancelledAt(a.CancelledAt).
 		SetMetadata(a.Metadata).
+		SetQuantity(a.Quantity).
+		SetBillingPeriod(a.BillingPeriod).
+		SetBillingPeriodCount(a.BillingPeriodCount).
+		SetNillableTrialEnd(a.TrialEnd).
 		SetCreatedBy(types.GetUserID(ctx)).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		SetCreatedAt(a.CreatedAt).
@@ -296,6 +300,10 @@ func (r *addonAssociationRepository) Update(ctx context.Context, a *domainAddonA
 		SetCancellationReason(a.CancellationReason).
 		SetNillableCancelledAt(a.CancelledAt).
 		SetMetadata(a.Metadata).
+		SetQuantity(a.Quantity).
+		SetBillingPeriod(a.BillingPeriod).
+		SetBillingPeriodCount(a.BillingPeriodCount).
+		SetNillableTrialEnd(a.TrialEnd).
 		SetUpdatedAt(time.Now().UTC()).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		Save(ctx)
@@ -74,6 +74,7 @@ func (r *customerRepository) Create(ctx context.Context, c *domainCustomer.Custo
 		SetUpdatedAt(c.UpdatedAt).
 		SetCreatedBy(c.CreatedBy).
 		SetNillableParentCustomerID(c.ParentCustomerID).
+		SetNillableConsolidateInvoices(c.ConsolidateInvoices).
 		SetUpdatedBy(c.UpdatedBy).
 		SetEnvironmentID(c.EnvironmentID).
 		Save(ctx)
@@ -341,6 +342,7 @@ func (r *customerRepository) Update(ctx context.Context, c *domainCustomer.Custo
 		SetAddressCountry(c.AddressCountry).
 		SetMetadata(c.Metadata).
 		SetNillableParentCustomerID(c.ParentCustomerID).
+		SetNillableConsolidateInvoices(c.ConsolidateInvoices).
 		SetUpdatedAt(time.Now().UTC()).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		Save(ctx)
@@ -59,6 +59,7 @@ func (r *entitlementRepository) Create(ctx context.Context, e *domainEntitlement
 		SetIsEnabled(e.IsEnabled).
 		SetNillableUsageLimit(e.UsageLimit).
 		SetUsageResetPeriod(e.UsageResetPeriod).
+		SetRolloverConfig(e.RolloverConfig).
 		SetIsSoftLimit(e.IsSoftLimit).
 		SetStaticValue(e.StaticValue).
 		SetNillableParentEntitlementID(e.ParentEntitlementID).
@@ -291,6 +292,7 @@ func (r *entitlementRepository) Update(ctx context.Context, e *domainEntitlement
 		SetIsSoftLimit(e.IsSoftLimit).
 		SetNillableUsageLimit(e.UsageLimit).
 		SetUsageResetPeriod(e.UsageResetPeriod).
+		SetRolloverConfig(e.RolloverConfig).
 		SetStaticValue(e.StaticValue).
 		SetNillableParentEntitlementID(e.ParentEntitlementID).
 		SetStatus(string(e.Status)).
@@ -390,6 +392,7 @@ func (r *entitlementRepository) CreateBulk(ctx context.Context, entitlements []*
 			SetIsEnabled(e.IsEnabled).
 			SetNillableUsageLimit(e.UsageLimit).
 			SetUsageResetPeriod(e.UsageResetPeriod).
+			SetRolloverConfig(e.RolloverConfig).
 			SetIsSoftLimit(e.IsSoftLimit).
 			SetStaticValue(e.StaticValue).
 			SetTenantID(e.TenantID).
@@ -0,0 +1,445 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/experiment"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/schema"
+	domainExperiment "github.com/flexprice/flexprice/internal/domain/experiment"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/lib/pq"
+)
+
+type experimentRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts ExperimentQueryOptions
+}
+
+func NewExperimentRepository(client postgres.IClient, log *logger.Logger) domainExperiment.Repository {
+	return &experimentRepository{
+		client:    client,
+		log:       log,
+		queryOpts: ExperimentQueryOptions{},
+	}
+}
+
+func (r *experimentRepository) Create(ctx context.Context, e *domainExperiment.Experiment) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating experiment",
+		"experiment_id", e.ID,
+		"tenant_id", e.TenantID,
+		"plan_id", e.PlanID,
+	)
+
+	span := StartRepositorySpan(ctx, "experiment", "create", map[string]interface{}{
+		"experiment_id": e.ID,
+		"plan_id":       e.PlanID,
+	})
+	defer FinishSpan(span)
+
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	_, err := client.Experiment.Create().
+		SetID(e.ID).
+		SetTenantID(e.TenantID).
+		SetEnvironmentID(e.EnvironmentID).
+		SetStatus(string(e.Status)).
+		SetName(e.Name).
+		SetDescription(e.Description).
+		SetPlanID(e.PlanID).
+		SetExperimentStatus(e.ExperimentStatus).
+		SetVariants(e.Variants).
+		SetNillableStartedAt(e.StartedAt).
+		SetNillableEndedAt(e.EndedAt).
+		SetMetadata(e.Metadata).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		SetCreatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			return experimentConstraintError(err, e)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create experiment").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentRepository) Get(ctx context.Context, id string) (*domainExperiment.Experiment, error) {
+	span := StartRepositorySpan(ctx, "experiment", "get", map[string]interface{}{
+		"experiment_id": id,
+	})
+	defer FinishSpan(span)
+
+	e, err := r.client.Reader(ctx).Experiment.Query().
+		Where(
+			experiment.ID(id),
+			experiment.TenantID(types.GetTenantID(ctx)),
+			experiment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Experiment with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"experiment_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get experiment with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainExperiment.FromEnt(e), nil
+}
+
+func (r *experimentRepository) GetRunningByPlan(ctx context.Context, planID string) (*domainExperiment.Experiment, error) {
+	span := StartRepositorySpan(ctx, "experiment", "get_running_by_plan", map[string]interface{}{
+		"plan_id": planID,
+	})
+	defer FinishSpan(span)
+
+	e, err := r.client.Reader(ctx).Experiment.Query().
+		Where(
+			experiment.PlanID(planID),
+			experiment.ExperimentStatus(types.ExperimentStatusRunning),
+			experiment.Status(string(types.StatusPublished)),
+			experiment.TenantID(types.GetTenantID(ctx)),
+			experiment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("No running experiment found for plan %s", planID).
+				WithReportableDetails(map[string]any{
+					"plan_id": planID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get running experiment").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainExperiment.FromEnt(e), nil
+}
+
+func (r *experimentRepository) Update(ctx context.Context, e *domainExperiment.Experiment) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating experiment",
+		"experiment_id", e.ID,
+		"tenant_id", e.TenantID,
+	)
+
+	span := StartRepositorySpan(ctx, "experiment", "update", map[string]interface{}{
+		"experiment_id": e.ID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.Experiment.Update().
+		Where(
+			experiment.ID(e.ID),
+			experiment.TenantID(e.TenantID),
+			experiment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetName(e.Name).
+		SetDescription(e.Description).
+		SetExperimentStatus(e.ExperimentStatus).
+		SetVariants(e.Variants).
+		SetNillableStartedAt(e.StartedAt).
+		SetNillableEndedAt(e.EndedAt).
+		SetMetadata(e.Metadata).
+		SetStatus(string(e.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			return experimentConstraintError(err, e)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update experiment").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting experiment",
+		"experiment_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	span := StartRepositorySpan(ctx, "experiment", "delete", map[string]interface{}{
+		"experiment_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.Experiment.Update().
+		Where(
+			experiment.ID(id),
+			experiment.TenantID(types.GetTenantID(ctx)),
+			experiment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete experiment").
+			WithReportableDetails(map[string]any{
+				"experiment_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentRepository) List(ctx context.Context, filter *types.ExperimentFilter) ([]*domainExperiment.Experiment, error) {
+	if filter == nil {
+		filter = types.NewExperimentFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "experiment", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).Experiment.Query()
+
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		return nil, err
+	}
+
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	experiments, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list experiments").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainExperiment.FromEntList(experiments), nil
+}
+
+func (r *experimentRepository) Count(ctx context.Context, filter *types.ExperimentFilter) (int, error) {
+	span := StartRepositorySpan(ctx, "experiment", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).Experiment.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count experiments").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+func experimentConstraintError(err error, e *domainExperiment.Experiment) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Constraint == schema.ExperimentRunningPlanConstraint {
+		return ierr.WithError(err).
+			WithHint("The plan already has a running experiment").
+			WithReportableDetails(map[string]any{
+				"plan_id": e.PlanID,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return ierr.WithError(err).
+		WithHint("Experiment violates a constraint").
+		WithReportableDetails(map[string]any{
+			"experiment_id": e.ID,
+		}).
+		Mark(ierr.ErrAlreadyExists)
+}
+
+// ExperimentQuery type alias for better readability
+type ExperimentQuery = *ent.ExperimentQuery
+
+// ExperimentQueryOptions implements query options for experiment filtering and sorting
+type ExperimentQueryOptions struct{}
+
+func (o ExperimentQueryOptions) ApplyTenantFilter(ctx context.Context, query ExperimentQuery) ExperimentQuery {
+	return query.Where(experiment.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o ExperimentQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query ExperimentQuery) ExperimentQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(experiment.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o ExperimentQueryOptions) ApplyStatusFilter(query ExperimentQuery, status string) ExperimentQuery {
+	if status == "" {
+		return query.Where(experiment.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(experiment.Status(status))
+}
+
+func (o ExperimentQueryOptions) ApplySortFilter(query ExperimentQuery, field string, order string) ExperimentQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o ExperimentQueryOptions) ApplyPaginationFilter(query ExperimentQuery, limit int, offset int) ExperimentQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o ExperimentQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return experiment.FieldCreatedAt
+	case "updated_at":
+		return experiment.FieldUpdatedAt
+	case "name":
+		return experiment.FieldName
+	case "plan_id":
+		return experiment.FieldPlanID
+	case "experiment_status":
+		return experiment.FieldExperimentStatus
+	case "started_at":
+		return experiment.FieldStartedAt
+	case "ended_at":
+		return experiment.FieldEndedAt
+	case "status":
+		return experiment.FieldStatus
+	default:
+		return ""
+	}
+}
+
+func (o ExperimentQueryOptions) GetFieldResolver(st string) (string, error) {
+	fieldName := o.GetFieldName(st)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in experiment query", st).
+			WithHintf("Unknown field name '%s' in experiment query", st).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o ExperimentQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.ExperimentFilter, query ExperimentQuery) (ExperimentQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.ExperimentIDs) > 0 {
+		query = query.Where(experiment.IDIn(f.ExperimentIDs...))
+	}
+
+	if len(f.PlanIDs) > 0 {
+		query = query.Where(experiment.PlanIDIn(f.PlanIDs...))
+	}
+
+	if len(f.ExperimentStatus) > 0 {
+		query = query.Where(experiment.ExperimentStatusIn(f.ExperimentStatus...))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(experiment.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(experiment.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[ExperimentQuery, predicate.Experiment](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.Experiment { return predicate.Experiment(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[ExperimentQuery, experiment.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) experiment.OrderOption { return experiment.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
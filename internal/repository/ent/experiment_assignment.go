@@ -0,0 +1,447 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/experimentassignment"
+	"github.com/flexprice/flexprice/ent/schema"
+	domainExperiment "github.com/flexprice/flexprice/internal/domain/experiment"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/lib/pq"
+)
+
+type experimentAssignmentRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts ExperimentAssignmentQueryOptions
+}
+
+func NewExperimentAssignmentRepository(client postgres.IClient, log *logger.Logger) domainExperiment.AssignmentRepository {
+	return &experimentAssignmentRepository{
+		client:    client,
+		log:       log,
+		queryOpts: ExperimentAssignmentQueryOptions{},
+	}
+}
+
+func (r *experimentAssignmentRepository) Create(ctx context.Context, a *domainExperiment.Assignment) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("creating experiment assignment",
+		"assignment_id", a.ID,
+		"experiment_id", a.ExperimentID,
+		"customer_id", a.CustomerID,
+		"variant_key", a.VariantKey,
+	)
+
+	span := StartRepositorySpan(ctx, "experiment_assignment", "create", map[string]interface{}{
+		"assignment_id": a.ID,
+		"experiment_id": a.ExperimentID,
+		"customer_id":   a.CustomerID,
+	})
+	defer FinishSpan(span)
+
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	_, err := client.ExperimentAssignment.Create().
+		SetID(a.ID).
+		SetTenantID(a.TenantID).
+		SetEnvironmentID(a.EnvironmentID).
+		SetStatus(string(a.Status)).
+		SetExperimentID(a.ExperimentID).
+		SetCustomerID(a.CustomerID).
+		SetVariantKey(a.VariantKey).
+		SetPlanID(a.PlanID).
+		SetNillableSubscriptionID(a.SubscriptionID).
+		SetCreatedAt(a.CreatedAt).
+		SetUpdatedAt(a.UpdatedAt).
+		SetCreatedBy(types.GetUserID(ctx)).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Constraint == schema.ExperimentAssignmentCustomerConstraint {
+				return ierr.WithError(err).
+					WithHint("Customer is already assigned to a variant of the experiment").
+					WithReportableDetails(map[string]any{
+						"experiment_id": a.ExperimentID,
+						"customer_id":   a.CustomerID,
+					}).
+					Mark(ierr.ErrAlreadyExists)
+			}
+			return ierr.WithError(err).
+				WithHint("Experiment assignment violates a constraint").
+				Mark(ierr.ErrAlreadyExists)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to create experiment assignment").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentAssignmentRepository) GetByExperimentAndCustomer(ctx context.Context, experimentID, customerID string) (*domainExperiment.Assignment, error) {
+	span := StartRepositorySpan(ctx, "experiment_assignment", "get_by_experiment_and_customer", map[string]interface{}{
+		"experiment_id": experimentID,
+		"customer_id":   customerID,
+	})
+	defer FinishSpan(span)
+
+	a, err := r.client.Reader(ctx).ExperimentAssignment.Query().
+		Where(
+			experimentassignment.ExperimentID(experimentID),
+			experimentassignment.CustomerID(customerID),
+			experimentassignment.Status(string(types.StatusPublished)),
+			experimentassignment.TenantID(types.GetTenantID(ctx)),
+			experimentassignment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("Customer is not assigned to the experiment").
+				WithReportableDetails(map[string]any{
+					"experiment_id": experimentID,
+					"customer_id":   customerID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get experiment assignment").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainExperiment.AssignmentFromEnt(a), nil
+}
+
+func (r *experimentAssignmentRepository) SetSubscription(ctx context.Context, id string, subscriptionID string) error {
+	span := StartRepositorySpan(ctx, "experiment_assignment", "set_subscription", map[string]interface{}{
+		"assignment_id":   id,
+		"subscription_id": subscriptionID,
+	})
+	defer FinishSpan(span)
+
+	_, err := r.client.Writer(ctx).ExperimentAssignment.Update().
+		Where(
+			experimentassignment.ID(id),
+			experimentassignment.TenantID(types.GetTenantID(ctx)),
+			experimentassignment.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetSubscriptionID(subscriptionID).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to link subscription to experiment assignment").
+			WithReportableDetails(map[string]any{
+				"assignment_id":   id,
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentAssignmentRepository) MarkConverted(ctx context.Context, subscriptionID string, convertedAt time.Time) error {
+	span := StartRepositorySpan(ctx, "experiment_assignment", "mark_converted", map[string]interface{}{
+		"subscription_id": subscriptionID,
+	})
+	defer FinishSpan(span)
+
+	_, err := r.client.Writer(ctx).ExperimentAssignment.Update().
+		Where(
+			experimentassignment.SubscriptionID(subscriptionID),
+			experimentassignment.ConvertedAtIsNil(),
+			experimentassignment.TenantID(types.GetTenantID(ctx)),
+			experimentassignment.EnvironmentID(types.GetEnvironmentID(ctx)),
+			experimentassignment.Status(string(types.StatusPublished)),
+		).
+		SetConvertedAt(convertedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to mark experiment assignment as converted").
+			WithReportableDetails(map[string]any{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *experimentAssignmentRepository) List(ctx context.Context, filter *types.ExperimentAssignmentFilter) ([]*domainExperiment.Assignment, error) {
+	if filter == nil {
+		filter = types.NewExperimentAssignmentFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "experiment_assignment", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).ExperimentAssignment.Query()
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	assignments, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list experiment assignments").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainExperiment.AssignmentFromEntList(assignments), nil
+}
+
+func (r *experimentAssignmentRepository) Count(ctx context.Context, filter *types.ExperimentAssignmentFilter) (int, error) {
+	span := StartRepositorySpan(ctx, "experiment_assignment", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).ExperimentAssignment.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count experiment assignments").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// GetVariantStats returns the assignments and conversions of the variants of an experiment.
+// An assignment converts once its subscription has been activated, i.e. it is or was active.
+func (r *experimentAssignmentRepository) GetVariantStats(ctx context.Context, experimentID string) ([]types.ExperimentVariantStats, error) {
+	tenantID := types.GetTenantID(ctx)
+	envID := types.GetEnvironmentID(ctx)
+
+	span := StartRepositorySpan(ctx, "experiment_assignment", "get_variant_stats", map[string]interface{}{
+		"experiment_id": experimentID,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		SELECT
+			a.variant_key,
+			COUNT(*) AS assignments,
+			COUNT(s.id) FILTER (WHERE a.converted_at IS NOT NULL OR s.subscription_status IN ('active', 'paused')) AS conversions
+		FROM experiment_assignments a
+		LEFT JOIN subscriptions s
+			ON s.id = a.subscription_id
+		 AND s.tenant_id = a.tenant_id
+		 AND s.environment_id = a.environment_id
+		 AND s.status = 'published'
+		WHERE a.tenant_id = $1
+			AND a.environment_id = $2
+			AND a.experiment_id = $3
+			AND a.status = 'published'
+		GROUP BY a.variant_key
+		ORDER BY a.variant_key`
+
+	rows, err := r.client.Reader(ctx).QueryContext(ctx, query, tenantID, envID, experimentID)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("failed to get experiment variant stats").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var results []types.ExperimentVariantStats
+	for rows.Next() {
+		var result types.ExperimentVariantStats
+		if err := rows.Scan(&result.VariantKey, &result.Assignments, &result.Conversions); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).WithHint("failed to scan experiment variant stats row").Mark(ierr.ErrDatabase)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("failed to iterate experiment variant stats rows").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return results, nil
+}
+
+// GetVariantRevenue returns the revenue of the variants of an experiment per currency. Like the
+// revenue trend, revenue is the total of the finalized and published invoices of the subscriptions.
+func (r *experimentAssignmentRepository) GetVariantRevenue(ctx context.Context, experimentID string) ([]types.ExperimentVariantRevenue, error) {
+	tenantID := types.GetTenantID(ctx)
+	envID := types.GetEnvironmentID(ctx)
+
+	span := StartRepositorySpan(ctx, "experiment_assignment", "get_variant_revenue", map[string]interface{}{
+		"experiment_id": experimentID,
+	})
+	defer FinishSpan(span)
+
+	query := `
+		SELECT
+			a.variant_key,
+			i.currency,
+			COALESCE(SUM(i.total), 0)::text AS revenue
+		FROM experiment_assignments a
+		JOIN invoices i
+			ON i.subscription_id = a.subscription_id
+		 AND i.tenant_id = a.tenant_id
+		 AND i.environment_id = a.environment_id
+		 AND i.invoice_status = 'FINALIZED'
+		 AND i.status = 'published'
+		WHERE a.tenant_id = $1
+			AND a.environment_id = $2
+			AND a.experiment_id = $3
+			AND a.status = 'published'
+		GROUP BY a.variant_key, i.currency
+		ORDER BY a.variant_key, i.currency`
+
+	rows, err := r.client.Reader(ctx).QueryContext(ctx, query, tenantID, envID, experimentID)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("failed to get experiment variant revenue").Mark(ierr.ErrDatabase)
+	}
+	defer rows.Close()
+
+	var results []types.ExperimentVariantRevenue
+	for rows.Next() {
+		var result types.ExperimentVariantRevenue
+		if err := rows.Scan(&result.VariantKey, &result.Currency, &result.Revenue); err != nil {
+			SetSpanError(span, err)
+			return nil, ierr.WithError(err).WithHint("failed to scan experiment variant revenue row").Mark(ierr.ErrDatabase)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).WithHint("failed to iterate experiment variant revenue rows").Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return results, nil
+}
+
+// ExperimentAssignmentQuery type alias for better readability
+type ExperimentAssignmentQuery = *ent.ExperimentAssignmentQuery
+
+// ExperimentAssignmentQueryOptions implements query options for experiment assignment filtering and sorting
+type ExperimentAssignmentQueryOptions struct{}
+
+func (o ExperimentAssignmentQueryOptions) ApplyTenantFilter(ctx context.Context, query ExperimentAssignmentQuery) ExperimentAssignmentQuery {
+	return query.Where(experimentassignment.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o ExperimentAssignmentQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query ExperimentAssignmentQuery) ExperimentAssignmentQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(experimentassignment.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o ExperimentAssignmentQueryOptions) ApplyStatusFilter(query ExperimentAssignmentQuery, status string) ExperimentAssignmentQuery {
+	if status == "" {
+		return query.Where(experimentassignment.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(experimentassignment.Status(status))
+}
+
+func (o ExperimentAssignmentQueryOptions) ApplySortFilter(query ExperimentAssignmentQuery, field string, order string) ExperimentAssignmentQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o ExperimentAssignmentQueryOptions) ApplyPaginationFilter(query ExperimentAssignmentQuery, limit int, offset int) ExperimentAssignmentQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o ExperimentAssignmentQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return experimentassignment.FieldCreatedAt
+	case "updated_at":
+		return experimentassignment.FieldUpdatedAt
+	case "variant_key":
+		return experimentassignment.FieldVariantKey
+	case "customer_id":
+		return experimentassignment.FieldCustomerID
+	default:
+		return ""
+	}
+}
+
+func (o ExperimentAssignmentQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.ExperimentAssignmentFilter, query ExperimentAssignmentQuery) ExperimentAssignmentQuery {
+	if f == nil {
+		return query
+	}
+
+	if len(f.ExperimentIDs) > 0 {
+		query = query.Where(experimentassignment.ExperimentIDIn(f.ExperimentIDs...))
+	}
+
+	if len(f.CustomerIDs) > 0 {
+		query = query.Where(experimentassignment.CustomerIDIn(f.CustomerIDs...))
+	}
+
+	if len(f.VariantKeys) > 0 {
+		query = query.Where(experimentassignment.VariantKeyIn(f.VariantKeys...))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(experimentassignment.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(experimentassignment.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	return query
+}
@@ -34,7 +34,7 @@ func (r *groupRepository) Create(ctx context.Context, grp *domainGroup.Group) er
 	tenantID := types.GetTenantID(ctx)
 	environmentID := types.GetEnvironmentID(ctx)
 
-	_, err := client.Group.Create().
+	create := client.Group.Create().
 		SetID(grp.ID).
 		SetName(grp.Name).
 		SetEntityType(string(grp.EntityType)).
@@ -43,8 +43,13 @@ func (r *groupRepository) Create(ctx context.Context, grp *domainGroup.Group) er
 		SetEnvironmentID(environmentID).
 		SetStatus(string(grp.Status)).
 		SetCreatedBy(types.GetUserID(ctx)).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if grp.Pooling != nil {
+		create = create.SetPooling(grp.Pooling)
+	}
+
+	_, err := create.Save(ctx)
 
 	if err != nil {
 		r.log.Error("Failed to create group", "error", err, "group_id", grp.ID)
@@ -189,14 +194,21 @@ func (r *groupRepository) Update(ctx context.Context, grp *domainGroup.Group) er
 	tenantID := types.GetTenantID(ctx)
 	environmentID := types.GetEnvironmentID(ctx)
 
-	_, err := client.Group.UpdateOneID(grp.ID).
+	update := client.Group.UpdateOneID(grp.ID).
 		Where(
 			group.TenantIDEQ(tenantID),
 			group.EnvironmentIDEQ(environmentID),
 		).
 		SetName(grp.Name).
-		SetUpdatedBy(types.GetUserID(ctx)).
-		Save(ctx)
+		SetUpdatedBy(types.GetUserID(ctx))
+
+	if grp.Pooling != nil {
+		update = update.SetPooling(grp.Pooling)
+	} else {
+		update = update.ClearPooling()
+	}
+
+	_, err := update.Save(ctx)
 
 	if err != nil {
 		r.log.Error("Failed to update group", "error", err, "group_id", grp.ID)
@@ -240,6 +252,7 @@ func (r *groupRepository) toDomainGroup(entGroup *ent.Group) *domainGroup.Group
 		EntityType:    types.GroupEntityType(entGroup.EntityType),
 		EnvironmentID: entGroup.EnvironmentID,
 		LookupKey:     entGroup.LookupKey,
+		Pooling:       entGroup.Pooling,
 		BaseModel: types.BaseModel{
 			TenantID:  entGroup.TenantID,
 			Status:    types.Status(entGroup.Status),
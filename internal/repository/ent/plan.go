@@ -68,6 +68,9 @@ func (r *planRepository) Create(ctx context.Context, p *domainPlan.Plan) error {
 		SetEnvironmentID(p.EnvironmentID).
 		SetMetadata(p.Metadata).
 		SetNillableDisplayOrder(p.DisplayOrder).
+		SetVersion(p.GetVersion()).
+		SetVersionStatus(p.GetVersionStatus()).
+		SetNillableBasePlanID(p.BasePlanID).
 		Save(ctx)
 
 	if err != nil {
@@ -300,6 +303,7 @@ func (r *planRepository) Update(ctx context.Context, p *domainPlan.Plan) error {
 		SetDescription(p.Description).
 		SetMetadata(p.Metadata).
 		SetNillableDisplayOrder(p.DisplayOrder).
+		SetVersionStatus(p.GetVersionStatus()).
 		SetUpdatedAt(time.Now().UTC()).
 		SetUpdatedBy(types.GetUserID(ctx)).
 		Save(ctx)
@@ -472,6 +476,17 @@ func (o PlanQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.Pl
 		query = query.Where(plan.LookupKeyEQ(*f.LookupKey))
 	}
 
+	if f.BasePlanID != "" {
+		query = query.Where(plan.Or(
+			plan.ID(f.BasePlanID),
+			plan.BasePlanID(f.BasePlanID),
+		))
+	}
+
+	if len(f.VersionStatus) > 0 {
+		query = query.Where(plan.VersionStatusIn(f.VersionStatus...))
+	}
+
 	if f.Filters != nil {
 		query, err = dsl.ApplyFilters[PlanQuery, predicate.Plan](
 			query,
@@ -72,7 +72,7 @@ func (r *planPriceSyncRepository) TerminateExpiredPlanPricesLineItems(
 					AND environment_id = $2
 					AND status = '%s'
 					AND plan_id = $3
-					AND subscription_status IN ('%s', '%s')
+					AND subscription_status IN ('%s', '%s', '%s')
 			),
 			ended_plan_prices AS (
 				SELECT
@@ -120,6 +120,7 @@ func (r *planPriceSyncRepository) TerminateExpiredPlanPricesLineItems(
 		string(types.StatusPublished),
 		string(types.SubscriptionStatusActive),
 		string(types.SubscriptionStatusTrialing),
+		string(types.SubscriptionStatusPastDue),
 		string(types.StatusPublished),
 		string(types.PRICE_ENTITY_TYPE_PLAN),
 		string(types.PRICE_TYPE_FIXED),
@@ -214,7 +215,7 @@ func (r *planPriceSyncRepository) ListPlanLineItemsToTerminate(
 					AND environment_id = $2
 					AND status = '%s'
 					AND plan_id = $3
-					AND subscription_status IN ('%s', '%s')
+					AND subscription_status IN ('%s', '%s', '%s')
 			),
 			ended_plan_prices AS (
 				SELECT
@@ -253,6 +254,7 @@ func (r *planPriceSyncRepository) ListPlanLineItemsToTerminate(
 		string(types.StatusPublished),
 		string(types.SubscriptionStatusActive),
 		string(types.SubscriptionStatusTrialing),
+		string(types.SubscriptionStatusPastDue),
 		string(types.StatusPublished),
 		string(types.PRICE_ENTITY_TYPE_PLAN),
 		string(types.PRICE_TYPE_FIXED),
@@ -373,7 +375,7 @@ func (r *planPriceSyncRepository) ListPlanLineItemsToCreate(
 					AND s.environment_id = $2
 					AND s.status = '%s'
 					AND s.plan_id = $3
-					AND s.subscription_status IN ('%s', '%s')
+					AND s.subscription_status IN ('%s', '%s', '%s')
 					%s
 				ORDER BY s.id
 				LIMIT $4
@@ -442,6 +444,7 @@ func (r *planPriceSyncRepository) ListPlanLineItemsToCreate(
 		string(types.StatusPublished),
 		string(types.SubscriptionStatusActive),
 		string(types.SubscriptionStatusTrialing),
+		string(types.SubscriptionStatusPastDue),
 		cursorCondition,
 		string(types.StatusPublished),
 		string(types.PRICE_ENTITY_TYPE_PLAN),
@@ -566,7 +569,7 @@ func (r *planPriceSyncRepository) GetLastSubscriptionIDInBatch(
 					AND s.environment_id = $2
 					AND s.status = '%s'
 					AND s.plan_id = $3
-					AND s.subscription_status IN ('%s', '%s')
+					AND s.subscription_status IN ('%s', '%s', '%s')
 					%s
 				ORDER BY s.id
 				LIMIT $4
@@ -579,6 +582,7 @@ func (r *planPriceSyncRepository) GetLastSubscriptionIDInBatch(
 		string(types.StatusPublished),
 		string(types.SubscriptionStatusActive),
 		string(types.SubscriptionStatusTrialing),
+		string(types.SubscriptionStatusPastDue),
 		cursorCondition,
 	)
 
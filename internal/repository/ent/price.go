@@ -80,6 +80,10 @@ func (r *priceRepository) Create(ctx context.Context, p *domainPrice.Price) erro
 		SetTiers(p.ToEntTiers()).
 		SetPriceUnitTiers(domainPrice.ToEntTiersFromJSONB(p.PriceUnitTiers)).
 		SetNillableTransformQuantity(lo.ToPtr(types.TransformQuantity(p.TransformQuantity))).
+		SetTimeWindows(p.TimeWindows).
+		SetCostPlus(p.CostPlus).
+		SetRolloverConfig(p.RolloverConfig).
+		SetIncludedQuantity(p.IncludedQuantity).
 		SetLookupKey(p.LookupKey).
 		SetDescription(p.Description).
 		SetMetadata(map[string]string(p.Metadata)).
@@ -401,6 +405,10 @@ func (r *priceRepository) CreateBulk(ctx context.Context, prices []*domainPrice.
 			SetNillableTierMode(lo.ToPtr(p.TierMode)).
 			SetTiers(p.ToEntTiers()).
 			SetTransformQuantity(types.TransformQuantity(p.TransformQuantity)).
+			SetTimeWindows(p.TimeWindows).
+			SetCostPlus(p.CostPlus).
+			SetRolloverConfig(p.RolloverConfig).
+			SetIncludedQuantity(p.IncludedQuantity).
 			SetLookupKey(p.LookupKey).
 			SetDescription(p.Description).
 			SetMetadata(map[string]string(p.Metadata)).
@@ -0,0 +1,526 @@
+package ent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/predicate"
+	"github.com/flexprice/flexprice/ent/quote"
+	"github.com/flexprice/flexprice/ent/quotelineitem"
+	"github.com/flexprice/flexprice/ent/schema"
+	domainQuote "github.com/flexprice/flexprice/internal/domain/quote"
+	"github.com/flexprice/flexprice/internal/dsl"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/lib/pq"
+)
+
+type quoteRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts QuoteQueryOptions
+}
+
+func NewQuoteRepository(client postgres.IClient, log *logger.Logger) domainQuote.Repository {
+	return &quoteRepository{
+		client:    client,
+		log:       log,
+		queryOpts: QuoteQueryOptions{},
+	}
+}
+
+func (r *quoteRepository) CreateWithLineItems(ctx context.Context, q *domainQuote.Quote) error {
+	r.log.Debugw("creating quote with line items",
+		"quote_id", q.ID,
+		"customer_id", q.CustomerID,
+		"line_items_count", len(q.LineItems),
+	)
+
+	span := StartRepositorySpan(ctx, "quote", "create_with_line_items", map[string]interface{}{
+		"quote_id":         q.ID,
+		"customer_id":      q.CustomerID,
+		"line_items_count": len(q.LineItems),
+	})
+	defer FinishSpan(span)
+
+	if q.EnvironmentID == "" {
+		q.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	err := r.client.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.client.Writer(ctx).Quote.Create().
+			SetID(q.ID).
+			SetTenantID(q.TenantID).
+			SetEnvironmentID(q.EnvironmentID).
+			SetStatus(string(q.Status)).
+			SetNillableQuoteNumber(q.QuoteNumber).
+			SetCustomerID(q.CustomerID).
+			SetPlanID(q.PlanID).
+			SetCurrency(q.Currency).
+			SetQuoteStatus(q.QuoteStatus).
+			SetDescription(q.Description).
+			SetBillingCadence(q.BillingCadence).
+			SetBillingPeriod(q.BillingPeriod).
+			SetBillingPeriodCount(q.BillingPeriodCount).
+			SetBillingCycle(q.BillingCycle).
+			SetNillableStartDate(q.StartDate).
+			SetValidUntil(q.ValidUntil).
+			SetCoupons(q.Coupons).
+			SetPhases(q.Phases).
+			SetSubtotal(q.Subtotal).
+			SetTotalDiscount(q.TotalDiscount).
+			SetTotal(q.Total).
+			SetMetadata(q.Metadata).
+			SetCreatedAt(q.CreatedAt).
+			SetUpdatedAt(q.UpdatedAt).
+			SetCreatedBy(q.CreatedBy).
+			SetUpdatedBy(q.UpdatedBy).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return quoteConstraintError(err, q)
+			}
+			return ierr.WithError(err).
+				WithHint("Failed to create quote").
+				Mark(ierr.ErrDatabase)
+		}
+
+		return r.createLineItems(ctx, q)
+	})
+	if err != nil {
+		SetSpanError(span, err)
+		return err
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *quoteRepository) createLineItems(ctx context.Context, q *domainQuote.Quote) error {
+	if len(q.LineItems) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.QuoteLineItemCreate, len(q.LineItems))
+	for i, item := range q.LineItems {
+		if item.EnvironmentID == "" {
+			item.EnvironmentID = q.EnvironmentID
+		}
+		builders[i] = r.client.Writer(ctx).QuoteLineItem.Create().
+			SetID(item.ID).
+			SetTenantID(item.TenantID).
+			SetEnvironmentID(item.EnvironmentID).
+			SetStatus(string(item.Status)).
+			SetQuoteID(q.ID).
+			SetPriceID(item.PriceID).
+			SetDisplayName(item.DisplayName).
+			SetQuantity(item.Quantity).
+			SetNillableUnitAmount(item.UnitAmount).
+			SetDiscountPercentage(item.DiscountPercentage).
+			SetAmount(item.Amount).
+			SetNillablePhaseIndex(item.PhaseIndex).
+			SetCurrency(item.Currency).
+			SetMetadata(item.Metadata).
+			SetCreatedAt(item.CreatedAt).
+			SetUpdatedAt(item.UpdatedAt).
+			SetCreatedBy(item.CreatedBy).
+			SetUpdatedBy(item.UpdatedBy)
+	}
+
+	if _, err := r.client.Writer(ctx).QuoteLineItem.CreateBulk(builders...).Save(ctx); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to create quote line items").
+			WithReportableDetails(map[string]any{
+				"quote_id": q.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+	return nil
+}
+
+func (r *quoteRepository) Get(ctx context.Context, id string) (*domainQuote.Quote, error) {
+	span := StartRepositorySpan(ctx, "quote", "get", map[string]interface{}{
+		"quote_id": id,
+	})
+	defer FinishSpan(span)
+
+	q, err := r.client.Reader(ctx).Quote.Query().
+		Where(
+			quote.ID(id),
+			quote.TenantID(types.GetTenantID(ctx)),
+			quote.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		WithLineItems(func(q *ent.QuoteLineItemQuery) {
+			q.Where(quotelineitem.Status(string(types.StatusPublished))).
+				Order(ent.Asc(quotelineitem.FieldID))
+		}).
+		Only(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHintf("Quote with ID %s was not found", id).
+				WithReportableDetails(map[string]any{
+					"quote_id": id,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, ierr.WithError(err).
+			WithHintf("Failed to get quote with ID %s", id).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainQuote.FromEnt(q), nil
+}
+
+func (r *quoteRepository) Update(ctx context.Context, q *domainQuote.Quote) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("updating quote",
+		"quote_id", q.ID,
+		"quote_status", q.QuoteStatus,
+	)
+
+	span := StartRepositorySpan(ctx, "quote", "update", map[string]interface{}{
+		"quote_id": q.ID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.Quote.Update().
+		Where(
+			quote.ID(q.ID),
+			quote.TenantID(q.TenantID),
+			quote.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetNillableQuoteNumber(q.QuoteNumber).
+		SetQuoteStatus(q.QuoteStatus).
+		SetDescription(q.Description).
+		SetNillableStartDate(q.StartDate).
+		SetValidUntil(q.ValidUntil).
+		SetCoupons(q.Coupons).
+		SetPhases(q.Phases).
+		SetSubtotal(q.Subtotal).
+		SetTotalDiscount(q.TotalDiscount).
+		SetTotal(q.Total).
+		SetNillableFinalizedAt(q.FinalizedAt).
+		SetNillableAcceptedAt(q.AcceptedAt).
+		SetNillableDeclinedAt(q.DeclinedAt).
+		SetDeclineReason(q.DeclineReason).
+		SetNillableSubscriptionID(q.SubscriptionID).
+		SetMetadata(q.Metadata).
+		SetStatus(string(q.Status)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsConstraintError(err) {
+			return quoteConstraintError(err, q)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update quote").
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *quoteRepository) ReplaceLineItems(ctx context.Context, q *domainQuote.Quote) error {
+	span := StartRepositorySpan(ctx, "quote", "replace_line_items", map[string]interface{}{
+		"quote_id":         q.ID,
+		"line_items_count": len(q.LineItems),
+	})
+	defer FinishSpan(span)
+
+	err := r.client.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.client.Writer(ctx).QuoteLineItem.Update().
+			Where(
+				quotelineitem.QuoteID(q.ID),
+				quotelineitem.TenantID(q.TenantID),
+				quotelineitem.EnvironmentID(types.GetEnvironmentID(ctx)),
+				quotelineitem.Status(string(types.StatusPublished)),
+			).
+			SetStatus(string(types.StatusDeleted)).
+			SetUpdatedAt(time.Now().UTC()).
+			SetUpdatedBy(types.GetUserID(ctx)).
+			Save(ctx)
+		if err != nil {
+			return ierr.WithError(err).
+				WithHint("Failed to remove quote line items").
+				WithReportableDetails(map[string]any{
+					"quote_id": q.ID,
+				}).
+				Mark(ierr.ErrDatabase)
+		}
+
+		return r.createLineItems(ctx, q)
+	})
+	if err != nil {
+		SetSpanError(span, err)
+		return err
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *quoteRepository) Delete(ctx context.Context, id string) error {
+	client := r.client.Writer(ctx)
+
+	r.log.Debugw("deleting quote",
+		"quote_id", id,
+		"tenant_id", types.GetTenantID(ctx),
+	)
+
+	span := StartRepositorySpan(ctx, "quote", "delete", map[string]interface{}{
+		"quote_id": id,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.Quote.Update().
+		Where(
+			quote.ID(id),
+			quote.TenantID(types.GetTenantID(ctx)),
+			quote.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetStatus(string(types.StatusArchived)).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		return ierr.WithError(err).
+			WithHint("Failed to delete quote").
+			WithReportableDetails(map[string]any{
+				"quote_id": id,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+func (r *quoteRepository) List(ctx context.Context, filter *types.QuoteFilter) ([]*domainQuote.Quote, error) {
+	if filter == nil {
+		filter = types.NewQuoteFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "quote", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).Quote.Query()
+
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		return nil, err
+	}
+
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	quotes, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list quotes").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return domainQuote.FromEntList(quotes), nil
+}
+
+func (r *quoteRepository) Count(ctx context.Context, filter *types.QuoteFilter) (int, error) {
+	span := StartRepositorySpan(ctx, "quote", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).Quote.Query()
+
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query, err := r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count quotes").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+func quoteConstraintError(err error, q *domainQuote.Quote) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Constraint == schema.Idx_tenant_environment_quote_number_unique {
+		return ierr.WithError(err).
+			WithHint("Quote with same quote number already exists").
+			WithReportableDetails(map[string]any{
+				"quote_number": q.QuoteNumber,
+			}).
+			Mark(ierr.ErrAlreadyExists)
+	}
+	return ierr.WithError(err).
+		WithHint("Quote violates a constraint").
+		WithReportableDetails(map[string]any{
+			"quote_id": q.ID,
+		}).
+		Mark(ierr.ErrAlreadyExists)
+}
+
+// QuoteQuery type alias for better readability
+type QuoteQuery = *ent.QuoteQuery
+
+// QuoteQueryOptions implements query options for quote filtering and sorting
+type QuoteQueryOptions struct{}
+
+func (o QuoteQueryOptions) ApplyTenantFilter(ctx context.Context, query QuoteQuery) QuoteQuery {
+	return query.Where(quote.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o QuoteQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query QuoteQuery) QuoteQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(quote.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o QuoteQueryOptions) ApplyStatusFilter(query QuoteQuery, status string) QuoteQuery {
+	if status == "" {
+		return query.Where(quote.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(quote.Status(status))
+}
+
+func (o QuoteQueryOptions) ApplySortFilter(query QuoteQuery, field string, order string) QuoteQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)))
+}
+
+func (o QuoteQueryOptions) ApplyPaginationFilter(query QuoteQuery, limit int, offset int) QuoteQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o QuoteQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "created_at":
+		return quote.FieldCreatedAt
+	case "updated_at":
+		return quote.FieldUpdatedAt
+	case "quote_number":
+		return quote.FieldQuoteNumber
+	case "customer_id":
+		return quote.FieldCustomerID
+	case "plan_id":
+		return quote.FieldPlanID
+	case "quote_status":
+		return quote.FieldQuoteStatus
+	case "valid_until":
+		return quote.FieldValidUntil
+	case "total":
+		return quote.FieldTotal
+	case "status":
+		return quote.FieldStatus
+	default:
+		return ""
+	}
+}
+
+func (o QuoteQueryOptions) GetFieldResolver(st string) (string, error) {
+	fieldName := o.GetFieldName(st)
+	if fieldName == "" {
+		return "", ierr.NewErrorf("unknown field name '%s' in quote query", st).
+			WithHintf("Unknown field name '%s' in quote query", st).
+			Mark(ierr.ErrValidation)
+	}
+	return fieldName, nil
+}
+
+func (o QuoteQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.QuoteFilter, query QuoteQuery) (QuoteQuery, error) {
+	var err error
+	if f == nil {
+		return query, nil
+	}
+
+	if len(f.QuoteIDs) > 0 {
+		query = query.Where(quote.IDIn(f.QuoteIDs...))
+	}
+
+	if len(f.CustomerIDs) > 0 {
+		query = query.Where(quote.CustomerIDIn(f.CustomerIDs...))
+	}
+
+	if len(f.PlanIDs) > 0 {
+		query = query.Where(quote.PlanIDIn(f.PlanIDs...))
+	}
+
+	if len(f.QuoteStatus) > 0 {
+		query = query.Where(quote.QuoteStatusIn(f.QuoteStatus...))
+	}
+
+	if f.TimeRangeFilter != nil {
+		if f.StartTime != nil {
+			query = query.Where(quote.CreatedAtGTE(*f.StartTime))
+		}
+		if f.EndTime != nil {
+			query = query.Where(quote.CreatedAtLTE(*f.EndTime))
+		}
+	}
+
+	if f.Filters != nil {
+		query, err = dsl.ApplyFilters[QuoteQuery, predicate.Quote](
+			query,
+			f.Filters,
+			o.GetFieldResolver,
+			func(p dsl.Predicate) predicate.Quote { return predicate.Quote(p) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.Sort != nil {
+		query, err = dsl.ApplySorts[QuoteQuery, quote.OrderOption](
+			query,
+			f.Sort,
+			o.GetFieldResolver,
+			func(o dsl.OrderFunc) quote.OrderOption { return quote.OrderOption(o) },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/flexprice/flexprice/ent"
 	"github.com/flexprice/flexprice/ent/coupon"
 	"github.com/flexprice/flexprice/ent/couponassociation"
@@ -53,6 +54,16 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		sub.EnvironmentID = types.GetEnvironmentID(ctx)
 	}
 
+	if sub.TrialEndAction == "" {
+		sub.TrialEndAction = types.TrialEndActionConvert
+	}
+	if sub.BackdatedBillingMode == "" {
+		sub.BackdatedBillingMode = types.BackdatedBillingModePerPeriod
+	}
+	if sub.SpendLimitState == "" {
+		sub.SpendLimitState = types.SpendLimitStateOk
+	}
+
 	subscription, err := client.Subscription.Create().
 		SetID(sub.ID).
 		SetTenantID(sub.TenantID).
@@ -89,6 +100,20 @@ func (r *subscriptionRepository) Create(ctx context.Context, sub *domainSub.Subs
 		SetNillableGatewayPaymentMethodID(sub.GatewayPaymentMethodID).
 		SetEnableTrueUp(sub.EnableTrueUp).
 		SetNillableInvoicingCustomerID(sub.InvoicingCustomerID).
+		SetNillableBillingThresholdAmount(sub.BillingThresholdAmount).
+		SetTrialEndAction(sub.TrialEndAction).
+		SetTrialReminderDays(sub.TrialReminderDays).
+		SetTrialUsageCaps(sub.TrialUsageCaps).
+		SetBackdatedBillingMode(sub.BackdatedBillingMode).
+		SetBackdatedBillingPending(sub.BackdatedBillingPending).
+		SetSpendLimitState(sub.SpendLimitState).
+		SetNillableSpendLimitedAt(sub.SpendLimitedAt).
+		SetTermMonths(sub.TermMonths).
+		SetAutoRenew(sub.AutoRenew).
+		SetRenewalNoticeDays(sub.RenewalNoticeDays).
+		SetNillableRenewalPlanID(sub.RenewalPlanID).
+		SetNillableCurrentTermStart(sub.CurrentTermStart).
+		SetNillableCurrentTermEnd(sub.CurrentTermEnd).
 		Save(ctx)
 
 	if err != nil {
@@ -167,6 +192,7 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 	// Set all fields
 	query.
 		SetLookupKey(sub.LookupKey).
+		SetPlanID(sub.PlanID).
 		SetStartDate(sub.StartDate).
 		SetBillingAnchor(sub.BillingAnchor).
 		SetSubscriptionStatus(sub.SubscriptionStatus).
@@ -201,12 +227,70 @@ func (r *subscriptionRepository) Update(ctx context.Context, sub *domainSub.Subs
 		query.ClearEndDate()
 	}
 
+	if sub.TrialStart != nil {
+		query.SetTrialStart(*sub.TrialStart)
+	} else {
+		query.ClearTrialStart()
+	}
+
+	if sub.TrialEnd != nil {
+		query.SetTrialEnd(*sub.TrialEnd)
+	} else {
+		query.ClearTrialEnd()
+	}
+
 	if sub.ActivePauseID != nil {
 		query.SetActivePauseID(*sub.ActivePauseID)
 	} else {
 		query.ClearActivePauseID()
 	}
 
+	if sub.BillingThresholdAmount != nil {
+		query.SetBillingThresholdAmount(*sub.BillingThresholdAmount)
+	} else {
+		query.ClearBillingThresholdAmount()
+	}
+
+	if sub.TrialEndAction != "" {
+		query.SetTrialEndAction(sub.TrialEndAction)
+	}
+	query.SetTrialReminderDays(sub.TrialReminderDays)
+	if sub.BackdatedBillingMode != "" {
+		query.SetBackdatedBillingMode(sub.BackdatedBillingMode)
+	}
+	query.SetBackdatedBillingPending(sub.BackdatedBillingPending)
+	if sub.SpendLimitState != "" {
+		query.SetSpendLimitState(sub.SpendLimitState)
+	}
+	if sub.SpendLimitedAt != nil {
+		query.SetSpendLimitedAt(*sub.SpendLimitedAt)
+	} else {
+		query.ClearSpendLimitedAt()
+	}
+	query.SetTermMonths(sub.TermMonths)
+	query.SetAutoRenew(sub.AutoRenew)
+	query.SetRenewalNoticeDays(sub.RenewalNoticeDays)
+	if sub.RenewalPlanID != nil {
+		query.SetRenewalPlanID(*sub.RenewalPlanID)
+	} else {
+		query.ClearRenewalPlanID()
+	}
+	if sub.CurrentTermStart != nil {
+		query.SetCurrentTermStart(*sub.CurrentTermStart)
+	} else {
+		query.ClearCurrentTermStart()
+	}
+	if sub.CurrentTermEnd != nil {
+		query.SetCurrentTermEnd(*sub.CurrentTermEnd)
+	} else {
+		query.ClearCurrentTermEnd()
+	}
+	if sub.TrialUsageCaps != nil {
+		query.SetTrialUsageCaps(sub.TrialUsageCaps)
+	} else {
+		query.ClearTrialUsageCaps()
+	}
+
 	// Execute update
 	_, err := query.Save(ctx)
 	if err != nil {
@@ -324,24 +408,39 @@ func (r *subscriptionRepository) List(ctx context.Context, filter *types.Subscri
 	return result, nil
 }
 
-// ListActiveSubscriptionsDueForRenewal retrieves all active subscriptions that are due for renewal in 24 hours
+// ListSubscriptionsDueForRenewal retrieves all active and past due subscriptions whose renewal is due in 24 hours.
+// Subscriptions with a term are due at their non-renewal deadline and only when they renew automatically,
+// other subscriptions are due at the end of their current period.
 func (r *subscriptionRepository) ListSubscriptionsDueForRenewal(ctx context.Context) ([]*domainSub.Subscription, error) {
 	now := time.Now().UTC()
 	targetTime := now.Add(24 * time.Hour)
 
-	// Create a 5-minute window around the target time
+	// Create a 2-hour window around the target time
 	windowStart := targetTime.Add(-1 * time.Hour)
 	windowEnd := targetTime.Add(1 * time.Hour)
 
-	// Find subscriptions ending exactly at the target time
 	subs, err := r.client.Reader(ctx).Subscription.Query().
 		Where(
 			subscription.And(
-				subscription.SubscriptionStatusEQ(types.SubscriptionStatusActive),
+				subscription.SubscriptionStatusIn(types.SubscriptionStatusActive, types.SubscriptionStatusPastDue),
 				subscription.StatusEQ(string(types.StatusPublished)),
-				subscription.CurrentPeriodEndGTE(windowStart),
-				subscription.CurrentPeriodEndLTE(windowEnd),
 				subscription.CancelAtPeriodEndEQ(false),
+				subscription.Or(
+					subscription.And(
+						subscription.Or(
+							subscription.TermMonthsEQ(0),
+							subscription.CurrentTermEndIsNil(),
+						),
+						subscription.CurrentPeriodEndGTE(windowStart),
+						subscription.CurrentPeriodEndLTE(windowEnd),
+					),
+					subscription.And(
+						subscription.TermMonthsGT(0),
+						subscription.CurrentTermEndNotNil(),
+						subscription.AutoRenew(true),
+						nonRenewalDeadlineBetween(windowStart, windowEnd),
+					),
+				),
 			),
 		).All(ctx)
 
@@ -358,6 +457,23 @@ func (r *subscriptionRepository) ListSubscriptionsDueForRenewal(ctx context.Cont
 	return result, nil
 }
 
+// nonRenewalDeadlineBetween matches subscriptions whose non-renewal deadline, the end of the current term
+// less the renewal notice days, falls within the given window
+func nonRenewalDeadlineBetween(start, end time.Time) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(").
+				Ident(s.C(subscription.FieldCurrentTermEnd)).
+				WriteString(" - ").
+				Ident(s.C(subscription.FieldRenewalNoticeDays)).
+				WriteString(" * INTERVAL '1 day') BETWEEN ").
+				Arg(start).
+				WriteString(" AND ").
+				Arg(end)
+		}))
+	})
+}
+
 // ListAll retrieves all subscriptions without pagination
 func (r *subscriptionRepository) ListAll(ctx context.Context, filter *types.SubscriptionFilter) ([]*domainSub.Subscription, error) {
 	if filter == nil {
@@ -520,6 +636,8 @@ func (o SubscriptionQueryOptions) GetFieldName(field string) string {
 		return subscription.FieldCurrentPeriodStart
 	case "current_period_end":
 		return subscription.FieldCurrentPeriodEnd
+	case "current_term_end":
+		return subscription.FieldCurrentTermEnd
 	case "status":
 		return subscription.FieldStatus
 	case "subscription_status":
@@ -544,6 +662,8 @@ func (o SubscriptionQueryOptions) GetFieldName(field string) string {
 		return subscription.FieldCommitmentAmount
 	case "overage_factor":
 		return subscription.FieldOverageFactor
+	case "billing_threshold_amount":
+		return subscription.FieldBillingThresholdAmount
 	case "payment_behavior":
 		return subscription.FieldPaymentBehavior
 	case "collection_method":
@@ -641,6 +761,25 @@ func (o *SubscriptionQueryOptions) applyEntityQueryOptions(_ context.Context, f
 		)
 	}
 
+	// Apply trial end filter
+	if f.TrialEndBefore != nil {
+		query = query.Where(subscription.TrialEndLT(*f.TrialEndBefore))
+	}
+
+	// Apply current term end filter
+	if f.CurrentTermEndBefore != nil {
+		query = query.Where(
+			subscription.TermMonthsGT(0),
+			subscription.CurrentTermEndNotNil(),
+			subscription.CurrentTermEndLT(*f.CurrentTermEndBefore),
+		)
+	}
+
+	// Skip subscriptions whose missed periods are being billed
+	if f.ExcludeBackdatedBillingPending {
+		query = query.Where(subscription.BackdatedBillingPending(false))
+	}
+
 	// Apply time range filters
 	if f.TimeRangeFilter != nil {
 		if f.TimeRangeFilter.StartTime != nil {
@@ -730,6 +869,7 @@ func (r *subscriptionRepository) CreateWithLineItems(ctx context.Context, sub *d
 				SetNillablePriceUnit(item.PriceUnit).
 				SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
 				SetQuantity(item.Quantity).
+				SetIncludedQuantity(item.IncludedQuantity).
 				SetCurrency(item.Currency).
 				SetBillingPeriod(item.BillingPeriod).
 				SetNillableStartDate(types.ToNillableTime(item.StartDate)).
@@ -743,6 +883,8 @@ func (r *subscriptionRepository) CreateWithLineItems(ctx context.Context, sub *d
 				SetNillableCommitmentOverageFactor(item.CommitmentOverageFactor).
 				SetCommitmentTrueUpEnabled(item.CommitmentTrueUpEnabled).
 				SetCommitmentWindowed(item.CommitmentWindowed).
+				SetQuantityChanges(item.QuantityChanges).
+				SetRollover(item.Rollover).
 				SetMetadata(item.Metadata).
 				SetTenantID(item.TenantID).
 				SetEnvironmentID(item.EnvironmentID).
@@ -837,6 +979,7 @@ func (r *subscriptionRepository) CreatePause(ctx context.Context, pause *domainS
 		SetSubscriptionID(pause.SubscriptionID).
 		SetPauseStatus(string(pause.PauseStatus)).
 		SetPauseMode(string(pause.PauseMode)).
+		SetBillingMode(string(pause.BillingMode)).
 		SetResumeMode(string(pause.ResumeMode)).
 		SetPauseStart(pause.PauseStart).
 		SetNillablePauseEnd(pause.PauseEnd).
@@ -1076,6 +1219,7 @@ func (r *subscriptionRepository) ListByCustomerID(ctx context.Context, customerI
 		SubscriptionStatus: []types.SubscriptionStatus{
 			types.SubscriptionStatusActive,
 			types.SubscriptionStatusTrialing,
+			types.SubscriptionStatusPastDue,
 		},
 		WithLineItems: true,
 	}
@@ -98,6 +98,7 @@ func (r *subscriptionLineItemRepository) Create(ctx context.Context, item *subsc
 		SetNillablePriceUnit(item.PriceUnit).
 		SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
 		SetQuantity(item.Quantity).
+		SetIncludedQuantity(item.IncludedQuantity).
 		SetCurrency(item.Currency).
 		SetBillingPeriod(item.BillingPeriod).
 		SetNillableStartDate(types.ToNillableTime(item.StartDate)).
@@ -113,6 +114,8 @@ func (r *subscriptionLineItemRepository) Create(ctx context.Context, item *subsc
 		SetNillableCommitmentOverageFactor(item.CommitmentOverageFactor).
 		SetCommitmentTrueUpEnabled(item.CommitmentTrueUpEnabled).
 		SetCommitmentWindowed(item.CommitmentWindowed).
+		SetQuantityChanges(item.QuantityChanges).
+		SetRollover(item.Rollover).
 		SetTenantID(item.TenantID).
 		SetEnvironmentID(item.EnvironmentID).
 		SetStatus(string(item.Status)).
@@ -235,6 +238,7 @@ func (r *subscriptionLineItemRepository) Update(ctx context.Context, item *subsc
 		SetNillablePriceUnit(item.PriceUnit).
 		SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
 		SetQuantity(item.Quantity).
+		SetIncludedQuantity(item.IncludedQuantity).
 		SetCurrency(item.Currency).
 		SetBillingPeriod(item.BillingPeriod).
 		SetNillableStartDate(types.ToNillableTime(item.StartDate)).
@@ -247,6 +251,8 @@ func (r *subscriptionLineItemRepository) Update(ctx context.Context, item *subsc
 		SetNillableCommitmentOverageFactor(item.CommitmentOverageFactor).
 		SetCommitmentTrueUpEnabled(item.CommitmentTrueUpEnabled).
 		SetCommitmentWindowed(item.CommitmentWindowed).
+		SetQuantityChanges(item.QuantityChanges).
+		SetRollover(item.Rollover).
 		SetStatus(string(item.Status)).
 		SetUpdatedBy(item.UpdatedBy).
 		SetUpdatedAt(time.Now()).
@@ -361,6 +367,7 @@ func (r *subscriptionLineItemRepository) CreateBulk(ctx context.Context, items [
 			SetNillablePriceUnit(item.PriceUnit).
 			SetNillableDisplayName(types.ToNillableString(item.DisplayName)).
 			SetQuantity(item.Quantity).
+			SetIncludedQuantity(item.IncludedQuantity).
 			SetCurrency(item.Currency).
 			SetBillingPeriod(item.BillingPeriod).
 			SetInvoiceCadence(item.InvoiceCadence).
@@ -0,0 +1,261 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/ent"
+	"github.com/flexprice/flexprice/ent/subscriptionmigrationresult"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/postgres"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// subscriptionMigrationResultBulkSize bounds the number of results created by a single insert
+const subscriptionMigrationResultBulkSize = 1000
+
+type subscriptionMigrationResultRepository struct {
+	client    postgres.IClient
+	log       *logger.Logger
+	queryOpts SubscriptionMigrationResultQueryOptions
+}
+
+// NewSubscriptionMigrationResultRepository creates a new subscription migration result repository
+func NewSubscriptionMigrationResultRepository(client postgres.IClient, log *logger.Logger) subscription.MigrationResultRepository {
+	return &subscriptionMigrationResultRepository{
+		client:    client,
+		log:       log,
+		queryOpts: SubscriptionMigrationResultQueryOptions{},
+	}
+}
+
+// CreateBulk creates the pending results of the subscriptions selected for a migration
+func (r *subscriptionMigrationResultRepository) CreateBulk(ctx context.Context, results []*subscription.MigrationResult) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_migration_result", "create_bulk", map[string]interface{}{
+		"count": len(results),
+	})
+	defer FinishSpan(span)
+
+	for _, chunk := range lo.Chunk(results, subscriptionMigrationResultBulkSize) {
+		builders := make([]*ent.SubscriptionMigrationResultCreate, 0, len(chunk))
+		for _, result := range chunk {
+			if result.EnvironmentID == "" {
+				result.EnvironmentID = types.GetEnvironmentID(ctx)
+			}
+
+			builders = append(builders, client.SubscriptionMigrationResult.Create().
+				SetID(result.ID).
+				SetTenantID(result.TenantID).
+				SetEnvironmentID(result.EnvironmentID).
+				SetStatus(string(result.Status)).
+				SetTaskID(result.TaskID).
+				SetSubscriptionID(result.SubscriptionID).
+				SetCustomerID(result.CustomerID).
+				SetMigrationStatus(result.MigrationStatus).
+				SetNillableNewSubscriptionID(result.NewSubscriptionID).
+				SetNillableScheduleID(result.ScheduleID).
+				SetNillableError(result.Error).
+				SetNillableProcessedAt(result.ProcessedAt).
+				SetCreatedAt(result.CreatedAt).
+				SetUpdatedAt(result.UpdatedAt).
+				SetCreatedBy(types.GetUserID(ctx)).
+				SetUpdatedBy(types.GetUserID(ctx)))
+		}
+
+		if _, err := client.SubscriptionMigrationResult.CreateBulk(builders...).Save(ctx); err != nil {
+			SetSpanError(span, err)
+			if ent.IsConstraintError(err) {
+				return ierr.WithError(err).
+					WithHint("Subscriptions were already selected for the migration").
+					Mark(ierr.ErrAlreadyExists)
+			}
+			return ierr.WithError(err).
+				WithHint("Failed to create subscription migration results").
+				Mark(ierr.ErrDatabase)
+		}
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+// Update saves the outcome of the migration of a subscription
+func (r *subscriptionMigrationResultRepository) Update(ctx context.Context, result *subscription.MigrationResult) error {
+	client := r.client.Writer(ctx)
+
+	span := StartRepositorySpan(ctx, "subscription_migration_result", "update", map[string]interface{}{
+		"result_id":       result.ID,
+		"subscription_id": result.SubscriptionID,
+	})
+	defer FinishSpan(span)
+
+	_, err := client.SubscriptionMigrationResult.UpdateOneID(result.ID).
+		Where(
+			subscriptionmigrationresult.TenantID(types.GetTenantID(ctx)),
+			subscriptionmigrationresult.EnvironmentID(types.GetEnvironmentID(ctx)),
+		).
+		SetCustomerID(result.CustomerID).
+		SetMigrationStatus(result.MigrationStatus).
+		SetNillableNewSubscriptionID(result.NewSubscriptionID).
+		SetNillableScheduleID(result.ScheduleID).
+		SetNillableError(result.Error).
+		SetNillableProcessedAt(result.ProcessedAt).
+		SetUpdatedAt(time.Now().UTC()).
+		SetUpdatedBy(types.GetUserID(ctx)).
+		Save(ctx)
+
+	if err != nil {
+		SetSpanError(span, err)
+		if ent.IsNotFound(err) {
+			return ierr.WithError(err).
+				WithHint("Subscription migration result not found").
+				WithReportableDetails(map[string]interface{}{
+					"result_id": result.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription migration result").
+			WithReportableDetails(map[string]interface{}{
+				"result_id": result.ID,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return nil
+}
+
+// List retrieves the results of a migration based on filter
+func (r *subscriptionMigrationResultRepository) List(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) ([]*subscription.MigrationResult, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionMigrationResultFilter()
+	}
+
+	span := StartRepositorySpan(ctx, "subscription_migration_result", "list", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).SubscriptionMigrationResult.Query()
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+	query = ApplyQueryOptions(ctx, query, filter, r.queryOpts)
+
+	results, err := query.All(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription migration results").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return subscription.MigrationResultFromEntList(results), nil
+}
+
+// Count returns the count of the results of a migration matching the filter
+func (r *subscriptionMigrationResultRepository) Count(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) (int, error) {
+	span := StartRepositorySpan(ctx, "subscription_migration_result", "count", map[string]interface{}{
+		"filter": filter,
+	})
+	defer FinishSpan(span)
+
+	query := r.client.Reader(ctx).SubscriptionMigrationResult.Query()
+	query = ApplyBaseFilters(ctx, query, filter, r.queryOpts)
+	query = r.queryOpts.applyEntityQueryOptions(ctx, filter, query)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		SetSpanError(span, err)
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscription migration results").
+			WithReportableDetails(map[string]any{
+				"filter": filter,
+			}).
+			Mark(ierr.ErrDatabase)
+	}
+
+	SetSpanSuccess(span)
+	return count, nil
+}
+
+// SubscriptionMigrationResultQuery type alias for better readability
+type SubscriptionMigrationResultQuery = *ent.SubscriptionMigrationResultQuery
+
+// SubscriptionMigrationResultQueryOptions implements query options for subscription migration result filtering and sorting
+type SubscriptionMigrationResultQueryOptions struct{}
+
+func (o SubscriptionMigrationResultQueryOptions) ApplyTenantFilter(ctx context.Context, query SubscriptionMigrationResultQuery) SubscriptionMigrationResultQuery {
+	return query.Where(subscriptionmigrationresult.TenantID(types.GetTenantID(ctx)))
+}
+
+func (o SubscriptionMigrationResultQueryOptions) ApplyEnvironmentFilter(ctx context.Context, query SubscriptionMigrationResultQuery) SubscriptionMigrationResultQuery {
+	environmentID := types.GetEnvironmentID(ctx)
+	if environmentID != "" {
+		return query.Where(subscriptionmigrationresult.EnvironmentIDEQ(environmentID))
+	}
+	return query
+}
+
+func (o SubscriptionMigrationResultQueryOptions) ApplyStatusFilter(query SubscriptionMigrationResultQuery, status string) SubscriptionMigrationResultQuery {
+	if status == "" {
+		return query.Where(subscriptionmigrationresult.StatusNotIn(string(types.StatusDeleted)))
+	}
+	return query.Where(subscriptionmigrationresult.Status(status))
+}
+
+// ApplySortFilter sorts the results, results sharing the sort value keep the order they were created in
+func (o SubscriptionMigrationResultQueryOptions) ApplySortFilter(query SubscriptionMigrationResultQuery, field string, order string) SubscriptionMigrationResultQuery {
+	orderFunc := ent.Desc
+	if order == "asc" {
+		orderFunc = ent.Asc
+	}
+	return query.Order(orderFunc(o.GetFieldName(field)), orderFunc(subscriptionmigrationresult.FieldID))
+}
+
+func (o SubscriptionMigrationResultQueryOptions) ApplyPaginationFilter(query SubscriptionMigrationResultQuery, limit int, offset int) SubscriptionMigrationResultQuery {
+	query = query.Limit(limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	return query
+}
+
+func (o SubscriptionMigrationResultQueryOptions) GetFieldName(field string) string {
+	switch field {
+	case "updated_at":
+		return subscriptionmigrationresult.FieldUpdatedAt
+	case "processed_at":
+		return subscriptionmigrationresult.FieldProcessedAt
+	default:
+		return subscriptionmigrationresult.FieldCreatedAt
+	}
+}
+
+func (o SubscriptionMigrationResultQueryOptions) applyEntityQueryOptions(_ context.Context, f *types.SubscriptionMigrationResultFilter, query SubscriptionMigrationResultQuery) SubscriptionMigrationResultQuery {
+	if f == nil {
+		return query
+	}
+
+	if f.TaskID != "" {
+		query = query.Where(subscriptionmigrationresult.TaskID(f.TaskID))
+	}
+
+	if len(f.MigrationStatus) > 0 {
+		query = query.Where(subscriptionmigrationresult.MigrationStatusIn(f.MigrationStatus...))
+	}
+
+	if len(f.SubscriptionIDs) > 0 {
+		query = query.Where(subscriptionmigrationresult.SubscriptionIDIn(f.SubscriptionIDs...))
+	}
+
+	return query
+}
@@ -61,6 +61,9 @@ func (r *subscriptionPhaseRepository) Create(ctx context.Context, phase *subscri
 		SetSubscriptionID(phase.SubscriptionID).
 		SetStartDate(phase.StartDate).
 		SetNillableEndDate(phase.EndDate).
+		SetNillableCommitmentAmount(phase.CommitmentAmount).
+		SetNillableOverageFactor(phase.OverageFactor).
+		SetNillableCommitmentTrueUpCadence((*string)(phase.CommitmentTrueUpCadence)).
 		SetStatus(string(phase.Status)).
 		SetCreatedAt(phase.CreatedAt).
 		SetUpdatedAt(phase.UpdatedAt).
@@ -122,6 +125,9 @@ func (r *subscriptionPhaseRepository) CreateBulk(ctx context.Context, phases []*
 			SetSubscriptionID(phase.SubscriptionID).
 			SetStartDate(phase.StartDate).
 			SetNillableEndDate(phase.EndDate).
+			SetNillableCommitmentAmount(phase.CommitmentAmount).
+			SetNillableOverageFactor(phase.OverageFactor).
+			SetNillableCommitmentTrueUpCadence((*string)(phase.CommitmentTrueUpCadence)).
 			SetStatus(string(phase.Status)).
 			SetCreatedAt(phase.CreatedAt).
 			SetUpdatedAt(phase.UpdatedAt).
@@ -20,6 +20,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/experiment"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/group"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -29,6 +30,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/planpricesync"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/quote"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
@@ -241,3 +243,19 @@ func NewCostSheetUsageRepository(p RepositoryParams) events.CostSheetUsageReposi
 func NewWorkflowExecutionRepository(p RepositoryParams) workflowexecution.Repository {
 	return entRepo.NewWorkflowExecutionRepository(p.EntClient, p.Logger, p.Cache)
 }
+
+func NewExperimentRepository(p RepositoryParams) experiment.Repository {
+	return entRepo.NewExperimentRepository(p.EntClient, p.Logger)
+}
+
+func NewExperimentAssignmentRepository(p RepositoryParams) experiment.AssignmentRepository {
+	return entRepo.NewExperimentAssignmentRepository(p.EntClient, p.Logger)
+}
+
+func NewSubscriptionMigrationResultRepository(p RepositoryParams) subscription.MigrationResultRepository {
+	return entRepo.NewSubscriptionMigrationResultRepository(p.EntClient, p.Logger)
+}
+
+func NewQuoteRepository(p RepositoryParams) quote.Repository {
+	return entRepo.NewQuoteRepository(p.EntClient, p.Logger)
+}
@@ -53,6 +53,15 @@ type BillingService interface {
 	// using the reference point to determine which charges to include
 	PrepareSubscriptionInvoiceRequest(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time, referencePoint types.InvoiceReferencePoint) (*dto.CreateInvoiceRequest, error)
 
+	// PrepareSubscriptionPeriodInvoiceRequest prepares the invoice request of a billing period that
+	// ended and settles the rollover of the period. Every invoice of an ended period is prepared
+	// with it, previews use PrepareSubscriptionInvoiceRequest.
+	PrepareSubscriptionPeriodInvoiceRequest(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time) (*dto.CreateInvoiceRequest, error)
+
+	// PrepareThresholdInvoiceRequest prepares an interim invoice request when the unbilled usage
+	// accrued in the current period exceeds the subscription's billing threshold
+	PrepareThresholdInvoiceRequest(ctx context.Context, sub *subscription.Subscription, asOf time.Time) (*dto.CreateInvoiceRequest, error)
+
 	// ClassifyLineItems classifies line items based on cadence and type
 	ClassifyLineItems(sub *subscription.Subscription, currentPeriodStart, currentPeriodEnd time.Time, nextPeriodStart, nextPeriodEnd time.Time) *LineItemClassification
 
@@ -77,6 +86,10 @@ type BillingService interface {
 
 	// CalculateFeatureUsageCharges calculates usage charges for a subscription
 	CalculateFeatureUsageCharges(ctx context.Context, sub *subscription.Subscription, usage *dto.GetUsageBySubscriptionResponse, periodStart, periodEnd time.Time) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error)
+
+	// SettleRollover records the rolled over usage consumed in a billing period and carries the
+	// unused included usage of the period over to the following periods
+	SettleRollover(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time) error
 }
 
 type billingService struct {
@@ -99,6 +112,13 @@ func (s *billingService) CalculateFixedCharges(
 	fixedCostLineItems := make([]dto.CreateInvoiceLineItemRequest, 0)
 
 	priceService := NewPriceService(s.ServiceParams)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	ctx = types.WithRoundingConfig(ctx, rounding)
+
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, sub.CustomerID)
+	if err != nil {
+		return nil, fixedCost, err
+	}
 
 	// Process fixed charges from line items
 	for _, item := range sub.LineItems {
@@ -122,19 +142,55 @@ func (s *billingService) CalculateFixedCharges(
 			return nil, fixedCost, err
 		}
 
+		// Negotiated rates from the rate card of the customer take precedence over the plan price
+		price.Price = priceDomain.RateCardPriceFor(rateCard, price.Price, periodStart)
+
+		// Addons with their own billing period are charged per cycle of the addon
+		if hasOwnBillingPeriod(sub, item, price.Price) {
+			cycleLineItems, cycleCost, err := s.calculateAddonCycleCharges(ctx, sub, item, price.Price, periodStart, periodEnd)
+			if err != nil {
+				return nil, fixedCost, err
+			}
+			fixedCostLineItems = append(fixedCostLineItems, cycleLineItems...)
+			fixedCost = fixedCost.Add(cycleCost)
+			continue
+		}
+
+		// Items billed in arrear whose quantity changed during the period are charged per
+		// quantity segment so that the invoice shows the seat timeline
+		if segmentLineItems, segmentCost := s.calculateQuantitySegmentCharges(ctx, sub, item, price.Price, periodStart, periodEnd); len(segmentLineItems) > 0 {
+			fixedCostLineItems = append(fixedCostLineItems, segmentLineItems...)
+			fixedCost = fixedCost.Add(segmentCost)
+			continue
+		}
+
+		// Items billed in advance are charged for the quantity at the start of the period,
+		// changes within the period are prorated when they are made
+		if item.HasQuantityChanges() {
+			quantityItem := *item
+			quantityItem.Quantity = item.QuantityAt(item.GetPeriodStart(periodStart))
+			item = &quantityItem
+		}
+
 		amount := priceService.CalculateCost(ctx, price.Price, item.Quantity)
 
-		// Apply proration if applicable
-		proratedAmount, err := s.applyProrationToLineItem(ctx, sub, item, price.Price, amount, &periodStart, &periodEnd)
-		if err != nil {
-			s.Logger.Warnw("failed to apply proration to line item, using original amount",
-				"error", err,
-				"subscription_id", sub.ID,
-				"line_item_id", item.ID,
-				"price_id", item.PriceID)
-			proratedAmount = amount
+		if item.EntityType == types.SubscriptionLineItemEntityTypeAddon {
+			// Addons are charged for the part of the period they are active, added addons are
+			// prorated from their start or the end of their trial and removed ones to their end
+			amount = amount.Mul(addonActiveFraction(item, periodStart, periodEnd))
+		} else {
+			// Apply proration if applicable
+			proratedAmount, err := s.applyProrationToLineItem(ctx, sub, item, price.Price, amount, &periodStart, &periodEnd)
+			if err != nil {
+				s.Logger.Warnw("failed to apply proration to line item, using original amount",
+					"error", err,
+					"subscription_id", sub.ID,
+					"line_item_id", item.ID,
+					"price_id", item.PriceID)
+				proratedAmount = amount
+			}
+			amount = proratedAmount
 		}
-		amount = proratedAmount
 
 		// Calculate price unit amount if price unit is available
 		var priceUnitAmount decimal.Decimal
@@ -161,9 +217,9 @@ func (s *billingService) CalculateFixedCharges(
 		}
 
 		// Round fixed charge amount to currency precision before creating invoice line item
-		// This ensures all line items use proper currency precision from the start
+		// unless the environment rounds at the invoice level
 		// Example: $10.278798 → $10.28 for USD (2 decimals), ¥1023.45 → ¥1023 for JPY (0 decimals)
-		roundedAmount := types.RoundToCurrencyPrecision(amount, sub.Currency)
+		roundedAmount := rounding.RoundLineItemAmount(amount, sub.Currency)
 
 		fixedCostLineItems = append(fixedCostLineItems, dto.CreateInvoiceLineItemRequest{
 			EntityID:        lo.ToPtr(item.EntityID),
@@ -203,6 +259,8 @@ func (s *billingService) CalculateUsageCharges(
 
 	usageCharges := make([]dto.CreateInvoiceLineItemRequest, 0)
 	totalUsageCost := decimal.Zero
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	ctx = types.WithRoundingConfig(ctx, rounding)
 
 	// Use subscription service to get aggregated entitlements
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
@@ -275,153 +333,45 @@ func (s *billingService) CalculateUsageCharges(
 			continue
 		}
 
+		// Charges of time-of-use prices only cover part of the meter usage, so the entitlement, the
+		// included usage and the line item commitment are applied to the total of the charges of the
+		// line item
+		var timeWindowCharges map[*dto.SubscriptionUsageByMetersResponse]*timeWindowCharge
+		if matchingCharges[0].Price != nil && len(matchingCharges[0].Price.TimeWindows) > 0 {
+			timeWindowCharges, err = s.applyEntitlementAndCommitmentToTimeWindowCharges(ctx, priceService, sub, item, matchingCharges,
+				entitlementsByMeterID[item.MeterID], meterMap[item.MeterID], customer, eventService, periodStart, periodEnd)
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+		}
+
+		// The usage included by the line item is shared by its normal and overage charges
+		includedRemaining := item.IncludedQuantity
+
 		// Process each matching charge individually (normal and overage charges)
 		for _, matchingCharge := range matchingCharges {
 			quantityForCalculation := decimal.NewFromFloat(matchingCharge.Quantity)
 			matchingEntitlement, ok := entitlementsByMeterID[item.MeterID]
 
+			// Cost-plus charges are rated as zero without a cost basis, so the invoice fails instead
+			costBasis, err := s.getCostBasisPrice(ctx, priceService, matchingCharge.Price)
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+			timeWindowCharge, hasTimeWindows := timeWindowCharges[matchingCharge]
+			if hasTimeWindows {
+				quantityForCalculation = timeWindowCharge.quantity
+			}
+
 			// Only apply entitlement adjustments if:
 			// 1. This is not an overage charge
 			// 2. There is a matching entitlement
 			// 3. The entitlement is enabled
-			if !matchingCharge.IsOverage && ok && matchingEntitlement.IsEnabled {
+			if !hasTimeWindows && !matchingCharge.IsOverage && ok && matchingEntitlement.IsEnabled {
 				if matchingEntitlement.UsageLimit != nil {
-
-					// consider the usage reset period
-					// TODO: Support other reset periods i.e. weekly, yearly
-					// usage limit is set, so we decrement the usage quantity by the already entitled usage
-
-					// case 1 : when the usage reset period is billing period
-					if (matchingEntitlement.UsageResetPeriod) == types.EntitlementUsageResetPeriod(sub.BillingPeriod) {
-
-						usageAllowed := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						adjustedQuantity := decimal.NewFromFloat(matchingCharge.Quantity).Sub(usageAllowed)
-						quantityForCalculation = decimal.Max(adjustedQuantity, decimal.Zero)
-
-					} else if matchingEntitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_DAILY {
-
-						// case 2 : when the usage reset period is daily
-						// For daily reset periods, we need to fetch usage with daily window size
-						// and calculate overage per day, then sum the total overage
-
-						// Create usage request with daily window size
-						usageRequest := &dto.GetUsageByMeterRequest{
-							MeterID:            item.MeterID,
-							PriceID:            item.PriceID,
-							ExternalCustomerID: customer.ExternalID,
-							StartTime:          item.GetPeriodStart(periodStart),
-							EndTime:            item.GetPeriodEnd(periodEnd),
-							WindowSize:         types.WindowSizeDay, // Use daily window size
-						}
-
-						// Get usage data with daily windows
-						usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
-						if err != nil {
-							return nil, decimal.Zero, err
-						}
-
-						// Calculate daily limit
-						dailyLimit := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						totalBillableQuantity := decimal.Zero
-
-						s.Logger.Debugw("calculating daily usage charges",
-							"subscription_id", sub.ID,
-							"line_item_id", item.ID,
-							"meter_id", item.MeterID,
-							"daily_limit", dailyLimit,
-							"num_daily_windows", len(usageResult.Results))
-
-						// Process each daily window
-						for _, dailyResult := range usageResult.Results {
-							dailyUsage := dailyResult.Value
-
-							// Calculate overage for this day: max(0, daily_usage - daily_limit)
-							dailyOverage := decimal.Max(decimal.Zero, dailyUsage.Sub(dailyLimit))
-
-							if dailyOverage.GreaterThan(decimal.Zero) {
-								// Add to total billable quantity
-								totalBillableQuantity = totalBillableQuantity.Add(dailyOverage)
-
-								s.Logger.Debugw("daily overage calculated",
-									"subscription_id", sub.ID,
-									"line_item_id", item.ID,
-									"date", dailyResult.WindowSize,
-									"daily_usage", dailyUsage,
-									"daily_limit", dailyLimit,
-									"daily_overage", dailyOverage)
-							}
-						}
-
-						// Use the total billable quantity for calculation
-						quantityForCalculation = totalBillableQuantity
-					} else if matchingEntitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY {
-
-						// case 3 : when the usage reset period is monthly
-						// For monthly reset periods, we need to fetch usage with monthly window size
-						// and calculate overage per month, then sum the total overage
-
-						// Create usage request with monthly window size
-						usageRequest := &dto.GetUsageByMeterRequest{
-							MeterID:            item.MeterID,
-							PriceID:            item.PriceID,
-							ExternalCustomerID: customer.ExternalID,
-							StartTime:          item.GetPeriodStart(periodStart),
-							EndTime:            item.GetPeriodEnd(periodEnd),
-							BillingAnchor:      &sub.BillingAnchor,
-							WindowSize:         types.WindowSizeMonth, // Use monthly window size
-						}
-
-						// Get usage data with monthly windows
-						usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
-						if err != nil {
-							return nil, decimal.Zero, err
-						}
-
-						// Calculate monthly limit
-						monthlyLimit := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						totalBillableQuantity := decimal.Zero
-
-						s.Logger.Debugw("calculating monthly usage charges",
-							"subscription_id", sub.ID,
-							"line_item_id", item.ID,
-							"meter_id", item.MeterID,
-							"monthly_limit", monthlyLimit,
-							"num_monthly_windows", len(usageResult.Results))
-
-						// Process each monthly window
-						for _, monthlyResult := range usageResult.Results {
-							monthlyUsage := monthlyResult.Value
-
-							// Calculate overage for this month: max(0, monthly_usage - monthly_limit)
-							monthlyOverage := decimal.Max(decimal.Zero, monthlyUsage.Sub(monthlyLimit))
-
-							if monthlyOverage.GreaterThan(decimal.Zero) {
-								// Add to total billable quantity
-								totalBillableQuantity = totalBillableQuantity.Add(monthlyOverage)
-
-								s.Logger.Debugw("monthly overage calculated",
-									"subscription_id", sub.ID,
-									"line_item_id", item.ID,
-									"month", monthlyResult.WindowSize,
-									"monthly_usage", monthlyUsage,
-									"monthly_limit", monthlyLimit,
-									"monthly_overage", monthlyOverage)
-							}
-						}
-
-						// Use the total billable quantity for calculation
-						quantityForCalculation = totalBillableQuantity
-					} else if matchingEntitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER {
-						// Calculate usage for never reset entitlements using helper function
-						usageAllowed := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						quantityForCalculation, err = s.calculateNeverResetUsage(ctx, sub, item, customer, eventService, periodStart, periodEnd, usageAllowed)
-						if err != nil {
-							return nil, decimal.Zero, err
-						}
-					} else {
-						usageAllowed := decimal.NewFromFloat(float64(*matchingEntitlement.UsageLimit))
-						adjustedQuantity := decimal.NewFromFloat(matchingCharge.Quantity).Sub(usageAllowed)
-						quantityForCalculation = decimal.Max(adjustedQuantity, decimal.Zero)
+					quantityForCalculation, err = s.billableQuantityAfterEntitlement(ctx, sub, item, matchingEntitlement, customer, eventService, periodStart, periodEnd, decimal.NewFromFloat(matchingCharge.Quantity))
+					if err != nil {
+						return nil, decimal.Zero, err
 					}
 
 					// Recalculate the amount based on the adjusted quantity
@@ -524,15 +474,31 @@ func (s *billingService) CalculateUsageCharges(
 			// For all other cases (no entitlement, disabled entitlement, or overage),
 			// use the full quantity and calculate the amount normally
 
+			// Rolled over units are used before the included usage of the period
+			quantityForCalculation, rolloverMetadata := s.applyRolloverToCharge(ctx, priceService, item, matchingCharge, meterMap[item.MeterID], periodStart, quantityForCalculation)
+
+			// The included usage of the period is deducted before the billing model is applied
+			quantityForCalculation, includedMetadata := s.applyIncludedQuantityToCharge(ctx, priceService, item, matchingCharge, meterMap[item.MeterID], &includedRemaining, quantityForCalculation)
+			if hasTimeWindows && item.IncludedQuantity.IsPositive() {
+				includedMetadata = includedQuantityMetadata(timeWindowCharge.included, timeWindowCharge.quantity)
+			}
+
 			// Add the amount to total usage cost
 			lineItemAmount := decimal.NewFromFloat(matchingCharge.Amount)
 
+			// Show the cost basis of cost-plus charges from the rated amount before commitments
+			costPlusMetadata := costPlusLineItemMetadata(matchingCharge.Price, costBasis, quantityForCalculation, lineItemAmount)
+
 			// Store commitment info separately
 			var commitmentInfo *types.CommitmentInfo
 
 			// Apply line-item commitment if configured
 			// Line item commitment takes precedence over subscription-level commitment
-			if item.HasCommitment() {
+			if hasTimeWindows {
+				lineItemAmount = timeWindowCharge.amount
+				matchingCharge.Amount = lineItemAmount.InexactFloat64()
+				commitmentInfo = timeWindowCharge.commitmentInfo
+			} else if item.HasCommitment() {
 				// Defensive check: skip commitment application if Price is nil
 				if matchingCharge.Price == nil {
 					s.Logger.Debugw("skipping commitment application due to missing price",
@@ -605,9 +571,9 @@ func (s *billingService) CalculateUsageCharges(
 			}
 
 			// Round line item amount to currency precision before creating invoice line item
-			// This ensures all line items use proper currency precision from the start
+			// unless the environment rounds at the invoice level
 			// Example: $10.278798 → $10.28 for USD (2 decimals), ¥1023.45 → ¥1023 for JPY (0 decimals)
-			roundedLineItemAmount := types.RoundToCurrencyPrecision(lineItemAmount, sub.Currency)
+			roundedLineItemAmount := rounding.RoundLineItemAmount(lineItemAmount, sub.Currency)
 
 			// Add rounded amount to total to ensure subtotal = sum of rounded line items
 			totalUsageCost = totalUsageCost.Add(roundedLineItemAmount)
@@ -616,6 +582,15 @@ func (s *billingService) CalculateUsageCharges(
 			metadata := types.Metadata{
 				"description": fmt.Sprintf("%s (Usage Charge)", item.DisplayName),
 			}
+			for key, value := range costPlusMetadata {
+				metadata[key] = value
+			}
+			for key, value := range rolloverMetadata {
+				metadata[key] = value
+			}
+			for key, value := range includedMetadata {
+				metadata[key] = value
+			}
 
 			displayName := lo.ToPtr(item.DisplayName)
 
@@ -627,6 +602,13 @@ func (s *billingService) CalculateUsageCharges(
 				displayName = lo.ToPtr(fmt.Sprintf("%s (Overage)", item.DisplayName))
 			}
 
+			// Add time-of-use window information
+			if matchingCharge.TimeWindow != "" {
+				metadata["time_window"] = matchingCharge.TimeWindow
+				metadata["description"] = fmt.Sprintf("%s (Usage Charge - %s)", item.DisplayName, matchingCharge.TimeWindow)
+				displayName = lo.ToPtr(fmt.Sprintf("%s (%s)", lo.FromPtr(displayName), matchingCharge.TimeWindow))
+			}
+
 			// Add usage reset period metadata if entitlement has daily, monthly, or never reset
 			if !matchingCharge.IsOverage && ok && matchingEntitlement.IsEnabled {
 				switch matchingEntitlement.UsageResetPeriod {
@@ -710,8 +692,7 @@ func (s *billingService) CalculateUsageCharges(
 					}
 				}
 				// Round remaining commitment to currency precision (2 decimal places for most currencies)
-				precision := types.GetCurrencyPrecision(sub.Currency)
-				roundedRemainingCommitment := remainingCommitment.Round(precision)
+				roundedRemainingCommitment := rounding.RoundLineItemAmount(remainingCommitment, sub.Currency)
 				commitmentUtilized := commitmentAmount.Sub(roundedRemainingCommitment)
 				trueUpLineItem := dto.CreateInvoiceLineItemRequest{
 					EntityID:        lo.ToPtr(sub.PlanID),
@@ -741,6 +722,48 @@ func (s *billingService) CalculateUsageCharges(
 	return usageCharges, totalUsageCost, nil
 }
 
+// getCostBasisPrice returns the price in the active costsheet that is the cost basis of a cost-plus
+// charge. It returns nil for other prices and an error when the active costsheet has no cost for
+// the meter, so such invoices are not finalized at zero.
+func (s *billingService) getCostBasisPrice(ctx context.Context, priceService PriceService, p *price.Price) (*price.Price, error) {
+	if p == nil || p.BillingModel != types.BILLING_MODEL_COST_PLUS || p.CostPlus == nil {
+		return nil, nil
+	}
+	return priceService.GetCostBasisPrice(ctx, p)
+}
+
+// costPlusLineItemMetadata returns the invoice line metadata showing the cost basis of a
+// cost-plus usage charge. It returns nil for other prices.
+func costPlusLineItemMetadata(
+	p *price.Price,
+	costBasis *price.Price,
+	quantity decimal.Decimal,
+	amount decimal.Decimal,
+) types.Metadata {
+	if costBasis == nil {
+		return nil
+	}
+
+	// The rated amount can come from bucketed usage, so the cost basis is derived from the amount
+	cost := p.CostPlus.CostFor(amount)
+	_, minMarginApplied := p.CostPlus.Apply(cost)
+	metadata := types.Metadata{
+		"cost_basis_costsheet_id": costBasis.EntityID,
+		"cost_basis_price_id":     costBasis.ID,
+		"cost_basis_amount":       types.RoundDecimal(cost, types.GetCurrencyPrecision(p.Currency), types.RoundingModeHalfUp).String(),
+		"cost_plus_mode":          p.CostPlus.Mode.String(),
+		"cost_plus_value":         p.CostPlus.Value.String(),
+		"margin_percent":          p.CostPlus.MarginPercent().Round(2).String(),
+	}
+	if quantity.IsPositive() {
+		metadata["cost_basis_unit_amount"] = cost.Div(quantity).Round(6).String()
+	}
+	if minMarginApplied {
+		metadata["min_margin_applied"] = "true"
+	}
+	return metadata
+}
+
 // calculateRemainingCommitment calculates the remaining commitment amount
 // that needs to be charged as a true-up
 func (s *billingService) calculateRemainingCommitment(
@@ -770,6 +793,8 @@ func (s *billingService) CalculateFeatureUsageCharges(
 
 	usageCharges := make([]dto.CreateInvoiceLineItemRequest, 0)
 	totalUsageCost := decimal.Zero
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	ctx = types.WithRoundingConfig(ctx, rounding)
 
 	// Use subscription service to get aggregated entitlements
 	subscriptionService := NewSubscriptionService(s.ServiceParams)
@@ -853,11 +878,20 @@ func (s *billingService) CalculateFeatureUsageCharges(
 				Mark(ierr.ErrNotFound)
 		}
 
+		// The usage included by the line item is shared by its normal and overage charges
+		includedRemaining := item.IncludedQuantity
+
 		// Process each matching charge individually (normal and overage charges)
 		for _, matchingCharge := range matchingCharges {
 			quantityForCalculation := decimal.NewFromFloat(matchingCharge.Quantity)
 			matchingEntitlement, entitlementOk := entitlementsByMeterID[item.MeterID]
 
+			// Cost-plus charges are rated as zero without a cost basis, so the invoice fails instead
+			costBasis, err := s.getCostBasisPrice(ctx, priceService, matchingCharge.Price)
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+
 			// Handle bucketed max meters first - this should always be checked regardless of entitlements
 			// But skip overage charges as they already have the correct amount with overage factor applied
 			if meter.IsBucketedMaxMeter() && matchingCharge.Price != nil {
@@ -1104,9 +1138,18 @@ func (s *billingService) CalculateFeatureUsageCharges(
 				matchingCharge.Amount = price.FormatAmountToFloat64WithPrecision(adjustedAmount, matchingCharge.Price.Currency)
 			}
 
+			// Rolled over units are used before the included usage of the period
+			quantityForCalculation, rolloverMetadata := s.applyRolloverToCharge(ctx, priceService, item, matchingCharge, meter, periodStart, quantityForCalculation)
+
+			// The included usage of the period is deducted before the billing model is applied
+			quantityForCalculation, includedMetadata := s.applyIncludedQuantityToCharge(ctx, priceService, item, matchingCharge, meter, &includedRemaining, quantityForCalculation)
+
 			// Add the amount to total usage cost
 			lineItemAmount := decimal.NewFromFloat(matchingCharge.Amount)
 
+			// Show the cost basis of cost-plus charges from the rated amount before commitments
+			costPlusMetadata := costPlusLineItemMetadata(matchingCharge.Price, costBasis, quantityForCalculation, lineItemAmount)
+
 			// Store commitment info separately
 			var commitmentInfo *types.CommitmentInfo
 
@@ -1190,6 +1233,15 @@ func (s *billingService) CalculateFeatureUsageCharges(
 			metadata := types.Metadata{
 				"description": fmt.Sprintf("%s (Usage Charge)", item.DisplayName),
 			}
+			for key, value := range costPlusMetadata {
+				metadata[key] = value
+			}
+			for key, value := range rolloverMetadata {
+				metadata[key] = value
+			}
+			for key, value := range includedMetadata {
+				metadata[key] = value
+			}
 
 			displayName := lo.ToPtr(item.DisplayName)
 
@@ -1285,8 +1337,7 @@ func (s *billingService) CalculateFeatureUsageCharges(
 					}
 				}
 				// Round remaining commitment to currency precision (2 decimal places for most currencies)
-				precision := types.GetCurrencyPrecision(sub.Currency)
-				roundedRemainingCommitment := remainingCommitment.Round(precision)
+				roundedRemainingCommitment := rounding.RoundLineItemAmount(remainingCommitment, sub.Currency)
 				commitmentUtilized := commitmentAmount.Sub(roundedRemainingCommitment)
 				trueUpLineItem := dto.CreateInvoiceLineItemRequest{
 					EntityID:        lo.ToPtr(sub.PlanID),
@@ -1335,12 +1386,19 @@ func (s *billingService) CalculateAllCharges(
 		return nil, err
 	}
 
-	return &BillingCalculationResult{
+	result := &BillingCalculationResult{
 		FixedCharges: fixedCharges,
 		UsageCharges: usageCharges,
 		TotalAmount:  fixedTotal.Add(usageTotal),
 		Currency:     sub.Currency,
-	}, nil
+	}
+
+	// Re-rate charges of prices that share a pooled tier ladder through their group
+	if err := s.applyPooledTiers(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (s *billingService) calculateAllChargesForPreview(
@@ -1362,12 +1420,41 @@ func (s *billingService) calculateAllChargesForPreview(
 		return nil, err
 	}
 
-	return &BillingCalculationResult{
+	result := &BillingCalculationResult{
 		FixedCharges: fixedCharges,
 		UsageCharges: usageCharges,
 		TotalAmount:  fixedTotal.Add(usageTotal),
 		Currency:     sub.Currency,
-	}, nil
+	}
+
+	// Re-rate charges of prices that share a pooled tier ladder through their group
+	if err := s.applyPooledTiers(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PrepareSubscriptionPeriodInvoiceRequest prepares the invoice request of a billing period that
+// ended and settles the rollover of the period once its usage is billed. Periods without charges
+// carry their included usage over as well. Settled periods are skipped, so preparing the invoice of
+// a period again does not settle it twice.
+func (s *billingService) PrepareSubscriptionPeriodInvoiceRequest(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+) (*dto.CreateInvoiceRequest, error) {
+	invoiceReq, err := s.PrepareSubscriptionInvoiceRequest(ctx, sub, periodStart, periodEnd, types.ReferencePointPeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.SettleRollover(ctx, sub, periodStart, periodEnd); err != nil {
+		return nil, err
+	}
+
+	return invoiceReq, nil
 }
 
 func (s *billingService) PrepareSubscriptionInvoiceRequest(
@@ -1413,6 +1500,31 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 	// Classify line items
 	classification := s.ClassifyLineItems(sub, periodStart, periodEnd, nextPeriodStart, nextPeriodEnd)
 
+	// Advance charges are not billed for periods starting while all billing is paused
+	currentPeriodPaused, err := s.advanceChargesPaused(ctx, sub, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	if currentPeriodPaused {
+		classification.CurrentPeriodAdvance = make([]*subscription.SubscriptionLineItem, 0)
+	}
+	nextPeriodPaused, err := s.advanceChargesPaused(ctx, sub, nextPeriodStart)
+	if err != nil {
+		return nil, err
+	}
+	if nextPeriodPaused {
+		classification.NextPeriodAdvance = make([]*subscription.SubscriptionLineItem, 0)
+	}
+
+	// The advance charges of the periods of a new term are billed by the subscription on the
+	// renewal plan, which replaces the subscription at the end of its term
+	if sub.RenewsToPlanAt(periodStart) {
+		classification.CurrentPeriodAdvance = make([]*subscription.SubscriptionLineItem, 0)
+	}
+	if sub.RenewsToPlanAt(nextPeriodStart) {
+		classification.NextPeriodAdvance = make([]*subscription.SubscriptionLineItem, 0)
+	}
+
 	var calculationResult *BillingCalculationResult
 	var metadata types.Metadata = make(types.Metadata)
 	var description string
@@ -1497,6 +1609,16 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 			Currency:     sub.Currency,
 		}
 
+		// Phase commitments count the gross usage of the period so they are applied before netting
+		if err := s.applyPhaseCommitments(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
+		// Usage already billed by threshold invoices of the period is netted out
+		if err := s.netThresholdBilledCharges(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
 		description = fmt.Sprintf("Invoice for subscription %s", sub.ID)
 
 	case types.ReferencePointPreview:
@@ -1537,6 +1659,14 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 			Currency:     sub.Currency,
 		}
 
+		if err := s.applyPhaseCommitments(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
+		if err := s.netThresholdBilledCharges(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
 		description = fmt.Sprintf("Preview invoice for subscription %s", sub.ID)
 		metadata["is_preview"] = "true"
 	case types.ReferencePointCancel:
@@ -1566,6 +1696,14 @@ func (s *billingService) PrepareSubscriptionInvoiceRequest(
 			Currency:     sub.Currency,
 		}
 
+		if err := s.applyPhaseCommitments(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
+		if err := s.netThresholdBilledCharges(ctx, sub, periodStart, periodEnd, calculationResult); err != nil {
+			return nil, err
+		}
+
 		description = fmt.Sprintf("Invoice for subscription %s", sub.ID)
 
 	default:
@@ -1628,6 +1766,11 @@ func (s *billingService) checkIfChargeInvoiced(
 	periodEnd time.Time,
 ) bool {
 	for _, item := range invoice.LineItems {
+		// consolidated invoices bill the line items of several subscriptions
+		if lo.FromPtr(lo.CoalesceOrEmpty(item.SubscriptionID, invoice.SubscriptionID)) != charge.SubscriptionID {
+			continue
+		}
+
 		// match the price id
 		if lo.FromPtr(item.PriceID) == charge.PriceID {
 			// match the period start and end
@@ -1705,15 +1848,14 @@ func (s *billingService) FilterLineItemsToBeInvoiced(
 
 	filteredLineItems := make([]*subscription.SubscriptionLineItem, 0, len(lineItems))
 
-	// Get existing invoices for this period
+	// Get existing invoices of the customer touching this period, the line items of the subscription
+	// can be billed on the consolidated invoice of another subscription whose period starts earlier
 	invoiceFilter := types.NewNoLimitInvoiceFilter()
-	invoiceFilter.SubscriptionID = sub.ID
+	invoiceFilter.CustomerID = sub.GetInvoicingCustomerID()
 	invoiceFilter.InvoiceType = types.InvoiceTypeSubscription
 	invoiceFilter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
-	invoiceFilter.TimeRangeFilter = &types.TimeRangeFilter{
-		StartTime: lo.ToPtr(periodStart),
-		EndTime:   lo.ToPtr(periodEnd),
-	}
+	invoiceFilter.PeriodStartLTE = lo.ToPtr(periodEnd)
+	invoiceFilter.PeriodEndGTE = lo.ToPtr(periodStart)
 
 	invoices, err := s.InvoiceRepo.List(ctx, invoiceFilter)
 	if err != nil {
@@ -1775,11 +1917,7 @@ func (s *billingService) calculateChargesForPreview(
 
 	if includeUsage {
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
-		usage, err = subscriptionService.GetFeatureUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
-			SubscriptionID: sub.ID,
-			StartTime:      periodStart,
-			EndTime:        periodEnd,
-		})
+		usage, err = s.getBillableUsage(ctx, sub, periodStart, periodEnd, subscriptionService.GetFeatureUsageBySubscription)
 		if err != nil {
 			return nil, err
 		}
@@ -1808,11 +1946,7 @@ func (s *billingService) CalculateCharges(
 
 	if includeUsage {
 		subscriptionService := NewSubscriptionService(s.ServiceParams)
-		usage, err = subscriptionService.GetUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
-			SubscriptionID: sub.ID,
-			StartTime:      periodStart,
-			EndTime:        periodEnd,
-		})
+		usage, err = s.getBillableUsage(ctx, sub, periodStart, periodEnd, subscriptionService.GetUsageBySubscription)
 		if err != nil {
 			return nil, err
 		}
@@ -1945,6 +2079,10 @@ func (s *billingService) CreateInvoiceRequestForCharges(
 	if err != nil {
 		return nil, err
 	}
+	// Line items are unrounded when the environment rounds at the invoice level, so the
+	// subtotal is rounded once here
+	result.TotalAmount = GetRoundingConfig(s.ServiceParams, ctx).RoundAmount(result.TotalAmount, sub.Currency)
+
 	// Create invoice request
 	// Use invoicing customer ID if available, otherwise fallback to subscription customer ID
 	req := &dto.CreateInvoiceRequest{
@@ -2032,6 +2170,7 @@ func aggregateMeteredEntitlementsForBilling(entitlements []*entitlement.Entitlem
 	isSoftLimit := false
 	var totalLimit int64 = 0
 	var usageResetPeriod types.EntitlementUsageResetPeriod
+	var rolloverConfig *types.RolloverConfig
 	resetPeriodCounts := make(map[types.EntitlementUsageResetPeriod]int)
 
 	for _, e := range entitlements {
@@ -2048,6 +2187,11 @@ func aggregateMeteredEntitlementsForBilling(entitlements []*entitlement.Entitlem
 			isSoftLimit = true
 		}
 
+		// the first entitlement with a rollover decides how the unused limit rolls over
+		if rolloverConfig == nil {
+			rolloverConfig = e.RolloverConfig
+		}
+
 		// total limit is the sum of all limits
 		totalLimit += *e.UsageLimit
 
@@ -2068,6 +2212,8 @@ func aggregateMeteredEntitlementsForBilling(entitlements []*entitlement.Entitlem
 	var finalLimit *int64
 	if !hasUnlimitedEntitlement {
 		finalLimit = &totalLimit
+	} else {
+		rolloverConfig = nil
 	}
 
 	return &dto.AggregatedEntitlement{
@@ -2075,6 +2221,7 @@ func aggregateMeteredEntitlementsForBilling(entitlements []*entitlement.Entitlem
 		UsageLimit:       finalLimit,
 		IsSoftLimit:      isSoftLimit,
 		UsageResetPeriod: usageResetPeriod,
+		RolloverConfig:   rolloverConfig,
 	}
 
 }
@@ -2218,6 +2365,7 @@ func (s *billingService) AggregateEntitlements(entitlements []*dto.EntitlementRe
 				UsageResetPeriod: types.EntitlementUsageResetPeriod(entResp.UsageResetPeriod),
 				IsSoftLimit:      entResp.IsSoftLimit,
 				StaticValue:      entResp.StaticValue,
+				RolloverConfig:   entResp.RolloverConfig,
 			}
 			domainEntitlements = append(domainEntitlements, domainEnt)
 		}
@@ -2389,7 +2537,7 @@ func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID
 
 	// Fetch all subscriptions at once
 	for _, subscriptionID := range subscriptionIDs {
-		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		sub, _, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
 		if err != nil {
 			s.Logger.Warnw("failed to get subscription", "subscription_id", subscriptionID, "error", err)
 			continue
@@ -2606,6 +2754,41 @@ func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID
 		}
 	}
 
+	// Rolled over units left for the current period of metered features, they are used before the
+	// included usage of the period
+	featureRolloverMap := make(map[string]decimal.Decimal)
+	for featureID, sub := range featureSubscriptionMap {
+		for _, item := range sub.LineItems {
+			if item.PriceType != types.PRICE_TYPE_USAGE || item.MeterID != featureMeterMap[featureID] || item.Rollover == nil {
+				continue
+			}
+			available := item.Rollover.Available(sub.CurrentPeriodStart)
+			consumed := item.Rollover.ConsumedIn(sub.CurrentPeriodStart, usageByFeature[featureID])
+			featureRolloverMap[featureID] = featureRolloverMap[featureID].Add(available.Sub(consumed))
+		}
+	}
+
+	// Usage included for free in each period by the usage line items of metered features
+	// and the part of it left after the usage of the current period. Rolled over units are used first.
+	featureIncludedMap := make(map[string]decimal.Decimal)
+	featureIncludedRemainingMap := make(map[string]decimal.Decimal)
+	for featureID, sub := range featureSubscriptionMap {
+		included := decimal.Zero
+		rolloverConsumed := decimal.Zero
+		for _, item := range sub.LineItems {
+			if item.PriceType != types.PRICE_TYPE_USAGE || item.MeterID != featureMeterMap[featureID] || !item.IsActive(currentTime) {
+				continue
+			}
+			included = included.Add(item.IncludedQuantity)
+			if item.Rollover != nil {
+				rolloverConsumed = rolloverConsumed.Add(item.Rollover.ConsumedIn(sub.CurrentPeriodStart, usageByFeature[featureID]))
+			}
+		}
+		usedIncluded := decimal.Max(decimal.Zero, usageByFeature[featureID].Sub(rolloverConsumed))
+		featureIncludedMap[featureID] = included
+		featureIncludedRemainingMap[featureID] = decimal.Max(decimal.Zero, included.Sub(usedIncluded))
+	}
+
 	// 5. Sort features by type and name
 	features := entitlements.Features
 	featureOrder := map[types.FeatureType]int{
@@ -2644,6 +2827,10 @@ func (s *billingService) GetCustomerUsageSummary(ctx context.Context, customerID
 			IsSoftLimit:      feature.Entitlement.IsSoftLimit,
 			Sources:          feature.Sources,
 			NextUsageResetAt: nextUsageResetAt,
+			RolloverBalance:  featureRolloverMap[featureID],
+
+			IncludedQuantity:  featureIncludedMap[featureID],
+			IncludedRemaining: featureIncludedRemainingMap[featureID],
 		}
 
 		resp.Features = append(resp.Features, featureSummary)
@@ -2664,6 +2851,155 @@ func (s *billingService) getUsagePercent(usage decimal.Decimal, limit *int64) de
 	return usage.Div(decimal.NewFromInt(*limit))
 }
 
+// billableQuantityAfterEntitlement returns the quantity of a usage line item that is billed once
+// the usage limit of its entitlement is deducted, per the usage reset period of the entitlement.
+// The entitlement must have a usage limit.
+func (s *billingService) billableQuantityAfterEntitlement(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	entitlement *dto.AggregatedEntitlement,
+	customer *customer.Customer,
+	eventService EventService,
+	periodStart,
+	periodEnd time.Time,
+	quantity decimal.Decimal,
+) (decimal.Decimal, error) {
+	// consider the usage reset period
+	// TODO: Support other reset periods i.e. weekly, yearly
+	// usage limit is set, so we decrement the usage quantity by the already entitled usage
+
+	// case 1 : when the usage reset period is billing period
+	if (entitlement.UsageResetPeriod) == types.EntitlementUsageResetPeriod(sub.BillingPeriod) {
+
+		usageAllowed := decimal.NewFromFloat(float64(*entitlement.UsageLimit))
+		adjustedQuantity := quantity.Sub(usageAllowed)
+		return decimal.Max(adjustedQuantity, decimal.Zero), nil
+
+	} else if entitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_DAILY {
+
+		// case 2 : when the usage reset period is daily
+		// For daily reset periods, we need to fetch usage with daily window size
+		// and calculate overage per day, then sum the total overage
+
+		// Create usage request with daily window size
+		usageRequest := &dto.GetUsageByMeterRequest{
+			MeterID:            item.MeterID,
+			PriceID:            item.PriceID,
+			ExternalCustomerID: customer.ExternalID,
+			StartTime:          item.GetPeriodStart(periodStart),
+			EndTime:            item.GetPeriodEnd(periodEnd),
+			WindowSize:         types.WindowSizeDay, // Use daily window size
+		}
+
+		// Get usage data with daily windows
+		usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		// Calculate daily limit
+		dailyLimit := decimal.NewFromFloat(float64(*entitlement.UsageLimit))
+		totalBillableQuantity := decimal.Zero
+
+		s.Logger.Debugw("calculating daily usage charges",
+			"subscription_id", sub.ID,
+			"line_item_id", item.ID,
+			"meter_id", item.MeterID,
+			"daily_limit", dailyLimit,
+			"num_daily_windows", len(usageResult.Results))
+
+		// Process each daily window
+		for _, dailyResult := range usageResult.Results {
+			dailyUsage := dailyResult.Value
+
+			// Calculate overage for this day: max(0, daily_usage - daily_limit)
+			dailyOverage := decimal.Max(decimal.Zero, dailyUsage.Sub(dailyLimit))
+
+			if dailyOverage.GreaterThan(decimal.Zero) {
+				// Add to total billable quantity
+				totalBillableQuantity = totalBillableQuantity.Add(dailyOverage)
+
+				s.Logger.Debugw("daily overage calculated",
+					"subscription_id", sub.ID,
+					"line_item_id", item.ID,
+					"date", dailyResult.WindowSize,
+					"daily_usage", dailyUsage,
+					"daily_limit", dailyLimit,
+					"daily_overage", dailyOverage)
+			}
+		}
+
+		// Use the total billable quantity for calculation
+		return totalBillableQuantity, nil
+	} else if entitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY {
+
+		// case 3 : when the usage reset period is monthly
+		// For monthly reset periods, we need to fetch usage with monthly window size
+		// and calculate overage per month, then sum the total overage
+
+		// Create usage request with monthly window size
+		usageRequest := &dto.GetUsageByMeterRequest{
+			MeterID:            item.MeterID,
+			PriceID:            item.PriceID,
+			ExternalCustomerID: customer.ExternalID,
+			StartTime:          item.GetPeriodStart(periodStart),
+			EndTime:            item.GetPeriodEnd(periodEnd),
+			BillingAnchor:      &sub.BillingAnchor,
+			WindowSize:         types.WindowSizeMonth, // Use monthly window size
+		}
+
+		// Get usage data with monthly windows
+		usageResult, err := eventService.GetUsageByMeter(ctx, usageRequest)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		// Calculate monthly limit
+		monthlyLimit := decimal.NewFromFloat(float64(*entitlement.UsageLimit))
+		totalBillableQuantity := decimal.Zero
+
+		s.Logger.Debugw("calculating monthly usage charges",
+			"subscription_id", sub.ID,
+			"line_item_id", item.ID,
+			"meter_id", item.MeterID,
+			"monthly_limit", monthlyLimit,
+			"num_monthly_windows", len(usageResult.Results))
+
+		// Process each monthly window
+		for _, monthlyResult := range usageResult.Results {
+			monthlyUsage := monthlyResult.Value
+
+			// Calculate overage for this month: max(0, monthly_usage - monthly_limit)
+			monthlyOverage := decimal.Max(decimal.Zero, monthlyUsage.Sub(monthlyLimit))
+
+			if monthlyOverage.GreaterThan(decimal.Zero) {
+				// Add to total billable quantity
+				totalBillableQuantity = totalBillableQuantity.Add(monthlyOverage)
+
+				s.Logger.Debugw("monthly overage calculated",
+					"subscription_id", sub.ID,
+					"line_item_id", item.ID,
+					"month", monthlyResult.WindowSize,
+					"monthly_usage", monthlyUsage,
+					"monthly_limit", monthlyLimit,
+					"monthly_overage", monthlyOverage)
+			}
+		}
+
+		// Use the total billable quantity for calculation
+		return totalBillableQuantity, nil
+	} else if entitlement.UsageResetPeriod == types.ENTITLEMENT_USAGE_RESET_PERIOD_NEVER {
+		// Calculate usage for never reset entitlements using helper function
+		usageAllowed := decimal.NewFromFloat(float64(*entitlement.UsageLimit))
+		return s.calculateNeverResetUsage(ctx, sub, item, customer, eventService, periodStart, periodEnd, usageAllowed)
+	} else {
+		usageAllowed := decimal.NewFromFloat(float64(*entitlement.UsageLimit))
+		adjustedQuantity := quantity.Sub(usageAllowed)
+		return decimal.Max(adjustedQuantity, decimal.Zero), nil
+	}
+}
+
 // calculateNeverResetUsage calculates billable usage for never reset entitlements with line item lifecycle awareness
 // This function is optimized for period-end billing scenarios where we need to calculate cumulative usage
 // that respects line item boundaries and lifecycle states.
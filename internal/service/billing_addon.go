@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// maxAddonCycles bounds the number of cycles walked from the start of an addon line item
+const maxAddonCycles = 1000
+
+// addonCycle is a billing cycle of an addon with its own billing period
+type addonCycle struct {
+	start time.Time
+	end   time.Time
+}
+
+// hasOwnBillingPeriod returns true if the line item is an addon billed on a period different
+// from the billing period of the subscription
+func hasOwnBillingPeriod(sub *subscription.Subscription, item *subscription.SubscriptionLineItem, p *price.Price) bool {
+	return item.EntityType == types.SubscriptionLineItemEntityTypeAddon &&
+		(p.BillingPeriod != sub.BillingPeriod || p.BillingPeriodCount != sub.BillingPeriodCount)
+}
+
+// addonCycles returns the billing cycles anchored at anchor that overlap [start, end), cycles
+// starting at or after endDate are not returned when it is set
+func addonCycles(anchor, endDate time.Time, period types.BillingPeriod, periodCount int, start, end time.Time) ([]addonCycle, error) {
+	cycles := make([]addonCycle, 0, 1)
+	cycleStart := anchor
+	for i := 0; i < maxAddonCycles && cycleStart.Before(end); i++ {
+		if !endDate.IsZero() && !cycleStart.Before(endDate) {
+			break
+		}
+
+		cycleEnd, err := types.NextBillingDate(cycleStart, anchor, periodCount, period, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !cycleEnd.After(cycleStart) {
+			return nil, ierr.NewError("invalid addon billing cycle").
+				WithHint("The billing period of the addon does not advance").
+				WithReportableDetails(map[string]interface{}{
+					"billing_period":       period,
+					"billing_period_count": periodCount,
+				}).
+				Mark(ierr.ErrInternal)
+		}
+
+		if cycleEnd.After(start) {
+			cycles = append(cycles, addonCycle{start: cycleStart, end: cycleEnd})
+		}
+		cycleStart = cycleEnd
+	}
+	return cycles, nil
+}
+
+// lineItemAddonCycles returns the cycles of an addon line item with its own billing period that
+// overlap [start, end). Cycles are anchored at the start date of the line item, which is the end
+// of the trial for addons with a trial.
+func lineItemAddonCycles(item *subscription.SubscriptionLineItem, p *price.Price, start, end time.Time) ([]addonCycle, error) {
+	return addonCycles(item.StartDate, item.EndDate, p.BillingPeriod, p.BillingPeriodCount, start, end)
+}
+
+// addonActiveFraction returns the part of [start, end) in which the addon line item is active
+func addonActiveFraction(item *subscription.SubscriptionLineItem, start, end time.Time) decimal.Decimal {
+	total := end.Sub(start)
+	if total <= 0 {
+		return decimal.Zero
+	}
+
+	activeStart := lo.Ternary(item.StartDate.After(start), item.StartDate, start)
+	activeEnd := lo.Ternary(!item.EndDate.IsZero() && item.EndDate.Before(end), item.EndDate, end)
+	if !activeEnd.After(activeStart) {
+		return decimal.Zero
+	}
+	if activeStart.Equal(start) && activeEnd.Equal(end) {
+		return decimal.NewFromInt(1)
+	}
+
+	return decimal.NewFromInt(int64(activeEnd.Sub(activeStart))).Div(decimal.NewFromInt(int64(total)))
+}
+
+// calculateAddonCycleCharges charges an addon line item with its own billing period for the cycles
+// due in [periodStart, periodEnd). Cycles billed in advance are due when they start and cycles
+// billed in arrear when they end, cycles cut short by the end date of the addon are prorated.
+func (s *billingService) calculateAddonCycleCharges(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	p *price.Price,
+	periodStart,
+	periodEnd time.Time,
+) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal, error) {
+	priceService := NewPriceService(s.ServiceParams)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+
+	cycles, err := lineItemAddonCycles(item, p, periodStart, periodEnd)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(cycles))
+	total := decimal.Zero
+	for _, cycle := range cycles {
+		dueAt := cycle.start
+		if item.InvoiceCadence == types.InvoiceCadenceArrear {
+			dueAt = lo.Ternary(!item.EndDate.IsZero() && item.EndDate.Before(cycle.end), item.EndDate, cycle.end)
+			if !dueAt.After(periodStart) || dueAt.After(periodEnd) {
+				continue
+			}
+		} else if dueAt.Before(periodStart) || !dueAt.Before(periodEnd) {
+			continue
+		}
+
+		quantity := item.QuantityAt(cycle.start)
+		amount := priceService.CalculateCost(ctx, p, quantity).Mul(addonActiveFraction(item, cycle.start, cycle.end))
+		roundedAmount := rounding.RoundLineItemAmount(amount, sub.Currency)
+
+		var priceUnitAmount decimal.Decimal
+		if item.PriceUnit != nil {
+			priceUnit, err := s.PriceUnitRepo.GetByCode(ctx, lo.FromPtr(item.PriceUnit))
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+			priceUnitAmount, err = priceunit.ConvertToPriceUnitAmount(ctx, amount, priceUnit.ConversionRate, priceUnit.BaseCurrency)
+			if err != nil {
+				return nil, decimal.Zero, err
+			}
+		}
+
+		lineItems = append(lineItems, dto.CreateInvoiceLineItemRequest{
+			EntityID:        lo.ToPtr(item.EntityID),
+			EntityType:      lo.ToPtr(string(item.EntityType)),
+			PlanDisplayName: lo.ToPtr(item.PlanDisplayName),
+			PriceID:         lo.ToPtr(item.PriceID),
+			PriceType:       lo.ToPtr(string(item.PriceType)),
+			PriceUnit:       item.PriceUnit,
+			PriceUnitAmount: lo.ToPtr(priceUnitAmount),
+			DisplayName:     lo.ToPtr(item.DisplayName),
+			Amount:          roundedAmount,
+			Quantity:        quantity,
+			PeriodStart:     lo.ToPtr(cycle.start),
+			PeriodEnd:       lo.ToPtr(cycle.end),
+			Metadata: types.Metadata{
+				"description":          fmt.Sprintf("%s (Fixed Charge)", item.DisplayName),
+				"billing_period":       string(p.BillingPeriod),
+				"billing_period_count": fmt.Sprintf("%d", p.BillingPeriodCount),
+			},
+		})
+		total = total.Add(roundedAmount)
+	}
+
+	return lineItems, total, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddonCycles(t *testing.T) {
+	anchor := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	// An annual addon on a monthly subscription is due once in the month it starts
+	cycles, err := addonCycles(anchor, time.Time{}, types.BILLING_PERIOD_ANNUAL, 1, anchor, anchor.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, cycles, 1)
+	assert.True(t, cycles[0].start.Equal(anchor))
+	assert.True(t, cycles[0].end.Equal(anchor.AddDate(1, 0, 0)))
+
+	// The following year the second cycle overlaps the period
+	periodStart := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	cycles, err = addonCycles(anchor, time.Time{}, types.BILLING_PERIOD_ANNUAL, 1, periodStart, periodStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, cycles, 1)
+	assert.True(t, cycles[0].start.Equal(anchor.AddDate(1, 0, 0)))
+
+	// Cycles starting after the end date of the addon are not returned
+	cycles, err = addonCycles(anchor, anchor.AddDate(0, 6, 0), types.BILLING_PERIOD_ANNUAL, 1, periodStart, periodStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	assert.Empty(t, cycles)
+}
+
+func TestAddonActiveFraction(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 30)
+
+	tests := []struct {
+		name     string
+		item     *subscription.SubscriptionLineItem
+		expected decimal.Decimal
+	}{
+		{
+			name:     "active_for_the_whole_period",
+			item:     &subscription.SubscriptionLineItem{StartDate: start.AddDate(0, -1, 0)},
+			expected: decimal.NewFromInt(1),
+		},
+		{
+			name:     "added_mid_period",
+			item:     &subscription.SubscriptionLineItem{StartDate: start.AddDate(0, 0, 10)},
+			expected: decimal.NewFromInt(20).Div(decimal.NewFromInt(30)),
+		},
+		{
+			name:     "removed_mid_period",
+			item:     &subscription.SubscriptionLineItem{StartDate: start, EndDate: start.AddDate(0, 0, 15)},
+			expected: decimal.NewFromFloat(0.5),
+		},
+		{
+			name:     "trial_ends_after_the_period",
+			item:     &subscription.SubscriptionLineItem{StartDate: end.AddDate(0, 0, 1)},
+			expected: decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addonActiveFraction(tt.item, start, end)
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// applyIncludedQuantityToCharge deducts the usage included for free in the period by a line item
+// from the billable quantity before the billing model is applied and re-rates the charge. The
+// allowance not yet used by the other charges of the line item is tracked in remaining, so usage
+// split into a normal and an overage charge consumes it once. Overage and bucketed charges are not
+// rated on the line item usage, so their amount is reduced in proportion to the quantity. It
+// returns the billable quantity and the invoice line metadata showing the included and billable
+// quantity. Time-of-use charges get the allowance with the entitlement of their line item and are
+// returned unchanged.
+func (s *billingService) applyIncludedQuantityToCharge(
+	ctx context.Context,
+	priceService PriceService,
+	item *subscription.SubscriptionLineItem,
+	charge *dto.SubscriptionUsageByMetersResponse,
+	m *meter.Meter,
+	remaining *decimal.Decimal,
+	quantity decimal.Decimal,
+) (decimal.Decimal, types.Metadata) {
+	if !item.IncludedQuantity.IsPositive() || charge.Price == nil || len(charge.Price.TimeWindows) > 0 {
+		return quantity, nil
+	}
+
+	included := decimal.Min(*remaining, decimal.Max(decimal.Zero, quantity))
+	*remaining = remaining.Sub(included)
+	billable := quantity.Sub(included)
+	metadata := includedQuantityMetadata(included, billable)
+	if !included.IsPositive() {
+		return quantity, metadata
+	}
+
+	var amount decimal.Decimal
+	if charge.IsOverage || (m != nil && (m.IsBucketedMaxMeter() || m.IsBucketedSumMeter())) {
+		amount = decimal.NewFromFloat(charge.Amount).Mul(billable).Div(quantity)
+	} else {
+		amount = priceService.CalculateCost(ctx, charge.Price, billable)
+	}
+	charge.Amount = price.FormatAmountToFloat64WithPrecision(amount, charge.Price.Currency)
+	return billable, metadata
+}
+
+// includedQuantityMetadata returns the invoice line metadata showing the usage included for free
+// and the billable usage of a charge
+func includedQuantityMetadata(included, billable decimal.Decimal) types.Metadata {
+	return types.Metadata{
+		"included_quantity": included.String(),
+		"billable_quantity": billable.String(),
+	}
+}
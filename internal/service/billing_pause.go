@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+)
+
+// usageFetcher fetches the usage of a subscription for a period
+type usageFetcher func(ctx context.Context, req *dto.GetUsageBySubscriptionRequest) (*dto.GetUsageBySubscriptionResponse, error)
+
+// billableUsageWindows returns the parts of the period during which the subscription was not paused.
+// Usage recorded while a subscription is paused is never billed, whatever the billing mode of the pause.
+func billableUsageWindows(periodStart, periodEnd time.Time, pauses []*subscription.SubscriptionPause) []dto.Period {
+	windows := []dto.Period{{Start: periodStart, End: periodEnd}}
+	for _, pause := range pauses {
+		pauseEnd := pause.EffectiveEnd()
+
+		// Pauses resumed before they started never took effect
+		if pauseEnd != nil && !pauseEnd.After(pause.PauseStart) {
+			continue
+		}
+
+		next := make([]dto.Period, 0, len(windows)+1)
+		for _, window := range windows {
+			if !pause.PauseStart.Before(window.End) || (pauseEnd != nil && !pauseEnd.After(window.Start)) {
+				next = append(next, window)
+				continue
+			}
+			if pause.PauseStart.After(window.Start) {
+				next = append(next, dto.Period{Start: window.Start, End: pause.PauseStart})
+			}
+			if pauseEnd != nil && pauseEnd.Before(window.End) {
+				next = append(next, dto.Period{Start: *pauseEnd, End: window.End})
+			}
+		}
+		windows = next
+	}
+	return windows
+}
+
+// getBillableUsage returns the usage of the subscription for the period without the usage recorded
+// while the subscription was paused. The usage of each unpaused part of the period is fetched
+// separately and the quantities are added up before the charges are rated once, so the prices and
+// the commitment of the subscription are applied to the usage of the whole period. Bucketed usage
+// is rated per bucket and window usage at the unit amount of its window, so their amounts are the
+// sum of the amounts of the parts.
+func (s *billingService) getBillableUsage(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+	fetch usageFetcher,
+) (*dto.GetUsageBySubscriptionResponse, error) {
+	pauses, err := s.SubRepo.ListPauses(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := billableUsageWindows(periodStart, periodEnd, pauses)
+	if len(windows) == 1 && windows[0].Start.Equal(periodStart) && windows[0].End.Equal(periodEnd) {
+		return fetch(ctx, &dto.GetUsageBySubscriptionRequest{
+			SubscriptionID: sub.ID,
+			StartTime:      periodStart,
+			EndTime:        periodEnd,
+		})
+	}
+
+	s.Logger.Debugw("excluding paused time from billable usage",
+		"subscription_id", sub.ID,
+		"period_start", periodStart,
+		"period_end", periodEnd,
+		"billable_windows", len(windows))
+
+	charges := make([]*dto.SubscriptionUsageByMetersResponse, 0)
+	chargesByKey := make(map[string]*dto.SubscriptionUsageByMetersResponse)
+	for _, window := range windows {
+		usage, err := fetch(ctx, &dto.GetUsageBySubscriptionRequest{
+			SubscriptionID: sub.ID,
+			StartTime:      window.Start,
+			EndTime:        window.End,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if usage == nil {
+			continue
+		}
+
+		for _, charge := range usage.Charges {
+			if charge == nil || charge.Price == nil {
+				continue
+			}
+
+			// Overage is split off again once the commitment is applied to the whole period
+			amount := charge.Amount
+			if charge.IsOverage && charge.OverageFactor > 0 {
+				amount = amount / charge.OverageFactor
+			}
+
+			key := fmt.Sprintf("%s:%s", charge.Price.ID, charge.TimeWindow)
+			if existing, ok := chargesByKey[key]; ok {
+				existing.Quantity += charge.Quantity
+				existing.Amount += amount
+				continue
+			}
+			merged := *charge
+			merged.Amount = amount
+			merged.IsOverage = false
+			merged.OverageFactor = 0
+			chargesByKey[key] = &merged
+			charges = append(charges, &merged)
+		}
+	}
+
+	priceService := NewPriceService(s.ServiceParams)
+	bucketedMeters := make(map[string]bool)
+	totalCost := decimal.Zero
+	for _, charge := range charges {
+		bucketed, ok := bucketedMeters[charge.MeterID]
+		if !ok && charge.MeterID != "" {
+			m, err := s.MeterRepo.GetMeter(ctx, charge.MeterID)
+			if err != nil {
+				return nil, err
+			}
+			bucketed = m.IsBucketedMaxMeter() || m.IsBucketedSumMeter()
+			bucketedMeters[charge.MeterID] = bucketed
+		}
+
+		amount := decimal.NewFromFloat(charge.Amount)
+		if charge.TimeWindow == "" && !bucketed {
+			amount = priceService.CalculateCost(ctx, charge.Price, decimal.NewFromFloat(charge.Quantity))
+		}
+		charge.Amount = price.FormatAmountToFloat64WithPrecision(amount, charge.Price.Currency)
+		charge.DisplayAmount = price.GetDisplayAmountWithPrecision(amount, charge.Price.Currency)
+		totalCost = totalCost.Add(amount)
+	}
+
+	result := &dto.GetUsageBySubscriptionResponse{
+		StartTime: periodStart,
+		EndTime:   periodEnd,
+		Currency:  sub.Currency,
+	}
+	totalCost = applySubscriptionCommitment(sub, charges, totalCost, result)
+	result.Amount = price.FormatAmountToFloat64WithPrecision(totalCost, sub.Currency)
+	result.DisplayAmount = price.GetDisplayAmountWithPrecision(totalCost, sub.Currency)
+
+	return result, nil
+}
+
+// advanceChargesPaused returns true if the subscription is paused with all billing stopped at the
+// start of the period, in which case the advance charges of the period are not billed
+func (s *billingService) advanceChargesPaused(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart time.Time,
+) (bool, error) {
+	if sub.PauseStatus == types.PauseStatusNone || sub.ActivePauseID == nil {
+		return false, nil
+	}
+
+	pause, err := s.SubRepo.GetPause(ctx, *sub.ActivePauseID)
+	if err != nil {
+		return false, err
+	}
+
+	return !pause.BillsFixedFees() && pause.Covers(periodStart), nil
+}
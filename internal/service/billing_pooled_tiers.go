@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/group"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// pooledTierCalculator re-rates invoice line items whose prices belong to a pooled group.
+// Quantities (or amounts) of all member line items are accumulated into the group's tier
+// ladder and the resulting charge is allocated back to the line items.
+type pooledTierCalculator struct {
+	logger       *logger.Logger
+	priceService PriceService
+	rounding     types.RoundingConfig
+}
+
+// newPooledTierCalculator creates a new pooled tier calculator
+func newPooledTierCalculator(logger *logger.Logger, priceService PriceService, rounding types.RoundingConfig) *pooledTierCalculator {
+	return &pooledTierCalculator{
+		logger:       logger,
+		priceService: priceService,
+		rounding:     rounding,
+	}
+}
+
+// applyPooledTiers re-rates the charges of the calculation result that belong to pooled groups
+// and updates the result total accordingly. Charges outside of pooled groups are left untouched.
+func (s *billingService) applyPooledTiers(ctx context.Context, result *BillingCalculationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	priceIDs := make([]string, 0, len(result.FixedCharges)+len(result.UsageCharges))
+	for _, charge := range result.FixedCharges {
+		if charge.PriceID != nil {
+			priceIDs = append(priceIDs, *charge.PriceID)
+		}
+	}
+	for _, charge := range result.UsageCharges {
+		if charge.PriceID != nil {
+			priceIDs = append(priceIDs, *charge.PriceID)
+		}
+	}
+	priceIDs = lo.Uniq(priceIDs)
+	if len(priceIDs) == 0 {
+		return nil
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().WithPriceIDs(priceIDs)
+	prices, err := s.PriceRepo.List(ctx, priceFilter)
+	if err != nil {
+		return err
+	}
+
+	groupIDByPriceID := make(map[string]string)
+	for _, p := range prices {
+		if p.GroupID != "" {
+			groupIDByPriceID[p.ID] = p.GroupID
+		}
+	}
+	if len(groupIDByPriceID) == 0 {
+		return nil
+	}
+
+	groups, err := s.GroupRepo.List(ctx, &types.GroupFilter{
+		QueryFilter: types.NewNoLimitQueryFilter(),
+		GroupIDs:    lo.Uniq(lo.Values(groupIDByPriceID)),
+		EntityType:  string(types.GroupEntityTypePrice),
+	})
+	if err != nil {
+		return err
+	}
+
+	pooledGroups := make(map[string]*group.Group)
+	for _, g := range groups {
+		if g.IsPooled() {
+			pooledGroups[g.ID] = g
+		}
+	}
+	if len(pooledGroups) == 0 {
+		return nil
+	}
+
+	// Collect member charges per pooled group. Commitment true-ups and charges with line item
+	// commitments are excluded since their amounts are not a function of the tier ladder.
+	membersByGroupID := make(map[string][]*dto.CreateInvoiceLineItemRequest)
+	collect := func(charges []dto.CreateInvoiceLineItemRequest) {
+		for i := range charges {
+			charge := &charges[i]
+			if charge.PriceID == nil || charge.CommitmentInfo != nil {
+				continue
+			}
+			groupID, ok := groupIDByPriceID[*charge.PriceID]
+			if !ok {
+				continue
+			}
+			if _, ok := pooledGroups[groupID]; !ok {
+				continue
+			}
+			membersByGroupID[groupID] = append(membersByGroupID[groupID], charge)
+		}
+	}
+	collect(result.FixedCharges)
+	collect(result.UsageCharges)
+
+	calculator := newPooledTierCalculator(s.Logger, NewPriceService(s.ServiceParams), GetRoundingConfig(s.ServiceParams, ctx))
+	for groupID, members := range membersByGroupID {
+		calculator.apply(ctx, pooledGroups[groupID], members, result.Currency)
+	}
+
+	total := decimal.Zero
+	for _, charge := range result.FixedCharges {
+		total = total.Add(charge.Amount)
+	}
+	for _, charge := range result.UsageCharges {
+		total = total.Add(charge.Amount)
+	}
+	result.TotalAmount = total
+
+	return nil
+}
+
+// apply rates the accumulated basis of the members through the group's tier ladder
+// and allocates the pooled charge back to the members proportionally to their contribution
+func (c *pooledTierCalculator) apply(
+	ctx context.Context,
+	grp *group.Group,
+	members []*dto.CreateInvoiceLineItemRequest,
+	currency string,
+) {
+	if len(members) == 0 {
+		return
+	}
+
+	pooling := grp.Pooling
+
+	weights := make([]decimal.Decimal, len(members))
+	totalBasis := decimal.Zero
+	for i, member := range members {
+		switch pooling.Basis {
+		case types.GroupPoolBasisAmount:
+			weights[i] = member.Amount
+		default:
+			weights[i] = member.Quantity
+		}
+		totalBasis = totalBasis.Add(weights[i])
+	}
+
+	// Rate the pooled basis through the group's ladder using a synthetic tiered price
+	pooledPrice := &price.Price{
+		ID:           grp.ID,
+		Currency:     currency,
+		BillingModel: types.BILLING_MODEL_TIERED,
+		TierMode:     pooling.TierMode,
+		Tiers:        toPriceTiers(pooling.Tiers),
+	}
+	pooledAmount := c.rounding.RoundLineItemAmount(
+		c.priceService.CalculateCost(ctx, pooledPrice, totalBasis), currency)
+
+	allocations := allocatePooledAmount(pooledAmount, weights, currency, c.rounding)
+
+	for i, member := range members {
+		if member.Metadata == nil {
+			member.Metadata = types.Metadata{}
+		}
+		member.Metadata["pooled_group_id"] = grp.ID
+		member.Metadata["pooled_basis"] = pooling.Basis.String()
+		member.Metadata["pooled_total"] = totalBasis.String()
+		member.Metadata["pooled_amount"] = pooledAmount.String()
+		member.Metadata["unpooled_amount"] = member.Amount.String()
+
+		member.Amount = allocations[i]
+		// price unit amounts are derived from the unpooled amount and no longer apply
+		member.PriceUnitAmount = nil
+	}
+
+	c.logger.Debugw("applied pooled tiers",
+		"group_id", grp.ID,
+		"basis", pooling.Basis,
+		"total_basis", totalBasis,
+		"pooled_amount", pooledAmount,
+		"members", len(members))
+}
+
+// allocatePooledAmount splits the amount proportionally to the weights, rounded with the line
+// item rounding of the policy. Any rounding remainder is assigned to the member with the largest
+// weight so that the allocations always add up to the pooled amount.
+func allocatePooledAmount(amount decimal.Decimal, weights []decimal.Decimal, currency string, rounding types.RoundingConfig) []decimal.Decimal {
+	allocations := make([]decimal.Decimal, len(weights))
+	for i := range allocations {
+		allocations[i] = decimal.Zero
+	}
+	if len(weights) == 0 {
+		return allocations
+	}
+
+	totalWeight := decimal.Zero
+	largest := 0
+	for i, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+		if weight.GreaterThan(weights[largest]) {
+			largest = i
+		}
+	}
+
+	// Without any weight (e.g. all members at zero usage) the flat part of the ladder,
+	// if any, is split evenly
+	if !totalWeight.IsPositive() {
+		weights = lo.Map(weights, func(_ decimal.Decimal, _ int) decimal.Decimal { return decimal.NewFromInt(1) })
+		totalWeight = decimal.NewFromInt(int64(len(weights)))
+	}
+
+	allocated := decimal.Zero
+	for i, weight := range weights {
+		allocations[i] = rounding.RoundLineItemAmount(amount.Mul(weight).Div(totalWeight), currency)
+		allocated = allocated.Add(allocations[i])
+	}
+	allocations[largest] = allocations[largest].Add(amount.Sub(allocated))
+
+	return allocations
+}
+
+// toPriceTiers converts pooled group tiers to price tiers
+func toPriceTiers(tiers []types.PriceTier) price.JSONBTiers {
+	result := make(price.JSONBTiers, len(tiers))
+	for i, tier := range tiers {
+		result[i] = price.PriceTier{
+			UpTo:       tier.UpTo,
+			UnitAmount: tier.UnitAmount,
+			FlatAmount: tier.FlatAmount,
+		}
+	}
+	return result
+}
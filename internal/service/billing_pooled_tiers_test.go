@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/group"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocatePooledAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		weights  []string
+		currency string
+		expected []string
+	}{
+		{
+			name:     "proportional_split",
+			amount:   "100.00",
+			weights:  []string{"1", "3"},
+			currency: "usd",
+			expected: []string{"25", "75"},
+		},
+		{
+			name:     "rounding_remainder_goes_to_largest_weight",
+			amount:   "10.00",
+			weights:  []string{"1", "1", "1"},
+			currency: "usd",
+			expected: []string{"3.34", "3.33", "3.33"},
+		},
+		{
+			name:     "zero_weights_split_evenly",
+			amount:   "9.00",
+			weights:  []string{"0", "0", "0"},
+			currency: "usd",
+			expected: []string{"3", "3", "3"},
+		},
+		{
+			name:     "zero_decimal_currency",
+			amount:   "1000",
+			weights:  []string{"1", "2"},
+			currency: "jpy",
+			expected: []string{"333", "667"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weights := lo.Map(tt.weights, func(w string, _ int) decimal.Decimal {
+				return decimal.RequireFromString(w)
+			})
+
+			allocations := allocatePooledAmount(decimal.RequireFromString(tt.amount), weights, tt.currency, types.DefaultRoundingConfig())
+
+			total := decimal.Zero
+			for i, allocation := range allocations {
+				assert.True(t, decimal.RequireFromString(tt.expected[i]).Equal(allocation),
+					"allocation %d: expected %s, got %s", i, tt.expected[i], allocation)
+				total = total.Add(allocation)
+			}
+			assert.True(t, decimal.RequireFromString(tt.amount).Equal(total), "allocations must add up to the pooled amount")
+		})
+	}
+}
+
+func TestPooledTierCalculator_Apply(t *testing.T) {
+	ctx := context.Background()
+	log := logger.GetLogger()
+	calculator := newPooledTierCalculator(log, NewPriceService(ServiceParams{Logger: log}), types.DefaultRoundingConfig())
+
+	tiers := []types.PriceTier{
+		{UpTo: lo.ToPtr(uint64(1000)), UnitAmount: decimal.NewFromInt(1)},
+		{UpTo: nil, UnitAmount: decimal.NewFromFloat(0.5)},
+	}
+
+	t.Run("quantity_basis_slab", func(t *testing.T) {
+		grp := &group.Group{
+			ID: "grp_quantity",
+			Pooling: &types.GroupPoolingConfig{
+				Basis:    types.GroupPoolBasisQuantity,
+				TierMode: types.BILLING_TIER_SLAB,
+				Tiers:    tiers,
+			},
+		}
+		members := []*dto.CreateInvoiceLineItemRequest{
+			{PriceID: lo.ToPtr("price_api"), Quantity: decimal.NewFromInt(1200), Amount: decimal.NewFromInt(1200)},
+			{PriceID: lo.ToPtr("price_storage"), Quantity: decimal.NewFromInt(800), Amount: decimal.NewFromInt(800)},
+		}
+
+		calculator.apply(ctx, grp, members, "usd")
+
+		// 2000 pooled units: 1000 * 1 + 1000 * 0.5 = 1500, split 60/40
+		assert.True(t, decimal.NewFromInt(900).Equal(members[0].Amount), "got %s", members[0].Amount)
+		assert.True(t, decimal.NewFromInt(600).Equal(members[1].Amount), "got %s", members[1].Amount)
+		assert.Equal(t, "grp_quantity", members[0].Metadata["pooled_group_id"])
+		assert.Equal(t, "1200", members[0].Metadata["unpooled_amount"])
+	})
+
+	t.Run("amount_basis_volume", func(t *testing.T) {
+		grp := &group.Group{
+			ID: "grp_amount",
+			Pooling: &types.GroupPoolingConfig{
+				Basis:    types.GroupPoolBasisAmount,
+				TierMode: types.BILLING_TIER_VOLUME,
+				Tiers:    tiers,
+			},
+		}
+		members := []*dto.CreateInvoiceLineItemRequest{
+			{PriceID: lo.ToPtr("price_api"), Quantity: decimal.NewFromInt(10), Amount: decimal.NewFromInt(1500)},
+			{PriceID: lo.ToPtr("price_compute"), Quantity: decimal.NewFromInt(1), Amount: decimal.NewFromInt(500)},
+		}
+
+		calculator.apply(ctx, grp, members, "usd")
+
+		// combined spend of 2000 falls in the second tier, so the whole spend is charged at 0.5
+		assert.True(t, decimal.NewFromInt(750).Equal(members[0].Amount), "got %s", members[0].Amount)
+		assert.True(t, decimal.NewFromInt(250).Equal(members[1].Amount), "got %s", members[1].Amount)
+		assert.Equal(t, string(types.GroupPoolBasisAmount), members[1].Metadata["pooled_basis"])
+	})
+}
+
+func TestGroupPoolingConfig_Validate(t *testing.T) {
+	valid := &types.GroupPoolingConfig{
+		Basis:    types.GroupPoolBasisQuantity,
+		TierMode: types.BILLING_TIER_SLAB,
+		Tiers: []types.PriceTier{
+			{UpTo: lo.ToPtr(uint64(10)), UnitAmount: decimal.NewFromInt(2)},
+			{UnitAmount: decimal.NewFromInt(1)},
+		},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalidBasis := *valid
+	invalidBasis.Basis = "seats"
+	assert.Error(t, invalidBasis.Validate())
+
+	openEndedMiddleTier := *valid
+	openEndedMiddleTier.Tiers = []types.PriceTier{
+		{UnitAmount: decimal.NewFromInt(2)},
+		{UpTo: lo.ToPtr(uint64(10)), UnitAmount: decimal.NewFromInt(1)},
+	}
+	assert.Error(t, openEndedMiddleTier.Validate())
+
+	descendingTiers := *valid
+	descendingTiers.Tiers = []types.PriceTier{
+		{UpTo: lo.ToPtr(uint64(10)), UnitAmount: decimal.NewFromInt(2)},
+		{UpTo: lo.ToPtr(uint64(5)), UnitAmount: decimal.NewFromInt(1)},
+	}
+	assert.Error(t, descendingTiers.Validate())
+}
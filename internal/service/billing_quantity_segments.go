@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// calculateQuantitySegmentCharges splits the fixed charge of a line item billed in arrear into one
+// invoice line item per quantity segment of the period, each prorated by its share of the period.
+// No line items are returned when the quantity did not change within the period.
+func (s *billingService) calculateQuantitySegmentCharges(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	price *price.Price,
+	periodStart,
+	periodEnd time.Time,
+) ([]dto.CreateInvoiceLineItemRequest, decimal.Decimal) {
+	if item.InvoiceCadence != types.InvoiceCadenceArrear || !item.HasQuantityChanges() {
+		return nil, decimal.Zero
+	}
+
+	segments := item.QuantitySegments(item.GetPeriod(periodStart, periodEnd))
+	if len(segments) < 2 {
+		return nil, decimal.Zero
+	}
+
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	ctx = types.WithRoundingConfig(ctx, rounding)
+	priceService := NewPriceService(s.ServiceParams)
+	amounts := prorateQuantitySegments(segments, periodStart, periodEnd, func(quantity decimal.Decimal) decimal.Decimal {
+		return priceService.CalculateCost(ctx, price, quantity)
+	})
+
+	var priceUnit *priceunit.PriceUnit
+	if item.PriceUnit != nil {
+		unit, err := s.PriceUnitRepo.GetByCode(ctx, lo.FromPtr(item.PriceUnit))
+		if err != nil {
+			s.Logger.Warnw("failed to get price unit",
+				"error", err,
+				"price_unit", lo.FromPtr(item.PriceUnit),
+				"subscription_id", sub.ID,
+				"line_item_id", item.ID)
+		} else {
+			priceUnit = unit
+		}
+	}
+
+	total := decimal.Zero
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(segments))
+	for i, segment := range segments {
+		amount := rounding.RoundLineItemAmount(amounts[i], sub.Currency)
+
+		var priceUnitAmount decimal.Decimal
+		if priceUnit != nil {
+			converted, err := priceunit.ConvertToPriceUnitAmount(ctx, amounts[i], priceUnit.ConversionRate, priceUnit.BaseCurrency)
+			if err != nil {
+				s.Logger.Warnw("failed to convert amount to price unit",
+					"error", err,
+					"price_unit", lo.FromPtr(item.PriceUnit),
+					"subscription_id", sub.ID,
+					"line_item_id", item.ID)
+			} else {
+				priceUnitAmount = converted
+			}
+		}
+
+		lineItems = append(lineItems, dto.CreateInvoiceLineItemRequest{
+			EntityID:        lo.ToPtr(item.EntityID),
+			EntityType:      lo.ToPtr(string(item.EntityType)),
+			PlanDisplayName: lo.ToPtr(item.PlanDisplayName),
+			PriceID:         lo.ToPtr(item.PriceID),
+			PriceType:       lo.ToPtr(string(item.PriceType)),
+			PriceUnit:       item.PriceUnit,
+			PriceUnitAmount: lo.ToPtr(priceUnitAmount),
+			DisplayName:     lo.ToPtr(item.DisplayName),
+			Amount:          amount,
+			Quantity:        segment.Quantity,
+			PeriodStart:     lo.ToPtr(segment.Start),
+			PeriodEnd:       lo.ToPtr(segment.End),
+			Metadata: types.Metadata{
+				"description": fmt.Sprintf("%s (Fixed Charge, quantity %s)", item.DisplayName, segment.Quantity),
+			},
+		})
+		total = total.Add(amount)
+	}
+
+	return lineItems, total
+}
+
+// prorateQuantitySegments returns the cost of the quantity of each segment prorated by the share
+// of the billing period the segment covers
+func prorateQuantitySegments(
+	segments []types.QuantitySegment,
+	periodStart,
+	periodEnd time.Time,
+	cost func(quantity decimal.Decimal) decimal.Decimal,
+) []decimal.Decimal {
+	period := decimal.NewFromFloat(periodEnd.Sub(periodStart).Seconds())
+
+	amounts := make([]decimal.Decimal, len(segments))
+	for i, segment := range segments {
+		amounts[i] = cost(segment.Quantity)
+		if period.IsPositive() {
+			share := decimal.NewFromFloat(segment.End.Sub(segment.Start).Seconds()).Div(period)
+			amounts[i] = amounts[i].Mul(share)
+		}
+	}
+	return amounts
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// rolloverForLineItem returns the rollover config of a usage line item and the usage included in
+// each billing period that can roll over. Included usage comes from an entitlement with rollover
+// that resets every billing period and from the included quantity of the line item and the free
+// leading tiers of a price with rollover. The price config takes precedence over the entitlement
+// config.
+func rolloverForLineItem(
+	sub *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	p *price.Price,
+	ent *dto.AggregatedEntitlement,
+) (*types.RolloverConfig, decimal.Decimal) {
+	var config *types.RolloverConfig
+	included := decimal.Zero
+
+	if ent != nil && ent.IsEnabled && ent.RolloverConfig != nil && ent.UsageLimit != nil &&
+		ent.UsageResetPeriod == types.EntitlementUsageResetPeriod(sub.BillingPeriod) {
+		config = ent.RolloverConfig
+		included = included.Add(decimal.NewFromInt(*ent.UsageLimit))
+	}
+
+	if p != nil && p.RolloverConfig != nil {
+		config = p.RolloverConfig
+		included = included.Add(item.IncludedQuantity).Add(p.FreeTierQuantity())
+	}
+
+	return config, included
+}
+
+// applyRolloverToCharge consumes the rolled over units of a line item before the included usage
+// of the period and re-rates the charge. It returns the billable quantity and the invoice line
+// metadata showing the rolled over units. Overage, time-of-use and bucketed charges are not
+// rated on the line item usage and are returned unchanged.
+func (s *billingService) applyRolloverToCharge(
+	ctx context.Context,
+	priceService PriceService,
+	item *subscription.SubscriptionLineItem,
+	charge *dto.SubscriptionUsageByMetersResponse,
+	m *meter.Meter,
+	periodStart time.Time,
+	quantity decimal.Decimal,
+) (decimal.Decimal, types.Metadata) {
+	if item.Rollover == nil || charge.IsOverage || charge.Price == nil || len(charge.Price.TimeWindows) > 0 ||
+		(m != nil && (m.IsBucketedMaxMeter() || m.IsBucketedSumMeter())) {
+		return quantity, nil
+	}
+
+	available := item.Rollover.Available(periodStart)
+	consumed := item.Rollover.ConsumedIn(periodStart, decimal.NewFromFloat(charge.Quantity))
+	if _, settled := item.Rollover.SettlementFor(periodStart); !settled && !available.IsPositive() {
+		return quantity, nil
+	}
+
+	metadata := types.Metadata{
+		"rollover_available": available.String(),
+		"rollover_quantity":  consumed.String(),
+	}
+	if !consumed.IsPositive() || !quantity.IsPositive() {
+		return quantity, metadata
+	}
+
+	billable := decimal.Max(decimal.Zero, quantity.Sub(consumed))
+	charge.Amount = price.FormatAmountToFloat64WithPrecision(priceService.CalculateCost(ctx, charge.Price, billable), charge.Price.Currency)
+	return billable, metadata
+}
+
+// SettleRollover records the rolled over units used in a billing period of a subscription and
+// carries the unused included usage over to the following periods. Settled periods are skipped,
+// so it is safe to call again for the same period.
+func (s *billingService) SettleRollover(ctx context.Context, sub *subscription.Subscription, periodStart, periodEnd time.Time) error {
+	priceIDs := make([]string, 0)
+	for _, item := range sub.LineItems {
+		if item.PriceType == types.PRICE_TYPE_USAGE {
+			priceIDs = append(priceIDs, item.PriceID)
+		}
+	}
+	if len(priceIDs) == 0 {
+		return nil
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithPriceIDs(lo.Uniq(priceIDs)).
+		WithAllowExpiredPrices(true)
+	prices, err := s.PriceRepo.List(ctx, priceFilter)
+	if err != nil {
+		return err
+	}
+	priceMap := lo.KeyBy(prices, func(p *price.Price) string { return p.ID })
+
+	subscriptionService := NewSubscriptionService(s.ServiceParams)
+	aggregatedEntitlements, err := subscriptionService.GetAggregatedSubscriptionEntitlements(ctx, sub.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	entitlementsByMeterID := make(map[string]*dto.AggregatedEntitlement)
+	for _, feature := range aggregatedEntitlements.Features {
+		if feature.Feature != nil && types.FeatureType(feature.Feature.Type) == types.FeatureTypeMetered &&
+			feature.Feature.MeterID != "" && feature.Entitlement != nil {
+			entitlementsByMeterID[feature.Feature.MeterID] = feature.Entitlement
+		}
+	}
+
+	var usage *dto.GetUsageBySubscriptionResponse
+	for _, item := range sub.LineItems {
+		if item.PriceType != types.PRICE_TYPE_USAGE {
+			continue
+		}
+
+		config, included := rolloverForLineItem(sub, item, priceMap[item.PriceID], entitlementsByMeterID[item.MeterID])
+		if config == nil && item.Rollover == nil {
+			continue
+		}
+		if _, settled := item.Rollover.SettlementFor(periodStart); settled {
+			continue
+		}
+
+		if usage == nil {
+			usage, err = s.getBillableUsage(ctx, sub, periodStart, periodEnd, subscriptionService.GetUsageBySubscription)
+			if err != nil {
+				return err
+			}
+		}
+
+		quantity := decimal.Zero
+		for _, charge := range usage.Charges {
+			if charge.Price != nil && charge.Price.ID == item.PriceID && !charge.IsOverage {
+				quantity = quantity.Add(decimal.NewFromFloat(charge.Quantity))
+			}
+		}
+
+		// Carried over units expire after the configured number of following periods
+		expiresAt := periodEnd
+		if config != nil {
+			for i := 0; i < config.MaxPeriods; i++ {
+				expiresAt, err = types.NextBillingDate(expiresAt, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod, nil)
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			// Rollover was removed, no new units are carried over
+			config = &types.RolloverConfig{MaxCarryover: lo.ToPtr(decimal.Zero)}
+		}
+
+		if item.Rollover == nil {
+			item.Rollover = &types.LineItemRollover{}
+		}
+		settlement := item.Rollover.Settle(config, periodStart, periodEnd, quantity, included, expiresAt)
+
+		if err := s.SubscriptionLineItemRepo.Update(ctx, item); err != nil {
+			return err
+		}
+
+		s.Logger.Debugw("settled rollover for line item",
+			"subscription_id", sub.ID,
+			"line_item_id", item.ID,
+			"period_start", periodStart,
+			"usage", settlement.Usage,
+			"included", settlement.Included,
+			"consumed", settlement.Consumed,
+			"granted", settlement.Granted)
+	}
+
+	return nil
+}
@@ -95,6 +95,7 @@ func (s *BillingServiceSuite) setupService() {
 		TaxAssociationRepo:    s.GetStores().TaxAssociationRepo,
 		TaxAppliedRepo:        s.GetStores().TaxAppliedRepo,
 		SettingsRepo:          s.GetStores().SettingsRepo,
+		SubscriptionPhaseRepo: s.GetStores().SubscriptionPhaseRepo,
 		EventPublisher:        s.GetPublisher(),
 		WebhookPublisher:      s.GetWebhookPublisher(),
 		ProrationCalculator:   s.GetCalculator(),
@@ -1360,6 +1361,230 @@ func (s *BillingServiceSuite) TestCalculateUsageChargesWithEntitlements() {
 	}
 }
 
+func (s *BillingServiceSuite) TestCalculateUsageChargesWithIncludedQuantity() {
+	var apiCallsLineItem *subscription.SubscriptionLineItem
+	for _, item := range s.testData.subscription.LineItems {
+		if item.PriceID == s.testData.prices.apiCalls.ID {
+			apiCallsLineItem = item
+		}
+	}
+	s.Require().NotNil(apiCallsLineItem, "Expected to find line item for API calls price")
+	apiCallsLineItem.IncludedQuantity = decimal.NewFromInt(200)
+
+	tests := []struct {
+		name             string
+		quantity         float64
+		expectedAmount   decimal.Decimal
+		expectedIncluded string
+		expectedBillable string
+	}{
+		{
+			name:             "usage_above_included_quantity",
+			quantity:         500,
+			expectedAmount:   decimal.NewFromInt(6), // (500 - 200) * 0.02
+			expectedIncluded: "200",
+			expectedBillable: "300",
+		},
+		{
+			name:             "usage_within_included_quantity",
+			quantity:         150,
+			expectedAmount:   decimal.Zero,
+			expectedIncluded: "150",
+			expectedBillable: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			usage := &dto.GetUsageBySubscriptionResponse{
+				StartTime: s.testData.subscription.CurrentPeriodStart,
+				EndTime:   s.testData.subscription.CurrentPeriodEnd,
+				Currency:  s.testData.subscription.Currency,
+				Charges: []*dto.SubscriptionUsageByMetersResponse{
+					{
+						Price:    s.testData.prices.apiCalls,
+						Quantity: tt.quantity,
+						Amount:   decimal.NewFromFloat(tt.quantity).Mul(s.testData.prices.apiCalls.Amount).InexactFloat64(),
+						MeterID:  s.testData.meters.apiCalls.ID,
+					},
+				},
+			}
+
+			lineItems, totalAmount, err := s.service.CalculateUsageCharges(
+				s.GetContext(),
+				s.testData.subscription,
+				usage,
+				s.testData.subscription.CurrentPeriodStart,
+				s.testData.subscription.CurrentPeriodEnd,
+			)
+			s.NoError(err)
+			s.Require().Len(lineItems, 1)
+			s.True(tt.expectedAmount.Equal(totalAmount), "expected %s, got %s", tt.expectedAmount, totalAmount)
+
+			// The invoice line is rated on the billable quantity and shows how much was included
+			s.Equal(tt.expectedBillable, lineItems[0].Quantity.String())
+			s.Equal(tt.expectedIncluded, lineItems[0].Metadata["included_quantity"])
+			s.Equal(tt.expectedBillable, lineItems[0].Metadata["billable_quantity"])
+		})
+	}
+
+	s.Run("included_quantity_is_shared_with_overage", func() {
+		usage := &dto.GetUsageBySubscriptionResponse{
+			StartTime: s.testData.subscription.CurrentPeriodStart,
+			EndTime:   s.testData.subscription.CurrentPeriodEnd,
+			Currency:  s.testData.subscription.Currency,
+			Charges: []*dto.SubscriptionUsageByMetersResponse{
+				{Price: s.testData.prices.apiCalls, Quantity: 100, Amount: 2, MeterID: s.testData.meters.apiCalls.ID},
+				{Price: s.testData.prices.apiCalls, Quantity: 400, Amount: 12, MeterID: s.testData.meters.apiCalls.ID, IsOverage: true, OverageFactor: 1.5},
+			},
+		}
+
+		lineItems, totalAmount, err := s.service.CalculateUsageCharges(
+			s.GetContext(),
+			s.testData.subscription,
+			usage,
+			s.testData.subscription.CurrentPeriodStart,
+			s.testData.subscription.CurrentPeriodEnd,
+		)
+		s.NoError(err)
+		s.Require().Len(lineItems, 2)
+
+		// The 200 included units cover the 100 normal units and 100 of the 400 overage units
+		s.True(decimal.NewFromInt(9).Equal(totalAmount), "expected 9, got %s", totalAmount)
+		s.Equal("100", lineItems[0].Metadata["included_quantity"])
+		s.Equal("100", lineItems[1].Metadata["included_quantity"])
+		s.Equal("300", lineItems[1].Metadata["billable_quantity"])
+	})
+}
+
+func (s *BillingServiceSuite) TestCalculateUsageChargesWithTimeWindows() {
+	ctx := s.GetContext()
+
+	var apiCallsLineItem *subscription.SubscriptionLineItem
+	for _, item := range s.testData.subscription.LineItems {
+		if item.PriceID == s.testData.prices.apiCalls.ID {
+			apiCallsLineItem = item
+		}
+	}
+	s.Require().NotNil(apiCallsLineItem, "Expected to find line item for API calls price")
+
+	timeOfUsePrice := *s.testData.prices.apiCalls
+	timeOfUsePrice.TimeWindows = []types.PriceTimeWindow{
+		{Name: "peak", StartTime: "09:00", EndTime: "17:00", UnitAmount: decimal.NewFromFloat(0.05)},
+	}
+
+	// 500 off-window units at 0.02 and 100 peak units at 0.05
+	usage := func() *dto.GetUsageBySubscriptionResponse {
+		return &dto.GetUsageBySubscriptionResponse{
+			StartTime: s.testData.subscription.CurrentPeriodStart,
+			EndTime:   s.testData.subscription.CurrentPeriodEnd,
+			Currency:  s.testData.subscription.Currency,
+			Charges: []*dto.SubscriptionUsageByMetersResponse{
+				{Price: &timeOfUsePrice, Quantity: 500, Amount: 10, MeterID: s.testData.meters.apiCalls.ID},
+				{Price: &timeOfUsePrice, Quantity: 100, Amount: 5, MeterID: s.testData.meters.apiCalls.ID, TimeWindow: "peak"},
+			},
+		}
+	}
+
+	s.Run("commitment_applies_to_total_of_windows", func() {
+		apiCallsLineItem.CommitmentType = types.COMMITMENT_TYPE_AMOUNT
+		apiCallsLineItem.CommitmentAmount = lo.ToPtr(decimal.NewFromInt(20))
+		apiCallsLineItem.CommitmentOverageFactor = lo.ToPtr(decimal.NewFromFloat(1.5))
+		apiCallsLineItem.CommitmentTrueUpEnabled = true
+		defer func() {
+			apiCallsLineItem.CommitmentType = ""
+			apiCallsLineItem.CommitmentAmount = nil
+			apiCallsLineItem.CommitmentOverageFactor = nil
+			apiCallsLineItem.CommitmentTrueUpEnabled = false
+		}()
+
+		lineItems, totalAmount, err := s.service.CalculateUsageCharges(ctx, s.testData.subscription, usage(),
+			s.testData.subscription.CurrentPeriodStart, s.testData.subscription.CurrentPeriodEnd)
+		s.NoError(err)
+		s.Require().Len(lineItems, 2)
+
+		// The usage of 15 is trued up to the commitment of 20 and spread over the windows
+		s.True(decimal.NewFromInt(20).Equal(totalAmount), "expected 20, got %s", totalAmount)
+		s.True(decimal.NewFromFloat(13.33).Equal(lineItems[0].Amount), "got %s", lineItems[0].Amount)
+		s.True(decimal.NewFromFloat(6.67).Equal(lineItems[1].Amount), "got %s", lineItems[1].Amount)
+		s.Require().NotNil(lineItems[0].CommitmentInfo)
+		s.True(decimal.NewFromInt(5).Equal(lineItems[0].CommitmentInfo.ComputedTrueUpAmount))
+		s.Nil(lineItems[1].CommitmentInfo)
+	})
+
+	s.Run("included_quantity_applies_to_total_of_windows", func() {
+		apiCallsLineItem.IncludedQuantity = decimal.NewFromInt(550)
+		defer func() { apiCallsLineItem.IncludedQuantity = decimal.Zero }()
+
+		lineItems, totalAmount, err := s.service.CalculateUsageCharges(ctx, s.testData.subscription, usage(),
+			s.testData.subscription.CurrentPeriodStart, s.testData.subscription.CurrentPeriodEnd)
+		s.NoError(err)
+		s.Require().Len(lineItems, 2)
+
+		// The 550 included units cover the off-window usage first and then 50 peak units
+		s.True(decimal.Zero.Equal(lineItems[0].Amount), "got %s", lineItems[0].Amount)
+		s.Equal("500", lineItems[0].Metadata["included_quantity"])
+		s.True(decimal.NewFromInt(50).Equal(lineItems[1].Quantity), "got %s", lineItems[1].Quantity)
+		s.Equal("50", lineItems[1].Metadata["included_quantity"])
+		s.True(decimal.NewFromFloat(2.5).Equal(totalAmount), "expected 2.5, got %s", totalAmount)
+	})
+
+	s.Run("entitlement_applies_to_total_of_windows", func() {
+		testFeature := &feature.Feature{
+			ID:        "feat_time_window",
+			Name:      "Time Window API Calls",
+			Type:      types.FeatureTypeMetered,
+			MeterID:   s.testData.meters.apiCalls.ID,
+			BaseModel: types.GetDefaultBaseModel(ctx),
+		}
+		s.NoError(s.GetStores().FeatureRepo.Create(ctx, testFeature))
+
+		_, err := s.GetStores().EntitlementRepo.Create(ctx, &entitlement.Entitlement{
+			ID:               "ent_time_window",
+			EntityType:       types.ENTITLEMENT_ENTITY_TYPE_PLAN,
+			EntityID:         s.testData.plan.ID,
+			FeatureID:        testFeature.ID,
+			FeatureType:      types.FeatureTypeMetered,
+			IsEnabled:        true,
+			UsageLimit:       lo.ToPtr(int64(550)),
+			UsageResetPeriod: types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY,
+			BaseModel:        types.GetDefaultBaseModel(ctx),
+		})
+		s.NoError(err)
+
+		lineItems, totalAmount, err := s.service.CalculateUsageCharges(ctx, s.testData.subscription, usage(),
+			s.testData.subscription.CurrentPeriodStart, s.testData.subscription.CurrentPeriodEnd)
+		s.NoError(err)
+		s.Require().Len(lineItems, 2)
+
+		// The 550 entitled units cover the off-window usage first and then 50 peak units
+		s.True(decimal.Zero.Equal(lineItems[0].Quantity), "got %s", lineItems[0].Quantity)
+		s.True(decimal.Zero.Equal(lineItems[0].Amount), "got %s", lineItems[0].Amount)
+		s.True(decimal.NewFromInt(50).Equal(lineItems[1].Quantity), "got %s", lineItems[1].Quantity)
+		s.True(decimal.NewFromFloat(2.5).Equal(totalAmount), "expected 2.5, got %s", totalAmount)
+		s.Equal("peak", lineItems[1].Metadata["time_window"])
+		s.Equal("monthly", lineItems[1].Metadata["usage_reset_period"])
+	})
+
+	s.Run("windows_use_customer_or_invoice_timezone", func() {
+		params := s.service.(*billingService).ServiceParams
+		sub := *s.testData.subscription
+
+		sub.CustomerTimezone = "EST"
+		s.Equal("America/New_York", resolveBillingTimezone(ctx, params, &sub))
+
+		sub.CustomerTimezone = ""
+		s.Equal("UTC", resolveBillingTimezone(ctx, params, &sub))
+
+		settingsSvc := NewSettingsService(params).(*settingsService)
+		invoiceConfig, err := GetSetting[types.InvoiceConfig](settingsSvc, ctx, types.SettingKeyInvoiceConfig)
+		s.Require().NoError(err)
+		invoiceConfig.InvoiceNumberTimezone = "IST"
+		s.Require().NoError(UpdateSetting(settingsSvc, ctx, types.SettingKeyInvoiceConfig, invoiceConfig))
+		s.Equal("Asia/Kolkata", resolveBillingTimezone(ctx, params, &sub))
+	})
+}
+
 func (s *BillingServiceSuite) TestCalculateUsageChargesWithDailyReset() {
 	// Setup test data for daily usage calculation
 	ctx := s.GetContext()
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// thresholdBilledCharge is the usage of a price that was already billed by interim threshold invoices
+type thresholdBilledCharge struct {
+	Amount   decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// PrepareThresholdInvoiceRequest prepares an interim invoice request for the usage charges accrued
+// in the current period up to asOf that were not billed by earlier threshold invoices. Nil is
+// returned when the subscription has no billing threshold or the unbilled accrual does not exceed it.
+// Commitment true-ups and coupons are left to the period end invoice.
+func (s *billingService) PrepareThresholdInvoiceRequest(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	asOf time.Time,
+) (*dto.CreateInvoiceRequest, error) {
+	if sub.BillingThresholdAmount == nil {
+		return nil, nil
+	}
+
+	periodStart := sub.CurrentPeriodStart
+	periodEnd := lo.Ternary(asOf.Before(sub.CurrentPeriodEnd), asOf, sub.CurrentPeriodEnd)
+	if !periodStart.Before(periodEnd) {
+		return nil, nil
+	}
+
+	// True-ups depend on the usage of the whole period so they are never part of an interim invoice
+	accrualSub := *sub
+	accrualSub.EnableTrueUp = false
+	usageLineItems := make([]*subscription.SubscriptionLineItem, 0, len(sub.LineItems))
+	for _, item := range sub.LineItems {
+		if item.PriceType != types.PRICE_TYPE_USAGE || item.InvoiceCadence != types.InvoiceCadenceArrear {
+			continue
+		}
+		itemCopy := *item
+		itemCopy.CommitmentTrueUpEnabled = false
+		usageLineItems = append(usageLineItems, &itemCopy)
+	}
+	if len(usageLineItems) == 0 {
+		return nil, nil
+	}
+
+	result, err := s.CalculateCharges(ctx, &accrualSub, usageLineItems, periodStart, periodEnd, true)
+	if err != nil {
+		return nil, err
+	}
+
+	billed, err := s.getThresholdBilledCharges(ctx, sub, periodStart, sub.CurrentPeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	billedTotal := decimal.Zero
+	for _, charge := range billed {
+		billedTotal = billedTotal.Add(charge.Amount)
+	}
+
+	// Bill the difference between the accrual and what earlier threshold invoices covered, per price
+	accrued := make(map[string]*thresholdBilledCharge)
+	templates := make(map[string]dto.CreateInvoiceLineItemRequest)
+	priceIDs := make([]string, 0)
+	for _, charge := range result.UsageCharges {
+		priceID := lo.FromPtr(charge.PriceID)
+		if _, ok := accrued[priceID]; !ok {
+			accrued[priceID] = &thresholdBilledCharge{}
+			templates[priceID] = charge
+			priceIDs = append(priceIDs, priceID)
+		}
+		accrued[priceID].Amount = accrued[priceID].Amount.Add(charge.Amount)
+		accrued[priceID].Quantity = accrued[priceID].Quantity.Add(charge.Quantity)
+	}
+
+	unbilledTotal := decimal.Zero
+	lineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(priceIDs))
+	for _, priceID := range priceIDs {
+		amount := accrued[priceID].Amount
+		quantity := accrued[priceID].Quantity
+		if prior, ok := billed[priceID]; ok {
+			amount = amount.Sub(prior.Amount)
+			quantity = decimal.Max(quantity.Sub(prior.Quantity), decimal.Zero)
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		lineItem := templates[priceID]
+		lineItem.Amount = amount
+		lineItem.Quantity = quantity
+		lineItem.PeriodStart = lo.ToPtr(periodStart)
+		lineItem.PeriodEnd = lo.ToPtr(periodEnd)
+		lineItem.CommitmentInfo = nil
+		lineItem.Metadata = lo.Assign(lineItem.Metadata, types.Metadata{
+			"billing_threshold": "true",
+		})
+		lineItems = append(lineItems, lineItem)
+		unbilledTotal = unbilledTotal.Add(amount)
+	}
+
+	if !unbilledTotal.GreaterThan(*sub.BillingThresholdAmount) {
+		return nil, nil
+	}
+
+	s.Logger.Infow("billing threshold exceeded",
+		"subscription_id", sub.ID,
+		"threshold", sub.BillingThresholdAmount,
+		"unbilled_amount", unbilledTotal,
+		"billed_amount", billedTotal,
+		"period_start", periodStart,
+		"as_of", periodEnd)
+
+	req, err := s.CreateInvoiceRequestForCharges(ctx, sub, &BillingCalculationResult{
+		FixedCharges: make([]dto.CreateInvoiceLineItemRequest, 0),
+		UsageCharges: lineItems,
+		TotalAmount:  unbilledTotal,
+		Currency:     sub.Currency,
+	}, periodStart, periodEnd,
+		fmt.Sprintf("Threshold invoice for subscription %s", sub.ID),
+		types.Metadata{
+			"billing_threshold_amount": sub.BillingThresholdAmount.String(),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent evaluations see the same billed amount and therefore generate the same key,
+	// so at most one interim invoice is created for the same accrual
+	req.IdempotencyKey = lo.ToPtr(idempotency.NewGenerator().GenerateKey(idempotency.ScopeThresholdInvoice, map[string]interface{}{
+		"tenant_id":       types.GetTenantID(ctx),
+		"environment_id":  types.GetEnvironmentID(ctx),
+		"subscription_id": sub.ID,
+		"period_start":    periodStart,
+		"billed_amount":   billedTotal.String(),
+	}))
+	req.BillingReason = types.InvoiceBillingReasonSubscriptionThreshold
+	req.InvoiceCoupons = nil
+	req.LineItemCoupons = nil
+
+	return req, nil
+}
+
+// netThresholdBilledCharges deducts the usage already billed by threshold invoices of the period
+// from the usage charges of the period end invoice
+func (s *billingService) netThresholdBilledCharges(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+	result *BillingCalculationResult,
+) error {
+	if result == nil || len(result.UsageCharges) == 0 {
+		return nil
+	}
+
+	billed, err := s.getThresholdBilledCharges(ctx, sub, periodStart, periodEnd)
+	if err != nil {
+		return err
+	}
+	if len(billed) == 0 {
+		return nil
+	}
+
+	deducted := deductThresholdBilledCharges(result.UsageCharges, billed)
+	result.TotalAmount = result.TotalAmount.Sub(deducted)
+
+	s.Logger.Infow("netted usage billed by threshold invoices",
+		"subscription_id", sub.ID,
+		"period_start", periodStart,
+		"period_end", periodEnd,
+		"deducted_amount", deducted)
+
+	return nil
+}
+
+// deductThresholdBilledCharges reduces the usage charges by the usage billed per price and returns
+// the deducted amount. A price can span several charges, so the billed usage is deducted from each
+// charge of the price until it is used up.
+func deductThresholdBilledCharges(charges []dto.CreateInvoiceLineItemRequest, billed map[string]*thresholdBilledCharge) decimal.Decimal {
+	deducted := decimal.Zero
+	for i := range charges {
+		charge := &charges[i]
+		prior, ok := billed[lo.FromPtr(charge.PriceID)]
+		if !ok || !prior.Amount.IsPositive() || !charge.Amount.IsPositive() {
+			continue
+		}
+
+		amount := decimal.Min(charge.Amount, prior.Amount)
+		quantity := decimal.Max(decimal.Min(charge.Quantity, prior.Quantity), decimal.Zero)
+		prior.Amount = prior.Amount.Sub(amount)
+		prior.Quantity = prior.Quantity.Sub(quantity)
+
+		charge.Amount = charge.Amount.Sub(amount)
+		charge.Quantity = charge.Quantity.Sub(quantity)
+		charge.Metadata = lo.Assign(charge.Metadata, types.Metadata{
+			"threshold_billed_amount": amount.String(),
+		})
+		deducted = deducted.Add(amount)
+	}
+	return deducted
+}
+
+// getThresholdBilledCharges returns the usage billed per price by the threshold invoices of the
+// period starting at periodStart
+func (s *billingService) getThresholdBilledCharges(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+) (map[string]*thresholdBilledCharge, error) {
+	invoiceFilter := types.NewNoLimitInvoiceFilter()
+	invoiceFilter.SubscriptionID = sub.ID
+	invoiceFilter.InvoiceType = types.InvoiceTypeSubscription
+	invoiceFilter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+	invoiceFilter.PeriodStartGTE = lo.ToPtr(periodStart)
+	invoiceFilter.PeriodEndLTE = lo.ToPtr(periodEnd)
+
+	invoices, err := s.InvoiceRepo.List(ctx, invoiceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	return sumThresholdBilledCharges(invoices, periodStart), nil
+}
+
+// sumThresholdBilledCharges sums the line items of the threshold invoices of the period starting
+// at periodStart per price
+func sumThresholdBilledCharges(invoices []*invoice.Invoice, periodStart time.Time) map[string]*thresholdBilledCharge {
+	billed := make(map[string]*thresholdBilledCharge)
+	for _, inv := range invoices {
+		if inv.BillingReason != string(types.InvoiceBillingReasonSubscriptionThreshold) ||
+			inv.PeriodStart == nil || !inv.PeriodStart.Equal(periodStart) {
+			continue
+		}
+
+		for _, item := range inv.LineItems {
+			priceID := lo.FromPtr(item.PriceID)
+			if _, ok := billed[priceID]; !ok {
+				billed[priceID] = &thresholdBilledCharge{}
+			}
+			billed[priceID].Amount = billed[priceID].Amount.Add(item.Amount)
+			billed[priceID].Quantity = billed[priceID].Quantity.Add(item.Quantity)
+		}
+	}
+	return billed
+}
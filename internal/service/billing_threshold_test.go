@@ -0,0 +1,217 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSumThresholdBilledCharges(t *testing.T) {
+	periodStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	previousPeriodStart := periodStart.AddDate(0, -1, 0)
+
+	lineItem := func(priceID, amount, quantity string) *invoice.InvoiceLineItem {
+		return &invoice.InvoiceLineItem{
+			PriceID:  lo.ToPtr(priceID),
+			Amount:   decimal.RequireFromString(amount),
+			Quantity: decimal.RequireFromString(quantity),
+		}
+	}
+
+	invoices := []*invoice.Invoice{
+		{
+			BillingReason: string(types.InvoiceBillingReasonSubscriptionThreshold),
+			PeriodStart:   lo.ToPtr(periodStart),
+			LineItems:     []*invoice.InvoiceLineItem{lineItem("price_a", "100", "1000"), lineItem("price_b", "20", "10")},
+		},
+		{
+			BillingReason: string(types.InvoiceBillingReasonSubscriptionThreshold),
+			PeriodStart:   lo.ToPtr(periodStart),
+			LineItems:     []*invoice.InvoiceLineItem{lineItem("price_a", "50", "500")},
+		},
+		{
+			// Threshold invoice of the previous period
+			BillingReason: string(types.InvoiceBillingReasonSubscriptionThreshold),
+			PeriodStart:   lo.ToPtr(previousPeriodStart),
+			LineItems:     []*invoice.InvoiceLineItem{lineItem("price_a", "70", "700")},
+		},
+		{
+			// Regular invoice of the period
+			BillingReason: string(types.InvoiceBillingReasonSubscriptionCycle),
+			PeriodStart:   lo.ToPtr(periodStart),
+			LineItems:     []*invoice.InvoiceLineItem{lineItem("price_a", "30", "300")},
+		},
+	}
+
+	billed := sumThresholdBilledCharges(invoices, periodStart)
+
+	require.Len(t, billed, 2)
+	assert.True(t, decimal.NewFromInt(150).Equal(billed["price_a"].Amount))
+	assert.True(t, decimal.NewFromInt(1500).Equal(billed["price_a"].Quantity))
+	assert.True(t, decimal.NewFromInt(20).Equal(billed["price_b"].Amount))
+	assert.True(t, decimal.NewFromInt(10).Equal(billed["price_b"].Quantity))
+}
+
+func TestDeductThresholdBilledCharges(t *testing.T) {
+	charge := func(priceID, amount, quantity string) dto.CreateInvoiceLineItemRequest {
+		return dto.CreateInvoiceLineItemRequest{
+			PriceID:  lo.ToPtr(priceID),
+			Amount:   decimal.RequireFromString(amount),
+			Quantity: decimal.RequireFromString(quantity),
+		}
+	}
+
+	charges := []dto.CreateInvoiceLineItemRequest{
+		// price_a spans two charges, e.g. two rate windows
+		charge("price_a", "60", "600"),
+		charge("price_a", "120", "1200"),
+		charge("price_b", "15", "10"),
+		charge("price_c", "40", "4"),
+	}
+	billed := map[string]*thresholdBilledCharge{
+		"price_a": {Amount: decimal.NewFromInt(100), Quantity: decimal.NewFromInt(1000)},
+		"price_b": {Amount: decimal.NewFromInt(20), Quantity: decimal.NewFromInt(12)},
+	}
+
+	deducted := deductThresholdBilledCharges(charges, billed)
+
+	assert.True(t, decimal.NewFromInt(115).Equal(deducted), "expected 115, got %s", deducted)
+
+	expected := []struct {
+		amount   int64
+		quantity int64
+	}{
+		{0, 0},
+		{80, 800},
+		{0, 0},
+		{40, 4},
+	}
+	for i, e := range expected {
+		assert.True(t, decimal.NewFromInt(e.amount).Equal(charges[i].Amount), "charge %d amount: expected %d, got %s", i, e.amount, charges[i].Amount)
+		assert.True(t, decimal.NewFromInt(e.quantity).Equal(charges[i].Quantity), "charge %d quantity: expected %d, got %s", i, e.quantity, charges[i].Quantity)
+	}
+	assert.Equal(t, "40", charges[1].Metadata["threshold_billed_amount"])
+	assert.Empty(t, charges[3].Metadata)
+}
+
+func (s *InvoiceServiceSuite) TestCreateSubscriptionThresholdInvoice() {
+	ctx := s.GetContext()
+
+	// The usage of the test data accrues 10 for the API calls and 5 for the storage
+	sub := &subscription.Subscription{
+		ID:                     "sub_threshold",
+		PlanID:                 s.testData.plan.ID,
+		CustomerID:             s.testData.customer.ID,
+		StartDate:              s.testData.subscription.StartDate,
+		CurrentPeriodStart:     s.testData.subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:       s.testData.subscription.CurrentPeriodEnd,
+		Currency:               "usd",
+		BillingPeriod:          types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount:     1,
+		SubscriptionStatus:     types.SubscriptionStatusActive,
+		BillingThresholdAmount: lo.ToPtr(decimal.NewFromInt(12)),
+		BaseModel:              types.GetDefaultBaseModel(ctx),
+	}
+	lineItems := make([]*subscription.SubscriptionLineItem, 0, 2)
+	for _, p := range []*price.Price{s.testData.prices.apiCalls, s.testData.prices.storage} {
+		lineItems = append(lineItems, &subscription.SubscriptionLineItem{
+			ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID: sub.ID,
+			CustomerID:     sub.CustomerID,
+			EntityID:       s.testData.plan.ID,
+			EntityType:     types.SubscriptionLineItemEntityTypePlan,
+			PriceID:        p.ID,
+			PriceType:      p.Type,
+			MeterID:        p.MeterID,
+			InvoiceCadence: types.InvoiceCadenceArrear,
+			Currency:       sub.Currency,
+			BillingPeriod:  sub.BillingPeriod,
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		})
+	}
+	s.Require().NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, sub, lineItems))
+
+	listThresholdInvoices := func() []*invoice.Invoice {
+		invoices, err := s.invoiceRepo.List(ctx, types.NewNoLimitInvoiceFilter())
+		s.Require().NoError(err)
+		return lo.Filter(invoices, func(inv *invoice.Invoice, _ int) bool {
+			return inv.BillingReason == string(types.InvoiceBillingReasonSubscriptionThreshold)
+		})
+	}
+
+	s.Run("crossing_the_threshold_creates_an_interim_invoice", func() {
+		inv, err := s.service.CreateSubscriptionThresholdInvoice(ctx, sub.ID)
+		s.Require().NoError(err)
+		s.Require().NotNil(inv)
+		s.Equal(sub.ID, lo.FromPtr(inv.SubscriptionID))
+		s.True(decimal.NewFromInt(15).Equal(inv.Subtotal), "expected 15, got %s", inv.Subtotal)
+		s.Len(listThresholdInvoices(), 1)
+	})
+
+	s.Run("billed_accrual_is_not_invoiced_again", func() {
+		inv, err := s.service.CreateSubscriptionThresholdInvoice(ctx, sub.ID)
+		s.NoError(err)
+		s.Nil(inv)
+		s.Len(listThresholdInvoices(), 1)
+	})
+
+	s.Run("concurrent_evaluations_create_one_invoice", func() {
+		// 300 more API calls accrue another 6 on top of the billed 15
+		for i := 0; i < 300; i++ {
+			s.NoError(s.eventRepo.InsertEvent(ctx, &events.Event{
+				ID:                 s.GetUUID(),
+				TenantID:           sub.TenantID,
+				EventName:          s.testData.meters.apiCalls.EventName,
+				ExternalCustomerID: s.testData.customer.ExternalID,
+				Timestamp:          s.testData.now.Add(-10 * time.Minute),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+		sub.BillingThresholdAmount = lo.ToPtr(decimal.NewFromInt(5))
+		s.Require().NoError(s.GetStores().SubscriptionRepo.Update(ctx, sub))
+
+		billingService := NewBillingService(s.service.(*invoiceService).ServiceParams)
+		storedSub, _, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, sub.ID)
+		s.Require().NoError(err)
+		first, err := billingService.PrepareThresholdInvoiceRequest(ctx, storedSub, time.Now().UTC())
+		s.Require().NoError(err)
+		second, err := billingService.PrepareThresholdInvoiceRequest(ctx, storedSub, time.Now().UTC())
+		s.Require().NoError(err)
+		s.Require().NotNil(first)
+		s.Require().NotNil(second)
+		s.Equal(*first.IdempotencyKey, *second.IdempotencyKey)
+
+		_, err = s.service.CreateInvoice(ctx, *first)
+		s.Require().NoError(err)
+		_, err = s.service.CreateInvoice(ctx, *second)
+		s.True(ierr.IsAlreadyExists(err))
+		s.Len(listThresholdInvoices(), 2)
+	})
+
+	s.Run("period_end_invoice_nets_threshold_billed_usage", func() {
+		// The period accrued 21, of which the threshold invoices billed 15 and 6
+		billingService := NewBillingService(s.service.(*invoiceService).ServiceParams)
+		storedSub, _, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, sub.ID)
+		s.Require().NoError(err)
+		req, err := billingService.PrepareSubscriptionInvoiceRequest(ctx, storedSub,
+			storedSub.CurrentPeriodStart, storedSub.CurrentPeriodEnd, types.ReferencePointPeriodEnd)
+		s.Require().NoError(err)
+		s.True(decimal.Zero.Equal(req.Subtotal), "expected 0, got %s", req.Subtotal)
+		s.Require().NotEmpty(req.LineItems)
+		for _, item := range req.LineItems {
+			s.True(item.Amount.IsZero(), "expected no unbilled usage, got %s", item.Amount)
+			s.NotEmpty(item.Metadata["threshold_billed_amount"])
+		}
+	})
+}
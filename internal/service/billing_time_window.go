@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// timeWindowCharge is the billed quantity and amount of one charge of a time-of-use price once
+// the entitlement, the included usage and the commitment of its line item are applied to the
+// total of its charges
+type timeWindowCharge struct {
+	quantity       decimal.Decimal
+	amount         decimal.Decimal
+	included       decimal.Decimal
+	commitmentInfo *types.CommitmentInfo
+}
+
+// applyEntitlementAndCommitmentToTimeWindowCharges applies the entitlement, the included usage and
+// the line item commitment of a time-of-use line item to the total usage of its charges. The usage
+// allowed by the entitlement and then the usage included by the line item are consumed from the
+// off-window charge first and then from the windows in the order they are defined, and the charges
+// are re-rated. The commitment is applied to the total amount of the charges and spread over them
+// in proportion to their amounts, the commitment info is returned on the first charge. The amount
+// of each charge is set to its amount before the commitment is applied.
+func (s *billingService) applyEntitlementAndCommitmentToTimeWindowCharges(
+	ctx context.Context,
+	priceService PriceService,
+	sub *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	charges []*dto.SubscriptionUsageByMetersResponse,
+	entitlement *dto.AggregatedEntitlement,
+	m *meter.Meter,
+	customer *customer.Customer,
+	eventService EventService,
+	periodStart,
+	periodEnd time.Time,
+) (map[*dto.SubscriptionUsageByMetersResponse]*timeWindowCharge, error) {
+	result := make(map[*dto.SubscriptionUsageByMetersResponse]*timeWindowCharge, len(charges))
+	for _, charge := range charges {
+		result[charge] = &timeWindowCharge{
+			quantity: decimal.NewFromFloat(charge.Quantity),
+			amount:   decimal.NewFromFloat(charge.Amount),
+		}
+	}
+
+	if entitlement != nil && entitlement.IsEnabled {
+		total := decimal.Zero
+		for _, charge := range charges {
+			if !charge.IsOverage {
+				total = total.Add(result[charge].quantity)
+			}
+		}
+
+		// Unlimited usage is not billed
+		billable := decimal.Zero
+		if entitlement.UsageLimit != nil {
+			var err error
+			billable, err = s.billableQuantityAfterEntitlement(ctx, sub, item, entitlement, customer, eventService, periodStart, periodEnd, total)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		remaining := total.Sub(billable)
+		for _, charge := range orderTimeWindowCharges(charges) {
+			if charge.IsOverage || !remaining.IsPositive() {
+				continue
+			}
+
+			tw := result[charge]
+			deducted := decimal.Min(remaining, tw.quantity)
+			remaining = remaining.Sub(deducted)
+			tw.quantity = tw.quantity.Sub(deducted)
+		}
+	}
+
+	remaining := decimal.Max(decimal.Zero, item.IncludedQuantity)
+	for _, charge := range orderTimeWindowCharges(charges) {
+		if charge.IsOverage || !remaining.IsPositive() {
+			continue
+		}
+
+		tw := result[charge]
+		tw.included = decimal.Min(remaining, tw.quantity)
+		remaining = remaining.Sub(tw.included)
+		tw.quantity = tw.quantity.Sub(tw.included)
+	}
+
+	// Charges are re-rated once for the usage left after the entitlement and the included usage
+	for _, charge := range charges {
+		tw := result[charge]
+		if tw.quantity.Equal(decimal.NewFromFloat(charge.Quantity)) {
+			continue
+		}
+		tw.amount = s.rateTimeWindowCharge(ctx, priceService, charge, m, tw.quantity)
+		charge.Amount = tw.amount.InexactFloat64()
+	}
+
+	if !item.HasCommitment() {
+		return result, nil
+	}
+
+	total := decimal.Zero
+	for _, charge := range charges {
+		total = total.Add(result[charge].amount)
+	}
+
+	commitmentCalc := newCommitmentCalculator(s.Logger, priceService)
+	final, info, err := commitmentCalc.applyCommitmentToLineItem(ctx, item, total, charges[0].Price)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, charge := range charges {
+		tw := result[charge]
+		switch {
+		case total.IsPositive():
+			tw.amount = tw.amount.Mul(final).Div(total)
+		case i == 0:
+			tw.amount = final
+		}
+	}
+	result[charges[0]].commitmentInfo = info
+
+	return result, nil
+}
+
+// orderTimeWindowCharges returns the charges of a time-of-use price with the off-window charge
+// first followed by the window charges in the order the windows are defined
+func orderTimeWindowCharges(charges []*dto.SubscriptionUsageByMetersResponse) []*dto.SubscriptionUsageByMetersResponse {
+	ordered := lo.Filter(charges, func(c *dto.SubscriptionUsageByMetersResponse, _ int) bool {
+		return c.TimeWindow == ""
+	})
+	if len(charges) == 0 || charges[0].Price == nil {
+		return ordered
+	}
+
+	for _, window := range charges[0].Price.TimeWindows {
+		ordered = append(ordered, lo.Filter(charges, func(c *dto.SubscriptionUsageByMetersResponse, _ int) bool {
+			return c.TimeWindow == window.Name
+		})...)
+	}
+	return ordered
+}
+
+// rateTimeWindowCharge rates a charge of a time-of-use price for the quantity. Window usage is
+// rated at the unit amount of the window and off-window usage with the billing model of the
+// price. Bucketed off-window usage is not rated on the total quantity, so its amount is reduced
+// in proportion to the quantity.
+func (s *billingService) rateTimeWindowCharge(
+	ctx context.Context,
+	priceService PriceService,
+	charge *dto.SubscriptionUsageByMetersResponse,
+	m *meter.Meter,
+	quantity decimal.Decimal,
+) decimal.Decimal {
+	if charge.TimeWindow != "" {
+		window, ok := lo.Find(charge.Price.TimeWindows, func(w types.PriceTimeWindow) bool {
+			return w.Name == charge.TimeWindow
+		})
+		if ok {
+			return window.UnitAmount.Mul(quantity)
+		}
+	}
+
+	if m != nil && (m.IsBucketedMaxMeter() || m.IsBucketedSumMeter()) {
+		original := decimal.NewFromFloat(charge.Quantity)
+		if !original.IsPositive() {
+			return decimal.Zero
+		}
+		return decimal.NewFromFloat(charge.Amount).Mul(quantity).Div(original)
+	}
+
+	return priceService.CalculateCost(ctx, charge.Price, quantity)
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// resolveBillingTimezone resolves the timezone in which the usage of a subscription is billed. It
+// is the timezone of the customer on the subscription, or the invoice timezone of the tenant for
+// subscriptions without one.
+func resolveBillingTimezone(ctx context.Context, params ServiceParams, sub *subscription.Subscription) string {
+	if sub.CustomerTimezone != "" {
+		return types.ResolveTimezone(sub.CustomerTimezone)
+	}
+
+	if params.SettingsRepo == nil {
+		return "UTC"
+	}
+
+	settingsSvc := NewSettingsService(params).(*settingsService)
+	invoiceConfig, err := GetSetting[types.InvoiceConfig](settingsSvc, ctx, types.SettingKeyInvoiceConfig)
+	if err != nil || invoiceConfig.InvoiceNumberTimezone == "" {
+		return "UTC"
+	}
+	return types.ResolveTimezone(invoiceConfig.InvoiceNumberTimezone)
+}
@@ -0,0 +1,978 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/addon"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/creditgrant"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// CatalogService exports the catalog of an environment to a declarative document and applies
+// such documents to an environment.
+type CatalogService interface {
+	// ExportCatalog exports the features, plans, addons and coupons of the current environment
+	ExportCatalog(ctx context.Context) (*dto.CatalogDocument, error)
+
+	// ApplyCatalog upserts the entities of a catalog document in a single transaction. Entities
+	// that exist in the environment but not in the document are left untouched.
+	ApplyCatalog(ctx context.Context, req dto.ApplyCatalogRequest) (*dto.ApplyCatalogResponse, error)
+}
+
+type catalogService struct {
+	ServiceParams
+}
+
+// NewCatalogService creates a new catalog service
+func NewCatalogService(params ServiceParams) CatalogService {
+	return &catalogService{
+		ServiceParams: params,
+	}
+}
+
+// catalogState is a snapshot of the catalog of an environment indexed by catalog keys
+type catalogState struct {
+	features          map[string]*feature.Feature
+	featuresByID      map[string]*feature.Feature
+	featuresByMeterID map[string]*feature.Feature
+	meters            map[string]*meter.Meter
+	plans             map[string]*plan.Plan
+	addons            map[string]*addon.Addon
+	prices            map[string]*price.Price
+	pricesByEntity    map[string][]*price.Price
+	entitlements      map[string][]*entitlement.Entitlement
+	creditGrants      map[string][]*creditgrant.CreditGrant
+	coupons           map[string]*coupon.Coupon
+	duplicateCoupons  map[string]bool
+
+	// skipped are the entities that cannot be addressed by a catalog key
+	skipped []dto.CatalogSkippedEntity
+}
+
+func (s *catalogService) loadCatalogState(ctx context.Context) (*catalogState, error) {
+	state := &catalogState{
+		features:          make(map[string]*feature.Feature),
+		featuresByID:      make(map[string]*feature.Feature),
+		featuresByMeterID: make(map[string]*feature.Feature),
+		meters:            make(map[string]*meter.Meter),
+		plans:             make(map[string]*plan.Plan),
+		addons:            make(map[string]*addon.Addon),
+		prices:            make(map[string]*price.Price),
+		pricesByEntity:    make(map[string][]*price.Price),
+		entitlements:      make(map[string][]*entitlement.Entitlement),
+		creditGrants:      make(map[string][]*creditgrant.CreditGrant),
+		coupons:           make(map[string]*coupon.Coupon),
+		duplicateCoupons:  make(map[string]bool),
+	}
+
+	features, err := s.FeatureRepo.ListAll(ctx, types.NewNoLimitFeatureFilter())
+	if err != nil {
+		return nil, err
+	}
+	meterIDs := make([]string, 0, len(features))
+	for _, f := range features {
+		state.featuresByID[f.ID] = f
+		if f.MeterID != "" {
+			state.featuresByMeterID[f.MeterID] = f
+			meterIDs = append(meterIDs, f.MeterID)
+		}
+		if f.LookupKey == "" {
+			state.skip(types.CatalogEntityTypeFeature, f.ID, f.Name, "feature has no lookup key")
+			continue
+		}
+		state.features[f.LookupKey] = f
+	}
+
+	if len(meterIDs) > 0 {
+		meterFilter := types.NewNoLimitMeterFilter()
+		meterFilter.MeterIDs = meterIDs
+		meters, err := s.MeterRepo.ListAll(ctx, meterFilter)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range meters {
+			state.meters[m.ID] = m
+		}
+	}
+
+	plans, err := s.PlanRepo.ListAll(ctx, types.NewNoLimitPlanFilter())
+	if err != nil {
+		return nil, err
+	}
+	planIDs := make([]string, 0, len(plans))
+	for _, p := range plans {
+		if p.LookupKey == "" {
+			state.skip(types.CatalogEntityTypePlan, p.ID, p.Name, "plan has no lookup key")
+			continue
+		}
+		state.plans[p.LookupKey] = p
+		planIDs = append(planIDs, p.ID)
+	}
+
+	addons, err := s.AddonRepo.List(ctx, types.NewNoLimitAddonFilter())
+	if err != nil {
+		return nil, err
+	}
+	addonIDs := make([]string, 0, len(addons))
+	for _, a := range addons {
+		state.addons[a.LookupKey] = a
+		addonIDs = append(addonIDs, a.ID)
+	}
+
+	if err := s.loadCatalogPrices(ctx, state, types.PRICE_ENTITY_TYPE_PLAN, planIDs); err != nil {
+		return nil, err
+	}
+	if err := s.loadCatalogPrices(ctx, state, types.PRICE_ENTITY_TYPE_ADDON, addonIDs); err != nil {
+		return nil, err
+	}
+
+	if len(planIDs) > 0 {
+		entitlements, err := s.EntitlementRepo.ListByPlanIDs(ctx, planIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entitlements {
+			state.entitlements[e.EntityID] = append(state.entitlements[e.EntityID], e)
+		}
+
+		grantFilter := types.NewNoLimitCreditGrantFilter()
+		grantFilter.PlanIDs = planIDs
+		grantFilter.Scope = lo.ToPtr(types.CreditGrantScopePlan)
+		grants, err := s.CreditGrantRepo.ListAll(ctx, grantFilter)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range grants {
+			planID := lo.FromPtr(g.PlanID)
+			state.creditGrants[planID] = append(state.creditGrants[planID], g)
+		}
+	}
+
+	if len(addonIDs) > 0 {
+		entitlements, err := s.EntitlementRepo.ListByAddonIDs(ctx, addonIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entitlements {
+			state.entitlements[e.EntityID] = append(state.entitlements[e.EntityID], e)
+		}
+	}
+
+	coupons, err := s.CouponRepo.List(ctx, types.NewNoLimitCouponFilter())
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range coupons {
+		if existing, ok := state.coupons[c.Name]; ok {
+			// Coupons are addressed by name, coupons sharing a name cannot be told apart
+			state.duplicateCoupons[c.Name] = true
+			state.skip(types.CatalogEntityTypeCoupon, existing.ID, existing.Name, "coupon name is not unique")
+			state.skip(types.CatalogEntityTypeCoupon, c.ID, c.Name, "coupon name is not unique")
+			continue
+		}
+		state.coupons[c.Name] = c
+	}
+	for name := range state.duplicateCoupons {
+		delete(state.coupons, name)
+	}
+
+	return state, nil
+}
+
+func (s *catalogService) loadCatalogPrices(ctx context.Context, state *catalogState, entityType types.PriceEntityType, entityIDs []string) error {
+	if len(entityIDs) == 0 {
+		return nil
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter()
+	priceFilter.EntityType = lo.ToPtr(entityType)
+	priceFilter.EntityIDs = entityIDs
+	prices, err := s.PriceRepo.ListAll(ctx, priceFilter)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range prices {
+		if p.LookupKey == "" {
+			state.skip(types.CatalogEntityTypePrice, p.ID, p.DisplayName, "price has no lookup key")
+			continue
+		}
+		state.prices[p.LookupKey] = p
+		state.pricesByEntity[p.EntityID] = append(state.pricesByEntity[p.EntityID], p)
+	}
+	return nil
+}
+
+func (st *catalogState) skip(entityType types.CatalogEntityType, id, name, reason string) {
+	st.skipped = append(st.skipped, dto.CatalogSkippedEntity{
+		EntityType: entityType,
+		ID:         id,
+		Name:       name,
+		Reason:     reason,
+	})
+}
+
+// featureLookupKeyOfMeter returns the lookup key of the feature of a meter
+func (st *catalogState) featureLookupKeyOfMeter(meterID string) string {
+	if f, ok := st.featuresByMeterID[meterID]; ok {
+		return f.LookupKey
+	}
+	return ""
+}
+
+func (st *catalogState) catalogFeature(f *feature.Feature) dto.CatalogFeature {
+	var m *meter.Meter
+	if f.MeterID != "" {
+		m = st.meters[f.MeterID]
+	}
+	return dto.NewCatalogFeature(f, m)
+}
+
+func (st *catalogState) catalogPrice(p *price.Price) dto.CatalogPrice {
+	return dto.NewCatalogPrice(p, st.featureLookupKeyOfMeter(p.MeterID))
+}
+
+func (s *catalogService) ExportCatalog(ctx context.Context) (*dto.CatalogDocument, error) {
+	state, err := s.loadCatalogState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &dto.CatalogDocument{
+		Version: dto.CatalogDocumentVersion,
+	}
+
+	for _, key := range sortedKeys(state.features) {
+		doc.Features = append(doc.Features, state.catalogFeature(state.features[key]))
+	}
+
+	for _, key := range sortedKeys(state.plans) {
+		p := state.plans[key]
+		cp := dto.NewCatalogPlan(p)
+		cp.Prices = s.exportCatalogPrices(state, p.ID)
+		cp.Entitlements = s.exportCatalogEntitlements(state, p.ID)
+		for _, g := range state.creditGrants[p.ID] {
+			cp.CreditGrants = append(cp.CreditGrants, dto.NewCatalogCreditGrant(g))
+		}
+		sort.Slice(cp.CreditGrants, func(i, j int) bool { return cp.CreditGrants[i].Name < cp.CreditGrants[j].Name })
+		doc.Plans = append(doc.Plans, cp)
+	}
+
+	for _, key := range sortedKeys(state.addons) {
+		a := state.addons[key]
+		ca := dto.NewCatalogAddon(a)
+		ca.Prices = s.exportCatalogPrices(state, a.ID)
+		ca.Entitlements = s.exportCatalogEntitlements(state, a.ID)
+		doc.Addons = append(doc.Addons, ca)
+	}
+
+	for _, key := range sortedKeys(state.coupons) {
+		doc.Coupons = append(doc.Coupons, dto.NewCatalogCoupon(state.coupons[key]))
+	}
+
+	doc.Skipped = state.skipped
+	return doc, nil
+}
+
+func (s *catalogService) exportCatalogPrices(state *catalogState, entityID string) []dto.CatalogPrice {
+	var prices []dto.CatalogPrice
+	for _, p := range state.pricesByEntity[entityID] {
+		cp := state.catalogPrice(p)
+		if p.MeterID != "" && cp.FeatureLookupKey == "" {
+			state.skip(types.CatalogEntityTypePrice, p.ID, p.LookupKey, "meter of the price has no feature with a lookup key")
+			continue
+		}
+		prices = append(prices, cp)
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LookupKey < prices[j].LookupKey })
+	return prices
+}
+
+func (s *catalogService) exportCatalogEntitlements(state *catalogState, entityID string) []dto.CatalogEntitlement {
+	var entitlements []dto.CatalogEntitlement
+	for _, e := range state.entitlements[entityID] {
+		f, ok := state.featuresByID[e.FeatureID]
+		if !ok || f.LookupKey == "" {
+			state.skip(types.CatalogEntityTypeEntitlement, e.ID, "", "feature of the entitlement has no lookup key")
+			continue
+		}
+		entitlements = append(entitlements, dto.NewCatalogEntitlement(e, f.LookupKey))
+	}
+	sort.Slice(entitlements, func(i, j int) bool { return entitlements[i].FeatureLookupKey < entitlements[j].FeatureLookupKey })
+	return entitlements
+}
+
+func (s *catalogService) ApplyCatalog(ctx context.Context, req dto.ApplyCatalogRequest) (*dto.ApplyCatalogResponse, error) {
+	if err := req.Document.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ApplyCatalogResponse{
+		DryRun: req.DryRun,
+	}
+
+	var conflicts []dto.CatalogChange
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		state, err := s.loadCatalogState(ctx)
+		if err != nil {
+			return err
+		}
+
+		planner := newCatalogPlanner(s, state)
+		if err := planner.plan(req.Document); err != nil {
+			return err
+		}
+
+		conflicts = lo.FilterMap(planner.changes, func(c *catalogChange, _ int) (dto.CatalogChange, bool) {
+			return c.CatalogChange, c.Action == types.CatalogChangeActionConflict
+		})
+		if req.DryRun || len(conflicts) > 0 {
+			resp.Changes, resp.Summary = planner.result()
+			return nil
+		}
+
+		for _, change := range planner.changes {
+			if change.apply == nil {
+				continue
+			}
+			id, err := change.apply(ctx)
+			if err != nil {
+				return err
+			}
+			change.ID = id
+		}
+
+		resp.Applied = true
+		resp.Changes, resp.Summary = planner.result()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.DryRun && len(conflicts) > 0 {
+		return nil, ierr.NewError("catalog document conflicts with the current catalog").
+			WithHint("The document changes fields that cannot be updated, run a dry run to review the conflicts").
+			WithReportableDetails(map[string]interface{}{
+				"conflicts": conflicts,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return resp, nil
+}
+
+// catalogChange is a planned change with the function that applies it
+type catalogChange struct {
+	dto.CatalogChange
+
+	// apply applies the change and returns the id of the resulting entity, it is nil for
+	// unchanged and conflicting entities
+	apply func(ctx context.Context) (string, error)
+}
+
+// catalogFeatureRef resolves a feature of a catalog document to the feature it is applied to
+type catalogFeatureRef struct {
+	featureType types.FeatureType
+	// feature is the existing feature, nil for features created by the document
+	feature *feature.Feature
+	// change is the change creating the feature
+	change *catalogChange
+	// meterID is the meter of a metered feature created by the document once applied
+	meterID string
+}
+
+func (r *catalogFeatureRef) id() string {
+	if r.feature != nil {
+		return r.feature.ID
+	}
+	return r.change.ID
+}
+
+func (r *catalogFeatureRef) meter() string {
+	if r.feature != nil {
+		return r.feature.MeterID
+	}
+	return r.meterID
+}
+
+// catalogPlanner computes the changes that apply a catalog document to a catalog state
+type catalogPlanner struct {
+	svc      *catalogService
+	state    *catalogState
+	features map[string]*catalogFeatureRef
+	changes  []*catalogChange
+}
+
+func newCatalogPlanner(svc *catalogService, state *catalogState) *catalogPlanner {
+	features := make(map[string]*catalogFeatureRef, len(state.features))
+	for key, f := range state.features {
+		features[key] = &catalogFeatureRef{featureType: f.Type, feature: f}
+	}
+	return &catalogPlanner{
+		svc:      svc,
+		state:    state,
+		features: features,
+	}
+}
+
+func (p *catalogPlanner) add(action types.CatalogChangeAction, entityType types.CatalogEntityType, key, id string, fields []string, apply func(ctx context.Context) (string, error)) *catalogChange {
+	change := &catalogChange{
+		CatalogChange: dto.CatalogChange{
+			Action:     action,
+			EntityType: entityType,
+			Key:        key,
+			ID:         id,
+			Fields:     fields,
+		},
+		apply: apply,
+	}
+	p.changes = append(p.changes, change)
+	return change
+}
+
+func (p *catalogPlanner) conflict(entityType types.CatalogEntityType, key, id string, fields []string, reason string) {
+	change := p.add(types.CatalogChangeActionConflict, entityType, key, id, fields, nil)
+	change.Reason = reason
+}
+
+func (p *catalogPlanner) result() ([]dto.CatalogChange, map[types.CatalogChangeAction]int) {
+	changes := make([]dto.CatalogChange, 0, len(p.changes))
+	summary := make(map[types.CatalogChangeAction]int)
+	for _, c := range p.changes {
+		summary[c.Action]++
+		if c.Action == types.CatalogChangeActionUnchanged {
+			continue
+		}
+		changes = append(changes, c.CatalogChange)
+	}
+	return changes, summary
+}
+
+func (p *catalogPlanner) plan(doc dto.CatalogDocument) error {
+	for _, f := range doc.Features {
+		p.planFeature(f)
+	}
+	for _, cp := range doc.Plans {
+		if err := p.planPlan(cp); err != nil {
+			return err
+		}
+	}
+	for _, ca := range doc.Addons {
+		if err := p.planAddon(ca); err != nil {
+			return err
+		}
+	}
+	for _, cc := range doc.Coupons {
+		p.planCoupon(cc)
+	}
+	return nil
+}
+
+func (p *catalogPlanner) planFeature(desired dto.CatalogFeature) {
+	existing, ok := p.state.features[desired.LookupKey]
+	if !ok {
+		ref := &catalogFeatureRef{featureType: desired.Type}
+		ref.change = p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypeFeature, desired.LookupKey, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewFeatureService(p.svc.ServiceParams).CreateFeature(ctx, desired.ToCreateFeatureRequest())
+				if err != nil {
+					return "", err
+				}
+				ref.meterID = resp.MeterID
+				return resp.ID, nil
+			})
+		p.features[desired.LookupKey] = ref
+		return
+	}
+
+	current := p.state.catalogFeature(existing)
+	desiredMeter, currentMeter := desired.Meter, current.Meter
+	desired.Meter, current.Meter = nil, nil
+	fields := catalogDiff(current, desired)
+
+	if lo.Contains(fields, "type") {
+		p.conflict(types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, fields, "type of a feature cannot be changed")
+		return
+	}
+	if desired.AlertSettings == nil && current.AlertSettings != nil {
+		p.conflict(types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, []string{"alert_settings"}, "alert settings of a feature cannot be removed")
+		return
+	}
+
+	var filters *[]meter.Filter
+	if desiredMeter != nil && currentMeter != nil {
+		dm, cm := *desiredMeter, *currentMeter
+		desiredFilters, currentFilters := dm.Filters, cm.Filters
+		dm.Filters, cm.Filters = nil, nil
+		if meterFields := catalogDiff(cm, dm); len(meterFields) > 0 {
+			p.conflict(types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, prefixFields("meter", meterFields), "meter of a feature cannot be changed")
+			return
+		}
+
+		added, removed := diffMeterFilters(currentFilters, desiredFilters)
+		if removed {
+			p.conflict(types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, []string{"meter.filters"}, "filter values of a meter cannot be removed")
+			return
+		}
+		if added {
+			fields = append(fields, "meter.filters")
+			filters = &desiredFilters
+		}
+	}
+
+	if len(fields) == 0 {
+		p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, nil, nil)
+		return
+	}
+
+	p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypeFeature, desired.LookupKey, existing.ID, fields,
+		func(ctx context.Context) (string, error) {
+			metadata := lo.Ternary(desired.Metadata != nil, desired.Metadata, types.Metadata{})
+			_, err := NewFeatureService(p.svc.ServiceParams).UpdateFeature(ctx, existing.ID, dto.UpdateFeatureRequest{
+				Name:          lo.ToPtr(desired.Name),
+				Description:   lo.ToPtr(desired.Description),
+				Metadata:      &metadata,
+				UnitSingular:  lo.ToPtr(desired.UnitSingular),
+				UnitPlural:    lo.ToPtr(desired.UnitPlural),
+				Filters:       filters,
+				AlertSettings: desired.AlertSettings,
+			})
+			return existing.ID, err
+		})
+}
+
+func (p *catalogPlanner) planPlan(desired dto.CatalogPlan) error {
+	existing, ok := p.state.plans[desired.LookupKey]
+
+	var change *catalogChange
+	if !ok {
+		change = p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypePlan, desired.LookupKey, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewPlanService(p.svc.ServiceParams).CreatePlan(ctx, desired.ToCreatePlanRequest())
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+	} else {
+		current := dto.NewCatalogPlan(existing)
+		header := desired
+		header.Prices, header.Entitlements, header.CreditGrants = nil, nil, nil
+		if header.DisplayOrder == nil {
+			header.DisplayOrder = current.DisplayOrder
+		}
+
+		if fields := catalogDiff(current, header); len(fields) > 0 {
+			change = p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypePlan, desired.LookupKey, existing.ID, fields,
+				func(ctx context.Context) (string, error) {
+					_, err := NewPlanService(p.svc.ServiceParams).UpdatePlan(ctx, existing.ID, dto.UpdatePlanRequest{
+						Name:         lo.ToPtr(header.Name),
+						Description:  lo.ToPtr(header.Description),
+						DisplayOrder: header.DisplayOrder,
+						Metadata:     lo.Ternary(header.Metadata != nil, header.Metadata, types.Metadata{}),
+					})
+					return existing.ID, err
+				})
+		} else {
+			change = p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypePlan, desired.LookupKey, existing.ID, nil, nil)
+		}
+	}
+
+	for _, cp := range desired.Prices {
+		if err := p.planPrice(types.PRICE_ENTITY_TYPE_PLAN, desired.LookupKey, change, cp); err != nil {
+			return err
+		}
+	}
+	for _, ce := range desired.Entitlements {
+		if err := p.planEntitlement(types.ENTITLEMENT_ENTITY_TYPE_PLAN, desired.LookupKey, change, ce); err != nil {
+			return err
+		}
+	}
+	for _, cg := range desired.CreditGrants {
+		p.planCreditGrant(desired.LookupKey, change, cg)
+	}
+	return nil
+}
+
+func (p *catalogPlanner) planAddon(desired dto.CatalogAddon) error {
+	existing, ok := p.state.addons[desired.LookupKey]
+
+	var change *catalogChange
+	if !ok {
+		change = p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypeAddon, desired.LookupKey, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewAddonService(p.svc.ServiceParams).CreateAddon(ctx, desired.ToCreateAddonRequest())
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+	} else {
+		current := dto.NewCatalogAddon(existing)
+		header := desired
+		header.Prices, header.Entitlements = nil, nil
+
+		fields := catalogDiff(current, header)
+		switch {
+		case lo.Contains(fields, "type"):
+			change = p.add(types.CatalogChangeActionConflict, types.CatalogEntityTypeAddon, desired.LookupKey, existing.ID, fields, nil)
+			change.Reason = "type of an addon cannot be changed"
+		case len(fields) > 0:
+			change = p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypeAddon, desired.LookupKey, existing.ID, fields,
+				func(ctx context.Context) (string, error) {
+					_, err := NewAddonService(p.svc.ServiceParams).UpdateAddon(ctx, existing.ID, dto.UpdateAddonRequest{
+						Name:        lo.ToPtr(header.Name),
+						Description: lo.ToPtr(header.Description),
+						Metadata:    lo.Ternary(header.Metadata != nil, header.Metadata, map[string]interface{}{}),
+					})
+					return existing.ID, err
+				})
+		default:
+			change = p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypeAddon, desired.LookupKey, existing.ID, nil, nil)
+		}
+	}
+
+	for _, cp := range desired.Prices {
+		if err := p.planPrice(types.PRICE_ENTITY_TYPE_ADDON, desired.LookupKey, change, cp); err != nil {
+			return err
+		}
+	}
+	for _, ce := range desired.Entitlements {
+		if err := p.planEntitlement(types.ENTITLEMENT_ENTITY_TYPE_ADDON, desired.LookupKey, change, ce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// catalogPriceSimpleFields are the fields of a price that are updated in place
+var catalogPriceSimpleFields = []string{"display_name", "description", "metadata"}
+
+// catalogPriceCriticalFields are the fields of a price that are changed by terminating the
+// price and creating a new version of it, in line with price updates through the API
+var catalogPriceCriticalFields = []string{"amount", "billing_model", "tier_mode", "tiers", "transform_quantity", "price_unit_config", "included_quantity"}
+
+func (p *catalogPlanner) planPrice(entityType types.PriceEntityType, parentKey string, parent *catalogChange, desired dto.CatalogPrice) error {
+	desired = desired.Normalize()
+
+	var featureRef *catalogFeatureRef
+	if desired.FeatureLookupKey != "" {
+		ref, ok := p.features[desired.FeatureLookupKey]
+		if !ok {
+			return ierr.NewErrorf("feature %s of price %s not found", desired.FeatureLookupKey, desired.LookupKey).
+				WithHint("Usage prices must reference a feature of the document or of the environment").
+				WithReportableDetails(map[string]interface{}{
+					"price":   desired.LookupKey,
+					"feature": desired.FeatureLookupKey,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		if ref.featureType != types.FeatureTypeMetered {
+			return ierr.NewErrorf("feature %s of price %s is not metered", desired.FeatureLookupKey, desired.LookupKey).
+				WithHint("Usage prices must reference a metered feature").
+				WithReportableDetails(map[string]interface{}{
+					"price":   desired.LookupKey,
+					"feature": desired.FeatureLookupKey,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		featureRef = ref
+	}
+
+	createRequest := func() dto.CreatePriceRequest {
+		meterID := ""
+		if featureRef != nil {
+			meterID = featureRef.meter()
+		}
+		return desired.ToCreatePriceRequest(entityType, parent.ID, meterID)
+	}
+
+	existing, ok := p.state.prices[desired.LookupKey]
+	if !ok {
+		p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypePrice, desired.LookupKey, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewPriceService(p.svc.ServiceParams).CreatePrice(ctx, createRequest())
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+		return nil
+	}
+
+	if parent.ID == "" || existing.EntityID != parent.ID {
+		p.conflict(types.CatalogEntityTypePrice, desired.LookupKey, existing.ID, []string{"entity_id"},
+			"price belongs to a different plan or addon than "+parentKey)
+		return nil
+	}
+
+	fields := catalogDiff(p.state.catalogPrice(existing), desired)
+	if len(fields) == 0 {
+		p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypePrice, desired.LookupKey, existing.ID, nil, nil)
+		return nil
+	}
+
+	immutable := lo.Without(fields, append(catalogPriceSimpleFields, catalogPriceCriticalFields...)...)
+	if existing.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM && desired.PriceUnitConfig != nil &&
+		lo.FromPtr(existing.PriceUnit) != desired.PriceUnitConfig.PriceUnit {
+		immutable = append(immutable, "price_unit_config.price_unit")
+	}
+	if len(immutable) > 0 {
+		p.conflict(types.CatalogEntityTypePrice, desired.LookupKey, existing.ID, fields,
+			"fields of a price other than its amounts and tiers cannot be changed, use a new lookup key instead")
+		return nil
+	}
+
+	if len(lo.Intersect(fields, catalogPriceCriticalFields)) == 0 {
+		p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypePrice, desired.LookupKey, existing.ID, fields,
+			func(ctx context.Context) (string, error) {
+				existing.DisplayName = desired.DisplayName
+				existing.Description = desired.Description
+				existing.Metadata = price.JSONBMetadata(desired.Metadata)
+				return existing.ID, p.svc.PriceRepo.Update(ctx, existing)
+			})
+		return nil
+	}
+
+	p.add(types.CatalogChangeActionReplace, types.CatalogEntityTypePrice, desired.LookupKey, existing.ID, fields,
+		func(ctx context.Context) (string, error) {
+			// The lookup key moves to the new version of the price, it is unique among published prices
+			terminatedAt := time.Now().UTC()
+			existing.EndDate = &terminatedAt
+			existing.LookupKey = ""
+			if err := p.svc.PriceRepo.Update(ctx, existing); err != nil {
+				return "", err
+			}
+
+			req := createRequest()
+			req.StartDate = &terminatedAt
+			req.ParentPriceID = existing.GetRootPriceID()
+			req.GroupID = existing.GroupID
+			resp, err := NewPriceService(p.svc.ServiceParams).CreatePrice(ctx, req)
+			if err != nil {
+				return "", err
+			}
+			return resp.ID, nil
+		})
+	return nil
+}
+
+func (p *catalogPlanner) planEntitlement(entityType types.EntitlementEntityType, parentKey string, parent *catalogChange, desired dto.CatalogEntitlement) error {
+	key := dto.CatalogChildKey(parentKey, desired.FeatureLookupKey)
+
+	ref, ok := p.features[desired.FeatureLookupKey]
+	if !ok {
+		return ierr.NewErrorf("feature %s of entitlement %s not found", desired.FeatureLookupKey, key).
+			WithHint("Entitlements must reference a feature of the document or of the environment").
+			WithReportableDetails(map[string]interface{}{
+				"entitlement": key,
+				"feature":     desired.FeatureLookupKey,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	var existing *entitlement.Entitlement
+	if ref.feature != nil && parent.ID != "" {
+		existing, _ = lo.Find(p.state.entitlements[parent.ID], func(e *entitlement.Entitlement) bool {
+			return e.FeatureID == ref.feature.ID
+		})
+	}
+
+	if existing == nil {
+		p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypeEntitlement, key, "", nil,
+			func(ctx context.Context) (string, error) {
+				req := desired.ToCreateEntitlementRequest(entityType, parent.ID, ref.id(), ref.featureType)
+				resp, err := NewEntitlementService(p.svc.ServiceParams).CreateEntitlement(ctx, req)
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+		return nil
+	}
+
+	fields := catalogDiff(dto.NewCatalogEntitlement(existing, desired.FeatureLookupKey), desired)
+	if len(fields) == 0 {
+		p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypeEntitlement, key, existing.ID, nil, nil)
+		return nil
+	}
+
+	p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypeEntitlement, key, existing.ID, fields,
+		func(ctx context.Context) (string, error) {
+			_, err := NewEntitlementService(p.svc.ServiceParams).UpdateEntitlement(ctx, existing.ID, desired.ToUpdateEntitlementRequest())
+			return existing.ID, err
+		})
+	return nil
+}
+
+func (p *catalogPlanner) planCreditGrant(parentKey string, parent *catalogChange, desired dto.CatalogCreditGrant) {
+	key := dto.CatalogChildKey(parentKey, desired.Name)
+
+	var existing *creditgrant.CreditGrant
+	if parent.ID != "" {
+		existing, _ = lo.Find(p.state.creditGrants[parent.ID], func(g *creditgrant.CreditGrant) bool {
+			return g.Name == desired.Name
+		})
+	}
+
+	if existing == nil {
+		p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypeCreditGrant, key, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewCreditGrantService(p.svc.ServiceParams).CreateCreditGrant(ctx, desired.ToCreateCreditGrantRequest(parent.ID))
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+		return
+	}
+
+	fields := catalogDiff(dto.NewCatalogCreditGrant(existing), desired)
+	switch {
+	case len(fields) == 0:
+		p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypeCreditGrant, key, existing.ID, nil, nil)
+	case len(lo.Without(fields, "metadata")) > 0:
+		p.conflict(types.CatalogEntityTypeCreditGrant, key, existing.ID, fields,
+			"only the metadata of a credit grant can be changed, use a new name instead")
+	default:
+		p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypeCreditGrant, key, existing.ID, fields,
+			func(ctx context.Context) (string, error) {
+				metadata := lo.Ternary(desired.Metadata != nil, desired.Metadata, types.Metadata{})
+				_, err := NewCreditGrantService(p.svc.ServiceParams).UpdateCreditGrant(ctx, existing.ID, dto.UpdateCreditGrantRequest{
+					Metadata: &metadata,
+				})
+				return existing.ID, err
+			})
+	}
+}
+
+func (p *catalogPlanner) planCoupon(desired dto.CatalogCoupon) {
+	if p.state.duplicateCoupons[desired.Name] {
+		p.conflict(types.CatalogEntityTypeCoupon, desired.Name, "", nil, "multiple coupons share this name")
+		return
+	}
+
+	existing, ok := p.state.coupons[desired.Name]
+	if !ok {
+		p.add(types.CatalogChangeActionCreate, types.CatalogEntityTypeCoupon, desired.Name, "", nil,
+			func(ctx context.Context) (string, error) {
+				resp, err := NewCouponService(p.svc.ServiceParams).CreateCoupon(ctx, desired.ToCreateCouponRequest())
+				if err != nil {
+					return "", err
+				}
+				return resp.ID, nil
+			})
+		return
+	}
+
+	fields := catalogDiff(dto.NewCatalogCoupon(existing), desired)
+	switch {
+	case len(fields) == 0:
+		p.add(types.CatalogChangeActionUnchanged, types.CatalogEntityTypeCoupon, desired.Name, existing.ID, nil, nil)
+	case len(lo.Without(fields, "metadata")) > 0:
+		p.conflict(types.CatalogEntityTypeCoupon, desired.Name, existing.ID, fields,
+			"only the metadata of a coupon can be changed, use a new name instead")
+	default:
+		p.add(types.CatalogChangeActionUpdate, types.CatalogEntityTypeCoupon, desired.Name, existing.ID, fields,
+			func(ctx context.Context) (string, error) {
+				metadata := lo.Ternary(desired.Metadata != nil, desired.Metadata, map[string]string{})
+				_, err := NewCouponService(p.svc.ServiceParams).UpdateCoupon(ctx, existing.ID, dto.UpdateCouponRequest{
+					Metadata: &metadata,
+				})
+				return existing.ID, err
+			})
+	}
+}
+
+// catalogDiff returns the sorted json fields whose values differ between two catalog entities
+func catalogDiff(current, desired interface{}) []string {
+	currentFields, desiredFields := toCatalogFields(current), toCatalogFields(desired)
+
+	var fields []string
+	for field, value := range desiredFields {
+		if !reflect.DeepEqual(currentFields[field], value) {
+			fields = append(fields, field)
+		}
+	}
+	for field := range currentFields {
+		if _, ok := desiredFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func toCatalogFields(v interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// diffMeterFilters reports whether the desired filters add values to, or remove values from,
+// the current filters of a meter
+func diffMeterFilters(current, desired []meter.Filter) (added bool, removed bool) {
+	toSet := func(filters []meter.Filter) map[string]map[string]bool {
+		set := make(map[string]map[string]bool, len(filters))
+		for _, f := range filters {
+			if set[f.Key] == nil {
+				set[f.Key] = make(map[string]bool, len(f.Values))
+			}
+			for _, v := range f.Values {
+				set[f.Key][v] = true
+			}
+		}
+		return set
+	}
+
+	currentSet, desiredSet := toSet(current), toSet(desired)
+	for key, values := range currentSet {
+		for v := range values {
+			if !desiredSet[key][v] {
+				removed = true
+			}
+		}
+	}
+	for key, values := range desiredSet {
+		if _, ok := currentSet[key]; !ok {
+			added = true
+			continue
+		}
+		for v := range values {
+			if !currentSet[key][v] {
+				added = true
+			}
+		}
+	}
+	return added, removed
+}
+
+func prefixFields(prefix string, fields []string) []string {
+	return lo.Map(fields, func(f string, _ int) string {
+		return prefix + "." + f
+	})
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogDiff(t *testing.T) {
+	current := dto.CatalogPrice{
+		LookupKey: "pro_monthly",
+		Amount:    lo.ToPtr(decimal.RequireFromString("10")),
+		Currency:  "usd",
+		Metadata:  map[string]string{"tier": "pro"},
+	}
+
+	// Equal decimals with a different scale and empty maps do not differ
+	desired := current
+	desired.Amount = lo.ToPtr(decimal.RequireFromString("10.00"))
+	assert.Empty(t, catalogDiff(current, desired))
+
+	desired.Amount = lo.ToPtr(decimal.RequireFromString("12"))
+	desired.Currency = "eur"
+	desired.Metadata = nil
+	assert.Equal(t, []string{"amount", "currency", "metadata"}, catalogDiff(current, desired))
+}
+
+func TestDiffMeterFilters(t *testing.T) {
+	current := []meter.Filter{{Key: "model", Values: []string{"gpt-4o", "o1"}}}
+
+	added, removed := diffMeterFilters(current, []meter.Filter{{Key: "model", Values: []string{"o1", "gpt-4o"}}})
+	assert.False(t, added)
+	assert.False(t, removed)
+
+	added, removed = diffMeterFilters(current, []meter.Filter{
+		{Key: "model", Values: []string{"gpt-4o", "o1", "o3"}},
+		{Key: "region", Values: []string{"us"}},
+	})
+	assert.True(t, added)
+	assert.False(t, removed)
+
+	_, removed = diffMeterFilters(current, []meter.Filter{{Key: "model", Values: []string{"o1"}}})
+	assert.True(t, removed)
+}
+
+func TestCatalogPlanner(t *testing.T) {
+	apiCalls := &feature.Feature{ID: "feat_1", LookupKey: "api_calls", Name: "API Calls", Type: types.FeatureTypeMetered, MeterID: "meter_1"}
+	apiMeter := &meter.Meter{
+		ID:          "meter_1",
+		Name:        "API Calls",
+		EventName:   "api_call",
+		Aggregation: meter.Aggregation{Type: types.AggregationCount},
+		ResetUsage:  types.ResetUsageBillingPeriod,
+	}
+	pro := &plan.Plan{ID: "plan_1", LookupKey: "pro", Name: "Pro", DisplayOrder: lo.ToPtr(0)}
+	basePrice := func(id, lookupKey string) *price.Price {
+		return &price.Price{
+			ID:                 id,
+			LookupKey:          lookupKey,
+			Amount:             decimal.NewFromInt(10),
+			Currency:           "usd",
+			PriceUnitType:      types.PRICE_UNIT_TYPE_FIAT,
+			Type:               types.PRICE_TYPE_FIXED,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			InvoiceCadence:     types.InvoiceCadenceAdvance,
+			EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+			EntityID:           pro.ID,
+		}
+	}
+	unchangedPrice := basePrice("price_1", "pro_unchanged")
+	renamedPrice := basePrice("price_2", "pro_renamed")
+	repricedPrice := basePrice("price_3", "pro_repriced")
+	recurrencePrice := basePrice("price_4", "pro_recurrence")
+
+	state := &catalogState{
+		features:          map[string]*feature.Feature{"api_calls": apiCalls},
+		featuresByID:      map[string]*feature.Feature{apiCalls.ID: apiCalls},
+		featuresByMeterID: map[string]*feature.Feature{apiMeter.ID: apiCalls},
+		meters:            map[string]*meter.Meter{apiMeter.ID: apiMeter},
+		plans:             map[string]*plan.Plan{"pro": pro},
+		prices: map[string]*price.Price{
+			unchangedPrice.LookupKey:  unchangedPrice,
+			renamedPrice.LookupKey:    renamedPrice,
+			repricedPrice.LookupKey:   repricedPrice,
+			recurrencePrice.LookupKey: recurrencePrice,
+		},
+	}
+
+	desiredPrice := func(p *price.Price) dto.CatalogPrice {
+		return dto.NewCatalogPrice(p, "")
+	}
+	renamed := desiredPrice(renamedPrice)
+	renamed.DisplayName = "Pro monthly"
+	repriced := desiredPrice(repricedPrice)
+	repriced.Amount = lo.ToPtr(decimal.NewFromInt(20))
+	recurrence := desiredPrice(recurrencePrice)
+	recurrence.BillingPeriod = types.BILLING_PERIOD_ANNUAL
+
+	doc := dto.CatalogDocument{
+		Features: []dto.CatalogFeature{
+			dto.NewCatalogFeature(apiCalls, apiMeter),
+			{LookupKey: "seats", Name: "Seats", Type: types.FeatureTypeStatic},
+		},
+		Plans: []dto.CatalogPlan{
+			{
+				LookupKey: "pro",
+				Name:      "Pro",
+				Prices: []dto.CatalogPrice{
+					desiredPrice(unchangedPrice),
+					renamed,
+					repriced,
+					recurrence,
+					{
+						LookupKey:        "pro_usage",
+						Type:             types.PRICE_TYPE_USAGE,
+						Currency:         "usd",
+						Amount:           lo.ToPtr(decimal.RequireFromString("0.01")),
+						BillingPeriod:    types.BILLING_PERIOD_MONTHLY,
+						BillingModel:     types.BILLING_MODEL_FLAT_FEE,
+						BillingCadence:   types.BILLING_CADENCE_RECURRING,
+						InvoiceCadence:   types.InvoiceCadenceArrear,
+						FeatureLookupKey: "api_calls",
+					},
+				},
+				Entitlements: []dto.CatalogEntitlement{
+					{FeatureLookupKey: "seats", IsEnabled: true, StaticValue: "5"},
+				},
+			},
+			{LookupKey: "enterprise", Name: "Enterprise"},
+		},
+	}
+	require.NoError(t, doc.Validate())
+
+	planner := newCatalogPlanner(nil, state)
+	require.NoError(t, planner.plan(doc))
+
+	changes, summary := planner.result()
+	actions := lo.Map(changes, func(c dto.CatalogChange, _ int) string {
+		return string(c.EntityType) + ":" + c.Key + ":" + string(c.Action)
+	})
+	assert.Equal(t, []string{
+		"feature:seats:create",
+		"price:pro_renamed:update",
+		"price:pro_repriced:replace",
+		"price:pro_recurrence:conflict",
+		"price:pro_usage:create",
+		"entitlement:pro/seats:create",
+		"plan:enterprise:create",
+	}, actions)
+
+	assert.Equal(t, 3, summary[types.CatalogChangeActionUnchanged])
+	assert.Equal(t, []string{"display_name"}, changes[1].Fields)
+	assert.Equal(t, []string{"amount"}, changes[2].Fields)
+	assert.Equal(t, []string{"billing_period"}, changes[3].Fields)
+}
+
+func TestCatalogPlannerUnknownFeature(t *testing.T) {
+	state := &catalogState{
+		features: map[string]*feature.Feature{},
+		plans:    map[string]*plan.Plan{},
+		prices:   map[string]*price.Price{},
+	}
+
+	doc := dto.CatalogDocument{
+		Plans: []dto.CatalogPlan{
+			{
+				LookupKey:    "pro",
+				Name:         "Pro",
+				Entitlements: []dto.CatalogEntitlement{{FeatureLookupKey: "missing", IsEnabled: true}},
+			},
+		},
+	}
+
+	planner := newCatalogPlanner(nil, state)
+	assert.Error(t, planner.plan(doc))
+}
@@ -307,7 +307,7 @@ func (s *costsheetUsageTrackingService) prepareProcessedEvents(ctx context.Conte
 	results := make([]*events.CostUsage, 0)
 
 	// CASE 1: Get active costsheet (with caching)
-	costSheet, err := s.getActiveCostsheetWithCache(ctx)
+	costSheet, err := getActiveCostsheetWithCache(ctx, s.ServiceParams)
 	if err != nil {
 		if ierr.IsNotFound(err) {
 			s.Logger.Debugw("no active costsheet found for event, skipping",
@@ -938,7 +938,7 @@ func (s *costsheetUsageTrackingService) GetCostSheetUsageAnalytics(ctx context.C
 	}
 
 	// STEP1: Get active costsheet (with caching)
-	costSheet, err := s.getActiveCostsheetWithCache(ctx)
+	costSheet, err := getActiveCostsheetWithCache(ctx, s.ServiceParams)
 	if err != nil {
 		s.Logger.Errorw("failed to fetch active costsheet", "error", err)
 		return nil, err
@@ -1372,7 +1372,7 @@ func (s *costsheetUsageTrackingService) getCorrectUsageValueForPoint(point event
 
 // getActiveCostsheetWithCache fetches the active costsheet for the tenant with caching
 // It first checks the cache, and if not found, fetches from the service and caches the result
-func (s *costsheetUsageTrackingService) getActiveCostsheetWithCache(ctx context.Context) (*dto.CostsheetResponse, error) {
+func getActiveCostsheetWithCache(ctx context.Context, params ServiceParams) (*dto.CostsheetResponse, error) {
 	tenantID := types.GetTenantID(ctx)
 	environmentID := types.GetEnvironmentID(ctx)
 	cacheKey := cache.GenerateKey(cache.PrefixCostsheet, tenantID, environmentID)
@@ -1382,14 +1382,14 @@ func (s *costsheetUsageTrackingService) getActiveCostsheetWithCache(ctx context.
 	var costSheet *dto.CostsheetResponse
 	if cached, found := cacheClient.ForceCacheGet(ctx, cacheKey); found {
 		if cachedCostSheet, ok := cached.(*dto.CostsheetResponse); ok {
-			s.Logger.Debugw("costsheet cache hit", "tenant_id", tenantID, "environment_id", environmentID)
+			params.Logger.Debugw("costsheet cache hit", "tenant_id", tenantID, "environment_id", environmentID)
 			costSheet = cachedCostSheet
 		}
 	}
 
 	// If not in cache, fetch from service
 	if costSheet == nil {
-		costSheetService := NewCostsheetService(s.ServiceParams)
+		costSheetService := NewCostsheetService(params)
 		var err error
 		costSheet, err = costSheetService.GetActiveCostsheetForTenant(ctx)
 		if err != nil {
@@ -1398,7 +1398,7 @@ func (s *costsheetUsageTrackingService) getActiveCostsheetWithCache(ctx context.
 
 		// Cache the result for 5 minutes
 		cacheClient.ForceCacheSet(ctx, cacheKey, costSheet, 5*time.Minute)
-		s.Logger.Debugw("costsheet cached", "tenant_id", tenantID, "environment_id", environmentID)
+		params.Logger.Debugw("costsheet cached", "tenant_id", tenantID, "environment_id", environmentID)
 	}
 
 	return costSheet, nil
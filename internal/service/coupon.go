@@ -153,7 +153,7 @@ func (s *couponService) ApplyDiscount(ctx context.Context, req dto.ApplyDiscount
 			Mark(ierr.ErrValidation)
 	}
 
-	result := c.ApplyDiscount(req.OriginalPrice, req.Currency)
+	result := c.ApplyDiscountWithRounding(req.OriginalPrice, req.Currency, GetRoundingConfig(s.ServiceParams, ctx))
 	return &dto.DiscountResult{
 		Discount:   result.Discount,
 		FinalPrice: result.FinalPrice,
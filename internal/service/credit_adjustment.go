@@ -50,6 +50,7 @@ func NewCreditAdjustmentService(
 //   - Figure out how much we can apply (can't exceed what's in the pool or what's needed)
 //   - Take money from wallets one by one until we've covered it
 //   - Round everything to the right currency precision (2 decimals for USD, 0 for JPY, etc.)
+//     with the rounding mode of the environment
 //   - Update the line item with how much was applied
 //   - Subtract what we used from the pool
 //
@@ -62,7 +63,7 @@ func NewCreditAdjustmentService(
 //
 // NOTE: This is exported for testing only. In production, use ApplyCreditsToInvoice() which
 // handles the full workflow including database operations.
-func (s *creditAdjustmentService) CalculateCreditAdjustments(inv *invoice.Invoice, wallets []*wallet.Wallet) (map[string]decimal.Decimal, error) {
+func (s *creditAdjustmentService) CalculateCreditAdjustments(ctx context.Context, inv *invoice.Invoice, wallets []*wallet.Wallet) (map[string]decimal.Decimal, error) {
 	amountsToDebitFromWallets := make(map[string]decimal.Decimal)
 
 	// Nothing to do if there are no wallets
@@ -89,6 +90,7 @@ func (s *creditAdjustmentService) CalculateCreditAdjustments(inv *invoice.Invoic
 
 	// We'll consume wallets in order (first wallet first, then second, etc.)
 	currentWalletIdx := 0
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
 
 	// Go through each line item and apply amounts
 	for _, lineItem := range inv.LineItems {
@@ -128,7 +130,7 @@ func (s *creditAdjustmentService) CalculateCreditAdjustments(inv *invoice.Invoic
 
 			// Take as much as we can from this wallet (either all of it or what we need, whichever is less)
 			rawAmount := decimal.Min(currentWalletBalance, amountStillNeeded)
-			roundedAmountFromWallet := decimal.Min(types.RoundToCurrencyPrecision(rawAmount, inv.Currency), rawAmount)
+			roundedAmountFromWallet := decimal.Min(rounding.RoundAmount(rawAmount, inv.Currency), rawAmount)
 
 			// Avoid hang when raw amount is positive but rounds to zero (e.g. 0.001 in USD)
 			if roundedAmountFromWallet.IsZero() && rawAmount.GreaterThan(decimal.Zero) && currentWalletBalance.GreaterThan(decimal.Zero) {
@@ -235,7 +237,7 @@ func (s *creditAdjustmentService) ApplyCreditsToInvoice(ctx context.Context, inv
 	// - Determines how much credit to apply from each wallet
 	// - Directly modifies lineItem.PrepaidCreditsApplied in memory (NOT persisted yet)
 	// - Returns a map of wallet debits (walletID -> total amount to debit)
-	amountsToDebitFromWallets, err := s.CalculateCreditAdjustments(inv, wallets)
+	amountsToDebitFromWallets, err := s.CalculateCreditAdjustments(ctx, inv, wallets)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +253,7 @@ func (s *CreditAdjustmentServiceSuite) TestCalculateCreditAdjustments_DustBalanc
 		s.createWalletForCalculation("wallet_dust", "USD", decimal.RequireFromString("0.001")),
 	}
 
-	debits, err := svc.CalculateCreditAdjustments(inv, wallets)
+	debits, err := svc.CalculateCreditAdjustments(s.GetContext(), inv, wallets)
 	s.Require().NoError(err)
 
 	// Dust is skipped (not debited); no amount applied to line item
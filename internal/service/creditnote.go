@@ -64,17 +64,26 @@ func (s *creditNoteService) CreateCreditNote(ctx context.Context, req *dto.Creat
 			"reason", req.Reason,
 			"line_items_count", len(req.LineItems))
 
-		// Validate credit note creation rules
-		if err := s.ValidateCreditNoteCreation(tx, req); err != nil {
-			return err
-		}
-
 		// Get invoice with line items
 		inv, err := s.InvoiceRepo.Get(tx, req.InvoiceID)
 		if err != nil {
 			return err
 		}
 
+		// Credited amounts are rounded to the currency precision with the rounding mode of the
+		// environment before they are validated against the invoice
+		rounding := GetRoundingConfig(s.ServiceParams, tx)
+		for i := range req.LineItems {
+			if rounded := rounding.RoundAmount(req.LineItems[i].Amount, inv.Currency); !rounded.Equal(req.LineItems[i].Amount) {
+				req.LineItems[i].Amount = rounded
+			}
+		}
+
+		// Validate credit note creation rules
+		if err := s.ValidateCreditNoteCreation(tx, req); err != nil {
+			return err
+		}
+
 		// Determine credit note type based on invoice payment status
 		creditNoteType, err := s.getCreditNoteType(inv)
 		if err != nil {
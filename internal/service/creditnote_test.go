@@ -426,6 +426,7 @@ func (s *CreditNoteServiceSuite) createTestWallets() {
 		Config:                   s.GetConfig(),
 		DB:                       s.GetDB(),
 		WalletRepo:               s.GetStores().WalletRepo,
+		SubRepo:                  s.GetStores().SubscriptionRepo,
 		SettingsRepo:             s.GetStores().SettingsRepo,
 		FeatureUsageRepo:         s.GetStores().FeatureUsageRepo,
 		AlertLogsRepo:            s.GetStores().AlertLogsRepo,
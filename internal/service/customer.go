@@ -421,6 +421,10 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id string, req dto
 		cust.Metadata = req.Metadata
 	}
 
+	if req.ConsolidateInvoices != nil {
+		cust.ConsolidateInvoices = req.ConsolidateInvoices
+	}
+
 	// Handle integration entity mappings if provided
 	if len(req.IntegrationEntityMapping) > 0 {
 		entityMappingService := NewEntityIntegrationMappingService(s.ServiceParams)
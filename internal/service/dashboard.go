@@ -8,11 +8,13 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
 )
 
 // DashboardService provides dashboard functionality
 type DashboardService interface {
 	GetRevenues(ctx context.Context, req dto.DashboardRevenuesRequest) (*dto.DashboardRevenuesResponse, error)
+	GetExperimentReport(ctx context.Context, experimentID string) (*dto.ExperimentReportResponse, error)
 }
 
 type dashboardService struct {
@@ -162,3 +164,98 @@ func (s *dashboardService) getInvoicePaymentStatus(ctx context.Context) (*dto.In
 		PeriodEnd:   now,
 	}, nil
 }
+
+// GetExperimentReport joins the assignments of a price experiment with the conversions and the
+// revenue of the subscriptions created through it
+func (s *dashboardService) GetExperimentReport(ctx context.Context, experimentID string) (*dto.ExperimentReportResponse, error) {
+	e, err := s.ExperimentRepo.Get(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.ExperimentAssignmentRepo.GetVariantStats(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revenues, err := s.ExperimentAssignmentRepo.GetVariantRevenue(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildExperimentReport(e.ID, e.Name, e.ExperimentStatus, e.Variants, stats, revenues)
+}
+
+// buildExperimentReport combines the variants of an experiment with their stats and revenue.
+// Variants without assignments are reported with zero values.
+func buildExperimentReport(
+	experimentID string,
+	name string,
+	status types.ExperimentStatus,
+	variants []types.ExperimentVariant,
+	stats []types.ExperimentVariantStats,
+	revenues []types.ExperimentVariantRevenue,
+) (*dto.ExperimentReportResponse, error) {
+	reports := make([]dto.ExperimentVariantReport, 0, len(variants))
+	indexes := make(map[string]int, len(variants))
+	for _, v := range variants {
+		indexes[v.Key] = len(reports)
+		reports = append(reports, dto.ExperimentVariantReport{
+			Key:                  v.Key,
+			PlanID:               v.PlanID,
+			Weight:               v.Weight,
+			ConversionRate:       decimal.Zero,
+			Revenue:              make(map[string]decimal.Decimal),
+			RevenuePerAssignment: make(map[string]decimal.Decimal),
+		})
+	}
+
+	for _, st := range stats {
+		i, ok := indexes[st.VariantKey]
+		if !ok {
+			continue
+		}
+		reports[i].Assignments = st.Assignments
+		reports[i].Conversions = st.Conversions
+		if st.Assignments > 0 {
+			reports[i].ConversionRate = decimal.NewFromInt(int64(st.Conversions)).
+				Div(decimal.NewFromInt(int64(st.Assignments))).
+				Round(4)
+		}
+	}
+
+	for _, r := range revenues {
+		i, ok := indexes[r.VariantKey]
+		if !ok {
+			continue
+		}
+		revenue, err := decimal.NewFromString(r.Revenue)
+		if err != nil {
+			return nil, ierr.WithError(err).
+				WithHint("Failed to parse experiment revenue").
+				Mark(ierr.ErrInternal)
+		}
+		currency := strings.ToLower(strings.TrimSpace(r.Currency))
+		reports[i].Revenue[currency] = reports[i].Revenue[currency].Add(revenue)
+	}
+
+	for i := range reports {
+		if reports[i].Assignments == 0 {
+			continue
+		}
+		for currency, revenue := range reports[i].Revenue {
+			reports[i].RevenuePerAssignment[currency] = types.RoundDecimal(
+				revenue.Div(decimal.NewFromInt(int64(reports[i].Assignments))),
+				types.GetCurrencyPrecision(currency),
+				types.RoundingModeHalfUp,
+			)
+		}
+	}
+
+	return &dto.ExperimentReportResponse{
+		ExperimentID:     experimentID,
+		Name:             name,
+		ExperimentStatus: status,
+		Variants:         reports,
+	}, nil
+}
@@ -0,0 +1,520 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/email"
+	invoiceModels "github.com/flexprice/flexprice/internal/temporal/models/invoice"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.temporal.io/api/serviceerror"
+)
+
+// DunningService recovers failed invoice payments with the dunning policy of the environment.
+// Every failed invoice is dunned by its own Temporal workflow: the subscription is past due while
+// the steps of the policy retry the payment and notify the customer, and the final action of the
+// policy applies when the invoice is still unpaid after the last step. A paid invoice makes the
+// subscription active again at any point.
+type DunningService interface {
+	// TriggerDunning starts the dunning workflow of an invoice whose payment attempt failed. Nothing
+	// happens when the invoice is paid, is not collected automatically or dunning is disabled.
+	TriggerDunning(ctx context.Context, invoiceID string) error
+
+	// StartDunning marks the subscription of the invoice past due and returns the dunning policy
+	// to run, the outcome is skipped when there is nothing to dun
+	StartDunning(ctx context.Context, invoiceID string) (*invoiceModels.StartDunningActivityOutput, error)
+
+	// RunDunningStep runs a step of the dunning policy: the payment is retried and, if the invoice
+	// is still unpaid, the customer is notified
+	RunDunningStep(ctx context.Context, invoiceID string, stepNumber int, step types.DunningStep) (types.DunningOutcome, error)
+
+	// FinishDunning applies the final action of the dunning policy to the subscription of an
+	// invoice still unpaid after the last step
+	FinishDunning(ctx context.Context, invoiceID string, action types.DunningFinalAction) (types.DunningOutcome, error)
+
+	// HandleInvoicePaid makes the past due or unpaid subscription of a paid invoice active again
+	HandleInvoicePaid(ctx context.Context, inv *invoice.Invoice) error
+}
+
+type dunningService struct {
+	ServiceParams
+}
+
+// NewDunningService creates a new dunning service
+func NewDunningService(params ServiceParams) DunningService {
+	return &dunningService{
+		ServiceParams: params,
+	}
+}
+
+// GetDunningConfig returns the dunning policy of the environment in the context. Payment
+// processing must not fail because of a broken setting, so the default policy, which is
+// disabled, is returned when the setting cannot be read.
+func GetDunningConfig(params ServiceParams, ctx context.Context) types.DunningConfig {
+	if params.SettingsRepo == nil {
+		return types.DefaultDunningConfig()
+	}
+
+	settingsSvc := NewSettingsService(params).(*settingsService)
+	config, err := GetSetting[types.DunningConfig](settingsSvc, ctx, types.SettingKeyDunningConfig)
+	if err != nil {
+		params.Logger.Warnw("failed to get dunning config, using default",
+			"error", err,
+			"environment_id", types.GetEnvironmentID(ctx))
+		return types.DefaultDunningConfig()
+	}
+	return config
+}
+
+func (s *dunningService) TriggerDunning(ctx context.Context, invoiceID string) error {
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	subs, err := s.getDunnableSubscriptions(ctx, inv)
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+	subscriptionIDs := lo.Map(subs, func(sub *subscription.Subscription, _ int) string { return sub.ID })
+
+	if !GetDunningConfig(s.ServiceParams, ctx).Enabled {
+		return nil
+	}
+
+	// Invoices synced to Stripe are collected, and dunned, by Stripe
+	if s.IntegrationFactory != nil {
+		stripeIntegration, err := s.IntegrationFactory.GetStripeIntegration(ctx)
+		if err == nil && stripeIntegration.InvoiceSyncSvc.IsInvoiceSyncedToStripe(ctx, inv.ID) {
+			s.Logger.Infow("invoice is synced to Stripe, skipping dunning",
+				"invoice_id", inv.ID,
+				"subscription_ids", subscriptionIDs)
+			return nil
+		}
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for invoice dunning",
+			"invoice_id", inv.ID)
+		return nil
+	}
+
+	// The workflow ID is fixed per invoice, an invoice already dunned is not dunned again
+	workflowRun, err := temporalSvc.ExecuteWorkflow(
+		ctx,
+		types.TemporalInvoiceDunningWorkflow,
+		invoiceModels.InvoiceDunningWorkflowInput{InvoiceID: inv.ID},
+	)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		s.Logger.Infow("invoice was already dunned, skipping dunning",
+			"invoice_id", inv.ID,
+			"subscription_ids", subscriptionIDs)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infow("invoice dunning workflow started successfully",
+		"invoice_id", inv.ID,
+		"subscription_ids", subscriptionIDs,
+		"workflow_id", workflowRun.GetID())
+	return nil
+}
+
+func (s *dunningService) StartDunning(ctx context.Context, invoiceID string) (*invoiceModels.StartDunningActivityOutput, error) {
+	skipped := &invoiceModels.StartDunningActivityOutput{Outcome: types.DunningOutcomeSkipped}
+
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := s.getDunnableSubscriptions(ctx, inv)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		return skipped, nil
+	}
+
+	config := GetDunningConfig(s.ServiceParams, ctx)
+	if !config.Enabled || len(config.Steps) == 0 {
+		return skipped, nil
+	}
+
+	for _, sub := range subs {
+		if err := s.markPastDue(ctx, sub); err != nil {
+			return nil, err
+		}
+	}
+
+	s.Logger.Infow("started invoice dunning",
+		"invoice_id", inv.ID,
+		"subscription_ids", inv.GetSubscriptionIDs(),
+		"steps", len(config.Steps),
+		"final_action", config.FinalAction)
+
+	s.publishInvoiceWebhookEvent(ctx, types.WebhookEventInvoiceDunningStarted, inv.ID)
+
+	return &invoiceModels.StartDunningActivityOutput{
+		Outcome:     types.DunningOutcomePending,
+		Steps:       config.Steps,
+		FinalAction: config.FinalAction,
+	}, nil
+}
+
+func (s *dunningService) RunDunningStep(ctx context.Context, invoiceID string, stepNumber int, step types.DunningStep) (types.DunningOutcome, error) {
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return "", err
+	}
+
+	if outcome, done, err := s.checkDunning(ctx, inv); err != nil || done {
+		return outcome, err
+	}
+
+	if step.RetryPayment {
+		if err := NewInvoiceService(s.ServiceParams).AttemptPayment(ctx, inv.ID); err != nil {
+			// A failed retry leaves the invoice unpaid, the next step retries again
+			s.Logger.Errorw("failed to retry invoice payment during dunning",
+				"error", err,
+				"invoice_id", inv.ID,
+				"step", stepNumber)
+		}
+
+		inv, err = s.InvoiceRepo.Get(ctx, invoiceID)
+		if err != nil {
+			return "", err
+		}
+		if outcome, done, err := s.checkDunning(ctx, inv); err != nil || done {
+			return outcome, err
+		}
+	}
+
+	// Another failed invoice may have been recovered in the meantime
+	for _, subscriptionID := range inv.GetSubscriptionIDs() {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			return "", err
+		}
+		if err := s.markPastDue(ctx, sub); err != nil {
+			return "", err
+		}
+	}
+
+	s.Logger.Infow("invoice still unpaid after dunning step",
+		"invoice_id", inv.ID,
+		"subscription_ids", inv.GetSubscriptionIDs(),
+		"step", stepNumber,
+		"amount_remaining", inv.AmountRemaining)
+
+	if step.SendEmail {
+		s.sendDunningEmail(ctx, inv)
+	}
+	if step.SendWebhook {
+		s.publishInvoiceWebhookEvent(ctx, types.WebhookEventInvoiceDunningStep, inv.ID)
+	}
+
+	return types.DunningOutcomePending, nil
+}
+
+func (s *dunningService) FinishDunning(ctx context.Context, invoiceID string, action types.DunningFinalAction) (types.DunningOutcome, error) {
+	if err := action.Validate(); err != nil {
+		return "", err
+	}
+
+	inv, err := s.InvoiceRepo.Get(ctx, invoiceID)
+	if err != nil {
+		return "", err
+	}
+
+	if outcome, done, err := s.checkDunning(ctx, inv); err != nil || done {
+		return outcome, err
+	}
+
+	s.Logger.Infow("dunning exhausted, applying final action",
+		"invoice_id", inv.ID,
+		"subscription_ids", inv.GetSubscriptionIDs(),
+		"final_action", action)
+
+	for _, subscriptionID := range inv.GetSubscriptionIDs() {
+		if err := s.applyFinalAction(ctx, subscriptionID, action); err != nil {
+			return "", err
+		}
+	}
+
+	s.publishInvoiceWebhookEvent(ctx, types.WebhookEventInvoiceDunningExhausted, inv.ID)
+
+	return types.DunningOutcomeExhausted, nil
+}
+
+// applyFinalAction applies the final action of the dunning policy to a subscription of an invoice
+// still unpaid after the last step. Subscriptions that were cancelled or paused in the meantime
+// are left unchanged.
+func (s *dunningService) applyFinalAction(ctx context.Context, subscriptionID string, action types.DunningFinalAction) error {
+	subscriptionSvc := NewSubscriptionService(s.ServiceParams).(*subscriptionService)
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	sub.LineItems = lineItems
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive && !sub.IsDelinquent() {
+		return nil
+	}
+
+	switch action {
+	case types.DunningFinalActionCancel:
+		if _, err := subscriptionSvc.CancelSubscription(ctx, sub.ID, &dto.CancelSubscriptionRequest{
+			CancellationType:  types.CancellationTypeImmediate,
+			ProrationBehavior: types.ProrationBehaviorNone,
+			Reason:            "dunning_exhausted",
+		}); err != nil {
+			return err
+		}
+
+	case types.DunningFinalActionPause:
+		now := time.Now().UTC()
+		if _, _, err := subscriptionSvc.executePause(ctx, sub, &dto.PauseSubscriptionRequest{
+			PauseMode: types.PauseModeImmediate,
+			Reason:    "invoice unpaid at the end of the dunning",
+		}, &now, nil); err != nil {
+			return err
+		}
+		subscriptionSvc.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+		subscriptionSvc.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionPaused, sub.ID)
+
+	case types.DunningFinalActionMarkUnpaid:
+		sub.SubscriptionStatus = types.SubscriptionStatusUnpaid
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		subscriptionSvc.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+	}
+	return nil
+}
+
+func (s *dunningService) HandleInvoicePaid(ctx context.Context, inv *invoice.Invoice) error {
+	if inv.InvoiceStatus == types.InvoiceStatusVoided || !isInvoiceSettled(inv) {
+		return nil
+	}
+	_, err := s.recoverSubscription(ctx, inv)
+	return err
+}
+
+// checkDunning returns the final outcome of the dunning of an invoice that no longer needs
+// dunning: a paid invoice recovers its subscriptions, and dunning stops for voided invoices and
+// when every subscription of the invoice was cancelled or paused in the meantime
+func (s *dunningService) checkDunning(ctx context.Context, inv *invoice.Invoice) (types.DunningOutcome, bool, error) {
+	subscriptionIDs := inv.GetSubscriptionIDs()
+	if len(subscriptionIDs) == 0 {
+		return types.DunningOutcomeStopped, true, nil
+	}
+
+	// Nothing was paid on a voided invoice, its subscriptions stay delinquent
+	if inv.InvoiceStatus == types.InvoiceStatusVoided {
+		s.Logger.Infow("invoice was voided, stopping dunning",
+			"invoice_id", inv.ID,
+			"subscription_ids", subscriptionIDs)
+		return types.DunningOutcomeStopped, true, nil
+	}
+
+	if isInvoiceSettled(inv) {
+		if _, err := s.recoverSubscription(ctx, inv); err != nil {
+			return "", true, err
+		}
+		return types.DunningOutcomeRecovered, true, nil
+	}
+
+	for _, subscriptionID := range subscriptionIDs {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			return "", true, err
+		}
+		if sub.SubscriptionStatus == types.SubscriptionStatusActive || sub.IsDelinquent() {
+			return types.DunningOutcomePending, false, nil
+		}
+	}
+
+	s.Logger.Infow("subscriptions are no longer active, stopping dunning",
+		"invoice_id", inv.ID,
+		"subscription_ids", subscriptionIDs)
+	return types.DunningOutcomeStopped, true, nil
+}
+
+// getDunnableSubscriptions returns the subscriptions of an unpaid invoice that is collected
+// automatically, none when the invoice is not dunned. Consolidated invoices return every
+// subscription of the group which is still active or delinquent.
+func (s *dunningService) getDunnableSubscriptions(ctx context.Context, inv *invoice.Invoice) ([]*subscription.Subscription, error) {
+	if inv.InvoiceStatus != types.InvoiceStatusFinalized || isInvoiceSettled(inv) {
+		return nil, nil
+	}
+
+	subs := make([]*subscription.Subscription, 0, len(inv.GetSubscriptionIDs()))
+	for _, subscriptionID := range inv.GetSubscriptionIDs() {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Invoices sent for manual payment are not failed payments, and incomplete subscriptions
+		// are handled by their first invoice
+		if types.CollectionMethod(sub.CollectionMethod) != types.CollectionMethodChargeAutomatically {
+			continue
+		}
+		if sub.SubscriptionStatus != types.SubscriptionStatusActive && !sub.IsDelinquent() {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// markPastDue moves an active subscription to past due
+func (s *dunningService) markPastDue(ctx context.Context, sub *subscription.Subscription) error {
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil
+	}
+
+	sub.SubscriptionStatus = types.SubscriptionStatusPastDue
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	NewSubscriptionService(s.ServiceParams).(*subscriptionService).publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+	return nil
+}
+
+// recoverSubscription makes the past due or unpaid subscriptions of a paid invoice active again
+// and returns false when none of them was delinquent
+func (s *dunningService) recoverSubscription(ctx context.Context, inv *invoice.Invoice) (bool, error) {
+	subscriptionSvc := NewSubscriptionService(s.ServiceParams).(*subscriptionService)
+
+	recovered := false
+	for _, subscriptionID := range inv.GetSubscriptionIDs() {
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			return false, err
+		}
+		if !sub.IsDelinquent() {
+			continue
+		}
+
+		previousStatus := sub.SubscriptionStatus
+		sub.SubscriptionStatus = types.SubscriptionStatusActive
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return false, err
+		}
+
+		s.Logger.Infow("recovered delinquent subscription",
+			"invoice_id", inv.ID,
+			"subscription_id", sub.ID,
+			"previous_status", previousStatus)
+
+		if previousStatus == types.SubscriptionStatusUnpaid {
+			// Credit grants deferred while the subscription was unpaid apply now
+			if err := subscriptionSvc.processPendingCreditGrantsForSubscription(ctx, sub); err != nil {
+				s.Logger.Errorw("failed to process pending credit grants during subscription recovery",
+					"subscription_id", sub.ID,
+					"error", err)
+			}
+		}
+
+		subscriptionSvc.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+		recovered = true
+	}
+
+	if recovered {
+		s.publishInvoiceWebhookEvent(ctx, types.WebhookEventInvoiceDunningRecovered, inv.ID)
+	}
+	return recovered, nil
+}
+
+// sendDunningEmail emails the customer of an invoice still unpaid during its dunning
+func (s *dunningService) sendDunningEmail(ctx context.Context, inv *invoice.Invoice) {
+	if s.Config == nil {
+		return
+	}
+
+	emailClient := email.NewEmailClient(email.Config{
+		Enabled:     s.Config.Email.Enabled,
+		APIKey:      s.Config.Email.ResendAPIKey,
+		FromAddress: s.Config.Email.FromAddress,
+		ReplyTo:     s.Config.Email.ReplyTo,
+	})
+	if !emailClient.IsEnabled() {
+		s.Logger.Debugw("email service is disabled, skipping dunning email",
+			"invoice_id", inv.ID)
+		return
+	}
+
+	customer, err := s.CustomerRepo.Get(ctx, inv.CustomerID)
+	if err != nil {
+		s.Logger.Errorw("failed to get customer for dunning email",
+			"error", err,
+			"invoice_id", inv.ID,
+			"customer_id", inv.CustomerID)
+		return
+	}
+	if customer.Email == "" {
+		s.Logger.Infow("customer has no email, skipping dunning email",
+			"invoice_id", inv.ID,
+			"customer_id", customer.ID)
+		return
+	}
+
+	resp, err := email.NewEmail(emailClient, s.Logger.Desugar()).SendEmail(ctx, email.SendEmailRequest{
+		FromAddress: s.Config.Email.FromAddress,
+		ToAddress:   customer.Email,
+		Subject:     fmt.Sprintf("Payment failed for invoice %s", lo.FromPtrOr(inv.InvoiceNumber, inv.ID)),
+		Text:        dunningEmailText(inv),
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to send dunning email",
+			"error", err,
+			"invoice_id", inv.ID,
+			"customer_id", customer.ID)
+		return
+	}
+	if !resp.Success {
+		s.Logger.Errorw("dunning email send was not successful",
+			"invoice_id", inv.ID,
+			"error", resp.Error)
+	}
+}
+
+// publishInvoiceWebhookEvent publishes an invoice webhook event of the dunning
+func (s *dunningService) publishInvoiceWebhookEvent(ctx context.Context, eventName string, invoiceID string) {
+	NewInvoiceService(s.ServiceParams).(*invoiceService).publishInternalWebhookEvent(ctx, eventName, invoiceID)
+}
+
+// dunningEmailText returns the body of the email sent to the customer of an unpaid invoice
+func dunningEmailText(inv *invoice.Invoice) string {
+	amount := inv.AmountRemaining.StringFixed(types.GetCurrencyPrecision(inv.Currency))
+	return fmt.Sprintf(
+		"We were unable to collect the payment of invoice %s. An amount of %s %s is still due.\n\n"+
+			"Please update your payment method or pay the invoice to keep your subscription active.",
+		lo.FromPtrOr(inv.InvoiceNumber, inv.ID), amount, strings.ToUpper(inv.Currency))
+}
+
+// isInvoiceSettled returns true if nothing is left to collect on the invoice
+func isInvoiceSettled(inv *invoice.Invoice) bool {
+	if inv.InvoiceStatus == types.InvoiceStatusVoided {
+		return true
+	}
+	if inv.PaymentStatus == types.PaymentStatusSucceeded || inv.PaymentStatus == types.PaymentStatusOverpaid {
+		return true
+	}
+	return inv.AmountRemaining.LessThanOrEqual(decimal.Zero)
+}
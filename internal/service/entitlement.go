@@ -79,6 +79,9 @@ func (s *entitlementService) CreateEntitlement(ctx context.Context, req dto.Crea
 				}).
 				Mark(ierr.ErrNotFound)
 		}
+		if err := validatePlanVersionEditable(ctx, s.ServiceParams, entityID); err != nil {
+			return nil, err
+		}
 	case types.ENTITLEMENT_ENTITY_TYPE_ADDON:
 		entity, err = s.AddonRepo.GetByID(ctx, entityID)
 		if err != nil {
@@ -243,6 +246,9 @@ func (s *entitlementService) CreateBulkEntitlement(ctx context.Context, req dto.
 					}).
 					Mark(ierr.ErrValidation)
 			}
+			if err := validatePlanVersionEditable(txCtx, s.ServiceParams, planID); err != nil {
+				return err
+			}
 		}
 
 		// Validate all addons exist
@@ -619,6 +625,12 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 		return nil, err
 	}
 
+	if existing.EntityType == types.ENTITLEMENT_ENTITY_TYPE_PLAN {
+		if err := validatePlanVersionEditable(ctx, s.ServiceParams, existing.EntityID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update fields if provided
 	if req.IsEnabled != nil {
 		existing.IsEnabled = *req.IsEnabled
@@ -641,6 +653,9 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 	if req.StaticValue != "" {
 		existing.StaticValue = req.StaticValue
 	}
+	if req.RolloverConfig != nil {
+		existing.RolloverConfig = req.RolloverConfig
+	}
 
 	// Validate updated entitlement
 	if err := existing.Validate(); err != nil {
@@ -666,8 +681,18 @@ func (s *entitlementService) UpdateEntitlement(ctx context.Context, id string, r
 }
 
 func (s *entitlementService) DeleteEntitlement(ctx context.Context, id string) error {
+	existing, err := s.EntitlementRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if existing.EntityType == types.ENTITLEMENT_ENTITY_TYPE_PLAN {
+		if err := validatePlanVersionEditable(ctx, s.ServiceParams, existing.EntityID); err != nil {
+			return err
+		}
+	}
 
-	err := s.EntitlementRepo.Delete(ctx, id)
+	err = s.EntitlementRepo.Delete(ctx, id)
 	if err != nil {
 		return err
 	}
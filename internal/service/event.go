@@ -134,6 +134,7 @@ func (s *eventService) GetUsageByMeter(ctx context.Context, req *dto.GetUsageByM
 		PriceID:            req.PriceID,
 		MeterID:            req.MeterID,
 		BillingAnchor:      req.BillingAnchor,
+		TimeWindow:         req.TimeWindow,
 	}
 
 	// Pass the multiplier from meter configuration if it's a SUM_WITH_MULTIPLIER aggregation
@@ -390,6 +390,7 @@ func (s *eventPostProcessingService) prepareProcessedEvents(ctx context.Context,
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
@@ -986,6 +987,7 @@ func (s *eventPostProcessingService) GetDetailedUsageAnalytics(ctx context.Conte
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
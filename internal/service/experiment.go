@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/experiment"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// ExperimentService manages price experiments that split the customers subscribing to a public
+// plan between weighted plan variants
+type ExperimentService interface {
+	CreateExperiment(ctx context.Context, req dto.CreateExperimentRequest) (*dto.ExperimentResponse, error)
+	GetExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error)
+	UpdateExperiment(ctx context.Context, id string, req dto.UpdateExperimentRequest) (*dto.ExperimentResponse, error)
+	DeleteExperiment(ctx context.Context, id string) error
+	ListExperiments(ctx context.Context, filter *types.ExperimentFilter) (*dto.ListExperimentsResponse, error)
+	StartExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error)
+	CompleteExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error)
+
+	// AssignVariant returns the variant of a customer, assigning the customer on first use
+	AssignVariant(ctx context.Context, id string, req dto.AssignExperimentVariantRequest) (*dto.ExperimentAssignmentResponse, error)
+	ListAssignments(ctx context.Context, filter *types.ExperimentAssignmentFilter) (*dto.ListExperimentAssignmentsResponse, error)
+
+	// ResolvePlanAssignment returns the assignment of a customer in the running experiment of a
+	// plan, or nil if the plan has no running experiment
+	ResolvePlanAssignment(ctx context.Context, planID string, customerID string) (*experiment.Assignment, error)
+}
+
+type experimentService struct {
+	ServiceParams
+}
+
+// NewExperimentService creates a new experiment service
+func NewExperimentService(params ServiceParams) ExperimentService {
+	return &experimentService{
+		ServiceParams: params,
+	}
+}
+
+func (s *experimentService) CreateExperiment(ctx context.Context, req dto.CreateExperimentRequest) (*dto.ExperimentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.PlanRepo.Get(ctx, req.PlanID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateVariantPlans(ctx, req.Variants); err != nil {
+		return nil, err
+	}
+
+	e := req.ToExperiment(ctx)
+	if err := s.ExperimentRepo.Create(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return &dto.ExperimentResponse{Experiment: e}, nil
+}
+
+func (s *experimentService) GetExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error) {
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ExperimentResponse{Experiment: e}, nil
+}
+
+func (s *experimentService) UpdateExperiment(ctx context.Context, id string, req dto.UpdateExperimentRequest) (*dto.ExperimentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		e.Name = *req.Name
+	}
+	if req.Description != nil {
+		e.Description = *req.Description
+	}
+	if req.Metadata != nil {
+		e.Metadata = req.Metadata
+	}
+
+	if req.Variants != nil {
+		// Changing the split of a running experiment would move unassigned customers between
+		// variants mid-experiment and skew the report
+		if e.ExperimentStatus != types.ExperimentStatusDraft {
+			return nil, ierr.NewError("variants can only be changed while the experiment is a draft").
+				WithHint("Create a new experiment to test a different split").
+				WithReportableDetails(map[string]interface{}{
+					"experiment_id":     e.ID,
+					"experiment_status": e.ExperimentStatus,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if err := s.validateVariantPlans(ctx, req.Variants); err != nil {
+			return nil, err
+		}
+		e.Variants = req.Variants
+	}
+
+	if err := s.ExperimentRepo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return &dto.ExperimentResponse{Experiment: e}, nil
+}
+
+func (s *experimentService) DeleteExperiment(ctx context.Context, id string) error {
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if e.IsRunning() {
+		return ierr.NewError("running experiments can not be deleted").
+			WithHint("Complete the experiment before deleting it").
+			WithReportableDetails(map[string]interface{}{
+				"experiment_id": id,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return s.ExperimentRepo.Delete(ctx, id)
+}
+
+func (s *experimentService) ListExperiments(ctx context.Context, filter *types.ExperimentFilter) (*dto.ListExperimentsResponse, error) {
+	if filter == nil {
+		filter = types.NewExperimentFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	experiments, err := s.ExperimentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.ExperimentRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(experiments, func(e *experiment.Experiment, _ int) *dto.ExperimentResponse {
+		return &dto.ExperimentResponse{Experiment: e}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+// StartExperiment starts assigning customers subscribing to the plan of the experiment
+func (s *experimentService) StartExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error) {
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.ExperimentStatus != types.ExperimentStatusDraft {
+		return nil, ierr.NewError("only draft experiments can be started").
+			WithHint("Create a new experiment to run it again").
+			WithReportableDetails(map[string]interface{}{
+				"experiment_id":     e.ID,
+				"experiment_status": e.ExperimentStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Variant plans may have been archived since the experiment was created
+	if err := s.validateVariantPlans(ctx, e.Variants); err != nil {
+		return nil, err
+	}
+
+	e.ExperimentStatus = types.ExperimentStatusRunning
+	e.StartedAt = lo.ToPtr(time.Now().UTC())
+	if err := s.ExperimentRepo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("started price experiment",
+		"experiment_id", e.ID,
+		"plan_id", e.PlanID,
+		"variants", len(e.Variants))
+
+	return &dto.ExperimentResponse{Experiment: e}, nil
+}
+
+// CompleteExperiment stops assigning customers. Subscriptions created through the plan resolve to
+// the plan itself again, existing subscriptions stay on their variant.
+func (s *experimentService) CompleteExperiment(ctx context.Context, id string) (*dto.ExperimentResponse, error) {
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.IsRunning() {
+		return nil, ierr.NewError("only running experiments can be completed").
+			WithHint("Start the experiment first").
+			WithReportableDetails(map[string]interface{}{
+				"experiment_id":     e.ID,
+				"experiment_status": e.ExperimentStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	e.ExperimentStatus = types.ExperimentStatusCompleted
+	e.EndedAt = lo.ToPtr(time.Now().UTC())
+	if err := s.ExperimentRepo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return &dto.ExperimentResponse{Experiment: e}, nil
+}
+
+func (s *experimentService) AssignVariant(ctx context.Context, id string, req dto.AssignExperimentVariantRequest) (*dto.ExperimentAssignmentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	e, err := s.ExperimentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.CustomerRepo.Get(ctx, req.CustomerID); err != nil {
+		return nil, err
+	}
+
+	a, err := s.assign(ctx, e, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ExperimentAssignmentResponse{Assignment: a}, nil
+}
+
+func (s *experimentService) ListAssignments(ctx context.Context, filter *types.ExperimentAssignmentFilter) (*dto.ListExperimentAssignmentsResponse, error) {
+	if filter == nil {
+		filter = types.NewExperimentAssignmentFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.ExperimentAssignmentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.ExperimentAssignmentRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(assignments, func(a *experiment.Assignment, _ int) *dto.ExperimentAssignmentResponse {
+		return &dto.ExperimentAssignmentResponse{Assignment: a}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *experimentService) ResolvePlanAssignment(ctx context.Context, planID string, customerID string) (*experiment.Assignment, error) {
+	e, err := s.ExperimentRepo.GetRunningByPlan(ctx, planID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.assign(ctx, e, customerID)
+}
+
+// assign returns the existing assignment of a customer or assigns the customer to a variant.
+// Existing assignments are returned even after the experiment completed, so customers keep
+// seeing the variant they were shown.
+func (s *experimentService) assign(ctx context.Context, e *experiment.Experiment, customerID string) (*experiment.Assignment, error) {
+	existing, err := s.ExperimentAssignmentRepo.GetByExperimentAndCustomer(ctx, e.ID, customerID)
+	if err == nil {
+		return existing, nil
+	}
+	if !ierr.IsNotFound(err) {
+		return nil, err
+	}
+
+	if !e.IsRunning() {
+		return nil, ierr.NewError("experiment is not running").
+			WithHint("Customers can only be assigned to running experiments").
+			WithReportableDetails(map[string]interface{}{
+				"experiment_id":     e.ID,
+				"experiment_status": e.ExperimentStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	variant := e.AssignVariant(customerID)
+	a := &experiment.Assignment{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_EXPERIMENT_ASSIGNMENT),
+		ExperimentID:  e.ID,
+		CustomerID:    customerID,
+		VariantKey:    variant.Key,
+		PlanID:        variant.PlanID,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.ExperimentAssignmentRepo.Create(ctx, a); err != nil {
+		// A concurrent request assigned the customer first, use its assignment
+		if ierr.IsAlreadyExists(err) {
+			return s.ExperimentAssignmentRepo.GetByExperimentAndCustomer(ctx, e.ID, customerID)
+		}
+		return nil, err
+	}
+
+	s.Logger.Debugw("assigned customer to experiment variant",
+		"experiment_id", e.ID,
+		"customer_id", customerID,
+		"variant_key", variant.Key,
+		"plan_id", variant.PlanID)
+
+	return a, nil
+}
+
+// validateVariantPlans checks that customers can subscribe to the plans of the variants
+func (s *experimentService) validateVariantPlans(ctx context.Context, variants []types.ExperimentVariant) error {
+	for _, v := range variants {
+		p, err := s.PlanRepo.Get(ctx, v.PlanID)
+		if err != nil {
+			return err
+		}
+		if p.Status != types.StatusPublished || !p.IsSubscribable() {
+			return ierr.NewError("variant plan is not available for subscriptions").
+				WithHint("Variant plans must be published").
+				WithReportableDetails(map[string]interface{}{
+					"variant_key": v.Key,
+					"plan_id":     v.PlanID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// recordExperimentConversion marks the experiment assignment of an activated subscription as
+// converted. Only subscriptions created through an experiment carry an assignment. The report is
+// not worth failing an activation for, so errors are logged.
+func recordExperimentConversion(ctx context.Context, params ServiceParams, sub *subscription.Subscription) {
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive || sub.Metadata["experiment_id"] == "" {
+		return
+	}
+
+	if err := params.ExperimentAssignmentRepo.MarkConverted(ctx, sub.ID, time.Now().UTC()); err != nil {
+		params.Logger.Errorw("failed to record experiment conversion",
+			"error", err,
+			"subscription_id", sub.ID,
+			"experiment_id", sub.Metadata["experiment_id"])
+	}
+}
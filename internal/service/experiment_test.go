@@ -0,0 +1,159 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/domain/experiment"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperimentAssignVariant(t *testing.T) {
+	e := &experiment.Experiment{
+		ID: "exp_1",
+		Variants: []types.ExperimentVariant{
+			{Key: "control", PlanID: "plan_a", Weight: 80},
+			{Key: "higher_price", PlanID: "plan_b", Weight: 20},
+		},
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		customerID := fmt.Sprintf("cust_%d", i)
+		variant := e.AssignVariant(customerID)
+		assert.Equal(t, variant, e.AssignVariant(customerID), "assignment must be deterministic")
+		counts[variant.Key]++
+	}
+
+	// Shares follow the weights within a small tolerance
+	assert.InDelta(t, 8000, counts["control"], 300)
+	assert.InDelta(t, 2000, counts["higher_price"], 300)
+}
+
+func TestExperimentAssignSticky(t *testing.T) {
+	ctx := testutil.SetupContext()
+	svc := &experimentService{ServiceParams: ServiceParams{
+		Logger:                   logger.GetLogger(),
+		ExperimentRepo:           testutil.NewInMemoryExperimentStore(),
+		ExperimentAssignmentRepo: testutil.NewInMemoryExperimentAssignmentStore(),
+	}}
+
+	e := &experiment.Experiment{
+		ID:               "exp_1",
+		PlanID:           "plan_public",
+		ExperimentStatus: types.ExperimentStatusRunning,
+		Variants: []types.ExperimentVariant{
+			{Key: "control", PlanID: "plan_a", Weight: 1},
+			{Key: "higher_price", PlanID: "plan_b", Weight: 1},
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	require.NoError(t, svc.ExperimentRepo.Create(ctx, e))
+
+	first, err := svc.ResolvePlanAssignment(ctx, "plan_public", "cust_1")
+	require.NoError(t, err)
+	require.NotNil(t, first)
+
+	// Changing the weights afterwards does not move an assigned customer
+	for i := range e.Variants {
+		e.Variants[i].Weight = 1
+		if e.Variants[i].Key == first.VariantKey {
+			e.Variants[i].Weight = 0
+		}
+	}
+	second, err := svc.assign(ctx, e, "cust_1")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, first.VariantKey, second.VariantKey)
+
+	// Completed experiments keep returning existing assignments but assign nobody new
+	e.ExperimentStatus = types.ExperimentStatusCompleted
+	third, err := svc.assign(ctx, e, "cust_1")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, third.ID)
+
+	_, err = svc.assign(ctx, e, "cust_2")
+	assert.Error(t, err)
+
+	// Plans without a running experiment resolve to no assignment
+	none, err := svc.ResolvePlanAssignment(ctx, "plan_other", "cust_1")
+	require.NoError(t, err)
+	assert.Nil(t, none)
+}
+
+func TestBuildExperimentReport(t *testing.T) {
+	variants := []types.ExperimentVariant{
+		{Key: "control", PlanID: "plan_a", Weight: 50},
+		{Key: "higher_price", PlanID: "plan_b", Weight: 50},
+		{Key: "unused", PlanID: "plan_c", Weight: 0},
+	}
+	stats := []types.ExperimentVariantStats{
+		{VariantKey: "control", Assignments: 3, Conversions: 1},
+		{VariantKey: "higher_price", Assignments: 4, Conversions: 3},
+	}
+	revenues := []types.ExperimentVariantRevenue{
+		{VariantKey: "control", Currency: "usd", Revenue: "10"},
+		{VariantKey: "higher_price", Currency: "USD", Revenue: "45.5"},
+		{VariantKey: "higher_price", Currency: "eur", Revenue: "20"},
+	}
+
+	report, err := buildExperimentReport("exp_1", "Pro pricing", types.ExperimentStatusRunning, variants, stats, revenues)
+	require.NoError(t, err)
+	require.Len(t, report.Variants, 3)
+
+	control := report.Variants[0]
+	assert.Equal(t, 3, control.Assignments)
+	assert.True(t, decimal.RequireFromString("0.3333").Equal(control.ConversionRate), "got %s", control.ConversionRate)
+	assert.True(t, decimal.RequireFromString("3.33").Equal(control.RevenuePerAssignment["usd"]), "got %s", control.RevenuePerAssignment["usd"])
+
+	higher := report.Variants[1]
+	assert.True(t, decimal.RequireFromString("0.75").Equal(higher.ConversionRate))
+	assert.True(t, decimal.RequireFromString("45.5").Equal(higher.Revenue["usd"]))
+	assert.True(t, decimal.RequireFromString("11.38").Equal(higher.RevenuePerAssignment["usd"]), "got %s", higher.RevenuePerAssignment["usd"])
+	assert.True(t, decimal.RequireFromString("5").Equal(higher.RevenuePerAssignment["eur"]))
+
+	unused := report.Variants[2]
+	assert.Zero(t, unused.Assignments)
+	assert.True(t, unused.ConversionRate.IsZero())
+	assert.Empty(t, unused.Revenue)
+}
+
+func TestRecordExperimentConversion(t *testing.T) {
+	ctx := testutil.SetupContext()
+	params := ServiceParams{
+		Logger:                   logger.GetLogger(),
+		ExperimentAssignmentRepo: testutil.NewInMemoryExperimentAssignmentStore(),
+	}
+
+	for _, a := range []*experiment.Assignment{
+		{ID: "assign_1", ExperimentID: "exp_1", CustomerID: "cust_1", VariantKey: "control", PlanID: "plan_a"},
+		{ID: "assign_2", ExperimentID: "exp_1", CustomerID: "cust_2", VariantKey: "control", PlanID: "plan_a"},
+	} {
+		a.BaseModel = types.GetDefaultBaseModel(ctx)
+		require.NoError(t, params.ExperimentAssignmentRepo.Create(ctx, a))
+	}
+	require.NoError(t, params.ExperimentAssignmentRepo.SetSubscription(ctx, "assign_1", "sub_1"))
+	require.NoError(t, params.ExperimentAssignmentRepo.SetSubscription(ctx, "assign_2", "sub_2"))
+
+	metadata := types.Metadata{"experiment_id": "exp_1"}
+	activated := &subscription.Subscription{ID: "sub_1", SubscriptionStatus: types.SubscriptionStatusActive, Metadata: metadata}
+	recordExperimentConversion(ctx, params, activated)
+	// Later activations, e.g. after a pause, keep the first conversion
+	recordExperimentConversion(ctx, params, activated)
+
+	// A trial cancelled before it converted never activated its subscription
+	cancelled := &subscription.Subscription{ID: "sub_2", SubscriptionStatus: types.SubscriptionStatusCancelled, Metadata: metadata}
+	recordExperimentConversion(ctx, params, cancelled)
+
+	stats, err := params.ExperimentAssignmentRepo.GetVariantStats(ctx, "exp_1")
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 2, stats[0].Assignments)
+	assert.Equal(t, 1, stats[0].Conversions)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/experiment"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/group"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
@@ -28,6 +29,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/planpricesync"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
+	"github.com/flexprice/flexprice/internal/domain/quote"
 	"github.com/flexprice/flexprice/internal/domain/proration"
 	"github.com/flexprice/flexprice/internal/domain/scheduledtask"
 	"github.com/flexprice/flexprice/internal/domain/secret"
@@ -109,6 +111,10 @@ type ServiceParams struct {
 	ScheduledTaskRepo            scheduledtask.Repository
 	PlanPriceSyncRepo            planpricesync.Repository
 	WorkflowExecutionRepo        workflowexecution.Repository
+	ExperimentRepo               experiment.Repository
+	ExperimentAssignmentRepo     experiment.AssignmentRepository
+	QuoteRepo                    quote.Repository
+	MigrationResultRepo          subscription.MigrationResultRepository
 
 	// Publishers
 	EventPublisher   publisher.EventPublisher
@@ -188,6 +194,10 @@ func NewServiceParams(
 	webhookPubSub pubsub.PubSub,
 	planPriceSyncRepo planpricesync.Repository,
 	workflowExecutionRepo workflowexecution.Repository,
+	experimentRepo experiment.Repository,
+	experimentAssignmentRepo experiment.AssignmentRepository,
+	quoteRepo quote.Repository,
+	migrationResultRepo subscription.MigrationResultRepository,
 ) ServiceParams {
 	return ServiceParams{
 		Logger:                       logger,
@@ -248,5 +258,9 @@ func NewServiceParams(
 		WebhookPubSub:                webhookPubSub,
 		PlanPriceSyncRepo:            planPriceSyncRepo,
 		WorkflowExecutionRepo:        workflowExecutionRepo,
+		ExperimentRepo:               experimentRepo,
+		ExperimentAssignmentRepo:     experimentAssignmentRepo,
+		QuoteRepo:                    quoteRepo,
+		MigrationResultRepo:          migrationResultRepo,
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/cache"
 	"github.com/flexprice/flexprice/internal/config"
 	"github.com/flexprice/flexprice/internal/domain/addon"
 	"github.com/flexprice/flexprice/internal/domain/customer"
@@ -30,6 +31,7 @@ import (
 	"github.com/flexprice/flexprice/internal/pubsub/kafka"
 	pubsubRouter "github.com/flexprice/flexprice/internal/pubsub/router"
 	workflowModels "github.com/flexprice/flexprice/internal/temporal/models"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
 	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -427,6 +429,9 @@ func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *e
 			return err
 		}
 
+		s.checkBillingThresholds(ctx, featureUsage)
+		s.checkSpendLimits(ctx, featureUsage)
+
 		// Only publish wallet balance alerts if enabled in configuration
 		if s.Config.FeatureUsageTracking.WalletAlertPushEnabled {
 			walletBalanceAlertService := NewWalletBalanceAlertService(s.ServiceParams)
@@ -465,6 +470,128 @@ func (s *featureUsageTrackingService) processEvent(ctx context.Context, event *e
 	return nil
 }
 
+// billingThresholdCheckInterval is how often the billing threshold of a subscription is checked
+// while usage is tracked for it
+const billingThresholdCheckInterval = time.Minute
+
+// spendLimitCheckInterval is how often the spend limit of a customer is checked while usage is
+// tracked for it
+const spendLimitCheckInterval = time.Minute
+
+// checkSpendLimits schedules the spend limit check of the customers of the processed usage off
+// the ingestion path, so their prepaid balances are not read for every event. The check is
+// debounced per customer: the first usage of an interval starts a workflow that checks the spend
+// limit at the end of the interval, the usage tracked until then is covered by the same check.
+// Failures are logged so they never block usage tracking.
+func (s *featureUsageTrackingService) checkSpendLimits(ctx context.Context, featureUsage []*events.FeatureUsage) {
+	customerIDs := lo.Uniq(lo.FilterMap(featureUsage, func(fu *events.FeatureUsage, _ int) (string, bool) {
+		return fu.CustomerID, fu.CustomerID != ""
+	}))
+
+	cacheClient := cache.GetInMemoryCache()
+	for _, customerID := range customerIDs {
+		cacheKey := cache.GenerateKey(cache.PrefixSpendLimitCheck, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), customerID)
+		if _, found := cacheClient.ForceCacheGet(ctx, cacheKey); found {
+			continue
+		}
+
+		if !s.scheduleSpendLimitCheck(ctx, customerID) {
+			continue
+		}
+		cacheClient.ForceCacheSet(ctx, cacheKey, true, spendLimitCheckInterval)
+	}
+}
+
+// scheduleSpendLimitCheck starts the workflow checking the spend limit of the customer at the end
+// of the check interval and reports whether it was started. The workflow ID is fixed per
+// customer, a check already pending for the customer is not started again.
+func (s *featureUsageTrackingService) scheduleSpendLimitCheck(ctx context.Context, customerID string) bool {
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for spend limit check",
+			"customer_id", customerID)
+		return false
+	}
+
+	_, err := temporalSvc.ExecuteWorkflow(
+		ctx,
+		types.TemporalSpendLimitWorkflow,
+		subscriptionModels.SpendLimitWorkflowInput{
+			CustomerID: customerID,
+			Delay:      spendLimitCheckInterval,
+		},
+	)
+	if err != nil {
+		s.Logger.Errorw("failed to start spend limit workflow",
+			"error", err,
+			"customer_id", customerID)
+		return false
+	}
+	return true
+}
+
+// checkBillingThresholds schedules the billing threshold check of the subscriptions of the
+// processed usage off the ingestion path. The check is debounced per subscription: the first
+// usage of an interval starts a workflow that checks the threshold at the end of the interval,
+// the usage tracked until then is covered by the same check. Subscriptions without a billing
+// threshold are remembered for the interval so they are not read again for every event.
+// Failures are logged so they never block usage tracking.
+func (s *featureUsageTrackingService) checkBillingThresholds(ctx context.Context, featureUsage []*events.FeatureUsage) {
+	subscriptionIDs := lo.Uniq(lo.FilterMap(featureUsage, func(fu *events.FeatureUsage, _ int) (string, bool) {
+		return fu.SubscriptionID, fu.SubscriptionID != ""
+	}))
+
+	cacheClient := cache.GetInMemoryCache()
+	for _, subscriptionID := range subscriptionIDs {
+		cacheKey := cache.GenerateKey(cache.PrefixBillingThresholdCheck, types.GetTenantID(ctx), types.GetEnvironmentID(ctx), subscriptionID)
+		if _, found := cacheClient.ForceCacheGet(ctx, cacheKey); found {
+			continue
+		}
+
+		sub, err := s.SubRepo.Get(ctx, subscriptionID)
+		if err != nil {
+			s.Logger.Errorw("failed to get subscription for billing threshold check",
+				"error", err,
+				"subscription_id", subscriptionID,
+			)
+			continue
+		}
+
+		hasThreshold := sub.BillingThresholdAmount != nil
+		if hasThreshold && !s.scheduleBillingThresholdCheck(ctx, subscriptionID) {
+			continue
+		}
+		cacheClient.ForceCacheSet(ctx, cacheKey, hasThreshold, billingThresholdCheckInterval)
+	}
+}
+
+// scheduleBillingThresholdCheck starts the workflow checking the billing threshold of the
+// subscription at the end of the check interval and reports whether it was started
+func (s *featureUsageTrackingService) scheduleBillingThresholdCheck(ctx context.Context, subscriptionID string) bool {
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for billing threshold check",
+			"subscription_id", subscriptionID)
+		return false
+	}
+
+	_, err := temporalSvc.ExecuteWorkflow(
+		ctx,
+		types.TemporalSubscriptionThresholdWorkflow,
+		subscriptionModels.SubscriptionThresholdWorkflowInput{
+			SubscriptionID: subscriptionID,
+			Delay:          billingThresholdCheckInterval,
+		},
+	)
+	if err != nil {
+		s.Logger.Errorw("failed to start subscription threshold workflow",
+			"error", err,
+			"subscription_id", subscriptionID)
+		return false
+	}
+	return true
+}
+
 // Generate a unique hash for deduplication
 // there are 2 cases:
 // 1. event_name + event_id // for non COUNT_UNIQUE aggregation types
@@ -543,6 +670,7 @@ func (s *featureUsageTrackingService) prepareProcessedEvents(ctx context.Context
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, filter)
@@ -986,6 +1114,7 @@ func (s *featureUsageTrackingService) prepareProcessedEventsV2(ctx context.Conte
 	subFilter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptions, err := s.SubRepo.List(ctx, subFilter)
@@ -1901,6 +2030,7 @@ func (s *featureUsageTrackingService) fetchSubscriptions(ctx context.Context, cu
 	filter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 		types.SubscriptionStatusPaused,
 		types.SubscriptionStatusCancelled,
 	}
@@ -3987,6 +4117,7 @@ func (s *featureUsageTrackingService) runDebugTracker(ctx context.Context, event
 	subFilter.SubscriptionStatus = []types.SubscriptionStatus{
 		types.SubscriptionStatusActive,
 		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
 	}
 
 	subscriptionsList, err := subscriptionService.ListSubscriptions(ctx, subFilter)
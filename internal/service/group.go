@@ -13,6 +13,7 @@ import (
 type GroupService interface {
 	CreateGroup(ctx context.Context, req dto.CreateGroupRequest) (*dto.GroupResponse, error)
 	GetGroup(ctx context.Context, id string) (*dto.GroupResponse, error)
+	UpdateGroup(ctx context.Context, id string, req dto.UpdateGroupRequest) (*dto.GroupResponse, error)
 	DeleteGroup(ctx context.Context, id string) error
 	ListGroups(ctx context.Context, filter *types.GroupFilter) (*dto.ListGroupsResponse, error)
 	ValidateGroup(ctx context.Context, id string, entityType types.GroupEntityType) error
@@ -82,6 +83,43 @@ func (s *groupService) GetGroup(ctx context.Context, id string) (*dto.GroupRespo
 	return dto.ToGroupResponseWithEntities(groupObj, entityIDs), nil
 }
 
+// UpdateGroup updates the name and pooled tier configuration of a group
+func (s *groupService) UpdateGroup(ctx context.Context, id string, req dto.UpdateGroupRequest) (*dto.GroupResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	groupObj, err := s.GroupRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		groupObj.Name = *req.Name
+	}
+
+	if req.Pooling != nil {
+		if groupObj.EntityType != types.GroupEntityTypePrice {
+			return nil, ierr.NewError("pooling is only supported for price groups").
+				WithHint("Pooled tiers can only be configured on groups of entity type price").
+				WithReportableDetails(map[string]interface{}{
+					"group_id":    id,
+					"entity_type": groupObj.EntityType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		groupObj.Pooling = req.Pooling
+	} else if req.RemovePooling {
+		groupObj.Pooling = nil
+	}
+
+	if err := s.GroupRepo.Update(ctx, groupObj); err != nil {
+		return nil, err
+	}
+
+	return s.GetGroup(ctx, id)
+}
+
 // DeleteGroup deletes a group (entity associations are automatically removed by foreign key constraint)
 func (s *groupService) DeleteGroup(ctx context.Context, id string) error {
 
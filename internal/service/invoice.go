@@ -40,6 +40,7 @@ type InvoiceService interface {
 	ProcessDraftInvoice(ctx context.Context, id string, paymentParams *dto.PaymentParameters, sub *subscription.Subscription, flowType types.InvoiceFlowType) error
 	UpdatePaymentStatus(ctx context.Context, id string, status types.PaymentStatus, amount *decimal.Decimal) error
 	CreateSubscriptionInvoice(ctx context.Context, req *dto.CreateSubscriptionInvoiceRequest, paymentParams *dto.PaymentParameters, flowType types.InvoiceFlowType, isDraftSubscription bool) (*dto.InvoiceResponse, *subscription.Subscription, error)
+	CreateSubscriptionThresholdInvoice(ctx context.Context, subscriptionID string) (*dto.InvoiceResponse, error)
 	GetPreviewInvoice(ctx context.Context, req dto.GetPreviewInvoiceRequest) (*dto.InvoiceResponse, error)
 	GetCustomerInvoiceSummary(ctx context.Context, customerID string, currency string) (*dto.CustomerInvoiceSummary, error)
 	GetUnpaidInvoicesToBePaid(ctx context.Context, req dto.GetUnpaidInvoicesToBePaidRequest) (*dto.GetUnpaidInvoicesToBePaidResponse, error)
@@ -211,7 +212,7 @@ func (s *invoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoic
 
 		// 5. Create invoice
 		// Convert request to domain model
-		inv, err := req.ToInvoice(ctx)
+		inv, err := req.ToInvoice(types.WithRoundingConfig(ctx, GetRoundingConfig(s.ServiceParams, ctx)))
 		if err != nil {
 			return err
 		}
@@ -220,8 +221,10 @@ func (s *invoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoic
 		inv.IdempotencyKey = &idempKey
 		inv.BillingSequence = billingSeq
 
-		// Set correct billing reason based on billing sequence for subscription invoices
-		if req.SubscriptionID != nil && billingSeq != nil && lo.FromPtr(billingSeq) == 1 {
+		// Set correct billing reason based on billing sequence for subscription invoices. Threshold
+		// invoices keep their reason so that the period end invoice nets out what they billed.
+		if req.SubscriptionID != nil && billingSeq != nil && lo.FromPtr(billingSeq) == 1 &&
+			req.BillingReason != types.InvoiceBillingReasonSubscriptionThreshold {
 			inv.BillingReason = string(types.InvoiceBillingReasonSubscriptionCreate)
 		}
 
@@ -320,7 +323,8 @@ func (s *invoiceService) GetInvoice(ctx context.Context, id string) (*dto.Invoic
 
 	response := dto.NewInvoiceResponse(inv)
 
-	if inv.InvoiceType == types.InvoiceTypeSubscription {
+	// Consolidated invoices belong to no single subscription
+	if inv.InvoiceType == types.InvoiceTypeSubscription && inv.SubscriptionID != nil {
 		subscription, err := subscriptionService.GetSubscription(ctx, *inv.SubscriptionID)
 		if err != nil {
 			return nil, err
@@ -1531,6 +1535,13 @@ func (s *invoiceService) ReconcilePaymentStatus(ctx context.Context, id string,
 		}
 	}
 
+	// A paid invoice makes its past due or unpaid subscription active again
+	if err := NewDunningService(s.ServiceParams).HandleInvoicePaid(ctx, inv); err != nil {
+		s.Logger.Errorw("failed to recover subscription after invoice payment",
+			"error", err,
+			"invoice_id", inv.ID)
+	}
+
 	// Publish webhook events
 	s.publishInternalWebhookEvent(ctx, types.WebhookEventInvoiceUpdatePayment, inv.ID)
 
@@ -1567,13 +1578,18 @@ func (s *invoiceService) CreateSubscriptionInvoice(ctx context.Context, req *dto
 			Mark(ierr.ErrValidation)
 	}
 
-	// Prepare invoice request using billing service
-	invoiceReq, err := billingService.PrepareSubscriptionInvoiceRequest(ctx,
-		subscription,
-		req.PeriodStart,
-		req.PeriodEnd,
-		req.ReferencePoint,
-	)
+	// Prepare invoice request using billing service, invoices of ended periods settle their rollover
+	var invoiceReq *dto.CreateInvoiceRequest
+	if req.ReferencePoint == types.ReferencePointPeriodEnd {
+		invoiceReq, err = billingService.PrepareSubscriptionPeriodInvoiceRequest(ctx, subscription, req.PeriodStart, req.PeriodEnd)
+	} else {
+		invoiceReq, err = billingService.PrepareSubscriptionInvoiceRequest(ctx,
+			subscription,
+			req.PeriodStart,
+			req.PeriodEnd,
+			req.ReferencePoint,
+		)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1600,6 +1616,50 @@ func (s *invoiceService) CreateSubscriptionInvoice(ctx context.Context, req *dto
 	return inv, subscription, nil
 }
 
+// CreateSubscriptionThresholdInvoice generates and charges an interim invoice when the usage accrued
+// in the current period of the subscription exceeds its billing threshold. Nil is returned when no
+// invoice is due or another worker already created it for the same accrual.
+func (s *invoiceService) CreateSubscriptionThresholdInvoice(ctx context.Context, subscriptionID string) (*dto.InvoiceResponse, error) {
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.BillingThresholdAmount == nil || sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil, nil
+	}
+
+	billingService := NewBillingService(s.ServiceParams)
+	invoiceReq, err := billingService.PrepareThresholdInvoiceRequest(ctx, sub, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if invoiceReq == nil {
+		return nil, nil
+	}
+
+	inv, err := s.CreateInvoice(ctx, *invoiceReq)
+	if err != nil {
+		if ierr.IsAlreadyExists(err) {
+			s.Logger.Infow("threshold invoice already created for accrual",
+				"subscription_id", sub.ID)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := s.ProcessDraftInvoice(ctx, inv.ID, nil, sub, types.InvoiceFlowRenewal); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("created threshold invoice",
+		"subscription_id", sub.ID,
+		"invoice_id", inv.ID,
+		"amount", inv.Total)
+
+	return inv, nil
+}
+
 func (s *invoiceService) GetPreviewInvoice(ctx context.Context, req dto.GetPreviewInvoiceRequest) (*dto.InvoiceResponse, error) {
 	billingService := NewBillingService(s.ServiceParams)
 
@@ -1627,7 +1687,7 @@ func (s *invoiceService) GetPreviewInvoice(ctx context.Context, req dto.GetPrevi
 		"invoice_request", invReq)
 
 	// Create a draft invoice object for preview; ToInvoice applies preview discounts and taxes
-	inv, err := invReq.ToInvoice(ctx)
+	inv, err := invReq.ToInvoice(types.WithRoundingConfig(ctx, GetRoundingConfig(s.ServiceParams, ctx)))
 	if err != nil {
 		return nil, err
 	}
@@ -1897,15 +1957,26 @@ func (s *invoiceService) AttemptPayment(ctx context.Context, id string) error {
 	return s.attemptPaymentForSubscriptionInvoice(ctx, inv, nil, nil, types.InvoiceFlowManual)
 }
 
+// getPaymentSubscription returns the subscription whose payment settings apply to an invoice, nil
+// for invoices of no subscription. The subscriptions of a consolidated invoice share their payment
+// settings, so the first of them is returned.
+func (s *invoiceService) getPaymentSubscription(ctx context.Context, inv *invoice.Invoice) (*subscription.Subscription, error) {
+	subscriptionIDs := inv.GetSubscriptionIDs()
+	if len(subscriptionIDs) == 0 {
+		return nil, nil
+	}
+	return s.SubRepo.Get(ctx, subscriptionIDs[0])
+}
+
 func (s *invoiceService) attemptPaymentForSubscriptionInvoice(ctx context.Context, inv *invoice.Invoice, paymentParams *dto.PaymentParameters, sub *subscription.Subscription, flowType types.InvoiceFlowType) error {
 	// Get subscription to access payment settings if not provided
-	if sub == nil && inv.SubscriptionID != nil {
+	if sub == nil {
 		var err error
-		sub, err = s.SubRepo.Get(ctx, *inv.SubscriptionID)
+		sub, err = s.getPaymentSubscription(ctx, inv)
 		if err != nil {
 			s.Logger.Errorw("failed to get subscription for payment processing",
 				"error", err,
-				"subscription_id", *inv.SubscriptionID,
+				"subscription_ids", inv.GetSubscriptionIDs(),
 				"invoice_id", inv.ID)
 			return err
 		}
@@ -2398,13 +2469,14 @@ func (s *invoiceService) RecalculateInvoiceAmounts(ctx context.Context, invoiceI
 	}
 
 	// Calculate total adjustment credits (with currency-aware rounding)
-	inv.AdjustmentAmount = types.RoundToCurrencyPrecision(totalAdjustmentAmount, inv.Currency)
-	inv.RefundedAmount = types.RoundToCurrencyPrecision(totalRefundAmount, inv.Currency)
-	inv.AmountDue = types.RoundToCurrencyPrecision(inv.Total.Sub(inv.AdjustmentAmount), inv.Currency)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	inv.AdjustmentAmount = rounding.RoundAmount(totalAdjustmentAmount, inv.Currency)
+	inv.RefundedAmount = rounding.RoundAmount(totalRefundAmount, inv.Currency)
+	inv.AmountDue = rounding.RoundAmount(inv.Total.Sub(inv.AdjustmentAmount), inv.Currency)
 
 	remaining := inv.AmountDue.Sub(inv.AmountPaid)
 	if remaining.IsPositive() {
-		inv.AmountRemaining = types.RoundToCurrencyPrecision(remaining, inv.Currency)
+		inv.AmountRemaining = rounding.RoundAmount(remaining, inv.Currency)
 	} else {
 		inv.AmountRemaining = decimal.Zero
 	}
@@ -3507,7 +3579,7 @@ func (s *invoiceService) SyncInvoiceToExternalVendors(ctx context.Context, invoi
 		return err
 	}
 
-	sub, err := s.SubRepo.Get(ctx, *invoice.SubscriptionID)
+	sub, err := s.getPaymentSubscription(ctx, invoice)
 	if err != nil {
 		return err
 	}
@@ -3685,6 +3757,7 @@ func (s *invoiceService) DistributeInvoiceLevelDiscount(ctx context.Context, lin
 
 	// Track remaining discount amount
 	remainingDiscount := invoiceDiscountAmount
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
 
 	// First pass - apply to all except last item
 	for i, lineItem := range eligibleItems {
@@ -3698,8 +3771,8 @@ func (s *invoiceService) DistributeInvoiceLevelDiscount(ctx context.Context, lin
 		proportion := amountAfterLineItemDiscount.Div(totalEligibleAmount)
 		lineItemShare := proportion.Mul(invoiceDiscountAmount)
 
-		// Round using currency precision
-		lineItemShare = types.RoundToCurrencyPrecision(lineItemShare, lineItem.Currency)
+		// Round with the line item rounding of the environment
+		lineItemShare = rounding.RoundLineItemAmount(lineItemShare, lineItem.Currency)
 
 		// Cap at line item amount (cannot exceed the eligible amount)
 		if lineItemShare.GreaterThan(amountAfterLineItemDiscount) {
@@ -738,6 +738,13 @@ func (p *paymentProcessor) handleInvoicePostProcessing(ctx context.Context, paym
 			"error", err)
 	}
 
+	// A paid invoice makes its past due or unpaid subscription active again
+	if err := NewDunningService(p.ServiceParams).HandleInvoicePaid(ctx, invoice); err != nil {
+		p.Logger.Errorw("failed to recover subscription after invoice payment",
+			"invoice_id", invoice.ID,
+			"error", err)
+	}
+
 	return nil
 }
 
@@ -255,6 +255,10 @@ func (s *planService) UpdatePlan(ctx context.Context, id string, req dto.UpdateP
 			Mark(ierr.ErrValidation)
 	}
 
+	if err := validatePlanVersionEditable(ctx, s.ServiceParams, id); err != nil {
+		return nil, err
+	}
+
 	// Get the existing plan
 	planResponse, err := s.GetPlan(ctx, id)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
@@ -159,6 +160,77 @@ func (s *PlanServiceSuite) TestUpdatePlan() {
 	s.Equal(*req.Name, resp.Plan.Name)
 }
 
+func (s *PlanServiceSuite) TestPublishPlanVersion() {
+	ctx := s.GetContext()
+
+	p := &plan.Plan{
+		ID:        "plan-versioned",
+		Name:      "Pro",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, p))
+
+	basePrice := &price.Price{
+		ID:                 "price-versioned",
+		Amount:             decimal.NewFromInt(10),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           p.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		LookupKey:          "pro_monthly",
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, basePrice))
+
+	// A plan that was never versioned can be edited in place
+	_, err := s.service.UpdatePlan(ctx, p.ID, dto.UpdatePlanRequest{Description: lo.ToPtr("Pro plan")})
+	s.NoError(err)
+
+	draft, err := s.service.CreatePlanVersion(ctx, p.ID)
+	s.NoError(err)
+
+	// The published version is changed through the draft
+	_, err = s.service.UpdatePlan(ctx, p.ID, dto.UpdatePlanRequest{Name: lo.ToPtr("Pro v1")})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	priceService := NewPriceService(s.params)
+	_, err = priceService.UpdatePrice(ctx, basePrice.ID, dto.UpdatePriceRequest{Description: "Base fee"})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	_, err = s.service.UpdatePlan(ctx, draft.Plan.ID, dto.UpdatePlanRequest{Name: lo.ToPtr("Pro v2")})
+	s.NoError(err)
+
+	_, err = s.service.PublishPlanVersion(ctx, draft.Plan.ID)
+	s.NoError(err)
+
+	// The lookup key of the price moves to the copy of the published version
+	prices, err := s.GetStores().PriceRepo.ListAll(ctx, types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{p.ID, draft.Plan.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN))
+	s.NoError(err)
+	s.Len(prices, 2)
+	for _, pr := range prices {
+		if pr.EntityID == draft.Plan.ID {
+			s.Equal("pro_monthly", pr.LookupKey)
+			s.Equal(basePrice.ID, pr.ParentPriceID)
+		} else {
+			s.Empty(pr.LookupKey)
+		}
+	}
+
+	// The archived version can no longer be edited
+	_, err = s.service.UpdatePlan(ctx, p.ID, dto.UpdatePlanRequest{Name: lo.ToPtr("Pro v1")})
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
 func (s *PlanServiceSuite) TestDeletePlan() {
 	// Create a plan
 	plan := &plan.Plan{ID: "plan-1", Name: "Plan to Delete"}
@@ -0,0 +1,691 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// CreatePlanVersion creates a draft version of a plan from the given version. The draft copies
+// the prices, entitlements and credit grants of the version and can be edited through the
+// regular plan, price and entitlement APIs without affecting existing subscribers.
+func (s *planService) CreatePlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error) {
+	source, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.listPlanVersions(ctx, source.GetBasePlanID())
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersion := 0
+	for _, v := range versions {
+		if v.IsDraft() {
+			return nil, ierr.NewError("plan already has a draft version").
+				WithHint("Publish or archive the existing draft version before creating a new one").
+				WithReportableDetails(map[string]interface{}{
+					"plan_id":       planID,
+					"draft_plan_id": v.ID,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+		latestVersion = lo.Max([]int{latestVersion, v.GetVersion()})
+	}
+
+	draft := &plan.Plan{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLAN),
+		Name:          source.Name,
+		Description:   source.Description,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		Metadata:      source.Metadata,
+		DisplayOrder:  source.DisplayOrder,
+		Version:       latestVersion + 1,
+		VersionStatus: types.PlanVersionStatusDraft,
+		BasePlanID:    lo.ToPtr(source.GetBasePlanID()),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+
+	err = s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.PlanRepo.Create(txCtx, draft); err != nil {
+			return err
+		}
+		return s.copyPlanVersionItems(txCtx, source, draft)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("created plan version",
+		"plan_id", draft.ID,
+		"source_plan_id", source.ID,
+		"base_plan_id", draft.GetBasePlanID(),
+		"version", draft.Version)
+
+	return s.GetPlan(ctx, draft.ID)
+}
+
+// copyPlanVersionItems copies the active prices, entitlements and credit grants of a plan version
+// to another version. Copied prices keep the root price of their source so that versions can be
+// compared price by price.
+func (s *planService) copyPlanVersionItems(ctx context.Context, source, target *plan.Plan) error {
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{source.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN)
+	prices, err := s.PriceRepo.ListAll(ctx, priceFilter)
+	if err != nil {
+		return err
+	}
+
+	if len(prices) > 0 {
+		copies := make([]*price.Price, 0, len(prices))
+		for _, p := range prices {
+			c := *p
+			c.ID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE)
+			c.EntityID = target.ID
+			// lookup keys are unique among published prices and stay with the source version
+			c.LookupKey = ""
+			c.ParentPriceID = p.GetRootPriceID()
+			c.EnvironmentID = types.GetEnvironmentID(ctx)
+			c.BaseModel = types.GetDefaultBaseModel(ctx)
+			copies = append(copies, &c)
+		}
+		if err := s.PriceRepo.CreateBulk(ctx, copies); err != nil {
+			return err
+		}
+	}
+
+	entitlements, err := s.EntitlementRepo.ListByPlanIDs(ctx, []string{source.ID})
+	if err != nil {
+		return err
+	}
+
+	if len(entitlements) > 0 {
+		copies := make([]*entitlement.Entitlement, 0, len(entitlements))
+		for _, e := range entitlements {
+			c := *e
+			c.ID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_ENTITLEMENT)
+			c.EntityID = target.ID
+			c.EnvironmentID = types.GetEnvironmentID(ctx)
+			c.BaseModel = types.GetDefaultBaseModel(ctx)
+			copies = append(copies, &c)
+		}
+		if _, err := s.EntitlementRepo.CreateBulk(ctx, copies); err != nil {
+			return err
+		}
+	}
+
+	creditGrants, err := s.CreditGrantRepo.GetByPlan(ctx, source.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range creditGrants {
+		c := *g
+		c.ID = types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CREDIT_GRANT)
+		c.PlanID = lo.ToPtr(target.ID)
+		c.EnvironmentID = types.GetEnvironmentID(ctx)
+		c.BaseModel = types.GetDefaultBaseModel(ctx)
+		if _, err := s.CreditGrantRepo.Create(ctx, &c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PublishPlanVersion publishes a draft version. The previously published version is archived:
+// its subscriptions stay on it until they are migrated, and its lookup key and the lookup keys of
+// its prices move to the new version.
+func (s *planService) PublishPlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error) {
+	draft, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !draft.IsDraft() {
+		return nil, ierr.NewError("only draft plan versions can be published").
+			WithHint("Create a new version of the plan to make changes").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id":        planID,
+				"version_status": draft.GetVersionStatus(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	versions, err := s.listPlanVersions(ctx, draft.GetBasePlanID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.DB.WithTx(ctx, func(txCtx context.Context) error {
+		for _, v := range versions {
+			if v.ID == draft.ID || v.GetVersionStatus() != types.PlanVersionStatusPublished {
+				continue
+			}
+
+			// The lookup key is unique among published plans, so it is released before it moves
+			if v.LookupKey != "" && draft.LookupKey == "" {
+				draft.LookupKey = v.LookupKey
+			}
+			v.LookupKey = ""
+			v.VersionStatus = types.PlanVersionStatusArchived
+			if err := s.PlanRepo.Update(txCtx, v); err != nil {
+				return err
+			}
+
+			if err := s.movePlanVersionPriceLookupKeys(txCtx, v, draft); err != nil {
+				return err
+			}
+		}
+
+		draft.VersionStatus = types.PlanVersionStatusPublished
+		return s.PlanRepo.Update(txCtx, draft)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("published plan version",
+		"plan_id", draft.ID,
+		"base_plan_id", draft.GetBasePlanID(),
+		"version", draft.GetVersion())
+
+	return s.GetPlan(ctx, draft.ID)
+}
+
+// movePlanVersionPriceLookupKeys moves the lookup keys of the prices of a version to the prices of
+// another version that share their root price. Lookup keys are unique among published prices, so
+// all keys are released before they are set on the target prices. Target prices that already have
+// a lookup key keep it.
+func (s *planService) movePlanVersionPriceLookupKeys(ctx context.Context, source, target *plan.Plan) error {
+	prices, err := s.PriceRepo.ListAll(ctx, types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{source.ID, target.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN))
+	if err != nil {
+		return err
+	}
+
+	lookupKeys := make(map[string]string)
+	for _, p := range prices {
+		if p.EntityID != source.ID || p.LookupKey == "" {
+			continue
+		}
+		lookupKeys[p.GetRootPriceID()] = p.LookupKey
+		p.LookupKey = ""
+		if err := s.PriceRepo.Update(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range prices {
+		if p.EntityID != target.ID || p.LookupKey != "" {
+			continue
+		}
+		lookupKey, ok := lookupKeys[p.GetRootPriceID()]
+		if !ok {
+			continue
+		}
+		p.LookupKey = lookupKey
+		if err := s.PriceRepo.Update(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ArchivePlanVersion archives a draft or published version. Subscriptions pinned to the version
+// keep running on it, but no new subscriptions can be created on it.
+func (s *planService) ArchivePlanVersion(ctx context.Context, planID string) (*dto.PlanResponse, error) {
+	p, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetVersionStatus() == types.PlanVersionStatusArchived {
+		return nil, ierr.NewError("plan version is already archived").
+			WithHint("The plan version is already archived").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id": planID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	p.VersionStatus = types.PlanVersionStatusArchived
+	if err := s.PlanRepo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return s.GetPlan(ctx, p.ID)
+}
+
+// ListPlanVersions lists all versions of the plan the given version belongs to, oldest first
+func (s *planService) ListPlanVersions(ctx context.Context, planID string) (*dto.ListPlansResponse, error) {
+	p, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := types.NewNoLimitPlanFilter()
+	filter.BasePlanID = p.GetBasePlanID()
+	filter.QueryFilter.Order = lo.ToPtr("asc")
+
+	return s.GetPlans(ctx, filter)
+}
+
+// DiffPlanVersions compares the prices and entitlements of two versions of a plan
+func (s *planService) DiffPlanVersions(ctx context.Context, planID string, targetPlanID string) (*dto.PlanVersionDiffResponse, error) {
+	source, target, err := s.getPlanVersionPair(ctx, planID, targetPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{source.ID, target.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN)
+	prices, err := s.PriceRepo.ListAll(ctx, priceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements, err := s.EntitlementRepo.ListByPlanIDs(ctx, []string{source.ID, target.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePrices := lo.Filter(prices, func(p *price.Price, _ int) bool { return p.EntityID == source.ID })
+	targetPrices := lo.Filter(prices, func(p *price.Price, _ int) bool { return p.EntityID == target.ID })
+	sourceEntitlements := lo.Filter(entitlements, func(e *entitlement.Entitlement, _ int) bool { return e.EntityID == source.ID })
+	targetEntitlements := lo.Filter(entitlements, func(e *entitlement.Entitlement, _ int) bool { return e.EntityID == target.ID })
+
+	return &dto.PlanVersionDiffResponse{
+		SourcePlanID:  source.ID,
+		SourceVersion: source.GetVersion(),
+		TargetPlanID:  target.ID,
+		TargetVersion: target.GetVersion(),
+		Prices:        diffPlanVersionPrices(sourcePrices, targetPrices),
+		Entitlements:  diffPlanVersionEntitlements(sourceEntitlements, targetEntitlements),
+	}, nil
+}
+
+// MigratePlanVersion moves subscriptions from a plan version to another version of the same plan.
+// The plan line items of the source version end at the effective date and line items for the
+// prices of the target version start at it. Quantities carry over between prices that share a
+// root price. Subscription specific price overrides are not carried over.
+func (s *planService) MigratePlanVersion(ctx context.Context, planID string, req dto.MigratePlanVersionRequest) (*dto.MigratePlanVersionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, target, err := s.getPlanVersionPair(ctx, planID, req.TargetPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.IsSubscribable() {
+		return nil, ierr.NewError("subscriptions can only be migrated to a published plan version").
+			WithHint("Publish the target version before migrating subscriptions to it").
+			WithReportableDetails(map[string]interface{}{
+				"target_plan_id": target.ID,
+				"version_status": target.GetVersionStatus(),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	effectiveDate := lo.FromPtrOr(req.EffectiveDate, time.Now()).UTC()
+
+	subscriptionFilter := types.NewNoLimitSubscriptionFilter()
+	subscriptionFilter.PlanID = source.ID
+	subscriptionFilter.SubscriptionIDs = req.SubscriptionIDs
+	subscriptionFilter.SubscriptionStatus = []types.SubscriptionStatus{types.SubscriptionStatusActive, types.SubscriptionStatusPastDue}
+	subs, err := s.SubRepo.ListAll(ctx, subscriptionFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{target.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN)
+	targetPrices, err := s.PriceRepo.ListAll(ctx, priceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.MigratePlanVersionResponse{
+		SourcePlanID:  source.ID,
+		TargetPlanID:  target.ID,
+		EffectiveDate: effectiveDate,
+		Results:       make([]dto.PlanVersionMigrationResult, 0, len(subs)),
+	}
+
+	// Subscriptions that were requested but are not active on the source version
+	for _, id := range lo.Without(req.SubscriptionIDs, lo.Map(subs, func(sub *subscription.Subscription, _ int) string { return sub.ID })...) {
+		response.Results = append(response.Results, dto.PlanVersionMigrationResult{
+			SubscriptionID: id,
+			Error:          "subscription is not an active subscription of the source plan version",
+		})
+		response.Failed++
+	}
+
+	for _, sub := range subs {
+		result := dto.PlanVersionMigrationResult{SubscriptionID: sub.ID}
+
+		err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
+			return s.migrateSubscriptionPlanVersion(txCtx, sub, source, target, targetPrices, effectiveDate, &result)
+		})
+		if err != nil {
+			s.Logger.Errorw("failed to migrate subscription to plan version",
+				"subscription_id", sub.ID,
+				"source_plan_id", source.ID,
+				"target_plan_id", target.ID,
+				"error", err)
+			response.Results = append(response.Results, dto.PlanVersionMigrationResult{
+				SubscriptionID: sub.ID,
+				Error:          err.Error(),
+			})
+			response.Failed++
+			continue
+		}
+
+		result.Migrated = true
+		response.Results = append(response.Results, result)
+		response.Migrated++
+
+		NewSubscriptionService(s.ServiceParams).(*subscriptionService).
+			publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+	}
+
+	s.Logger.Infow("migrated subscriptions to plan version",
+		"source_plan_id", source.ID,
+		"target_plan_id", target.ID,
+		"effective_date", effectiveDate,
+		"migrated", response.Migrated,
+		"failed", response.Failed)
+
+	return response, nil
+}
+
+func (s *planService) migrateSubscriptionPlanVersion(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	source, target *plan.Plan,
+	targetPrices []*price.Price,
+	effectiveDate time.Time,
+	result *dto.PlanVersionMigrationResult,
+) error {
+	if effectiveDate.Before(sub.CurrentPeriodStart) {
+		return ierr.NewError("effective date is before the current period of the subscription").
+			WithHint("The effective date must be within or after the current billing period").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id":      sub.ID,
+				"current_period_start": sub.CurrentPeriodStart,
+				"effective_date":       effectiveDate,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	lineItems, err := s.SubscriptionLineItemRepo.ListBySubscription(ctx, sub)
+	if err != nil {
+		return err
+	}
+
+	sourcePrices, err := s.PriceRepo.ListAll(ctx, types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{source.ID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_PLAN).
+		WithAllowExpiredPrices(true))
+	if err != nil {
+		return err
+	}
+	rootPriceIDs := lo.SliceToMap(sourcePrices, func(p *price.Price) (string, string) {
+		return p.ID, p.GetRootPriceID()
+	})
+
+	subscriptionService := NewSubscriptionService(s.ServiceParams)
+
+	// End the line items of the source version, remembering quantities per root price
+	quantities := make(map[string]*subscription.SubscriptionLineItem)
+	for _, item := range lineItems {
+		if item.EntityType != types.SubscriptionLineItemEntityTypePlan || item.EntityID != source.ID {
+			continue
+		}
+		if !item.EndDate.IsZero() && !item.EndDate.After(effectiveDate) {
+			continue
+		}
+
+		if rootPriceID, ok := rootPriceIDs[item.PriceID]; ok {
+			quantities[rootPriceID] = item
+		}
+
+		endDate := lo.Ternary(item.StartDate.After(effectiveDate), item.StartDate, effectiveDate)
+		if _, err := subscriptionService.DeleteSubscriptionLineItem(ctx, item.ID, dto.DeleteSubscriptionLineItemRequest{
+			EffectiveFrom: lo.ToPtr(endDate),
+		}); err != nil {
+			return err
+		}
+		result.LineItemsTerminated++
+	}
+
+	sub.PlanID = target.ID
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	for _, p := range targetPrices {
+		if !p.IsEligibleForSubscription(sub.Currency, sub.BillingPeriod, sub.BillingPeriodCount) {
+			continue
+		}
+
+		createReq := dto.CreateSubscriptionLineItemRequest{
+			PriceID:              p.ID,
+			StartDate:            lo.ToPtr(effectiveDate),
+			Quantity:             p.GetDefaultQuantity(),
+			SkipEntitlementCheck: true,
+			Metadata: map[string]string{
+				"added_by":       "plan_version_migration",
+				"source_plan_id": source.ID,
+			},
+		}
+		if previous, ok := quantities[p.GetRootPriceID()]; ok && p.Type == types.PRICE_TYPE_FIXED {
+			createReq.Quantity = previous.QuantityAt(effectiveDate)
+		}
+
+		if _, err := subscriptionService.AddSubscriptionLineItem(ctx, sub.ID, createReq); err != nil {
+			return err
+		}
+		result.LineItemsCreated++
+	}
+
+	return nil
+}
+
+// validatePlanVersionEditable checks that the plan, its prices and its entitlements can be edited.
+// Draft versions can be edited freely. Published and archived versions of a versioned plan serve
+// subscriptions and are changed through a new draft version, plans that were never versioned can
+// still be edited in place. Items of a plan that no longer exists are not guarded.
+func validatePlanVersionEditable(ctx context.Context, params ServiceParams, planID string) error {
+	p, err := params.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if p.IsDraft() {
+		return nil
+	}
+
+	versioned := p.BasePlanID != nil || p.GetVersionStatus() == types.PlanVersionStatusArchived
+	if !versioned {
+		filter := types.NewNoLimitPlanFilter()
+		filter.BasePlanID = p.ID
+		count, err := params.PlanRepo.Count(ctx, filter)
+		if err != nil {
+			return err
+		}
+		versioned = count > 1
+	}
+	if !versioned {
+		return nil
+	}
+
+	return ierr.NewError("only draft plan versions can be edited").
+		WithHint("Create a new version of the plan to make changes").
+		WithReportableDetails(map[string]interface{}{
+			"plan_id":        p.ID,
+			"version":        p.GetVersion(),
+			"version_status": p.GetVersionStatus(),
+		}).
+		Mark(ierr.ErrValidation)
+}
+
+// getPlanVersionPair returns two versions of the same plan
+func (s *planService) getPlanVersionPair(ctx context.Context, planID, otherPlanID string) (*plan.Plan, *plan.Plan, error) {
+	source, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	other, err := s.PlanRepo.Get(ctx, otherPlanID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if source.ID == other.ID || source.GetBasePlanID() != other.GetBasePlanID() {
+		return nil, nil, ierr.NewError("plans are not different versions of the same plan").
+			WithHint("Provide two different versions of the same plan").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id":        source.ID,
+				"target_plan_id": other.ID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return source, other, nil
+}
+
+func (s *planService) listPlanVersions(ctx context.Context, basePlanID string) ([]*plan.Plan, error) {
+	filter := types.NewNoLimitPlanFilter()
+	filter.BasePlanID = basePlanID
+	return s.PlanRepo.ListAll(ctx, filter)
+}
+
+// diffPlanVersionPrices matches the prices of two versions by their root price and reports the
+// added, removed and changed prices
+func diffPlanVersionPrices(source, target []*price.Price) []dto.PlanVersionPriceDiff {
+	sourceByRoot := lo.SliceToMap(source, func(p *price.Price) (string, *price.Price) { return p.GetRootPriceID(), p })
+	targetByRoot := lo.SliceToMap(target, func(p *price.Price) (string, *price.Price) { return p.GetRootPriceID(), p })
+
+	diffs := make([]dto.PlanVersionPriceDiff, 0, len(sourceByRoot)+len(targetByRoot))
+	for _, root := range sortedKeys(sourceByRoot) {
+		s := sourceByRoot[root]
+		t, ok := targetByRoot[root]
+		if !ok {
+			diffs = append(diffs, dto.PlanVersionPriceDiff{
+				Change:        types.PlanVersionChangeRemoved,
+				SourcePriceID: s.ID,
+				Source:        &dto.PriceResponse{Price: s},
+			})
+			continue
+		}
+
+		fields := planVersionPriceFields(s, t)
+		diffs = append(diffs, dto.PlanVersionPriceDiff{
+			Change:        lo.Ternary(len(fields) > 0, types.PlanVersionChangeChanged, types.PlanVersionChangeUnchanged),
+			SourcePriceID: s.ID,
+			TargetPriceID: t.ID,
+			Fields:        fields,
+			Source:        &dto.PriceResponse{Price: s},
+			Target:        &dto.PriceResponse{Price: t},
+		})
+	}
+
+	for _, root := range sortedKeys(targetByRoot) {
+		if _, ok := sourceByRoot[root]; ok {
+			continue
+		}
+		t := targetByRoot[root]
+		diffs = append(diffs, dto.PlanVersionPriceDiff{
+			Change:        types.PlanVersionChangeAdded,
+			TargetPriceID: t.ID,
+			Target:        &dto.PriceResponse{Price: t},
+		})
+	}
+
+	return diffs
+}
+
+// planVersionPriceFields returns the fields that differ between two versions of a price. Lookup
+// keys are ignored since they stay with the version that was published first.
+func planVersionPriceFields(source, target *price.Price) []string {
+	s := dto.NewCatalogPrice(source, source.MeterID)
+	t := dto.NewCatalogPrice(target, target.MeterID)
+	s.LookupKey, t.LookupKey = "", ""
+
+	fields := catalogDiff(s, t)
+	for i, f := range fields {
+		if f == "feature_lookup_key" {
+			fields[i] = "meter_id"
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// diffPlanVersionEntitlements matches the entitlements of two versions by feature and reports the
+// added, removed and changed entitlements
+func diffPlanVersionEntitlements(source, target []*entitlement.Entitlement) []dto.PlanVersionEntitlementDiff {
+	sourceByFeature := lo.SliceToMap(source, func(e *entitlement.Entitlement) (string, *entitlement.Entitlement) { return e.FeatureID, e })
+	targetByFeature := lo.SliceToMap(target, func(e *entitlement.Entitlement) (string, *entitlement.Entitlement) { return e.FeatureID, e })
+
+	diffs := make([]dto.PlanVersionEntitlementDiff, 0, len(sourceByFeature)+len(targetByFeature))
+	for _, featureID := range sortedKeys(sourceByFeature) {
+		s := sourceByFeature[featureID]
+		t, ok := targetByFeature[featureID]
+		if !ok {
+			diffs = append(diffs, dto.PlanVersionEntitlementDiff{
+				Change:              types.PlanVersionChangeRemoved,
+				FeatureID:           featureID,
+				SourceEntitlementID: s.ID,
+				Source:              dto.EntitlementToResponse(s),
+			})
+			continue
+		}
+
+		fields := catalogDiff(dto.NewCatalogEntitlement(s, featureID), dto.NewCatalogEntitlement(t, featureID))
+		diffs = append(diffs, dto.PlanVersionEntitlementDiff{
+			Change:              lo.Ternary(len(fields) > 0, types.PlanVersionChangeChanged, types.PlanVersionChangeUnchanged),
+			FeatureID:           featureID,
+			SourceEntitlementID: s.ID,
+			TargetEntitlementID: t.ID,
+			Fields:              fields,
+			Source:              dto.EntitlementToResponse(s),
+			Target:              dto.EntitlementToResponse(t),
+		})
+	}
+
+	for _, featureID := range sortedKeys(targetByFeature) {
+		if _, ok := sourceByFeature[featureID]; ok {
+			continue
+		}
+		t := targetByFeature[featureID]
+		diffs = append(diffs, dto.PlanVersionEntitlementDiff{
+			Change:              types.PlanVersionChangeAdded,
+			FeatureID:           featureID,
+			TargetEntitlementID: t.ID,
+			Target:              dto.EntitlementToResponse(t),
+		})
+	}
+
+	return diffs
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffPlanVersionPrices(t *testing.T) {
+	newPrice := func(id, parentID, amount string) *price.Price {
+		return &price.Price{
+			ID:            id,
+			ParentPriceID: parentID,
+			Amount:        decimal.RequireFromString(amount),
+			Currency:      "usd",
+			Type:          types.PRICE_TYPE_FIXED,
+			BillingModel:  types.BILLING_MODEL_FLAT_FEE,
+			BillingPeriod: types.BILLING_PERIOD_MONTHLY,
+		}
+	}
+
+	base := newPrice("price_base", "", "10")
+	base.LookupKey = "pro_base"
+	seats := newPrice("price_seats", "", "5")
+	support := newPrice("price_support", "", "100")
+
+	// The target version copies base and seats, changes the seat price and drops support
+	baseCopy := newPrice("price_base_v2", "price_base", "10.00")
+	seatsCopy := newPrice("price_seats_v2", "price_seats", "6")
+	usage := newPrice("price_usage", "", "0.01")
+	usage.Type = types.PRICE_TYPE_USAGE
+	usage.MeterID = "meter_1"
+
+	diffs := diffPlanVersionPrices(
+		[]*price.Price{base, seats, support},
+		[]*price.Price{baseCopy, seatsCopy, usage},
+	)
+	require.Len(t, diffs, 4)
+
+	byChange := lo.GroupBy(diffs, func(d dto.PlanVersionPriceDiff) types.PlanVersionChange { return d.Change })
+	require.Len(t, byChange[types.PlanVersionChangeUnchanged], 1)
+	assert.Equal(t, "price_base_v2", byChange[types.PlanVersionChangeUnchanged][0].TargetPriceID)
+
+	require.Len(t, byChange[types.PlanVersionChangeChanged], 1)
+	assert.Equal(t, "price_seats", byChange[types.PlanVersionChangeChanged][0].SourcePriceID)
+	assert.Equal(t, []string{"amount"}, byChange[types.PlanVersionChangeChanged][0].Fields)
+
+	require.Len(t, byChange[types.PlanVersionChangeRemoved], 1)
+	assert.Equal(t, "price_support", byChange[types.PlanVersionChangeRemoved][0].SourcePriceID)
+
+	require.Len(t, byChange[types.PlanVersionChangeAdded], 1)
+	assert.Equal(t, "price_usage", byChange[types.PlanVersionChangeAdded][0].TargetPriceID)
+}
+
+func TestPlanVersionPriceFields_Meter(t *testing.T) {
+	source := &price.Price{ID: "price_1", Type: types.PRICE_TYPE_USAGE, MeterID: "meter_1", Amount: decimal.NewFromInt(1)}
+	target := &price.Price{ID: "price_2", ParentPriceID: "price_1", Type: types.PRICE_TYPE_USAGE, MeterID: "meter_2", Amount: decimal.NewFromInt(1)}
+
+	assert.Equal(t, []string{"meter_id"}, planVersionPriceFields(source, target))
+}
+
+func TestDiffPlanVersionEntitlements(t *testing.T) {
+	source := []*entitlement.Entitlement{
+		{ID: "ent_1", FeatureID: "feat_api", FeatureType: types.FeatureTypeMetered, IsEnabled: true, UsageLimit: lo.ToPtr(int64(1000))},
+		{ID: "ent_2", FeatureID: "feat_sso", FeatureType: types.FeatureTypeBoolean, IsEnabled: true},
+	}
+	target := []*entitlement.Entitlement{
+		{ID: "ent_3", FeatureID: "feat_api", FeatureType: types.FeatureTypeMetered, IsEnabled: true, UsageLimit: lo.ToPtr(int64(5000))},
+		{ID: "ent_4", FeatureID: "feat_seats", FeatureType: types.FeatureTypeStatic, IsEnabled: true, StaticValue: "10"},
+	}
+
+	diffs := diffPlanVersionEntitlements(source, target)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, types.PlanVersionChangeChanged, diffs[0].Change)
+	assert.Equal(t, "feat_api", diffs[0].FeatureID)
+	assert.Equal(t, []string{"usage_limit"}, diffs[0].Fields)
+
+	assert.Equal(t, types.PlanVersionChangeRemoved, diffs[1].Change)
+	assert.Equal(t, "feat_sso", diffs[1].FeatureID)
+
+	assert.Equal(t, types.PlanVersionChangeAdded, diffs[2].Change)
+	assert.Equal(t, "feat_seats", diffs[2].FeatureID)
+}
@@ -23,6 +23,12 @@ type PriceService interface {
 	GetPricesBySubscriptionID(ctx context.Context, subscriptionID string) (*dto.ListPricesResponse, error)
 	GetPricesByAddonID(ctx context.Context, addonID string) (*dto.ListPricesResponse, error)
 	GetPricesByCostsheetID(ctx context.Context, costsheetID string) (*dto.ListPricesResponse, error)
+
+	// GetPricesByCustomerID returns the rate card of a customer
+	GetPricesByCustomerID(ctx context.Context, customerID string) (*dto.ListPricesResponse, error)
+
+	// CreateRateCardPrice adds a negotiated price to the rate card of a customer
+	CreateRateCardPrice(ctx context.Context, customerID string, req dto.CreateRateCardPriceRequest) (*dto.PriceResponse, error)
 	GetPrices(ctx context.Context, filter *types.PriceFilter) (*dto.ListPricesResponse, error)
 	UpdatePrice(ctx context.Context, id string, req dto.UpdatePriceRequest) (*dto.PriceResponse, error)
 	DeletePrice(ctx context.Context, id string, req dto.DeletePriceRequest) error
@@ -39,6 +45,12 @@ type PriceService interface {
 	// specifically for costsheet calculations
 	CalculateCostSheetPrice(ctx context.Context, price *price.Price, quantity decimal.Decimal) decimal.Decimal
 
+	// CalculateCostPlusBreakdown rates a COST_PLUS price and returns its cost basis from the active costsheet
+	CalculateCostPlusBreakdown(ctx context.Context, price *price.Price, quantities ...decimal.Decimal) (*types.CostPlusBreakdown, error)
+
+	// GetCostBasisPrice returns the price of the meter of a COST_PLUS price in the active costsheet
+	GetCostBasisPrice(ctx context.Context, price *price.Price) (*price.Price, error)
+
 	GetByLookupKey(ctx context.Context, lookupKey string) (*dto.PriceResponse, error)
 }
 
@@ -61,6 +73,12 @@ func (s *priceService) CreatePrice(ctx context.Context, req dto.CreatePriceReque
 			return nil, err
 		}
 
+		if req.EntityType == types.PRICE_ENTITY_TYPE_PLAN {
+			if err := validatePlanVersionEditable(ctx, s.ServiceParams, req.EntityID); err != nil {
+				return nil, err
+			}
+		}
+
 		// Validate that the entity has less than 1000 active prices
 		filter := types.NewNoLimitPriceFilter().
 			WithEntityIDs([]string{req.EntityID}).
@@ -144,6 +162,13 @@ func (s *priceService) preparePriceForCreation(ctx context.Context, req *dto.Cre
 
 	}
 
+	// Cost plus prices are rated from the active costsheet, which must already have a cost for the meter
+	if p.BillingModel == types.BILLING_MODEL_COST_PLUS {
+		if err := s.validateCostPlusPrice(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+
 	// Apply price unit conversion if price type is CUSTOM
 	// This converts amounts/tiers and updates the Price object
 	if p.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
@@ -236,6 +261,16 @@ func (s *priceService) validateEntityExists(ctx context.Context, entityType type
 				}).
 				Mark(ierr.ErrNotFound)
 		}
+	case types.PRICE_ENTITY_TYPE_CUSTOMER:
+		customer, err := s.CustomerRepo.Get(ctx, entityID)
+		if err != nil || customer == nil {
+			return ierr.NewError("customer not found").
+				WithHint("The specified customer does not exist").
+				WithReportableDetails(map[string]interface{}{
+					"customer_id": entityID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
 	case types.PRICE_ENTITY_TYPE_COSTSHEET:
 		costsheet, err := s.CostSheetRepo.GetByID(ctx, entityID)
 		if err != nil || costsheet == nil {
@@ -281,6 +316,12 @@ func (s *priceService) validateBulkPriceEntityLimits(ctx context.Context, items
 			continue
 		}
 
+		if entityType == types.PRICE_ENTITY_TYPE_PLAN {
+			if err := validatePlanVersionEditable(ctx, s.ServiceParams, entityID); err != nil {
+				return err
+			}
+		}
+
 		// Count existing active prices for this entity
 		filter := types.NewNoLimitPriceFilter().
 			WithEntityIDs([]string{entityID}).
@@ -497,6 +538,29 @@ func (s *priceService) GetPricesBySubscriptionID(ctx context.Context, subscripti
 	return response, nil
 }
 
+// GetPricesByCustomerID fetches the rate card prices of a customer, including expired rates
+func (s *priceService) GetPricesByCustomerID(ctx context.Context, customerID string) (*dto.ListPricesResponse, error) {
+	if customerID == "" {
+		return nil, ierr.NewError("customer_id is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation)
+	}
+
+	priceFilter := types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{customerID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_CUSTOMER).
+		WithStatus(types.StatusPublished).
+		WithAllowExpiredPrices(true).
+		WithExpand(string(types.ExpandMeters))
+
+	response, err := s.GetPrices(ctx, priceFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func (s *priceService) GetPricesByAddonID(ctx context.Context, addonID string) (*dto.ListPricesResponse, error) {
 
 	if addonID == "" {
@@ -763,6 +827,12 @@ func (s *priceService) UpdatePrice(ctx context.Context, id string, req dto.Updat
 		return nil, err
 	}
 
+	if existingPrice.EntityType == types.PRICE_ENTITY_TYPE_PLAN {
+		if err := validatePlanVersionEditable(ctx, s.ServiceParams, existingPrice.EntityID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate price unit type cannot be changed
 	hasFIATFields := req.Amount != nil || len(req.Tiers) > 0
 	hasCUSTOMFields := req.PriceUnitAmount != nil || len(req.PriceUnitTiers) > 0
@@ -921,6 +991,12 @@ func (s *priceService) DeletePrice(ctx context.Context, id string, req dto.Delet
 		return err
 	}
 
+	if price.EntityType == types.PRICE_ENTITY_TYPE_PLAN {
+		if err := validatePlanVersionEditable(ctx, s.ServiceParams, price.EntityID); err != nil {
+			return err
+		}
+	}
+
 	// Check if price is already terminated
 	if price.EndDate != nil {
 		return ierr.NewError("price is already terminated").
@@ -967,7 +1043,10 @@ func (s *priceService) calculateBucketedMaxCost(ctx context.Context, price *pric
 	totalCost := decimal.Zero
 
 	// For tiered pricing, handle each bucket's max value according to tier mode
-	if price.BillingModel == types.BILLING_MODEL_TIERED {
+	if price.BillingModel == types.BILLING_MODEL_COST_PLUS {
+		// Each bucket is costed independently, the markup applies to the total cost
+		totalCost = s.calculateCostPlusCost(ctx, price, bucketedValues...)
+	} else if price.BillingModel == types.BILLING_MODEL_TIERED {
 		// Process each bucket's max value independently through its appropriate tier
 		for _, maxValue := range bucketedValues {
 			bucketCost := s.calculateTieredCost(ctx, price, maxValue)
@@ -981,7 +1060,7 @@ func (s *priceService) calculateBucketedMaxCost(ctx context.Context, price *pric
 		}
 	}
 
-	return totalCost.Round(types.GetCurrencyPrecision(price.Currency))
+	return GetRoundingConfig(s.ServiceParams, ctx).RoundLineItemAmount(totalCost, price.Currency)
 }
 
 // calculateSingletonCost calculates cost for a single value
@@ -1017,6 +1096,9 @@ func (s *priceService) calculateSingletonCost(ctx context.Context, price *price.
 
 	case types.BILLING_MODEL_TIERED:
 		cost = s.calculateTieredCost(ctx, price, quantity)
+
+	case types.BILLING_MODEL_COST_PLUS:
+		cost = s.calculateCostPlusCost(ctx, price, quantity)
 	}
 
 	return cost
@@ -1118,6 +1200,16 @@ func (s *priceService) calculateTieredCost(ctx context.Context, price *price.Pri
 				break
 			}
 		}
+	case types.BILLING_TIER_STAIR_STEP:
+		// The whole quantity is charged the flat amount of its bracket
+		cost = price.CalculateStairStepAmount(quantity)
+
+		s.Logger.WithContext(ctx).Debugf(
+			"stair step total cost for quantity %s: %s price: %s",
+			quantity.String(),
+			cost.String(),
+			price.ID,
+		)
 	default:
 		s.Logger.WithContext(ctx).Errorf("invalid tier mode: %s", price.TierMode)
 		return decimal.Zero
@@ -1180,10 +1272,15 @@ func (s *priceService) CalculateCostWithBreakup(ctx context.Context, price *pric
 
 	case types.BILLING_MODEL_TIERED:
 		result = s.calculateTieredCostWithBreakup(ctx, price, quantity)
+
+	case types.BILLING_MODEL_COST_PLUS:
+		result.FinalCost = s.calculateCostPlusCost(ctx, price, quantity)
+		result.EffectiveUnitCost = result.FinalCost.Div(quantity)
+		result.TierUnitAmount = result.EffectiveUnitCost
 	}
 
 	if round {
-		result.FinalCost = result.FinalCost.Round(types.GetCurrencyPrecision(price.Currency))
+		result.FinalCost = GetRoundingConfig(s.ServiceParams, ctx).RoundLineItemAmount(result.FinalCost, price.Currency)
 	}
 
 	return result
@@ -1298,6 +1395,25 @@ func (s *priceService) calculateTieredCostWithBreakup(ctx context.Context, price
 		} else {
 			result.EffectiveUnitCost = decimal.Zero
 		}
+	case types.BILLING_TIER_STAIR_STEP:
+		if quantity.IsPositive() {
+			result.SelectedTierIndex = price.StairStepBracketIndex(quantity)
+		}
+		result.FinalCost = price.CalculateStairStepAmount(quantity)
+
+		// Calculate effective unit cost (handle zero quantity case)
+		if !quantity.IsZero() {
+			result.EffectiveUnitCost = result.FinalCost.Div(quantity)
+		} else {
+			result.EffectiveUnitCost = decimal.Zero
+		}
+
+		s.Logger.WithContext(ctx).Debugf(
+			"stair step total cost for quantity %s: %s price: %s",
+			quantity.String(),
+			result.FinalCost.String(),
+			price.ID,
+		)
 	default:
 		s.Logger.WithContext(ctx).Errorf("invalid tier mode: %s", price.TierMode)
 	}
@@ -1434,6 +1550,8 @@ func (s *priceService) applyPriceUnitConversionToPrice(ctx context.Context, p *p
 			Mark(ierr.ErrValidation)
 	}
 
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+
 	// 4. Convert amount if provided (for FLAT_FEE and PACKAGE billing models)
 	if p.PriceUnitAmount != nil {
 		// Convert price unit amount to fiat currency
@@ -1446,8 +1564,8 @@ func (s *priceService) applyPriceUnitConversionToPrice(ctx context.Context, p *p
 			return err
 		}
 
-		// Update the price amount with converted value
-		p.Amount = fiatAmount
+		// Update the price amount with converted value, unit rates keep sub-cent precision
+		p.Amount = rounding.RoundUnitAmount(fiatAmount, p.Currency)
 	}
 
 	// 5. Convert tiers if provided (for TIERED billing model)
@@ -1467,7 +1585,7 @@ func (s *priceService) applyPriceUnitConversionToPrice(ctx context.Context, p *p
 
 			convertedTier := price.PriceTier{
 				UpTo:       tier.UpTo,
-				UnitAmount: convertedUnitAmount,
+				UnitAmount: rounding.RoundUnitAmount(convertedUnitAmount, p.Currency),
 			}
 
 			// Convert flat amount if provided
@@ -1480,7 +1598,7 @@ func (s *priceService) applyPriceUnitConversionToPrice(ctx context.Context, p *p
 				if err != nil {
 					return err
 				}
-				convertedTier.FlatAmount = lo.ToPtr(convertedFlatAmount)
+				convertedTier.FlatAmount = lo.ToPtr(rounding.RoundUnitAmount(convertedFlatAmount, p.Currency))
 			}
 
 			convertedTiers[i] = convertedTier
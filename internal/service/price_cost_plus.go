@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CalculateCostPlusBreakdown rates a COST_PLUS price for the given quantities. Each quantity is
+// costed independently under the meter's price in the active costsheet (bucketed meters pass one
+// quantity per bucket) and the markup is applied to the total cost. Amounts are not rounded.
+func (s *priceService) CalculateCostPlusBreakdown(ctx context.Context, p *price.Price, quantities ...decimal.Decimal) (*types.CostPlusBreakdown, error) {
+	if p.BillingModel != types.BILLING_MODEL_COST_PLUS || p.CostPlus == nil {
+		return nil, ierr.NewError("price is not a cost plus price").
+			WithHint("Cost basis is only available for COST_PLUS prices").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":      p.ID,
+				"billing_model": p.BillingModel,
+			}).
+			Mark(ierr.ErrInvalidOperation)
+	}
+
+	costPrice, err := s.GetCostBasisPrice(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := decimal.Zero
+	for _, quantity := range quantities {
+		cost = cost.Add(s.calculateSingletonCost(ctx, costPrice, quantity))
+	}
+
+	amount, minMarginApplied := p.CostPlus.Apply(cost)
+
+	return &types.CostPlusBreakdown{
+		CostsheetID:      costPrice.EntityID,
+		CostPriceID:      costPrice.ID,
+		Cost:             cost,
+		Amount:           amount,
+		MarginPercent:    p.CostPlus.MarginPercent().Round(2),
+		MinMarginApplied: minMarginApplied,
+	}, nil
+}
+
+// calculateCostPlusCost returns the amount of a COST_PLUS price. Rating can not fail at this point,
+// so a missing cost basis is logged and charged as zero. Billing resolves the cost basis of each
+// cost-plus charge with GetCostBasisPrice before rating it and fails the invoice instead, so a zero
+// amount is only ever shown in previews.
+func (s *priceService) calculateCostPlusCost(ctx context.Context, p *price.Price, quantities ...decimal.Decimal) decimal.Decimal {
+	breakdown, err := s.CalculateCostPlusBreakdown(ctx, p, quantities...)
+	if err != nil {
+		s.Logger.WithContext(ctx).Errorw("failed to calculate cost plus amount",
+			"price_id", p.ID,
+			"meter_id", p.MeterID,
+			"error", err)
+		return decimal.Zero
+	}
+	return breakdown.Amount
+}
+
+// GetCostBasisPrice returns the price of the meter of a cost-plus price in the active costsheet,
+// which is the most recently created costsheet of the environment. The active costsheet is cached
+// for the tenant and environment, so rating does not query it for every charge.
+func (s *priceService) GetCostBasisPrice(ctx context.Context, p *price.Price) (*price.Price, error) {
+	costsheet, err := getActiveCostsheetWithCache(ctx, s.ServiceParams)
+	if err != nil {
+		if ierr.IsNotFound(err) {
+			return nil, ierr.WithError(err).
+				WithHint("Cost plus prices require an active costsheet with a price for their meter").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": p.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	costPrice, found := lo.Find(costsheet.Prices, func(cp *dto.PriceResponse) bool {
+		return cp.Price != nil &&
+			cp.MeterID == p.MeterID &&
+			strings.EqualFold(cp.Currency, p.Currency) &&
+			cp.BillingModel != types.BILLING_MODEL_COST_PLUS
+	})
+	if !found {
+		return nil, ierr.NewError("no cost found for meter in the active costsheet").
+			WithHint("Add a price for the meter in the same currency to the active costsheet").
+			WithReportableDetails(map[string]interface{}{
+				"price_id":     p.ID,
+				"meter_id":     p.MeterID,
+				"currency":     p.Currency,
+				"costsheet_id": costsheet.ID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	return costPrice.Price, nil
+}
+
+// validateCostPlusPrice checks that a cost-plus price can be rated from the active costsheet
+func (s *priceService) validateCostPlusPrice(ctx context.Context, p *price.Price) error {
+	if p.EntityType == types.PRICE_ENTITY_TYPE_COSTSHEET {
+		return ierr.NewError("costsheet prices can not be cost plus prices").
+			WithHint("Costsheet prices define the cost basis and need a FLAT_FEE, PACKAGE or TIERED billing model").
+			Mark(ierr.ErrValidation)
+	}
+
+	_, err := s.GetCostBasisPrice(ctx, p)
+	return err
+}
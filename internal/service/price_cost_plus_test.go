@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flexprice/flexprice/internal/cache"
+	domainCostsheet "github.com/flexprice/flexprice/internal/domain/costsheet"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// activeCostsheetRepo returns a fixed list of costsheets, the other methods are not used by rating
+type activeCostsheetRepo struct {
+	domainCostsheet.Repository
+	costsheets []*domainCostsheet.Costsheet
+	lists      int
+}
+
+func (r *activeCostsheetRepo) List(_ context.Context, _ *domainCostsheet.Filter) ([]*domainCostsheet.Costsheet, error) {
+	r.lists++
+	return r.costsheets, nil
+}
+
+func (r *activeCostsheetRepo) Count(_ context.Context, _ *domainCostsheet.Filter) (int64, error) {
+	return int64(len(r.costsheets)), nil
+}
+
+func TestCalculateCostPlusBreakdown(t *testing.T) {
+	ctx := testutil.SetupContext()
+	priceRepo := testutil.NewInMemoryPriceStore()
+	costsheets := &activeCostsheetRepo{}
+	svc := &priceService{ServiceParams: ServiceParams{
+		Logger:        logger.GetLogger(),
+		PriceRepo:     priceRepo,
+		CostSheetRepo: costsheets,
+	}}
+	costsheetCacheKey := cache.GenerateKey(cache.PrefixCostsheet, types.GetTenantID(ctx), types.GetEnvironmentID(ctx))
+	cache.GetInMemoryCache().Delete(ctx, costsheetCacheKey)
+	defer cache.GetInMemoryCache().Delete(ctx, costsheetCacheKey)
+
+	tokens := &price.Price{
+		ID:           "price_tokens",
+		Amount:       decimal.Zero,
+		Currency:     "usd",
+		Type:         types.PRICE_TYPE_USAGE,
+		BillingModel: types.BILLING_MODEL_COST_PLUS,
+		MeterID:      "meter_tokens",
+		EntityType:   types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:     "plan_1",
+		CostPlus: &types.CostPlusConfig{
+			Mode:             types.CostPlusModeMarkup,
+			Value:            decimal.NewFromInt(20),
+			MinMarginPercent: lo.ToPtr(decimal.NewFromInt(10)),
+		},
+	}
+
+	// Without an active costsheet cost plus prices can not be rated
+	_, err := svc.CalculateCostPlusBreakdown(ctx, tokens, decimal.NewFromInt(1000))
+	require.Error(t, err)
+	assert.True(t, ierr.IsNotFound(err))
+	assert.True(t, svc.CalculateCost(ctx, tokens, decimal.NewFromInt(1000)).IsZero())
+
+	costsheets.costsheets = []*domainCostsheet.Costsheet{{ID: "cs_1", Name: "Model costs"}}
+	cost := &price.Price{
+		ID:           "price_cost_tokens",
+		Amount:       decimal.RequireFromString("0.002"),
+		Currency:     "usd",
+		Type:         types.PRICE_TYPE_USAGE,
+		BillingModel: types.BILLING_MODEL_FLAT_FEE,
+		MeterID:      "meter_tokens",
+		EntityType:   types.PRICE_ENTITY_TYPE_COSTSHEET,
+		EntityID:     "cs_1",
+		BaseModel:    types.GetDefaultBaseModel(ctx),
+	}
+	cost.EnvironmentID = types.GetEnvironmentID(ctx)
+	require.NoError(t, priceRepo.Create(ctx, cost))
+
+	breakdown, err := svc.CalculateCostPlusBreakdown(ctx, tokens, decimal.NewFromInt(1000))
+	require.NoError(t, err)
+	assert.Equal(t, "cs_1", breakdown.CostsheetID)
+	assert.Equal(t, "price_cost_tokens", breakdown.CostPriceID)
+	assert.True(t, decimal.NewFromInt(2).Equal(breakdown.Cost), "got %s", breakdown.Cost)
+	assert.True(t, decimal.RequireFromString("2.4").Equal(breakdown.Amount), "got %s", breakdown.Amount)
+	assert.True(t, decimal.RequireFromString("16.67").Equal(breakdown.MarginPercent), "got %s", breakdown.MarginPercent)
+	assert.False(t, breakdown.MinMarginApplied)
+
+	// The active costsheet is cached, so rating does not list costsheets again
+	lists := costsheets.lists
+	assert.True(t, decimal.RequireFromString("2.4").Equal(svc.CalculateCost(ctx, tokens, decimal.NewFromInt(1000))))
+	assert.Equal(t, lists, costsheets.lists)
+
+	// Prices follow cost changes once the cached costsheet expires
+	cost.Amount = decimal.RequireFromString("0.003")
+	require.NoError(t, priceRepo.Update(ctx, cost))
+	cache.GetInMemoryCache().Delete(ctx, costsheetCacheKey)
+	assert.True(t, decimal.RequireFromString("3.6").Equal(svc.CalculateCost(ctx, tokens, decimal.NewFromInt(1000))))
+
+	// Bucketed usage is costed per bucket before the markup
+	assert.True(t, decimal.RequireFromString("3.6").Equal(svc.CalculateBucketedCost(ctx, tokens, []decimal.Decimal{decimal.NewFromInt(400), decimal.NewFromInt(600)})))
+
+	// Costs in another currency are not a cost basis
+	eurTokens := *tokens
+	eurTokens.Currency = "eur"
+	_, err = svc.CalculateCostPlusBreakdown(ctx, &eurTokens, decimal.NewFromInt(1000))
+	assert.True(t, ierr.IsNotFound(err))
+}
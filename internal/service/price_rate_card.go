@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// CreateRateCardPrice adds a negotiated price to the rate card of a customer. Rates keyed by price
+// copy the price and replace its amount, tiers or package, rates keyed by feature are usage prices
+// on the meter of the feature. Rates for the same price or feature cannot overlap in time.
+func (s *priceService) CreateRateCardPrice(ctx context.Context, customerID string, req dto.CreateRateCardPriceRequest) (*dto.PriceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var createReq dto.CreatePriceRequest
+	if req.PriceID != "" {
+		parent, err := s.PriceRepo.Get(ctx, req.PriceID)
+		if err != nil {
+			return nil, err
+		}
+		if !parent.IsPlanScoped() && !parent.IsAddonScoped() {
+			return nil, ierr.NewError("rate card prices can only replace plan or addon prices").
+				WithHint("Use subscription price overrides to change the price of a single subscription").
+				WithReportableDetails(map[string]interface{}{
+					"price_id":    parent.ID,
+					"entity_type": parent.EntityType,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if parent.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
+			return nil, ierr.NewError("rate card prices are not supported for custom price units").
+				WithHint("Negotiated rates can only replace prices in a fiat currency").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": parent.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		createReq = dto.CreatePriceRequest{
+			Currency:           parent.Currency,
+			Type:               parent.Type,
+			PriceUnitType:      types.PRICE_UNIT_TYPE_FIAT,
+			BillingPeriod:      parent.BillingPeriod,
+			BillingPeriodCount: parent.BillingPeriodCount,
+			BillingModel:       lo.CoalesceOrEmpty(req.BillingModel, parent.BillingModel),
+			BillingCadence:     parent.BillingCadence,
+			InvoiceCadence:     parent.InvoiceCadence,
+			TrialPeriod:        parent.TrialPeriod,
+			MeterID:            parent.MeterID,
+			Description:        parent.Description,
+			DisplayName:        parent.DisplayName,
+			TierMode:           lo.CoalesceOrEmpty(req.TierMode, parent.TierMode),
+			ParentPriceID:      parent.GetRootPriceID(),
+		}
+
+		switch createReq.BillingModel {
+		case types.BILLING_MODEL_FLAT_FEE, types.BILLING_MODEL_PACKAGE:
+			createReq.Amount = lo.Ternary(req.Amount != nil, req.Amount, lo.ToPtr(parent.Amount))
+			if createReq.BillingModel == types.BILLING_MODEL_PACKAGE {
+				createReq.TransformQuantity = req.TransformQuantity
+				if createReq.TransformQuantity == nil && parent.TransformQuantity != (price.JSONBTransformQuantity{}) {
+					createReq.TransformQuantity = lo.ToPtr(price.TransformQuantity(parent.TransformQuantity))
+				}
+			}
+		case types.BILLING_MODEL_TIERED:
+			createReq.Tiers = req.Tiers
+			if len(createReq.Tiers) == 0 {
+				createReq.Tiers = lo.Map(parent.Tiers, func(tier price.PriceTier, _ int) dto.CreatePriceTier {
+					return dto.CreatePriceTier{
+						UpTo:       tier.UpTo,
+						UnitAmount: tier.UnitAmount,
+						FlatAmount: tier.FlatAmount,
+					}
+				})
+			}
+		}
+	} else {
+		f, err := s.FeatureRepo.Get(ctx, req.FeatureID)
+		if err != nil {
+			return nil, err
+		}
+		if f.Type != types.FeatureTypeMetered || f.MeterID == "" {
+			return nil, ierr.NewError("rate card prices can only be keyed by metered features").
+				WithHint("Key the rate by the price it replaces for features without a meter").
+				WithReportableDetails(map[string]interface{}{
+					"feature_id":   f.ID,
+					"feature_type": f.Type,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		createReq = dto.CreatePriceRequest{
+			Amount:             req.Amount,
+			Currency:           req.Currency,
+			Type:               types.PRICE_TYPE_USAGE,
+			PriceUnitType:      types.PRICE_UNIT_TYPE_FIAT,
+			BillingPeriod:      req.BillingPeriod,
+			BillingPeriodCount: lo.Ternary(req.BillingPeriodCount > 0, req.BillingPeriodCount, 1),
+			BillingModel:       req.BillingModel,
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			InvoiceCadence:     types.InvoiceCadenceArrear,
+			MeterID:            f.MeterID,
+			DisplayName:        f.Name,
+			TierMode:           req.TierMode,
+			Tiers:              req.Tiers,
+			TransformQuantity:  req.TransformQuantity,
+		}
+	}
+
+	createReq.EntityType = types.PRICE_ENTITY_TYPE_CUSTOMER
+	createReq.EntityID = customerID
+	createReq.StartDate = lo.Ternary(req.StartDate != nil, req.StartDate, lo.ToPtr(time.Now().UTC()))
+	createReq.EndDate = req.EndDate
+	createReq.Metadata = lo.Assign(req.Metadata)
+	if req.FeatureID != "" {
+		createReq.Metadata["feature_id"] = req.FeatureID
+	}
+
+	if err := s.validateRateCardOverlap(ctx, customerID, &createReq); err != nil {
+		return nil, err
+	}
+
+	return s.CreatePrice(ctx, createReq)
+}
+
+// validateRateCardOverlap rejects a rate that is valid at the same time as another rate of the
+// customer for the same price or feature
+func (s *priceService) validateRateCardOverlap(ctx context.Context, customerID string, req *dto.CreatePriceRequest) error {
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, customerID)
+	if err != nil {
+		return err
+	}
+
+	rate := &price.Price{
+		EntityType:         types.PRICE_ENTITY_TYPE_CUSTOMER,
+		ParentPriceID:      req.ParentPriceID,
+		Type:               req.Type,
+		Currency:           req.Currency,
+		MeterID:            req.MeterID,
+		BillingPeriod:      req.BillingPeriod,
+		BillingPeriodCount: req.BillingPeriodCount,
+		StartDate:          req.StartDate,
+		EndDate:            req.EndDate,
+	}
+
+	for _, existing := range rateCard {
+		if existing.IsFeatureRate() != rate.IsFeatureRate() {
+			continue
+		}
+		sameKey := lo.Ternary(rate.IsFeatureRate(), existing.ReplacesPrice(rate), existing.ParentPriceID == rate.ParentPriceID)
+		if !sameKey {
+			continue
+		}
+
+		startsBeforeExistingEnds := existing.EndDate == nil || rate.StartDate.Before(*existing.EndDate)
+		endsAfterExistingStarts := rate.EndDate == nil || existing.StartDate == nil || rate.EndDate.After(*existing.StartDate)
+		if startsBeforeExistingEnds && endsAfterExistingStarts {
+			return ierr.NewError("rate overlaps an existing rate of the customer").
+				WithHint("End the existing rate before adding a new rate for the same price or feature").
+				WithReportableDetails(map[string]interface{}{
+					"customer_id":       customerID,
+					"existing_price_id": existing.ID,
+					"start_date":        rate.StartDate,
+					"end_date":          rate.EndDate,
+				}).
+				Mark(ierr.ErrAlreadyExists)
+		}
+	}
+
+	return nil
+}
+
+// getCustomerRateCard returns the rate card prices of a customer. Expired rates are included so
+// that past periods are rated with the rates valid at the time.
+func getCustomerRateCard(ctx context.Context, params ServiceParams, customerID string) ([]*price.Price, error) {
+	filter := types.NewNoLimitPriceFilter().
+		WithEntityIDs([]string{customerID}).
+		WithEntityType(types.PRICE_ENTITY_TYPE_CUSTOMER).
+		WithStatus(types.StatusPublished).
+		WithAllowExpiredPrices(true)
+
+	return params.PriceRepo.List(ctx, filter)
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateCardPriceFor(t *testing.T) {
+	now := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	seat := &price.Price{
+		ID:                 "price_seat",
+		Amount:             decimal.NewFromInt(50),
+		Currency:           "usd",
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           "plan_1",
+	}
+	calls := &price.Price{
+		ID:                 "price_calls",
+		Amount:             decimal.RequireFromString("0.10"),
+		Currency:           "usd",
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		MeterID:            "meter_calls",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           "plan_1",
+	}
+
+	seatRate := &price.Price{
+		ID:            "price_rate_seat",
+		Amount:        decimal.NewFromInt(40),
+		Currency:      "usd",
+		Type:          types.PRICE_TYPE_FIXED,
+		BillingModel:  types.BILLING_MODEL_FLAT_FEE,
+		EntityType:    types.PRICE_ENTITY_TYPE_CUSTOMER,
+		EntityID:      "cust_1",
+		ParentPriceID: "price_seat",
+		StartDate:     lo.ToPtr(now.AddDate(0, -1, 0)),
+		EndDate:       lo.ToPtr(now.AddDate(0, 1, 0)),
+		BaseModel:     types.BaseModel{Status: types.StatusPublished},
+	}
+	callsRate := &price.Price{
+		ID:                 "price_rate_calls",
+		Amount:             decimal.RequireFromString("0.08"),
+		Currency:           "usd",
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		MeterID:            "meter_calls",
+		EntityType:         types.PRICE_ENTITY_TYPE_CUSTOMER,
+		EntityID:           "cust_1",
+		StartDate:          lo.ToPtr(now.AddDate(0, -1, 0)),
+		BaseModel:          types.BaseModel{Status: types.StatusPublished},
+	}
+	rateCard := []*price.Price{seatRate, callsRate}
+
+	// Rates keyed by price replace the amount but keep the id of the plan price
+	rated := price.RateCardPriceFor(rateCard, seat, now)
+	assert.Equal(t, "price_seat", rated.ID)
+	assert.True(t, decimal.NewFromInt(40).Equal(rated.Amount))
+	assert.Equal(t, "price_rate_seat", rated.Metadata["rate_card_price_id"])
+	assert.True(t, decimal.NewFromInt(50).Equal(seat.Amount), "plan price must not be changed")
+
+	// Rates keyed by feature replace usage prices on the meter of the feature
+	rated = price.RateCardPriceFor(rateCard, calls, now)
+	assert.Equal(t, "price_calls", rated.ID)
+	assert.True(t, decimal.RequireFromString("0.08").Equal(rated.Amount))
+
+	// Expired rates do not apply
+	rated = price.RateCardPriceFor(rateCard, seat, now.AddDate(0, 2, 0))
+	assert.Same(t, seat, rated)
+
+	// Rates in another currency do not apply
+	eur := *calls
+	eur.Currency = "eur"
+	assert.Same(t, &eur, price.RateCardPriceFor(rateCard, &eur, now))
+
+	// Subscription overrides take precedence over the rate card
+	override := *seat
+	override.EntityType = types.PRICE_ENTITY_TYPE_SUBSCRIPTION
+	override.ParentPriceID = "price_seat"
+	assert.Same(t, &override, price.RateCardPriceFor(rateCard, &override, now))
+
+	// Rates keyed by price take precedence over rates keyed by feature
+	callsPriceRate := *callsRate
+	callsPriceRate.ID = "price_rate_calls_price"
+	callsPriceRate.Amount = decimal.RequireFromString("0.05")
+	callsPriceRate.ParentPriceID = "price_calls"
+	rated = price.RateCardPriceFor(append(rateCard, &callsPriceRate), calls, now)
+	assert.True(t, decimal.RequireFromString("0.05").Equal(rated.Amount))
+}
+
+func TestCreateRateCardPrice(t *testing.T) {
+	ctx := testutil.SetupContext()
+	log := logger.GetLogger()
+	priceRepo := testutil.NewInMemoryPriceStore()
+	customerRepo := testutil.NewInMemoryCustomerStore()
+	featureRepo := testutil.NewInMemoryFeatureStore()
+	svc := NewPriceService(ServiceParams{
+		Logger:        log,
+		DB:            testutil.NewMockPostgresClient(log),
+		PriceRepo:     priceRepo,
+		CustomerRepo:  customerRepo,
+		FeatureRepo:   featureRepo,
+		MeterRepo:     testutil.NewInMemoryMeterStore(),
+		PlanRepo:      testutil.NewInMemoryPlanStore(),
+		AddonRepo:     testutil.NewInMemoryAddonStore(),
+		SubRepo:       testutil.NewInMemorySubscriptionStore(),
+		PriceUnitRepo: testutil.NewInMemoryPriceUnitStore(),
+	})
+
+	require.NoError(t, customerRepo.Create(ctx, &customer.Customer{
+		ID:         "cust_1",
+		ExternalID: "ext_cust_1",
+		Name:       "Enterprise customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}))
+	seat := &price.Price{
+		ID:                 "price_seat",
+		Amount:             decimal.NewFromInt(50),
+		Currency:           "usd",
+		Type:               types.PRICE_TYPE_FIXED,
+		PriceUnitType:      types.PRICE_UNIT_TYPE_FIAT,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           "plan_1",
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	seat.EnvironmentID = types.GetEnvironmentID(ctx)
+	require.NoError(t, priceRepo.Create(ctx, seat))
+
+	start := time.Now().UTC().Truncate(time.Second)
+	resp, err := svc.CreateRateCardPrice(ctx, "cust_1", dto.CreateRateCardPriceRequest{
+		PriceID:   "price_seat",
+		Amount:    lo.ToPtr(decimal.NewFromInt(40)),
+		StartDate: lo.ToPtr(start),
+		EndDate:   lo.ToPtr(start.AddDate(1, 0, 0)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.PRICE_ENTITY_TYPE_CUSTOMER, resp.Price.EntityType)
+	assert.Equal(t, "cust_1", resp.Price.EntityID)
+	assert.Equal(t, "price_seat", resp.Price.ParentPriceID)
+	assert.True(t, decimal.NewFromInt(40).Equal(resp.Price.Amount))
+
+	// A second rate for the same price valid at the same time is rejected
+	_, err = svc.CreateRateCardPrice(ctx, "cust_1", dto.CreateRateCardPriceRequest{
+		PriceID:   "price_seat",
+		Amount:    lo.ToPtr(decimal.NewFromInt(35)),
+		StartDate: lo.ToPtr(start.AddDate(0, 6, 0)),
+	})
+	require.Error(t, err)
+	assert.True(t, ierr.IsAlreadyExists(err))
+
+	// A rate starting when the existing rate ends is accepted
+	_, err = svc.CreateRateCardPrice(ctx, "cust_1", dto.CreateRateCardPriceRequest{
+		PriceID:   "price_seat",
+		Amount:    lo.ToPtr(decimal.NewFromInt(35)),
+		StartDate: lo.ToPtr(start.AddDate(1, 0, 0)),
+	})
+	require.NoError(t, err)
+
+	// Rates keyed by feature require a metered feature
+	require.NoError(t, featureRepo.Create(ctx, &feature.Feature{
+		ID:        "feat_sso",
+		Name:      "SSO",
+		Type:      types.FeatureTypeBoolean,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}))
+	_, err = svc.CreateRateCardPrice(ctx, "cust_1", dto.CreateRateCardPriceRequest{
+		FeatureID:     "feat_sso",
+		Currency:      "usd",
+		BillingPeriod: types.BILLING_PERIOD_MONTHLY,
+		BillingModel:  types.BILLING_MODEL_FLAT_FEE,
+		Amount:        lo.ToPtr(decimal.NewFromInt(1)),
+	})
+	require.Error(t, err)
+	assert.True(t, ierr.IsValidation(err))
+
+	rateCard, err := svc.GetPricesByCustomerID(ctx, "cust_1")
+	require.NoError(t, err)
+	assert.Len(t, rateCard.Items, 2)
+}
@@ -519,6 +519,39 @@ func (s *PriceServiceSuite) TestCalculateCostWithBreakup_TieredSlab() {
 	s.Equal(2, result.SelectedTierIndex)                               // Index 2 (third tier)
 }
 
+func (s *PriceServiceSuite) TestCalculateCostWithBreakup_TieredStairStep() {
+	upTo10 := uint64(10)
+	upTo50 := uint64(50)
+	price := &price.Price{
+		ID:           "price-stair-step",
+		Amount:       decimal.Zero,
+		Currency:     "usd",
+		BillingModel: types.BILLING_MODEL_TIERED,
+		TierMode:     types.BILLING_TIER_STAIR_STEP,
+		Tiers: []price.PriceTier{
+			{UpTo: &upTo10, FlatAmount: lo.ToPtr(decimal.NewFromInt(100))}, // 1-10 users: $100
+			{UpTo: &upTo50, FlatAmount: lo.ToPtr(decimal.NewFromInt(400))}, // 11-50 users: $400
+			{FlatAmount: lo.ToPtr(decimal.NewFromInt(1000))},               // 51+ users: $1000
+		},
+	}
+
+	// The whole bracket is charged regardless of the quantity within it
+	s.True(decimal.NewFromInt(100).Equal(s.priceService.CalculateCost(s.ctx, price, decimal.NewFromInt(1))))
+	s.True(decimal.NewFromInt(100).Equal(s.priceService.CalculateCost(s.ctx, price, decimal.NewFromInt(10))))
+	s.True(decimal.NewFromInt(400).Equal(s.priceService.CalculateCost(s.ctx, price, decimal.NewFromInt(11))))
+	s.True(decimal.NewFromInt(1000).Equal(s.priceService.CalculateCost(s.ctx, price, decimal.NewFromInt(51))))
+
+	result := s.priceService.CalculateCostWithBreakup(s.ctx, price, decimal.NewFromInt(20), false)
+	s.True(decimal.NewFromInt(400).Equal(result.FinalCost))
+	s.True(decimal.NewFromInt(20).Equal(result.EffectiveUnitCost))
+	s.Equal(1, result.SelectedTierIndex)
+
+	// No quantity, no charge
+	result = s.priceService.CalculateCostWithBreakup(s.ctx, price, decimal.Zero, false)
+	s.True(result.FinalCost.IsZero())
+	s.Equal(-1, result.SelectedTierIndex)
+}
+
 func (s *PriceServiceSuite) TestCalculateCostWithBreakup_ZeroQuantity() {
 	price := &price.Price{
 		ID:           "price-5",
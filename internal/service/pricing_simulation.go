@@ -0,0 +1,861 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/task"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PricingSimulationService replays historical usage of existing subscriptions against a candidate
+// plan without persisting any invoices.
+type PricingSimulationService interface {
+	// CreatePricingSimulation creates a simulation task and starts the simulation workflow
+	CreatePricingSimulation(ctx context.Context, req dto.CreatePricingSimulationRequest) (*dto.PricingSimulationResponse, error)
+
+	// RunPricingSimulation executes the simulation of a task and uploads the result file
+	RunPricingSimulation(ctx context.Context, taskID string) error
+}
+
+type pricingSimulationService struct {
+	ServiceParams
+}
+
+// NewPricingSimulationService creates a new pricing simulation service
+func NewPricingSimulationService(params ServiceParams) PricingSimulationService {
+	return &pricingSimulationService{
+		ServiceParams: params,
+	}
+}
+
+// pricingSimulationCSV is a row of the pricing simulation result file
+type pricingSimulationCSV struct {
+	CustomerID         string `csv:"customer_id"`
+	ExternalCustomerID string `csv:"external_customer_id"`
+	CustomerName       string `csv:"name"`
+	Currency           string `csv:"currency"`
+	Subscriptions      int    `csv:"subscriptions"`
+	BillingPeriods     int    `csv:"billing_periods"`
+	CurrentAmount      string `csv:"current_amount"`
+	SimulatedAmount    string `csv:"simulated_amount"`
+	Delta              string `csv:"delta"`
+	DeltaPercent       string `csv:"delta_percent"`
+}
+
+// pricingSimulationTotalRow is the customer_id of the per currency aggregate rows of the result file
+const pricingSimulationTotalRow = "TOTAL"
+
+func (s *pricingSimulationService) CreatePricingSimulation(ctx context.Context, req dto.CreatePricingSimulationRequest) (*dto.PricingSimulationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.PlanID != "" {
+		if _, err := s.PlanRepo.Get(ctx, req.PlanID); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata := map[string]interface{}{
+		"start_time": req.StartTime.Format(time.RFC3339),
+		"end_time":   req.EndTime.Format(time.RFC3339),
+	}
+	if !req.IsInline() {
+		jobConfig, err := s.resolveJobConfig(ctx, req.ConnectionID, req.JobConfig)
+		if err != nil {
+			return nil, err
+		}
+		req.JobConfig = jobConfig
+		metadata["connection_id"] = req.ConnectionID
+		metadata["region"] = jobConfig.Region
+	}
+
+	requestMetadata, err := toMetadataMap(req)
+	if err != nil {
+		return nil, err
+	}
+	metadata["request"] = requestMetadata
+
+	now := time.Now().UTC()
+	t := &task.Task{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TASK),
+		TaskType:      types.TaskTypeSimulation,
+		EntityType:    types.EntityTypePricingSimulation,
+		FileType:      types.FileTypeCSV,
+		TaskStatus:    types.TaskStatusPending,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		Metadata:      metadata,
+		StartedAt:     &now,
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.TaskRepo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	// Simulations without an S3 connection are small enough to run within the request
+	if req.IsInline() {
+		results, err := s.runSimulation(ctx, t, &req)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.PricingSimulationResponse{
+			Task:    dto.NewTaskResponse(t),
+			Results: results,
+			Summary: summarizePricingSimulation(results),
+		}, nil
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		return nil, ierr.NewError("temporal service not available").
+			WithHint("Pricing simulation workflow requires Temporal service").
+			Mark(ierr.ErrInternal)
+	}
+
+	workflowRun, err := temporalSvc.ExecuteWorkflow(ctx, types.TemporalPricingSimulationWorkflow, t.ID)
+	if err != nil {
+		s.Logger.Errorw("failed to start pricing simulation workflow", "error", err, "task_id", t.ID)
+		return nil, err
+	}
+
+	t.WorkflowID = lo.ToPtr(workflowRun.GetID())
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return &dto.PricingSimulationResponse{
+		Task:       dto.NewTaskResponse(t),
+		WorkflowID: workflowRun.GetID(),
+		RunID:      workflowRun.GetRunID(),
+	}, nil
+}
+
+func (s *pricingSimulationService) RunPricingSimulation(ctx context.Context, taskID string) error {
+	t, err := s.TaskRepo.Get(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if t.TaskType != types.TaskTypeSimulation || t.EntityType != types.EntityTypePricingSimulation {
+		return ierr.NewError("task is not a pricing simulation").
+			WithHint("Only pricing simulation tasks can be run as simulations").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":     taskID,
+				"task_type":   t.TaskType,
+				"entity_type": t.EntityType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	var req dto.CreatePricingSimulationRequest
+	if err := fromMetadataMap(t.Metadata["request"], &req); err != nil {
+		return s.failSimulation(ctx, t, err)
+	}
+
+	_, err = s.runSimulation(ctx, t, &req)
+	return err
+}
+
+// runSimulation runs the simulation of a task and completes the task. The result file is uploaded
+// to the S3 connection of the simulation, results of simulations without a connection are kept in
+// the task metadata.
+func (s *pricingSimulationService) runSimulation(ctx context.Context, t *task.Task, req *dto.CreatePricingSimulationRequest) ([]*dto.PricingSimulationCustomerResult, error) {
+	t.TaskStatus = types.TaskStatusProcessing
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	results, failed, err := s.simulate(ctx, req)
+	if err != nil {
+		return nil, s.failSimulation(ctx, t, err)
+	}
+
+	if req.IsInline() {
+		rows, err := toMetadataList(buildPricingSimulationRows(results))
+		if err != nil {
+			return nil, s.failSimulation(ctx, t, err)
+		}
+		t.Metadata["results"] = rows
+	} else {
+		rows := buildPricingSimulationRows(results)
+		var buf bytes.Buffer
+		if err := gocsv.Marshal(rows, &buf); err != nil {
+			return nil, s.failSimulation(ctx, t, ierr.WithError(err).
+				WithHint("Failed to marshal simulation result to CSV").
+				Mark(ierr.ErrInternal))
+		}
+
+		fileName := fmt.Sprintf("pricing-simulation-%s-%s-%s", t.ID,
+			req.StartTime.Format("060102150405"), req.EndTime.Format("060102150405"))
+		fileURL, err := s.uploadResult(ctx, req, fileName, buf.Bytes())
+		if err != nil {
+			return nil, s.failSimulation(ctx, t, err)
+		}
+		t.FileURL = fileURL
+		t.FileName = lo.ToPtr(fileName + ".csv")
+	}
+
+	now := time.Now().UTC()
+	t.TaskStatus = types.TaskStatusCompleted
+	t.TotalRecords = lo.ToPtr(len(results) + len(failed))
+	t.ProcessedRecords = len(results) + len(failed)
+	t.SuccessfulRecords = len(results)
+	t.FailedRecords = len(failed)
+	t.CompletedAt = &now
+	t.Metadata["summary"] = summarizePricingSimulation(results)
+	if len(failed) > 0 {
+		t.Metadata["failed_subscriptions"] = failed
+	}
+
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// simulate rates every billing period of the matching subscriptions that starts within the
+// simulation range once with the current line items and once with the candidate plan. It returns
+// the results per customer and currency along with the IDs of subscriptions that failed to rate.
+func (s *pricingSimulationService) simulate(ctx context.Context, req *dto.CreatePricingSimulationRequest) ([]*dto.PricingSimulationCustomerResult, []string, error) {
+	candidatePlan, candidatePrices, candidateEntitlements, err := s.getCandidatePlan(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subs, err := s.listSimulationSubscriptions(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulatedPriceRepo := &simulationPriceRepository{
+		Repository: s.PriceRepo,
+		prices:     lo.SliceToMap(candidatePrices, func(p *dto.PriceResponse) (string, *price.Price) { return p.ID, p.Price }),
+	}
+	simulatedSubRepo := &simulationSubscriptionRepository{
+		Repository: s.SubRepo,
+	}
+	simulatedEntitlementRepo := &simulationEntitlementRepository{
+		Repository:   s.EntitlementRepo,
+		planID:       candidatePlan.ID,
+		entitlements: candidateEntitlements,
+	}
+	simulatedParams := s.ServiceParams
+	simulatedParams.PriceRepo = simulatedPriceRepo
+	simulatedParams.SubRepo = simulatedSubRepo
+	simulatedParams.EntitlementRepo = simulatedEntitlementRepo
+
+	billingService := NewBillingService(s.ServiceParams)
+	simulatedBillingService := NewBillingService(simulatedParams)
+
+	results := make(map[string]*dto.PricingSimulationCustomerResult)
+	customers := make(map[string]*customer.Customer)
+	failed := make([]string, 0)
+
+	for _, sub := range subs {
+		sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, sub.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		simulatedSub, simulatedLineItems := s.buildSimulatedSubscription(ctx, sub, lineItems, candidatePlan, candidatePrices)
+		simulatedSubRepo.sub = simulatedSub
+		simulatedSubRepo.lineItems = simulatedLineItems
+
+		current, simulated, periods, err := s.simulateSubscription(ctx, req, billingService, simulatedBillingService, sub, lineItems, simulatedSub, simulatedLineItems)
+		if err != nil {
+			s.Logger.Errorw("failed to simulate subscription",
+				"error", err,
+				"subscription_id", sub.ID)
+			failed = append(failed, sub.ID)
+			continue
+		}
+		if periods == 0 {
+			continue
+		}
+
+		cust, ok := customers[sub.CustomerID]
+		if !ok {
+			cust, err = s.CustomerRepo.Get(ctx, sub.CustomerID)
+			if err != nil {
+				return nil, nil, err
+			}
+			customers[sub.CustomerID] = cust
+		}
+
+		key := sub.CustomerID + ":" + sub.Currency
+		result, ok := results[key]
+		if !ok {
+			result = &dto.PricingSimulationCustomerResult{
+				CustomerID:         cust.ID,
+				ExternalCustomerID: cust.ExternalID,
+				CustomerName:       cust.Name,
+				Currency:           sub.Currency,
+				CurrentAmount:      decimal.Zero,
+				SimulatedAmount:    decimal.Zero,
+			}
+			results[key] = result
+		}
+		result.Subscriptions++
+		result.BillingPeriods += periods
+		result.CurrentAmount = result.CurrentAmount.Add(current)
+		result.SimulatedAmount = result.SimulatedAmount.Add(simulated)
+	}
+
+	return lo.Values(results), failed, nil
+}
+
+// simulateSubscription returns the current and simulated amounts of all billing periods of the
+// subscription that start within the simulation range
+func (s *pricingSimulationService) simulateSubscription(
+	ctx context.Context,
+	req *dto.CreatePricingSimulationRequest,
+	billingService, simulatedBillingService BillingService,
+	sub *subscription.Subscription,
+	lineItems []*subscription.SubscriptionLineItem,
+	simulatedSub *subscription.Subscription,
+	simulatedLineItems []*subscription.SubscriptionLineItem,
+) (decimal.Decimal, decimal.Decimal, int, error) {
+	current := decimal.Zero
+	simulated := decimal.Zero
+	periods := 0
+
+	periodStart := sub.StartDate
+	for periodStart.Before(req.EndTime) {
+		if sub.EndDate != nil && !periodStart.Before(*sub.EndDate) {
+			break
+		}
+
+		periodEnd, err := types.NextBillingDate(periodStart, sub.BillingAnchor, sub.BillingPeriodCount, sub.BillingPeriod, sub.EndDate)
+		if err != nil {
+			return decimal.Zero, decimal.Zero, 0, err
+		}
+		if !periodEnd.After(periodStart) {
+			break
+		}
+
+		if !periodStart.Before(req.StartTime) {
+			currentResult, err := billingService.CalculateCharges(ctx, sub,
+				activeLineItemsForPeriod(lineItems, periodStart, periodEnd), periodStart, periodEnd, true)
+			if err != nil {
+				return decimal.Zero, decimal.Zero, 0, err
+			}
+
+			simulatedResult, err := simulatedBillingService.CalculateCharges(ctx, simulatedSub,
+				activeLineItemsForPeriod(simulatedLineItems, periodStart, periodEnd), periodStart, periodEnd, true)
+			if err != nil {
+				return decimal.Zero, decimal.Zero, 0, err
+			}
+
+			current = current.Add(currentResult.TotalAmount)
+			simulated = simulated.Add(simulatedResult.TotalAmount)
+			periods++
+		}
+
+		periodStart = periodEnd
+	}
+
+	return current, simulated, periods, nil
+}
+
+// getCandidatePlan returns the plan the subscriptions are simulated on along with its prices and
+// entitlements. Prices and entitlements of an inline candidate plan are built in memory and never
+// persisted.
+func (s *pricingSimulationService) getCandidatePlan(ctx context.Context, req *dto.CreatePricingSimulationRequest) (*dto.PlanResponse, []*dto.PriceResponse, []*entitlement.Entitlement, error) {
+	if req.PlanID != "" {
+		p, err := s.PlanRepo.Get(ctx, req.PlanID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		priceService := NewPriceService(s.ServiceParams)
+		prices, err := priceService.GetPricesByPlanID(ctx, dto.GetPricesByPlanRequest{
+			PlanID:       req.PlanID,
+			AllowExpired: false,
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		entitlements, err := s.EntitlementRepo.ListByPlanIDs(ctx, []string{req.PlanID})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return &dto.PlanResponse{Plan: p}, prices.Items, entitlements, nil
+	}
+
+	candidatePlan := &dto.PlanResponse{
+		Plan: req.CandidatePlan.ToPlan(ctx),
+	}
+
+	prices := make([]*dto.PriceResponse, 0, len(req.CandidatePlan.Prices))
+	for _, priceReq := range req.CandidatePlan.Prices {
+		priceReq.EntityType = types.PRICE_ENTITY_TYPE_PLAN
+		priceReq.EntityID = candidatePlan.ID
+		if priceReq.StartDate == nil {
+			priceReq.StartDate = lo.ToPtr(req.StartTime)
+		}
+
+		p, err := priceReq.ToPrice(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		prices = append(prices, &dto.PriceResponse{Price: p})
+	}
+
+	entitlements := make([]*entitlement.Entitlement, 0, len(req.CandidatePlan.Entitlements))
+	for _, entReq := range req.CandidatePlan.Entitlements {
+		entReq.PlanID = candidatePlan.ID
+		entitlements = append(entitlements, entReq.ToEntitlement(ctx))
+	}
+
+	return candidatePlan, prices, entitlements, nil
+}
+
+// listSimulationSubscriptions returns the subscriptions that were active during the simulation range
+func (s *pricingSimulationService) listSimulationSubscriptions(ctx context.Context, req *dto.CreatePricingSimulationRequest) ([]*subscription.Subscription, error) {
+	filters := make([]*types.SubscriptionFilter, 0)
+	if len(req.CustomerIDs) == 0 {
+		filters = append(filters, types.NewNoLimitSubscriptionFilter())
+	}
+	for _, customerID := range lo.Uniq(req.CustomerIDs) {
+		filter := types.NewNoLimitSubscriptionFilter()
+		filter.CustomerID = customerID
+		filters = append(filters, filter)
+	}
+
+	subs := make([]*subscription.Subscription, 0)
+	for _, filter := range filters {
+		filter.SubscriptionStatusNotIn = []types.SubscriptionStatus{
+			types.SubscriptionStatusDraft,
+			types.SubscriptionStatusIncomplete,
+		}
+
+		items, err := s.SubRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sub := range items {
+			if !sub.StartDate.Before(req.EndTime) {
+				continue
+			}
+			if sub.CancelledAt != nil && sub.CancelledAt.Before(req.StartTime) {
+				continue
+			}
+			subs = append(subs, sub)
+		}
+	}
+
+	return subs, nil
+}
+
+// buildSimulatedSubscription returns a copy of the subscription on the candidate plan. Plan line items
+// are replaced by line items of the candidate prices matching the subscription currency and billing
+// period while addon line items are kept. Fixed candidate prices keep the quantity of the current line
+// item of the same price and fall back to the price default otherwise.
+func (s *pricingSimulationService) buildSimulatedSubscription(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	lineItems []*subscription.SubscriptionLineItem,
+	candidatePlan *dto.PlanResponse,
+	candidatePrices []*dto.PriceResponse,
+) (*subscription.Subscription, []*subscription.SubscriptionLineItem) {
+	simulatedSub := *sub
+	simulatedSub.PlanID = candidatePlan.ID
+
+	quantities := make(map[string]decimal.Decimal)
+	simulatedLineItems := make([]*subscription.SubscriptionLineItem, 0, len(lineItems))
+	for _, item := range lineItems {
+		if item.EntityType == types.SubscriptionLineItemEntityTypePlan {
+			quantities[item.PriceID] = item.Quantity
+			continue
+		}
+		simulatedLineItems = append(simulatedLineItems, item)
+	}
+
+	for _, p := range filterValidPricesForSubscription(candidatePrices, sub) {
+		lineItemReq := dto.CreateSubscriptionLineItemRequest{
+			PriceID:  p.ID,
+			Quantity: quantities[p.ID],
+		}
+		item := lineItemReq.ToSubscriptionLineItem(ctx, dto.LineItemParams{
+			Subscription: &dto.SubscriptionResponse{Subscription: &simulatedSub},
+			Price:        p,
+			Plan:         candidatePlan,
+			EntityType:   types.SubscriptionLineItemEntityTypePlan,
+		})
+		// The candidate plan is simulated as if the subscription had always been on it
+		item.StartDate = sub.StartDate
+		simulatedLineItems = append(simulatedLineItems, item)
+	}
+
+	simulatedSub.LineItems = simulatedLineItems
+	return &simulatedSub, simulatedLineItems
+}
+
+// resolveJobConfig returns the S3 job config of the result upload, populating bucket, region and key
+// prefix for Flexprice-managed connections
+func (s *pricingSimulationService) resolveJobConfig(ctx context.Context, connectionID string, jobConfig *types.S3JobConfig) (*types.S3JobConfig, error) {
+	conn, err := s.ConnectionRepo.Get(ctx, connectionID)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Connection not found").
+			Mark(ierr.ErrNotFound)
+	}
+
+	if conn.ProviderType != types.SecretProviderS3 {
+		return nil, ierr.NewError("connection is not an S3 connection").
+			WithHint("Pricing simulation results can only be uploaded to S3").
+			WithReportableDetails(map[string]interface{}{
+				"connection_id": connectionID,
+				"provider_type": conn.ProviderType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if conn.SyncConfig == nil || conn.SyncConfig.S3 == nil || !conn.SyncConfig.S3.IsFlexpriceManaged {
+		if err := jobConfig.Validate(); err != nil {
+			return nil, err
+		}
+		return jobConfig, nil
+	}
+
+	if err := jobConfig.ValidateForFlexpriceManaged(); err != nil {
+		return nil, err
+	}
+
+	resolved := &types.S3JobConfig{
+		Bucket:      s.Config.FlexpriceS3Exports.Bucket,
+		Region:      s.Config.FlexpriceS3Exports.Region,
+		KeyPrefix:   conn.SyncConfig.S3.KeyPrefix,
+		Compression: lo.Ternary(jobConfig.Compression == "", types.S3CompressionTypeNone, jobConfig.Compression),
+		Encryption:  lo.Ternary(jobConfig.Encryption == "", types.S3EncryptionTypeAES256, jobConfig.Encryption),
+	}
+	if err := resolved.Validate(); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// uploadResult uploads the result file to the S3 connection of the simulation and returns its URL
+func (s *pricingSimulationService) uploadResult(ctx context.Context, req *dto.CreatePricingSimulationRequest, fileName string, data []byte) (string, error) {
+	s3Integration, err := s.IntegrationFactory.GetS3Client(ctx)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to get S3 integration client from factory").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	s3Client, _, err := s3Integration.GetS3Client(ctx, req.JobConfig, req.ConnectionID)
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to get configured S3 client").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	uploadResponse, err := s3Client.UploadCSV(ctx, fileName, data, "pricing-simulation")
+	if err != nil {
+		return "", ierr.WithError(err).
+			WithHint("Failed to upload simulation result to S3").
+			Mark(ierr.ErrHTTPClient)
+	}
+
+	return uploadResponse.FileURL, nil
+}
+
+// failSimulation marks the simulation task as failed and returns the original error
+func (s *pricingSimulationService) failSimulation(ctx context.Context, t *task.Task, cause error) error {
+	now := time.Now().UTC()
+	t.TaskStatus = types.TaskStatusFailed
+	t.FailedAt = &now
+	t.ErrorSummary = lo.ToPtr(cause.Error())
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		s.Logger.Errorw("failed to mark pricing simulation as failed", "error", err, "task_id", t.ID)
+	}
+	return cause
+}
+
+// activeLineItemsForPeriod returns the published line items that overlap the billing period
+func activeLineItemsForPeriod(lineItems []*subscription.SubscriptionLineItem, periodStart, periodEnd time.Time) []*subscription.SubscriptionLineItem {
+	return lo.Filter(lineItems, func(item *subscription.SubscriptionLineItem, _ int) bool {
+		return item.Status == types.StatusPublished &&
+			item.StartDate.Before(periodEnd) &&
+			(item.EndDate.IsZero() || item.EndDate.After(periodStart))
+	})
+}
+
+// buildPricingSimulationRows returns the CSV rows of the simulation results sorted by currency and
+// customer, followed by one TOTAL row per currency
+func buildPricingSimulationRows(results []*dto.PricingSimulationCustomerResult) []*pricingSimulationCSV {
+	sorted := make([]*dto.PricingSimulationCustomerResult, len(results))
+	copy(sorted, results)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Currency != sorted[j].Currency {
+			return sorted[i].Currency < sorted[j].Currency
+		}
+		return sorted[i].CustomerID < sorted[j].CustomerID
+	})
+
+	rows := make([]*pricingSimulationCSV, 0, len(sorted))
+	for _, r := range sorted {
+		rows = append(rows, toPricingSimulationCSV(r))
+	}
+
+	totals := summarizePricingSimulation(results)
+	currencies := lo.Keys(totals)
+	sort.Strings(currencies)
+	for _, currency := range currencies {
+		rows = append(rows, toPricingSimulationCSV(totals[currency]))
+	}
+
+	return rows
+}
+
+// summarizePricingSimulation aggregates the simulation results per currency
+func summarizePricingSimulation(results []*dto.PricingSimulationCustomerResult) map[string]*dto.PricingSimulationCustomerResult {
+	totals := make(map[string]*dto.PricingSimulationCustomerResult)
+	for _, r := range results {
+		total, ok := totals[r.Currency]
+		if !ok {
+			total = &dto.PricingSimulationCustomerResult{
+				CustomerID:      pricingSimulationTotalRow,
+				Currency:        r.Currency,
+				CurrentAmount:   decimal.Zero,
+				SimulatedAmount: decimal.Zero,
+			}
+			totals[r.Currency] = total
+		}
+		total.Subscriptions += r.Subscriptions
+		total.BillingPeriods += r.BillingPeriods
+		total.CurrentAmount = total.CurrentAmount.Add(r.CurrentAmount)
+		total.SimulatedAmount = total.SimulatedAmount.Add(r.SimulatedAmount)
+	}
+	return totals
+}
+
+func toPricingSimulationCSV(r *dto.PricingSimulationCustomerResult) *pricingSimulationCSV {
+	return &pricingSimulationCSV{
+		CustomerID:         r.CustomerID,
+		ExternalCustomerID: r.ExternalCustomerID,
+		CustomerName:       r.CustomerName,
+		Currency:           r.Currency,
+		Subscriptions:      r.Subscriptions,
+		BillingPeriods:     r.BillingPeriods,
+		CurrentAmount:      r.CurrentAmount.String(),
+		SimulatedAmount:    r.SimulatedAmount.String(),
+		Delta:              r.Delta().String(),
+		DeltaPercent:       r.DeltaPercent().String(),
+	}
+}
+
+// toMetadataMap converts a value into a generic map so that it can be stored in task metadata
+func toMetadataMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode task metadata").
+			Mark(ierr.ErrInternal)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode task metadata").
+			Mark(ierr.ErrInternal)
+	}
+	return m, nil
+}
+
+// toMetadataList converts a slice into a generic list so that it can be stored in task metadata
+func toMetadataList(v interface{}) ([]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode task metadata").
+			Mark(ierr.ErrInternal)
+	}
+
+	var l []interface{}
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to encode task metadata").
+			Mark(ierr.ErrInternal)
+	}
+	return l, nil
+}
+
+// fromMetadataMap decodes a value stored in task metadata by toMetadataMap
+func fromMetadataMap(m interface{}, v interface{}) error {
+	data, err := json.Marshal(m)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to decode task metadata").
+			Mark(ierr.ErrInternal)
+	}
+	return nil
+}
+
+// simulationPriceRepository serves the in-memory prices of a candidate plan and delegates
+// everything else to the underlying repository
+type simulationPriceRepository struct {
+	price.Repository
+	prices map[string]*price.Price
+}
+
+func (r *simulationPriceRepository) Get(ctx context.Context, id string) (*price.Price, error) {
+	if p, ok := r.prices[id]; ok {
+		return p, nil
+	}
+	return r.Repository.Get(ctx, id)
+}
+
+func (r *simulationPriceRepository) List(ctx context.Context, filter *types.PriceFilter) ([]*price.Price, error) {
+	candidates, remaining := r.split(filter)
+	if len(candidates) == 0 {
+		return r.Repository.List(ctx, filter)
+	}
+	if len(remaining) == 0 {
+		return candidates, nil
+	}
+
+	remainingFilter := *filter
+	remainingFilter.PriceIDs = remaining
+	prices, err := r.Repository.List(ctx, &remainingFilter)
+	if err != nil {
+		return nil, err
+	}
+	return append(candidates, prices...), nil
+}
+
+func (r *simulationPriceRepository) Count(ctx context.Context, filter *types.PriceFilter) (int, error) {
+	candidates, remaining := r.split(filter)
+	if len(candidates) == 0 {
+		return r.Repository.Count(ctx, filter)
+	}
+	if len(remaining) == 0 {
+		return len(candidates), nil
+	}
+
+	remainingFilter := *filter
+	remainingFilter.PriceIDs = remaining
+	count, err := r.Repository.Count(ctx, &remainingFilter)
+	if err != nil {
+		return 0, err
+	}
+	return len(candidates) + count, nil
+}
+
+// split separates the candidate prices requested by ID from the IDs that have to be loaded
+func (r *simulationPriceRepository) split(filter *types.PriceFilter) ([]*price.Price, []string) {
+	if filter == nil || len(filter.PriceIDs) == 0 {
+		return nil, nil
+	}
+
+	candidates := make([]*price.Price, 0)
+	remaining := make([]string, 0)
+	for _, id := range filter.PriceIDs {
+		if p, ok := r.prices[id]; ok {
+			candidates = append(candidates, p)
+		} else {
+			remaining = append(remaining, id)
+		}
+	}
+	return candidates, remaining
+}
+
+// simulationSubscriptionRepository serves the simulated copy of the subscription that is being
+// rated and delegates everything else to the underlying repository
+type simulationSubscriptionRepository struct {
+	subscription.Repository
+	sub       *subscription.Subscription
+	lineItems []*subscription.SubscriptionLineItem
+}
+
+func (r *simulationSubscriptionRepository) Get(ctx context.Context, id string) (*subscription.Subscription, error) {
+	if r.sub != nil && r.sub.ID == id {
+		return r.sub, nil
+	}
+	return r.Repository.Get(ctx, id)
+}
+
+func (r *simulationSubscriptionRepository) GetWithLineItems(ctx context.Context, id string) (*subscription.Subscription, []*subscription.SubscriptionLineItem, error) {
+	if r.sub != nil && r.sub.ID == id {
+		return r.sub, r.lineItems, nil
+	}
+	return r.Repository.GetWithLineItems(ctx, id)
+}
+
+// simulationEntitlementRepository serves the entitlements of the candidate plan. Entitlement
+// overrides of a subscription refer to the entitlements of its current plan and are left out.
+type simulationEntitlementRepository struct {
+	entitlement.Repository
+	planID       string
+	entitlements []*entitlement.Entitlement
+}
+
+func (r *simulationEntitlementRepository) List(ctx context.Context, filter *types.EntitlementFilter) ([]*entitlement.Entitlement, error) {
+	if filter == nil || filter.EntityType == nil {
+		return r.Repository.List(ctx, filter)
+	}
+
+	switch *filter.EntityType {
+	case types.ENTITLEMENT_ENTITY_TYPE_PLAN:
+		if lo.Contains(filter.EntityIDs, r.planID) {
+			return r.candidateEntitlements(filter), nil
+		}
+	case types.ENTITLEMENT_ENTITY_TYPE_SUBSCRIPTION:
+		return []*entitlement.Entitlement{}, nil
+	}
+	return r.Repository.List(ctx, filter)
+}
+
+func (r *simulationEntitlementRepository) Count(ctx context.Context, filter *types.EntitlementFilter) (int, error) {
+	if filter == nil || filter.EntityType == nil {
+		return r.Repository.Count(ctx, filter)
+	}
+
+	switch *filter.EntityType {
+	case types.ENTITLEMENT_ENTITY_TYPE_PLAN:
+		if lo.Contains(filter.EntityIDs, r.planID) {
+			return len(r.candidateEntitlements(filter)), nil
+		}
+	case types.ENTITLEMENT_ENTITY_TYPE_SUBSCRIPTION:
+		return 0, nil
+	}
+	return r.Repository.Count(ctx, filter)
+}
+
+func (r *simulationEntitlementRepository) ListByPlanIDs(ctx context.Context, planIDs []string) ([]*entitlement.Entitlement, error) {
+	if lo.Contains(planIDs, r.planID) {
+		return r.entitlements, nil
+	}
+	return r.Repository.ListByPlanIDs(ctx, planIDs)
+}
+
+// candidateEntitlements returns the entitlements of the candidate plan matching the feature filter
+func (r *simulationEntitlementRepository) candidateEntitlements(filter *types.EntitlementFilter) []*entitlement.Entitlement {
+	return lo.Filter(r.entitlements, func(e *entitlement.Entitlement, _ int) bool {
+		return e.Status == types.StatusPublished &&
+			(len(filter.FeatureIDs) == 0 || lo.Contains(filter.FeatureIDs, e.FeatureID))
+	})
+}
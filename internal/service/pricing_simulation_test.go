@@ -0,0 +1,383 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/feature"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PricingSimulationServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  PricingSimulationService
+	testData struct {
+		customer     *customer.Customer
+		meter        *meter.Meter
+		feature      *feature.Feature
+		subscription *subscription.Subscription
+	}
+}
+
+func TestPricingSimulationService(t *testing.T) {
+	suite.Run(t, new(PricingSimulationServiceSuite))
+}
+
+func (s *PricingSimulationServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.service = NewPricingSimulationService(ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   s.GetConfig(),
+		DB:                       s.GetDB(),
+		SubRepo:                  s.GetStores().SubscriptionRepo,
+		SubscriptionLineItemRepo: s.GetStores().SubscriptionLineItemRepo,
+		SubscriptionPhaseRepo:    s.GetStores().SubscriptionPhaseRepo,
+		PlanRepo:                 s.GetStores().PlanRepo,
+		PriceRepo:                s.GetStores().PriceRepo,
+		PriceUnitRepo:            s.GetStores().PriceUnitRepo,
+		EventRepo:                s.GetStores().EventRepo,
+		MeterRepo:                s.GetStores().MeterRepo,
+		CustomerRepo:             s.GetStores().CustomerRepo,
+		InvoiceRepo:              s.GetStores().InvoiceRepo,
+		EntitlementRepo:          s.GetStores().EntitlementRepo,
+		FeatureRepo:              s.GetStores().FeatureRepo,
+		TenantRepo:               s.GetStores().TenantRepo,
+		WalletRepo:               s.GetStores().WalletRepo,
+		AddonAssociationRepo:     s.GetStores().AddonAssociationRepo,
+		CouponAssociationRepo:    s.GetStores().CouponAssociationRepo,
+		SettingsRepo:             s.GetStores().SettingsRepo,
+		TaskRepo:                 s.GetStores().TaskRepo,
+		ConnectionRepo:           s.GetStores().ConnectionRepo,
+		FeatureUsageRepo:         s.GetStores().FeatureUsageRepo,
+		EventPublisher:           s.GetPublisher(),
+		WebhookPublisher:         s.GetWebhookPublisher(),
+		IntegrationFactory:       s.GetIntegrationFactory(),
+	})
+	s.setupTestData()
+}
+
+// setupTestData creates a subscription that started three months ago on a plan with a fixed fee
+// of 10 and API calls at 0.1 per call of which 1000 are included, and 100 API calls in each period
+func (s *PricingSimulationServiceSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         "cust_simulation",
+		ExternalID: "ext_cust_simulation",
+		Name:       "Simulation Customer",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.meter = &meter.Meter{
+		ID:          "meter_simulation_api_calls",
+		Name:        "API Calls",
+		EventName:   "simulation_api_call",
+		Aggregation: meter.Aggregation{Type: types.AggregationCount},
+		BaseModel:   types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().MeterRepo.CreateMeter(ctx, s.testData.meter))
+
+	s.testData.feature = &feature.Feature{
+		ID:        "feat_simulation_api_calls",
+		Name:      "API Calls",
+		Type:      types.FeatureTypeMetered,
+		MeterID:   s.testData.meter.ID,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().FeatureRepo.Create(ctx, s.testData.feature))
+
+	currentPlan := &plan.Plan{
+		ID:        "plan_simulation_current",
+		Name:      "Current",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, currentPlan))
+
+	_, err := s.GetStores().EntitlementRepo.Create(ctx, &entitlement.Entitlement{
+		ID:               "ent_simulation_current",
+		EntityType:       types.ENTITLEMENT_ENTITY_TYPE_PLAN,
+		EntityID:         currentPlan.ID,
+		FeatureID:        s.testData.feature.ID,
+		FeatureType:      types.FeatureTypeMetered,
+		IsEnabled:        true,
+		UsageLimit:       lo.ToPtr(int64(1000)),
+		UsageResetPeriod: types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY,
+		BaseModel:        types.GetDefaultBaseModel(ctx),
+	})
+	s.NoError(err)
+
+	fixedPrice := &price.Price{
+		ID:                 "price_simulation_fixed",
+		Amount:             decimal.NewFromInt(10),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           currentPlan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, fixedPrice))
+
+	usagePrice := &price.Price{
+		ID:                 "price_simulation_usage",
+		Amount:             decimal.NewFromFloat(0.1),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           currentPlan.ID,
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		MeterID:            s.testData.meter.ID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, usagePrice))
+
+	now := time.Now().UTC()
+	startDate := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -3, 0)
+	s.testData.subscription = &subscription.Subscription{
+		ID:                 "sub_simulation",
+		PlanID:             currentPlan.ID,
+		CustomerID:         s.testData.customer.ID,
+		StartDate:          startDate,
+		BillingAnchor:      startDate,
+		CurrentPeriodStart: startDate.AddDate(0, 3, 0),
+		CurrentPeriodEnd:   startDate.AddDate(0, 4, 0),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingCycle:       types.BillingCycleAnniversary,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+
+	lineItem := func(p *price.Price, quantity decimal.Decimal) *subscription.SubscriptionLineItem {
+		return &subscription.SubscriptionLineItem{
+			ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+			SubscriptionID:  s.testData.subscription.ID,
+			CustomerID:      s.testData.customer.ID,
+			EntityID:        currentPlan.ID,
+			EntityType:      types.SubscriptionLineItemEntityTypePlan,
+			PlanDisplayName: currentPlan.Name,
+			PriceID:         p.ID,
+			PriceType:       p.Type,
+			MeterID:         p.MeterID,
+			DisplayName:     p.ID,
+			Quantity:        quantity,
+			Currency:        p.Currency,
+			BillingPeriod:   p.BillingPeriod,
+			InvoiceCadence:  p.InvoiceCadence,
+			StartDate:       startDate,
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		}
+	}
+	lineItems := []*subscription.SubscriptionLineItem{
+		lineItem(fixedPrice, decimal.NewFromInt(1)),
+		lineItem(usagePrice, decimal.Zero),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, s.testData.subscription, lineItems))
+
+	for month := 0; month < 3; month++ {
+		for i := 0; i < 100; i++ {
+			s.NoError(s.GetStores().EventRepo.InsertEvent(ctx, &events.Event{
+				ID:                 s.GetUUID(),
+				TenantID:           types.GetTenantID(ctx),
+				EventName:          s.testData.meter.EventName,
+				ExternalCustomerID: s.testData.customer.ExternalID,
+				Timestamp:          startDate.AddDate(0, month, 10),
+				Properties:         map[string]interface{}{},
+			}))
+		}
+	}
+}
+
+func (s *PricingSimulationServiceSuite) TestCreateInlinePricingSimulation() {
+	startDate := s.testData.subscription.StartDate
+	usageLimit := int64(50)
+
+	resp, err := s.service.CreatePricingSimulation(s.GetContext(), dto.CreatePricingSimulationRequest{
+		CandidatePlan: &dto.SimulationCandidatePlan{
+			Name: "Candidate",
+			Prices: []dto.CreatePriceRequest{
+				{
+					Amount:             lo.ToPtr(decimal.NewFromInt(20)),
+					Currency:           "usd",
+					Type:               types.PRICE_TYPE_FIXED,
+					BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+					BillingPeriodCount: 1,
+					BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+					BillingCadence:     types.BILLING_CADENCE_RECURRING,
+					InvoiceCadence:     types.InvoiceCadenceAdvance,
+				},
+				{
+					Amount:             lo.ToPtr(decimal.NewFromFloat(0.1)),
+					Currency:           "usd",
+					Type:               types.PRICE_TYPE_USAGE,
+					BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+					BillingPeriodCount: 1,
+					BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+					BillingCadence:     types.BILLING_CADENCE_RECURRING,
+					InvoiceCadence:     types.InvoiceCadenceArrear,
+					MeterID:            s.testData.meter.ID,
+				},
+			},
+			Entitlements: []dto.CreateEntitlementRequest{
+				{
+					FeatureID:        s.testData.feature.ID,
+					FeatureType:      types.FeatureTypeMetered,
+					UsageLimit:       &usageLimit,
+					UsageResetPeriod: types.ENTITLEMENT_USAGE_RESET_PERIOD_MONTHLY,
+				},
+			},
+		},
+		// The second and third billing period of the subscription
+		StartTime:   startDate.AddDate(0, 1, 0),
+		EndTime:     startDate.AddDate(0, 3, 0),
+		CustomerIDs: []string{s.testData.customer.ID},
+	})
+	s.Require().NoError(err)
+
+	// Simulations without a connection run within the request
+	s.Empty(resp.WorkflowID)
+	s.Equal(types.TaskStatusCompleted, resp.Task.TaskStatus)
+	s.Require().Len(resp.Results, 1)
+
+	// The current plan includes all 100 calls of a period, the candidate plan only 50 of them
+	result := resp.Results[0]
+	s.Equal(s.testData.customer.ID, result.CustomerID)
+	s.Equal(1, result.Subscriptions)
+	s.Equal(2, result.BillingPeriods)
+	s.True(decimal.NewFromInt(20).Equal(result.CurrentAmount), "current amount %s", result.CurrentAmount)
+	s.True(decimal.NewFromInt(50).Equal(result.SimulatedAmount), "simulated amount %s", result.SimulatedAmount)
+	s.True(decimal.NewFromInt(30).Equal(resp.Summary["usd"].Delta()))
+
+	t, err := s.GetStores().TaskRepo.Get(s.GetContext(), resp.Task.ID)
+	s.Require().NoError(err)
+	s.Empty(t.FileURL)
+	s.Len(t.Metadata["results"], 2)
+}
+
+func (s *PricingSimulationServiceSuite) TestCreatePricingSimulationValidation() {
+	req := dto.CreatePricingSimulationRequest{
+		PlanID:    "plan_simulation_current",
+		StartTime: s.testData.subscription.StartDate,
+		EndTime:   s.testData.subscription.StartDate.AddDate(0, 1, 0),
+	}
+
+	// Simulations of all customers need an S3 connection for the result file
+	_, err := s.service.CreatePricingSimulation(s.GetContext(), req)
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+
+	req.CustomerIDs = []string{s.testData.customer.ID}
+	req.JobConfig = &types.S3JobConfig{Bucket: "bucket", Region: "us-east-1"}
+	_, err = s.service.CreatePricingSimulation(s.GetContext(), req)
+	s.Error(err)
+	s.True(ierr.IsValidation(err))
+}
+
+func TestBuildPricingSimulationRows(t *testing.T) {
+	results := []*dto.PricingSimulationCustomerResult{
+		{
+			CustomerID:      "cust_b",
+			Currency:        "usd",
+			Subscriptions:   1,
+			BillingPeriods:  2,
+			CurrentAmount:   decimal.NewFromInt(200),
+			SimulatedAmount: decimal.NewFromInt(150),
+		},
+		{
+			CustomerID:      "cust_a",
+			Currency:        "usd",
+			Subscriptions:   2,
+			BillingPeriods:  4,
+			CurrentAmount:   decimal.NewFromInt(100),
+			SimulatedAmount: decimal.NewFromInt(130),
+		},
+		{
+			CustomerID:      "cust_c",
+			Currency:        "eur",
+			Subscriptions:   1,
+			BillingPeriods:  1,
+			CurrentAmount:   decimal.Zero,
+			SimulatedAmount: decimal.NewFromInt(10),
+		},
+	}
+
+	rows := buildPricingSimulationRows(results)
+	require.Len(t, rows, 5)
+
+	// Customers are sorted by currency and customer, totals per currency come last
+	assert.Equal(t, []string{"cust_c", "cust_a", "cust_b", pricingSimulationTotalRow, pricingSimulationTotalRow},
+		[]string{rows[0].CustomerID, rows[1].CustomerID, rows[2].CustomerID, rows[3].CustomerID, rows[4].CustomerID})
+
+	assert.Equal(t, "30", rows[1].Delta)
+	assert.Equal(t, "30", rows[1].DeltaPercent)
+	assert.Equal(t, "-50", rows[2].Delta)
+	assert.Equal(t, "-25", rows[2].DeltaPercent)
+	// No current revenue has no meaningful percentage
+	assert.Equal(t, "0", rows[0].DeltaPercent)
+
+	eurTotal, usdTotal := rows[3], rows[4]
+	assert.Equal(t, "eur", eurTotal.Currency)
+	assert.Equal(t, "10", eurTotal.Delta)
+	assert.Equal(t, "usd", usdTotal.Currency)
+	assert.Equal(t, 3, usdTotal.Subscriptions)
+	assert.Equal(t, 6, usdTotal.BillingPeriods)
+	assert.Equal(t, "300", usdTotal.CurrentAmount)
+	assert.Equal(t, "280", usdTotal.SimulatedAmount)
+	assert.Equal(t, "-20", usdTotal.Delta)
+	assert.Equal(t, "-6.67", usdTotal.DeltaPercent)
+}
+
+func TestActiveLineItemsForPeriod(t *testing.T) {
+	periodStart := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	lineItem := func(id string, start, end time.Time, status types.Status) *subscription.SubscriptionLineItem {
+		return &subscription.SubscriptionLineItem{
+			ID:        id,
+			StartDate: start,
+			EndDate:   end,
+			BaseModel: types.BaseModel{Status: status},
+		}
+	}
+
+	items := []*subscription.SubscriptionLineItem{
+		lineItem("open_ended", periodStart.AddDate(0, -2, 0), time.Time{}, types.StatusPublished),
+		lineItem("ended_before", periodStart.AddDate(0, -2, 0), periodStart, types.StatusPublished),
+		lineItem("ended_within", periodStart.AddDate(0, -2, 0), periodStart.AddDate(0, 0, 10), types.StatusPublished),
+		lineItem("starts_after", periodEnd, time.Time{}, types.StatusPublished),
+		lineItem("deleted", periodStart, time.Time{}, types.StatusDeleted),
+	}
+
+	active := activeLineItemsForPeriod(items, periodStart, periodEnd)
+	ids := make([]string, 0, len(active))
+	for _, item := range active {
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, []string{"open_ended", "ended_within"}, ids)
+}
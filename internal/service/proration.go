@@ -9,6 +9,7 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/price"
+	priceDomain "github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/proration"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -40,6 +41,10 @@ func (s *prorationService) CalculateProration(ctx context.Context, params prorat
 		zap.String("action", string(params.Action)),
 	)
 
+	if params.RoundingMode == "" {
+		params.RoundingMode, _ = GetRoundingConfig(s.serviceParams, ctx).ForCurrency(params.Currency)
+	}
+
 	result, err := calculator.Calculate(ctx, params)
 	if err != nil {
 		s.serviceParams.Logger.Error("proration calculation failed",
@@ -277,6 +282,13 @@ func (s *prorationService) CalculateSubscriptionCancellationProration(
 	var processingErrors []error
 	processedCount := 0
 
+	// Line items are credited at the rate the customer was charged, which is the negotiated rate
+	// from the rate card of the customer when one applies
+	rateCard, err := getCustomerRateCard(ctx, s.serviceParams, subscription.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Process each active line item
 	for _, lineItem := range lineItems {
 		if lineItem.Status != types.StatusPublished {
@@ -305,6 +317,7 @@ func (s *prorationService) CalculateSubscriptionCancellationProration(
 				"price_id", lineItem.PriceID)
 			continue
 		}
+		price = priceDomain.RateCardPriceFor(rateCard, price, subscription.CurrentPeriodStart)
 
 		// Create proration parameters for cancellation
 		params, err := s.CreateProrationParamsForLineItemCancellation(
@@ -446,7 +459,7 @@ func (s *prorationService) CreateProrationParamsForLineItemCancellation(
 	// }
 
 	// For now, use calculated amount as fallback
-	originalAmountPaid := price.Amount.Mul(item.Quantity)
+	originalAmountPaid := proratedUnitAmount(price, item.Quantity).Mul(item.Quantity)
 
 	// TODO: Get any previous credits issued for this line item in current period
 	// previousCredits, err := s.getPreviousCreditsForLineItem(ctx, subscription.ID, item.ID, periodStart, effectiveDate)
@@ -480,7 +493,7 @@ func (s *prorationService) CreateProrationParamsForLineItemCancellation(
 		// For cancellation, we only have "old" values (what's being cancelled)
 		OldPriceID:      item.PriceID,
 		OldQuantity:     item.Quantity,
-		OldPricePerUnit: price.Amount,
+		OldPricePerUnit: proratedUnitAmount(price, item.Quantity),
 		NewPriceID:      "", // Nothing new for cancellation
 		NewQuantity:     decimal.Zero,
 		NewPricePerUnit: decimal.Zero,
@@ -557,7 +570,7 @@ func (s *prorationService) CreateProrationParamsForLineItem(
 		Action:                action,
 		NewPriceID:            item.PriceID,
 		NewQuantity:           item.Quantity,
-		NewPricePerUnit:       price.Amount,
+		NewPricePerUnit:       proratedUnitAmount(price, item.Quantity),
 		ProrationDate:         subscription.StartDate,
 		ProrationBehavior:     behavior,
 		CustomerTimezone:      subscription.CustomerTimezone,
@@ -569,6 +582,69 @@ func (s *prorationService) CreateProrationParamsForLineItem(
 	}, nil
 }
 
+// CalculateQuantityChangeProration calculates the proration for changing the quantity of a line item
+// at the effective date. The quantity in effect at the effective date is taken from the quantity
+// history of the line item so that consecutive changes within a period are prorated correctly.
+func (s *prorationService) CalculateQuantityChangeProration(
+	ctx context.Context,
+	subscription *subscription.Subscription,
+	item *subscription.SubscriptionLineItem,
+	price *price.Price,
+	newQuantity decimal.Decimal,
+	effectiveDate time.Time,
+	behavior types.ProrationBehavior,
+) (*proration.ProrationResult, error) {
+	oldQuantity := item.QuantityAt(effectiveDate)
+
+	// The calculator prorates unit price times quantity, using the effective unit price
+	// keeps tiered and package prices correct as unit price times quantity equals their cost
+	priceService := NewPriceService(s.serviceParams)
+	effectiveUnitPrice := func(quantity decimal.Decimal) decimal.Decimal {
+		if !quantity.IsPositive() {
+			return decimal.Zero
+		}
+		return priceService.CalculateCost(ctx, price, quantity).Div(quantity)
+	}
+
+	params := proration.ProrationParams{
+		SubscriptionID:        subscription.ID,
+		LineItemID:            item.ID,
+		PlanPayInAdvance:      price.InvoiceCadence == types.InvoiceCadenceAdvance,
+		CurrentPeriodStart:    subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:      subscription.CurrentPeriodEnd.Add(time.Second * -1),
+		Action:                types.ProrationActionQuantityChange,
+		OldPriceID:            item.PriceID,
+		NewPriceID:            item.PriceID,
+		OldQuantity:           oldQuantity,
+		NewQuantity:           newQuantity,
+		OldPricePerUnit:       effectiveUnitPrice(oldQuantity),
+		NewPricePerUnit:       effectiveUnitPrice(newQuantity),
+		ProrationDate:         effectiveDate,
+		ProrationBehavior:     behavior,
+		CustomerTimezone:      subscription.CustomerTimezone,
+		OriginalAmountPaid:    decimal.Zero,
+		PreviousCreditsIssued: decimal.Zero,
+		ProrationStrategy:     types.StrategySecondBased,
+		Currency:              price.Currency,
+		PlanDisplayName:       item.PlanDisplayName,
+	}
+
+	return s.CalculateProration(ctx, params)
+}
+
+// proratedUnitAmount returns the unit price the calculator prorates for a fixed line item.
+// Stair-step prices charge the flat amount of the bracket, which is spread over the quantity
+// so that unit price times quantity equals the bracket amount.
+func proratedUnitAmount(p *price.Price, quantity decimal.Decimal) decimal.Decimal {
+	if !p.IsStairStep() {
+		return p.Amount
+	}
+	if !quantity.IsPositive() {
+		return decimal.Zero
+	}
+	return p.CalculateStairStepAmount(quantity).Div(quantity)
+}
+
 // isRefundEligible determines if a customer is eligible for refund/credit based on cancellation scenario
 func (s *prorationService) isRefundEligible(
 	subscription *subscription.Subscription,
@@ -529,3 +529,34 @@ func (s *ProrationServiceSuite) TestCalculateSubscriptionProration() {
 		})
 	}
 }
+
+func TestProratedUnitAmount(t *testing.T) {
+	upTo10 := uint64(10)
+	flat100 := decimal.NewFromInt(100)
+	flat400 := decimal.NewFromInt(400)
+	stairStep := &price.Price{
+		Amount:       decimal.Zero,
+		BillingModel: types.BILLING_MODEL_TIERED,
+		TierMode:     types.BILLING_TIER_STAIR_STEP,
+		Tiers: []price.PriceTier{
+			{UpTo: &upTo10, FlatAmount: &flat100},
+			{FlatAmount: &flat400},
+		},
+	}
+
+	// Unit price times quantity is the bracket amount, which is what gets prorated
+	quantity := decimal.NewFromInt(11)
+	total := proratedUnitAmount(stairStep, quantity).Mul(quantity).Round(2)
+	if !total.Equal(flat400) {
+		t.Errorf("expected stair step total %s, got %s", flat400, total)
+	}
+	if !proratedUnitAmount(stairStep, decimal.Zero).IsZero() {
+		t.Errorf("expected zero unit amount for zero quantity")
+	}
+
+	// Other fixed prices keep prorating their unit amount
+	flatFee := &price.Price{Amount: decimal.NewFromInt(25), BillingModel: types.BILLING_MODEL_FLAT_FEE}
+	if !proratedUnitAmount(flatFee, quantity).Equal(decimal.NewFromInt(25)) {
+		t.Errorf("expected flat fee unit amount to be unchanged")
+	}
+}
@@ -0,0 +1,772 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/pdf"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/quote"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// metadataKeyQuoteID links a subscription to the quote it was converted from
+	metadataKeyQuoteID = "quote_id"
+)
+
+// QuoteService manages quotes proposing a subscription to a customer. Quotes are drafted, finalized
+// and shared with the customer, and converted into a subscription once the customer accepts them.
+type QuoteService interface {
+	CreateQuote(ctx context.Context, req dto.CreateQuoteRequest) (*dto.QuoteResponse, error)
+	GetQuote(ctx context.Context, id string) (*dto.QuoteResponse, error)
+	UpdateQuote(ctx context.Context, id string, req dto.UpdateQuoteRequest) (*dto.QuoteResponse, error)
+	DeleteQuote(ctx context.Context, id string) error
+	ListQuotes(ctx context.Context, filter *types.QuoteFilter) (*dto.ListQuotesResponse, error)
+
+	// FinalizeQuote assigns a quote number and opens the quote for acceptance
+	FinalizeQuote(ctx context.Context, id string) (*dto.QuoteResponse, error)
+	// AcceptQuote converts an open quote into a subscription with the quoted line items, coupons and phases
+	AcceptQuote(ctx context.Context, id string) (*dto.QuoteResponse, error)
+	DeclineQuote(ctx context.Context, id string, req dto.DeclineQuoteRequest) (*dto.QuoteResponse, error)
+
+	GetQuotePDF(ctx context.Context, id string) ([]byte, error)
+}
+
+type quoteService struct {
+	ServiceParams
+}
+
+// NewQuoteService creates a new quote service
+func NewQuoteService(params ServiceParams) QuoteService {
+	return &quoteService{
+		ServiceParams: params,
+	}
+}
+
+func (s *quoteService) CreateQuote(ctx context.Context, req dto.CreateQuoteRequest) (*dto.QuoteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.CustomerRepo.Get(ctx, req.CustomerID); err != nil {
+		return nil, err
+	}
+
+	p, err := s.PlanRepo.Get(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != types.StatusPublished || !p.IsSubscribable() {
+		return nil, ierr.NewError("plan is not available for subscriptions").
+			WithHint("Quotes can only be created for published plans").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id": req.PlanID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	q := req.ToQuote(ctx)
+	if err := s.prepareQuote(ctx, q, req.LineItems); err != nil {
+		return nil, err
+	}
+
+	if err := s.QuoteRepo.CreateWithLineItems(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return &dto.QuoteResponse{Quote: q}, nil
+}
+
+func (s *quoteService) GetQuote(ctx context.Context, id string) (*dto.QuoteResponse, error) {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.QuoteResponse{Quote: q}, nil
+}
+
+func (s *quoteService) UpdateQuote(ctx context.Context, id string, req dto.UpdateQuoteRequest) (*dto.QuoteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.QuoteStatus != types.QuoteStatusDraft {
+		return nil, ierr.NewError("only draft quotes can be updated").
+			WithHint("Create a new quote to change an open quote").
+			WithReportableDetails(map[string]interface{}{
+				"quote_id":     q.ID,
+				"quote_status": q.QuoteStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if req.Description != nil {
+		q.Description = *req.Description
+	}
+	if req.StartDate != nil {
+		q.StartDate = req.StartDate
+	}
+	if req.ValidUntil != nil {
+		q.ValidUntil = *req.ValidUntil
+	}
+	if req.Coupons != nil {
+		q.Coupons = req.Coupons
+	}
+	if req.Phases != nil {
+		q.Phases = req.Phases
+	}
+	if req.Metadata != nil {
+		q.Metadata = req.Metadata
+	}
+
+	// Line items are recalculated on every update as the phases and prices of the plan may have changed
+	lineItems := req.LineItems
+	if lineItems == nil {
+		lineItems = toQuoteLineItemRequests(q.LineItems)
+	}
+	if err := dto.ValidateQuoteLineItems(lineItems, q.Phases); err != nil {
+		return nil, err
+	}
+
+	if err := s.prepareQuote(ctx, q, lineItems); err != nil {
+		return nil, err
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.QuoteRepo.Update(ctx, q); err != nil {
+			return err
+		}
+		return s.QuoteRepo.ReplaceLineItems(ctx, q)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.QuoteResponse{Quote: q}, nil
+}
+
+func (s *quoteService) DeleteQuote(ctx context.Context, id string) error {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if q.QuoteStatus != types.QuoteStatusDraft {
+		return ierr.NewError("only draft quotes can be deleted").
+			WithHint("Open quotes can be declined instead").
+			WithReportableDetails(map[string]interface{}{
+				"quote_id":     q.ID,
+				"quote_status": q.QuoteStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return s.QuoteRepo.Delete(ctx, id)
+}
+
+func (s *quoteService) ListQuotes(ctx context.Context, filter *types.QuoteFilter) (*dto.ListQuotesResponse, error) {
+	if filter == nil {
+		filter = types.NewQuoteFilter()
+	}
+
+	if filter.QueryFilter == nil {
+		filter.QueryFilter = types.NewDefaultQueryFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	quotes, err := s.QuoteRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.QuoteRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := lo.Map(quotes, func(q *quote.Quote, _ int) *dto.QuoteResponse {
+		return &dto.QuoteResponse{Quote: q}
+	})
+
+	listResponse := types.NewListResponse(responses, total, filter.GetLimit(), filter.GetOffset())
+	return &listResponse, nil
+}
+
+func (s *quoteService) FinalizeQuote(ctx context.Context, id string) (*dto.QuoteResponse, error) {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.QuoteStatus != types.QuoteStatusDraft {
+		return nil, ierr.NewError("only draft quotes can be finalized").
+			WithHint("The quote has already been finalized").
+			WithReportableDetails(map[string]interface{}{
+				"quote_id":     q.ID,
+				"quote_status": q.QuoteStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	now := time.Now().UTC()
+	if !q.ValidUntil.After(now) {
+		return nil, ierr.NewError("quote valid_until is in the past").
+			WithHint("Update valid_until before finalizing the quote").
+			WithReportableDetails(map[string]interface{}{
+				"quote_id":    q.ID,
+				"valid_until": q.ValidUntil,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	q.QuoteNumber = lo.ToPtr(types.GenerateShortIDWithPrefix(types.SHORT_ID_PREFIX_QUOTE))
+	q.QuoteStatus = types.QuoteStatusOpen
+	q.FinalizedAt = lo.ToPtr(now)
+	if err := s.QuoteRepo.Update(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return &dto.QuoteResponse{Quote: q}, nil
+}
+
+func (s *quoteService) AcceptQuote(ctx context.Context, id string) (*dto.QuoteResponse, error) {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateOpen(ctx, q); err != nil {
+		return nil, err
+	}
+
+	subReq, err := s.toSubscriptionRequest(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	var sub *dto.SubscriptionResponse
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		sub, err = NewSubscriptionService(s.ServiceParams).CreateSubscription(ctx, subReq)
+		if err != nil {
+			return err
+		}
+
+		q.QuoteStatus = types.QuoteStatusAccepted
+		q.AcceptedAt = lo.ToPtr(time.Now().UTC())
+		q.SubscriptionID = lo.ToPtr(sub.ID)
+		return s.QuoteRepo.Update(ctx, q)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("converted quote into subscription",
+		"quote_id", q.ID,
+		"subscription_id", sub.ID,
+		"customer_id", q.CustomerID,
+		"plan_id", q.PlanID)
+
+	return &dto.QuoteResponse{Quote: q, Subscription: sub}, nil
+}
+
+func (s *quoteService) DeclineQuote(ctx context.Context, id string, req dto.DeclineQuoteRequest) (*dto.QuoteResponse, error) {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateOpen(ctx, q); err != nil {
+		return nil, err
+	}
+
+	q.QuoteStatus = types.QuoteStatusDeclined
+	q.DeclinedAt = lo.ToPtr(time.Now().UTC())
+	q.DeclineReason = req.Reason
+	if err := s.QuoteRepo.Update(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return &dto.QuoteResponse{Quote: q}, nil
+}
+
+func (s *quoteService) GetQuotePDF(ctx context.Context, id string) ([]byte, error) {
+	q, err := s.QuoteRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.CustomerRepo.Get(ctx, q.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.TenantRepo.GetByID(ctx, q.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceSvc := NewInvoiceService(s.ServiceParams).(*invoiceService)
+	data, err := s.getQuoteDataForPDFGen(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	data.Biller = invoiceSvc.getBillerInfo(tenant)
+	data.Recipient = invoiceSvc.getRecipientInfo(customer)
+
+	return s.PDFGenerator.RenderQuotePdf(ctx, data)
+}
+
+func (s *quoteService) getQuoteDataForPDFGen(ctx context.Context, q *quote.Quote) (*pdf.QuoteData, error) {
+	precision := types.GetCurrencyPrecision(q.Currency)
+	toFloat := func(amount decimal.Decimal) float64 {
+		f, _ := amount.Round(precision).Float64()
+		return f
+	}
+
+	data := &pdf.QuoteData{
+		ID:            q.ID,
+		QuoteNumber:   lo.FromPtr(q.QuoteNumber),
+		QuoteStatus:   string(q.QuoteStatus),
+		Currency:      types.GetCurrencySymbol(q.Currency),
+		Precision:     precision,
+		IssuingDate:   pdf.CustomTime{Time: lo.FromPtr(q.FinalizedAt)},
+		ValidUntil:    pdf.CustomTime{Time: q.ValidUntil},
+		StartDate:     pdf.CustomTime{Time: lo.FromPtr(q.StartDate)},
+		BillingPeriod: string(q.BillingPeriod),
+		Description:   q.Description,
+		Subtotal:      toFloat(q.Subtotal),
+		TotalDiscount: toFloat(q.TotalDiscount),
+		Total:         toFloat(q.Total),
+	}
+
+	prices := make(map[string]*price.Price)
+	for _, item := range q.LineItems {
+		if _, ok := prices[item.PriceID]; ok {
+			continue
+		}
+		p, err := s.PriceRepo.Get(ctx, item.PriceID)
+		if err != nil {
+			return nil, err
+		}
+		prices[item.PriceID] = p
+	}
+
+	for _, item := range q.LineItems {
+		phase := ""
+		if item.PhaseIndex != nil {
+			phase = quotePhaseName(q.Phases, *item.PhaseIndex)
+		}
+		data.LineItems = append(data.LineItems, pdf.QuoteLineItemData{
+			DisplayName:        item.DisplayName,
+			Phase:              phase,
+			Quantity:           item.Quantity.InexactFloat64(),
+			DiscountPercentage: item.DiscountPercentage.InexactFloat64(),
+			Amount:             toFloat(item.Amount),
+			Usage:              prices[item.PriceID].Type == types.PRICE_TYPE_USAGE,
+		})
+	}
+
+	// Phase dates are only known when the quote has a start date
+	var phaseDates []dto.SubscriptionPhaseCreateRequest
+	if q.StartDate != nil {
+		var err error
+		phaseDates, err = quotePhaseDates(q, *q.StartDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, phase := range q.Phases {
+		phaseData := pdf.QuotePhaseData{
+			Name:            quotePhaseName(q.Phases, i),
+			DurationPeriods: phase.DurationPeriods,
+			Amount:          toFloat(q.AmountForPhase(i)),
+		}
+		if phaseDates != nil {
+			phaseData.StartDate = pdf.CustomTime{Time: phaseDates[i].StartDate}
+			phaseData.EndDate = pdf.CustomTime{Time: lo.FromPtr(phaseDates[i].EndDate)}
+		}
+		data.Phases = append(data.Phases, phaseData)
+	}
+
+	return data, nil
+}
+
+// validateOpen checks that the customer can still accept or decline the quote. Open quotes past
+// their validity date are marked as expired.
+func (s *quoteService) validateOpen(ctx context.Context, q *quote.Quote) error {
+	if q.QuoteStatus == types.QuoteStatusOpen && q.IsExpired(time.Now().UTC()) {
+		q.QuoteStatus = types.QuoteStatusExpired
+		if err := s.QuoteRepo.Update(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	if q.QuoteStatus != types.QuoteStatusOpen {
+		return ierr.NewError("quote is not open").
+			WithHint("Only finalized quotes which have not expired can be accepted or declined").
+			WithReportableDetails(map[string]interface{}{
+				"quote_id":     q.ID,
+				"quote_status": q.QuoteStatus,
+				"valid_until":  q.ValidUntil,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// prepareQuote validates the coupons of the quote, builds its line items from the prices of the plan
+// and calculates its totals
+func (s *quoteService) prepareQuote(ctx context.Context, q *quote.Quote, reqs []dto.QuoteLineItemRequest) error {
+	if err := s.validateCoupons(ctx, q); err != nil {
+		return err
+	}
+
+	lineItems, err := s.buildLineItems(ctx, q, reqs)
+	if err != nil {
+		return err
+	}
+	q.LineItems = lineItems
+
+	return s.calculateTotals(ctx, q)
+}
+
+// planPrices returns the prices of the plan a subscription created from the quote is billed for
+func (s *quoteService) planPrices(ctx context.Context, q *quote.Quote) ([]*dto.PriceResponse, error) {
+	prices, err := NewPriceService(s.ServiceParams).GetPricesByPlanID(ctx, dto.GetPricesByPlanRequest{
+		PlanID: q.PlanID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validPrices := filterValidPricesForSubscription(prices.Items, &subscription.Subscription{
+		Currency:           q.Currency,
+		BillingPeriod:      q.BillingPeriod,
+		BillingPeriodCount: q.BillingPeriodCount,
+	})
+	if len(validPrices) == 0 {
+		return nil, ierr.NewError("no prices found for quote").
+			WithHint("The plan has no prices for the currency and billing period of the quote").
+			WithReportableDetails(map[string]interface{}{
+				"plan_id":        q.PlanID,
+				"currency":       q.Currency,
+				"billing_period": q.BillingPeriod,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return validPrices, nil
+}
+
+// buildLineItems quotes every price of the plan. Prices without a line item request are quoted at
+// their plan amount, line item requests with a phase are added for the prices they change in the phase.
+func (s *quoteService) buildLineItems(ctx context.Context, q *quote.Quote, reqs []dto.QuoteLineItemRequest) ([]*quote.LineItem, error) {
+	prices, err := s.planPrices(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	priceMap := lo.SliceToMap(prices, func(p *dto.PriceResponse) (string, *price.Price) {
+		return p.Price.ID, p.Price
+	})
+
+	baseReqs := make(map[string]dto.QuoteLineItemRequest, len(reqs))
+	for _, req := range reqs {
+		p, ok := priceMap[req.PriceID]
+		if !ok {
+			return nil, ierr.NewError("price is not part of the quoted plan").
+				WithHint("Line items must quote prices of the plan in the currency and billing period of the quote").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": req.PriceID,
+					"plan_id":  q.PlanID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if (req.UnitAmount != nil || req.DiscountPercentage != nil) &&
+			p.BillingModel != types.BILLING_MODEL_FLAT_FEE && p.BillingModel != types.BILLING_MODEL_PACKAGE {
+			return nil, ierr.NewError("unit_amount and discount_percentage are only supported for flat fee and package prices").
+				WithHint("Use a coupon to discount tiered prices").
+				WithReportableDetails(map[string]interface{}{
+					"price_id":      req.PriceID,
+					"billing_model": p.BillingModel,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if req.PhaseIndex == nil {
+			baseReqs[req.PriceID] = req
+		}
+	}
+
+	lineItems := make([]*quote.LineItem, 0, len(prices)+len(reqs))
+	for _, p := range prices {
+		req, ok := baseReqs[p.Price.ID]
+		if !ok {
+			req = dto.QuoteLineItemRequest{PriceID: p.Price.ID}
+		}
+		lineItems = append(lineItems, s.newLineItem(ctx, q, p.Price, req))
+	}
+	for _, req := range reqs {
+		if req.PhaseIndex != nil {
+			lineItems = append(lineItems, s.newLineItem(ctx, q, priceMap[req.PriceID], req))
+		}
+	}
+
+	return lineItems, nil
+}
+
+// newLineItem creates the line item of a price and calculates its amount for a billing period
+func (s *quoteService) newLineItem(ctx context.Context, q *quote.Quote, p *price.Price, req dto.QuoteLineItemRequest) *quote.LineItem {
+	defaultQuantity := decimal.Zero
+	if p.Type == types.PRICE_TYPE_FIXED {
+		defaultQuantity = decimal.NewFromInt(1)
+	}
+
+	item := &quote.LineItem{
+		ID:                 types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUOTE_LINE_ITEM),
+		QuoteID:            q.ID,
+		PriceID:            p.ID,
+		DisplayName:        lo.CoalesceOrEmpty(req.DisplayName, p.DisplayName, p.Description),
+		Quantity:           lo.FromPtrOr(req.Quantity, defaultQuantity),
+		UnitAmount:         req.UnitAmount,
+		DiscountPercentage: lo.FromPtrOr(req.DiscountPercentage, decimal.Zero),
+		PhaseIndex:         req.PhaseIndex,
+		Currency:           q.Currency,
+		Metadata:           req.Metadata,
+		EnvironmentID:      q.EnvironmentID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+
+	quoted := p
+	if item.UnitAmount != nil {
+		override := *p
+		override.Amount = *item.UnitAmount
+		quoted = &override
+	}
+
+	amount := NewPriceService(s.ServiceParams).CalculateCost(ctx, quoted, item.Quantity)
+	if !item.DiscountPercentage.IsZero() {
+		hundred := decimal.NewFromInt(100)
+		amount = amount.Mul(hundred.Sub(item.DiscountPercentage)).Div(hundred)
+	}
+	item.Amount = GetRoundingConfig(s.ServiceParams, ctx).RoundLineItemAmount(amount, q.Currency)
+
+	return item
+}
+
+// validateCoupons checks that the coupons of the quote and its phases can be redeemed
+func (s *quoteService) validateCoupons(ctx context.Context, q *quote.Quote) error {
+	couponIDs := append([]string{}, q.Coupons...)
+	for _, phase := range q.Phases {
+		couponIDs = append(couponIDs, phase.Coupons...)
+	}
+
+	for _, id := range lo.Uniq(couponIDs) {
+		c, err := s.CouponRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !c.IsValid() {
+			return ierr.NewError("coupon can not be redeemed").
+				WithHint("The coupon is expired or has reached its maximum redemptions").
+				WithReportableDetails(map[string]interface{}{
+					"coupon_id": id,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if c.Type == types.CouponTypeFixed && !types.IsMatchingCurrency(c.Currency, q.Currency) {
+			return ierr.NewError("coupon currency does not match the quote currency").
+				WithHint("Fixed amount coupons must be in the currency of the quote").
+				WithReportableDetails(map[string]interface{}{
+					"coupon_id":       id,
+					"coupon_currency": c.Currency,
+					"quote_currency":  q.Currency,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// calculateTotals calculates the amounts of the first billing period of the quote. Coupons of the
+// quote and of its first phase are applied one after the other.
+func (s *quoteService) calculateTotals(ctx context.Context, q *quote.Quote) error {
+	subtotal := q.AmountForPhase(0)
+
+	couponIDs := append([]string{}, q.Coupons...)
+	if len(q.Phases) > 0 {
+		couponIDs = append(couponIDs, q.Phases[0].Coupons...)
+	}
+
+	totalDiscount := decimal.Zero
+	remaining := subtotal
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	for _, id := range couponIDs {
+		c, err := s.CouponRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		result := c.ApplyDiscountWithRounding(remaining, q.Currency, rounding)
+		totalDiscount = totalDiscount.Add(result.Discount)
+		remaining = result.FinalPrice
+	}
+
+	q.Subtotal = subtotal
+	q.TotalDiscount = totalDiscount
+	q.Total = remaining
+	return nil
+}
+
+// toSubscriptionRequest builds the request creating the subscription of an accepted quote
+func (s *quoteService) toSubscriptionRequest(ctx context.Context, q *quote.Quote) (dto.CreateSubscriptionRequest, error) {
+	start := time.Now().UTC()
+	if q.StartDate != nil {
+		start = q.StartDate.UTC()
+	}
+	start = start.Truncate(time.Millisecond)
+
+	prices, err := s.planPrices(ctx, q)
+	if err != nil {
+		return dto.CreateSubscriptionRequest{}, err
+	}
+	priceMap := lo.SliceToMap(prices, func(p *dto.PriceResponse) (string, *price.Price) {
+		return p.Price.ID, p.Price
+	})
+
+	req := dto.CreateSubscriptionRequest{
+		CustomerID:         q.CustomerID,
+		PlanID:             q.PlanID,
+		Currency:           q.Currency,
+		StartDate:          lo.ToPtr(start),
+		BillingCadence:     q.BillingCadence,
+		BillingPeriod:      q.BillingPeriod,
+		BillingPeriodCount: q.BillingPeriodCount,
+		BillingCycle:       q.BillingCycle,
+		Coupons:            q.Coupons,
+		OverrideLineItems:  quoteOverrideLineItems(q.LineItemsForPhase(0), priceMap),
+		Metadata: map[string]string{
+			metadataKeyQuoteID: q.ID,
+		},
+	}
+
+	if len(q.Phases) == 0 {
+		return req, nil
+	}
+
+	phases, err := quotePhaseDates(q, start)
+	if err != nil {
+		return dto.CreateSubscriptionRequest{}, err
+	}
+	for i := range phases {
+		phases[i].Coupons = q.Phases[i].Coupons
+		if i > 0 {
+			phases[i].OverrideLineItems = quoteOverrideLineItems(q.LineItemsForPhase(i), priceMap)
+		}
+	}
+	req.Phases = phases
+	// A last phase with a duration ends the subscription
+	req.EndDate = phases[len(phases)-1].EndDate
+
+	return req, nil
+}
+
+// quotePhaseDates returns the phases of a subscription starting at start for the phases of the quote
+func quotePhaseDates(q *quote.Quote, start time.Time) ([]dto.SubscriptionPhaseCreateRequest, error) {
+	anchor := start
+	if q.BillingCycle == types.BillingCycleCalendar {
+		anchor = types.CalculateCalendarBillingAnchor(start, q.BillingPeriod)
+	}
+
+	phases := make([]dto.SubscriptionPhaseCreateRequest, 0, len(q.Phases))
+	phaseStart := start
+	for i, phase := range q.Phases {
+		req := dto.SubscriptionPhaseCreateRequest{
+			StartDate: phaseStart,
+			Metadata: map[string]string{
+				metadataKeyQuoteID: q.ID,
+				"quote_phase":      quotePhaseName(q.Phases, i),
+			},
+		}
+
+		if phase.DurationPeriods > 0 {
+			end := phaseStart
+			for n := 0; n < phase.DurationPeriods; n++ {
+				next, err := types.NextBillingDate(end, anchor, q.BillingPeriodCount, q.BillingPeriod, nil)
+				if err != nil {
+					return nil, err
+				}
+				end = next
+			}
+			req.EndDate = lo.ToPtr(end)
+			phaseStart = end
+		}
+		phases = append(phases, req)
+	}
+	return phases, nil
+}
+
+// quoteOverrideLineItems returns the price overrides of the subscription for quoted line items which
+// change the quantity or amount of their price
+func quoteOverrideLineItems(items []*quote.LineItem, prices map[string]*price.Price) []dto.OverrideLineItemRequest {
+	var overrides []dto.OverrideLineItemRequest
+	for _, item := range items {
+		p := prices[item.PriceID]
+		if p == nil {
+			continue
+		}
+
+		override := dto.OverrideLineItemRequest{PriceID: item.PriceID}
+		if p.Type == types.PRICE_TYPE_FIXED && !item.Quantity.Equal(decimal.NewFromInt(1)) {
+			override.Quantity = lo.ToPtr(item.Quantity)
+		}
+		if item.HasOverride() {
+			override.Amount = lo.ToPtr(item.DiscountedUnitAmount(lo.FromPtrOr(item.UnitAmount, p.Amount)))
+		}
+
+		if override.Quantity != nil || override.Amount != nil {
+			overrides = append(overrides, override)
+		}
+	}
+	return overrides
+}
+
+// quotePhaseName returns the name of a phase, unnamed phases are numbered
+func quotePhaseName(phases []types.QuotePhase, index int) string {
+	if index < len(phases) && strings.TrimSpace(phases[index].Name) != "" {
+		return phases[index].Name
+	}
+	return "Phase " + strconv.Itoa(index+1)
+}
+
+// toQuoteLineItemRequests returns the requests recreating line items of a quote
+func toQuoteLineItemRequests(items []*quote.LineItem) []dto.QuoteLineItemRequest {
+	return lo.Map(items, func(item *quote.LineItem, _ int) dto.QuoteLineItemRequest {
+		req := dto.QuoteLineItemRequest{
+			PriceID:     item.PriceID,
+			DisplayName: item.DisplayName,
+			Quantity:    lo.ToPtr(item.Quantity),
+			UnitAmount:  item.UnitAmount,
+			PhaseIndex:  item.PhaseIndex,
+			Metadata:    item.Metadata,
+		}
+		if !item.DiscountPercentage.IsZero() {
+			req.DiscountPercentage = lo.ToPtr(item.DiscountPercentage)
+		}
+		return req
+	})
+}
@@ -0,0 +1,454 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/coupon"
+	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/meter"
+	pdfdomain "github.com/flexprice/flexprice/internal/domain/pdf"
+	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/quote"
+	"github.com/flexprice/flexprice/internal/domain/tenant"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuoteServiceSuite struct {
+	testutil.BaseServiceTestSuite
+	service  QuoteService
+	testData struct {
+		customer   *customer.Customer
+		plan       *plan.Plan
+		seatPrice  *price.Price
+		usagePrice *price.Price
+		coupon     *coupon.Coupon
+	}
+}
+
+func TestQuoteService(t *testing.T) {
+	suite.Run(t, new(QuoteServiceSuite))
+}
+
+func (s *QuoteServiceSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+	s.service = NewQuoteService(ServiceParams{
+		Logger:                   s.GetLogger(),
+		Config:                   s.GetConfig(),
+		DB:                       s.GetDB(),
+		PDFGenerator:             s.GetPDFGenerator(),
+		QuoteRepo:                s.GetStores().QuoteRepo,
+		TaxAssociationRepo:       s.GetStores().TaxAssociationRepo,
+		TaxRateRepo:              s.GetStores().TaxRateRepo,
+		SubRepo:                  s.GetStores().SubscriptionRepo,
+		SubscriptionLineItemRepo: s.GetStores().SubscriptionLineItemRepo,
+		SubscriptionPhaseRepo:    s.GetStores().SubscriptionPhaseRepo,
+		SubScheduleRepo:          s.GetStores().SubscriptionScheduleRepo,
+		PlanRepo:                 s.GetStores().PlanRepo,
+		PriceRepo:                s.GetStores().PriceRepo,
+		PriceUnitRepo:            s.GetStores().PriceUnitRepo,
+		MeterRepo:                s.GetStores().MeterRepo,
+		CustomerRepo:             s.GetStores().CustomerRepo,
+		InvoiceRepo:              s.GetStores().InvoiceRepo,
+		EntitlementRepo:          s.GetStores().EntitlementRepo,
+		FeatureRepo:              s.GetStores().FeatureRepo,
+		TenantRepo:               s.GetStores().TenantRepo,
+		WalletRepo:               s.GetStores().WalletRepo,
+		CreditGrantRepo:          s.GetStores().CreditGrantRepo,
+		CouponRepo:               s.GetStores().CouponRepo,
+		CouponAssociationRepo:    s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo:    s.GetStores().CouponApplicationRepo,
+		AddonAssociationRepo:     s.GetStores().AddonAssociationRepo,
+		ConnectionRepo:           s.GetStores().ConnectionRepo,
+		SettingsRepo:             s.GetStores().SettingsRepo,
+		EventPublisher:           s.GetPublisher(),
+		WebhookPublisher:         s.GetWebhookPublisher(),
+		ProrationCalculator:      s.GetCalculator(),
+		IntegrationFactory:       s.GetIntegrationFactory(),
+	})
+	s.setupTestData()
+}
+
+func (s *QuoteServiceSuite) setupTestData() {
+	ctx := s.GetContext()
+
+	s.testData.customer = &customer.Customer{
+		ID:         types.GenerateUUIDWithPrefix(types.UUID_PREFIX_CUSTOMER),
+		ExternalID: "ext_quote_customer",
+		Name:       "Quote Customer",
+		Email:      "quotes@example.com",
+		BaseModel:  types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CustomerRepo.Create(ctx, s.testData.customer))
+
+	s.testData.plan = &plan.Plan{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PLAN),
+		Name:      "Team",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, s.testData.plan))
+
+	s.testData.seatPrice = &price.Price{
+		ID:                 "price_quote_seats",
+		DisplayName:        "Seats",
+		Amount:             decimal.NewFromInt(10),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.seatPrice))
+
+	apiCalls := &meter.Meter{
+		ID:        types.GenerateUUIDWithPrefix(types.UUID_PREFIX_METER),
+		Name:      "API Calls",
+		EventName: "api_call",
+		Aggregation: meter.Aggregation{
+			Type: types.AggregationCount,
+		},
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().MeterRepo.CreateMeter(ctx, apiCalls))
+
+	s.testData.usagePrice = &price.Price{
+		ID:                 "price_quote_api_calls",
+		DisplayName:        "API Calls",
+		Amount:             decimal.NewFromFloat(0.01),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_USAGE,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		MeterID:            apiCalls.ID,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, s.testData.usagePrice))
+
+	s.testData.coupon = &coupon.Coupon{
+		ID:            "coupon_quote_10",
+		Name:          "10% off",
+		Type:          types.CouponTypePercentage,
+		Cadence:       types.CouponCadenceForever,
+		PercentageOff: lo.ToPtr(decimal.NewFromInt(10)),
+		Currency:      "usd",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(ctx, s.testData.coupon))
+}
+
+func (s *QuoteServiceSuite) createQuoteRequest() dto.CreateQuoteRequest {
+	return dto.CreateQuoteRequest{
+		CustomerID:     s.testData.customer.ID,
+		PlanID:         s.testData.plan.ID,
+		Currency:       "USD",
+		BillingCadence: types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:  types.BILLING_PERIOD_MONTHLY,
+		ValidUntil:     time.Now().UTC().Add(30 * 24 * time.Hour),
+		Coupons:        []string{s.testData.coupon.ID},
+		LineItems: []dto.QuoteLineItemRequest{
+			{
+				PriceID:            s.testData.seatPrice.ID,
+				Quantity:           lo.ToPtr(decimal.NewFromInt(3)),
+				DiscountPercentage: lo.ToPtr(decimal.NewFromInt(10)),
+			},
+		},
+	}
+}
+
+func (s *QuoteServiceSuite) TestCreateQuote() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.CreateQuote(ctx, s.createQuoteRequest())
+	s.Require().NoError(err)
+
+	s.Equal(types.QuoteStatusDraft, resp.QuoteStatus)
+	s.Equal("usd", resp.Currency)
+	s.Nil(resp.QuoteNumber)
+	s.Require().Len(resp.LineItems, 2, "prices of the plan which are not listed are quoted too")
+
+	seats, _ := lo.Find(resp.LineItems, func(item *quote.LineItem) bool {
+		return item.PriceID == s.testData.seatPrice.ID
+	})
+	s.Require().NotNil(seats)
+	s.Equal("Seats", seats.DisplayName)
+	s.True(decimal.NewFromInt(27).Equal(seats.Amount), "3 seats at 10 with a 10%% discount, got %s", seats.Amount)
+
+	usage, _ := lo.Find(resp.LineItems, func(item *quote.LineItem) bool {
+		return item.PriceID == s.testData.usagePrice.ID
+	})
+	s.Require().NotNil(usage)
+	s.True(usage.Quantity.IsZero())
+	s.True(usage.Amount.IsZero())
+
+	s.True(decimal.NewFromInt(27).Equal(resp.Subtotal))
+	s.True(decimal.NewFromFloat(2.7).Equal(resp.TotalDiscount), "got %s", resp.TotalDiscount)
+	s.True(decimal.NewFromFloat(24.3).Equal(resp.Total), "got %s", resp.Total)
+
+	stored, err := s.service.GetQuote(ctx, resp.ID)
+	s.Require().NoError(err)
+	s.Len(stored.LineItems, 2)
+}
+
+func (s *QuoteServiceSuite) TestCreateQuoteValidation() {
+	ctx := s.GetContext()
+
+	tests := []struct {
+		name   string
+		mutate func(req *dto.CreateQuoteRequest)
+	}{
+		{
+			name: "price_not_in_plan",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.LineItems = []dto.QuoteLineItemRequest{{PriceID: "price_unknown"}}
+			},
+		},
+		{
+			name: "phase_index_without_phase",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.LineItems[0].PhaseIndex = lo.ToPtr(0)
+			},
+		},
+		{
+			name: "open_ended_phase_before_last",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.Phases = []types.QuotePhase{{Name: "Ramp-up"}, {Name: "Full"}}
+			},
+		},
+		{
+			name: "start_date_in_future",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.StartDate = lo.ToPtr(time.Now().UTC().Add(24 * time.Hour))
+			},
+		},
+		{
+			name: "discount_above_hundred_percent",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.LineItems[0].DiscountPercentage = lo.ToPtr(decimal.NewFromInt(101))
+			},
+		},
+		{
+			name: "unknown_currency_prices",
+			mutate: func(req *dto.CreateQuoteRequest) {
+				req.Currency = "EUR"
+				req.LineItems = nil
+				req.Coupons = nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req := s.createQuoteRequest()
+			tt.mutate(&req)
+
+			_, err := s.service.CreateQuote(ctx, req)
+			s.Error(err)
+			s.True(ierr.IsValidation(err), "expected validation error, got %v", err)
+		})
+	}
+}
+
+func (s *QuoteServiceSuite) TestQuoteLifecycle() {
+	ctx := s.GetContext()
+
+	created, err := s.service.CreateQuote(ctx, s.createQuoteRequest())
+	s.Require().NoError(err)
+
+	// Drafts can be edited, line items are recalculated
+	updated, err := s.service.UpdateQuote(ctx, created.ID, dto.UpdateQuoteRequest{
+		Description: lo.ToPtr("Annual team proposal"),
+		LineItems: []dto.QuoteLineItemRequest{
+			{
+				PriceID:    s.testData.seatPrice.ID,
+				Quantity:   lo.ToPtr(decimal.NewFromInt(5)),
+				UnitAmount: lo.ToPtr(decimal.NewFromInt(8)),
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("Annual team proposal", updated.Description)
+	s.True(decimal.NewFromInt(40).Equal(updated.Subtotal), "got %s", updated.Subtotal)
+	s.True(decimal.NewFromInt(36).Equal(updated.Total), "got %s", updated.Total)
+
+	stored, err := s.service.GetQuote(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Len(stored.LineItems, 2)
+
+	finalized, err := s.service.FinalizeQuote(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Equal(types.QuoteStatusOpen, finalized.QuoteStatus)
+	s.Require().NotNil(finalized.QuoteNumber)
+	s.Contains(*finalized.QuoteNumber, types.SHORT_ID_PREFIX_QUOTE)
+	s.NotNil(finalized.FinalizedAt)
+
+	_, err = s.service.UpdateQuote(ctx, created.ID, dto.UpdateQuoteRequest{Description: lo.ToPtr("changed")})
+	s.True(ierr.IsValidation(err), "open quotes can not be edited")
+
+	s.True(ierr.IsValidation(s.service.DeleteQuote(ctx, created.ID)), "open quotes can not be deleted")
+
+	declined, err := s.service.DeclineQuote(ctx, created.ID, dto.DeclineQuoteRequest{Reason: "Too expensive"})
+	s.Require().NoError(err)
+	s.Equal(types.QuoteStatusDeclined, declined.QuoteStatus)
+	s.Equal("Too expensive", declined.DeclineReason)
+	s.NotNil(declined.DeclinedAt)
+
+	_, err = s.service.AcceptQuote(ctx, created.ID)
+	s.True(ierr.IsValidation(err), "declined quotes can not be accepted")
+
+	list, err := s.service.ListQuotes(ctx, &types.QuoteFilter{
+		QueryFilter: types.NewDefaultQueryFilter(),
+		QuoteStatus: []types.QuoteStatus{types.QuoteStatusDeclined},
+	})
+	s.Require().NoError(err)
+	s.Len(list.Items, 1)
+}
+
+func (s *QuoteServiceSuite) TestAcceptQuoteCreatesSubscriptionWithPhases() {
+	ctx := s.GetContext()
+
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	req := s.createQuoteRequest()
+	req.StartDate = lo.ToPtr(start)
+	rampUpCoupon := &coupon.Coupon{
+		ID:            "coupon_quote_ramp_up",
+		Name:          "Ramp-up discount",
+		Type:          types.CouponTypePercentage,
+		Cadence:       types.CouponCadenceForever,
+		PercentageOff: lo.ToPtr(decimal.NewFromInt(20)),
+		Currency:      "usd",
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().CouponRepo.Create(ctx, rampUpCoupon))
+
+	req.Phases = []types.QuotePhase{
+		{Name: "Ramp-up", DurationPeriods: 3, Coupons: []string{rampUpCoupon.ID}},
+		{Name: "Full price"},
+	}
+	// Seats cost 5 during the ramp-up
+	req.LineItems = append(req.LineItems, dto.QuoteLineItemRequest{
+		PriceID:    s.testData.seatPrice.ID,
+		Quantity:   lo.ToPtr(decimal.NewFromInt(3)),
+		UnitAmount: lo.ToPtr(decimal.NewFromInt(5)),
+		PhaseIndex: lo.ToPtr(0),
+	})
+
+	created, err := s.service.CreateQuote(ctx, req)
+	s.Require().NoError(err)
+	s.True(decimal.NewFromInt(15).Equal(created.Subtotal), "first period is billed at the ramp-up amount, got %s", created.Subtotal)
+
+	_, err = s.service.FinalizeQuote(ctx, created.ID)
+	s.Require().NoError(err)
+
+	accepted, err := s.service.AcceptQuote(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Equal(types.QuoteStatusAccepted, accepted.QuoteStatus)
+	s.Require().NotNil(accepted.SubscriptionID)
+	s.NotNil(accepted.AcceptedAt)
+
+	sub, err := s.GetStores().SubscriptionRepo.Get(ctx, *accepted.SubscriptionID)
+	s.Require().NoError(err)
+	s.Equal(s.testData.customer.ID, sub.CustomerID)
+	s.Equal(s.testData.plan.ID, sub.PlanID)
+	s.Equal(created.ID, sub.Metadata[metadataKeyQuoteID])
+	s.True(sub.StartDate.Equal(start))
+	s.Nil(sub.EndDate, "the last phase is open ended")
+
+	phaseFilter := types.NewSubscriptionPhaseFilter()
+	phaseFilter.SubscriptionIDs = []string{sub.ID}
+	phases, err := s.GetStores().SubscriptionPhaseRepo.List(ctx, phaseFilter)
+	s.Require().NoError(err)
+	s.Require().Len(phases, 2)
+
+	rampUpEnd := start.AddDate(0, 3, 0)
+	for _, phase := range phases {
+		if phase.StartDate.Equal(start) {
+			s.Require().NotNil(phase.EndDate)
+			s.True(phase.EndDate.Equal(rampUpEnd), "ramp-up lasts 3 billing periods, ends %s", phase.EndDate)
+		} else {
+			s.True(phase.StartDate.Equal(rampUpEnd))
+			s.Nil(phase.EndDate)
+		}
+	}
+
+	// Coupons of the first phase are applied with the subscription and end with the phase
+	couponFilter := types.NewCouponAssociationFilter()
+	couponFilter.SubscriptionIDs = []string{sub.ID}
+	couponFilter.CouponIDs = []string{rampUpCoupon.ID}
+	associations, err := s.GetStores().CouponAssociationRepo.List(ctx, couponFilter)
+	s.Require().NoError(err)
+	s.Require().Len(associations, 1)
+	s.Require().NotNil(associations[0].EndDate)
+	s.True(associations[0].EndDate.Equal(rampUpEnd))
+
+	// Accepted quotes can not be accepted twice
+	_, err = s.service.AcceptQuote(ctx, created.ID)
+	s.True(ierr.IsValidation(err))
+}
+
+func (s *QuoteServiceSuite) TestAcceptExpiredQuote() {
+	ctx := s.GetContext()
+
+	created, err := s.service.CreateQuote(ctx, s.createQuoteRequest())
+	s.Require().NoError(err)
+	finalized, err := s.service.FinalizeQuote(ctx, created.ID)
+	s.Require().NoError(err)
+
+	finalized.ValidUntil = time.Now().UTC().Add(-time.Hour)
+	s.NoError(s.GetStores().QuoteRepo.Update(ctx, finalized.Quote))
+
+	_, err = s.service.AcceptQuote(ctx, created.ID)
+	s.True(ierr.IsValidation(err))
+
+	stored, err := s.service.GetQuote(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Equal(types.QuoteStatusExpired, stored.QuoteStatus)
+	s.Nil(stored.SubscriptionID)
+}
+
+func (s *QuoteServiceSuite) TestGetQuotePDF() {
+	ctx := s.GetContext()
+
+	s.NoError(s.GetStores().TenantRepo.Create(ctx, &tenant.Tenant{
+		ID:     types.GetTenantID(ctx),
+		Name:   "Acme",
+		Status: types.StatusPublished,
+	}))
+
+	created, err := s.service.CreateQuote(ctx, s.createQuoteRequest())
+	s.Require().NoError(err)
+	finalized, err := s.service.FinalizeQuote(ctx, created.ID)
+	s.Require().NoError(err)
+
+	generator := s.GetPDFGenerator().(*testutil.MockPDFGenerator)
+	generator.On("RenderQuotePdf", mock.Anything, mock.MatchedBy(func(data *pdfdomain.QuoteData) bool {
+		return data.QuoteNumber == *finalized.QuoteNumber &&
+			data.Biller.Name == "Acme" &&
+			data.Recipient.Name == s.testData.customer.Name &&
+			len(data.LineItems) == 2 &&
+			data.Total == 24.3
+	})).Return([]byte("%PDF"), nil).Once()
+
+	pdf, err := s.service.GetQuotePDF(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Equal([]byte("%PDF"), pdf)
+	generator.AssertExpectations(s.T())
+}
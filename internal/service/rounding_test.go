@@ -373,3 +373,28 @@ func TestDiscountRounding_EdgeCases(t *testing.T) {
 		assert.True(t, result.FinalPrice.Equal(expectedFinal))
 	})
 }
+
+// TestDiscountRounding_ConfiguredPolicy tests that discounts follow the configured rounding policy
+func TestDiscountRounding_ConfiguredPolicy(t *testing.T) {
+	percentageOff := decimal.RequireFromString("12.5")
+	c := &coupon.Coupon{
+		Type:          types.CouponTypePercentage,
+		PercentageOff: &percentageOff,
+	}
+	price := decimal.RequireFromString("0.20") // 12.5% of 0.20 = 0.025
+
+	t.Run("Half_Even", func(t *testing.T) {
+		rounding := types.DefaultRoundingConfig()
+		rounding.Mode = types.RoundingModeHalfEven
+		result := c.ApplyDiscountWithRounding(price, "usd", rounding)
+		assert.True(t, result.Discount.Equal(decimal.RequireFromString("0.02")), "got %s", result.Discount)
+	})
+
+	t.Run("Invoice_Level_Keeps_Discount_Unrounded", func(t *testing.T) {
+		rounding := types.DefaultRoundingConfig()
+		rounding.Level = types.RoundingLevelInvoice
+		result := c.ApplyDiscountWithRounding(price, "usd", rounding)
+		assert.True(t, result.Discount.Equal(decimal.RequireFromString("0.025")), "got %s", result.Discount)
+		assert.True(t, result.FinalPrice.Equal(decimal.RequireFromString("0.175")), "got %s", result.FinalPrice)
+	})
+}
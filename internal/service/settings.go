@@ -118,6 +118,28 @@ func GetSetting[T any](s *settingsService, ctx context.Context, key types.Settin
 	return typedValue, nil
 }
 
+// GetRoundingConfig returns the rounding policy of the environment in the context.
+// A policy already carried by the context is reused. Calculations must not fail because
+// of a broken setting, so the default policy is returned when the setting cannot be read.
+func GetRoundingConfig(params ServiceParams, ctx context.Context) types.RoundingConfig {
+	if config, ok := types.GetRoundingConfigFromContext(ctx); ok {
+		return config
+	}
+	if params.SettingsRepo == nil {
+		return types.DefaultRoundingConfig()
+	}
+
+	settingsSvc := NewSettingsService(params).(*settingsService)
+	config, err := GetSetting[types.RoundingConfig](settingsSvc, ctx, types.SettingKeyRoundingConfig)
+	if err != nil {
+		params.Logger.Warnw("failed to get rounding config, using default",
+			"error", err,
+			"environment_id", types.GetEnvironmentID(ctx))
+		return types.DefaultRoundingConfig()
+	}
+	return config
+}
+
 // UpdateSetting updates a setting value (creates if doesn't exist)
 //
 // WHEN TO USE:
@@ -208,6 +230,12 @@ func (s *settingsService) GetSettingByKey(ctx context.Context, key types.Setting
 		return getSettingByKey[types.AlertConfig](s, ctx, key)
 	case types.SettingKeyPrepareProcessedEvents:
 		return getSettingByKey[*workflowModels.WorkflowConfig](s, ctx, key)
+	case types.SettingKeyRoundingConfig:
+		return getSettingByKey[types.RoundingConfig](s, ctx, key)
+	case types.SettingKeyDunningConfig:
+		return getSettingByKey[types.DunningConfig](s, ctx, key)
+	case types.SettingKeySpendLimitConfig:
+		return getSettingByKey[types.SpendLimitConfig](s, ctx, key)
 	default:
 		return nil, ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -246,6 +274,12 @@ func (s *settingsService) UpdateSettingByKey(ctx context.Context, key types.Sett
 		return updateSettingByKey[types.AlertConfig](s, ctx, key, req)
 	case types.SettingKeyPrepareProcessedEvents:
 		return updateSettingByKey[*workflowModels.WorkflowConfig](s, ctx, key, req)
+	case types.SettingKeyRoundingConfig:
+		return updateSettingByKey[types.RoundingConfig](s, ctx, key, req)
+	case types.SettingKeyDunningConfig:
+		return updateSettingByKey[types.DunningConfig](s, ctx, key, req)
+	case types.SettingKeySpendLimitConfig:
+		return updateSettingByKey[types.SpendLimitConfig](s, ctx, key, req)
 	default:
 		return nil, ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
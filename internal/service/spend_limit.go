@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// SpendLimitService enforces the spend limit of prepaid customers. The subscriptions of a customer
+// are limited while the real-time balance of the customer's active prepaid wallets in the
+// subscription currency is at or below the floor of the environment, which denies their
+// entitlements, and restored as soon as a top-up brings the balance back above the floor.
+type SpendLimitService interface {
+	// CheckSpendLimits reads the real-time balances of the customer's active prepaid wallets and
+	// limits or restores the subscriptions of the customer
+	CheckSpendLimits(ctx context.Context, customerID string) error
+
+	// EnforceSpendLimits limits or restores the subscriptions of a customer from the real-time
+	// balances of the customer's wallets. The balances must include every active prepaid wallet
+	// of the customer.
+	EnforceSpendLimits(ctx context.Context, customerID string, balances []*dto.WalletBalanceResponse) error
+}
+
+type spendLimitService struct {
+	ServiceParams
+}
+
+// NewSpendLimitService creates a new spend limit service
+func NewSpendLimitService(params ServiceParams) SpendLimitService {
+	return &spendLimitService{
+		ServiceParams: params,
+	}
+}
+
+// GetSpendLimitConfig returns the spend limit enforcement of the environment in the context.
+// Balance checks must not fail because of a broken setting, so the default, which does not limit
+// usage, is returned when the setting cannot be read.
+func GetSpendLimitConfig(params ServiceParams, ctx context.Context) types.SpendLimitConfig {
+	if params.SettingsRepo == nil {
+		return types.DefaultSpendLimitConfig()
+	}
+
+	settingsSvc := NewSettingsService(params).(*settingsService)
+	config, err := GetSetting[types.SpendLimitConfig](settingsSvc, ctx, types.SettingKeySpendLimitConfig)
+	if err != nil {
+		params.Logger.Warnw("failed to get spend limit config, using default",
+			"error", err,
+			"environment_id", types.GetEnvironmentID(ctx))
+		return types.DefaultSpendLimitConfig()
+	}
+	return config
+}
+
+func (s *spendLimitService) CheckSpendLimits(ctx context.Context, customerID string) error {
+	config := GetSpendLimitConfig(s.ServiceParams, ctx)
+
+	subscriptions, err := s.listEnforcedSubscriptions(ctx, config, customerID)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	wallets, err := s.WalletRepo.GetWalletsByCustomerID(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	walletService := NewWalletService(s.ServiceParams)
+	balances := make([]*dto.WalletBalanceResponse, 0, len(wallets))
+	for _, w := range wallets {
+		if w.WalletType != types.WalletTypePrePaid || w.WalletStatus != types.WalletStatusActive {
+			continue
+		}
+
+		// The spend limit state is left unchanged while a balance is unknown
+		balance, err := walletService.GetWalletBalanceV2(ctx, w.ID)
+		if err != nil {
+			return err
+		}
+		balances = append(balances, balance)
+	}
+
+	return s.enforceSpendLimits(ctx, config, subscriptions, balances)
+}
+
+func (s *spendLimitService) EnforceSpendLimits(ctx context.Context, customerID string, balances []*dto.WalletBalanceResponse) error {
+	config := GetSpendLimitConfig(s.ServiceParams, ctx)
+
+	subscriptions, err := s.listEnforcedSubscriptions(ctx, config, customerID)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	return s.enforceSpendLimits(ctx, config, subscriptions, balances)
+}
+
+// listEnforcedSubscriptions returns the subscriptions of the customer, nil when the spend limit is
+// disabled and none of them is limited as there is nothing to limit and nothing to restore
+func (s *spendLimitService) listEnforcedSubscriptions(ctx context.Context, config types.SpendLimitConfig, customerID string) ([]*subscription.Subscription, error) {
+	subscriptions, err := s.SubRepo.ListByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.IsEnabled() && !lo.ContainsBy(subscriptions, func(sub *subscription.Subscription) bool {
+		return sub.IsSpendLimited()
+	}) {
+		return nil, nil
+	}
+	return subscriptions, nil
+}
+
+// enforceSpendLimits moves each subscription to the spend limit state of the balance of its currency
+func (s *spendLimitService) enforceSpendLimits(
+	ctx context.Context,
+	config types.SpendLimitConfig,
+	subscriptions []*subscription.Subscription,
+	balances []*dto.WalletBalanceResponse,
+) error {
+	prepaidBalances := prepaidBalancesByCurrency(balances)
+
+	for _, sub := range subscriptions {
+		balance, hasPrepaidWallet := prepaidBalances[strings.ToLower(sub.Currency)]
+
+		state := types.SpendLimitStateOk
+		if config.IsEnabled() && hasPrepaidWallet && balance.LessThanOrEqual(config.Floor) {
+			state = config.EnforcementMode.LimitedState()
+		}
+
+		if err := s.setSpendLimitState(ctx, sub, state, balance, config.Floor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setSpendLimitState moves the subscription to the spend limit state and publishes the webhook of
+// the transition
+func (s *spendLimitService) setSpendLimitState(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	state types.SpendLimitState,
+	balance decimal.Decimal,
+	floor decimal.Decimal,
+) error {
+	previousState := sub.SpendLimitState
+	if previousState == "" {
+		previousState = types.SpendLimitStateOk
+	}
+	if previousState == state {
+		return nil
+	}
+
+	sub.SpendLimitState = state
+	if state.IsLimited() {
+		sub.SpendLimitedAt = lo.ToPtr(time.Now().UTC())
+	}
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("subscription spend limit state changed",
+		"subscription_id", sub.ID,
+		"customer_id", sub.CustomerID,
+		"previous_state", previousState,
+		"state", state,
+		"balance", balance,
+		"floor", floor)
+
+	eventName := types.WebhookEventSubscriptionSpendLimitReached
+	if !state.IsLimited() {
+		eventName = types.WebhookEventSubscriptionSpendLimitRestored
+	}
+	NewSubscriptionService(s.ServiceParams).(*subscriptionService).publishInternalWebhookEvent(ctx, eventName, sub.ID)
+	return nil
+}
+
+// prepaidBalancesByCurrency sums the real-time balance of the active prepaid wallets per currency.
+// Currencies without an active prepaid wallet are not limited.
+func prepaidBalancesByCurrency(balances []*dto.WalletBalanceResponse) map[string]decimal.Decimal {
+	result := make(map[string]decimal.Decimal)
+	for _, balance := range balances {
+		if balance == nil || balance.Wallet == nil {
+			continue
+		}
+		if balance.WalletType != types.WalletTypePrePaid || balance.WalletStatus != types.WalletStatusActive {
+			continue
+		}
+
+		realTimeBalance := balance.Balance
+		if balance.RealTimeBalance != nil {
+			realTimeBalance = *balance.RealTimeBalance
+		}
+
+		currency := strings.ToLower(balance.Currency)
+		result[currency] = result[currency].Add(realTimeBalance)
+	}
+	return result
+}
+
+// applySpendLimit denies the entitlements a spend limited subscription cannot use: the metered
+// features when its usage is blocked and every feature when it is suspended. The entitlements are
+// copied so that cached entitlements are left untouched.
+func applySpendLimit(sub *subscription.Subscription, entitlements []*dto.EntitlementResponse) []*dto.EntitlementResponse {
+	if !sub.IsSpendLimited() {
+		return entitlements
+	}
+
+	return lo.Map(entitlements, func(ent *dto.EntitlementResponse, _ int) *dto.EntitlementResponse {
+		if ent == nil || ent.Entitlement == nil || !sub.SpendLimitState.DeniesFeature(ent.FeatureType) {
+			return ent
+		}
+
+		denied := *ent.Entitlement
+		denied.IsEnabled = false
+
+		resp := *ent
+		resp.Entitlement = &denied
+		return &resp
+	})
+}
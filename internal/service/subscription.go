@@ -11,6 +11,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/addonassociation"
 	"github.com/flexprice/flexprice/internal/domain/customer"
 	"github.com/flexprice/flexprice/internal/domain/entitlement"
+	"github.com/flexprice/flexprice/internal/domain/experiment"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/interfaces"
@@ -97,6 +98,28 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		}
 	}
 
+	// Resolve the plan from its lookup key, customers of a running price experiment get their variant
+	var assignment *experiment.Assignment
+	if req.PlanID == "" {
+		publicPlan, err := s.PlanRepo.GetByLookupKey(ctx, req.PlanLookupKey)
+		if err != nil {
+			return nil, err
+		}
+		req.PlanID = publicPlan.ID
+
+		assignment, err = NewExperimentService(s.ServiceParams).ResolvePlanAssignment(ctx, publicPlan.ID, customer.ID)
+		if err != nil {
+			return nil, err
+		}
+		if assignment != nil {
+			req.PlanID = assignment.PlanID
+			req.Metadata = lo.Assign(req.Metadata, map[string]string{
+				"experiment_id":          assignment.ExperimentID,
+				"experiment_variant_key": assignment.VariantKey,
+			})
+		}
+	}
+
 	// Get and validate plan
 	plan, err := s.PlanRepo.Get(ctx, req.PlanID)
 	if err != nil {
@@ -108,6 +131,17 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			WithReportableDetails(map[string]interface{}{"plan_id": req.PlanID, "status": plan.Status}).
 			Mark(ierr.ErrValidation)
 	}
+	if !plan.IsSubscribable() {
+		return nil, ierr.NewError("plan version is not published").
+			WithHint("Subscriptions can only be created on the published version of a plan").
+			WithReportableDetails(map[string]interface{}{"plan_id": req.PlanID, "version_status": plan.GetVersionStatus()}).
+			Mark(ierr.ErrValidation)
+	}
+	if lo.FromPtr(req.RenewalPlanID) != "" {
+		if err := s.validateRenewalPlan(ctx, *req.RenewalPlanID); err != nil {
+			return nil, err
+		}
+	}
 
 	sub := req.ToSubscription(ctx)
 
@@ -143,6 +177,7 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 	} else {
 		sub.StartDate = sub.StartDate.UTC().Truncate(time.Millisecond)
 	}
+	sub.StartTerm(sub.StartDate)
 	if req.BillingAnchor != nil {
 		sub.BillingAnchor = *req.BillingAnchor
 	} else if sub.BillingCycle == types.BillingCycleCalendar {
@@ -270,6 +305,9 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 	if req.SubscriptionStatus != "" {
 		sub.SubscriptionStatus = req.SubscriptionStatus
 	}
+	if req.SubscriptionStatus == "" || req.SubscriptionStatus == types.SubscriptionStatusTrialing {
+		applySubscriptionTrial(sub, time.Now().UTC())
+	}
 
 	s.Logger.Infow("creating subscription",
 		"customer_id", sub.CustomerID, "plan_id", sub.PlanID, "start_date", sub.StartDate,
@@ -286,6 +324,12 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		if err = s.SubRepo.CreateWithLineItems(ctx, sub, sub.LineItems); err != nil {
 			return err
 		}
+		// The first subscription of an assignment is the one its conversion and revenue are reported on
+		if assignment != nil && assignment.SubscriptionID == nil {
+			if err = s.ExperimentAssignmentRepo.SetSubscription(ctx, assignment.ID, sub.ID); err != nil {
+				return err
+			}
+		}
 		if len(req.Addons) > 0 {
 			if err = s.handleSubscriptionAddons(ctx, sub, req.Addons); err != nil {
 				return err
@@ -352,8 +396,8 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 			}
 		}
 
-		// Create invoice for non-draft subscriptions
-		if req.SubscriptionStatus != types.SubscriptionStatusDraft {
+		// Create invoice for non-draft subscriptions, trialing subscriptions are invoiced when the trial converts
+		if req.SubscriptionStatus != types.SubscriptionStatusDraft && sub.SubscriptionStatus != types.SubscriptionStatusTrialing {
 			paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
 			invoice, updatedSub, err = invoiceService.CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
 				SubscriptionID: sub.ID,
@@ -396,6 +440,8 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		response.LatestInvoice = invoice
 	}
 
+	recordExperimentConversion(ctx, s.ServiceParams, sub)
+
 	// Sync to HubSpot and publish webhooks
 	isDraft := req.SubscriptionStatus == types.SubscriptionStatusDraft
 	if isDraft {
@@ -405,6 +451,12 @@ func (s *subscriptionService) CreateSubscription(ctx context.Context, req dto.Cr
 		s.triggerHubSpotDealSyncWorkflow(ctx, sub.ID, customer.ID)
 		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionCreated, sub.ID)
 	}
+	if sub.IsTrialing() {
+		s.triggerSubscriptionTrialWorkflow(ctx, sub.ID)
+	}
+	if !isDraft {
+		s.triggerBackdatedBilling(ctx, sub)
+	}
 	return response, nil
 }
 
@@ -452,6 +504,7 @@ func (s *subscriptionService) ActivateDraftSubscription(ctx context.Context, sub
 
 	sub.CurrentPeriodStart = sub.StartDate
 	sub.CurrentPeriodEnd = nextBillingDate
+	sub.StartTerm(sub.StartDate)
 
 	// Update line item start dates and end dates
 	for _, item := range sub.LineItems {
@@ -568,8 +621,11 @@ func (s *subscriptionService) ActivateDraftSubscription(ctx context.Context, sub
 		response.LatestInvoice = invoice
 	}
 
+	recordExperimentConversion(ctx, s.ServiceParams, sub)
+
 	// Publish activation webhook
 	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionActivated, sub.ID)
+	s.triggerBackdatedBilling(ctx, sub)
 
 	return response, nil
 }
@@ -1051,6 +1107,13 @@ func (s *subscriptionService) ProcessSubscriptionPriceOverrides(
 			SkipEntityValidation: true,
 		}
 
+		// Included usage is kept unless overridden
+		if override.IncludedQuantity != nil {
+			createPriceReq.IncludedQuantity = override.IncludedQuantity
+		} else if originalPrice.IncludedQuantity.IsPositive() {
+			createPriceReq.IncludedQuantity = lo.ToPtr(originalPrice.IncludedQuantity)
+		}
+
 		// Handle PriceUnitConfig construction for CUSTOM price unit type
 		var priceUnitConfig *dto.PriceUnitConfig
 		if originalPrice.PriceUnitType == types.PRICE_UNIT_TYPE_CUSTOM {
@@ -1141,6 +1204,7 @@ func (s *subscriptionService) ProcessSubscriptionPriceOverrides(
 		if override.Quantity != nil {
 			lineItem.Quantity = *override.Quantity
 		}
+		lineItem.IncludedQuantity = overriddenPriceResp.IncludedQuantity
 
 		// Update the line item to reference the new subscription-scoped price
 		// Also update display name to match the new price (which preserves the original display name)
@@ -1160,7 +1224,8 @@ func (s *subscriptionService) ProcessSubscriptionPriceOverrides(
 			"tiers_override", len(override.Tiers) > 0,
 			"transform_quantity_override", override.TransformQuantity != nil,
 			"price_unit_amount_override", override.PriceUnitAmount != nil,
-			"price_unit_tiers_override", len(override.PriceUnitTiers) > 0)
+			"price_unit_tiers_override", len(override.PriceUnitTiers) > 0,
+			"included_quantity_override", override.IncludedQuantity != nil)
 	}
 
 	return nil
@@ -1555,6 +1620,41 @@ func (s *subscriptionService) UpdateSubscription(ctx context.Context, subscripti
 	return s.GetSubscription(ctx, subscriptionID)
 }
 
+// UpdateBillingThreshold sets or removes the billing threshold of a subscription. Usage accrued
+// before the change is evaluated against the new threshold on the next processed event.
+func (s *subscriptionService) UpdateBillingThreshold(ctx context.Context, subscriptionID string, req dto.UpdateBillingThresholdRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		return nil, ierr.NewError("subscription is cancelled").
+			WithHint("Billing thresholds cannot be changed on cancelled subscriptions").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	sub.BillingThresholdAmount = req.Amount
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("updated subscription billing threshold",
+		"subscription_id", sub.ID,
+		"billing_threshold_amount", req.Amount)
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+
+	return s.GetSubscription(ctx, subscriptionID)
+}
+
 // CancelSubscription provides enhanced cancellation with proration support
 func (s *subscriptionService) CancelSubscription(
 	ctx context.Context,
@@ -2011,6 +2111,15 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 		}
 	}
 
+	// Negotiated rates from the rate card of the customer take precedence over the plan prices
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, subscription.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	for id, p := range priceMap {
+		priceMap[id] = price.RateCardPriceFor(rateCard, p, usageStartTime)
+	}
+
 	totalCost := decimal.Zero
 
 	s.Logger.Debugw("calculating usage for subscription",
@@ -2042,6 +2151,9 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 		"total_line_items", len(lineItems),
 		"distinct_event_names", distinctEventNames)
 
+	// Time-of-use windows are evaluated in the customer's timezone
+	timezone := resolveBillingTimezone(ctx, s.ServiceParams, subscription)
+
 	meterUsageRequests := make([]*dto.GetUsageByMeterRequest, 0, len(lineItems))
 	for _, lineItem := range lineItems {
 		if lineItem.PriceType != types.PRICE_TYPE_USAGE {
@@ -2098,6 +2210,15 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 		for _, filter := range meter.Filters {
 			usageRequest.Filters[filter.Key] = filter.Values
 		}
+
+		// Usage within time-of-use windows is rated separately, so the regular
+		// request only aggregates the usage outside of all windows
+		if priceObj, ok := priceMap[lineItem.PriceID]; ok && len(priceObj.TimeWindows) > 0 {
+			usageRequest.TimeWindow = &types.TimeWindowFilter{
+				Timezone: timezone,
+				Exclude:  priceObj.TimeWindows,
+			}
+		}
 		meterUsageRequests = append(meterUsageRequests, usageRequest)
 	}
 
@@ -2204,13 +2325,41 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 
 		usageCharges = append(usageCharges, charge)
 		totalCost = totalCost.Add(cost)
+
+		windowCharges, err := s.getTimeWindowCharges(ctx, eventService, request, priceObj, timezone, meterDisplayName)
+		if err != nil {
+			return nil, err
+		}
+		for _, windowCharge := range windowCharges {
+			usageCharges = append(usageCharges, windowCharge)
+			totalCost = totalCost.Add(decimal.NewFromFloat(windowCharge.Amount))
+		}
 	}
 
 	// Apply commitment logic if set on the subscription
+	totalCost = applySubscriptionCommitment(subscription, usageCharges, totalCost, response)
+
+	response.StartTime = usageStartTime
+	response.EndTime = usageEndTime
+	response.Amount = price.FormatAmountToFloat64WithPrecision(totalCost, subscription.Currency)
+	response.Currency = subscription.Currency
+	response.DisplayAmount = price.GetDisplayAmountWithPrecision(totalCost, subscription.Currency)
+	return response, nil
+}
+
+// applySubscriptionCommitment splits the usage charges of a subscription with a commitment and an
+// overage factor into the charges covered by the commitment and the overage charges. It sets the
+// charges and the commitment fields of the response and returns the total cost of the charges.
+func applySubscriptionCommitment(
+	sub *subscription.Subscription,
+	usageCharges []*dto.SubscriptionUsageByMetersResponse,
+	totalCost decimal.Decimal,
+	response *dto.GetUsageBySubscriptionResponse,
+) decimal.Decimal {
 	hasCommitment := false
 
-	commitmentAmount := lo.FromPtr(subscription.CommitmentAmount)
-	overageFactor := lo.FromPtr(subscription.OverageFactor)
+	commitmentAmount := lo.FromPtr(sub.CommitmentAmount)
+	overageFactor := lo.FromPtr(sub.OverageFactor)
 
 	// Check if commitment amount is greater than zero
 	if commitmentAmount.GreaterThan(decimal.Zero) {
@@ -2289,8 +2438,8 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 				if normalQuantityDecimal.GreaterThan(decimal.Zero) {
 					normalCharge := *charge // Create a copy
 					normalCharge.Quantity = normalQuantityDecimal.InexactFloat64()
-					normalCharge.Amount = price.FormatAmountToFloat64WithPrecision(normalAmountDecimal, subscription.Currency)
-					normalCharge.DisplayAmount = price.FormatAmountToStringWithPrecision(normalAmountDecimal, subscription.Currency)
+					normalCharge.Amount = price.FormatAmountToFloat64WithPrecision(normalAmountDecimal, sub.Currency)
+					normalCharge.DisplayAmount = price.FormatAmountToStringWithPrecision(normalAmountDecimal, sub.Currency)
 					normalCharge.IsOverage = false
 					response.Charges = append(response.Charges, &normalCharge)
 				}
@@ -2305,8 +2454,8 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 
 					overageCharge := *charge // Create a copy
 					overageCharge.Quantity = overageQuantityDecimal.InexactFloat64()
-					overageCharge.Amount = price.FormatAmountToFloat64WithPrecision(overageAmountDecimal, subscription.Currency)
-					overageCharge.DisplayAmount = price.FormatAmountToStringWithPrecision(overageAmountDecimal, subscription.Currency)
+					overageCharge.Amount = price.FormatAmountToFloat64WithPrecision(overageAmountDecimal, sub.Currency)
+					overageCharge.DisplayAmount = price.FormatAmountToStringWithPrecision(overageAmountDecimal, sub.Currency)
 					overageCharge.IsOverage = true
 					overageCharge.OverageFactor = overageFactorFloat
 					response.Charges = append(response.Charges, &overageCharge)
@@ -2322,7 +2471,7 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 			overageAmountDecimal := chargeAmount.Mul(overageFactor)
 			totalOverageAmount = totalOverageAmount.Add(overageAmountDecimal)
 
-			charge.Amount = price.FormatAmountToFloat64WithPrecision(overageAmountDecimal, subscription.Currency)
+			charge.Amount = price.FormatAmountToFloat64WithPrecision(overageAmountDecimal, sub.Currency)
 			charge.DisplayAmount = overageAmountDecimal.StringFixed(6)
 			charge.IsOverage = true
 			charge.OverageFactor = overageFactorFloat
@@ -2338,18 +2487,12 @@ func (s *subscriptionService) GetUsageBySubscription(ctx context.Context, req *d
 		response.OverageAmount = overageAmountFloat
 
 		// Update total cost with commitment + overage calculation
-		totalCost = commitmentUtilized.Add(totalOverageAmount)
-	} else {
-		// Without commitment, just use the original charges
-		response.Charges = usageCharges
+		return commitmentUtilized.Add(totalOverageAmount)
 	}
 
-	response.StartTime = usageStartTime
-	response.EndTime = usageEndTime
-	response.Amount = price.FormatAmountToFloat64WithPrecision(totalCost, subscription.Currency)
-	response.Currency = subscription.Currency
-	response.DisplayAmount = price.GetDisplayAmountWithPrecision(totalCost, subscription.Currency)
-	return response, nil
+	// Without commitment, just use the original charges
+	response.Charges = usageCharges
+	return totalCost
 }
 
 // UpdateBillingPeriods updates the current billing periods for all active subscriptions
@@ -2369,6 +2512,9 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 		StartAt:      now,
 	}
 
+	// Trials missed by their workflow are ended before the billing periods are processed
+	s.endExpiredTrials(ctx, now)
+
 	offset := 0
 	for {
 		filter := &types.SubscriptionFilter{
@@ -2377,10 +2523,13 @@ func (s *subscriptionService) UpdateBillingPeriods(ctx context.Context) (*dto.Su
 				Offset: lo.ToPtr(offset),
 				Status: lo.ToPtr(types.StatusPublished),
 			},
-			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive},
+			// Paused subscriptions are included to resume them and to bill the fixed fees of pauses that keep billing them
+			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive, types.SubscriptionStatusPastDue, types.SubscriptionStatusPaused},
 			TimeRangeFilter: &types.TimeRangeFilter{
 				EndTime: &now,
 			},
+			// The missed periods of backdated subscriptions are billed by their backdated billing workflow
+			ExcludeBackdatedBillingPending: true,
 		}
 
 		subs, err := s.SubRepo.ListAllTenant(ctx, filter)
@@ -2467,112 +2616,17 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 		return nil
 	}
 
-	// Skip processing for paused subscriptions
-	if sub.SubscriptionStatus == types.SubscriptionStatusPaused {
+	// Activate due pauses and resume ended ones before billing the period
+	pauseResult, err := s.processSubscriptionPause(ctx, sub, now)
+	if err != nil {
+		return err
+	}
+	if pauseResult.SkipBilling {
 		s.Logger.Infow("skipping period processing for paused subscription",
 			"subscription_id", sub.ID)
 		return nil
 	}
 
-	// Check for scheduled pauses that should be activated
-	if sub.PauseStatus == types.PauseStatusScheduled && sub.ActivePauseID != nil {
-		pause, err := s.SubRepo.GetPause(ctx, *sub.ActivePauseID)
-		if err != nil {
-			return err
-		}
-
-		// If this is a period-end pause and we're at period end, activate it
-		if pause.PauseMode == types.PauseModePeriodEnd && !now.Before(sub.CurrentPeriodEnd) {
-			sub.SubscriptionStatus = types.SubscriptionStatusPaused
-			pause.PauseStatus = types.PauseStatusActive
-
-			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
-
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
-				return err
-			}
-
-			s.Logger.Infow("activated period-end pause",
-				"subscription_id", sub.ID,
-				"pause_id", pause.ID)
-
-			// Skip further processing
-			return nil
-		}
-
-		// If this is a scheduled pause and we've reached the start date, activate it
-		if pause.PauseMode == types.PauseModeScheduled && !now.Before(pause.PauseStart) {
-			sub.SubscriptionStatus = types.SubscriptionStatusPaused
-			pause.PauseStatus = types.PauseStatusActive
-
-			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
-
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
-				return err
-			}
-
-			s.Logger.Infow("activated scheduled pause",
-				"subscription_id", sub.ID,
-				"pause_id", pause.ID)
-
-			// Skip further processing
-			return nil
-		}
-	}
-
-	// Check for auto-resume based on pause end date
-	if sub.SubscriptionStatus == types.SubscriptionStatusPaused && sub.ActivePauseID != nil {
-		pause, err := s.SubRepo.GetPause(ctx, *sub.ActivePauseID)
-		if err != nil {
-			return err
-		}
-
-		// If this pause has an end date and we've reached it, auto-resume
-		if pause.PauseEnd != nil && !now.Before(*pause.PauseEnd) {
-			// Calculate the pause duration
-			pauseDuration := now.Sub(pause.PauseStart)
-
-			// Update the pause record
-			pause.PauseStatus = types.PauseStatusCompleted
-			pause.ResumedAt = &now
-
-			// Update the subscription
-			sub.SubscriptionStatus = types.SubscriptionStatusActive
-			sub.PauseStatus = types.PauseStatusNone
-			sub.ActivePauseID = nil
-
-			// Adjust the billing period by the pause duration
-			sub.CurrentPeriodEnd = sub.CurrentPeriodEnd.Add(pauseDuration)
-
-			// Update the subscription and pause
-			if err := s.SubRepo.Update(ctx, sub); err != nil {
-				return err
-			}
-
-			if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
-				return err
-			}
-
-			s.Logger.Infow("auto-resumed subscription",
-				"subscription_id", sub.ID,
-				"pause_id", pause.ID,
-				"pause_duration", pauseDuration)
-
-			// Continue with normal processing
-		} else {
-			// Still paused, skip processing
-			s.Logger.Infow("skipping period processing for paused subscription",
-				"subscription_id", sub.ID)
-			return nil
-		}
-	}
-
 	// TODO: Check if subscription has ended and should be cancelled
 
 	// Initialize services
@@ -2643,7 +2697,7 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 	}
 
 	// Use db's WithTx for atomic operations
-	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
 		// Process all periods except the last one (which becomes the new current period)
 		for i := 0; i < len(periods)-1; i++ {
 			period := periods[i]
@@ -2737,13 +2791,9 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 			return err
 		}
 
-		// Process pending plan changes at period end (only if subscription is still active)
-		if sub.SubscriptionStatus == types.SubscriptionStatusActive {
-			if err := s.processPendingPlanChanges(ctx, sub); err != nil {
-				s.Logger.Errorw("failed to process pending plan changes",
-					"subscription_id", sub.ID,
-					"error", err)
-			}
+		// Apply quantity decreases scheduled for the new period
+		if err := s.applyScheduledQuantityChanges(ctx, sub); err != nil {
+			return err
 		}
 
 		s.Logger.Infow("completed subscription period processing",
@@ -2766,6 +2816,27 @@ func (s *subscriptionService) processSubscriptionPeriod(ctx context.Context, sub
 		return err
 	}
 
+	// Renew the term before the pending plan changes, like the billing workflow does
+	renewal, err := s.ProcessTermRenewal(ctx, sub.ID)
+	if err != nil {
+		s.Logger.Errorw("failed to process term renewal",
+			"subscription_id", sub.ID,
+			"error", err)
+	} else if renewal.SubscriptionID != sub.ID {
+		// The renewal replaced the subscription, which ends its pending plan changes
+		return nil
+	}
+
+	// Process pending plan changes at period end (only if subscription is still active)
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil
+	}
+	if err := s.processPendingPlanChanges(ctx, sub); err != nil {
+		s.Logger.Errorw("failed to process pending plan changes",
+			"subscription_id", sub.ID,
+			"error", err)
+	}
+
 	return nil
 }
 
@@ -2952,6 +3023,61 @@ func (s *subscriptionService) MarkCancellationScheduleAsExecuted(ctx context.Con
 	return nil
 }
 
+// getTimeWindowCharges aggregates the usage of each time-of-use window of the price and rates
+// it at the window's unit amount. Windows are matched in order, so usage that falls into
+// multiple windows is only counted in the first one.
+func (s *subscriptionService) getTimeWindowCharges(
+	ctx context.Context,
+	eventService EventService,
+	request *dto.GetUsageByMeterRequest,
+	priceObj *price.Price,
+	timezone string,
+	meterDisplayName string,
+) ([]*dto.SubscriptionUsageByMetersResponse, error) {
+	if len(priceObj.TimeWindows) == 0 {
+		return nil, nil
+	}
+
+	charges := make([]*dto.SubscriptionUsageByMetersResponse, 0, len(priceObj.TimeWindows))
+	for i, window := range priceObj.TimeWindows {
+		windowRequest := *request
+		windowRequest.TimeWindow = &types.TimeWindowFilter{
+			Timezone: timezone,
+			Include:  &priceObj.TimeWindows[i],
+			Exclude:  priceObj.TimeWindows[:i],
+		}
+
+		usage, err := eventService.GetUsageByMeter(ctx, &windowRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		quantity := usage.Value
+		if request.Meter != nil && (request.Meter.IsBucketedMaxMeter() || request.Meter.IsBucketedSumMeter()) {
+			quantity = decimal.Zero
+			for _, result := range usage.Results {
+				quantity = quantity.Add(result.Value)
+			}
+		}
+
+		cost := window.UnitAmount.Mul(quantity)
+
+		s.Logger.Debugw("calculated time window usage for meter",
+			"meter_id", request.MeterID,
+			"price_id", priceObj.ID,
+			"time_window", window.Name,
+			"timezone", timezone,
+			"quantity", quantity,
+			"cost", cost)
+
+		charge := createChargeResponse(priceObj, quantity, cost, meterDisplayName)
+		charge.TimeWindow = window.Name
+		charges = append(charges, charge)
+	}
+
+	return charges, nil
+}
+
 func createChargeResponse(priceObj *price.Price, quantity decimal.Decimal, cost decimal.Decimal, meterDisplayName string) *dto.SubscriptionUsageByMetersResponse {
 	if priceObj == nil {
 		return nil
@@ -3146,6 +3272,7 @@ func (s *subscriptionService) executePause(
 		SubscriptionID:      sub.ID,
 		PauseStatus:         pauseStatus,
 		PauseMode:           req.PauseMode,
+		BillingMode:         defaultPauseBillingMode(req),
 		ResumeMode:          types.ResumeModeAuto, // Default to auto resume if pause end is set
 		PauseStart:          *pauseStart,
 		PauseEnd:            pauseEnd,
@@ -3295,45 +3422,8 @@ func (s *subscriptionService) executeResume(
 	activePause *subscription.SubscriptionPause,
 	req *dto.ResumeSubscriptionRequest,
 ) (*subscription.Subscription, *subscription.SubscriptionPause, error) {
-	// Update the pause record
-	now := time.Now()
-	activePause.PauseStatus = types.PauseStatusCompleted
-	activePause.ResumeMode = req.ResumeMode
-	activePause.ResumedAt = &now
 	activePause.Metadata = req.Metadata
-	activePause.UpdatedBy = types.GetUserID(ctx)
-
-	// Calculate the pause duration
-	pauseDuration := now.Sub(activePause.PauseStart)
-
-	// Update the subscription
-	sub.PauseStatus = types.PauseStatusNone
-	sub.ActivePauseID = nil
-
-	// Only change subscription status if it was paused
-	if sub.SubscriptionStatus == types.SubscriptionStatusPaused {
-		sub.SubscriptionStatus = types.SubscriptionStatusActive
-	}
-
-	// Adjust the billing period by the pause duration
-	sub.CurrentPeriodEnd = sub.CurrentPeriodEnd.Add(pauseDuration)
-
-	// Execute the transaction
-	err := s.DB.WithTx(ctx, func(txCtx context.Context) error {
-		// Update the pause record
-		if err := s.SubRepo.UpdatePause(txCtx, activePause); err != nil {
-			return err
-		}
-
-		// Update the subscription
-		if err := s.SubRepo.Update(txCtx, sub); err != nil {
-			return err
-		}
-
-		return nil
-	})
-
-	if err != nil {
+	if err := s.resumeFromPause(ctx, sub, activePause, time.Now().UTC(), req.ResumeMode); err != nil {
 		return nil, nil, err
 	}
 
@@ -3652,7 +3742,8 @@ func (s *subscriptionService) publishInternalWebhookEvent(ctx context.Context, e
 	}
 }
 
-// ProcessSubscriptionRenewalDueAlert processes subscriptions that are due for renewal in 24 hours
+// ProcessSubscriptionRenewalDueAlert processes subscriptions whose renewal is due in 24 hours, at the
+// non-renewal deadline for subscriptions with a term and at the end of the current period otherwise
 func (s *subscriptionService) ProcessSubscriptionRenewalDueAlert(ctx context.Context) error {
 	subscriptions, err := s.SubRepo.ListSubscriptionsDueForRenewal(ctx)
 	if err != nil {
@@ -3717,6 +3808,16 @@ func (s *subscriptionService) handleSubCoupons(
 		}
 	}
 
+	// Coupons of the first phase apply from the creation of the subscription, the first phase shares
+	// the line items of the subscription
+	if len(req.Phases) > 0 {
+		firstPhase := req.Phases[0]
+		for _, coupon := range s.normalizePhaseCoupons(firstPhase, "", originalPriceToLineItemMap) {
+			coupon.SubscriptionPhaseID = nil
+			subscriptionCoupons = append(subscriptionCoupons, coupon)
+		}
+	}
+
 	if len(subscriptionCoupons) == 0 {
 		return nil
 	}
@@ -3852,12 +3953,6 @@ func (s *subscriptionService) addAddonToSubscription(
 		}
 	}
 
-	// Validate and filter prices for the addon
-	validPrices, err := s.ValidateAndFilterPricesForSubscription(ctx, req.AddonID, types.PRICE_ENTITY_TYPE_ADDON, sub, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	// Create subscription addon association
 	addonAssociation := req.ToAddonAssociation(
 		ctx,
@@ -3865,10 +3960,28 @@ func (s *subscriptionService) addAddonToSubscription(
 		types.AddonAssociationEntityTypeSubscription,
 	)
 
+	// Addon prices are matched against the billing period of the addon, which defaults to the
+	// billing period of the subscription
+	priceSub := lo.ToPtr(*sub)
+	if addonAssociation.BillingPeriod != "" {
+		priceSub.BillingPeriod = addonAssociation.BillingPeriod
+		priceSub.BillingPeriodCount = addonAssociation.BillingPeriodCount
+	}
+
+	// Validate and filter prices for the addon
+	validPrices, err := s.ValidateAndFilterPricesForSubscription(ctx, req.AddonID, types.PRICE_ENTITY_TYPE_ADDON, priceSub, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateAddonBillingPeriod(sub, addonAssociation, validPrices); err != nil {
+		return nil, err
+	}
+
 	// Create line items for addon prices
 	lineItems := make([]*subscription.SubscriptionLineItem, 0, len(validPrices))
 	for _, priceResponse := range validPrices {
-		lineItem := s.createLineItemFromPrice(ctx, priceResponse, sub, req.AddonID, a.Addon.Name)
+		lineItem := s.createLineItemFromPrice(ctx, priceResponse, sub, addonAssociation, a.Addon.Name)
 		if err := s.applyLineItemCommitmentFromMap(ctx, lineItem, req.LineItemCommitments); err != nil {
 			return nil, err
 		}
@@ -3899,7 +4012,8 @@ func (s *subscriptionService) addAddonToSubscription(
 			}
 		}
 
-		return nil
+		// Charge the part of the current period after the start or the trial of the addon
+		return s.invoiceAddedAddon(ctx, sub, addonAssociation, lineItems, validPrices, req.ProrationBehavior)
 	})
 
 	if err != nil {
@@ -3967,7 +4081,9 @@ func (s *subscriptionService) validateEntitlementCompatibility(ctx context.Conte
 	return nil
 }
 
-// RemoveAddonFromSubscription removes an addon from a subscription by addon association ID
+// RemoveAddonFromSubscription removes an addon from a subscription by addon association ID. The addon
+// is removed at the end of its current period or immediately, in which case the unused part of the
+// period that was paid in advance is credited to the customer's wallet.
 func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, req *dto.RemoveAddonRequest) error {
 	// Validate request
 	if err := req.Validate(); err != nil {
@@ -3980,8 +4096,8 @@ func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, r
 		return err
 	}
 
-	// check if association already has end date i.e. scheduled to be removed
-	if association.EndDate != nil {
+	// check if association is already cancelled i.e. scheduled to be removed
+	if association.CancelledAt != nil {
 		return ierr.NewError("addon is already scheduled to be removed").
 			WithHint("This addon is already marked for removal").
 			WithReportableDetails(map[string]interface{}{
@@ -3991,27 +4107,11 @@ func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, r
 			Mark(ierr.ErrValidation)
 	}
 
-	// get cancel at date from subscription
-	var effectiveEndDate *time.Time
-
-	if association.EntityType == types.AddonAssociationEntityTypeSubscription {
-		sub, err := s.SubRepo.Get(ctx, association.EntityID)
-		if err != nil {
-			return err
-		}
-
-		effectiveEndDate = lo.ToPtr(sub.CurrentPeriodEnd)
-	}
-
 	endReason := "Cancelled by API"
 	if req.Reason != "" {
 		endReason = req.Reason
 	}
 
-	association.CancellationReason = endReason
-	association.CancelledAt = effectiveEndDate
-	association.EndDate = effectiveEndDate
-
 	// Get line items to terminate
 	lineItemFilter := types.NewSubscriptionLineItemFilter()
 	lineItemFilter.SubscriptionIDs = []string{association.EntityID}
@@ -4023,14 +4123,61 @@ func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, r
 		return err
 	}
 
+	if association.EntityType != types.AddonAssociationEntityTypeSubscription {
+		association.CancellationReason = endReason
+		return s.AddonAssociationRepo.Update(ctx, association)
+	}
+
+	sub, err := s.SubRepo.Get(ctx, association.EntityID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	effectiveEndDate := now
+	if req.CancellationType == types.CancellationTypeEndOfPeriod {
+		effectiveEndDate, err = s.addonPeriodEnd(sub, association, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	if association.EndDate != nil && association.EndDate.Before(effectiveEndDate) {
+		effectiveEndDate = *association.EndDate
+	}
+
+	association.CancellationReason = endReason
+	association.CancelledAt = lo.ToPtr(effectiveEndDate)
+	association.EndDate = lo.ToPtr(effectiveEndDate)
+
+	var credit decimal.Decimal
+	if req.CancellationType == types.CancellationTypeImmediate && req.ProrationBehavior == types.ProrationBehaviorCreateProrations {
+		credit, err = s.calculateRemovedAddonCredit(ctx, sub, association, lineItems, effectiveEndDate)
+		if err != nil {
+			return err
+		}
+	}
+
 	return s.DB.WithTx(ctx, func(ctx context.Context) error {
 		if err := s.AddonAssociationRepo.Update(ctx, association); err != nil {
 			return err
 		}
 
-		deleteReq := dto.DeleteSubscriptionLineItemRequest{EffectiveFrom: effectiveEndDate}
 		for _, lineItem := range lineItems {
-			if _, err := s.DeleteSubscriptionLineItem(ctx, lineItem.ID, deleteReq); err != nil {
+			// Addons removed during their trial end before they are billed
+			endDate := lo.Ternary(effectiveEndDate.Before(lineItem.StartDate), lineItem.StartDate, effectiveEndDate)
+			if !lineItem.EndDate.IsZero() && !lineItem.EndDate.After(endDate) {
+				continue
+			}
+			lineItem.EndDate = endDate
+			if err := s.SubscriptionLineItemRepo.Update(ctx, lineItem); err != nil {
+				return err
+			}
+		}
+
+		if credit.IsPositive() {
+			walletService := NewWalletService(s.ServiceParams)
+			if err := walletService.TopUpWalletForProratedCharge(ctx, sub.CustomerID, credit, sub.Currency); err != nil {
 				return err
 			}
 		}
@@ -4040,8 +4187,9 @@ func (s *subscriptionService) RemoveAddonFromSubscription(ctx context.Context, r
 }
 
 // createLineItemFromPrice creates a subscription line item from a price for addon additions
-func (s *subscriptionService) createLineItemFromPrice(ctx context.Context, priceResponse *dto.PriceResponse, sub *subscription.Subscription, addonID, addonName string) *subscription.SubscriptionLineItem {
+func (s *subscriptionService) createLineItemFromPrice(ctx context.Context, priceResponse *dto.PriceResponse, sub *subscription.Subscription, association *addonassociation.AddonAssociation, addonName string) *subscription.SubscriptionLineItem {
 	price := priceResponse.Price
+	addonID := association.AddonID
 
 	lineItem := &subscription.SubscriptionLineItem{
 		ID:             types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
@@ -4055,13 +4203,14 @@ func (s *subscriptionService) createLineItemFromPrice(ctx context.Context, price
 		BillingPeriod:  price.BillingPeriod,
 		InvoiceCadence: price.InvoiceCadence,
 		TrialPeriod:    0,
-		StartDate:      time.Now(),
-		EndDate:        time.Time{},
+		StartDate:      association.BillingStartDate(),
+		EndDate:        lo.FromPtr(association.EndDate),
 		Metadata: map[string]string{
-			"addon_id":        addonID,
-			"subscription_id": sub.ID,
-			"addon_quantity":  "1",
-			"addon_status":    string(types.AddonStatusActive),
+			"addon_id":             addonID,
+			"addon_association_id": association.ID,
+			"subscription_id":      sub.ID,
+			"addon_quantity":       association.Quantity.String(),
+			"addon_status":         string(types.AddonStatusActive),
 		},
 		EnvironmentID: sub.EnvironmentID,
 		BaseModel:     types.GetDefaultBaseModel(ctx),
@@ -4081,7 +4230,7 @@ func (s *subscriptionService) createLineItemFromPrice(ctx context.Context, price
 		lineItem.MeterDisplayName = priceResponse.Meter.Name
 		lineItem.Quantity = decimal.Zero
 	} else {
-		lineItem.Quantity = decimal.NewFromInt(1)
+		lineItem.Quantity = association.Quantity
 	}
 
 	// Copy price unit fields from price to line item
@@ -4145,6 +4294,8 @@ func (s *subscriptionService) ActivateIncompleteSubscription(ctx context.Context
 			"note", "cron job will process these as backup")
 	}
 
+	recordExperimentConversion(ctx, s.ServiceParams, sub)
+
 	// Publish webhook event for subscription activation
 	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionActivated, subscriptionID)
 
@@ -4332,8 +4483,8 @@ func (s *subscriptionService) ProcessAutoCancellationSubscriptions(ctx context.C
 		// Filter invoices that are past grace period
 		now := time.Now().UTC()
 		eligibleInvoices := lo.Filter(invoices, func(inv *invoice.Invoice, _ int) bool {
-			// Must have a subscription ID
-			if inv.SubscriptionID == nil {
+			// Must have a subscription, consolidated invoices have several
+			if len(inv.GetSubscriptionIDs()) == 0 {
 				return false
 			}
 
@@ -4341,7 +4492,7 @@ func (s *subscriptionService) ProcessAutoCancellationSubscriptions(ctx context.C
 			if inv.DueDate == nil {
 				s.Logger.Warnw("invoice has invalid due date, skipping",
 					"invoice_id", inv.ID,
-					"subscription_id", *inv.SubscriptionID)
+					"subscription_ids", inv.GetSubscriptionIDs())
 				return false
 			}
 
@@ -4354,7 +4505,7 @@ func (s *subscriptionService) ProcessAutoCancellationSubscriptions(ctx context.C
 			if isPastGracePeriod {
 				s.Logger.Debugw("found invoice past grace period",
 					"invoice_id", inv.ID,
-					"subscription_id", *inv.SubscriptionID,
+					"subscription_ids", inv.GetSubscriptionIDs(),
 					"due_date", inv.DueDate,
 					"grace_period_end_time", gracePeriodEndTime,
 					"amount_remaining", inv.AmountRemaining,
@@ -4365,8 +4516,8 @@ func (s *subscriptionService) ProcessAutoCancellationSubscriptions(ctx context.C
 		})
 
 		// Extract unique subscription IDs from eligible invoices
-		subscriptionIDs := lo.Uniq(lo.FilterMap(eligibleInvoices, func(inv *invoice.Invoice, _ int) (string, bool) {
-			return lo.FromPtr(inv.SubscriptionID), inv.SubscriptionID != nil
+		subscriptionIDs := lo.Uniq(lo.FlatMap(eligibleInvoices, func(inv *invoice.Invoice, _ int) []string {
+			return inv.GetSubscriptionIDs()
 		}))
 
 		s.Logger.Debugw("found subscriptions with invoices past grace period",
@@ -4383,10 +4534,10 @@ func (s *subscriptionService) ProcessAutoCancellationSubscriptions(ctx context.C
 			continue
 		}
 
-		// Get ONLY ACTIVE subscriptions for this tenant x environment
+		// Get ONLY ACTIVE and PAST DUE subscriptions for this tenant x environment
 		filter := &types.SubscriptionFilter{
 			SubscriptionIDs:    subscriptionIDs,
-			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive},
+			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive, types.SubscriptionStatusPastDue},
 		}
 
 		subscriptions, err := s.SubRepo.List(tenantCtx, filter)
@@ -4761,6 +4912,15 @@ func (s *subscriptionService) GetFeatureUsageBySubscription(ctx context.Context,
 		}
 	}
 
+	// Negotiated rates from the rate card of the customer take precedence over the plan prices
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, subscription.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	for id, p := range priceMap {
+		priceMap[id] = price.RateCardPriceFor(rateCard, p, usageStartTime)
+	}
+
 	s.Logger.Debugw("calculating usage for subscription V2",
 		"subscription_id", req.SubscriptionID,
 		"start_time", usageStartTime,
@@ -5168,7 +5328,8 @@ func (s *subscriptionService) GetSubscriptionEntitlements(ctx context.Context, s
 		subscriptionID,
 	)
 
-	return finalEntitlements, nil
+	// Step 7: Deny the entitlements of a subscription limited by the spend limit of its customer
+	return applySpendLimit(sub, finalEntitlements), nil
 }
 
 // filterOverriddenEntitlements removes plan/addon entitlements that have been overridden
@@ -5434,6 +5595,7 @@ func (s *subscriptionService) ProcessSubscriptionEntitlementOverrides(
 			FeatureType:         parentEnt.FeatureType,
 			UsageResetPeriod:    parentEnt.UsageResetPeriod,
 			IsSoftLimit:         parentEnt.IsSoftLimit,
+			RolloverConfig:      parentEnt.RolloverConfig,
 			DisplayOrder:        parentEnt.DisplayOrder,
 			ParentEntitlementID: &parentEnt.ID,
 			StartDate:           &sub.StartDate, // Set start date to subscription start
@@ -5707,16 +5869,26 @@ func (s *subscriptionService) CreateDraftInvoiceForSubscription(ctx context.Cont
 		return nil, err
 	}
 
+	// Subscriptions of customers with consolidated invoicing are invoiced together by whichever
+	// subscription of their group reaches the end of the period first. The line items already
+	// billed on the consolidated invoice are skipped for the other subscriptions of the group.
+	group, err := s.consolidatedInvoiceGroup(ctx, sub, period.End)
+	if err != nil {
+		return nil, err
+	}
+	if group != nil {
+		return s.createConsolidatedInvoice(ctx, group, period)
+	}
+
 	billingService := NewBillingService(s.ServiceParams)
 	invoiceService := NewInvoiceService(s.ServiceParams)
 
 	// Prepare Invoice Request
-	invoiceReq, err := billingService.PrepareSubscriptionInvoiceRequest(
+	invoiceReq, err := billingService.PrepareSubscriptionPeriodInvoiceRequest(
 		ctx,
 		sub,
 		period.Start,
 		period.End,
-		types.ReferencePointPeriodEnd,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/addonassociation"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// validateAddonBillingPeriod validates an addon billed on its own billing period. The period of the
+// addon cannot be shorter than the period of the subscription, as cycles are charged on the invoices
+// of the subscription, and usage prices are only billed on the period of the subscription.
+func (s *subscriptionService) validateAddonBillingPeriod(
+	sub *subscription.Subscription,
+	association *addonassociation.AddonAssociation,
+	prices []*dto.PriceResponse,
+) error {
+	if !association.HasOwnBillingPeriod(sub.BillingPeriod, sub.BillingPeriodCount) {
+		return nil
+	}
+
+	subPeriodEnd, err := types.NextBillingDate(sub.CurrentPeriodStart, sub.CurrentPeriodStart, sub.BillingPeriodCount, sub.BillingPeriod, nil)
+	if err != nil {
+		return err
+	}
+	addonPeriodEnd, err := types.NextBillingDate(sub.CurrentPeriodStart, sub.CurrentPeriodStart, association.BillingPeriodCount, association.BillingPeriod, nil)
+	if err != nil {
+		return err
+	}
+	if addonPeriodEnd.Before(subPeriodEnd) {
+		return ierr.NewError("addon billing period cannot be shorter than the subscription billing period").
+			WithHint("Please choose a billing period for the addon at least as long as the billing period of the subscription").
+			WithReportableDetails(map[string]interface{}{
+				"billing_period":                    association.BillingPeriod,
+				"billing_period_count":              association.BillingPeriodCount,
+				"subscription_billing_period":       sub.BillingPeriod,
+				"subscription_billing_period_count": sub.BillingPeriodCount,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, p := range prices {
+		if p.Price.Type == types.PRICE_TYPE_USAGE {
+			return ierr.NewError("usage prices cannot be billed on the billing period of the addon").
+				WithHint("Addons with usage prices must be billed on the billing period of the subscription").
+				WithReportableDetails(map[string]interface{}{
+					"addon_id": association.AddonID,
+					"price_id": p.Price.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// invoiceAddedAddon invoices the fixed advance charges of an addon that fall in the current period of
+// the subscription. Addons billed on the period of the subscription are charged for the part of the
+// current period after the start or the trial of the addon when prorations are created, addons with
+// their own billing period are always charged for their first cycle. Invoices of addons in trial are
+// due at the end of the trial.
+func (s *subscriptionService) invoiceAddedAddon(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	association *addonassociation.AddonAssociation,
+	lineItems []*subscription.SubscriptionLineItem,
+	prices []*dto.PriceResponse,
+	prorationBehavior types.ProrationBehavior,
+) error {
+	priceService := NewPriceService(s.ServiceParams)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	priceMap := lo.KeyBy(prices, func(p *dto.PriceResponse) string { return p.Price.ID })
+
+	// Addon charges are rated with the customer's rate card like the rest of the subscription
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, sub.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	invoiceLineItems := make([]dto.CreateInvoiceLineItemRequest, 0, len(lineItems))
+	total := decimal.Zero
+	addLine := func(item *subscription.SubscriptionLineItem, p *price.Price, amount decimal.Decimal, start, end time.Time, description string) {
+		amount = rounding.RoundLineItemAmount(amount, sub.Currency)
+		if !amount.IsPositive() {
+			return
+		}
+		invoiceLineItems = append(invoiceLineItems, dto.CreateInvoiceLineItemRequest{
+			EntityID:        lo.ToPtr(item.EntityID),
+			EntityType:      lo.ToPtr(string(item.EntityType)),
+			PlanDisplayName: lo.ToPtr(item.PlanDisplayName),
+			PriceID:         lo.ToPtr(item.PriceID),
+			PriceType:       lo.ToPtr(string(item.PriceType)),
+			DisplayName:     lo.ToPtr(item.DisplayName),
+			Amount:          amount,
+			Quantity:        item.Quantity,
+			PeriodStart:     lo.ToPtr(start),
+			PeriodEnd:       lo.ToPtr(end),
+			Metadata: types.Metadata{
+				"description":          description,
+				"addon_association_id": association.ID,
+				"billing_period":       string(p.BillingPeriod),
+			},
+		})
+		total = total.Add(amount)
+	}
+
+	for _, item := range lineItems {
+		if item.PriceType != types.PRICE_TYPE_FIXED || item.InvoiceCadence != types.InvoiceCadenceAdvance {
+			continue
+		}
+		priceResponse, ok := priceMap[item.PriceID]
+		if !ok {
+			continue
+		}
+		p := priceResponse.Price
+
+		if hasOwnBillingPeriod(sub, item, p) {
+			cycles, err := lineItemAddonCycles(item, p, item.StartDate, sub.CurrentPeriodEnd)
+			if err != nil {
+				return err
+			}
+			for _, cycle := range cycles {
+				cyclePrice := price.RateCardPriceFor(rateCard, p, cycle.start)
+				amount := priceService.CalculateCost(ctx, cyclePrice, item.Quantity).Mul(addonActiveFraction(item, cycle.start, cycle.end))
+				addLine(item, p, amount, cycle.start, cycle.end, fmt.Sprintf("%s (Fixed Charge)", item.DisplayName))
+			}
+			continue
+		}
+
+		if prorationBehavior != types.ProrationBehaviorCreateProrations {
+			continue
+		}
+		fraction := addonActiveFraction(item, sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+		if !fraction.IsPositive() {
+			continue
+		}
+		start := lo.Ternary(item.StartDate.After(sub.CurrentPeriodStart), item.StartDate, sub.CurrentPeriodStart)
+		end := lo.Ternary(!item.EndDate.IsZero() && item.EndDate.Before(sub.CurrentPeriodEnd), item.EndDate, sub.CurrentPeriodEnd)
+		amount := priceService.CalculateCost(ctx, price.RateCardPriceFor(rateCard, p, start), item.Quantity).Mul(fraction)
+		addLine(item, p, amount, start, end, fmt.Sprintf("%s (Prorated addon charge)", item.DisplayName))
+	}
+
+	if len(invoiceLineItems) == 0 {
+		return nil
+	}
+
+	var dueDate *time.Time
+	if association.IsInTrial(time.Now().UTC()) {
+		dueDate = association.TrialEnd
+	}
+
+	invoiceService := NewInvoiceService(s.ServiceParams)
+	inv, err := invoiceService.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     sub.CustomerID,
+		SubscriptionID: lo.ToPtr(sub.ID),
+		IdempotencyKey: lo.ToPtr(association.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		Currency:       sub.Currency,
+		AmountDue:      total,
+		Subtotal:       total,
+		Total:          total,
+		Description:    "Addon added to subscription",
+		DueDate:        dueDate,
+		BillingPeriod:  lo.ToPtr(string(sub.BillingPeriod)),
+		PeriodStart:    invoiceLineItems[0].PeriodStart,
+		PeriodEnd:      invoiceLineItems[0].PeriodEnd,
+		BillingReason:  types.InvoiceBillingReasonSubscriptionUpdate,
+		PaymentStatus:  lo.ToPtr(types.PaymentStatusPending),
+		LineItems:      invoiceLineItems,
+		Metadata: types.Metadata{
+			"addon_id":             association.AddonID,
+			"addon_association_id": association.ID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Invoices of addons in trial are due when the trial ends, so they are not paid right away
+	if dueDate != nil {
+		return invoiceService.FinalizeInvoice(ctx, inv.ID)
+	}
+
+	// The invoice is finalized, synced and paid with the payment settings of the subscription
+	paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
+	return invoiceService.ProcessDraftInvoice(ctx, inv.ID, paymentParams, sub, types.InvoiceFlowRenewal)
+}
+
+// addonPeriodEnd returns the date an addon removed at the end of its period is removed at. Addons
+// in trial are removed when the trial ends, before they are billed.
+func (s *subscriptionService) addonPeriodEnd(
+	sub *subscription.Subscription,
+	association *addonassociation.AddonAssociation,
+	now time.Time,
+) (time.Time, error) {
+	if association.IsInTrial(now) {
+		return association.BillingStartDate(), nil
+	}
+
+	if !association.HasOwnBillingPeriod(sub.BillingPeriod, sub.BillingPeriodCount) {
+		return sub.CurrentPeriodEnd, nil
+	}
+
+	cycles, err := addonCycles(
+		association.BillingStartDate(),
+		lo.FromPtr(association.EndDate),
+		association.BillingPeriod,
+		association.BillingPeriodCount,
+		now,
+		now.Add(time.Nanosecond),
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(cycles) == 0 {
+		return now, nil
+	}
+	return cycles[0].end, nil
+}
+
+// calculateRemovedAddonCredit returns the credit for the unused part of the cycle of the fixed
+// advance charges of an addon removed immediately. Only cycles already invoiced are credited, either
+// on the invoice created when the addon was added or on an invoice of the subscription.
+func (s *subscriptionService) calculateRemovedAddonCredit(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	association *addonassociation.AddonAssociation,
+	lineItems []*subscription.SubscriptionLineItem,
+	effectiveDate time.Time,
+) (decimal.Decimal, error) {
+	addInvoiced := true
+	if _, err := s.InvoiceRepo.GetByIdempotencyKey(ctx, association.ID); err != nil {
+		if !ierr.IsNotFound(err) {
+			return decimal.Zero, err
+		}
+		addInvoiced = false
+	}
+	billedBySubscription := association.CreatedAt.Before(sub.CurrentPeriodStart)
+	if !addInvoiced && !billedBySubscription {
+		return decimal.Zero, nil
+	}
+
+	priceService := NewPriceService(s.ServiceParams)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, sub.CustomerID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	credit := decimal.Zero
+	for _, item := range lineItems {
+		if item.PriceType != types.PRICE_TYPE_FIXED || item.InvoiceCadence != types.InvoiceCadenceAdvance {
+			continue
+		}
+
+		p, err := s.PriceRepo.Get(ctx, item.PriceID)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		effective := lo.Ternary(item.StartDate.After(effectiveDate), item.StartDate, effectiveDate)
+		cycle := addonCycle{start: sub.CurrentPeriodStart, end: sub.CurrentPeriodEnd}
+		if hasOwnBillingPeriod(sub, item, p) {
+			cycles, err := lineItemAddonCycles(item, p, effective, effective.Add(time.Nanosecond))
+			if err != nil {
+				return decimal.Zero, err
+			}
+			if len(cycles) == 0 {
+				continue
+			}
+			cycle = cycles[0]
+		}
+
+		if !cycle.start.Before(sub.CurrentPeriodEnd) || !effective.Before(cycle.end) {
+			continue
+		}
+
+		cycleEnd := lo.Ternary(!item.EndDate.IsZero() && item.EndDate.Before(cycle.end), item.EndDate, cycle.end)
+		if !cycleEnd.After(effective) {
+			continue
+		}
+
+		fraction := decimal.NewFromInt(int64(cycleEnd.Sub(effective))).Div(decimal.NewFromInt(int64(cycle.end.Sub(cycle.start))))
+		amount := priceService.CalculateCost(ctx, price.RateCardPriceFor(rateCard, p, cycle.start), item.QuantityAt(effective)).Mul(fraction)
+		credit = credit.Add(rounding.RoundLineItemAmount(amount, sub.Currency))
+	}
+
+	return credit, nil
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// metadataKeyBackdatedCatchUpPeriods is the number of billing periods billed on a catch-up invoice
+	metadataKeyBackdatedCatchUpPeriods = "backdated_catch_up_periods"
+
+	// backdatedReprocessBatchSize is the batch size of the reprocessing of the events of a backdated subscription
+	backdatedReprocessBatchSize = 100
+)
+
+// splitCatchUpPeriods returns the ended billing periods of the subscription billed together on its
+// catch-up invoice and the periods invoiced on their own. Periods are only caught up on the first
+// billing run of a backdated subscription with the catch_up billing mode, which bills the periods
+// that ended before the subscription was created, and only when more than one period ended.
+func splitCatchUpPeriods(sub *subscription.Subscription, periods []dto.Period) ([]dto.Period, []dto.Period) {
+	if sub.BackdatedBillingMode != types.BackdatedBillingModeCatchUp || len(periods) < 2 {
+		return nil, periods
+	}
+	if !periods[0].Start.Equal(sub.StartDate) {
+		return nil, periods
+	}
+	return periods, nil
+}
+
+// CreateDraftInvoicesForPeriods creates the draft invoices of the ended billing periods of the
+// subscription. Missed periods of backdated subscriptions with the catch_up billing mode are billed
+// on a single catch-up invoice, every other period is invoiced on its own. Periods without charges
+// create no invoice.
+func (s *subscriptionService) CreateDraftInvoicesForPeriods(ctx context.Context, subscriptionID string, periods []dto.Period) ([]*dto.InvoiceResponse, error) {
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices := make([]*dto.InvoiceResponse, 0, len(periods))
+
+	catchUpPeriods, periods := splitCatchUpPeriods(sub, periods)
+	if len(catchUpPeriods) > 0 {
+		invoiceReq, err := s.prepareCatchUpInvoiceRequest(ctx, sub, catchUpPeriods, true)
+		if err != nil {
+			return nil, err
+		}
+		if !invoiceReq.Subtotal.IsZero() {
+			s.Logger.Infow("creating catch-up invoice for backdated subscription",
+				"subscription_id", sub.ID,
+				"period_start", catchUpPeriods[0].Start,
+				"period_end", catchUpPeriods[len(catchUpPeriods)-1].End,
+				"periods", len(catchUpPeriods))
+
+			inv, err := NewInvoiceService(s.ServiceParams).CreateInvoice(ctx, *invoiceReq)
+			if err != nil {
+				return nil, err
+			}
+			invoices = append(invoices, inv)
+		}
+	}
+
+	for _, period := range periods {
+		inv, err := s.CreateDraftInvoiceForSubscription(ctx, subscriptionID, period)
+		if err != nil {
+			return nil, err
+		}
+		if inv != nil {
+			invoices = append(invoices, inv)
+		}
+	}
+
+	return invoices, nil
+}
+
+// prepareCatchUpInvoiceRequest prepares a single invoice with the charges of each of the periods.
+// The charges of each period are calculated on their own, so usage is priced per period. The
+// rollover of each period is settled before the next period is prepared unless the invoice is
+// only previewed.
+func (s *subscriptionService) prepareCatchUpInvoiceRequest(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periods []dto.Period,
+	settle bool,
+) (*dto.CreateInvoiceRequest, error) {
+	billingService := NewBillingService(s.ServiceParams)
+
+	var invoiceReq *dto.CreateInvoiceRequest
+	for _, period := range periods {
+		var req *dto.CreateInvoiceRequest
+		var err error
+		if settle {
+			req, err = billingService.PrepareSubscriptionPeriodInvoiceRequest(ctx, sub, period.Start, period.End)
+		} else {
+			req, err = billingService.PrepareSubscriptionInvoiceRequest(ctx, sub, period.Start, period.End, types.ReferencePointPeriodEnd)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if invoiceReq == nil {
+			invoiceReq = req
+			continue
+		}
+
+		invoiceReq.LineItems = append(invoiceReq.LineItems, req.LineItems...)
+		invoiceReq.Subtotal = invoiceReq.Subtotal.Add(req.Subtotal)
+		invoiceReq.Total = invoiceReq.Total.Add(req.Total)
+		invoiceReq.AmountDue = invoiceReq.AmountDue.Add(req.AmountDue)
+		invoiceReq.InvoiceCoupons = append(invoiceReq.InvoiceCoupons, req.InvoiceCoupons...)
+		invoiceReq.LineItemCoupons = append(invoiceReq.LineItemCoupons, req.LineItemCoupons...)
+		invoiceReq.DueDate = req.DueDate
+	}
+
+	// Coupons apply once to the catch-up invoice
+	invoiceReq.InvoiceCoupons = lo.UniqBy(invoiceReq.InvoiceCoupons, func(c dto.InvoiceCoupon) string {
+		return c.CouponID + ":" + lo.FromPtr(c.CouponAssociationID)
+	})
+	invoiceReq.LineItemCoupons = lo.UniqBy(invoiceReq.LineItemCoupons, func(c dto.InvoiceLineItemCoupon) string {
+		return c.LineItemID + ":" + c.CouponID + ":" + lo.FromPtr(c.CouponAssociationID)
+	})
+
+	invoiceReq.PeriodStart = lo.ToPtr(periods[0].Start)
+	invoiceReq.PeriodEnd = lo.ToPtr(periods[len(periods)-1].End)
+	if invoiceReq.Metadata == nil {
+		invoiceReq.Metadata = make(types.Metadata)
+	}
+	invoiceReq.Metadata[metadataKeyBackdatedCatchUpPeriods] = strconv.Itoa(len(periods))
+
+	return invoiceReq, nil
+}
+
+// PreviewBackdatedInvoices previews the invoices the subscription billing workflow generates for
+// the billing periods of the subscription that already ended, with the usage of each period.
+// Nothing is persisted. The invoice of the first period created when a draft subscription is
+// activated is not part of the preview.
+func (s *subscriptionService) PreviewBackdatedInvoices(ctx context.Context, subscriptionID string) (*dto.BackdatedInvoicesPreviewResponse, error) {
+	sub, _, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BackdatedInvoicesPreviewResponse{
+		SubscriptionID:       sub.ID,
+		Currency:             sub.Currency,
+		BackdatedBillingMode: sub.BackdatedBillingMode,
+		Invoices:             make([]*dto.BackdatedInvoicePreview, 0),
+		Total:                decimal.Zero,
+	}
+
+	periods, err := s.CalculateBillingPeriods(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The last period is the new current period, it has not ended yet
+	endedPeriods := periods[:len(periods)-1]
+
+	billingService := NewBillingService(s.ServiceParams)
+	catchUpPeriods, endedPeriods := splitCatchUpPeriods(sub, endedPeriods)
+
+	addPreview := func(invoiceReq *dto.CreateInvoiceRequest, billed []dto.Period, isCatchUp bool) error {
+		if invoiceReq.Subtotal.IsZero() {
+			return nil
+		}
+		inv, err := invoiceReq.ToInvoice(types.WithRoundingConfig(ctx, GetRoundingConfig(s.ServiceParams, ctx)))
+		if err != nil {
+			return err
+		}
+		response.Invoices = append(response.Invoices, &dto.BackdatedInvoicePreview{
+			PeriodStart: billed[0].Start,
+			PeriodEnd:   billed[len(billed)-1].End,
+			BillingPeriods: lo.Map(billed, func(p dto.Period, _ int) dto.BackdatedBillingPeriod {
+				return dto.BackdatedBillingPeriod{Start: p.Start, End: p.End}
+			}),
+			IsCatchUp: isCatchUp,
+			Invoice:   dto.NewInvoiceResponse(inv),
+		})
+		response.Total = response.Total.Add(inv.Total)
+		return nil
+	}
+
+	if len(catchUpPeriods) > 0 {
+		invoiceReq, err := s.prepareCatchUpInvoiceRequest(ctx, sub, catchUpPeriods, false)
+		if err != nil {
+			return nil, err
+		}
+		if err := addPreview(invoiceReq, catchUpPeriods, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, period := range endedPeriods {
+		invoiceReq, err := billingService.PrepareSubscriptionInvoiceRequest(ctx, sub, period.Start, period.End, types.ReferencePointPeriodEnd)
+		if err != nil {
+			return nil, err
+		}
+		if err := addPreview(invoiceReq, []dto.Period{period}, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// triggerBackdatedBilling starts the backdated billing workflow of subscriptions whose first billing
+// period already ended, so the invoices of the missed periods are generated without waiting for the
+// billing schedule. The workflow reprocesses the events of the customer since the start of the
+// subscription before billing it, so the feature usage of the subscription covers the missed periods.
+// The subscription is flagged as pending until the workflow completes, the billing cron skips it
+// meanwhile. When the workflow can not be started, the missed periods are left to the billing cron.
+func (s *subscriptionService) triggerBackdatedBilling(ctx context.Context, sub *subscription.Subscription) {
+	now := time.Now().UTC()
+	if sub.SubscriptionStatus == types.SubscriptionStatusDraft || sub.IsTrialing() || !sub.CurrentPeriodEnd.Before(now) {
+		return
+	}
+
+	s.Logger.Infow("billing missed periods of backdated subscription",
+		"subscription_id", sub.ID,
+		"start_date", sub.StartDate,
+		"current_period_end", sub.CurrentPeriodEnd,
+		"backdated_billing_mode", sub.BackdatedBillingMode)
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for backdated subscription billing",
+			"subscription_id", sub.ID)
+		return
+	}
+
+	cust, err := s.CustomerRepo.Get(ctx, sub.CustomerID)
+	if err != nil {
+		s.Logger.Errorw("failed to get customer of backdated subscription, missed periods are left to the billing cron",
+			"error", err,
+			"subscription_id", sub.ID)
+		return
+	}
+
+	// The subscription is flagged before the workflow starts so the billing cron can not bill it
+	// before its events are reprocessed
+	sub.BackdatedBillingPending = true
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		s.Logger.Errorw("failed to flag backdated subscription, missed periods are left to the billing cron",
+			"error", err,
+			"subscription_id", sub.ID)
+		return
+	}
+
+	workflowRun, err := temporalSvc.ExecuteWorkflow(ctx, types.TemporalBackdatedBillingWorkflow, subscriptionModels.BackdatedSubscriptionBillingWorkflowInput{
+		SubscriptionID:     sub.ID,
+		ExternalCustomerID: cust.ExternalID,
+		ReprocessStartDate: sub.StartDate,
+		ReprocessEndDate:   now,
+		ReprocessBatchSize: backdatedReprocessBatchSize,
+		PeriodStart:        sub.CurrentPeriodStart,
+		PeriodEnd:          sub.CurrentPeriodEnd,
+	})
+	if err != nil {
+		s.Logger.Errorw("failed to start backdated billing workflow, missed periods are left to the billing cron",
+			"error", err,
+			"subscription_id", sub.ID)
+		if err := s.CompleteBackdatedBilling(ctx, sub.ID); err != nil {
+			s.Logger.Errorw("failed to hand backdated subscription back to the billing cron",
+				"error", err,
+				"subscription_id", sub.ID)
+		}
+		return
+	}
+
+	s.Logger.Debugw("backdated billing workflow started",
+		"subscription_id", sub.ID,
+		"workflow_id", workflowRun.GetID(),
+		"run_id", workflowRun.GetRunID())
+}
+
+// CompleteBackdatedBilling clears the backdated billing flag of the subscription once its backdated
+// billing workflow completed, the billing cron bills the subscription again from then on
+func (s *subscriptionService) CompleteBackdatedBilling(ctx context.Context, subscriptionID string) error {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !sub.BackdatedBillingPending {
+		return nil
+	}
+
+	sub.BackdatedBillingPending = false
+	return s.SubRepo.Update(ctx, sub)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/plan"
+	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/proration"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	ierr "github.com/flexprice/flexprice/internal/errors"
@@ -237,6 +238,9 @@ func (s *subscriptionChangeService) ExecuteSubscriptionChangeInternal(
 
 		// Calculate effective date
 		effectiveDate := time.Now()
+		if req.EffectiveDate != nil {
+			effectiveDate = *req.EffectiveDate
+		}
 
 		// Execute the change based on type
 		result, err := s.executeChange(txCtx, currentSub, lineItems, targetPlan, changeType, req, effectiveDate)
@@ -397,8 +401,8 @@ func (s *subscriptionChangeService) scheduleChangeForPeriodEnd(
 func (s *subscriptionChangeService) validateSubscriptionForChange(sub *subscription.Subscription) error {
 	// Check subscription status
 	switch sub.SubscriptionStatus {
-	case types.SubscriptionStatusActive, types.SubscriptionStatusTrialing:
-		// These are valid states for changes
+	case types.SubscriptionStatusActive, types.SubscriptionStatusTrialing, types.SubscriptionStatusPastDue:
+		// These are valid states for changes, past due subscriptions are still active while dunned
 	case types.SubscriptionStatusPaused:
 		return ierr.NewError("cannot change paused subscription").
 			WithHint("Resume the subscription before changing plans").
@@ -409,7 +413,7 @@ func (s *subscriptionChangeService) validateSubscriptionForChange(sub *subscript
 			Mark(ierr.ErrValidation)
 	default:
 		return ierr.NewError("subscription not in valid state for changes").
-			WithHint("Subscription must be active or trialing").
+			WithHint("Subscription must be active, trialing or past due").
 			WithReportableDetails(map[string]any{
 				"current_status": sub.SubscriptionStatus,
 			}).
@@ -512,12 +516,18 @@ func (s *subscriptionChangeService) calculateNextInvoicePreview(
 		return nil, err
 	}
 
+	// Negotiated rates from the rate card of the customer take precedence over the target plan prices
+	rateCard, err := getCustomerRateCard(ctx, s.serviceParams, currentSub.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate what the next invoice would look like with the new plan
 	lineItems := []dto.InvoiceLineItemPreview{}
 	subtotal := decimal.Zero
 
 	for _, priceResp := range targetPricesResponse.Items {
-		p := priceResp.Price
+		p := price.RateCardPriceFor(rateCard, priceResp.Price, effectiveDate)
 		if !p.Amount.IsZero() && p.Amount.GreaterThan(decimal.Zero) {
 			description := fmt.Sprintf("%s - %s", targetPlan.Name, p.Description)
 			if p.Description == "" {
@@ -693,6 +703,13 @@ func (s *subscriptionChangeService) createNewSubscription(
 		CommitmentAmount:   currentSub.CommitmentAmount,
 		OverageFactor:      currentSub.OverageFactor,
 		Workflow:           lo.ToPtr(types.TemporalSubscriptionCreationWorkflow),
+		TermMonths:         currentSub.TermMonths,
+		AutoRenew:          lo.ToPtr(currentSub.AutoRenew),
+		RenewalNoticeDays:  currentSub.RenewalNoticeDays,
+	}
+	// Keep renewing to a different plan only when the subscription is not changed to it
+	if currentSub.TermMonths > 0 && lo.FromPtr(currentSub.RenewalPlanID) != targetPlan.ID {
+		createSubReq.RenewalPlanID = currentSub.RenewalPlanID
 	}
 
 	subscriptionService := NewSubscriptionService(s.serviceParams)
@@ -707,6 +724,15 @@ func (s *subscriptionChangeService) createNewSubscription(
 		return nil, err
 	}
 
+	// The new subscription continues the contract term of the current one
+	if currentSub.HasTerm() {
+		newSub.CurrentTermStart = currentSub.CurrentTermStart
+		newSub.CurrentTermEnd = currentSub.CurrentTermEnd
+		if err := s.serviceParams.SubRepo.Update(ctx, newSub); err != nil {
+			return nil, err
+		}
+	}
+
 	// Handle entitlement proration for subscription changes
 	// This handles both anniversary and calendar billing cycles
 	s.serviceParams.Logger.Infow("checking entitlement proration condition",
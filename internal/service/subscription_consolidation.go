@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/idempotency"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+const (
+	// metadataKeyConsolidatedSubscriptionIDs lists the subscriptions billed on a consolidated invoice
+	metadataKeyConsolidatedSubscriptionIDs = invoice.MetadataKeyConsolidatedSubscriptionIDs
+)
+
+// consolidatesInvoices returns true if the subscriptions of the customer are invoiced together.
+// The setting of the customer takes precedence over the invoice config of the environment.
+func (s *subscriptionService) consolidatesInvoices(ctx context.Context, customerID string) (bool, error) {
+	cust, err := s.CustomerRepo.Get(ctx, customerID)
+	if err != nil {
+		return false, err
+	}
+	if cust.ConsolidateInvoices != nil {
+		return *cust.ConsolidateInvoices, nil
+	}
+
+	settingsSvc := NewSettingsService(s.ServiceParams).(*settingsService)
+	invoiceConfig, err := GetSetting[types.InvoiceConfig](settingsSvc, ctx, types.SettingKeyInvoiceConfig)
+	if err != nil {
+		return false, err
+	}
+	return invoiceConfig.ConsolidateSubscriptionInvoices, nil
+}
+
+// consolidatedInvoiceGroup returns the subscriptions invoiced together with the subscription for
+// the period ending at periodEnd, ordered by ID. Nil is returned when the subscription is invoiced
+// on its own.
+//
+// Subscriptions are invoiced together when they share the invoicing customer, currency, billing
+// period, payment settings and tax rates, and their periods end at periodEnd. Subscriptions which
+// already moved on to the next period are still part of the group. Paused subscriptions and
+// subscriptions with coupons are always invoiced on their own.
+func (s *subscriptionService) consolidatedInvoiceGroup(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodEnd time.Time,
+) ([]*subscription.Subscription, error) {
+	enabled, err := s.consolidatesInvoices(ctx, sub.GetInvoicingCustomerID())
+	if err != nil || !enabled {
+		return nil, err
+	}
+
+	filter := types.NewNoLimitSubscriptionFilter()
+	filter.CustomerID = sub.CustomerID
+	filter.SubscriptionStatus = []types.SubscriptionStatus{
+		types.SubscriptionStatusActive,
+		types.SubscriptionStatusTrialing,
+		types.SubscriptionStatusPastDue,
+	}
+	filter.BillingPeriod = []types.BillingPeriod{sub.BillingPeriod}
+
+	subs, err := s.SubRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	taxRateIDs, err := s.subscriptionTaxRateIDs(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	group := make([]*subscription.Subscription, 0, len(subs))
+	for _, candidate := range subs {
+		// The invoice is collected and taxed like every subscription of the group
+		if candidate.GetInvoicingCustomerID() != sub.GetInvoicingCustomerID() ||
+			!strings.EqualFold(candidate.Currency, sub.Currency) ||
+			candidate.BillingPeriodCount != sub.BillingPeriodCount ||
+			candidate.CollectionMethod != sub.CollectionMethod ||
+			candidate.PaymentBehavior != sub.PaymentBehavior ||
+			lo.FromPtr(candidate.GatewayPaymentMethodID) != lo.FromPtr(sub.GatewayPaymentMethodID) ||
+			candidate.PauseStatus == types.PauseStatusActive {
+			continue
+		}
+		if !candidate.CurrentPeriodEnd.Equal(periodEnd) && !candidate.CurrentPeriodStart.Equal(periodEnd) {
+			continue
+		}
+
+		candidateTaxRateIDs, err := s.subscriptionTaxRateIDs(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Equal(candidateTaxRateIDs, taxRateIDs) {
+			continue
+		}
+
+		hasCoupons, err := s.hasActiveCoupons(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if hasCoupons {
+			continue
+		}
+		group = append(group, candidate)
+	}
+
+	if len(group) < 2 || !lo.ContainsBy(group, func(member *subscription.Subscription) bool {
+		return member.ID == sub.ID
+	}) {
+		return nil, nil
+	}
+
+	sort.Slice(group, func(i, j int) bool {
+		return group[i].ID < group[j].ID
+	})
+	return group, nil
+}
+
+// subscriptionTaxRateIDs returns the sorted IDs of the tax rates applied to the invoices of the
+// subscription
+func (s *subscriptionService) subscriptionTaxRateIDs(ctx context.Context, sub *subscription.Subscription) ([]string, error) {
+	taxRates, err := NewTaxService(s.ServiceParams).PrepareTaxRatesForInvoice(ctx, dto.CreateInvoiceRequest{
+		SubscriptionID: lo.ToPtr(sub.ID),
+		CustomerID:     sub.GetInvoicingCustomerID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := lo.Map(taxRates, func(rate *dto.TaxRateResponse, _ int) string { return rate.ID })
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// hasActiveCoupons returns true if coupons apply to the current period of the subscription
+func (s *subscriptionService) hasActiveCoupons(ctx context.Context, sub *subscription.Subscription) (bool, error) {
+	filter := types.NewCouponAssociationFilter()
+	filter.SubscriptionIDs = []string{sub.ID}
+	filter.ActiveOnly = true
+	filter.PeriodStart = &sub.CurrentPeriodStart
+	filter.PeriodEnd = &sub.CurrentPeriodEnd
+
+	associations, err := NewCouponAssociationService(s.ServiceParams).ListCouponAssociations(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return len(associations.Items) > 0, nil
+}
+
+// createConsolidatedInvoice creates a single draft invoice for the subscriptions of the group for the
+// period. The invoice belongs to no single subscription, the line items of each subscription are
+// kept together and tagged with the subscription. Line items which are already invoiced are
+// skipped, so the invoice is only created once whichever subscription of the group is processed
+// first. Nil is returned when nothing is due.
+func (s *subscriptionService) createConsolidatedInvoice(
+	ctx context.Context,
+	group []*subscription.Subscription,
+	period dto.Period,
+) (*dto.InvoiceResponse, error) {
+	billingService := NewBillingService(s.ServiceParams)
+
+	var invoiceReq *dto.CreateInvoiceRequest
+	subscriptionIDs := make([]string, 0, len(group))
+	for _, member := range group {
+		sub, _, err := s.SubRepo.GetWithLineItems(ctx, member.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Members which already moved on are invoiced for the period they just completed
+		periodStart, periodEnd := sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+		if !periodEnd.Equal(period.End) {
+			periodStart, periodEnd = period.Start, period.End
+			if sub.StartDate.After(periodStart) {
+				periodStart = sub.StartDate
+			}
+		}
+
+		req, err := billingService.PrepareSubscriptionPeriodInvoiceRequest(ctx, sub, periodStart, periodEnd)
+		if err != nil {
+			return nil, err
+		}
+		for i := range req.LineItems {
+			req.LineItems[i].SubscriptionID = lo.ToPtr(sub.ID)
+		}
+
+		subscriptionIDs = append(subscriptionIDs, sub.ID)
+		if invoiceReq == nil {
+			invoiceReq = req
+			invoiceReq.PeriodStart = lo.ToPtr(period.Start)
+			invoiceReq.PeriodEnd = lo.ToPtr(period.End)
+			continue
+		}
+
+		invoiceReq.LineItems = append(invoiceReq.LineItems, req.LineItems...)
+		invoiceReq.Subtotal = invoiceReq.Subtotal.Add(req.Subtotal)
+		invoiceReq.Total = invoiceReq.Total.Add(req.Total)
+		invoiceReq.AmountDue = invoiceReq.AmountDue.Add(req.AmountDue)
+	}
+
+	if invoiceReq == nil || invoiceReq.Subtotal.IsZero() {
+		return nil, nil
+	}
+
+	if invoiceReq.Metadata == nil {
+		invoiceReq.Metadata = make(types.Metadata)
+	}
+	invoiceReq.Metadata[metadataKeyConsolidatedSubscriptionIDs] = strings.Join(subscriptionIDs, ",")
+	invoiceReq.SubscriptionID = nil
+	invoiceReq.IdempotencyKey = lo.ToPtr(idempotency.NewGenerator().GenerateKey(idempotency.ScopeSubscriptionInvoice, map[string]interface{}{
+		"tenant_id":        types.GetTenantID(ctx),
+		"environment_id":   types.GetEnvironmentID(ctx),
+		"customer_id":      invoiceReq.CustomerID,
+		"subscription_ids": subscriptionIDs,
+		"period_start":     period.Start,
+		"period_end":       period.End,
+	}))
+
+	s.Logger.Infow("creating consolidated invoice",
+		"customer_id", invoiceReq.CustomerID,
+		"subscription_ids", subscriptionIDs,
+		"period_start", period.Start,
+		"period_end", period.End)
+
+	return NewInvoiceService(s.ServiceParams).CreateInvoice(ctx, *invoiceReq)
+}
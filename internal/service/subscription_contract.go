@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// metadataKeyContractTrueUp marks invoice line items truing up the commitment of a phase
+	metadataKeyContractTrueUp = "is_contract_commitment_trueup"
+	// metadataKeyContractOverage marks invoice line items charging the overage of a phase commitment
+	metadataKeyContractOverage = "is_contract_commitment_overage"
+)
+
+// getSubscriptionPhases returns the phases of the subscription ordered by start date
+func getSubscriptionPhases(ctx context.Context, params ServiceParams, subscriptionID string) ([]*subscription.SubscriptionPhase, error) {
+	filter := types.NewNoLimitSubscriptionPhaseFilter()
+	filter.SubscriptionIDs = []string{subscriptionID}
+	filter.QueryFilter.Sort = lo.ToPtr("start_date")
+	filter.QueryFilter.Order = lo.ToPtr("asc")
+
+	return params.SubscriptionPhaseRepo.List(ctx, filter)
+}
+
+// commitmentPhaseAt returns the phase with a commitment containing t
+func commitmentPhaseAt(phases []*subscription.SubscriptionPhase, t time.Time) *subscription.SubscriptionPhase {
+	phase, ok := lo.Find(phases, func(phase *subscription.SubscriptionPhase) bool {
+		return phase.HasCommitment() && !t.Before(phase.StartDate) && (phase.EndDate == nil || t.Before(*phase.EndDate))
+	})
+	if !ok {
+		return nil
+	}
+	return phase
+}
+
+// listContractInvoices returns the subscription invoices of the invoicing customer of the subscription,
+// starting on or after periodStart when it is set
+func listContractInvoices(ctx context.Context, params ServiceParams, sub *subscription.Subscription, periodStart *time.Time) ([]*invoice.Invoice, error) {
+	invoiceFilter := types.NewNoLimitInvoiceFilter()
+	invoiceFilter.CustomerID = sub.GetInvoicingCustomerID()
+	invoiceFilter.InvoiceType = types.InvoiceTypeSubscription
+	invoiceFilter.InvoiceStatus = []types.InvoiceStatus{types.InvoiceStatusDraft, types.InvoiceStatusFinalized}
+	invoiceFilter.PeriodStartGTE = periodStart
+
+	return params.InvoiceRepo.List(ctx, invoiceFilter)
+}
+
+// contractBilledAmounts is what the invoices of a subscription billed in a commitment period
+type contractBilledAmounts struct {
+	Spend   decimal.Decimal
+	TrueUp  decimal.Decimal
+	Overage decimal.Decimal
+}
+
+// sumContractBilledAmounts sums the line items of the subscription billed for periods starting
+// in [start, end). Usage charges draw down the commitment, true-up and overage charges of the
+// commitment itself are summed separately.
+func sumContractBilledAmounts(invoices []*invoice.Invoice, subscriptionID string, start, end time.Time) *contractBilledAmounts {
+	billed := &contractBilledAmounts{}
+	for _, inv := range invoices {
+		for _, item := range inv.LineItems {
+			// consolidated invoices bill the line items of several subscriptions
+			if lo.FromPtr(lo.CoalesceOrEmpty(item.SubscriptionID, inv.SubscriptionID)) != subscriptionID {
+				continue
+			}
+			if item.PeriodStart == nil || item.PeriodStart.Before(start) || !item.PeriodStart.Before(end) {
+				continue
+			}
+
+			switch {
+			case item.Metadata[metadataKeyContractTrueUp] == "true":
+				billed.TrueUp = billed.TrueUp.Add(item.Amount)
+			case item.Metadata[metadataKeyContractOverage] == "true":
+				billed.Overage = billed.Overage.Add(item.Amount)
+			case lo.FromPtr(item.PriceType) == string(types.PRICE_TYPE_USAGE):
+				billed.Spend = billed.Spend.Add(item.Amount)
+			}
+		}
+	}
+	return billed
+}
+
+// applyPhaseCommitments applies the commitment of the phase the billing period starts in to the usage
+// charges of the period. Usage beyond the commitment of the commitment period is charged the overage
+// factor and the period which reaches the end of the commitment period trues up the unused commitment.
+// It must run before usage billed by threshold invoices is netted out as it counts the gross usage.
+func (s *billingService) applyPhaseCommitments(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	periodStart,
+	periodEnd time.Time,
+	result *BillingCalculationResult,
+) error {
+	if result == nil {
+		return nil
+	}
+
+	phases, err := getSubscriptionPhases(ctx, s.ServiceParams, sub.ID)
+	if err != nil {
+		return err
+	}
+
+	phase := commitmentPhaseAt(phases, periodStart)
+	if phase == nil {
+		return nil
+	}
+
+	period, err := phase.CommitmentPeriodAt(periodStart)
+	if err != nil || period == nil {
+		return err
+	}
+
+	// Usage of earlier billing periods of the commitment period
+	priorSpend := decimal.Zero
+	if period.StartDate.Before(periodStart) {
+		invoices, err := listContractInvoices(ctx, s.ServiceParams, sub, lo.ToPtr(period.StartDate))
+		if err != nil {
+			return err
+		}
+		priorSpend = sumContractBilledAmounts(invoices, sub.ID, period.StartDate, periodStart).Spend
+	}
+
+	currentSpend := decimal.Zero
+	for _, charge := range result.UsageCharges {
+		currentSpend = currentSpend.Add(charge.Amount)
+	}
+	totalSpend := priorSpend.Add(currentSpend)
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	commitment := rounding.RoundAmount(period.Amount, sub.Currency)
+	planDisplayName := ""
+	for _, item := range sub.LineItems {
+		if item.PlanDisplayName != "" {
+			planDisplayName = item.PlanDisplayName
+			break
+		}
+	}
+	newCommitmentCharge := func(displayName string, amount decimal.Decimal, metadata types.Metadata) dto.CreateInvoiceLineItemRequest {
+		return dto.CreateInvoiceLineItemRequest{
+			EntityID:        lo.ToPtr(sub.PlanID),
+			EntityType:      lo.ToPtr(string(types.SubscriptionLineItemEntityTypePlan)),
+			PriceType:       lo.ToPtr(string(types.PRICE_TYPE_FIXED)),
+			PlanDisplayName: lo.ToPtr(planDisplayName),
+			DisplayName:     lo.ToPtr(fmt.Sprintf("%s %s", planDisplayName, displayName)),
+			Amount:          amount,
+			Quantity:        decimal.NewFromInt(1),
+			PeriodStart:     lo.ToPtr(periodStart),
+			PeriodEnd:       lo.ToPtr(periodEnd),
+			PriceID:         lo.ToPtr(types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE)),
+			Metadata: lo.Assign(types.Metadata{
+				"subscription_phase_id":      phase.ID,
+				"commitment_amount":          commitment.String(),
+				"commitment_period_start":    period.StartDate.Format(time.RFC3339),
+				"commitment_period_end":      period.EndDate.Format(time.RFC3339),
+				"commitment_true_up_cadence": string(lo.FromPtr(phase.CommitmentTrueUpCadence)),
+			}, metadata),
+		}
+	}
+
+	// Only the part of the usage of this billing period exceeding the commitment is charged the overage factor
+	overageFactor := phase.GetOverageFactor()
+	if overageFactor.GreaterThan(decimal.NewFromInt(1)) {
+		priorOverage := decimal.Max(priorSpend.Sub(commitment), decimal.Zero)
+		totalOverage := decimal.Max(totalSpend.Sub(commitment), decimal.Zero)
+		overageCharge := rounding.RoundLineItemAmount(totalOverage.Sub(priorOverage).Mul(overageFactor.Sub(decimal.NewFromInt(1))), sub.Currency)
+		if overageCharge.IsPositive() {
+			result.UsageCharges = append(result.UsageCharges, newCommitmentCharge("Commitment Overage", overageCharge, types.Metadata{
+				metadataKeyContractOverage: "true",
+				"description":              "Overage factor applied to usage beyond the commitment",
+				"overage_factor":           overageFactor.String(),
+				"overage_amount":           totalOverage.Sub(priorOverage).String(),
+			}))
+			result.TotalAmount = result.TotalAmount.Add(overageCharge)
+		}
+	}
+
+	// The billing period reaching the end of the commitment period trues up the unused commitment
+	if !periodEnd.Before(period.EndDate) {
+		trueUp := rounding.RoundLineItemAmount(decimal.Max(commitment.Sub(totalSpend), decimal.Zero), sub.Currency)
+		if trueUp.IsPositive() {
+			result.UsageCharges = append(result.UsageCharges, newCommitmentCharge("Commitment True Up", trueUp, types.Metadata{
+				metadataKeyContractTrueUp: "true",
+				"description":             "Remaining commitment amount for commitment period",
+				"commitment_utilized":     totalSpend.String(),
+			}))
+			result.TotalAmount = result.TotalAmount.Add(trueUp)
+		}
+	}
+
+	s.Logger.Infow("applied phase commitment to billing period",
+		"subscription_id", sub.ID,
+		"phase_id", phase.ID,
+		"period_start", periodStart,
+		"period_end", periodEnd,
+		"commitment_period_start", period.StartDate,
+		"commitment_period_end", period.EndDate,
+		"commitment_amount", commitment,
+		"prior_spend", priorSpend,
+		"current_spend", currentSpend)
+
+	return nil
+}
+
+// GetContractSummary returns the commitments of the phases of a ramped contract and how much of the
+// commitment of every commitment period started so far was drawn down by billed usage
+func (s *subscriptionService) GetContractSummary(ctx context.Context, subscriptionID string) (*dto.SubscriptionContractSummaryResponse, error) {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	phases, err := getSubscriptionPhases(ctx, s.ServiceParams, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SubscriptionContractSummaryResponse{
+		SubscriptionID: sub.ID,
+		Currency:       sub.Currency,
+		Phases:         make([]*dto.ContractPhaseSummary, 0, len(phases)),
+	}
+
+	committedPhases := lo.Filter(phases, func(phase *subscription.SubscriptionPhase, _ int) bool {
+		return phase.HasCommitment()
+	})
+	if len(committedPhases) == 0 {
+		return response, nil
+	}
+
+	invoices, err := listContractInvoices(ctx, s.ServiceParams, sub, lo.ToPtr(committedPhases[0].StartDate))
+	if err != nil {
+		return nil, err
+	}
+
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
+	now := time.Now().UTC()
+	for _, phase := range committedPhases {
+		periods, err := phase.CommitmentPeriods(now)
+		if err != nil {
+			return nil, err
+		}
+
+		phaseSummary := &dto.ContractPhaseSummary{
+			PhaseID:                 phase.ID,
+			StartDate:               phase.StartDate,
+			EndDate:                 phase.EndDate,
+			CommitmentAmount:        lo.FromPtr(phase.CommitmentAmount),
+			OverageFactor:           phase.GetOverageFactor(),
+			CommitmentTrueUpCadence: lo.FromPtr(phase.CommitmentTrueUpCadence),
+			Periods:                 make([]*dto.ContractCommitmentPeriodSummary, 0, len(periods)),
+		}
+
+		for _, period := range periods {
+			billed := sumContractBilledAmounts(invoices, sub.ID, period.StartDate, period.EndDate)
+			commitment := rounding.RoundAmount(period.Amount, sub.Currency)
+
+			phaseSummary.Periods = append(phaseSummary.Periods, &dto.ContractCommitmentPeriodSummary{
+				StartDate:        period.StartDate,
+				EndDate:          period.EndDate,
+				CommitmentAmount: commitment,
+				UtilizedAmount:   billed.Spend,
+				RemainingAmount:  decimal.Max(commitment.Sub(billed.Spend), decimal.Zero),
+				TrueUpAmount:     billed.TrueUp,
+				OverageAmount:    billed.Overage,
+				IsCurrent:        !now.Before(period.StartDate) && now.Before(period.EndDate),
+			})
+			phaseSummary.CommittedAmount = phaseSummary.CommittedAmount.Add(commitment)
+			phaseSummary.UtilizedAmount = phaseSummary.UtilizedAmount.Add(billed.Spend)
+			phaseSummary.TrueUpAmount = phaseSummary.TrueUpAmount.Add(billed.TrueUp)
+			phaseSummary.OverageAmount = phaseSummary.OverageAmount.Add(billed.Overage)
+		}
+
+		response.Phases = append(response.Phases, phaseSummary)
+	}
+
+	return response, nil
+}
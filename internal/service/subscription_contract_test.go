@@ -0,0 +1,374 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/invoice"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/testutil"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSubscriptionPhaseCommitmentPeriods(t *testing.T) {
+	start := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("monthly_cadence_splits_the_annual_commitment", func(t *testing.T) {
+		phase := &subscription.SubscriptionPhase{
+			StartDate:               start,
+			EndDate:                 lo.ToPtr(start.AddDate(1, 0, 0)),
+			CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(1200)),
+			CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_MONTHLY),
+		}
+
+		periods, err := phase.CommitmentPeriods(time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, periods, 2)
+		// Periods keep the day of the phase start, clamped to the end of shorter months
+		assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), periods[0].EndDate)
+		assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), periods[1].EndDate)
+		assert.True(t, decimal.NewFromInt(100).Equal(periods[1].Amount))
+
+		period, err := phase.CommitmentPeriodAt(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NotNil(t, period)
+		assert.Equal(t, periods[1].StartDate, period.StartDate)
+	})
+
+	t.Run("annual_cadence_prorates_a_partial_last_year", func(t *testing.T) {
+		phaseEnd := time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC)
+		phase := &subscription.SubscriptionPhase{
+			StartDate:               start,
+			EndDate:                 &phaseEnd,
+			CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(3650)),
+			CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_ANNUAL),
+		}
+
+		periods, err := phase.CommitmentPeriods(phaseEnd)
+		require.NoError(t, err)
+		require.Len(t, periods, 2)
+		assert.True(t, decimal.NewFromInt(3650).Equal(periods[0].Amount))
+		assert.Equal(t, phaseEnd, periods[1].EndDate)
+		// 181 of the 365 days of the second contract year
+		assert.True(t, decimal.NewFromInt(1810).Equal(periods[1].Amount), "got %s", periods[1].Amount)
+
+		period, err := phase.CommitmentPeriodAt(phaseEnd)
+		require.NoError(t, err)
+		assert.Nil(t, period)
+	})
+
+	t.Run("phase_without_commitment", func(t *testing.T) {
+		phase := &subscription.SubscriptionPhase{StartDate: start}
+		periods, err := phase.CommitmentPeriods(start.AddDate(1, 0, 0))
+		require.NoError(t, err)
+		assert.Empty(t, periods)
+	})
+}
+
+func TestSubscriptionPhaseCreateRequestCommitmentValidation(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     dto.SubscriptionPhaseCreateRequest
+		wantErr bool
+	}{
+		{
+			name: "valid_commitment",
+			req: dto.SubscriptionPhaseCreateRequest{
+				StartDate:               start,
+				CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(1000)),
+				OverageFactor:           lo.ToPtr(decimal.NewFromFloat(1.2)),
+				CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_ANNUAL),
+			},
+		},
+		{
+			name: "missing_cadence",
+			req: dto.SubscriptionPhaseCreateRequest{
+				StartDate:        start,
+				CommitmentAmount: lo.ToPtr(decimal.NewFromInt(1000)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_cadence",
+			req: dto.SubscriptionPhaseCreateRequest{
+				StartDate:               start,
+				CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(1000)),
+				CommitmentTrueUpCadence: lo.ToPtr(types.CommitmentTrueUpCadence("weekly")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "overage_factor_below_one",
+			req: dto.SubscriptionPhaseCreateRequest{
+				StartDate:               start,
+				CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(1000)),
+				OverageFactor:           lo.ToPtr(decimal.NewFromFloat(0.5)),
+				CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_MONTHLY),
+			},
+			wantErr: true,
+		},
+		{
+			name: "cadence_without_commitment",
+			req: dto.SubscriptionPhaseCreateRequest{
+				StartDate:               start,
+				CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_MONTHLY),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type SubscriptionContractTestSuite struct {
+	testutil.BaseServiceTestSuite
+	billingService      *billingService
+	subscriptionService SubscriptionService
+	subscription        *subscription.Subscription
+	annualPhase         *subscription.SubscriptionPhase
+	monthlyPhase        *subscription.SubscriptionPhase
+}
+
+func TestSubscriptionContract(t *testing.T) {
+	suite.Run(t, new(SubscriptionContractTestSuite))
+}
+
+func (s *SubscriptionContractTestSuite) SetupTest() {
+	s.BaseServiceTestSuite.SetupTest()
+
+	params := ServiceParams{
+		Logger:                s.GetLogger(),
+		Config:                s.GetConfig(),
+		DB:                    s.GetDB(),
+		SubRepo:               s.GetStores().SubscriptionRepo,
+		SubscriptionPhaseRepo: s.GetStores().SubscriptionPhaseRepo,
+		InvoiceRepo:           s.GetStores().InvoiceRepo,
+	}
+	s.billingService = NewBillingService(params).(*billingService)
+	s.subscriptionService = NewSubscriptionService(params)
+
+	ctx := s.GetContext()
+	s.subscription = &subscription.Subscription{
+		ID:                 "subs_contract",
+		CustomerID:         "cust_contract",
+		PlanID:             "plan_contract",
+		Currency:           "usd",
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		StartDate:          time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EnvironmentID:      types.GetEnvironmentID(ctx),
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionRepo.Create(ctx, s.subscription))
+
+	// A two year ramp, an annual commitment trued up yearly followed by a larger one trued up monthly
+	s.annualPhase = &subscription.SubscriptionPhase{
+		ID:                      "phase_year_1",
+		SubscriptionID:          s.subscription.ID,
+		StartDate:               time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:                 lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(1200)),
+		OverageFactor:           lo.ToPtr(decimal.NewFromFloat(1.5)),
+		CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_ANNUAL),
+		EnvironmentID:           types.GetEnvironmentID(ctx),
+		BaseModel:               types.GetDefaultBaseModel(ctx),
+	}
+	s.monthlyPhase = &subscription.SubscriptionPhase{
+		ID:                      "phase_year_2",
+		SubscriptionID:          s.subscription.ID,
+		StartDate:               time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		CommitmentAmount:        lo.ToPtr(decimal.NewFromInt(2400)),
+		CommitmentTrueUpCadence: lo.ToPtr(types.COMMITMENT_TRUE_UP_CADENCE_MONTHLY),
+		EnvironmentID:           types.GetEnvironmentID(ctx),
+		BaseModel:               types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().SubscriptionPhaseRepo.CreateBulk(ctx, []*subscription.SubscriptionPhase{s.annualPhase, s.monthlyPhase}))
+
+	// Usage billed in January and February of the first contract year
+	s.createInvoice("inv_contract_jan", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), []*invoice.InvoiceLineItem{
+		s.usageLineItem(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 300),
+	})
+	s.createInvoice("inv_contract_feb", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), []*invoice.InvoiceLineItem{
+		s.usageLineItem(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), 500),
+	})
+}
+
+func (s *SubscriptionContractTestSuite) createInvoice(id string, periodStart time.Time, lineItems []*invoice.InvoiceLineItem) {
+	ctx := s.GetContext()
+	inv := &invoice.Invoice{
+		ID:             id,
+		CustomerID:     s.subscription.CustomerID,
+		SubscriptionID: lo.ToPtr(s.subscription.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		InvoiceStatus:  types.InvoiceStatusFinalized,
+		Currency:       s.subscription.Currency,
+		PeriodStart:    lo.ToPtr(periodStart),
+		PeriodEnd:      lo.ToPtr(periodStart.AddDate(0, 1, 0)),
+		LineItems:      lineItems,
+		EnvironmentID:  types.GetEnvironmentID(ctx),
+		BaseModel:      types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().InvoiceRepo.CreateWithLineItems(ctx, inv))
+}
+
+func (s *SubscriptionContractTestSuite) usageLineItem(periodStart time.Time, amount int64) *invoice.InvoiceLineItem {
+	return &invoice.InvoiceLineItem{
+		ID:          types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INVOICE_LINE_ITEM),
+		PriceID:     lo.ToPtr("price_api_calls"),
+		PriceType:   lo.ToPtr(string(types.PRICE_TYPE_USAGE)),
+		Amount:      decimal.NewFromInt(amount),
+		Quantity:    decimal.NewFromInt(amount),
+		PeriodStart: lo.ToPtr(periodStart),
+		PeriodEnd:   lo.ToPtr(periodStart.AddDate(0, 1, 0)),
+	}
+}
+
+func (s *SubscriptionContractTestSuite) usageCharges(amount int64) *BillingCalculationResult {
+	return &BillingCalculationResult{
+		UsageCharges: []dto.CreateInvoiceLineItemRequest{
+			{
+				PriceID:   lo.ToPtr("price_api_calls"),
+				PriceType: lo.ToPtr(string(types.PRICE_TYPE_USAGE)),
+				Amount:    decimal.NewFromInt(amount),
+				Quantity:  decimal.NewFromInt(amount),
+			},
+		},
+		TotalAmount: decimal.NewFromInt(amount),
+		Currency:    s.subscription.Currency,
+	}
+}
+
+func (s *SubscriptionContractTestSuite) TestApplyPhaseCommitments() {
+	ctx := s.GetContext()
+
+	tests := []struct {
+		name        string
+		periodStart time.Time
+		usage       int64
+		wantTrueUp  string
+		wantOverage string
+	}{
+		{
+			// 800 drawn down so far, the period does not end the contract year
+			name:        "mid_year_below_commitment",
+			periodStart: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			usage:       100,
+		},
+		{
+			// 1300 spent, 100 beyond the commitment is charged half again
+			name:        "mid_year_beyond_commitment",
+			periodStart: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			usage:       500,
+			wantOverage: "50",
+		},
+		{
+			// The last period of the contract year trues up the unused 200
+			name:        "year_end_below_commitment",
+			periodStart: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			usage:       200,
+			wantTrueUp:  "200",
+		},
+		{
+			name:        "year_end_beyond_commitment",
+			periodStart: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			usage:       600,
+			wantOverage: "100",
+		},
+		{
+			// The second phase commits to 200 every month
+			name:        "monthly_true_up",
+			periodStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			usage:       150,
+			wantTrueUp:  "50",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := s.usageCharges(tt.usage)
+			err := s.billingService.applyPhaseCommitments(ctx, s.subscription, tt.periodStart, tt.periodStart.AddDate(0, 1, 0), result)
+			s.NoError(err)
+
+			expectedTotal := decimal.NewFromInt(tt.usage)
+			trueUp, hasTrueUp := lo.Find(result.UsageCharges, func(charge dto.CreateInvoiceLineItemRequest) bool {
+				return charge.Metadata[metadataKeyContractTrueUp] == "true"
+			})
+			s.Equal(tt.wantTrueUp != "", hasTrueUp)
+			if hasTrueUp {
+				s.True(decimal.RequireFromString(tt.wantTrueUp).Equal(trueUp.Amount), "true-up: expected %s, got %s", tt.wantTrueUp, trueUp.Amount)
+				s.Equal(string(types.PRICE_TYPE_FIXED), lo.FromPtr(trueUp.PriceType))
+				expectedTotal = expectedTotal.Add(trueUp.Amount)
+			}
+
+			overage, hasOverage := lo.Find(result.UsageCharges, func(charge dto.CreateInvoiceLineItemRequest) bool {
+				return charge.Metadata[metadataKeyContractOverage] == "true"
+			})
+			s.Equal(tt.wantOverage != "", hasOverage)
+			if hasOverage {
+				s.True(decimal.RequireFromString(tt.wantOverage).Equal(overage.Amount), "overage: expected %s, got %s", tt.wantOverage, overage.Amount)
+				expectedTotal = expectedTotal.Add(overage.Amount)
+			}
+
+			s.True(expectedTotal.Equal(result.TotalAmount), "total: expected %s, got %s", expectedTotal, result.TotalAmount)
+		})
+	}
+}
+
+func (s *SubscriptionContractTestSuite) TestGetContractSummary() {
+	ctx := s.GetContext()
+
+	// December closes the first contract year with a true-up and January draws down the second phase
+	s.createInvoice("inv_contract_dec", time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), []*invoice.InvoiceLineItem{
+		s.usageLineItem(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), 100),
+		{
+			ID:          types.GenerateUUIDWithPrefix(types.UUID_PREFIX_INVOICE_LINE_ITEM),
+			PriceType:   lo.ToPtr(string(types.PRICE_TYPE_FIXED)),
+			Amount:      decimal.NewFromInt(300),
+			Quantity:    decimal.NewFromInt(1),
+			PeriodStart: lo.ToPtr(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)),
+			Metadata:    types.Metadata{metadataKeyContractTrueUp: "true"},
+		},
+	})
+	s.createInvoice("inv_contract_jan_2026", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), []*invoice.InvoiceLineItem{
+		s.usageLineItem(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 250),
+	})
+
+	summary, err := s.subscriptionService.GetContractSummary(ctx, s.subscription.ID)
+	s.NoError(err)
+	s.Equal(s.subscription.ID, summary.SubscriptionID)
+	s.Require().Len(summary.Phases, 2)
+
+	year1 := summary.Phases[0]
+	s.Equal(s.annualPhase.ID, year1.PhaseID)
+	s.Require().Len(year1.Periods, 1)
+	s.True(decimal.NewFromInt(1200).Equal(year1.CommittedAmount))
+	s.True(decimal.NewFromInt(900).Equal(year1.UtilizedAmount), "got %s", year1.UtilizedAmount)
+	s.True(decimal.NewFromInt(300).Equal(year1.Periods[0].RemainingAmount))
+	s.True(decimal.NewFromInt(300).Equal(year1.TrueUpAmount))
+	s.False(year1.Periods[0].IsCurrent)
+
+	year2 := summary.Phases[1]
+	s.Equal(types.COMMITMENT_TRUE_UP_CADENCE_MONTHLY, year2.CommitmentTrueUpCadence)
+	s.Require().NotEmpty(year2.Periods)
+	s.True(decimal.NewFromInt(200).Equal(year2.Periods[0].CommitmentAmount))
+	s.True(decimal.NewFromInt(250).Equal(year2.Periods[0].UtilizedAmount))
+	s.True(decimal.Zero.Equal(year2.Periods[0].RemainingAmount))
+}
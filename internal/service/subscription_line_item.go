@@ -231,6 +231,16 @@ func (s *subscriptionService) UpdateSubscriptionLineItem(ctx context.Context, li
 			Mark(ierr.ErrValidation)
 	}
 
+	if req.IncludedQuantity != nil && existingLineItem.PriceType != types.PRICE_TYPE_USAGE {
+		return nil, ierr.NewError("included_quantity can only be set for usage line items").
+			WithHint("Only usage line items can include usage for free").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": lineItemID,
+				"price_type":   existingLineItem.PriceType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
 	// Check if we need to create a new line item (with price overrides)
 	if req.ShouldCreateNewLineItem() {
 		// Validate line item is not already terminated
@@ -260,6 +270,7 @@ func (s *subscriptionService) UpdateSubscriptionLineItem(ctx context.Context, li
 			TierMode:          req.TierMode,
 			Tiers:             req.Tiers,
 			TransformQuantity: req.TransformQuantity,
+			IncludedQuantity:  req.IncludedQuantity,
 		}
 
 		priceMap := map[string]*dto.PriceResponse{existingLineItem.PriceID: price}
@@ -460,6 +471,21 @@ func (s *subscriptionService) validateLineItemCommitment(ctx context.Context, li
 				}).
 				Mark(ierr.ErrValidation)
 		}
+
+		// Window commitments are applied to the buckets of the whole meter usage, which the
+		// windows of a time-of-use price split by time of day
+		p, err := s.PriceRepo.Get(ctx, lineItem.PriceID)
+		if err != nil {
+			return err
+		}
+		if len(p.TimeWindows) > 0 {
+			return ierr.NewError("window commitment is not supported for time-of-use prices").
+				WithHint("Use a commitment on the total usage of a price with time windows").
+				WithReportableDetails(map[string]interface{}{
+					"price_id": p.ID,
+				}).
+				Mark(ierr.ErrValidation)
+		}
 	}
 
 	// Rule 5: Validate commitment type matches what was set
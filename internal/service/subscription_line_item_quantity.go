@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/price"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// lineItemQuantityChange is a quantity change of a line item that is computed but not yet persisted
+type lineItemQuantityChange struct {
+	sub      *subscription.Subscription
+	lineItem *subscription.SubscriptionLineItem
+	price    *price.Price
+	change   types.LineItemQuantityChange
+
+	// cancelOnly is set when the request only cancels the scheduled changes of the line item
+	cancelOnly bool
+
+	creditAmount decimal.Decimal
+	chargeAmount decimal.Decimal
+}
+
+func (c *lineItemQuantityChange) netAmount() decimal.Decimal {
+	return c.chargeAmount.Sub(c.creditAmount)
+}
+
+// UpdateLineItemQuantity changes the quantity of a fixed line item. Increases take effect at the
+// effective date and, for items billed in advance, the prorated difference is invoiced immediately.
+// Decreases either take effect at the end of the current period or immediately with the unused
+// time credited to the customer's wallet. Items billed in arrear are charged per quantity segment
+// at the end of the period, so no money moves when their quantity changes. Requesting the current
+// quantity cancels a scheduled change.
+func (s *subscriptionService) UpdateLineItemQuantity(ctx context.Context, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.LineItemQuantityChangeResponse, error) {
+	qc, err := s.prepareLineItemQuantityChange(ctx, lineItemID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		net := qc.netAmount()
+		if net.IsPositive() {
+			inv, err := s.createQuantityChangeInvoice(ctx, qc)
+			if err != nil {
+				return err
+			}
+			qc.change.InvoiceID = inv.ID
+			qc.lineItem.QuantityChanges[len(qc.lineItem.QuantityChanges)-1] = qc.change
+		} else if net.IsNegative() {
+			walletService := NewWalletService(s.ServiceParams)
+			if err := walletService.TopUpWalletForProratedCharge(ctx, qc.sub.CustomerID, net.Abs(), qc.sub.Currency); err != nil {
+				return err
+			}
+		}
+
+		return s.SubscriptionLineItemRepo.Update(ctx, qc.lineItem)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("updated subscription line item quantity",
+		"subscription_id", qc.sub.ID,
+		"line_item_id", qc.lineItem.ID,
+		"old_quantity", qc.change.OldQuantity,
+		"new_quantity", qc.change.NewQuantity,
+		"status", qc.change.Status,
+		"effective_date", qc.change.EffectiveDate,
+		"net_amount", qc.netAmount())
+
+	return s.toLineItemQuantityChangeResponse(qc, false), nil
+}
+
+// PreviewLineItemQuantityChange computes the result of a quantity change without applying it
+func (s *subscriptionService) PreviewLineItemQuantityChange(ctx context.Context, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*dto.LineItemQuantityChangeResponse, error) {
+	qc, err := s.prepareLineItemQuantityChange(ctx, lineItemID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toLineItemQuantityChangeResponse(qc, true), nil
+}
+
+// GetLineItemQuantityHistory returns the quantity history of a line item and its quantity
+// timeline over the current billing period
+func (s *subscriptionService) GetLineItemQuantityHistory(ctx context.Context, lineItemID string) (*dto.LineItemQuantityHistoryResponse, error) {
+	lineItem, err := s.SubscriptionLineItemRepo.Get(ctx, lineItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, lineItem.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	periodStart, periodEnd := lineItem.GetPeriod(sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	return &dto.LineItemQuantityHistoryResponse{
+		LineItemID: lineItem.ID,
+		Quantity:   lineItem.Quantity,
+		Changes:    lo.Ternary(lineItem.QuantityChanges == nil, []types.LineItemQuantityChange{}, lineItem.QuantityChanges),
+		Timeline:   lineItem.QuantitySegments(periodStart, periodEnd),
+	}, nil
+}
+
+// prepareLineItemQuantityChange validates the request, computes the proration and applies the
+// change to the in memory line item. Scheduled changes that have not taken effect yet are
+// superseded by the new change.
+func (s *subscriptionService) prepareLineItemQuantityChange(ctx context.Context, lineItemID string, req dto.UpdateLineItemQuantityRequest) (*lineItemQuantityChange, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.SubscriptionLineItemRepo.Get(ctx, lineItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The change is applied to a copy so that previews never modify the stored line item
+	lineItem := lo.ToPtr(*stored)
+	lineItem.QuantityChanges = slices.Clone(stored.QuantityChanges)
+
+	if lineItem.Status != types.StatusPublished || lineItem.PriceType != types.PRICE_TYPE_FIXED {
+		return nil, ierr.NewError("line item quantity cannot be changed").
+			WithHint("Only the quantity of active fixed price line items can be changed").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": lineItemID,
+				"status":       lineItem.Status,
+				"price_type":   lineItem.PriceType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	sub, err := s.SubRepo.Get(ctx, lineItem.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return nil, ierr.NewError("subscription is not active").
+			WithHint("Only active subscriptions can have line item quantities changed").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": sub.ID,
+				"status":          sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	effectiveDate := time.Now().UTC()
+	if req.EffectiveDate != nil {
+		effectiveDate = req.EffectiveDate.UTC()
+	}
+
+	periodStart, periodEnd := lineItem.GetPeriod(sub.CurrentPeriodStart, sub.CurrentPeriodEnd)
+	if effectiveDate.Before(periodStart) || !effectiveDate.Before(periodEnd) {
+		return nil, ierr.NewError("effective date must be within the current billing period").
+			WithHint("Quantity changes can only take effect within the current billing period of the line item").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id":   lineItemID,
+				"effective_date": effectiveDate,
+				"period_start":   periodStart,
+				"period_end":     periodEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	// Pending scheduled changes are superseded by the new change
+	scheduled := lineItem.ScheduledQuantityChanges()
+	for _, i := range scheduled {
+		lineItem.QuantityChanges[i].Status = types.QuantityChangeStatusCancelled
+	}
+
+	oldQuantity := lineItem.QuantityAt(effectiveDate)
+	if oldQuantity.Equal(req.Quantity) && len(scheduled) > 0 {
+		lineItem.UpdatedBy = types.GetUserID(ctx)
+		return &lineItemQuantityChange{sub: sub, lineItem: lineItem, cancelOnly: true}, nil
+	}
+	if oldQuantity.Equal(req.Quantity) {
+		return nil, ierr.NewError("quantity is unchanged").
+			WithHint("The new quantity must differ from the current quantity").
+			WithReportableDetails(map[string]interface{}{
+				"line_item_id": lineItemID,
+				"quantity":     oldQuantity,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	priceService := NewPriceService(s.ServiceParams)
+	priceResp, err := priceService.GetPrice(ctx, lineItem.PriceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prorate at the rate of the customer's rate card, the same rate the period is billed at
+	rateCard, err := getCustomerRateCard(ctx, s.ServiceParams, sub.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	qc := &lineItemQuantityChange{
+		sub:      sub,
+		lineItem: lineItem,
+		price:    price.RateCardPriceFor(rateCard, priceResp.Price, effectiveDate),
+		change: types.LineItemQuantityChange{
+			ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_QUANTITY_CHANGE),
+			OldQuantity:   oldQuantity,
+			NewQuantity:   req.Quantity,
+			EffectiveDate: effectiveDate,
+			Status:        types.QuantityChangeStatusApplied,
+			CreatedAt:     time.Now().UTC(),
+			CreatedBy:     types.GetUserID(ctx),
+		},
+	}
+
+	isDecrease := req.Quantity.LessThan(oldQuantity)
+	switch {
+	case isDecrease && req.DecreaseBehavior == types.QuantityDecreaseBehaviorNextPeriod:
+		// The current quantity stays in effect until the end of the period
+		qc.change.EffectiveDate = periodEnd
+		qc.change.Status = types.QuantityChangeStatusScheduled
+	case lineItem.InvoiceCadence == types.InvoiceCadenceAdvance && req.ProrationBehavior == types.ProrationBehaviorCreateProrations:
+		result, err := NewProrationService(s.ServiceParams).CalculateQuantityChangeProration(
+			ctx, sub, lineItem, qc.price, req.Quantity, effectiveDate, req.ProrationBehavior,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			for _, item := range result.CreditItems {
+				qc.creditAmount = qc.creditAmount.Add(item.Amount.Abs())
+			}
+			for _, item := range result.ChargeItems {
+				qc.chargeAmount = qc.chargeAmount.Add(item.Amount)
+			}
+		}
+		qc.change.ProratedAmount = qc.netAmount()
+	}
+
+	if qc.change.Status == types.QuantityChangeStatusApplied {
+		lineItem.Quantity = req.Quantity
+	}
+	lineItem.QuantityChanges = append(lineItem.QuantityChanges, qc.change)
+	lineItem.UpdatedBy = types.GetUserID(ctx)
+
+	return qc, nil
+}
+
+// createQuantityChangeInvoice invoices the prorated charge of a quantity increase for the rest of the current period
+func (s *subscriptionService) createQuantityChangeInvoice(ctx context.Context, qc *lineItemQuantityChange) (*dto.InvoiceResponse, error) {
+	invoiceService := NewInvoiceService(s.ServiceParams)
+
+	amount := qc.netAmount()
+	periodEnd := qc.lineItem.GetPeriodEnd(qc.sub.CurrentPeriodEnd)
+	displayName := lo.CoalesceOrEmpty(qc.lineItem.DisplayName, qc.lineItem.PlanDisplayName)
+
+	inv, err := invoiceService.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		CustomerID:     qc.sub.CustomerID,
+		SubscriptionID: lo.ToPtr(qc.sub.ID),
+		IdempotencyKey: lo.ToPtr(qc.change.ID),
+		InvoiceType:    types.InvoiceTypeSubscription,
+		Currency:       qc.sub.Currency,
+		AmountDue:      amount,
+		Subtotal:       amount,
+		Total:          amount,
+		Description:    fmt.Sprintf("Quantity change of %s from %s to %s", displayName, qc.change.OldQuantity, qc.change.NewQuantity),
+		BillingPeriod:  lo.ToPtr(string(qc.sub.BillingPeriod)),
+		PeriodStart:    lo.ToPtr(qc.change.EffectiveDate),
+		PeriodEnd:      lo.ToPtr(periodEnd),
+		BillingReason:  types.InvoiceBillingReasonProration,
+		PaymentStatus:  lo.ToPtr(types.PaymentStatusPending),
+		LineItems: []dto.CreateInvoiceLineItemRequest{
+			{
+				EntityID:        lo.ToPtr(qc.lineItem.EntityID),
+				EntityType:      lo.ToPtr(string(qc.lineItem.EntityType)),
+				PlanDisplayName: lo.ToPtr(qc.lineItem.PlanDisplayName),
+				PriceID:         lo.ToPtr(qc.lineItem.PriceID),
+				PriceType:       lo.ToPtr(string(qc.lineItem.PriceType)),
+				DisplayName:     lo.ToPtr(displayName),
+				Amount:          amount,
+				Quantity:        qc.change.NewQuantity.Sub(qc.change.OldQuantity).Abs(),
+				PeriodStart:     lo.ToPtr(qc.change.EffectiveDate),
+				PeriodEnd:       lo.ToPtr(periodEnd),
+				Metadata: types.Metadata{
+					"description":        fmt.Sprintf("%s (Prorated quantity change %s → %s)", displayName, qc.change.OldQuantity, qc.change.NewQuantity),
+					"quantity_change_id": qc.change.ID,
+				},
+			},
+		},
+		Metadata: types.Metadata{
+			"line_item_id":       qc.lineItem.ID,
+			"quantity_change_id": qc.change.ID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoice is finalized, synced and paid with the payment settings of the subscription
+	paymentParams := dto.NewPaymentParametersFromSubscription(qc.sub.CollectionMethod, qc.sub.PaymentBehavior, qc.sub.GatewayPaymentMethodID).NormalizePaymentParameters()
+	if err := invoiceService.ProcessDraftInvoice(ctx, inv.ID, paymentParams, qc.sub, types.InvoiceFlowRenewal); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// applyScheduledQuantityChanges applies the scheduled quantity changes of the line items of a
+// subscription that took effect by the start of the current period
+func (s *subscriptionService) applyScheduledQuantityChanges(ctx context.Context, sub *subscription.Subscription) error {
+	lineItems, err := s.SubscriptionLineItemRepo.ListBySubscription(ctx, sub)
+	if err != nil {
+		return err
+	}
+
+	for _, lineItem := range lineItems {
+		updated := false
+		for _, i := range lineItem.ScheduledQuantityChanges() {
+			change := &lineItem.QuantityChanges[i]
+			if change.EffectiveDate.After(sub.CurrentPeriodStart) {
+				continue
+			}
+			change.Status = types.QuantityChangeStatusApplied
+			lineItem.Quantity = change.NewQuantity
+			updated = true
+		}
+		if !updated {
+			continue
+		}
+
+		if err := s.SubscriptionLineItemRepo.Update(ctx, lineItem); err != nil {
+			return err
+		}
+
+		s.Logger.Infow("applied scheduled quantity change",
+			"subscription_id", sub.ID,
+			"line_item_id", lineItem.ID,
+			"quantity", lineItem.Quantity)
+	}
+
+	return nil
+}
+
+func (s *subscriptionService) toLineItemQuantityChangeResponse(qc *lineItemQuantityChange, preview bool) *dto.LineItemQuantityChangeResponse {
+	periodStart, periodEnd := qc.lineItem.GetPeriod(qc.sub.CurrentPeriodStart, qc.sub.CurrentPeriodEnd)
+	resp := &dto.LineItemQuantityChangeResponse{
+		Preview:      preview,
+		LineItem:     qc.lineItem,
+		CreditAmount: qc.creditAmount,
+		ChargeAmount: qc.chargeAmount,
+		NetAmount:    qc.netAmount(),
+		InvoiceID:    qc.change.InvoiceID,
+		Timeline:     qc.lineItem.QuantitySegments(periodStart, periodEnd),
+	}
+	if !qc.cancelOnly {
+		resp.Change = lo.ToPtr(qc.change)
+	}
+	return resp
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/subscription"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -287,3 +289,113 @@ func (s *SubscriptionLineItemServiceSuite) TestAddSubscriptionLineItem_Success()
 	_, err = s.GetStores().SubscriptionLineItemRepo.Get(ctx, resp.SubscriptionLineItem.ID)
 	s.NoError(err)
 }
+
+func (s *SubscriptionLineItemServiceSuite) TestUpdateLineItemQuantity_IncreaseInvoicesProration() {
+	ctx := s.GetContext()
+	s.testData.subscription.CustomerTimezone = "UTC"
+	s.NoError(s.GetStores().SubscriptionRepo.Update(ctx, s.testData.subscription))
+
+	req := dto.UpdateLineItemQuantityRequest{Quantity: decimal.NewFromInt(3)}
+
+	preview, err := s.service.PreviewLineItemQuantityChange(ctx, s.testData.lineItem.ID, req)
+	s.NoError(err)
+	s.True(preview.Preview)
+	s.True(preview.NetAmount.IsPositive())
+	s.Empty(preview.InvoiceID)
+
+	li, err := s.GetStores().SubscriptionLineItemRepo.Get(ctx, s.testData.lineItem.ID)
+	s.NoError(err)
+	s.True(li.Quantity.Equal(decimal.NewFromInt(1)), "preview must not change the quantity")
+	s.Empty(li.QuantityChanges)
+
+	resp, err := s.service.UpdateLineItemQuantity(ctx, s.testData.lineItem.ID, req)
+	s.NoError(err)
+	s.False(resp.Preview)
+	s.True(resp.NetAmount.Equal(preview.NetAmount))
+	s.NotEmpty(resp.InvoiceID)
+	s.Equal(types.QuantityChangeStatusApplied, resp.Change.Status)
+	s.Len(resp.Timeline, 2)
+
+	li, err = s.GetStores().SubscriptionLineItemRepo.Get(ctx, s.testData.lineItem.ID)
+	s.NoError(err)
+	s.True(li.Quantity.Equal(decimal.NewFromInt(3)))
+	s.Len(li.QuantityChanges, 1)
+	s.Equal(resp.InvoiceID, li.QuantityChanges[0].InvoiceID)
+}
+
+func (s *SubscriptionLineItemServiceSuite) TestUpdateLineItemQuantity_ProratesAtRateCardPrice() {
+	ctx := s.GetContext()
+	s.testData.subscription.CustomerTimezone = "UTC"
+	s.NoError(s.GetStores().SubscriptionRepo.Update(ctx, s.testData.subscription))
+	req := dto.UpdateLineItemQuantityRequest{Quantity: decimal.NewFromInt(3)}
+
+	planRate, err := s.service.PreviewLineItemQuantityChange(ctx, s.testData.lineItem.ID, req)
+	s.NoError(err)
+	s.True(planRate.NetAmount.IsPositive())
+
+	// The customer's rate card halves the seat price
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, &price.Price{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_PRICE),
+		Amount:        decimal.NewFromInt(25),
+		Currency:      "usd",
+		EntityType:    types.PRICE_ENTITY_TYPE_CUSTOMER,
+		EntityID:      s.testData.customer.ID,
+		ParentPriceID: s.testData.price.ID,
+		Type:          types.PRICE_TYPE_FIXED,
+		BillingModel:  types.BILLING_MODEL_FLAT_FEE,
+		StartDate:     lo.ToPtr(s.testData.subscription.StartDate),
+		BaseModel:     types.GetDefaultBaseModel(ctx),
+	}))
+
+	rated, err := s.service.PreviewLineItemQuantityChange(ctx, s.testData.lineItem.ID, req)
+	s.NoError(err)
+	s.InDelta(planRate.NetAmount.Div(decimal.NewFromInt(2)).InexactFloat64(), rated.NetAmount.InexactFloat64(), 0.01)
+}
+
+func (s *SubscriptionLineItemServiceSuite) TestUpdateLineItemQuantity_DecreaseNextPeriod() {
+	ctx := s.GetContext()
+
+	resp, err := s.service.UpdateLineItemQuantity(ctx, s.testData.lineItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.Zero,
+	})
+	s.NoError(err)
+	s.Equal(types.QuantityChangeStatusScheduled, resp.Change.Status)
+	s.True(resp.Change.EffectiveDate.Equal(s.testData.subscription.CurrentPeriodEnd))
+	s.True(resp.NetAmount.IsZero())
+	s.True(resp.LineItem.Quantity.Equal(decimal.NewFromInt(1)))
+	s.True(resp.LineItem.QuantityAt(s.testData.subscription.CurrentPeriodEnd).IsZero())
+
+	// Requesting the current quantity cancels the scheduled decrease
+	resp, err = s.service.UpdateLineItemQuantity(ctx, s.testData.lineItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(1),
+	})
+	s.NoError(err)
+	s.Nil(resp.Change)
+
+	li, err := s.GetStores().SubscriptionLineItemRepo.Get(ctx, s.testData.lineItem.ID)
+	s.NoError(err)
+	s.Len(li.QuantityChanges, 1)
+	s.Equal(types.QuantityChangeStatusCancelled, li.QuantityChanges[0].Status)
+	s.True(li.QuantityAt(s.testData.subscription.CurrentPeriodEnd).Equal(decimal.NewFromInt(1)))
+
+	_, err = s.service.UpdateLineItemQuantity(ctx, s.testData.lineItem.ID, dto.UpdateLineItemQuantityRequest{
+		Quantity: decimal.NewFromInt(1),
+	})
+	s.Error(err)
+}
+
+func TestProrateQuantitySegments(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mid := start.AddDate(0, 0, 10)
+	end := start.AddDate(0, 0, 20)
+	segments := []types.QuantitySegment{
+		{Start: start, End: mid, Quantity: decimal.NewFromInt(2)},
+		{Start: mid, End: end, Quantity: decimal.NewFromInt(4)},
+	}
+
+	amounts := prorateQuantitySegments(segments, start, end, func(quantity decimal.Decimal) decimal.Decimal {
+		return quantity.Mul(decimal.NewFromInt(10))
+	})
+	assert.True(t, amounts[0].Equal(decimal.NewFromInt(10)))
+	assert.True(t, amounts[1].Equal(decimal.NewFromInt(20)))
+}
@@ -0,0 +1,612 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/task"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// SubscriptionMigrationService moves subscriptions matching a filter to another plan in bulk. The
+// migration runs as a task executed by a Temporal workflow which migrates the subscriptions in
+// batches and can be paused and resumed between batches.
+type SubscriptionMigrationService interface {
+	// PreviewSubscriptionMigration returns a dry-run report of the migration without changing any subscription
+	PreviewSubscriptionMigration(ctx context.Context, req dto.SubscriptionMigrationRequest) (*dto.SubscriptionMigrationPreviewResponse, error)
+
+	// CreateSubscriptionMigration creates a migration task and starts the migration workflow
+	CreateSubscriptionMigration(ctx context.Context, req dto.SubscriptionMigrationRequest) (*dto.SubscriptionMigrationResponse, error)
+
+	// GetSubscriptionMigration returns the progress of a migration
+	GetSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error)
+
+	// ListSubscriptionMigrationResults returns a page of the per subscription results of a migration
+	ListSubscriptionMigrationResults(ctx context.Context, taskID string, filter *types.SubscriptionMigrationResultFilter) (*dto.ListSubscriptionMigrationResultsResponse, error)
+
+	// PauseSubscriptionMigration pauses a running migration once the batch in flight completes
+	PauseSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error)
+
+	// ResumeSubscriptionMigration resumes a paused migration
+	ResumeSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error)
+
+	// StartSubscriptionMigration selects the subscriptions of a migration task
+	StartSubscriptionMigration(ctx context.Context, taskID string) error
+
+	// ProcessSubscriptionMigrationBatch migrates the next batch of subscriptions of a migration
+	// task and returns true once every subscription has been processed
+	ProcessSubscriptionMigrationBatch(ctx context.Context, taskID string) (bool, error)
+
+	// UpdateSubscriptionMigrationStatus marks a migration task as paused or processing
+	UpdateSubscriptionMigrationStatus(ctx context.Context, taskID string, status types.TaskStatus) error
+
+	// FailSubscriptionMigration marks a migration task as failed once its workflow gave up
+	FailSubscriptionMigration(ctx context.Context, taskID string, reason string) error
+}
+
+type subscriptionMigrationService struct {
+	ServiceParams
+}
+
+// NewSubscriptionMigrationService creates a new subscription migration service
+func NewSubscriptionMigrationService(params ServiceParams) SubscriptionMigrationService {
+	return &subscriptionMigrationService{
+		ServiceParams: params,
+	}
+}
+
+func (s *subscriptionMigrationService) PreviewSubscriptionMigration(ctx context.Context, req dto.SubscriptionMigrationRequest) (*dto.SubscriptionMigrationPreviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.PlanRepo.Get(ctx, req.TargetPlanID); err != nil {
+		return nil, err
+	}
+
+	subs, err := s.listMigrationSubscriptions(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	changeService := NewSubscriptionChangeService(s.ServiceParams)
+	response := &dto.SubscriptionMigrationPreviewResponse{
+		TargetPlanID:       req.TargetPlanID,
+		ChangeAt:           req.ChangeAt,
+		ProrationBehavior:  req.ProrationBehavior,
+		TotalSubscriptions: len(subs),
+		Subscriptions:      make([]*dto.SubscriptionMigrationPreviewItem, 0, len(subs)),
+	}
+
+	for _, sub := range subs {
+		item := &dto.SubscriptionMigrationPreviewItem{
+			SubscriptionID: sub.ID,
+			CustomerID:     sub.CustomerID,
+			CurrentPlanID:  sub.PlanID,
+		}
+		response.Subscriptions = append(response.Subscriptions, item)
+
+		if err := s.validateScheduledMigration(ctx, sub, &req); err != nil {
+			item.Error = err.Error()
+			continue
+		}
+
+		preview, err := changeService.PreviewSubscriptionChange(ctx, sub.ID, req.ToSubscriptionChangeRequest(sub))
+		if err != nil {
+			item.Error = err.Error()
+			continue
+		}
+		if req.ChangeAt == types.ScheduleTypePeriodEnd {
+			preview.EffectiveDate = sub.CurrentPeriodEnd
+		}
+
+		item.Eligible = true
+		item.Preview = preview
+		response.EligibleSubscriptions++
+	}
+
+	return response, nil
+}
+
+func (s *subscriptionMigrationService) CreateSubscriptionMigration(ctx context.Context, req dto.SubscriptionMigrationRequest) (*dto.SubscriptionMigrationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.PlanRepo.Get(ctx, req.TargetPlanID); err != nil {
+		return nil, err
+	}
+
+	requestMetadata, err := toMetadataMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	t := &task.Task{
+		ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TASK),
+		TaskType:      types.TaskTypeMigration,
+		EntityType:    types.EntityTypeSubscriptionMigration,
+		FileType:      types.FileTypeJSON,
+		TaskStatus:    types.TaskStatusPending,
+		EnvironmentID: types.GetEnvironmentID(ctx),
+		Metadata: map[string]interface{}{
+			"request": requestMetadata,
+		},
+		StartedAt: &now,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+
+	if err := s.TaskRepo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		return nil, ierr.NewError("temporal service not available").
+			WithHint("Subscription migration workflow requires Temporal service").
+			Mark(ierr.ErrInternal)
+	}
+
+	workflowRun, err := temporalSvc.ExecuteWorkflow(ctx, types.TemporalSubscriptionMigrationWorkflow, t.ID)
+	if err != nil {
+		s.Logger.Errorw("failed to start subscription migration workflow", "error", err, "task_id", t.ID)
+		return nil, err
+	}
+
+	t.WorkflowID = lo.ToPtr(workflowRun.GetID())
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return s.toMigrationResponse(t)
+}
+
+func (s *subscriptionMigrationService) GetSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error) {
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toMigrationResponse(t)
+}
+
+func (s *subscriptionMigrationService) ListSubscriptionMigrationResults(ctx context.Context, taskID string, filter *types.SubscriptionMigrationResultFilter) (*dto.ListSubscriptionMigrationResultsResponse, error) {
+	if filter == nil {
+		filter = types.NewSubscriptionMigrationResultFilter()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMigrationTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	filter.TaskID = taskID
+
+	results, err := s.MigrationResultRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.MigrationResultRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items := lo.Map(results, func(r *subscription.MigrationResult, _ int) *dto.SubscriptionMigrationResult {
+		return dto.NewSubscriptionMigrationResult(r)
+	})
+
+	response := types.NewListResponse(items, count, filter.GetLimit(), filter.GetOffset())
+	return &response, nil
+}
+
+func (s *subscriptionMigrationService) PauseSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error) {
+	return s.signalMigration(ctx, taskID, types.TaskStatusProcessing, types.TemporalSignalPauseSubscriptionMigration)
+}
+
+func (s *subscriptionMigrationService) ResumeSubscriptionMigration(ctx context.Context, taskID string) (*dto.SubscriptionMigrationResponse, error) {
+	return s.signalMigration(ctx, taskID, types.TaskStatusPaused, types.TemporalSignalResumeSubscriptionMigration)
+}
+
+// signalMigration sends a signal to the workflow of a migration task in the expected status. The
+// workflow updates the status of the task once it acts on the signal.
+func (s *subscriptionMigrationService) signalMigration(ctx context.Context, taskID string, expected types.TaskStatus, signal string) (*dto.SubscriptionMigrationResponse, error) {
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.TaskStatus != expected || t.WorkflowID == nil {
+		return nil, ierr.NewError(fmt.Sprintf("subscription migration must be %s", expected)).
+			WithHint("Only running migrations can be paused and only paused migrations can be resumed").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":     taskID,
+				"task_status": t.TaskStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		return nil, ierr.NewError("temporal service not available").
+			WithHint("Subscription migration workflow requires Temporal service").
+			Mark(ierr.ErrInternal)
+	}
+
+	if err := temporalSvc.SignalWorkflow(ctx, *t.WorkflowID, "", signal, nil); err != nil {
+		s.Logger.Errorw("failed to signal subscription migration workflow",
+			"error", err,
+			"task_id", taskID,
+			"signal", signal)
+		return nil, ierr.WithError(err).
+			WithHint("Failed to signal the subscription migration workflow").
+			Mark(ierr.ErrInternal)
+	}
+
+	return s.toMigrationResponse(t)
+}
+
+func (s *subscriptionMigrationService) StartSubscriptionMigration(ctx context.Context, taskID string) error {
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	// The subscriptions are selected once, retries keep the original selection
+	selected, err := s.MigrationResultRepo.Count(ctx, s.newMigrationResultFilter(taskID))
+	if err != nil {
+		return err
+	}
+	if selected > 0 {
+		return nil
+	}
+
+	req, err := s.getMigrationRequest(t)
+	if err != nil {
+		return err
+	}
+
+	subs, err := s.listMigrationSubscriptions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infow("starting subscription migration",
+		"task_id", t.ID,
+		"target_plan_id", req.TargetPlanID,
+		"subscriptions", len(subs))
+
+	results := lo.Map(subs, func(sub *subscription.Subscription, _ int) *subscription.MigrationResult {
+		return &subscription.MigrationResult{
+			ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_MIGRATION_RESULT),
+			TaskID:          t.ID,
+			SubscriptionID:  sub.ID,
+			CustomerID:      sub.CustomerID,
+			MigrationStatus: types.SubscriptionMigrationStatusPending,
+			EnvironmentID:   t.EnvironmentID,
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		}
+	})
+
+	t.TotalRecords = lo.ToPtr(len(results))
+	t.TaskStatus = types.TaskStatusProcessing
+	if len(results) == 0 {
+		t.TaskStatus = types.TaskStatusCompleted
+		t.CompletedAt = lo.ToPtr(time.Now().UTC())
+	}
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.MigrationResultRepo.CreateBulk(ctx, results); err != nil {
+			return err
+		}
+		return s.TaskRepo.Update(ctx, t)
+	})
+}
+
+func (s *subscriptionMigrationService) ProcessSubscriptionMigrationBatch(ctx context.Context, taskID string) (bool, error) {
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return false, err
+	}
+
+	if t.TaskStatus == types.TaskStatusCompleted || t.TaskStatus == types.TaskStatusFailed {
+		return true, nil
+	}
+
+	req, err := s.getMigrationRequest(t)
+	if err != nil {
+		return false, err
+	}
+
+	filter := s.newMigrationResultFilter(taskID, types.SubscriptionMigrationStatusPending)
+	filter.Limit = lo.ToPtr(req.BatchSize)
+	pending, err := s.MigrationResultRepo.List(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	// The change of each subscription is saved along with its result, a retried batch only picks
+	// up the subscriptions still pending instead of migrating the subscriptions of the batch again
+	changeService := NewSubscriptionChangeService(s.ServiceParams)
+	for _, result := range pending {
+		if err := s.migrateSubscription(ctx, changeService, req, result); err != nil {
+			return false, err
+		}
+	}
+
+	if err := s.updateMigrationProgress(ctx, t); err != nil {
+		return false, err
+	}
+
+	total := lo.FromPtr(t.TotalRecords)
+	done := t.ProcessedRecords >= total
+	if done {
+		t.TaskStatus = types.TaskStatusCompleted
+		t.CompletedAt = lo.ToPtr(time.Now().UTC())
+		if t.FailedRecords > 0 {
+			t.ErrorSummary = lo.ToPtr(fmt.Sprintf("%d of %d subscriptions failed to migrate", t.FailedRecords, total))
+		}
+	}
+	if err := s.TaskRepo.Update(ctx, t); err != nil {
+		return false, err
+	}
+
+	s.Logger.Infow("processed subscription migration batch",
+		"task_id", t.ID,
+		"processed", t.ProcessedRecords,
+		"total", total,
+		"failed", t.FailedRecords)
+
+	return done, nil
+}
+
+func (s *subscriptionMigrationService) UpdateSubscriptionMigrationStatus(ctx context.Context, taskID string, status types.TaskStatus) error {
+	if status != types.TaskStatusPaused && status != types.TaskStatusProcessing {
+		return ierr.NewError("invalid subscription migration status").
+			WithHint("A subscription migration can only be paused or resumed").
+			WithReportableDetails(map[string]interface{}{
+				"task_id": taskID,
+				"status":  status,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if t.TaskStatus == status {
+		return nil
+	}
+
+	return NewTaskService(s.ServiceParams).UpdateTaskStatus(ctx, taskID, status)
+}
+
+func (s *subscriptionMigrationService) FailSubscriptionMigration(ctx context.Context, taskID string, reason string) error {
+	t, err := s.getMigrationTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if t.TaskStatus == types.TaskStatusCompleted || t.TaskStatus == types.TaskStatusFailed {
+		return nil
+	}
+
+	s.Logger.Errorw("subscription migration failed",
+		"task_id", t.ID,
+		"processed", t.ProcessedRecords,
+		"reason", reason)
+
+	t.TaskStatus = types.TaskStatusFailed
+	t.FailedAt = lo.ToPtr(time.Now().UTC())
+	if reason != "" {
+		t.ErrorSummary = lo.ToPtr(reason)
+	}
+	return s.TaskRepo.Update(ctx, t)
+}
+
+// migrateSubscription changes the plan of the subscription of a pending result. The change and the
+// result are saved in one transaction, a failed change is rolled back and saved on the result.
+func (s *subscriptionMigrationService) migrateSubscription(
+	ctx context.Context,
+	changeService SubscriptionChangeService,
+	req *dto.SubscriptionMigrationRequest,
+	result *subscription.MigrationResult,
+) error {
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		sub, err := s.SubRepo.Get(ctx, result.SubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := changeService.ExecuteSubscriptionChange(ctx, sub.ID, req.ToSubscriptionChangeRequest(sub))
+		if err != nil {
+			return err
+		}
+
+		migrated := *result
+		migrated.ProcessedAt = lo.ToPtr(time.Now().UTC())
+		if resp.IsScheduled {
+			migrated.MigrationStatus = types.SubscriptionMigrationStatusScheduled
+			migrated.ScheduleID = resp.ScheduleID
+		} else {
+			migrated.MigrationStatus = types.SubscriptionMigrationStatusMigrated
+			migrated.NewSubscriptionID = lo.ToPtr(resp.NewSubscription.ID)
+		}
+		return s.MigrationResultRepo.Update(ctx, &migrated)
+	})
+	if err == nil {
+		return nil
+	}
+
+	s.Logger.Warnw("failed to migrate subscription",
+		"error", err,
+		"subscription_id", result.SubscriptionID,
+		"target_plan_id", req.TargetPlanID)
+
+	result.MigrationStatus = types.SubscriptionMigrationStatusFailed
+	result.Error = lo.ToPtr(err.Error())
+	result.ProcessedAt = lo.ToPtr(time.Now().UTC())
+	return s.MigrationResultRepo.Update(ctx, result)
+}
+
+// updateMigrationProgress counts the processed results of a migration task into its counters
+func (s *subscriptionMigrationService) updateMigrationProgress(ctx context.Context, t *task.Task) error {
+	failed, err := s.MigrationResultRepo.Count(ctx, s.newMigrationResultFilter(t.ID, types.SubscriptionMigrationStatusFailed))
+	if err != nil {
+		return err
+	}
+
+	successful, err := s.MigrationResultRepo.Count(ctx, s.newMigrationResultFilter(t.ID,
+		types.SubscriptionMigrationStatusMigrated,
+		types.SubscriptionMigrationStatusScheduled,
+	))
+	if err != nil {
+		return err
+	}
+
+	t.FailedRecords = failed
+	t.SuccessfulRecords = successful
+	t.ProcessedRecords = failed + successful
+	return nil
+}
+
+// newMigrationResultFilter returns a filter of the results of a migration task in the given statuses
+func (s *subscriptionMigrationService) newMigrationResultFilter(taskID string, statuses ...types.SubscriptionMigrationStatus) *types.SubscriptionMigrationResultFilter {
+	filter := types.NewSubscriptionMigrationResultFilter()
+	filter.TaskID = taskID
+	filter.MigrationStatus = statuses
+	return filter
+}
+
+// validateScheduledMigration reports subscriptions whose plan change cannot be scheduled for the
+// end of the current period, it is a no-op for immediate migrations
+func (s *subscriptionMigrationService) validateScheduledMigration(ctx context.Context, sub *subscription.Subscription, req *dto.SubscriptionMigrationRequest) error {
+	if req.ChangeAt != types.ScheduleTypePeriodEnd {
+		return nil
+	}
+
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+		return ierr.NewError("subscription must be active to schedule changes").
+			WithHint("Only active subscriptions can have scheduled plan changes").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, changeType := range []types.SubscriptionScheduleChangeType{
+		types.SubscriptionScheduleChangeTypeCancellation,
+		types.SubscriptionScheduleChangeTypePlanChange,
+	} {
+		pending, err := s.SubScheduleRepo.GetPendingBySubscriptionAndType(ctx, sub.ID, changeType)
+		if err != nil {
+			return err
+		}
+		if pending != nil {
+			return ierr.NewError(fmt.Sprintf("subscription has a pending %s schedule", changeType)).
+				WithHint("Cancel the pending schedule before migrating the subscription").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// listMigrationSubscriptions returns the active and trialing subscriptions matching the filter of
+// the migration that are not on the target plan yet
+func (s *subscriptionMigrationService) listMigrationSubscriptions(ctx context.Context, req *dto.SubscriptionMigrationRequest) ([]*subscription.Subscription, error) {
+	filters := make([]*types.SubscriptionFilter, 0)
+	switch {
+	case len(req.Filter.PlanIDs) > 0:
+		for _, planID := range lo.Uniq(req.Filter.PlanIDs) {
+			filter := types.NewNoLimitSubscriptionFilter()
+			filter.PlanID = planID
+			filters = append(filters, filter)
+		}
+	case len(req.Filter.CustomerIDs) > 0:
+		for _, customerID := range lo.Uniq(req.Filter.CustomerIDs) {
+			filter := types.NewNoLimitSubscriptionFilter()
+			filter.CustomerID = customerID
+			filters = append(filters, filter)
+		}
+	default:
+		filters = append(filters, types.NewNoLimitSubscriptionFilter())
+	}
+
+	subs := make([]*subscription.Subscription, 0)
+	for _, filter := range filters {
+		filter.SubscriptionStatus = []types.SubscriptionStatus{
+			types.SubscriptionStatusActive,
+			types.SubscriptionStatusTrialing,
+		}
+
+		items, err := s.SubRepo.ListAll(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, items...)
+	}
+
+	subs = lo.UniqBy(subs, func(sub *subscription.Subscription) string { return sub.ID })
+	return lo.Filter(subs, func(sub *subscription.Subscription, _ int) bool {
+		return sub.PlanID != req.TargetPlanID && req.Filter.Matches(sub)
+	}), nil
+}
+
+// getMigrationTask returns the task of a subscription migration
+func (s *subscriptionMigrationService) getMigrationTask(ctx context.Context, taskID string) (*task.Task, error) {
+	t, err := s.TaskRepo.Get(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.TaskType != types.TaskTypeMigration || t.EntityType != types.EntityTypeSubscriptionMigration {
+		return nil, ierr.NewError("task is not a subscription migration").
+			WithHint("Only subscription migration tasks can be used as migrations").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":     taskID,
+				"task_type":   t.TaskType,
+				"entity_type": t.EntityType,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]interface{})
+	}
+	return t, nil
+}
+
+// getMigrationRequest returns the request stored on a migration task
+func (s *subscriptionMigrationService) getMigrationRequest(t *task.Task) (*dto.SubscriptionMigrationRequest, error) {
+	var req dto.SubscriptionMigrationRequest
+	if err := fromMetadataMap(t.Metadata["request"], &req); err != nil {
+		return nil, err
+	}
+	if req.BatchSize == 0 {
+		req.BatchSize = types.DefaultSubscriptionMigrationBatchSize
+	}
+	return &req, nil
+}
+
+// toMigrationResponse builds the response of a migration task, the request stored in the task
+// metadata is returned as a typed field
+func (s *subscriptionMigrationService) toMigrationResponse(t *task.Task) (*dto.SubscriptionMigrationResponse, error) {
+	req, err := s.getMigrationRequest(t)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResponse := dto.NewTaskResponse(t)
+	taskResponse.Metadata = nil
+
+	return &dto.SubscriptionMigrationResponse{
+		Task:    taskResponse,
+		Request: req,
+	}, nil
+}
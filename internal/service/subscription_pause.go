@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// ProcessSubscriptionPause activates the scheduled pause of a subscription once it is due and
+// resumes the subscription once its pause has ended. It is run before the billing period of the
+// subscription is processed, which is skipped while all billing of the subscription is paused.
+func (s *subscriptionService) ProcessSubscriptionPause(ctx context.Context, subscriptionID string, now time.Time) (*dto.SubscriptionPauseProcessingResult, error) {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.processSubscriptionPause(ctx, sub, now)
+}
+
+func (s *subscriptionService) processSubscriptionPause(ctx context.Context, sub *subscription.Subscription, now time.Time) (*dto.SubscriptionPauseProcessingResult, error) {
+	result := &dto.SubscriptionPauseProcessingResult{
+		IsPaused:         sub.SubscriptionStatus == types.SubscriptionStatusPaused,
+		CurrentPeriodEnd: sub.CurrentPeriodEnd,
+		PauseID:          sub.ActivePauseID,
+	}
+
+	// Paused subscriptions without a pause record are not billed until they are resumed
+	if sub.ActivePauseID == nil || sub.PauseStatus == types.PauseStatusNone {
+		result.SkipBilling = result.IsPaused
+		return result, nil
+	}
+
+	pause, err := s.SubRepo.GetPause(ctx, *sub.ActivePauseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.PauseStatus == types.PauseStatusScheduled {
+		if now.Before(pause.PauseStart) {
+			return result, nil
+		}
+
+		if err := s.activatePause(ctx, sub, pause); err != nil {
+			return nil, err
+		}
+
+		s.Logger.Infow("activated scheduled pause",
+			"subscription_id", sub.ID,
+			"pause_id", pause.ID,
+			"pause_mode", pause.PauseMode,
+			"billing_mode", pause.BillingMode)
+
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionPaused, sub.ID)
+	}
+
+	// Pauses with an end date resume the subscription automatically
+	if pause.PauseEnd != nil && !now.Before(*pause.PauseEnd) {
+		resumedAt := *pause.PauseEnd
+		if err := s.resumeFromPause(ctx, sub, pause, resumedAt, types.ResumeModeAuto); err != nil {
+			return nil, err
+		}
+
+		s.Logger.Infow("auto-resumed subscription",
+			"subscription_id", sub.ID,
+			"pause_id", pause.ID,
+			"pause_duration", resumedAt.Sub(pause.PauseStart))
+
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionResumed, sub.ID)
+
+		result.IsPaused = false
+		result.WasResumed = true
+		result.CurrentPeriodEnd = sub.CurrentPeriodEnd
+		return result, nil
+	}
+
+	result.IsPaused = true
+	result.CurrentPeriodEnd = sub.CurrentPeriodEnd
+	result.SkipBilling = !pause.BillsFixedFees()
+	return result, nil
+}
+
+// activatePause starts a scheduled pause. When all billing stops at the end of the current period,
+// the period is invoiced first, without the advance charges of the next period, and remains the
+// current period of the subscription until it resumes.
+func (s *subscriptionService) activatePause(ctx context.Context, sub *subscription.Subscription, pause *subscription.SubscriptionPause) error {
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if !pause.BillsFixedFees() && !sub.CurrentPeriodEnd.After(pause.PauseStart) {
+			paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
+			_, updatedSub, err := NewInvoiceService(s.ServiceParams).CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
+				SubscriptionID: sub.ID,
+				PeriodStart:    sub.CurrentPeriodStart,
+				PeriodEnd:      sub.CurrentPeriodEnd,
+				ReferencePoint: types.ReferencePointPeriodEnd,
+			}, paymentParams, types.InvoiceFlowRenewal, false)
+			if err != nil {
+				return err
+			}
+			if updatedSub != nil {
+				*sub = *updatedSub
+			}
+		}
+
+		sub.SubscriptionStatus = types.SubscriptionStatusPaused
+		sub.PauseStatus = types.PauseStatusActive
+		pause.PauseStatus = types.PauseStatusActive
+
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		return s.SubRepo.UpdatePause(ctx, pause)
+	})
+}
+
+// resumeFromPause completes the pause of the subscription and moves its billing period:
+//   - pauses billing fixed fees kept the billing periods going, the period is left as is
+//   - pauses started after the current period ended start a new period when the subscription
+//     resumes, and its advance charges are invoiced
+//   - pauses started during the current period extend it by the length of the pause
+func (s *subscriptionService) resumeFromPause(
+	ctx context.Context,
+	sub *subscription.Subscription,
+	pause *subscription.SubscriptionPause,
+	resumedAt time.Time,
+	resumeMode types.ResumeMode,
+) error {
+	pause.PauseStatus = types.PauseStatusCompleted
+	pause.ResumeMode = resumeMode
+	pause.ResumedAt = &resumedAt
+	pause.UpdatedBy = types.GetUserID(ctx)
+
+	sub.PauseStatus = types.PauseStatusNone
+	sub.ActivePauseID = nil
+	if sub.SubscriptionStatus == types.SubscriptionStatusPaused {
+		sub.SubscriptionStatus = types.SubscriptionStatusActive
+	}
+
+	startNewPeriod := false
+	switch {
+	case pause.BillsFixedFees() || !resumedAt.After(pause.PauseStart):
+		// Nothing was held back, either the pause kept billing or it never started
+	case !sub.CurrentPeriodEnd.After(pause.PauseStart):
+		if err := restartBillingPeriod(sub, resumedAt); err != nil {
+			return err
+		}
+		startNewPeriod = true
+	default:
+		sub.CurrentPeriodEnd = sub.CurrentPeriodEnd.Add(resumedAt.Sub(pause.PauseStart))
+	}
+
+	return s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.SubRepo.UpdatePause(ctx, pause); err != nil {
+			return err
+		}
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		if !startNewPeriod {
+			return nil
+		}
+
+		paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
+		_, updatedSub, err := NewInvoiceService(s.ServiceParams).CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
+			SubscriptionID: sub.ID,
+			PeriodStart:    sub.CurrentPeriodStart,
+			PeriodEnd:      sub.CurrentPeriodEnd,
+			ReferencePoint: types.ReferencePointPeriodStart,
+		}, paymentParams, types.InvoiceFlowRenewal, false)
+		if err != nil {
+			return err
+		}
+		if updatedSub != nil {
+			*sub = *updatedSub
+		}
+		return nil
+	})
+}
+
+// restartBillingPeriod starts a new billing period of the subscription at start. Anniversary billed
+// subscriptions are anchored to start, calendar billed ones to the calendar period containing it.
+func restartBillingPeriod(sub *subscription.Subscription, start time.Time) error {
+	billingAnchor := start
+	if sub.BillingCycle == types.BillingCycleCalendar {
+		billingAnchor = types.CalculateCalendarBillingAnchor(start, sub.BillingPeriod)
+	}
+	periodEnd, err := types.NextBillingDate(start, billingAnchor, sub.BillingPeriodCount, sub.BillingPeriod, sub.EndDate)
+	if err != nil {
+		return err
+	}
+
+	sub.BillingAnchor = billingAnchor
+	sub.CurrentPeriodStart = start
+	sub.CurrentPeriodEnd = periodEnd
+	return nil
+}
+
+// defaultPauseBillingMode returns the billing mode of a pause request, all billing stops by default
+func defaultPauseBillingMode(req *dto.PauseSubscriptionRequest) types.PauseBillingMode {
+	return lo.Ternary(req.BillingMode == "", types.PauseBillingModeStopAll, req.BillingMode)
+}
@@ -61,6 +61,21 @@ func (s *SubscriptionPauseTestSuite) SetupTest() {
 		PaymentRepo:      s.GetStores().PaymentRepo,
 		EventPublisher:   s.GetPublisher(),
 		WebhookPublisher: s.GetWebhookPublisher(),
+
+		// Needed to invoice the period ended by a pause and the period started by a resume
+		TaxAssociationRepo:         s.GetStores().TaxAssociationRepo,
+		TaxRateRepo:                s.GetStores().TaxRateRepo,
+		SubscriptionLineItemRepo:   s.GetStores().SubscriptionLineItemRepo,
+		SubScheduleRepo:            s.GetStores().SubscriptionScheduleRepo,
+		PriceUnitRepo:              s.GetStores().PriceUnitRepo,
+		CreditGrantRepo:            s.GetStores().CreditGrantRepo,
+		CreditGrantApplicationRepo: s.GetStores().CreditGrantApplicationRepo,
+		CouponRepo:                 s.GetStores().CouponRepo,
+		CouponAssociationRepo:      s.GetStores().CouponAssociationRepo,
+		CouponApplicationRepo:      s.GetStores().CouponApplicationRepo,
+		AddonAssociationRepo:       s.GetStores().AddonAssociationRepo,
+		SettingsRepo:               s.GetStores().SettingsRepo,
+		FeatureUsageRepo:           s.GetStores().FeatureUsageRepo,
 	})
 
 	s.setupPauseTestData()
@@ -655,3 +670,148 @@ func (s *SubscriptionPauseTestSuite) TestUpdateBillingPeriodsWithPausedSubscript
 	s.Equal(pausedSub.CurrentPeriodStart, updatedSub.CurrentPeriodStart)
 	s.Equal(pausedSub.CurrentPeriodEnd, updatedSub.CurrentPeriodEnd)
 }
+
+func (s *SubscriptionPauseTestSuite) TestPeriodEndPause() {
+	ctx := s.GetContext()
+	sub := s.pauseTestData.activeSubscription
+	periodEnd := sub.CurrentPeriodEnd
+
+	resp, err := s.service.PauseSubscription(ctx, sub.ID, &dto.PauseSubscriptionRequest{
+		PauseMode: types.PauseModePeriodEnd,
+		PauseDays: lo.ToPtr(10),
+	})
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusActive, resp.Subscription.SubscriptionStatus)
+	s.Equal(types.PauseStatusScheduled, resp.Subscription.PauseStatus)
+	s.Equal(types.PauseBillingModeStopAll, resp.Pause.BillingMode)
+	s.True(resp.Pause.PauseStart.Equal(periodEnd))
+
+	s.Run("not_due_before_period_end", func() {
+		result, err := s.service.ProcessSubscriptionPause(ctx, sub.ID, periodEnd.Add(-time.Hour))
+		s.NoError(err)
+		s.False(result.IsPaused)
+		s.False(result.SkipBilling)
+	})
+
+	s.Run("activates_at_period_end", func() {
+		result, err := s.service.ProcessSubscriptionPause(ctx, sub.ID, periodEnd.Add(time.Hour))
+		s.NoError(err)
+		s.True(result.IsPaused)
+		s.True(result.SkipBilling)
+
+		updated, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusPaused, updated.SubscriptionStatus)
+		s.Equal(types.PauseStatusActive, updated.PauseStatus)
+	})
+
+	s.Run("resumes_with_new_period_at_pause_end", func() {
+		pauseEnd := periodEnd.AddDate(0, 0, 10)
+		result, err := s.service.ProcessSubscriptionPause(ctx, sub.ID, pauseEnd.Add(time.Hour))
+		s.NoError(err)
+		s.True(result.WasResumed)
+		s.False(result.SkipBilling)
+
+		updated, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusActive, updated.SubscriptionStatus)
+		s.Equal(types.PauseStatusNone, updated.PauseStatus)
+		s.True(updated.CurrentPeriodStart.Equal(pauseEnd))
+		s.Equal(pauseEnd.AddDate(0, 1, 0).Unix(), updated.CurrentPeriodEnd.Unix())
+	})
+}
+
+func (s *SubscriptionPauseTestSuite) TestFixedFeesOnlyPause() {
+	ctx := s.GetContext()
+	sub := s.pauseTestData.activeSubscription
+	periodStart, periodEnd := sub.CurrentPeriodStart, sub.CurrentPeriodEnd
+
+	resp, err := s.service.PauseSubscription(ctx, sub.ID, &dto.PauseSubscriptionRequest{
+		PauseMode:   types.PauseModeImmediate,
+		BillingMode: types.PauseBillingModeFixedFeesOnly,
+		PauseDays:   lo.ToPtr(5),
+	})
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusPaused, resp.Subscription.SubscriptionStatus)
+
+	// Fixed fees keep being billed during the pause
+	result, err := s.service.ProcessSubscriptionPause(ctx, sub.ID, time.Now().UTC())
+	s.NoError(err)
+	s.True(result.IsPaused)
+	s.False(result.SkipBilling)
+
+	// The billing period is not moved when the subscription resumes
+	resumed, err := s.service.ResumeSubscription(ctx, sub.ID, &dto.ResumeSubscriptionRequest{
+		ResumeMode: types.ResumeModeImmediate,
+	})
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusActive, resumed.Subscription.SubscriptionStatus)
+	s.True(resumed.Subscription.CurrentPeriodStart.Equal(periodStart))
+	s.True(resumed.Subscription.CurrentPeriodEnd.Equal(periodEnd))
+}
+
+func TestBillableUsageWindows(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return start.AddDate(0, 0, d-1) }
+
+	tests := []struct {
+		name   string
+		pauses []*subscription.SubscriptionPause
+		want   []dto.Period
+	}{
+		{
+			name: "no_pauses",
+			want: []dto.Period{{Start: start, End: end}},
+		},
+		{
+			name: "pause_in_the_middle_of_the_period",
+			pauses: []*subscription.SubscriptionPause{
+				{PauseStart: day(10), ResumedAt: lo.ToPtr(day(15))},
+			},
+			want: []dto.Period{{Start: start, End: day(10)}, {Start: day(15), End: end}},
+		},
+		{
+			name: "open_ended_pause",
+			pauses: []*subscription.SubscriptionPause{
+				{PauseStart: day(20)},
+			},
+			want: []dto.Period{{Start: start, End: day(20)}},
+		},
+		{
+			name: "pause_started_before_the_period",
+			pauses: []*subscription.SubscriptionPause{
+				{PauseStart: start.AddDate(0, 0, -5), PauseEnd: lo.ToPtr(day(3))},
+			},
+			want: []dto.Period{{Start: day(3), End: end}},
+		},
+		{
+			name: "pause_resumed_before_it_started",
+			pauses: []*subscription.SubscriptionPause{
+				{PauseStart: day(10), PauseEnd: lo.ToPtr(day(20)), ResumedAt: lo.ToPtr(day(5))},
+			},
+			want: []dto.Period{{Start: start, End: end}},
+		},
+		{
+			name: "period_fully_paused",
+			pauses: []*subscription.SubscriptionPause{
+				{PauseStart: start.AddDate(0, 0, -1), PauseEnd: lo.ToPtr(end)},
+			},
+			want: []dto.Period{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := billableUsageWindows(start, end, tt.pauses)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d windows, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Errorf("window %d = %v - %v, want %v - %v", i, got[i].Start, got[i].End, tt.want[i].Start, tt.want[i].End)
+				}
+			}
+		})
+	}
+}
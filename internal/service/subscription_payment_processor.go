@@ -85,7 +85,11 @@ func (s *subscriptionPaymentProcessor) HandlePaymentBehavior(
 				"amount_paid", result.AmountPaid,
 			)
 			sub.SubscriptionStatus = types.SubscriptionStatusActive
-			return s.SubRepo.Update(ctx, sub)
+			if err := s.SubRepo.Update(ctx, sub); err != nil {
+				return err
+			}
+			recordExperimentConversion(ctx, *s.ServiceParams, sub)
+			return nil
 		}
 
 		// If payment failed or partial, keep subscription status unchanged
@@ -313,6 +317,16 @@ func (s *subscriptionPaymentProcessor) attemptPaymentDefaultActive(
 		latestSub = sub
 	}
 
+	// Subscriptions in dunning stay past due or unpaid until a payment succeeds
+	if !result.Success && latestSub.IsDelinquent() {
+		s.Logger.Infow("default_active payment failed for delinquent subscription, keeping status",
+			"subscription_id", sub.ID,
+			"status", latestSub.SubscriptionStatus,
+		)
+		sub.SubscriptionStatus = latestSub.SubscriptionStatus
+		return nil
+	}
+
 	// For default_active behavior, always set to active regardless of payment result
 	targetStatus := types.SubscriptionStatusActive
 
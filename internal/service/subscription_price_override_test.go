@@ -126,6 +126,53 @@ func TestCreateSubscriptionWithPriceOverrides(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "duplicate price_id in override line items")
 	})
+
+	t.Run("should validate inherited tiers when switching to stair step", func(t *testing.T) {
+		graduated := &price.Price{
+			ID:            "tiered_price",
+			Type:          types.PRICE_TYPE_USAGE,
+			BillingModel:  types.BILLING_MODEL_TIERED,
+			TierMode:      types.BILLING_TIER_SLAB,
+			PriceUnitType: types.PRICE_UNIT_TYPE_FIAT,
+			Tiers: price.JSONBTiers{
+				{UpTo: lo.ToPtr(uint64(100)), UnitAmount: decimal.NewFromInt(1)},
+				{UnitAmount: decimal.RequireFromString("0.5")},
+			},
+		}
+		priceMap := map[string]*dto.PriceResponse{"tiered_price": {Price: graduated}}
+
+		// Per-unit tiers have no flat amount to charge for a bracket
+		override := dto.OverrideLineItemRequest{
+			PriceID:  "tiered_price",
+			TierMode: types.BILLING_TIER_STAIR_STEP,
+		}
+		err := override.Validate(priceMap, nil, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "flat_amount is required for stair step tiers")
+
+		// Brackets provided with the override replace the inherited tiers
+		override.Tiers = []dto.CreatePriceTier{
+			{UpTo: lo.ToPtr(uint64(100)), FlatAmount: lo.ToPtr(decimal.NewFromInt(50))},
+			{FlatAmount: lo.ToPtr(decimal.NewFromInt(90))},
+		}
+		require.NoError(t, override.Validate(priceMap, nil, ""))
+
+		// Prices without tiers need the brackets in the override
+		flat := &price.Price{
+			ID:            "flat_price",
+			Type:          types.PRICE_TYPE_USAGE,
+			BillingModel:  types.BILLING_MODEL_FLAT_FEE,
+			PriceUnitType: types.PRICE_UNIT_TYPE_FIAT,
+			Amount:        decimal.NewFromInt(1),
+		}
+		override = dto.OverrideLineItemRequest{
+			PriceID:  "flat_price",
+			TierMode: types.BILLING_TIER_STAIR_STEP,
+		}
+		err = override.Validate(map[string]*dto.PriceResponse{"flat_price": {Price: flat}}, nil, "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "tiers are required when tier mode is STAIR_STEP")
+	})
 }
 
 func TestPriceScopeFiltering(t *testing.T) {
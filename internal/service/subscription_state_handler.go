@@ -56,6 +56,14 @@ func (h *SubscriptionStateHandler) DetermineCreditGrantAction() (StateAction, er
 		// Paused subscriptions should skip credits until resumed
 		return StateActionSkip, nil
 
+	case types.SubscriptionStatusPastDue:
+		// Past due subscriptions stay live while their dunning retries the payment
+		return StateActionApply, nil
+
+	case types.SubscriptionStatusUnpaid:
+		// Defer unpaid subscriptions as they become active again once the invoice is paid
+		return StateActionDefer, nil
+
 	default:
 		// Unknown status - skip for safety
 		return StateActionSkip, ierr.NewError("unknown subscription status").
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// validateRenewalPlan checks that a subscription can renew to the plan
+func (s *subscriptionService) validateRenewalPlan(ctx context.Context, planID string) error {
+	plan, err := s.PlanRepo.Get(ctx, planID)
+	if err != nil {
+		return err
+	}
+	if plan.Status != types.StatusPublished || !plan.IsSubscribable() {
+		return ierr.NewError("renewal plan is not active").
+			WithHint("Subscriptions can only renew to the published version of an active plan").
+			WithReportableDetails(map[string]interface{}{
+				"renewal_plan_id": planID,
+				"status":          plan.Status,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ProcessTermRenewal handles the end of the contract term of a subscription after its billing
+// period was moved forward. Auto-renewing subscriptions whose current period starts at or after
+// the end of the term start a new term, on the renewal plan when one is set. Subscriptions that do
+// not auto-renew are scheduled to be cancelled at the end of the billing period in which the term
+// ends.
+func (s *subscriptionService) ProcessTermRenewal(ctx context.Context, subscriptionID string) (*dto.SubscriptionTermRenewalResult, error) {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.SubscriptionTermRenewalResult{SubscriptionID: sub.ID}
+	if !sub.HasTerm() || sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		return result, nil
+	}
+
+	if !sub.AutoRenew {
+		if !sub.IsInFinalTermPeriod() || sub.CancelAtPeriodEnd {
+			return result, nil
+		}
+		if err := s.scheduleNonRenewal(ctx, sub); err != nil {
+			return nil, err
+		}
+		result.NonRenewalScheduled = true
+		return result, nil
+	}
+
+	if !sub.IsTermEnded() {
+		return result, nil
+	}
+
+	previousTermEnd := *sub.CurrentTermEnd
+	for !sub.CurrentTermEnd.After(sub.CurrentPeriodStart) {
+		sub.StartTerm(*sub.CurrentTermEnd)
+	}
+
+	renewalPlanID := lo.FromPtr(sub.RenewalPlanID)
+	sub.RenewalPlanID = nil
+
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		if renewalPlanID == "" || renewalPlanID == sub.PlanID {
+			return nil
+		}
+
+		// The new subscription starts with the new term. The advance charges of its first period
+		// were left out of the last invoice of the replaced subscription, so the change is not
+		// prorated and the new subscription bills them.
+		response, err := NewSubscriptionChangeService(s.ServiceParams).ExecuteSubscriptionChangeInternal(ctx, sub.ID, dto.SubscriptionChangeRequest{
+			TargetPlanID:       renewalPlanID,
+			ProrationBehavior:  types.ProrationBehaviorNone,
+			BillingCadence:     sub.BillingCadence,
+			BillingPeriod:      sub.BillingPeriod,
+			BillingPeriodCount: sub.BillingPeriodCount,
+			BillingCycle:       sub.BillingCycle,
+			Metadata:           sub.Metadata,
+			EffectiveDate:      lo.ToPtr(previousTermEnd),
+		})
+		if err != nil {
+			return err
+		}
+		result.SubscriptionID = response.NewSubscription.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("renewed subscription term",
+		"subscription_id", sub.ID,
+		"renewed_subscription_id", result.SubscriptionID,
+		"renewal_plan_id", renewalPlanID,
+		"previous_term_end", previousTermEnd,
+		"current_term_end", *sub.CurrentTermEnd)
+
+	result.Renewed = true
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionRenewed, result.SubscriptionID)
+	return result, nil
+}
+
+// scheduleNonRenewal cancels a subscription that does not auto-renew at the end of the billing
+// period in which its term ends
+func (s *subscriptionService) scheduleNonRenewal(ctx context.Context, sub *subscription.Subscription) error {
+	sub.CancelAtPeriodEnd = true
+	sub.CancelAt = lo.ToPtr(sub.CurrentPeriodEnd)
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return err
+	}
+
+	s.Logger.Infow("scheduled subscription non-renewal",
+		"subscription_id", sub.ID,
+		"current_term_end", *sub.CurrentTermEnd,
+		"cancel_at", *sub.CancelAt)
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionNonRenewalScheduled, sub.ID)
+	return nil
+}
+
+// UpdateSubscriptionRenewal updates the renewal of a fixed-term subscription. Turning the renewal
+// off in the last billing period of the term schedules the cancellation right away, the billing
+// workflow schedules it when the last period starts otherwise.
+func (s *subscriptionService) UpdateSubscriptionRenewal(ctx context.Context, subscriptionID string, req dto.UpdateSubscriptionRenewalRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sub.HasTerm() {
+		return nil, ierr.NewError("subscription does not have a term").
+			WithHint("Renewal settings can only be updated on fixed-term subscriptions").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if sub.SubscriptionStatus == types.SubscriptionStatusCancelled {
+		return nil, ierr.NewError("subscription is cancelled").
+			WithHint("The renewal of a cancelled subscription cannot be updated").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if req.RenewalNoticeDays != nil {
+		sub.RenewalNoticeDays = *req.RenewalNoticeDays
+	}
+
+	if req.RenewalPlanID != nil {
+		if *req.RenewalPlanID == "" || *req.RenewalPlanID == sub.PlanID {
+			sub.RenewalPlanID = nil
+		} else {
+			if err := s.validateRenewalPlan(ctx, *req.RenewalPlanID); err != nil {
+				return nil, err
+			}
+			sub.RenewalPlanID = req.RenewalPlanID
+		}
+	}
+
+	scheduleNonRenewal := false
+	if req.AutoRenew != nil && *req.AutoRenew != sub.AutoRenew {
+		if *req.AutoRenew && sub.CancelAtPeriodEnd {
+			return nil, ierr.NewError("subscription is scheduled for cancellation").
+				WithHint("The renewal of a subscription scheduled for cancellation cannot be turned on").
+				WithReportableDetails(map[string]interface{}{
+					"subscription_id": subscriptionID,
+					"cancel_at":       sub.CancelAt,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+
+		if !*req.AutoRenew {
+			deadline := *sub.NonRenewalDeadline()
+			if time.Now().UTC().After(deadline) {
+				return nil, ierr.NewError("non-renewal notice deadline has passed").
+					WithHint("The renewal can only be turned off before the notice period of the term starts").
+					WithReportableDetails(map[string]interface{}{
+						"subscription_id":      subscriptionID,
+						"current_term_end":     *sub.CurrentTermEnd,
+						"non_renewal_deadline": deadline,
+					}).
+					Mark(ierr.ErrValidation)
+			}
+			scheduleNonRenewal = sub.IsInFinalTermPeriod() && !sub.CancelAtPeriodEnd
+		}
+		sub.AutoRenew = *req.AutoRenew
+	}
+
+	if scheduleNonRenewal {
+		if err := s.scheduleNonRenewal(ctx, sub); err != nil {
+			return nil, err
+		}
+	} else if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("updated subscription renewal",
+		"subscription_id", sub.ID,
+		"auto_renew", sub.AutoRenew,
+		"renewal_plan_id", sub.RenewalPlanID,
+		"renewal_notice_days", sub.RenewalNoticeDays)
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+
+	return s.GetSubscription(ctx, subscriptionID)
+}
+
+// ListUpcomingRenewals lists the fixed-term subscriptions whose current term ends within the
+// requested number of days
+func (s *subscriptionService) ListUpcomingRenewals(ctx context.Context, req dto.ListUpcomingRenewalsRequest) (*dto.ListUpcomingRenewalsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	until := time.Now().UTC().AddDate(0, 0, req.Days)
+	filter := &types.SubscriptionFilter{
+		QueryFilter: req.QueryFilter,
+		SubscriptionStatus: []types.SubscriptionStatus{
+			types.SubscriptionStatusActive,
+			types.SubscriptionStatusTrialing,
+			types.SubscriptionStatusPastDue,
+		},
+		CurrentTermEndBefore: &until,
+	}
+	filter.QueryFilter.Status = lo.ToPtr(types.StatusPublished)
+	filter.QueryFilter.Sort = lo.ToPtr("current_term_end")
+	filter.QueryFilter.Order = lo.ToPtr("asc")
+
+	subs, err := s.SubRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.SubRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListUpcomingRenewalsResponse{
+		Items: lo.Map(subs, func(sub *subscription.Subscription, _ int) *dto.UpcomingRenewalResponse {
+			return dto.NewUpcomingRenewalResponse(sub)
+		}),
+		Pagination: types.NewPaginationResponse(count, filter.GetLimit(), filter.GetOffset()),
+		Until:      until,
+	}, nil
+}
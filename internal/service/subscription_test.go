@@ -9,18 +9,23 @@ import (
 	"github.com/flexprice/flexprice/internal/api/dto"
 	"github.com/flexprice/flexprice/internal/domain/addon"
 	"github.com/flexprice/flexprice/internal/domain/customer"
+	"github.com/flexprice/flexprice/internal/domain/entitlement"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/settings"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
+	"github.com/flexprice/flexprice/internal/domain/task"
+	"github.com/flexprice/flexprice/internal/domain/wallet"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/testutil"
 	"github.com/flexprice/flexprice/internal/types"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -236,6 +241,111 @@ func (s *SubscriptionServiceSuite) TestAddAddonToSubscriptionLineItemCommitments
 	})
 }
 
+func (s *SubscriptionServiceSuite) TestAddAddonToSubscriptionWithQuantityPeriodAndTrial() {
+	ctx := s.GetContext()
+	subService := s.service.(*subscriptionService)
+
+	createAddonWithFixedPrice := func(addonID, priceID string, period types.BillingPeriod) {
+		s.NoError(subService.AddonRepo.Create(ctx, &addon.Addon{
+			ID:        addonID,
+			LookupKey: addonID,
+			Name:      "Seat Addon",
+			Type:      types.AddonTypeOnetime,
+			BaseModel: types.GetDefaultBaseModel(ctx),
+		}))
+		s.NoError(s.GetStores().PriceRepo.Create(ctx, &price.Price{
+			ID:                 priceID,
+			Amount:             decimal.NewFromInt(10),
+			Currency:           "usd",
+			EntityType:         types.PRICE_ENTITY_TYPE_ADDON,
+			EntityID:           addonID,
+			Type:               types.PRICE_TYPE_FIXED,
+			BillingPeriod:      period,
+			BillingPeriodCount: 1,
+			BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			InvoiceCadence:     types.InvoiceCadenceAdvance,
+			BaseModel:          types.GetDefaultBaseModel(ctx),
+		}))
+	}
+
+	addonLineItem := func(addonID string) *subscription.SubscriptionLineItem {
+		filter := types.NewNoLimitSubscriptionLineItemFilter()
+		filter.SubscriptionIDs = []string{s.testData.subscription.ID}
+		items, err := s.GetStores().SubscriptionLineItemRepo.List(ctx, filter)
+		s.NoError(err)
+		for _, it := range items {
+			if it.EntityType == types.SubscriptionLineItemEntityTypeAddon && it.EntityID == addonID {
+				return it
+			}
+		}
+		s.FailNow("addon line item not found", addonID)
+		return nil
+	}
+
+	s.Run("per_seat_addon_with_trial", func() {
+		createAddonWithFixedPrice("addon_seats_trial", "price_addon_seats_trial", types.BILLING_PERIOD_MONTHLY)
+
+		now := time.Now().UTC()
+		association, err := s.service.AddAddonToSubscription(ctx, s.testData.subscription.ID, &dto.AddAddonToSubscriptionRequest{
+			AddonID:         "addon_seats_trial",
+			StartDate:       &now,
+			Quantity:        lo.ToPtr(decimal.NewFromInt(3)),
+			TrialPeriodDays: 14,
+		})
+		s.NoError(err)
+		s.True(decimal.NewFromInt(3).Equal(association.Quantity))
+		s.NotNil(association.TrialEnd)
+
+		item := addonLineItem("addon_seats_trial")
+		s.True(decimal.NewFromInt(3).Equal(item.Quantity))
+		s.True(item.StartDate.Equal(*association.TrialEnd), "line item is billed from the end of the trial")
+		s.Equal("3", item.Metadata["addon_quantity"])
+
+		// Removing the addon during the trial ends it before it is billed
+		s.NoError(s.service.RemoveAddonFromSubscription(ctx, &dto.RemoveAddonRequest{
+			AddonAssociationID: association.ID,
+		}))
+		item = addonLineItem("addon_seats_trial")
+		s.True(item.EndDate.Equal(item.StartDate))
+
+		err = s.service.RemoveAddonFromSubscription(ctx, &dto.RemoveAddonRequest{
+			AddonAssociationID: association.ID,
+		})
+		s.Error(err)
+	})
+
+	s.Run("annual_addon_on_monthly_subscription", func() {
+		createAddonWithFixedPrice("addon_annual", "price_addon_annual", types.BILLING_PERIOD_ANNUAL)
+
+		now := time.Now().UTC()
+		association, err := s.service.AddAddonToSubscription(ctx, s.testData.subscription.ID, &dto.AddAddonToSubscriptionRequest{
+			AddonID:       "addon_annual",
+			StartDate:     &now,
+			BillingPeriod: types.BILLING_PERIOD_ANNUAL,
+		})
+		s.NoError(err)
+		s.Equal(types.BILLING_PERIOD_ANNUAL, association.BillingPeriod)
+
+		item := addonLineItem("addon_annual")
+		s.Equal("price_addon_annual", item.PriceID)
+		s.Equal(types.BILLING_PERIOD_ANNUAL, item.BillingPeriod)
+	})
+
+	s.Run("rejects_addon_period_shorter_than_subscription", func() {
+		createAddonWithFixedPrice("addon_daily", "price_addon_daily", types.BILLING_PERIOD_DAILY)
+
+		now := time.Now().UTC()
+		_, err := s.service.AddAddonToSubscription(ctx, s.testData.subscription.ID, &dto.AddAddonToSubscriptionRequest{
+			AddonID:       "addon_daily",
+			StartDate:     &now,
+			BillingPeriod: types.BILLING_PERIOD_DAILY,
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+}
+
 func (s *SubscriptionServiceSuite) SetupTest() {
 	s.BaseServiceTestSuite.SetupTest()
 	s.ClearStores() // Clear all stores before each test for isolation
@@ -285,6 +395,8 @@ func (s *SubscriptionServiceSuite) setupService() {
 		AddonAssociationRepo:       s.GetStores().AddonAssociationRepo,
 		ConnectionRepo:             s.GetStores().ConnectionRepo,
 		SettingsRepo:               s.GetStores().SettingsRepo,
+		TaskRepo:                   s.GetStores().TaskRepo,
+		MigrationResultRepo:        s.GetStores().MigrationResultRepo,
 		EventPublisher:             s.GetPublisher(),
 		WebhookPublisher:           s.GetWebhookPublisher(),
 		ProrationCalculator:        s.GetCalculator(),
@@ -5238,3 +5350,1249 @@ func (s *SubscriptionServiceSuite) TestUpdateBillingPeriodsWithInvoicingCustomer
 	s.True(updatedSub.CurrentPeriodStart.After(periodStart), "Period start should be updated")
 	s.True(updatedSub.CurrentPeriodEnd.After(periodEnd), "Period end should be updated")
 }
+
+func (s *SubscriptionServiceSuite) TestSubscriptionTrialLifecycle() {
+	trialEnd := s.testData.now.Add(14 * 24 * time.Hour)
+	req := dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		StartDate:          lo.ToPtr(s.testData.now),
+		TrialStart:         lo.ToPtr(s.testData.now),
+		TrialEnd:           lo.ToPtr(trialEnd),
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingCycle:       types.BillingCycleAnniversary,
+		TrialReminderDays:  3,
+	}
+
+	resp, err := s.service.CreateSubscription(s.GetContext(), req)
+	s.NoError(err)
+	s.Equal(types.SubscriptionStatusTrialing, resp.SubscriptionStatus)
+	s.Equal(types.TrialEndActionConvert, resp.TrialEndAction)
+
+	s.Run("trial_state_has_reminder", func() {
+		state, err := s.service.GetTrialState(s.GetContext(), resp.ID)
+		s.NoError(err)
+		s.True(state.IsTrialing)
+		s.NotNil(state.ReminderAt)
+		s.Equal(trialEnd.AddDate(0, 0, -3).Unix(), state.ReminderAt.Unix())
+		s.False(state.UsageCapReached)
+	})
+
+	s.Run("trial_cannot_be_shortened", func() {
+		_, err := s.service.ExtendTrial(s.GetContext(), resp.ID, dto.ExtendTrialRequest{
+			TrialEnd: lo.ToPtr(trialEnd.Add(-24 * time.Hour)),
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("extend_trial_by_days", func() {
+		extended, err := s.service.ExtendTrial(s.GetContext(), resp.ID, dto.ExtendTrialRequest{Days: 7})
+		s.NoError(err)
+		s.Equal(trialEnd.AddDate(0, 0, 7).Unix(), extended.TrialEnd.Unix())
+		s.Equal(types.SubscriptionStatusTrialing, extended.SubscriptionStatus)
+	})
+
+	s.Run("end_trial_before_trial_end_is_rejected", func() {
+		_, err := s.service.EndTrial(s.GetContext(), resp.ID)
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("billing_cron_skips_trials_within_grace_period", func() {
+		sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+		s.NoError(err)
+		original := *sub.TrialEnd
+		sub.TrialEnd = lo.ToPtr(s.testData.now.Add(-trialEndGracePeriod / 2))
+		s.NoError(s.GetStores().SubscriptionRepo.Update(s.GetContext(), sub))
+		defer func() {
+			sub.TrialEnd = &original
+			s.NoError(s.GetStores().SubscriptionRepo.Update(s.GetContext(), sub))
+		}()
+
+		// The trial workflow ends trials at their trial end, the cron only picks up missed ones
+		s.service.(*subscriptionService).endExpiredTrials(s.GetContext(), s.testData.now)
+		current, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusTrialing, current.SubscriptionStatus)
+	})
+
+	s.Run("billing_cron_ends_trials_missed_by_workflow", func() {
+		sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+		s.NoError(err)
+		original := *sub.TrialEnd
+		sub.TrialEnd = lo.ToPtr(s.testData.now.Add(-2 * trialEndGracePeriod))
+		s.NoError(s.GetStores().SubscriptionRepo.Update(s.GetContext(), sub))
+
+		s.service.(*subscriptionService).endExpiredTrials(s.GetContext(), s.testData.now)
+		current, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusActive, current.SubscriptionStatus)
+
+		// Put the subscription back in its trial for the next case
+		current.SubscriptionStatus = types.SubscriptionStatusTrialing
+		current.TrialEnd = &original
+		s.NoError(s.GetStores().SubscriptionRepo.Update(s.GetContext(), current))
+	})
+
+	s.Run("end_trial_converts_subscription", func() {
+		sub, err := s.GetStores().SubscriptionRepo.Get(s.GetContext(), resp.ID)
+		s.NoError(err)
+		endedAt := s.testData.now.Add(-time.Hour).Truncate(time.Second)
+		sub.TrialEnd = lo.ToPtr(endedAt)
+		s.NoError(s.GetStores().SubscriptionRepo.Update(s.GetContext(), sub))
+
+		converted, err := s.service.EndTrial(s.GetContext(), resp.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusActive, converted.SubscriptionStatus)
+		s.Equal(endedAt.Unix(), converted.CurrentPeriodStart.Unix())
+		s.True(converted.CurrentPeriodEnd.After(converted.CurrentPeriodStart))
+
+		// Ending the trial again is a no-op
+		again, err := s.service.EndTrial(s.GetContext(), resp.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusActive, again.SubscriptionStatus)
+	})
+}
+
+func TestApplySubscriptionTrial(t *testing.T) {
+	now := time.Now().UTC()
+	sub := &subscription.Subscription{
+		StartDate:          now,
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		LineItems: []*subscription.SubscriptionLineItem{
+			{TrialPeriod: 7},
+			{TrialPeriod: 14},
+		},
+	}
+
+	applySubscriptionTrial(sub, now)
+	assert.Equal(t, types.SubscriptionStatusTrialing, sub.SubscriptionStatus)
+	assert.Equal(t, now.AddDate(0, 0, 14), *sub.TrialEnd)
+	assert.True(t, sub.IsTrialing())
+
+	caps := map[string]decimal.Decimal{"meter_b": decimal.NewFromInt(10), "meter_a": decimal.NewFromInt(100)}
+	sub.TrialUsageCaps = caps
+	_, exceeded := sub.ExceededTrialUsageCap(map[string]decimal.Decimal{"meter_a": decimal.NewFromInt(99)})
+	assert.False(t, exceeded)
+	meterID, exceeded := sub.ExceededTrialUsageCap(map[string]decimal.Decimal{"meter_b": decimal.NewFromInt(10)})
+	assert.True(t, exceeded)
+	assert.Equal(t, "meter_b", meterID)
+
+	ended := &subscription.Subscription{
+		StartDate:          now.AddDate(0, 0, -30),
+		SubscriptionStatus: types.SubscriptionStatusActive,
+		LineItems:          []*subscription.SubscriptionLineItem{{TrialPeriod: 7}},
+	}
+	applySubscriptionTrial(ended, now)
+	assert.Equal(t, types.SubscriptionStatusActive, ended.SubscriptionStatus)
+}
+
+func (s *SubscriptionServiceSuite) TestConsolidatedInvoicing() {
+	ctx := s.GetContext()
+	periodEnd := s.testData.now.Add(-time.Hour)
+	periodStart := periodEnd.AddDate(0, -1, 0)
+
+	fixedArrear := &price.Price{
+		ID:                 "price_fixed_consolidated",
+		Amount:             decimal.NewFromFloat(10.00),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, fixedArrear))
+
+	createSub := func(id string) *subscription.Subscription {
+		sub := &subscription.Subscription{
+			ID:                 id,
+			PlanID:             s.testData.plan.ID,
+			CustomerID:         s.testData.customer.ID,
+			StartDate:          periodStart,
+			BillingAnchor:      periodStart,
+			CurrentPeriodStart: periodStart,
+			CurrentPeriodEnd:   periodEnd,
+			Currency:           "usd",
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			SubscriptionStatus: types.SubscriptionStatusActive,
+			BaseModel:          types.GetDefaultBaseModel(ctx),
+		}
+		lineItems := []*subscription.SubscriptionLineItem{
+			{
+				ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+				SubscriptionID:  sub.ID,
+				CustomerID:      sub.CustomerID,
+				EntityID:        s.testData.plan.ID,
+				EntityType:      types.SubscriptionLineItemEntityTypePlan,
+				PlanDisplayName: s.testData.plan.Name,
+				PriceID:         fixedArrear.ID,
+				PriceType:       fixedArrear.Type,
+				DisplayName:     "Platform fee",
+				Quantity:        decimal.NewFromInt(1),
+				Currency:        sub.Currency,
+				BillingPeriod:   sub.BillingPeriod,
+				InvoiceCadence:  types.InvoiceCadenceArrear,
+				StartDate:       periodStart,
+				BaseModel:       types.GetDefaultBaseModel(ctx),
+			},
+		}
+		s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, sub, lineItems))
+		return sub
+	}
+
+	first := createSub("sub_consolidated_a")
+	second := createSub("sub_consolidated_b")
+	period := dto.Period{Start: periodStart, End: periodEnd}
+
+	s.Run("invoiced_on_their_own_without_opt_in", func() {
+		group, err := s.service.(*subscriptionService).consolidatedInvoiceGroup(ctx, first, periodEnd)
+		s.NoError(err)
+		s.Nil(group)
+	})
+
+	cust, err := s.GetStores().CustomerRepo.Get(ctx, s.testData.customer.ID)
+	s.NoError(err)
+	cust.ConsolidateInvoices = lo.ToPtr(true)
+	s.NoError(s.GetStores().CustomerRepo.Update(ctx, cust))
+
+	s.Run("any_subscription_of_the_group_invoices_the_group", func() {
+		inv, err := s.service.CreateDraftInvoiceForSubscription(ctx, second.ID, period)
+		s.NoError(err)
+		s.Require().NotNil(inv)
+		s.Nil(inv.SubscriptionID, "consolidated invoices belong to no single subscription")
+		s.True(decimal.NewFromInt(20).Equal(inv.Subtotal), "subtotal %s", inv.Subtotal)
+		s.Equal(first.ID+","+second.ID, inv.Metadata[metadataKeyConsolidatedSubscriptionIDs])
+		s.Equal([]string{first.ID, second.ID}, inv.GetSubscriptionIDs())
+
+		s.Require().Len(inv.LineItems, 2)
+		s.Equal(first.ID, lo.FromPtr(inv.LineItems[0].SubscriptionID))
+		s.Equal(second.ID, lo.FromPtr(inv.LineItems[1].SubscriptionID))
+	})
+
+	s.Run("group_is_invoiced_once", func() {
+		inv, err := s.service.CreateDraftInvoiceForSubscription(ctx, first.ID, period)
+		s.NoError(err)
+		s.Nil(inv)
+	})
+
+	s.Run("consolidated_line_items_are_not_invoiced_again", func() {
+		sub, _, err := s.GetStores().SubscriptionRepo.GetWithLineItems(ctx, second.ID)
+		s.NoError(err)
+		lineItems, err := NewBillingService(s.service.(*subscriptionService).ServiceParams).
+			FilterLineItemsToBeInvoiced(ctx, sub, periodStart, periodEnd, sub.LineItems)
+		s.NoError(err)
+		s.Empty(lineItems)
+	})
+
+	s.Run("different_payment_settings_are_invoiced_on_their_own", func() {
+		third := createSub("sub_consolidated_c")
+		third.CollectionMethod = string(types.CollectionMethodSendInvoice)
+		s.NoError(s.GetStores().SubscriptionRepo.Update(ctx, third))
+
+		group, err := s.service.(*subscriptionService).consolidatedInvoiceGroup(ctx, third, periodEnd)
+		s.NoError(err)
+		s.Nil(group)
+
+		group, err = s.service.(*subscriptionService).consolidatedInvoiceGroup(ctx, first, periodEnd)
+		s.NoError(err)
+		s.Equal([]string{first.ID, second.ID}, lo.Map(group, func(sub *subscription.Subscription, _ int) string { return sub.ID }))
+	})
+}
+
+func (s *SubscriptionServiceSuite) TestBackdatedSubscriptionInvoicing() {
+	ctx := s.GetContext()
+	start := s.testData.now.AddDate(0, -3, 0).Add(-time.Hour)
+
+	fixedArrear := &price.Price{
+		ID:                 "price_fixed_backdated",
+		Amount:             decimal.NewFromFloat(10.00),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           s.testData.plan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceArrear,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, fixedArrear))
+
+	createSub := func(id string, mode types.BackdatedBillingMode) *subscription.Subscription {
+		sub := &subscription.Subscription{
+			ID:                   id,
+			PlanID:               s.testData.plan.ID,
+			CustomerID:           s.testData.customer.ID,
+			StartDate:            start,
+			BillingAnchor:        start,
+			CurrentPeriodStart:   start,
+			CurrentPeriodEnd:     start.AddDate(0, 1, 0),
+			Currency:             "usd",
+			BillingCadence:       types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:        types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount:   1,
+			SubscriptionStatus:   types.SubscriptionStatusActive,
+			BackdatedBillingMode: mode,
+			BaseModel:            types.GetDefaultBaseModel(ctx),
+		}
+		lineItems := []*subscription.SubscriptionLineItem{
+			{
+				ID:              types.GenerateUUIDWithPrefix(types.UUID_PREFIX_SUBSCRIPTION_LINE_ITEM),
+				SubscriptionID:  sub.ID,
+				CustomerID:      sub.CustomerID,
+				EntityID:        s.testData.plan.ID,
+				EntityType:      types.SubscriptionLineItemEntityTypePlan,
+				PlanDisplayName: s.testData.plan.Name,
+				PriceID:         fixedArrear.ID,
+				PriceType:       fixedArrear.Type,
+				DisplayName:     "Platform fee",
+				Quantity:        decimal.NewFromInt(1),
+				Currency:        sub.Currency,
+				BillingPeriod:   sub.BillingPeriod,
+				InvoiceCadence:  types.InvoiceCadenceArrear,
+				StartDate:       start,
+				BaseModel:       types.GetDefaultBaseModel(ctx),
+			},
+		}
+		s.NoError(s.GetStores().SubscriptionRepo.CreateWithLineItems(ctx, sub, lineItems))
+		return sub
+	}
+
+	endedPeriods := func(sub *subscription.Subscription) []dto.Period {
+		periods, err := s.service.CalculateBillingPeriods(ctx, sub.ID)
+		s.NoError(err)
+		s.Require().Len(periods, 4)
+		return periods[:len(periods)-1]
+	}
+
+	s.Run("backdated_billing_mode_requires_start_date", func() {
+		_, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+			CustomerID:           s.testData.customer.ID,
+			PlanID:               s.testData.plan.ID,
+			Currency:             "usd",
+			BillingCadence:       types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:        types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount:   1,
+			BackdatedBillingMode: types.BackdatedBillingModeCatchUp,
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("invalid_backdated_billing_mode", func() {
+		_, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+			CustomerID:           s.testData.customer.ID,
+			PlanID:               s.testData.plan.ID,
+			StartDate:            lo.ToPtr(start),
+			Currency:             "usd",
+			BillingCadence:       types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:        types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount:   1,
+			BackdatedBillingMode: types.BackdatedBillingMode("yearly"),
+		})
+		s.Error(err)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("per_period_invoices_each_missed_period", func() {
+		sub := createSub("sub_backdated_per_period", types.BackdatedBillingModePerPeriod)
+
+		preview, err := s.service.PreviewBackdatedInvoices(ctx, sub.ID)
+		s.NoError(err)
+		s.Equal(types.BackdatedBillingModePerPeriod, preview.BackdatedBillingMode)
+		s.Require().Len(preview.Invoices, 3)
+		s.False(preview.Invoices[0].IsCatchUp)
+		s.Equal(start.Unix(), preview.Invoices[0].PeriodStart.Unix())
+		s.True(decimal.NewFromInt(30).Equal(preview.Total), "total %s", preview.Total)
+
+		invoices, err := s.service.CreateDraftInvoicesForPeriods(ctx, sub.ID, endedPeriods(sub))
+		s.NoError(err)
+		s.Require().Len(invoices, 3)
+		for i, inv := range invoices {
+			s.True(decimal.NewFromInt(10).Equal(inv.Subtotal), "subtotal %s", inv.Subtotal)
+			s.Equal(preview.Invoices[i].PeriodEnd.Unix(), inv.PeriodEnd.Unix())
+		}
+	})
+
+	s.Run("catch_up_invoices_missed_periods_together", func() {
+		sub := createSub("sub_backdated_catch_up", types.BackdatedBillingModeCatchUp)
+		periods := endedPeriods(sub)
+
+		preview, err := s.service.PreviewBackdatedInvoices(ctx, sub.ID)
+		s.NoError(err)
+		s.Require().Len(preview.Invoices, 1)
+		s.True(preview.Invoices[0].IsCatchUp)
+		s.Len(preview.Invoices[0].BillingPeriods, 3)
+		s.True(decimal.NewFromInt(30).Equal(preview.Total), "total %s", preview.Total)
+
+		invoices, err := s.service.CreateDraftInvoicesForPeriods(ctx, sub.ID, periods)
+		s.NoError(err)
+		s.Require().Len(invoices, 1)
+		inv := invoices[0]
+		s.True(decimal.NewFromInt(30).Equal(inv.Subtotal), "subtotal %s", inv.Subtotal)
+		s.Equal(start.Unix(), inv.PeriodStart.Unix())
+		s.Equal(periods[2].End.Unix(), inv.PeriodEnd.Unix())
+		s.Equal("3", inv.Metadata[metadataKeyBackdatedCatchUpPeriods])
+		s.Len(inv.LineItems, 3)
+	})
+
+	s.Run("catch_up_only_applies_to_the_first_billing_run", func() {
+		sub := createSub("sub_backdated_caught_up", types.BackdatedBillingModeCatchUp)
+		periods := endedPeriods(sub)
+
+		catchUp, rest := splitCatchUpPeriods(sub, periods[1:])
+		s.Empty(catchUp)
+		s.Len(rest, 2)
+	})
+
+	s.Run("billing_cron_skips_subscriptions_pending_backdated_billing", func() {
+		sub := createSub("sub_backdated_pending", types.BackdatedBillingModePerPeriod)
+		sub.BackdatedBillingPending = true
+		s.NoError(s.GetStores().SubscriptionRepo.Update(ctx, sub))
+
+		billedIDs := func(resp *dto.SubscriptionUpdatePeriodResponse) []string {
+			return lo.Map(resp.Items, func(item *dto.SubscriptionUpdatePeriodResponseItem, _ int) string {
+				return item.SubscriptionID
+			})
+		}
+
+		resp, err := s.service.UpdateBillingPeriods(ctx)
+		s.NoError(err)
+		s.NotContains(billedIDs(resp), sub.ID)
+
+		s.NoError(s.service.CompleteBackdatedBilling(ctx, sub.ID))
+		updated, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.False(updated.BackdatedBillingPending)
+
+		resp, err = s.service.UpdateBillingPeriods(ctx)
+		s.NoError(err)
+		s.Contains(billedIDs(resp), sub.ID)
+	})
+}
+
+func (s *SubscriptionServiceSuite) TestBulkSubscriptionMigration() {
+	ctx := s.GetContext()
+	migrationService := NewSubscriptionMigrationService(s.service.(*subscriptionService).ServiceParams)
+
+	targetPlan := &plan.Plan{
+		ID:        "plan_migration_target",
+		Name:      "Migration Target",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, targetPlan))
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, &price.Price{
+		ID:                 "price_migration_target",
+		Amount:             decimal.NewFromFloat(30.00),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           targetPlan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}))
+
+	createSub := func(metadata map[string]string) *dto.SubscriptionResponse {
+		resp, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+			CustomerID:         s.testData.customer.ID,
+			PlanID:             s.testData.plan.ID,
+			Currency:           "usd",
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			Metadata:           metadata,
+		})
+		s.Require().NoError(err)
+		return resp
+	}
+	legacy := []*dto.SubscriptionResponse{
+		createSub(map[string]string{"cohort": "legacy"}),
+		createSub(map[string]string{"cohort": "legacy"}),
+	}
+	other := createSub(map[string]string{"cohort": "current"})
+
+	req := dto.SubscriptionMigrationRequest{
+		Filter: dto.SubscriptionMigrationFilter{
+			PlanIDs:  []string{s.testData.plan.ID},
+			Metadata: map[string]string{"cohort": "legacy"},
+		},
+		TargetPlanID:      targetPlan.ID,
+		ProrationBehavior: types.ProrationBehaviorNone,
+		BatchSize:         1,
+	}
+
+	s.Run("validation", func() {
+		invalid := req
+		invalid.Filter = dto.SubscriptionMigrationFilter{}
+		_, err := migrationService.PreviewSubscriptionMigration(ctx, invalid)
+		s.True(ierr.IsValidation(err))
+
+		invalid = req
+		invalid.TargetPlanID = s.testData.plan.ID
+		_, err = migrationService.PreviewSubscriptionMigration(ctx, invalid)
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("dry_run", func() {
+		preview, err := migrationService.PreviewSubscriptionMigration(ctx, req)
+		s.Require().NoError(err)
+		s.Equal(types.ScheduleTypeImmediate, preview.ChangeAt)
+		s.Equal(2, preview.TotalSubscriptions)
+		s.Equal(2, preview.EligibleSubscriptions)
+		for _, item := range preview.Subscriptions {
+			s.NotEqual(other.ID, item.SubscriptionID)
+			s.True(item.Eligible)
+			s.Equal(targetPlan.ID, item.Preview.TargetPlan.ID)
+		}
+
+		// Nothing is migrated by a dry run
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, legacy[0].ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusActive, sub.SubscriptionStatus)
+	})
+
+	s.Run("batches_report_per_subscription_results", func() {
+		s.NoError(req.Validate())
+		requestMetadata, err := toMetadataMap(req)
+		s.NoError(err)
+		t := &task.Task{
+			ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TASK),
+			TaskType:      types.TaskTypeMigration,
+			EntityType:    types.EntityTypeSubscriptionMigration,
+			FileType:      types.FileTypeJSON,
+			TaskStatus:    types.TaskStatusPending,
+			EnvironmentID: types.GetEnvironmentID(ctx),
+			Metadata:      map[string]interface{}{"request": requestMetadata},
+			BaseModel:     types.GetDefaultBaseModel(ctx),
+		}
+		s.NoError(s.GetStores().TaskRepo.Create(ctx, t))
+
+		s.NoError(migrationService.StartSubscriptionMigration(ctx, t.ID))
+		migration, err := migrationService.GetSubscriptionMigration(ctx, t.ID)
+		s.NoError(err)
+		s.Equal(types.TaskStatusProcessing, migration.Task.TaskStatus)
+		s.Equal(2, lo.FromPtr(migration.Task.TotalRecords))
+
+		// Pausing and resuming only changes the status of the task
+		s.NoError(migrationService.UpdateSubscriptionMigrationStatus(ctx, t.ID, types.TaskStatusPaused))
+		s.NoError(migrationService.UpdateSubscriptionMigrationStatus(ctx, t.ID, types.TaskStatusProcessing))
+		s.Error(migrationService.UpdateSubscriptionMigrationStatus(ctx, t.ID, types.TaskStatusCompleted))
+
+		// A retried start keeps the original selection
+		s.NoError(migrationService.StartSubscriptionMigration(ctx, t.ID))
+		results, err := migrationService.ListSubscriptionMigrationResults(ctx, t.ID, nil)
+		s.NoError(err)
+		s.Equal(2, results.Pagination.Total)
+		s.Require().Len(results.Items, 2)
+		subscriptionIDs := lo.Map(results.Items, func(r *dto.SubscriptionMigrationResult, _ int) string {
+			s.Equal(types.SubscriptionMigrationStatusPending, r.Status)
+			return r.SubscriptionID
+		})
+
+		// The second subscription is cancelled after it was selected, it fails to migrate
+		cancelled, err := s.GetStores().SubscriptionRepo.Get(ctx, subscriptionIDs[1])
+		s.NoError(err)
+		cancelled.SubscriptionStatus = types.SubscriptionStatusCancelled
+		s.NoError(s.GetStores().SubscriptionRepo.Update(ctx, cancelled))
+
+		done, err := migrationService.ProcessSubscriptionMigrationBatch(ctx, t.ID)
+		s.NoError(err)
+		s.False(done)
+
+		migration, err = migrationService.GetSubscriptionMigration(ctx, t.ID)
+		s.NoError(err)
+		s.Equal(1, migration.Task.ProcessedRecords)
+
+		processedFilter := types.NewSubscriptionMigrationResultFilter()
+		processedFilter.MigrationStatus = []types.SubscriptionMigrationStatus{types.SubscriptionMigrationStatusMigrated}
+		results, err = migrationService.ListSubscriptionMigrationResults(ctx, t.ID, processedFilter)
+		s.NoError(err)
+		s.Require().Len(results.Items, 1)
+		s.Equal(subscriptionIDs[0], results.Items[0].SubscriptionID)
+		s.NotNil(results.Items[0].ProcessedAt)
+
+		newSub, err := s.GetStores().SubscriptionRepo.Get(ctx, results.Items[0].NewSubscriptionID)
+		s.NoError(err)
+		s.Equal(targetPlan.ID, newSub.PlanID)
+		s.Equal("legacy", newSub.Metadata["cohort"])
+
+		done, err = migrationService.ProcessSubscriptionMigrationBatch(ctx, t.ID)
+		s.NoError(err)
+		s.True(done)
+
+		migration, err = migrationService.GetSubscriptionMigration(ctx, t.ID)
+		s.NoError(err)
+		s.Equal(types.TaskStatusCompleted, migration.Task.TaskStatus)
+		s.Equal(2, migration.Task.ProcessedRecords)
+		s.Equal(1, migration.Task.SuccessfulRecords)
+		s.Equal(1, migration.Task.FailedRecords)
+		s.NotNil(migration.Task.ErrorSummary)
+
+		results, err = migrationService.ListSubscriptionMigrationResults(ctx, t.ID, nil)
+		s.NoError(err)
+		s.Require().Len(results.Items, 2)
+		s.Equal(types.SubscriptionMigrationStatusMigrated, results.Items[0].Status)
+		s.Equal(types.SubscriptionMigrationStatusFailed, results.Items[1].Status)
+		s.NotEmpty(results.Items[1].Error)
+
+		// A completed migration is not failed by its workflow afterwards
+		s.NoError(migrationService.FailSubscriptionMigration(ctx, t.ID, "activity timed out"))
+		migration, err = migrationService.GetSubscriptionMigration(ctx, t.ID)
+		s.NoError(err)
+		s.Equal(types.TaskStatusCompleted, migration.Task.TaskStatus)
+	})
+
+	s.Run("workflow_failures_fail_the_task", func() {
+		requestMetadata, err := toMetadataMap(req)
+		s.NoError(err)
+		t := &task.Task{
+			ID:            types.GenerateUUIDWithPrefix(types.UUID_PREFIX_TASK),
+			TaskType:      types.TaskTypeMigration,
+			EntityType:    types.EntityTypeSubscriptionMigration,
+			FileType:      types.FileTypeJSON,
+			TaskStatus:    types.TaskStatusProcessing,
+			EnvironmentID: types.GetEnvironmentID(ctx),
+			Metadata:      map[string]interface{}{"request": requestMetadata},
+			BaseModel:     types.GetDefaultBaseModel(ctx),
+		}
+		s.NoError(s.GetStores().TaskRepo.Create(ctx, t))
+
+		s.NoError(migrationService.FailSubscriptionMigration(ctx, t.ID, "activity timed out"))
+		migration, err := migrationService.GetSubscriptionMigration(ctx, t.ID)
+		s.NoError(err)
+		s.Equal(types.TaskStatusFailed, migration.Task.TaskStatus)
+		s.NotNil(migration.Task.FailedAt)
+		s.Equal("activity timed out", lo.FromPtr(migration.Task.ErrorSummary))
+	})
+}
+
+func (s *SubscriptionServiceSuite) TestInvoiceDunning() {
+	ctx := s.GetContext()
+	params := s.service.(*subscriptionService).ServiceParams
+	dunningService := NewDunningService(params)
+	settingsSvc := NewSettingsService(params).(*settingsService)
+
+	createFailedInvoice := func() (*subscription.Subscription, *invoice.Invoice) {
+		resp, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+			CustomerID:         s.testData.customer.ID,
+			PlanID:             s.testData.plan.ID,
+			Currency:           "usd",
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+		})
+		s.Require().NoError(err)
+
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, resp.ID)
+		s.Require().NoError(err)
+		sub.CollectionMethod = string(types.CollectionMethodChargeAutomatically)
+		sub.SubscriptionStatus = types.SubscriptionStatusActive
+		s.Require().NoError(s.GetStores().SubscriptionRepo.Update(ctx, sub))
+
+		inv := &invoice.Invoice{
+			ID:              s.GetUUID(),
+			CustomerID:      s.testData.customer.ID,
+			SubscriptionID:  lo.ToPtr(sub.ID),
+			InvoiceType:     types.InvoiceTypeSubscription,
+			InvoiceStatus:   types.InvoiceStatusFinalized,
+			PaymentStatus:   types.PaymentStatusFailed,
+			Currency:        "usd",
+			AmountDue:       decimal.NewFromFloat(30),
+			AmountPaid:      decimal.Zero,
+			AmountRemaining: decimal.NewFromFloat(30),
+			BaseModel:       types.GetDefaultBaseModel(ctx),
+		}
+		s.Require().NoError(s.GetStores().InvoiceRepo.Create(ctx, inv))
+		return sub, inv
+	}
+
+	subscriptionStatus := func(id string) types.SubscriptionStatus {
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, id)
+		s.Require().NoError(err)
+		return sub.SubscriptionStatus
+	}
+
+	s.Run("policy_validation", func() {
+		s.NoError(types.DefaultDunningConfig().Validate())
+
+		config := types.DefaultDunningConfig()
+		config.Steps[1].DaysAfterFailure = config.Steps[0].DaysAfterFailure
+		s.True(ierr.IsValidation(config.Validate()))
+
+		config = types.DefaultDunningConfig()
+		config.FinalAction = "refund"
+		s.True(ierr.IsValidation(config.Validate()))
+
+		config = types.DunningConfig{Enabled: true, FinalAction: types.DunningFinalActionCancel}
+		s.True(ierr.IsValidation(config.Validate()))
+	})
+
+	s.Run("disabled_policy_skips_dunning", func() {
+		sub, inv := createFailedInvoice()
+		output, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeSkipped, output.Outcome)
+		s.Equal(types.SubscriptionStatusActive, subscriptionStatus(sub.ID))
+	})
+
+	config := types.DunningConfig{
+		Enabled: true,
+		Steps: []types.DunningStep{
+			{DaysAfterFailure: 1, SendWebhook: true},
+			{DaysAfterFailure: 3, SendEmail: true},
+		},
+		FinalAction: types.DunningFinalActionMarkUnpaid,
+	}
+	s.Require().NoError(UpdateSetting(settingsSvc, ctx, types.SettingKeyDunningConfig, config))
+
+	s.Run("unpaid_after_last_step_and_recovered_by_payment", func() {
+		sub, inv := createFailedInvoice()
+
+		output, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.DunningOutcomePending, output.Outcome)
+		s.Equal(config.Steps, output.Steps)
+		s.Equal(types.DunningFinalActionMarkUnpaid, output.FinalAction)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+
+		for i, step := range output.Steps {
+			outcome, err := dunningService.RunDunningStep(ctx, inv.ID, i+1, step)
+			s.NoError(err)
+			s.Equal(types.DunningOutcomePending, outcome)
+			s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+		}
+
+		outcome, err := dunningService.FinishDunning(ctx, inv.ID, output.FinalAction)
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeExhausted, outcome)
+		s.Equal(types.SubscriptionStatusUnpaid, subscriptionStatus(sub.ID))
+
+		// Paying the invoice after the dunning makes the subscription active again
+		inv.PaymentStatus = types.PaymentStatusSucceeded
+		inv.AmountPaid = inv.AmountDue
+		inv.AmountRemaining = decimal.Zero
+		s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+		s.NoError(dunningService.HandleInvoicePaid(ctx, inv))
+		s.Equal(types.SubscriptionStatusActive, subscriptionStatus(sub.ID))
+	})
+
+	s.Run("consolidated_invoice_duns_every_subscription", func() {
+		first, firstInv := createFailedInvoice()
+		second, secondInv := createFailedInvoice()
+		s.NoError(s.GetStores().InvoiceRepo.Delete(ctx, secondInv.ID))
+
+		inv := firstInv
+		inv.SubscriptionID = nil
+		inv.Metadata = types.Metadata{metadataKeyConsolidatedSubscriptionIDs: first.ID + "," + second.ID}
+		s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+
+		output, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.DunningOutcomePending, output.Outcome)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(first.ID))
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(second.ID))
+
+		inv.PaymentStatus = types.PaymentStatusSucceeded
+		inv.AmountPaid = inv.AmountDue
+		inv.AmountRemaining = decimal.Zero
+		s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+		s.NoError(dunningService.HandleInvoicePaid(ctx, inv))
+		s.Equal(types.SubscriptionStatusActive, subscriptionStatus(first.ID))
+		s.Equal(types.SubscriptionStatusActive, subscriptionStatus(second.ID))
+	})
+
+	s.Run("invoice_paid_between_steps_recovers", func() {
+		sub, inv := createFailedInvoice()
+
+		_, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+
+		inv.PaymentStatus = types.PaymentStatusSucceeded
+		inv.AmountPaid = inv.AmountDue
+		inv.AmountRemaining = decimal.Zero
+		s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+
+		outcome, err := dunningService.RunDunningStep(ctx, inv.ID, 1, config.Steps[0])
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeRecovered, outcome)
+		s.Equal(types.SubscriptionStatusActive, subscriptionStatus(sub.ID))
+	})
+
+	s.Run("voided_invoice_stops_without_recovering", func() {
+		sub, inv := createFailedInvoice()
+
+		_, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+
+		inv.InvoiceStatus = types.InvoiceStatusVoided
+		s.NoError(s.GetStores().InvoiceRepo.Update(ctx, inv))
+
+		outcome, err := dunningService.RunDunningStep(ctx, inv.ID, 1, config.Steps[0])
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeStopped, outcome)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+
+		s.NoError(dunningService.HandleInvoicePaid(ctx, inv))
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+	})
+
+	s.Run("cancel_final_action", func() {
+		sub, inv := createFailedInvoice()
+
+		_, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+
+		outcome, err := dunningService.FinishDunning(ctx, inv.ID, types.DunningFinalActionCancel)
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeExhausted, outcome)
+		s.Equal(types.SubscriptionStatusCancelled, subscriptionStatus(sub.ID))
+
+		// The dunning of a cancelled subscription stops
+		outcome, err = dunningService.RunDunningStep(ctx, inv.ID, 1, config.Steps[0])
+		s.NoError(err)
+		s.Equal(types.DunningOutcomeStopped, outcome)
+	})
+
+	s.Run("past_due_subscription_changes_plan", func() {
+		sub, inv := createFailedInvoice()
+
+		_, err := dunningService.StartDunning(ctx, inv.ID)
+		s.NoError(err)
+		s.Equal(types.SubscriptionStatusPastDue, subscriptionStatus(sub.ID))
+
+		targetPlan := &plan.Plan{
+			ID:        "plan_past_due_target",
+			Name:      "Past Due Target",
+			BaseModel: types.GetDefaultBaseModel(ctx),
+		}
+		s.NoError(s.GetStores().PlanRepo.Create(ctx, targetPlan))
+		s.NoError(s.GetStores().PriceRepo.Create(ctx, &price.Price{
+			ID:                 "price_past_due_target",
+			Amount:             decimal.NewFromFloat(30.00),
+			Currency:           "usd",
+			EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+			EntityID:           targetPlan.ID,
+			Type:               types.PRICE_TYPE_FIXED,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			InvoiceCadence:     types.InvoiceCadenceAdvance,
+			BaseModel:          types.GetDefaultBaseModel(ctx),
+		}))
+
+		// Past due subscriptions are still active while dunned, their plan changes go ahead
+		resp, err := NewSubscriptionChangeService(params).ExecuteSubscriptionChangeInternal(ctx, sub.ID, dto.SubscriptionChangeRequest{
+			TargetPlanID:       targetPlan.ID,
+			ProrationBehavior:  types.ProrationBehaviorNone,
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BillingCycle:       types.BillingCycleAnniversary,
+		})
+		s.NoError(err)
+		s.Equal(targetPlan.ID, resp.NewSubscription.PlanID)
+	})
+}
+
+func (s *SubscriptionServiceSuite) TestSpendLimitEnforcement() {
+	ctx := s.GetContext()
+	params := s.service.(*subscriptionService).ServiceParams
+	spendLimitService := NewSpendLimitService(params)
+	settingsSvc := NewSettingsService(params).(*settingsService)
+
+	resp, err := s.service.CreateSubscription(ctx, dto.CreateSubscriptionRequest{
+		CustomerID:         s.testData.customer.ID,
+		PlanID:             s.testData.plan.ID,
+		Currency:           "usd",
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+	})
+	s.Require().NoError(err)
+
+	meteredFeature := &feature.Feature{
+		ID:        s.GetUUID(),
+		Name:      "API Calls",
+		Type:      types.FeatureTypeMetered,
+		MeterID:   s.testData.meters.apiCalls.ID,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	booleanFeature := &feature.Feature{
+		ID:        s.GetUUID(),
+		Name:      "SSO",
+		Type:      types.FeatureTypeBoolean,
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	for _, f := range []*feature.Feature{meteredFeature, booleanFeature} {
+		s.Require().NoError(s.GetStores().FeatureRepo.Create(ctx, f))
+		_, err := s.GetStores().EntitlementRepo.Create(ctx, &entitlement.Entitlement{
+			ID:          s.GetUUID(),
+			EntityType:  types.ENTITLEMENT_ENTITY_TYPE_PLAN,
+			EntityID:    s.testData.plan.ID,
+			FeatureID:   f.ID,
+			FeatureType: f.Type,
+			IsEnabled:   true,
+			BaseModel:   types.GetDefaultBaseModel(ctx),
+		})
+		s.Require().NoError(err)
+	}
+
+	prepaidBalance := func(amount float64, status types.WalletStatus) []*dto.WalletBalanceResponse {
+		return []*dto.WalletBalanceResponse{{
+			Wallet: &wallet.Wallet{
+				ID:           s.GetUUID(),
+				CustomerID:   s.testData.customer.ID,
+				Currency:     "USD",
+				WalletType:   types.WalletTypePrePaid,
+				WalletStatus: status,
+			},
+			RealTimeBalance: lo.ToPtr(decimal.NewFromFloat(amount)),
+		}}
+	}
+
+	spendLimitState := func() types.SpendLimitState {
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, resp.ID)
+		s.Require().NoError(err)
+		return sub.SpendLimitState
+	}
+
+	enabledFeatures := func() map[string]bool {
+		entitlements, err := s.service.GetSubscriptionEntitlements(ctx, resp.ID)
+		s.Require().NoError(err)
+		enabled := make(map[string]bool)
+		for _, ent := range entitlements {
+			enabled[ent.FeatureID] = ent.IsEnabled
+		}
+		return enabled
+	}
+
+	s.Run("config_validation", func() {
+		s.NoError(types.DefaultSpendLimitConfig().Validate())
+		s.True(ierr.IsValidation(types.SpendLimitConfig{EnforcementMode: "throttle"}.Validate()))
+	})
+
+	s.Run("no_enforcement_by_default", func() {
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(0, types.WalletStatusActive)))
+		s.False(spendLimitState().IsLimited())
+	})
+
+	s.Require().NoError(UpdateSetting(settingsSvc, ctx, types.SettingKeySpendLimitConfig, types.SpendLimitConfig{
+		EnforcementMode: types.SpendLimitEnforcementModeBlockUsage,
+		Floor:           decimal.NewFromInt(5),
+	}))
+
+	s.Run("usage_blocked_at_floor_and_restored_on_top_up", func() {
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(5, types.WalletStatusActive)))
+		s.Equal(types.SpendLimitStateUsageBlocked, spendLimitState())
+
+		enabled := enabledFeatures()
+		s.False(enabled[meteredFeature.ID])
+		s.True(enabled[booleanFeature.ID])
+
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(20, types.WalletStatusActive)))
+		s.False(spendLimitState().IsLimited())
+
+		enabled = enabledFeatures()
+		s.True(enabled[meteredFeature.ID])
+		s.True(enabled[booleanFeature.ID])
+	})
+
+	s.Require().NoError(UpdateSetting(settingsSvc, ctx, types.SettingKeySpendLimitConfig, types.SpendLimitConfig{
+		EnforcementMode: types.SpendLimitEnforcementModeSuspend,
+		Floor:           decimal.Zero,
+	}))
+
+	s.Run("suspended_below_floor", func() {
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(-3, types.WalletStatusActive)))
+		s.Equal(types.SpendLimitStateSuspended, spendLimitState())
+
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, resp.ID)
+		s.Require().NoError(err)
+		s.NotNil(sub.SpendLimitedAt)
+
+		enabled := enabledFeatures()
+		s.False(enabled[meteredFeature.ID])
+		s.False(enabled[booleanFeature.ID])
+	})
+
+	s.Run("restored_without_active_prepaid_wallet", func() {
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(0, types.WalletStatusClosed)))
+		s.False(spendLimitState().IsLimited())
+	})
+
+	s.Run("checked_against_stored_wallet_balance", func() {
+		s.NoError(spendLimitService.EnforceSpendLimits(ctx, s.testData.customer.ID, prepaidBalance(-3, types.WalletStatusActive)))
+		s.Require().Equal(types.SpendLimitStateSuspended, spendLimitState())
+
+		s.Require().NoError(s.GetStores().WalletRepo.CreateWallet(ctx, &wallet.Wallet{
+			ID:             s.GetUUID(),
+			CustomerID:     s.testData.customer.ID,
+			Currency:       "usd",
+			Balance:        decimal.NewFromInt(50),
+			CreditBalance:  decimal.NewFromInt(50),
+			ConversionRate: decimal.NewFromInt(1),
+			WalletType:     types.WalletTypePrePaid,
+			WalletStatus:   types.WalletStatusActive,
+			BaseModel:      types.GetDefaultBaseModel(ctx),
+		}))
+
+		s.NoError(spendLimitService.CheckSpendLimits(ctx, s.testData.customer.ID))
+		s.False(spendLimitState().IsLimited())
+	})
+}
+
+func (s *SubscriptionServiceSuite) TestSubscriptionTermRenewal() {
+	ctx := s.GetContext()
+
+	renewalPlan := &plan.Plan{
+		ID:        "plan_renewal_target",
+		Name:      "Renewal Target",
+		BaseModel: types.GetDefaultBaseModel(ctx),
+	}
+	s.NoError(s.GetStores().PlanRepo.Create(ctx, renewalPlan))
+	s.NoError(s.GetStores().PriceRepo.Create(ctx, &price.Price{
+		ID:                 "price_renewal_target",
+		Amount:             decimal.NewFromFloat(40.00),
+		Currency:           "usd",
+		EntityType:         types.PRICE_ENTITY_TYPE_PLAN,
+		EntityID:           renewalPlan.ID,
+		Type:               types.PRICE_TYPE_FIXED,
+		BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+		BillingPeriodCount: 1,
+		BillingModel:       types.BILLING_MODEL_FLAT_FEE,
+		BillingCadence:     types.BILLING_CADENCE_RECURRING,
+		InvoiceCadence:     types.InvoiceCadenceAdvance,
+		BaseModel:          types.GetDefaultBaseModel(ctx),
+	}))
+
+	newRequest := func(termMonths int) dto.CreateSubscriptionRequest {
+		return dto.CreateSubscriptionRequest{
+			CustomerID:         s.testData.customer.ID,
+			PlanID:             s.testData.plan.ID,
+			Currency:           "usd",
+			BillingCadence:     types.BILLING_CADENCE_RECURRING,
+			BillingPeriod:      types.BILLING_PERIOD_MONTHLY,
+			BillingPeriodCount: 1,
+			BillingCycle:       types.BillingCycleAnniversary,
+			TermMonths:         termMonths,
+		}
+	}
+	createSub := func(req dto.CreateSubscriptionRequest) *subscription.Subscription {
+		resp, err := s.service.CreateSubscription(ctx, req)
+		s.Require().NoError(err)
+		sub, err := s.GetStores().SubscriptionRepo.Get(ctx, resp.ID)
+		s.Require().NoError(err)
+		return sub
+	}
+	// moveToPeriod moves the current billing period of the subscription like the billing workflow
+	moveToPeriod := func(sub *subscription.Subscription, start time.Time) {
+		sub.CurrentPeriodStart = start
+		sub.CurrentPeriodEnd = start.AddDate(0, 1, 0)
+		s.Require().NoError(s.GetStores().SubscriptionRepo.Update(ctx, sub))
+	}
+
+	s.Run("validation", func() {
+		_, err := s.service.CreateSubscription(ctx, newRequest(types.MAX_SUBSCRIPTION_TERM_MONTHS+1))
+		s.True(ierr.IsValidation(err))
+
+		req := newRequest(0)
+		req.RenewalPlanID = lo.ToPtr(renewalPlan.ID)
+		_, err = s.service.CreateSubscription(ctx, req)
+		s.True(ierr.IsValidation(err))
+
+		req = newRequest(12)
+		req.RenewalPlanID = lo.ToPtr("plan_missing")
+		_, err = s.service.CreateSubscription(ctx, req)
+		s.Error(err)
+	})
+
+	s.Run("subscriptions_without_term_are_not_renewed", func() {
+		sub := createSub(newRequest(0))
+		s.False(sub.HasTerm())
+
+		result, err := s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.False(result.Renewed)
+		s.False(result.NonRenewalScheduled)
+
+		_, err = s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{AutoRenew: lo.ToPtr(false)})
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("auto_renew_starts_a_new_term", func() {
+		sub := createSub(newRequest(12))
+		s.Require().True(sub.HasTerm())
+		s.True(sub.AutoRenew)
+		termEnd := *sub.CurrentTermEnd
+		s.Equal(sub.StartDate.Truncate(time.Second).AddDate(0, 12, 0), termEnd)
+
+		// Nothing happens before the end of the term
+		result, err := s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.False(result.Renewed)
+
+		moveToPeriod(sub, termEnd)
+		result, err = s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.True(result.Renewed)
+		s.Equal(sub.ID, result.SubscriptionID)
+
+		renewed, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.Equal(termEnd, *renewed.CurrentTermStart)
+		s.Equal(termEnd.AddDate(0, 12, 0), *renewed.CurrentTermEnd)
+		s.False(renewed.CancelAtPeriodEnd)
+	})
+
+	s.Run("renewal_to_a_different_plan", func() {
+		// The term ended yesterday
+		req := newRequest(12)
+		req.RenewalPlanID = lo.ToPtr(renewalPlan.ID)
+		req.StartDate = lo.ToPtr(time.Now().UTC().AddDate(-1, 0, -1))
+		sub := createSub(req)
+		termEnd := *sub.CurrentTermEnd
+
+		// The last invoice of the term leaves the advance charges of the new term to the
+		// subscription on the renewal plan
+		finalPeriodStart := termEnd.AddDate(0, -1, 0)
+		moveToPeriod(sub, finalPeriodStart)
+		invoiceReq, err := NewBillingService(s.service.(*subscriptionService).ServiceParams).
+			PrepareSubscriptionInvoiceRequest(ctx, sub, finalPeriodStart, termEnd, types.ReferencePointPeriodEnd)
+		s.Require().NoError(err)
+		for _, item := range invoiceReq.LineItems {
+			s.True(item.PeriodStart.Before(termEnd))
+		}
+
+		moveToPeriod(sub, termEnd)
+		result, err := s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.True(result.Renewed)
+		s.NotEqual(sub.ID, result.SubscriptionID)
+
+		renewed, err := s.GetStores().SubscriptionRepo.Get(ctx, result.SubscriptionID)
+		s.NoError(err)
+		s.Equal(termEnd, renewed.StartDate)
+		s.Equal(termEnd, renewed.CurrentPeriodStart)
+		s.Equal(renewalPlan.ID, renewed.PlanID)
+		s.Equal(12, renewed.TermMonths)
+		s.Nil(renewed.RenewalPlanID)
+		s.Equal(termEnd, *renewed.CurrentTermStart)
+		s.Equal(termEnd.AddDate(0, 12, 0), *renewed.CurrentTermEnd)
+	})
+
+	s.Run("billing_cron_renews_the_term", func() {
+		// The one month term ended yesterday
+		req := newRequest(1)
+		req.StartDate = lo.ToPtr(time.Now().UTC().AddDate(0, -1, -1))
+		sub := createSub(req)
+		termEnd := *sub.CurrentTermEnd
+
+		err := s.service.(*subscriptionService).processSubscriptionPeriod(ctx, sub, time.Now().UTC())
+		s.Require().NoError(err)
+
+		renewed, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.Equal(termEnd, renewed.CurrentPeriodStart)
+		s.Equal(termEnd, *renewed.CurrentTermStart)
+		s.Equal(termEnd.AddDate(0, 1, 0), *renewed.CurrentTermEnd)
+	})
+
+	s.Run("non_renewal_is_scheduled_in_the_final_period", func() {
+		req := newRequest(12)
+		req.AutoRenew = lo.ToPtr(false)
+		sub := createSub(req)
+		termEnd := *sub.CurrentTermEnd
+
+		result, err := s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.False(result.NonRenewalScheduled)
+
+		moveToPeriod(sub, termEnd.AddDate(0, -1, 0))
+		result, err = s.service.ProcessTermRenewal(ctx, sub.ID)
+		s.NoError(err)
+		s.True(result.NonRenewalScheduled)
+
+		scheduled, err := s.GetStores().SubscriptionRepo.Get(ctx, sub.ID)
+		s.NoError(err)
+		s.True(scheduled.CancelAtPeriodEnd)
+		s.Equal(termEnd, *scheduled.CancelAt)
+		s.Equal(types.SubscriptionRenewalActionCancel, scheduled.RenewalAction())
+
+		// The renewal of a subscription scheduled for cancellation cannot be turned back on
+		_, err = s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{AutoRenew: lo.ToPtr(true)})
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("update_renewal", func() {
+		// A one month term is in its final period right away
+		sub := createSub(newRequest(1))
+
+		resp, err := s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{RenewalPlanID: lo.ToPtr(renewalPlan.ID)})
+		s.NoError(err)
+		s.Equal(renewalPlan.ID, lo.FromPtr(resp.RenewalPlanID))
+		s.Equal(types.SubscriptionRenewalActionRenewToPlan, resp.RenewalAction())
+
+		_, err = s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{})
+		s.True(ierr.IsValidation(err))
+
+		resp, err = s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{AutoRenew: lo.ToPtr(false)})
+		s.NoError(err)
+		s.False(resp.AutoRenew)
+		s.True(resp.CancelAtPeriodEnd)
+		s.Equal(sub.CurrentPeriodEnd, *resp.CancelAt)
+	})
+
+	s.Run("non_renewal_after_notice_deadline", func() {
+		req := newRequest(1)
+		req.RenewalNoticeDays = 60
+		sub := createSub(req)
+
+		_, err := s.service.UpdateSubscriptionRenewal(ctx, sub.ID, dto.UpdateSubscriptionRenewalRequest{AutoRenew: lo.ToPtr(false)})
+		s.True(ierr.IsValidation(err))
+	})
+
+	s.Run("upcoming_renewals", func() {
+		_, err := s.service.ListUpcomingRenewals(ctx, dto.ListUpcomingRenewalsRequest{Days: 400})
+		s.True(ierr.IsValidation(err))
+
+		resp, err := s.service.ListUpcomingRenewals(ctx, dto.ListUpcomingRenewalsRequest{Days: 40})
+		s.NoError(err)
+		s.NotEmpty(resp.Items)
+		for i, item := range resp.Items {
+			s.Equal(1, item.TermMonths)
+			s.False(item.CurrentTermEnd.After(resp.Until))
+			if i > 0 {
+				s.False(item.CurrentTermEnd.Before(*resp.Items[i-1].CurrentTermEnd))
+			}
+		}
+		s.Equal(len(resp.Items), resp.Pagination.Total)
+
+		page, err := s.service.ListUpcomingRenewals(ctx, dto.ListUpcomingRenewalsRequest{
+			QueryFilter: &types.QueryFilter{Limit: lo.ToPtr(1), Offset: lo.ToPtr(1)},
+			Days:        40,
+		})
+		s.NoError(err)
+		s.Len(page.Items, 1)
+		s.Equal(resp.Items[1].SubscriptionID, page.Items[0].SubscriptionID)
+		s.Equal(resp.Pagination.Total, page.Pagination.Total)
+	})
+}
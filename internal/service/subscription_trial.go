@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/api/dto"
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	temporalservice "github.com/flexprice/flexprice/internal/temporal/service"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// applySubscriptionTrial derives the trial of a new subscription from the longest trial period of
+// its line items when no trial end was requested, and puts subscriptions whose trial has not ended
+// yet in trialing status
+func applySubscriptionTrial(sub *subscription.Subscription, now time.Time) {
+	if sub.TrialEnd == nil {
+		trialDays := lo.Max(lo.Map(sub.LineItems, func(item *subscription.SubscriptionLineItem, _ int) int {
+			return item.TrialPeriod
+		}))
+		if trialDays > 0 {
+			sub.TrialStart = lo.ToPtr(sub.StartDate)
+			sub.TrialEnd = lo.ToPtr(sub.StartDate.AddDate(0, 0, trialDays))
+		}
+	}
+
+	if sub.TrialEnd == nil || !sub.TrialEnd.After(now) {
+		return
+	}
+	if sub.TrialStart == nil {
+		sub.TrialStart = lo.ToPtr(sub.StartDate)
+	}
+	sub.SubscriptionStatus = types.SubscriptionStatusTrialing
+}
+
+// trialEndGracePeriod is how long after the end of a trial the billing cron ends it when the trial
+// workflow of the subscription did not
+const trialEndGracePeriod = time.Hour
+
+// triggerSubscriptionTrialWorkflow starts the workflow that sends the trial reminder and ends the
+// trial of a subscription. Trials whose workflow fails to start are ended by the billing cron with
+// endExpiredTrials.
+func (s *subscriptionService) triggerSubscriptionTrialWorkflow(ctx context.Context, subscriptionID string) {
+	temporalSvc := temporalservice.GetGlobalTemporalService()
+	if temporalSvc == nil {
+		s.Logger.Warnw("temporal service not available for subscription trial",
+			"subscription_id", subscriptionID)
+		return
+	}
+
+	workflowRun, err := temporalSvc.ExecuteWorkflow(
+		ctx,
+		types.TemporalSubscriptionTrialWorkflow,
+		subscriptionModels.SubscriptionTrialWorkflowInput{SubscriptionID: subscriptionID},
+	)
+	if err != nil {
+		s.Logger.Errorw("failed to start subscription trial workflow",
+			"error", err,
+			"subscription_id", subscriptionID)
+		return
+	}
+
+	s.Logger.Infow("subscription trial workflow started successfully",
+		"subscription_id", subscriptionID,
+		"workflow_id", workflowRun.GetID())
+}
+
+// endExpiredTrials ends the trials that ended more than the grace period ago. Trials are ended by
+// their workflow at the trial end, so this only picks up subscriptions whose workflow failed to
+// start or to end the trial. Failures are logged and retried on the next run.
+func (s *subscriptionService) endExpiredTrials(ctx context.Context, now time.Time) {
+	const batchSize = 100
+	trialEndBefore := now.Add(-trialEndGracePeriod)
+
+	offset := 0
+	for {
+		filter := &types.SubscriptionFilter{
+			QueryFilter: &types.QueryFilter{
+				Limit:  lo.ToPtr(batchSize),
+				Offset: lo.ToPtr(offset),
+				Status: lo.ToPtr(types.StatusPublished),
+			},
+			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusTrialing},
+			TrialEndBefore:     &trialEndBefore,
+		}
+
+		subs, err := s.SubRepo.ListAllTenant(ctx, filter)
+		if err != nil {
+			s.Logger.Errorw("failed to list subscriptions with expired trials", "error", err)
+			return
+		}
+
+		failed := 0
+		for _, sub := range subs {
+			subCtx := context.WithValue(ctx, types.CtxTenantID, sub.TenantID)
+			subCtx = context.WithValue(subCtx, types.CtxEnvironmentID, sub.EnvironmentID)
+			subCtx = context.WithValue(subCtx, types.CtxUserID, sub.CreatedBy)
+
+			s.Logger.Warnw("ending expired trial missed by its workflow",
+				"subscription_id", sub.ID,
+				"trial_end", sub.TrialEnd)
+
+			if _, err := s.EndTrial(subCtx, sub.ID); err != nil {
+				s.Logger.Errorw("failed to end expired trial",
+					"error", err,
+					"subscription_id", sub.ID)
+				failed++
+			}
+		}
+
+		// Ended trials drop out of the filter, only the failed ones are skipped on the next page
+		offset += failed
+		if len(subs) < batchSize {
+			return
+		}
+	}
+}
+
+// ExtendTrial moves the end of the trial of a trialing subscription. The trial workflow picks up
+// the new end of the trial, and sends a new reminder, when its current timer fires.
+func (s *subscriptionService) ExtendTrial(ctx context.Context, subscriptionID string, req dto.ExtendTrialRequest) (*dto.SubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sub.IsTrialing() {
+		return nil, ierr.NewError("subscription is not trialing").
+			WithHint("Only the trial of trialing subscriptions can be extended").
+			WithReportableDetails(map[string]interface{}{
+				"subscription_id": subscriptionID,
+				"status":          sub.SubscriptionStatus,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	trialEnd := req.NewTrialEnd(*sub.TrialEnd)
+	if !trialEnd.After(*sub.TrialEnd) {
+		return nil, ierr.NewError("trial_end must be after the current end of the trial").
+			WithHint("Trials can only be extended").
+			WithReportableDetails(map[string]interface{}{
+				"current_trial_end": *sub.TrialEnd,
+				"trial_end":         trialEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	if sub.EndDate != nil && trialEnd.After(*sub.EndDate) {
+		return nil, ierr.NewError("trial_end cannot be after the end of the subscription").
+			WithHint("Extend the trial to a date before the end of the subscription").
+			WithReportableDetails(map[string]interface{}{
+				"end_date":  *sub.EndDate,
+				"trial_end": trialEnd,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	previousTrialEnd := *sub.TrialEnd
+	sub.TrialEnd = &trialEnd
+	if err := s.SubRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infow("extended subscription trial",
+		"subscription_id", sub.ID,
+		"previous_trial_end", previousTrialEnd,
+		"trial_end", trialEnd)
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionTrialExtended, sub.ID)
+
+	return s.GetSubscription(ctx, subscriptionID)
+}
+
+// GetTrialState returns the trial of a subscription and whether its trial usage caps were reached
+func (s *subscriptionService) GetTrialState(ctx context.Context, subscriptionID string) (*dto.SubscriptionTrialState, error) {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &dto.SubscriptionTrialState{
+		IsTrialing:   sub.IsTrialing(),
+		TrialEnd:     sub.TrialEnd,
+		ReminderAt:   sub.TrialReminderAt(),
+		HasUsageCaps: len(sub.TrialUsageCaps) > 0,
+	}
+	if !state.IsTrialing || !state.HasUsageCaps {
+		return state, nil
+	}
+
+	state.ExceededMeterID, state.UsageCapReached, err = s.exceededTrialUsageCap(ctx, sub, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// exceededTrialUsageCap returns the meter whose usage since the start of the trial reached its
+// trial usage cap. Meters rated by several prices count the usage of the price with most usage.
+func (s *subscriptionService) exceededTrialUsageCap(ctx context.Context, sub *subscription.Subscription, now time.Time) (string, bool, error) {
+	if len(sub.TrialUsageCaps) == 0 {
+		return "", false, nil
+	}
+
+	usage, err := s.GetUsageBySubscription(ctx, &dto.GetUsageBySubscriptionRequest{
+		SubscriptionID: sub.ID,
+		StartTime:      lo.FromPtrOr(sub.TrialStart, sub.StartDate),
+		EndTime:        now,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	usageByMeter := make(map[string]decimal.Decimal)
+	for _, charge := range usage.Charges {
+		quantity := decimal.NewFromFloat(charge.Quantity)
+		if current, ok := usageByMeter[charge.MeterID]; !ok || quantity.GreaterThan(current) {
+			usageByMeter[charge.MeterID] = quantity
+		}
+	}
+
+	meterID, reached := sub.ExceededTrialUsageCap(usageByMeter)
+	return meterID, reached, nil
+}
+
+// SendTrialReminder publishes the trial will end webhook of a trialing subscription
+func (s *subscriptionService) SendTrialReminder(ctx context.Context, subscriptionID string) error {
+	sub, err := s.SubRepo.Get(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if !sub.IsTrialing() {
+		s.Logger.Infow("subscription is no longer trialing, skipping trial reminder",
+			"subscription_id", subscriptionID,
+			"status", sub.SubscriptionStatus)
+		return nil
+	}
+
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionTrialWillEnd, sub.ID)
+	return nil
+}
+
+// EndTrial ends the trial of a subscription with its trial end action. Trials end at their trial
+// end, or earlier when a trial usage cap is reached. Subscriptions that are no longer trialing are
+// returned unchanged.
+func (s *subscriptionService) EndTrial(ctx context.Context, subscriptionID string) (*dto.SubscriptionResponse, error) {
+	sub, lineItems, err := s.SubRepo.GetWithLineItems(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	sub.LineItems = lineItems
+
+	if !sub.IsTrialing() {
+		return &dto.SubscriptionResponse{Subscription: sub}, nil
+	}
+
+	now := time.Now().UTC()
+	trialEnd := *sub.TrialEnd
+	if trialEnd.After(now) {
+		meterID, reached, err := s.exceededTrialUsageCap(ctx, sub, now)
+		if err != nil {
+			return nil, err
+		}
+		if !reached {
+			return nil, ierr.NewError("trial has not ended").
+				WithHint("Trials end at their trial end or when a trial usage cap is reached").
+				WithReportableDetails(map[string]interface{}{
+					"subscription_id": subscriptionID,
+					"trial_end":       trialEnd,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		s.Logger.Infow("trial usage cap reached, ending trial early",
+			"subscription_id", subscriptionID,
+			"meter_id", meterID,
+			"usage_cap", sub.TrialUsageCaps[meterID])
+		trialEnd = now
+	}
+
+	action := sub.TrialEndAction
+	if action == types.TrialEndActionPauseIfNoPaymentMethod && s.hasPaymentMethod(ctx, sub) {
+		action = types.TrialEndActionConvert
+	}
+
+	s.Logger.Infow("ending subscription trial",
+		"subscription_id", subscriptionID,
+		"trial_end", trialEnd,
+		"trial_end_action", action)
+
+	// The trial end and the end action are applied in one transaction, so that a failed action
+	// leaves the subscription trialing and the trial is ended again on the next attempt
+	err = s.DB.WithTx(ctx, func(ctx context.Context) error {
+		switch action {
+		case types.TrialEndActionCancel:
+			sub.TrialEnd = &trialEnd
+			if err := s.SubRepo.Update(ctx, sub); err != nil {
+				return err
+			}
+			_, err := s.CancelSubscription(ctx, sub.ID, &dto.CancelSubscriptionRequest{
+				CancellationType:  types.CancellationTypeImmediate,
+				ProrationBehavior: types.ProrationBehaviorNone,
+				Reason:            "trial_ended",
+			})
+			return err
+
+		case types.TrialEndActionPauseIfNoPaymentMethod:
+			if _, err := s.convertTrial(ctx, sub, trialEnd, false); err != nil {
+				return err
+			}
+			_, _, err := s.executePause(ctx, sub, &dto.PauseSubscriptionRequest{
+				PauseMode: types.PauseModeImmediate,
+				Reason:    "no payment method at the end of the trial",
+			}, &trialEnd, nil)
+			return err
+
+		default:
+			_, err := s.convertTrial(ctx, sub, trialEnd, true)
+			return err
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case types.TrialEndActionCancel:
+	case types.TrialEndActionPauseIfNoPaymentMethod:
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionUpdated, sub.ID)
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionPaused, sub.ID)
+	default:
+		s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionActivated, sub.ID)
+	}
+	s.publishInternalWebhookEvent(ctx, types.WebhookEventSubscriptionTrialEnded, sub.ID)
+
+	return s.GetSubscription(ctx, subscriptionID)
+}
+
+// convertTrial activates a trialing subscription and starts its first billing period at the end
+// of the trial, usage during the trial is never billed. The first period is invoiced when
+// createInvoice is set.
+func (s *subscriptionService) convertTrial(ctx context.Context, sub *subscription.Subscription, trialEnd time.Time, createInvoice bool) (*dto.InvoiceResponse, error) {
+	if err := restartBillingPeriod(sub, trialEnd); err != nil {
+		return nil, err
+	}
+
+	sub.TrialEnd = &trialEnd
+	sub.SubscriptionStatus = types.SubscriptionStatusActive
+
+	var invoice *dto.InvoiceResponse
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.SubRepo.Update(ctx, sub); err != nil {
+			return err
+		}
+		if !createInvoice {
+			return nil
+		}
+
+		paymentParams := dto.NewPaymentParametersFromSubscription(sub.CollectionMethod, sub.PaymentBehavior, sub.GatewayPaymentMethodID).NormalizePaymentParameters()
+		inv, updatedSub, err := NewInvoiceService(s.ServiceParams).CreateSubscriptionInvoice(ctx, &dto.CreateSubscriptionInvoiceRequest{
+			SubscriptionID: sub.ID,
+			PeriodStart:    sub.CurrentPeriodStart,
+			PeriodEnd:      sub.CurrentPeriodEnd,
+			ReferencePoint: types.ReferencePointPeriodStart,
+		}, paymentParams, types.InvoiceFlowRenewal, false)
+		if err != nil {
+			return err
+		}
+		if updatedSub != nil {
+			*sub = *updatedSub
+		}
+		invoice = inv
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordExperimentConversion(ctx, s.ServiceParams, sub)
+	return invoice, nil
+}
+
+// hasPaymentMethod returns true if the invoices of the subscription can be collected at the end of
+// its trial. Subscriptions invoiced for manual payment do not need a payment method.
+func (s *subscriptionService) hasPaymentMethod(ctx context.Context, sub *subscription.Subscription) bool {
+	if lo.FromPtr(sub.GatewayPaymentMethodID) != "" {
+		return true
+	}
+	if types.CollectionMethod(sub.CollectionMethod) == types.CollectionMethodSendInvoice {
+		return true
+	}
+	if s.IntegrationFactory == nil {
+		return false
+	}
+
+	stripeIntegration, err := s.IntegrationFactory.GetStripeIntegration(ctx)
+	if err != nil {
+		s.Logger.Debugw("stripe integration not available, assuming no payment method",
+			"error", err,
+			"subscription_id", sub.ID)
+		return false
+	}
+
+	hasPaymentMethod, err := stripeIntegration.PaymentSvc.HasSavedPaymentMethods(ctx, sub.GetInvoicingCustomerID(), NewCustomerService(s.ServiceParams))
+	if err != nil {
+		s.Logger.Warnw("failed to check saved payment methods",
+			"error", err,
+			"subscription_id", sub.ID,
+			"invoicing_customer_id", sub.GetInvoicingCustomerID())
+		return false
+	}
+	return hasPaymentMethod
+}
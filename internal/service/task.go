@@ -153,6 +153,11 @@ func isValidStatusTransition(from, to types.TaskStatus) bool {
 		types.TaskStatusProcessing: {
 			types.TaskStatusCompleted,
 			types.TaskStatusFailed,
+			types.TaskStatusPaused,
+		},
+		types.TaskStatusPaused: {
+			types.TaskStatusProcessing,
+			types.TaskStatusFailed,
 		},
 		types.TaskStatusFailed: {
 			types.TaskStatusProcessing,
@@ -1198,39 +1203,16 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 		"bucket", bucket,
 		"key", key)
 
-	// Verify task has a scheduled task (export tasks must have connection credentials)
-	if t.ScheduledTaskID == "" {
-		return "", ierr.NewError("task has no scheduled_task_id").
-			WithHint("Cannot generate download URL for task without scheduled task").
-			WithReportableDetails(map[string]interface{}{
-				"task_id": id,
-			}).
-			Mark(ierr.ErrNotFound)
-	}
-
-	// Get the scheduled task to find the connection
-	scheduledTask, err := s.ScheduledTaskRepo.Get(ctx, t.ScheduledTaskID)
+	// Resolve the connection the file was exported with
+	connectionID, jobRegion, err := s.getTaskExportConnection(ctx, t)
 	if err != nil {
-		s.Logger.Errorw("failed to get scheduled task", "error", err, "scheduled_task_id", t.ScheduledTaskID)
-		return "", ierr.WithError(err).
-			WithHint("Failed to get scheduled task").
-			Mark(ierr.ErrNotFound)
-	}
-
-	if scheduledTask.ConnectionID == "" {
-		return "", ierr.NewError("scheduled task has no connection_id").
-			WithHint("Cannot generate download URL without connection").
-			WithReportableDetails(map[string]interface{}{
-				"task_id":           id,
-				"scheduled_task_id": t.ScheduledTaskID,
-			}).
-			Mark(ierr.ErrNotFound)
+		return "", err
 	}
 
 	// Get the connection to determine if it's Flexprice-managed
-	conn, err := s.ConnectionRepo.Get(ctx, scheduledTask.ConnectionID)
+	conn, err := s.ConnectionRepo.Get(ctx, connectionID)
 	if err != nil {
-		s.Logger.Errorw("failed to get connection", "error", err, "connection_id", scheduledTask.ConnectionID)
+		s.Logger.Errorw("failed to get connection", "error", err, "connection_id", connectionID)
 		return "", ierr.WithError(err).
 			WithHint("Failed to get connection").
 			Mark(ierr.ErrNotFound)
@@ -1258,7 +1240,7 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 	}
 
 	s.Logger.Debugw("generating presigned URL",
-		"connection_id", scheduledTask.ConnectionID,
+		"connection_id", connectionID,
 		"is_flexprice_managed", isFlexpriceManaged)
 
 	// Get the S3 integration which handles credential decryption
@@ -1276,9 +1258,9 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 		// For Flexprice-managed, use the region from config
 		region = s.Config.FlexpriceS3Exports.Region
 	} else {
-		// For customer-owned, use the region from scheduled task's job config
-		if scheduledTask.JobConfig == nil || scheduledTask.JobConfig.Region == "" {
-			return "", ierr.NewError("scheduled task job config region not configured").
+		// For customer-owned, use the region from the export job config
+		if jobRegion == "" {
+			return "", ierr.NewError("export job config region not configured").
 				WithHint("S3 region is required in job config for customer-owned exports").
 				WithReportableDetails(map[string]interface{}{
 					"task_id":           id,
@@ -1286,7 +1268,7 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 				}).
 				Mark(ierr.ErrValidation)
 		}
-		region = scheduledTask.JobConfig.Region
+		region = jobRegion
 	}
 
 	// Create job config with the bucket and region
@@ -1296,7 +1278,7 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 	}
 
 	// Get S3 client configured with connection-specific credentials
-	s3Client, _, err := s3Integration.GetS3Client(ctx, jobConfig, scheduledTask.ConnectionID)
+	s3Client, _, err := s3Integration.GetS3Client(ctx, jobConfig, connectionID)
 	if err != nil {
 		s.Logger.Errorw("failed to get S3 client with connection credentials", "error", err)
 		return "", ierr.WithError(err).
@@ -1322,8 +1304,52 @@ func (s *taskService) GenerateDownloadURL(ctx context.Context, id string) (strin
 
 	s.Logger.Infow("successfully generated presigned URL",
 		"task_id", id,
-		"connection_id", scheduledTask.ConnectionID,
+		"connection_id", connectionID,
 		"is_flexprice_managed", isFlexpriceManaged)
 
 	return result.URL, nil
 }
+
+// getTaskExportConnection returns the connection ID and job config region of the S3 export a task
+// file was written to. Scheduled exports keep them on the scheduled task while ad-hoc tasks such as
+// pricing simulations store them in the task metadata.
+func (s *taskService) getTaskExportConnection(ctx context.Context, t *task.Task) (string, string, error) {
+	if t.ScheduledTaskID == "" {
+		connectionID, _ := t.Metadata["connection_id"].(string)
+		region, _ := t.Metadata["region"].(string)
+		if connectionID == "" {
+			return "", "", ierr.NewError("task has no export connection").
+				WithHint("Cannot generate download URL for task without scheduled task or connection").
+				WithReportableDetails(map[string]interface{}{
+					"task_id": t.ID,
+				}).
+				Mark(ierr.ErrNotFound)
+		}
+		return connectionID, region, nil
+	}
+
+	// Get the scheduled task to find the connection
+	scheduledTask, err := s.ScheduledTaskRepo.Get(ctx, t.ScheduledTaskID)
+	if err != nil {
+		s.Logger.Errorw("failed to get scheduled task", "error", err, "scheduled_task_id", t.ScheduledTaskID)
+		return "", "", ierr.WithError(err).
+			WithHint("Failed to get scheduled task").
+			Mark(ierr.ErrNotFound)
+	}
+
+	if scheduledTask.ConnectionID == "" {
+		return "", "", ierr.NewError("scheduled task has no connection_id").
+			WithHint("Cannot generate download URL without connection").
+			WithReportableDetails(map[string]interface{}{
+				"task_id":           t.ID,
+				"scheduled_task_id": t.ScheduledTaskID,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+
+	var region string
+	if scheduledTask.JobConfig != nil {
+		region = scheduledTask.JobConfig.Region
+	}
+	return scheduledTask.ConnectionID, region, nil
+}
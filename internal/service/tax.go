@@ -920,6 +920,7 @@ func (s *taxService) ApplyTaxesOnInvoice(ctx context.Context, inv *invoice.Invoi
 	}
 	totalTaxAmount := decimal.Zero
 	taxAppliedRecords := make([]*dto.TaxAppliedResponse, 0, len(taxRates))
+	rounding := GetRoundingConfig(s.ServiceParams, ctx)
 
 	// Process each tax rate
 	for _, taxRate := range taxRates {
@@ -929,7 +930,7 @@ func (s *taxService) ApplyTaxesOnInvoice(ctx context.Context, inv *invoice.Invoi
 		}
 
 		// Round each tax amount immediately at source to ensure currency precision
-		roundedTaxAmount := types.RoundToCurrencyPrecision(lo.FromPtr(taxAmount), inv.Currency)
+		roundedTaxAmount := rounding.RoundAmount(lo.FromPtr(taxAmount), inv.Currency)
 		totalTaxAmount = totalTaxAmount.Add(roundedTaxAmount)
 
 		taxAppliedRecord, err := s.processTaxApplication(ctx, inv, taxRate, taxableAmount, roundedTaxAmount)
@@ -641,6 +641,9 @@ func (s *walletService) TopUpWallet(ctx context.Context, walletID string, req *d
 		return nil, err
 	}
 
+	// A top-up restores the usage of spend limited subscriptions right away
+	s.checkSpendLimits(ctx, w.CustomerID)
+
 	// Get the wallet transaction by idempotency key
 	tx, err := s.WalletRepo.GetTransactionByIdempotencyKey(ctx, idempotencyKey)
 	if err != nil {
@@ -1028,9 +1031,23 @@ func (s *walletService) completePurchasedCreditTransaction(ctx context.Context,
 		)
 	}
 
+	// A paid top-up restores the usage of spend limited subscriptions right away
+	s.checkSpendLimits(ctx, w.CustomerID)
+
 	return nil
 }
 
+// checkSpendLimits limits or restores the subscriptions of the customer after a balance change.
+// Failures are logged so they never fail the wallet operation.
+func (s *walletService) checkSpendLimits(ctx context.Context, customerID string) {
+	if err := NewSpendLimitService(s.ServiceParams).CheckSpendLimits(ctx, customerID); err != nil {
+		s.Logger.Errorw("failed to check spend limits",
+			"error", err,
+			"customer_id", customerID,
+		)
+	}
+}
+
 // logCreditBalanceAlert logs a credit balance alert for a wallet after a balance change
 func (s *walletService) logCreditBalanceAlert(ctx context.Context, w *wallet.Wallet, newCreditBalance decimal.Decimal) error {
 	// Check credit balance alerts after wallet operation
@@ -1749,7 +1766,7 @@ func (s *walletService) shouldSkipCreditExpiryDueToActiveSubscriptionOrInvoice(c
 	subFilter := types.NewSubscriptionFilter()
 	subFilter.CustomerID = tx.CustomerID
 	subFilter.Limit = lo.ToPtr(1)
-	subFilter.SubscriptionStatus = []types.SubscriptionStatus{types.SubscriptionStatusActive}
+	subFilter.SubscriptionStatus = []types.SubscriptionStatus{types.SubscriptionStatusActive, types.SubscriptionStatusPastDue}
 	subFilter.TimeRangeFilter = &types.TimeRangeFilter{
 		EndTime: lo.ToPtr(time.Now().UTC()),
 	}
@@ -2708,6 +2725,11 @@ func (s *walletService) CheckWalletBalanceAlert(ctx context.Context, req *wallet
 		ServiceParams: s.ServiceParams,
 	}
 
+	// Real-time balances of the wallets, used to enforce the spend limit of the customer. The spend
+	// limit is only enforced once the balance of every wallet is known.
+	balances := make([]*dto.WalletBalanceResponse, 0, len(wallets))
+	balancesComplete := true
+
 	// Process each wallet
 	for _, w := range wallets {
 		s.Logger.Debugw("processing wallet for alert check",
@@ -2724,8 +2746,10 @@ func (s *walletService) CheckWalletBalanceAlert(ctx context.Context, req *wallet
 				"wallet_id", w.ID,
 				"event_id", req.ID,
 			)
+			balancesComplete = false
 			continue
 		}
+		balances = append(balances, balance)
 
 		// Skip if alerts are disabled for this wallet
 		if !w.AlertEnabled {
@@ -2981,6 +3005,21 @@ func (s *walletService) CheckWalletBalanceAlert(ctx context.Context, req *wallet
 			continue
 		}
 	}
+
+	// Limit or restore the usage of the customer's subscriptions from the same balances
+	if !balancesComplete {
+		s.Logger.Warnw("wallet balances incomplete, leaving spend limits unchanged",
+			"customer_id", req.CustomerID,
+			"event_id", req.ID,
+		)
+	} else if err := NewSpendLimitService(s.ServiceParams).EnforceSpendLimits(ctx, req.CustomerID, balances); err != nil {
+		s.Logger.Errorw("failed to enforce spend limits",
+			"error", err,
+			"customer_id", req.CustomerID,
+			"event_id", req.ID,
+		)
+	}
+
 	s.Logger.Infow("completed wallet balance alert check for customer",
 		"customer_id", req.CustomerID,
 		"wallets_processed", len(wallets),
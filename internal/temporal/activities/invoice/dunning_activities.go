@@ -0,0 +1,104 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/logger"
+	"github.com/flexprice/flexprice/internal/service"
+	invoiceModels "github.com/flexprice/flexprice/internal/temporal/models/invoice"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// DunningActivities contains the activities of the invoice dunning workflow
+type DunningActivities struct {
+	dunningService service.DunningService
+	logger         *logger.Logger
+}
+
+// NewDunningActivities creates a new DunningActivities instance
+func NewDunningActivities(dunningService service.DunningService, logger *logger.Logger) *DunningActivities {
+	return &DunningActivities{
+		dunningService: dunningService,
+		logger:         logger,
+	}
+}
+
+// TriggerDunningActivity starts the dunning workflow of an invoice whose payment attempt failed
+func (a *DunningActivities) TriggerDunningActivity(
+	ctx context.Context,
+	input invoiceModels.TriggerDunningActivityInput,
+) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	// Set context values
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	if err := a.dunningService.TriggerDunning(ctx, input.InvoiceID); err != nil {
+		a.logger.Errorw("failed to trigger invoice dunning",
+			"invoice_id", input.InvoiceID,
+			"error", err)
+		return err
+	}
+	return nil
+}
+
+// StartDunningActivity marks the subscription of the invoice past due and returns the dunning policy to run
+func (a *DunningActivities) StartDunningActivity(
+	ctx context.Context,
+	input invoiceModels.DunningActivityInput,
+) (*invoiceModels.StartDunningActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = setDunningActivityContext(ctx, input)
+
+	return a.dunningService.StartDunning(ctx, input.InvoiceID)
+}
+
+// RunDunningStepActivity retries the payment of the invoice and notifies the customer when it is still unpaid
+func (a *DunningActivities) RunDunningStepActivity(
+	ctx context.Context,
+	input invoiceModels.RunDunningStepActivityInput,
+) (*invoiceModels.DunningActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = setDunningActivityContext(ctx, input.DunningActivityInput)
+
+	outcome, err := a.dunningService.RunDunningStep(ctx, input.InvoiceID, input.StepNumber, input.Step)
+	if err != nil {
+		return nil, err
+	}
+	return &invoiceModels.DunningActivityOutput{Outcome: outcome}, nil
+}
+
+// FinishDunningActivity applies the final action of the dunning policy to the subscription of the invoice
+func (a *DunningActivities) FinishDunningActivity(
+	ctx context.Context,
+	input invoiceModels.FinishDunningActivityInput,
+) (*invoiceModels.DunningActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = setDunningActivityContext(ctx, input.DunningActivityInput)
+
+	outcome, err := a.dunningService.FinishDunning(ctx, input.InvoiceID, input.FinalAction)
+	if err != nil {
+		return nil, err
+	}
+	return &invoiceModels.DunningActivityOutput{Outcome: outcome}, nil
+}
+
+func setDunningActivityContext(ctx context.Context, input invoiceModels.DunningActivityInput) context.Context {
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+	return ctx
+}
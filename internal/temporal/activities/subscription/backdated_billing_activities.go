@@ -0,0 +1,37 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// BackdatedBillingActivities contains the activities of the backdated subscription billing workflow
+type BackdatedBillingActivities struct {
+	subscriptionService service.SubscriptionService
+}
+
+// NewBackdatedBillingActivities creates a new BackdatedBillingActivities instance
+func NewBackdatedBillingActivities(subscriptionService service.SubscriptionService) *BackdatedBillingActivities {
+	return &BackdatedBillingActivities{
+		subscriptionService: subscriptionService,
+	}
+}
+
+// CompleteBackdatedBillingActivity hands the backdated subscription back to the billing cron
+func (a *BackdatedBillingActivities) CompleteBackdatedBillingActivity(
+	ctx context.Context,
+	input subscriptionModels.CompleteBackdatedBillingActivityInput,
+) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	return a.subscriptionService.CompleteBackdatedBilling(ctx, input.SubscriptionID)
+}
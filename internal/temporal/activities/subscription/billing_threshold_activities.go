@@ -0,0 +1,47 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// BillingThresholdActivities contains the activities of the subscription billing threshold workflow
+type BillingThresholdActivities struct {
+	invoiceService service.InvoiceService
+}
+
+// NewBillingThresholdActivities creates a new BillingThresholdActivities instance
+func NewBillingThresholdActivities(invoiceService service.InvoiceService) *BillingThresholdActivities {
+	return &BillingThresholdActivities{
+		invoiceService: invoiceService,
+	}
+}
+
+// CheckBillingThresholdActivity creates the threshold invoice of the subscription when the usage
+// accrued in its current period exceeds its billing threshold
+func (a *BillingThresholdActivities) CheckBillingThresholdActivity(
+	ctx context.Context,
+	input subscriptionModels.CheckBillingThresholdActivityInput,
+) (*subscriptionModels.CheckBillingThresholdActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	inv, err := a.invoiceService.CreateSubscriptionThresholdInvoice(ctx, input.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &subscriptionModels.CheckBillingThresholdActivityOutput{}
+	if inv != nil {
+		output.InvoiceID = inv.ID
+	}
+	return output, nil
+}
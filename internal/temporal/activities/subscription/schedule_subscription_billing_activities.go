@@ -59,10 +59,13 @@ func (s *SubscriptionActivities) ScheduleBillingActivity(ctx context.Context, in
 				Offset: lo.ToPtr(offset),
 				Status: lo.ToPtr(types.StatusPublished),
 			},
-			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive},
+			// Paused subscriptions are included to resume them and to bill the fixed fees of pauses that keep billing them
+			SubscriptionStatus: []types.SubscriptionStatus{types.SubscriptionStatusActive, types.SubscriptionStatusPastDue, types.SubscriptionStatusPaused},
 			TimeRangeFilter: &types.TimeRangeFilter{
 				EndTime: &now,
 			},
+			// The missed periods of backdated subscriptions are billed by their backdated billing workflow
+			ExcludeBackdatedBillingPending: true,
 		}
 
 		subs, err := s.subscriptionService.ListAllTenantSubscriptions(ctx, filter)
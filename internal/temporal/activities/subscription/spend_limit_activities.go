@@ -0,0 +1,38 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SpendLimitActivities contains the activities of the spend limit workflow
+type SpendLimitActivities struct {
+	spendLimitService service.SpendLimitService
+}
+
+// NewSpendLimitActivities creates a new SpendLimitActivities instance
+func NewSpendLimitActivities(spendLimitService service.SpendLimitService) *SpendLimitActivities {
+	return &SpendLimitActivities{
+		spendLimitService: spendLimitService,
+	}
+}
+
+// CheckSpendLimitsActivity limits or restores the subscriptions of the customer from the
+// real-time balances of the customer's prepaid wallets
+func (a *SpendLimitActivities) CheckSpendLimitsActivity(
+	ctx context.Context,
+	input subscriptionModels.CheckSpendLimitsActivityInput,
+) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	return a.spendLimitService.CheckSpendLimits(ctx, input.CustomerID)
+}
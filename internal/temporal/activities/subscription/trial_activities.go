@@ -0,0 +1,91 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/service"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// TrialActivities contains the activities of the subscription trial workflow
+type TrialActivities struct {
+	subscriptionService service.SubscriptionService
+}
+
+// NewTrialActivities creates a new TrialActivities instance
+func NewTrialActivities(subscriptionService service.SubscriptionService) *TrialActivities {
+	return &TrialActivities{
+		subscriptionService: subscriptionService,
+	}
+}
+
+// GetTrialStateActivity reads the trial of the subscription and checks its usage caps
+func (a *TrialActivities) GetTrialStateActivity(
+	ctx context.Context,
+	input subscriptionModels.SubscriptionTrialActivityInput,
+) (*subscriptionModels.GetTrialStateActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = setTrialActivityContext(ctx, input)
+
+	state, err := a.subscriptionService.GetTrialState(ctx, input.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &subscriptionModels.GetTrialStateActivityOutput{
+		IsTrialing:      state.IsTrialing,
+		ReminderAt:      state.ReminderAt,
+		HasUsageCaps:    state.HasUsageCaps,
+		UsageCapReached: state.UsageCapReached,
+	}
+	if state.TrialEnd != nil {
+		output.TrialEnd = *state.TrialEnd
+	}
+	return output, nil
+}
+
+// SendTrialReminderActivity publishes the trial will end webhook of the subscription
+func (a *TrialActivities) SendTrialReminderActivity(
+	ctx context.Context,
+	input subscriptionModels.SubscriptionTrialActivityInput,
+) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	ctx = setTrialActivityContext(ctx, input)
+
+	return a.subscriptionService.SendTrialReminder(ctx, input.SubscriptionID)
+}
+
+// EndTrialActivity ends the trial of the subscription with its trial end action
+func (a *TrialActivities) EndTrialActivity(
+	ctx context.Context,
+	input subscriptionModels.SubscriptionTrialActivityInput,
+) (*subscriptionModels.EndTrialActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = setTrialActivityContext(ctx, input)
+
+	resp, err := a.subscriptionService.EndTrial(ctx, input.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionModels.EndTrialActivityOutput{
+		SubscriptionStatus: string(resp.SubscriptionStatus),
+	}, nil
+}
+
+func setTrialActivityContext(ctx context.Context, input subscriptionModels.SubscriptionTrialActivityInput) context.Context {
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+	return ctx
+}
@@ -64,6 +64,35 @@ func (s *BillingActivities) CheckDraftSubscriptionActivity(
 	}, nil
 }
 
+// CheckPauseActivity activates the scheduled pause of the subscription once it is due and resumes
+// the subscription once its pause has ended
+func (s *BillingActivities) CheckPauseActivity(
+	ctx context.Context,
+	input subscriptionModels.CheckSubscriptionPauseStatusActivityInput,
+) (*subscriptionModels.CheckSubscriptionPauseStatusActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Set context values
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	result, err := s.subscriptionService.ProcessSubscriptionPause(ctx, input.SubscriptionID, input.CurrentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionModels.CheckSubscriptionPauseStatusActivityOutput{
+		ShouldSkipProcessing: result.SkipBilling,
+		IsPaused:             result.IsPaused,
+		WasResumed:           result.WasResumed,
+		UpdatedPeriodEnd:     lo.ToPtr(result.CurrentPeriodEnd),
+		PauseID:              result.PauseID,
+	}, nil
+}
+
 // CalculatePeriodsActivity calculates billing periods from the current period up to the specified time
 func (s *BillingActivities) CalculatePeriodsActivity(
 	ctx context.Context,
@@ -110,20 +139,17 @@ func (s *BillingActivities) CreateDraftInvoicesActivity(
 
 	subscriptionService := service.NewSubscriptionService(s.serviceParams)
 
-	invoices := make([]string, 0)
-	for _, period := range input.Periods {
-		invoice, err := subscriptionService.CreateDraftInvoiceForSubscription(ctx, input.SubscriptionID, period)
-		if err != nil {
-			return nil, err
-		}
-		// Skip nil invoices (zero-amount invoices)
-		if invoice != nil {
-			invoices = append(invoices, invoice.ID)
-		}
+	// Zero-amount invoices are not created, missed periods of backdated subscriptions can be
+	// billed on a single catch-up invoice
+	invoices, err := subscriptionService.CreateDraftInvoicesForPeriods(ctx, input.SubscriptionID, input.Periods)
+	if err != nil {
+		return nil, err
 	}
 
 	return &subscriptionModels.CreateInvoicesActivityOutput{
-		InvoiceIDs: invoices,
+		InvoiceIDs: lo.Map(invoices, func(inv *dto.InvoiceResponse, _ int) string {
+			return inv.ID
+		}),
 	}, nil
 }
 
@@ -305,6 +331,36 @@ func (s *BillingActivities) CheckCancellationActivity(
 	}, nil
 }
 
+// ProcessTermRenewalActivity renews the contract term of a subscription whose term ended, or
+// schedules the cancellation of a subscription that does not auto-renew at the end of its term
+func (s *BillingActivities) ProcessTermRenewalActivity(
+	ctx context.Context,
+	input subscriptionModels.ProcessTermRenewalActivityInput,
+) (*subscriptionModels.ProcessTermRenewalActivityOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Set context values
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+	ctx = types.SetUserID(ctx, input.UserID)
+
+	result, err := s.subscriptionService.ProcessTermRenewal(ctx, input.SubscriptionID)
+	if err != nil {
+		s.logger.Errorw("failed to process subscription term renewal",
+			"subscription_id", input.SubscriptionID,
+			"error", err)
+		return nil, err
+	}
+
+	return &subscriptionModels.ProcessTermRenewalActivityOutput{
+		SubscriptionID:      result.SubscriptionID,
+		Renewed:             result.Renewed,
+		NonRenewalScheduled: result.NonRenewalScheduled,
+	}, nil
+}
+
 // ProcessPendingPlanChangesActivity processes any pending plan change schedules for a subscription
 func (s *BillingActivities) ProcessPendingPlanChangesActivity(
 	ctx context.Context,
@@ -328,8 +384,8 @@ func (s *BillingActivities) ProcessPendingPlanChangesActivity(
 		return nil, err
 	}
 
-	// Only process if subscription is active
-	if sub.SubscriptionStatus != types.SubscriptionStatusActive {
+	// Only process if subscription is active, past due subscriptions are still active while dunned
+	if sub.SubscriptionStatus != types.SubscriptionStatusActive && sub.SubscriptionStatus != types.SubscriptionStatusPastDue {
 		s.logger.Infow("subscription not active, skipping plan change processing",
 			"subscription_id", sub.ID,
 			"status", sub.SubscriptionStatus)
@@ -0,0 +1,62 @@
+package activities
+
+import (
+	"context"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// PricingSimulationActivities contains the pricing simulation activities
+type PricingSimulationActivities struct {
+	simulationService service.PricingSimulationService
+	taskService       service.TaskService
+}
+
+// NewPricingSimulationActivities creates a new PricingSimulationActivities instance
+func NewPricingSimulationActivities(simulationService service.PricingSimulationService, taskService service.TaskService) *PricingSimulationActivities {
+	return &PricingSimulationActivities{
+		simulationService: simulationService,
+		taskService:       taskService,
+	}
+}
+
+// RunPricingSimulation runs the pricing simulation of a task
+func (a *PricingSimulationActivities) RunPricingSimulation(ctx context.Context, input models.ProcessTaskActivityInput) (*models.ProcessTaskActivityResult, error) {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Set context values using centralized utilities
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+
+	if err := a.simulationService.RunPricingSimulation(ctx, input.TaskID); err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to run pricing simulation").
+			WithReportableDetails(map[string]interface{}{
+				"task_id": input.TaskID,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	// Get the updated task to return results
+	task, err := a.taskService.GetTask(ctx, input.TaskID)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to get updated task").
+			Mark(ierr.ErrValidation)
+	}
+
+	return &models.ProcessTaskActivityResult{
+		TaskID:            task.ID,
+		ProcessedRecords:  task.ProcessedRecords,
+		SuccessfulRecords: task.SuccessfulRecords,
+		FailedRecords:     task.FailedRecords,
+		ErrorSummary:      task.ErrorSummary,
+		Metadata:          task.Metadata,
+	}, nil
+}
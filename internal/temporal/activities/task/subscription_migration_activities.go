@@ -0,0 +1,103 @@
+package activities
+
+import (
+	"context"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/service"
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// SubscriptionMigrationActivities contains the bulk subscription migration activities
+type SubscriptionMigrationActivities struct {
+	migrationService service.SubscriptionMigrationService
+}
+
+// NewSubscriptionMigrationActivities creates a new SubscriptionMigrationActivities instance
+func NewSubscriptionMigrationActivities(migrationService service.SubscriptionMigrationService) *SubscriptionMigrationActivities {
+	return &SubscriptionMigrationActivities{
+		migrationService: migrationService,
+	}
+}
+
+// StartSubscriptionMigration selects the subscriptions of a migration task
+func (a *SubscriptionMigrationActivities) StartSubscriptionMigration(ctx context.Context, input models.ProcessTaskActivityInput) error {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	// Set context values using centralized utilities
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+
+	if err := a.migrationService.StartSubscriptionMigration(ctx, input.TaskID); err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to start subscription migration").
+			WithReportableDetails(map[string]interface{}{
+				"task_id": input.TaskID,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return nil
+}
+
+// ProcessSubscriptionMigrationBatch migrates the next batch of subscriptions of a migration task
+func (a *SubscriptionMigrationActivities) ProcessSubscriptionMigrationBatch(ctx context.Context, input models.ProcessTaskActivityInput) (*models.SubscriptionMigrationBatchActivityResult, error) {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Set context values using centralized utilities
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+
+	done, err := a.migrationService.ProcessSubscriptionMigrationBatch(ctx, input.TaskID)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to process subscription migration batch").
+			WithReportableDetails(map[string]interface{}{
+				"task_id": input.TaskID,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return &models.SubscriptionMigrationBatchActivityResult{
+		TaskID: input.TaskID,
+		Done:   done,
+	}, nil
+}
+
+// UpdateSubscriptionMigrationStatus marks a migration task as paused, processing or failed
+func (a *SubscriptionMigrationActivities) UpdateSubscriptionMigrationStatus(ctx context.Context, input models.SubscriptionMigrationStatusActivityInput) error {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	// Set context values using centralized utilities
+	ctx = types.SetTenantID(ctx, input.TenantID)
+	ctx = types.SetEnvironmentID(ctx, input.EnvironmentID)
+
+	var err error
+	if input.Status == types.TaskStatusFailed {
+		err = a.migrationService.FailSubscriptionMigration(ctx, input.TaskID, lo.FromPtr(input.ErrorSummary))
+	} else {
+		err = a.migrationService.UpdateSubscriptionMigrationStatus(ctx, input.TaskID, input.Status)
+	}
+	if err != nil {
+		return ierr.WithError(err).
+			WithHint("Failed to update subscription migration status").
+			WithReportableDetails(map[string]interface{}{
+				"task_id": input.TaskID,
+				"status":  input.Status,
+			}).
+			Mark(ierr.ErrInternal)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package invoice
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// ===================== Invoice Dunning Workflow Models =====================
+
+// InvoiceDunningWorkflowInput represents the input for the workflow dunning a failed invoice
+type InvoiceDunningWorkflowInput struct {
+	InvoiceID     string `json:"invoice_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+}
+
+// Validate validates the invoice dunning workflow input
+func (i *InvoiceDunningWorkflowInput) Validate() error {
+	if i.InvoiceID == "" {
+		return ierr.NewError("invoice_id is required").
+			WithHint("Invoice ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ActivityInput returns the input of the dunning activities of the workflow
+func (i *InvoiceDunningWorkflowInput) ActivityInput() DunningActivityInput {
+	return DunningActivityInput{
+		InvoiceID:     i.InvoiceID,
+		TenantID:      i.TenantID,
+		EnvironmentID: i.EnvironmentID,
+		UserID:        i.UserID,
+	}
+}
+
+// InvoiceDunningWorkflowResult represents the result of the invoice dunning workflow
+type InvoiceDunningWorkflowResult struct {
+	InvoiceID   string               `json:"invoice_id"`
+	Outcome     types.DunningOutcome `json:"outcome"`
+	CompletedAt time.Time            `json:"completed_at"`
+}
+
+// DunningActivityInput represents the input of the dunning activities
+type DunningActivityInput struct {
+	InvoiceID     string `json:"invoice_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+}
+
+// Validate validates the dunning activity input
+func (i *DunningActivityInput) Validate() error {
+	if i.InvoiceID == "" {
+		return ierr.NewError("invoice_id is required").
+			WithHint("Invoice ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// StartDunningActivityOutput represents the dunning policy the workflow runs. The policy is read
+// once when the dunning starts, later changes to the policy apply to new failed invoices only.
+type StartDunningActivityOutput struct {
+	Outcome     types.DunningOutcome     `json:"outcome"`
+	Steps       []types.DunningStep      `json:"steps,omitempty"`
+	FinalAction types.DunningFinalAction `json:"final_action,omitempty"`
+}
+
+// RunDunningStepActivityInput represents the input for running a step of the dunning policy
+type RunDunningStepActivityInput struct {
+	DunningActivityInput
+	StepNumber int               `json:"step_number"`
+	Step       types.DunningStep `json:"step"`
+}
+
+// Validate validates the run dunning step activity input
+func (i *RunDunningStepActivityInput) Validate() error {
+	if err := i.DunningActivityInput.Validate(); err != nil {
+		return err
+	}
+	if i.StepNumber <= 0 {
+		return ierr.NewError("step_number must be greater than 0").
+			WithHint("Dunning steps are numbered from 1").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// FinishDunningActivityInput represents the input for applying the final action of the dunning policy
+type FinishDunningActivityInput struct {
+	DunningActivityInput
+	FinalAction types.DunningFinalAction `json:"final_action"`
+}
+
+// Validate validates the finish dunning activity input
+func (i *FinishDunningActivityInput) Validate() error {
+	if err := i.DunningActivityInput.Validate(); err != nil {
+		return err
+	}
+	return i.FinalAction.Validate()
+}
+
+// DunningActivityOutput represents the state of the dunning after a step or the final action
+type DunningActivityOutput struct {
+	Outcome types.DunningOutcome `json:"outcome"`
+}
+
+// TriggerDunningActivityInput represents the input for starting the dunning of an invoice after
+// its payment attempt
+type TriggerDunningActivityInput struct {
+	InvoiceID     string `json:"invoice_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+}
+
+// Validate validates the trigger dunning activity input
+func (i *TriggerDunningActivityInput) Validate() error {
+	if i.InvoiceID == "" {
+		return ierr.NewError("invoice_id is required").
+			WithHint("Invoice ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
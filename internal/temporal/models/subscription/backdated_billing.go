@@ -0,0 +1,115 @@
+package subscription
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// BackdatedSubscriptionBillingWorkflowInput represents the input for the workflow billing the missed
+// periods of a backdated subscription
+type BackdatedSubscriptionBillingWorkflowInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+
+	// ExternalCustomerID is the external ID of the customer whose events are reprocessed,
+	// no events are reprocessed when it is empty
+	ExternalCustomerID string    `json:"external_customer_id,omitempty"`
+	ReprocessStartDate time.Time `json:"reprocess_start_date"`
+	ReprocessEndDate   time.Time `json:"reprocess_end_date"`
+	ReprocessBatchSize int       `json:"reprocess_batch_size"`
+
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+// Validate validates the backdated subscription billing workflow input
+func (i *BackdatedSubscriptionBillingWorkflowInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.ExternalCustomerID != "" && (i.ReprocessStartDate.IsZero() || i.ReprocessEndDate.IsZero()) {
+		return ierr.NewError("reprocess_start_date and reprocess_end_date are required").
+			WithHint("Reprocess dates are required to reprocess the events of the customer").
+			Mark(ierr.ErrValidation)
+	}
+	if i.PeriodStart.IsZero() || i.PeriodEnd.IsZero() {
+		return ierr.NewError("period_start and period_end are required").
+			WithHint("Period Start and Period End are required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// BillingWorkflowInput returns the input of the billing workflow of the subscription
+func (i *BackdatedSubscriptionBillingWorkflowInput) BillingWorkflowInput() ProcessSubscriptionBillingWorkflowInput {
+	return ProcessSubscriptionBillingWorkflowInput{
+		SubscriptionID: i.SubscriptionID,
+		TenantID:       i.TenantID,
+		EnvironmentID:  i.EnvironmentID,
+		UserID:         i.UserID,
+		PeriodStart:    i.PeriodStart,
+		PeriodEnd:      i.PeriodEnd,
+	}
+}
+
+// ActivityInput returns the input of the activity completing the backdated billing
+func (i *BackdatedSubscriptionBillingWorkflowInput) ActivityInput() CompleteBackdatedBillingActivityInput {
+	return CompleteBackdatedBillingActivityInput{
+		SubscriptionID: i.SubscriptionID,
+		TenantID:       i.TenantID,
+		EnvironmentID:  i.EnvironmentID,
+		UserID:         i.UserID,
+	}
+}
+
+// BackdatedSubscriptionBillingWorkflowResult represents the result of the backdated subscription billing workflow
+type BackdatedSubscriptionBillingWorkflowResult struct {
+	SubscriptionID string    `json:"subscription_id"`
+	Reprocessed    bool      `json:"reprocessed"`
+	Billed         bool      `json:"billed"`
+	CompletedAt    time.Time `json:"completed_at"`
+}
+
+// CompleteBackdatedBillingActivityInput represents the input of the activity handing a backdated
+// subscription back to the billing cron
+type CompleteBackdatedBillingActivityInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+}
+
+// Validate validates the complete backdated billing activity input
+func (i *CompleteBackdatedBillingActivityInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package subscription
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// SubscriptionThresholdWorkflowInput represents the input for the workflow checking the
+// billing threshold of a subscription after usage was tracked for it
+type SubscriptionThresholdWorkflowInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+
+	// Delay is how long the workflow waits before the check, so the usage tracked in the
+	// meantime is checked at once
+	Delay time.Duration `json:"delay"`
+}
+
+// Validate validates the subscription threshold workflow input
+func (i *SubscriptionThresholdWorkflowInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.Delay < 0 {
+		return ierr.NewError("delay must not be negative").
+			WithHint("Delay must not be negative").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ActivityInput returns the input of the billing threshold activity of the workflow
+func (i *SubscriptionThresholdWorkflowInput) ActivityInput() CheckBillingThresholdActivityInput {
+	return CheckBillingThresholdActivityInput{
+		SubscriptionID: i.SubscriptionID,
+		TenantID:       i.TenantID,
+		EnvironmentID:  i.EnvironmentID,
+		UserID:         i.UserID,
+	}
+}
+
+// SubscriptionThresholdWorkflowResult represents the result of the subscription threshold workflow
+type SubscriptionThresholdWorkflowResult struct {
+	SubscriptionID string    `json:"subscription_id"`
+	InvoiceID      string    `json:"invoice_id,omitempty"`
+	CompletedAt    time.Time `json:"completed_at"`
+}
+
+// CheckBillingThresholdActivityInput represents the input of the billing threshold activity
+type CheckBillingThresholdActivityInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+}
+
+// Validate validates the billing threshold activity input
+func (i *CheckBillingThresholdActivityInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CheckBillingThresholdActivityOutput represents the outcome of the billing threshold check
+type CheckBillingThresholdActivityOutput struct {
+	// InvoiceID is the threshold invoice that was created, empty when the threshold was not reached
+	InvoiceID string `json:"invoice_id,omitempty"`
+}
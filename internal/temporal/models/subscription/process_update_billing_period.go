@@ -98,6 +98,7 @@ type CheckSubscriptionPauseStatusActivityInput struct {
 	SubscriptionID string    `json:"subscription_id"`
 	TenantID       string    `json:"tenant_id"`
 	EnvironmentID  string    `json:"environment_id"`
+	UserID         string    `json:"user_id"`
 	CurrentTime    time.Time `json:"current_time"`
 }
 
@@ -390,3 +391,39 @@ type ProcessPendingPlanChangesActivityOutput struct {
 	Success    bool `json:"success"`
 	WasChanged bool `json:"was_changed"`
 }
+
+// ProcessTermRenewalActivityInput represents the input for processing the term renewal of a subscription
+type ProcessTermRenewalActivityInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+}
+
+// Validate validates the process term renewal activity input
+func (i *ProcessTermRenewalActivityInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ProcessTermRenewalActivityOutput represents the output for processing the term renewal of a subscription
+type ProcessTermRenewalActivityOutput struct {
+	// SubscriptionID is the subscription after the renewal, a new subscription when it renewed to a different plan
+	SubscriptionID      string `json:"subscription_id"`
+	Renewed             bool   `json:"renewed"`
+	NonRenewalScheduled bool   `json:"non_renewal_scheduled"`
+}
@@ -0,0 +1,89 @@
+package subscription
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// SpendLimitWorkflowInput represents the input for the workflow checking the spend limit of a
+// customer after usage was tracked for it
+type SpendLimitWorkflowInput struct {
+	CustomerID    string `json:"customer_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+
+	// Delay is how long the workflow waits before the check, so the usage tracked in the
+	// meantime is checked at once
+	Delay time.Duration `json:"delay"`
+}
+
+// Validate validates the spend limit workflow input
+func (i *SpendLimitWorkflowInput) Validate() error {
+	if i.CustomerID == "" {
+		return ierr.NewError("customer_id is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.Delay < 0 {
+		return ierr.NewError("delay must not be negative").
+			WithHint("Delay must not be negative").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ActivityInput returns the input of the spend limit activity of the workflow
+func (i *SpendLimitWorkflowInput) ActivityInput() CheckSpendLimitsActivityInput {
+	return CheckSpendLimitsActivityInput{
+		CustomerID:    i.CustomerID,
+		TenantID:      i.TenantID,
+		EnvironmentID: i.EnvironmentID,
+		UserID:        i.UserID,
+	}
+}
+
+// SpendLimitWorkflowResult represents the result of the spend limit workflow
+type SpendLimitWorkflowResult struct {
+	CustomerID  string    `json:"customer_id"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// CheckSpendLimitsActivityInput represents the input of the spend limit activity
+type CheckSpendLimitsActivityInput struct {
+	CustomerID    string `json:"customer_id"`
+	TenantID      string `json:"tenant_id"`
+	EnvironmentID string `json:"environment_id"`
+	UserID        string `json:"user_id"`
+}
+
+// Validate validates the spend limit activity input
+func (i *CheckSpendLimitsActivityInput) Validate() error {
+	if i.CustomerID == "" {
+		return ierr.NewError("customer_id is required").
+			WithHint("Customer ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package subscription
+
+import (
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+)
+
+// SubscriptionTrialWorkflowInput represents the input for the workflow driving the trial of a subscription
+type SubscriptionTrialWorkflowInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+
+	// ReminderSentFor is the trial end the trial will end reminder was sent for, carried over
+	// when the workflow continues as new
+	ReminderSentFor *time.Time `json:"reminder_sent_for,omitempty"`
+}
+
+// Validate validates the subscription trial workflow input
+func (i *SubscriptionTrialWorkflowInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ActivityInput returns the input of the trial activities of the workflow
+func (i *SubscriptionTrialWorkflowInput) ActivityInput() SubscriptionTrialActivityInput {
+	return SubscriptionTrialActivityInput{
+		SubscriptionID: i.SubscriptionID,
+		TenantID:       i.TenantID,
+		EnvironmentID:  i.EnvironmentID,
+		UserID:         i.UserID,
+	}
+}
+
+// SubscriptionTrialWorkflowResult represents the result of the subscription trial workflow
+type SubscriptionTrialWorkflowResult struct {
+	SubscriptionID string    `json:"subscription_id"`
+	Outcome        string    `json:"outcome"`
+	CompletedAt    time.Time `json:"completed_at"`
+}
+
+// SubscriptionTrialActivityInput represents the input of the subscription trial activities
+type SubscriptionTrialActivityInput struct {
+	SubscriptionID string `json:"subscription_id"`
+	TenantID       string `json:"tenant_id"`
+	EnvironmentID  string `json:"environment_id"`
+	UserID         string `json:"user_id"`
+}
+
+// Validate validates the subscription trial activity input
+func (i *SubscriptionTrialActivityInput) Validate() error {
+	if i.SubscriptionID == "" {
+		return ierr.NewError("subscription_id is required").
+			WithHint("Subscription ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.TenantID == "" {
+		return ierr.NewError("tenant_id is required").
+			WithHint("Tenant ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	if i.EnvironmentID == "" {
+		return ierr.NewError("environment_id is required").
+			WithHint("Environment ID is required").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GetTrialStateActivityOutput represents the trial of a subscription as read by the workflow
+type GetTrialStateActivityOutput struct {
+	IsTrialing      bool       `json:"is_trialing"`
+	TrialEnd        time.Time  `json:"trial_end"`
+	ReminderAt      *time.Time `json:"reminder_at,omitempty"`
+	HasUsageCaps    bool       `json:"has_usage_caps"`
+	UsageCapReached bool       `json:"usage_cap_reached"`
+}
+
+// EndTrialActivityOutput represents the outcome of ending the trial of a subscription
+type EndTrialActivityOutput struct {
+	SubscriptionStatus string `json:"subscription_status"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+)
+
+// SubscriptionMigrationStatusActivityInput represents the input for the activity that pauses,
+// resumes or fails a subscription migration task
+type SubscriptionMigrationStatusActivityInput struct {
+	ProcessTaskActivityInput
+	Status types.TaskStatus `json:"status"`
+	// ErrorSummary is the reason a failed migration stopped
+	ErrorSummary *string `json:"error_summary,omitempty"`
+}
+
+// Validate validates the subscription migration status activity input
+func (i *SubscriptionMigrationStatusActivityInput) Validate() error {
+	if err := i.ProcessTaskActivityInput.Validate(); err != nil {
+		return err
+	}
+	if i.Status != types.TaskStatusPaused && i.Status != types.TaskStatusProcessing && i.Status != types.TaskStatusFailed {
+		return ierr.NewError("status must be PAUSED, PROCESSING or FAILED").
+			WithHint("A subscription migration can only be paused, resumed or failed").
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// SubscriptionMigrationBatchActivityResult represents the result of the activity that migrates a
+// batch of subscriptions
+type SubscriptionMigrationBatchActivityResult struct {
+	TaskID string `json:"task_id"`
+	// Done is true once every subscription of the migration has been processed
+	Done bool `json:"done"`
+}
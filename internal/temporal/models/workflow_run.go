@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/client"
 )
 
@@ -56,6 +57,8 @@ type StartWorkflowOptions struct {
 	WorkflowRunTimeout time.Duration
 	// WorkflowTaskTimeout is the timeout for workflow task processing
 	WorkflowTaskTimeout time.Duration
+	// WorkflowIDReusePolicy decides whether a workflow ID of a closed workflow can be started again
+	WorkflowIDReusePolicy enums.WorkflowIdReusePolicy
 }
 
 // ToSDKOptions converts StartWorkflowOptions to Temporal SDK client.StartWorkflowOptions
@@ -66,6 +69,7 @@ func (o *StartWorkflowOptions) ToSDKOptions() client.StartWorkflowOptions {
 		WorkflowExecutionTimeout: o.WorkflowExecutionTimeout,
 		WorkflowRunTimeout:       o.WorkflowRunTimeout,
 		WorkflowTaskTimeout:      o.WorkflowTaskTimeout,
+		WorkflowIDReusePolicy:    o.WorkflowIDReusePolicy,
 	}
 }
 
@@ -49,6 +49,8 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 	prepareEventsActivities := prepareProcessedEventsActivities.NewPrepareProcessedEventsActivities(params)
 
 	taskService := service.NewTaskService(params)
+	pricingSimulationActivities := taskActivities.NewPricingSimulationActivities(service.NewPricingSimulationService(params), taskService)
+	subscriptionMigrationActivities := taskActivities.NewSubscriptionMigrationActivities(service.NewSubscriptionMigrationService(params))
 	taskActivities := taskActivities.NewTaskActivities(taskService)
 
 	// QuickBooks price sync activities
@@ -102,11 +104,21 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 		params.Logger,
 	)
 
+	trialActivities := subscriptionActivities.NewTrialActivities(subscriptionService)
+	billingThresholdActivities := subscriptionActivities.NewBillingThresholdActivities(service.NewInvoiceService(params))
+	backdatedBillingActivities := subscriptionActivities.NewBackdatedBillingActivities(subscriptionService)
+	spendLimitActivities := subscriptionActivities.NewSpendLimitActivities(service.NewSpendLimitService(params))
+
 	invoiceActs := invoiceActivities.NewInvoiceActivities(
 		params,
 		params.Logger,
 	)
 
+	dunningActivities := invoiceActivities.NewDunningActivities(
+		service.NewDunningService(params),
+		params.Logger,
+	)
+
 	hubspotQuoteSyncActivities := hubspotActivities.NewQuoteSyncActivities(
 		params.IntegrationFactory,
 		params.Logger,
@@ -147,7 +159,7 @@ func RegisterWorkflowsAndActivities(temporalService temporalService.TemporalServ
 
 	// Get all task queues and register workflows/activities for each
 	for _, taskQueue := range types.GetAllTaskQueues() {
-		config := buildWorkerConfig(taskQueue, workflowTrackingActivities, planActivities, prepareEventsActivities, taskActivities, taskActivity, scheduledTaskActivity, exportActivity, hubspotDealSyncActivities, hubspotInvoiceSyncActivities, hubspotQuoteSyncActivities, qbPriceSyncActivities, nomodInvoiceSyncActivities, moyasarInvoiceSyncActivities, customerActivities, scheduleBillingActivities, billingActivities, invoiceActs, reprocessEventsActivities, reprocessRawEventsActivities)
+		config := buildWorkerConfig(taskQueue, workflowTrackingActivities, planActivities, prepareEventsActivities, taskActivities, taskActivity, scheduledTaskActivity, exportActivity, hubspotDealSyncActivities, hubspotInvoiceSyncActivities, hubspotQuoteSyncActivities, qbPriceSyncActivities, nomodInvoiceSyncActivities, moyasarInvoiceSyncActivities, customerActivities, scheduleBillingActivities, billingActivities, trialActivities, billingThresholdActivities, backdatedBillingActivities, spendLimitActivities, invoiceActs, reprocessEventsActivities, reprocessRawEventsActivities, pricingSimulationActivities, subscriptionMigrationActivities, dunningActivities)
 		if err := registerWorker(temporalService, config); err != nil {
 			return fmt.Errorf("failed to register worker for task queue %s: %w", taskQueue, err)
 		}
@@ -175,9 +187,16 @@ func buildWorkerConfig(
 	customerActivities *customerActivities.CustomerActivities,
 	scheduleBillingActivities *subscriptionActivities.SubscriptionActivities,
 	billingActivities *subscriptionActivities.BillingActivities,
+	trialActivities *subscriptionActivities.TrialActivities,
+	billingThresholdActivities *subscriptionActivities.BillingThresholdActivities,
+	backdatedBillingActivities *subscriptionActivities.BackdatedBillingActivities,
+	spendLimitActivities *subscriptionActivities.SpendLimitActivities,
 	invoiceActs *invoiceActivities.InvoiceActivities,
 	reprocessEventsActivities *eventsActivities.ReprocessEventsActivities,
 	reprocessRawEventsActivities *eventsActivities.ReprocessRawEventsActivities,
+	pricingSimulationActivities *taskActivities.PricingSimulationActivities,
+	subscriptionMigrationActivities *taskActivities.SubscriptionMigrationActivities,
+	dunningActivities *invoiceActivities.DunningActivities,
 ) WorkerConfig {
 	workflowsList := []interface{}{}
 	// Add tracking activity to all task queues
@@ -195,9 +214,15 @@ func buildWorkerConfig(
 			workflows.HubSpotQuoteSyncWorkflow,
 			workflows.NomodInvoiceSyncWorkflow,
 			workflows.MoyasarInvoiceSyncWorkflow,
+			workflows.PricingSimulationWorkflow,
+			workflows.SubscriptionMigrationWorkflow,
 		)
 		activitiesList = append(activitiesList,
 			taskActivities.ProcessTask,
+			pricingSimulationActivities.RunPricingSimulation,
+			subscriptionMigrationActivities.StartSubscriptionMigration,
+			subscriptionMigrationActivities.ProcessSubscriptionMigrationBatch,
+			subscriptionMigrationActivities.UpdateSubscriptionMigrationStatus,
 			hubspotDealSyncActivities.CreateLineItems,
 			hubspotDealSyncActivities.UpdateDealAmount,
 			hubspotInvoiceSyncActivities.SyncInvoiceToHubSpot,
@@ -234,30 +259,52 @@ func buildWorkerConfig(
 			workflowsList,
 			subscriptionWorkflows.ScheduleSubscriptionBillingWorkflow,
 			subscriptionWorkflows.ProcessSubscriptionBillingWorkflow,
+			subscriptionWorkflows.SubscriptionTrialWorkflow,
+			subscriptionWorkflows.SubscriptionThresholdWorkflow,
+			subscriptionWorkflows.BackdatedSubscriptionBillingWorkflow,
+			subscriptionWorkflows.SpendLimitWorkflow,
 		)
 		activitiesList = append(activitiesList,
 			// Schedule billing activities
 			scheduleBillingActivities.ScheduleBillingActivity,
 			// Subscription billing period activities
 			billingActivities.CheckDraftSubscriptionActivity,
+			billingActivities.CheckPauseActivity,
 			billingActivities.CalculatePeriodsActivity,
 			billingActivities.CreateDraftInvoicesActivity,
 			billingActivities.UpdateCurrentPeriodActivity,
 			billingActivities.CheckCancellationActivity,
+			billingActivities.ProcessTermRenewalActivity,
 			billingActivities.ProcessPendingPlanChangesActivity,
 			billingActivities.TriggerInvoiceWorkflowActivity,
+			// Subscription trial activities
+			trialActivities.GetTrialStateActivity,
+			trialActivities.SendTrialReminderActivity,
+			trialActivities.EndTrialActivity,
+			// Subscription billing threshold activities
+			billingThresholdActivities.CheckBillingThresholdActivity,
+			// Backdated subscription billing activities
+			backdatedBillingActivities.CompleteBackdatedBillingActivity,
+			// Spend limit activities
+			spendLimitActivities.CheckSpendLimitsActivity,
 		)
 
 	case types.TemporalTaskQueueInvoice:
 		workflowsList = append(
 			workflowsList,
 			invoiceWorkflows.ProcessInvoiceWorkflow,
+			invoiceWorkflows.InvoiceDunningWorkflow,
 		)
 		activitiesList = append(activitiesList,
 			// Invoice workflow activities
 			invoiceActs.FinalizeInvoiceActivity,
 			invoiceActs.SyncInvoiceToVendorActivity,
 			invoiceActs.AttemptInvoicePaymentActivity,
+			// Invoice dunning activities
+			dunningActivities.TriggerDunningActivity,
+			dunningActivities.StartDunningActivity,
+			dunningActivities.RunDunningStepActivity,
+			dunningActivities.FinishDunningActivity,
 		)
 
 	case types.TemporalTaskQueueWorkflows:
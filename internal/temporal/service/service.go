@@ -17,6 +17,7 @@ import (
 	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
 	"github.com/flexprice/flexprice/internal/temporal/worker"
 	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/interceptor"
 )
 
@@ -371,6 +372,20 @@ func (s *temporalService) ExecuteWorkflow(ctx context.Context, workflowType type
 		TaskQueue: workflowType.TaskQueueName(),
 	}
 
+	// Workflows with a fixed ID run once per context at a time
+	if contextID := s.extractWorkflowContextID(workflowType, input); contextID != "" {
+		switch workflowType {
+		case types.TemporalInvoiceDunningWorkflow:
+			// Dunning runs once per invoice, a second start for the invoice is rejected by Temporal
+			options.ID = types.GenerateFixedWorkflowID(workflowType.String(), contextID)
+			options.WorkflowIDReusePolicy = enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+		case types.TemporalSpendLimitWorkflow:
+			// A start while the check of the customer is pending returns that check, which covers
+			// the usage tracked until it runs
+			options.ID = types.GenerateFixedWorkflowID(workflowType.String(), contextID)
+		}
+	}
+
 	// Execute workflow using existing StartWorkflow method
 	return s.StartWorkflow(ctx, options, workflowType, input)
 }
@@ -392,11 +407,36 @@ func (s *temporalService) extractWorkflowContextID(workflowType types.TemporalWo
 		if input, ok := params.(subscriptionModels.ProcessSubscriptionBillingWorkflowInput); ok {
 			return input.SubscriptionID
 		}
+	case types.TemporalSubscriptionTrialWorkflow:
+		// Extract subscription ID from SubscriptionTrialWorkflowInput
+		if input, ok := params.(subscriptionModels.SubscriptionTrialWorkflowInput); ok {
+			return input.SubscriptionID
+		}
+	case types.TemporalSubscriptionThresholdWorkflow:
+		// Extract subscription ID from SubscriptionThresholdWorkflowInput
+		if input, ok := params.(subscriptionModels.SubscriptionThresholdWorkflowInput); ok {
+			return input.SubscriptionID
+		}
+	case types.TemporalSpendLimitWorkflow:
+		// Extract customer ID from SpendLimitWorkflowInput
+		if input, ok := params.(subscriptionModels.SpendLimitWorkflowInput); ok {
+			return input.CustomerID
+		}
+	case types.TemporalBackdatedBillingWorkflow:
+		// Extract subscription ID from BackdatedSubscriptionBillingWorkflowInput
+		if input, ok := params.(subscriptionModels.BackdatedSubscriptionBillingWorkflowInput); ok {
+			return input.SubscriptionID
+		}
 	case types.TemporalProcessInvoiceWorkflow:
 		// Extract invoice ID from ProcessInvoiceWorkflowInput
 		if input, ok := params.(invoiceModels.ProcessInvoiceWorkflowInput); ok {
 			return input.InvoiceID
 		}
+	case types.TemporalInvoiceDunningWorkflow:
+		// Extract invoice ID from InvoiceDunningWorkflowInput
+		if input, ok := params.(invoiceModels.InvoiceDunningWorkflowInput); ok {
+			return input.InvoiceID
+		}
 	case types.TemporalPrepareProcessedEventsWorkflow:
 		// Extract event ID from PrepareProcessedEventsWorkflowInput
 		if input, ok := params.(*models.PrepareProcessedEventsWorkflowInput); ok {
@@ -477,7 +517,7 @@ func (s *temporalService) buildWorkflowInput(ctx context.Context, workflowType t
 		return s.buildPriceSyncInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalQuickBooksPriceSyncWorkflow:
 		return s.buildQuickBooksPriceSyncInput(ctx, tenantID, environmentID, userID, params)
-	case types.TemporalTaskProcessingWorkflow:
+	case types.TemporalTaskProcessingWorkflow, types.TemporalPricingSimulationWorkflow, types.TemporalSubscriptionMigrationWorkflow:
 		return s.buildTaskProcessingInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalHubSpotDealSyncWorkflow:
 		return s.buildHubSpotDealSyncInput(ctx, tenantID, environmentID, params)
@@ -487,6 +527,14 @@ func (s *temporalService) buildWorkflowInput(ctx context.Context, workflowType t
 		return s.buildScheduleSubscriptionBillingWorkflowInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalProcessSubscriptionBillingWorkflow:
 		return s.buildProcessSubscriptionBillingWorkflowInput(ctx, tenantID, environmentID, userID, params)
+	case types.TemporalSubscriptionTrialWorkflow:
+		return s.buildSubscriptionTrialWorkflowInput(ctx, tenantID, environmentID, userID, params)
+	case types.TemporalSubscriptionThresholdWorkflow:
+		return s.buildSubscriptionThresholdWorkflowInput(ctx, tenantID, environmentID, userID, params)
+	case types.TemporalBackdatedBillingWorkflow:
+		return s.buildBackdatedSubscriptionBillingWorkflowInput(ctx, tenantID, environmentID, userID, params)
+	case types.TemporalSpendLimitWorkflow:
+		return s.buildSpendLimitWorkflowInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalHubSpotQuoteSyncWorkflow:
 		return s.buildHubSpotQuoteSyncInput(ctx, tenantID, environmentID, params)
 	case types.TemporalNomodInvoiceSyncWorkflow:
@@ -499,6 +547,8 @@ func (s *temporalService) buildWorkflowInput(ctx context.Context, workflowType t
 		return s.buildPrepareProcessedEventsInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalProcessInvoiceWorkflow:
 		return s.buildProcessInvoiceInput(ctx, tenantID, environmentID, params)
+	case types.TemporalInvoiceDunningWorkflow:
+		return s.buildInvoiceDunningInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalReprocessEventsWorkflow:
 		return s.buildReprocessEventsInput(ctx, tenantID, environmentID, userID, params)
 	case types.TemporalReprocessRawEventsWorkflow:
@@ -756,6 +806,29 @@ func (s *temporalService) buildProcessInvoiceInput(_ context.Context, tenantID,
 		Mark(errors.ErrValidation)
 }
 
+// buildInvoiceDunningInput builds input for invoice dunning workflow
+func (s *temporalService) buildInvoiceDunningInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
+	var input invoiceModels.InvoiceDunningWorkflowInput
+	switch p := params.(type) {
+	case invoiceModels.InvoiceDunningWorkflowInput:
+		input = p
+	case string:
+		input = invoiceModels.InvoiceDunningWorkflowInput{InvoiceID: p}
+	default:
+		return nil, errors.NewError("invalid input for invoice dunning workflow").
+			WithHint("Provide InvoiceDunningWorkflowInput or invoice ID").
+			Mark(errors.ErrValidation)
+	}
+
+	input.TenantID = tenantID
+	input.EnvironmentID = environmentID
+	input.UserID = userID
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
 // buildPrepareProcessedEventsInput builds input for prepare processed events workflow
 func (s *temporalService) buildPrepareProcessedEventsInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
 	// If already correct type, just ensure context is set
@@ -933,6 +1006,93 @@ func (s *temporalService) buildProcessSubscriptionBillingWorkflowInput(_ context
 		Mark(errors.ErrValidation)
 }
 
+// buildSubscriptionTrialWorkflowInput builds input for subscription trial workflow
+func (s *temporalService) buildSubscriptionTrialWorkflowInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
+	var input subscriptionModels.SubscriptionTrialWorkflowInput
+	switch p := params.(type) {
+	case subscriptionModels.SubscriptionTrialWorkflowInput:
+		input = p
+	case string:
+		input = subscriptionModels.SubscriptionTrialWorkflowInput{SubscriptionID: p}
+	default:
+		return nil, errors.NewError("invalid input for subscription trial workflow").
+			WithHint("Provide SubscriptionTrialWorkflowInput or subscription ID").
+			Mark(errors.ErrValidation)
+	}
+
+	input.TenantID = tenantID
+	input.EnvironmentID = environmentID
+	input.UserID = userID
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// buildBackdatedSubscriptionBillingWorkflowInput builds input for backdated subscription billing workflow
+func (s *temporalService) buildBackdatedSubscriptionBillingWorkflowInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
+	input, ok := params.(subscriptionModels.BackdatedSubscriptionBillingWorkflowInput)
+	if !ok {
+		return nil, errors.NewError("invalid input for backdated subscription billing workflow").
+			WithHint("Provide BackdatedSubscriptionBillingWorkflowInput").
+			Mark(errors.ErrValidation)
+	}
+
+	input.TenantID = tenantID
+	input.EnvironmentID = environmentID
+	input.UserID = userID
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// buildSubscriptionThresholdWorkflowInput builds input for subscription threshold workflow
+func (s *temporalService) buildSubscriptionThresholdWorkflowInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
+	var input subscriptionModels.SubscriptionThresholdWorkflowInput
+	switch p := params.(type) {
+	case subscriptionModels.SubscriptionThresholdWorkflowInput:
+		input = p
+	case string:
+		input = subscriptionModels.SubscriptionThresholdWorkflowInput{SubscriptionID: p}
+	default:
+		return nil, errors.NewError("invalid input for subscription threshold workflow").
+			WithHint("Provide SubscriptionThresholdWorkflowInput or subscription ID").
+			Mark(errors.ErrValidation)
+	}
+
+	input.TenantID = tenantID
+	input.EnvironmentID = environmentID
+	input.UserID = userID
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// buildSpendLimitWorkflowInput builds input for spend limit workflow
+func (s *temporalService) buildSpendLimitWorkflowInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
+	var input subscriptionModels.SpendLimitWorkflowInput
+	switch p := params.(type) {
+	case subscriptionModels.SpendLimitWorkflowInput:
+		input = p
+	case string:
+		input = subscriptionModels.SpendLimitWorkflowInput{CustomerID: p}
+	default:
+		return nil, errors.NewError("invalid input for spend limit workflow").
+			WithHint("Provide SpendLimitWorkflowInput or customer ID").
+			Mark(errors.ErrValidation)
+	}
+
+	input.TenantID = tenantID
+	input.EnvironmentID = environmentID
+	input.UserID = userID
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
 // buildReprocessEventsInput builds input for reprocess events workflow
 func (s *temporalService) buildReprocessEventsInput(_ context.Context, tenantID, environmentID, userID string, params interface{}) (interface{}, error) {
 	// If already correct type, just ensure context is set
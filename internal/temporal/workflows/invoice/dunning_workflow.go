@@ -0,0 +1,112 @@
+package invoice
+
+import (
+	"time"
+
+	invoiceModels "github.com/flexprice/flexprice/internal/temporal/models/invoice"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowInvoiceDunning = "InvoiceDunningWorkflow"
+	// Activity names - must match the registered method names
+	ActivityTriggerDunning = "TriggerDunningActivity"
+	ActivityStartDunning   = "StartDunningActivity"
+	ActivityRunDunningStep = "RunDunningStepActivity"
+	ActivityFinishDunning  = "FinishDunningActivity"
+)
+
+// InvoiceDunningWorkflow duns a single invoice whose payment failed with durable timers:
+// 1. Mark the subscription past due and read the dunning policy
+// 2. Sleep until each step is due, retry the payment and notify the customer
+// 3. Apply the final action of the policy when the invoice is still unpaid after the last step
+//
+// The invoice is read again at every step, so invoices paid or voided in the meantime end the
+// dunning without a signal.
+func InvoiceDunningWorkflow(
+	ctx workflow.Context,
+	input invoiceModels.InvoiceDunningWorkflowInput,
+) (*invoiceModels.InvoiceDunningWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting invoice dunning workflow",
+		"invoice_id", input.InvoiceID,
+		"tenant_id", input.TenantID,
+		"environment_id", input.EnvironmentID)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return nil, err
+	}
+
+	// Payments are only retried by the steps of the policy, a failed activity is an infrastructure error
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    3,
+		},
+	})
+
+	activityInput := input.ActivityInput()
+	result := func(outcome types.DunningOutcome) *invoiceModels.InvoiceDunningWorkflowResult {
+		logger.Info("Invoice dunning completed", "invoice_id", input.InvoiceID, "outcome", outcome)
+		return &invoiceModels.InvoiceDunningWorkflowResult{
+			InvoiceID:   input.InvoiceID,
+			Outcome:     outcome,
+			CompletedAt: workflow.Now(ctx),
+		}
+	}
+
+	failedAt := workflow.Now(ctx)
+
+	var policy invoiceModels.StartDunningActivityOutput
+	if err := workflow.ExecuteActivity(ctx, ActivityStartDunning, activityInput).Get(ctx, &policy); err != nil {
+		logger.Error("Failed to start dunning", "error", err, "invoice_id", input.InvoiceID)
+		return nil, err
+	}
+	if policy.Outcome.IsFinal() {
+		return result(policy.Outcome), nil
+	}
+
+	for i, step := range policy.Steps {
+		dueAt := failedAt.Add(time.Duration(step.DaysAfterFailure) * 24 * time.Hour)
+		if wait := dueAt.Sub(workflow.Now(ctx)); wait > 0 {
+			logger.Info("Waiting for next dunning step",
+				"invoice_id", input.InvoiceID,
+				"step", i+1,
+				"due_at", dueAt)
+			if err := workflow.Sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		var output invoiceModels.DunningActivityOutput
+		if err := workflow.ExecuteActivity(ctx, ActivityRunDunningStep, invoiceModels.RunDunningStepActivityInput{
+			DunningActivityInput: activityInput,
+			StepNumber:           i + 1,
+			Step:                 step,
+		}).Get(ctx, &output); err != nil {
+			logger.Error("Failed to run dunning step", "error", err, "invoice_id", input.InvoiceID, "step", i+1)
+			return nil, err
+		}
+		if output.Outcome.IsFinal() {
+			return result(output.Outcome), nil
+		}
+	}
+
+	var output invoiceModels.DunningActivityOutput
+	if err := workflow.ExecuteActivity(ctx, ActivityFinishDunning, invoiceModels.FinishDunningActivityInput{
+		DunningActivityInput: activityInput,
+		FinalAction:          policy.FinalAction,
+	}).Get(ctx, &output); err != nil {
+		logger.Error("Failed to finish dunning", "error", err, "invoice_id", input.InvoiceID)
+		return nil, err
+	}
+
+	return result(output.Outcome), nil
+}
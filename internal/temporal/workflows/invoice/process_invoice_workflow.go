@@ -23,6 +23,7 @@ const (
 // 1. Finalize the invoice
 // 2. Sync invoice to external vendors
 // 3. Attempt payment for the invoice
+// 4. Start the dunning of the invoice if its payment failed
 func ProcessInvoiceWorkflow(
 	ctx workflow.Context,
 	input invoiceModels.ProcessInvoiceWorkflowInput,
@@ -114,6 +115,27 @@ func ProcessInvoiceWorkflow(
 		return nil, err
 	}
 
+	// ================================================================================
+	// STEP 4: Start Dunning
+	// ================================================================================
+	logger.Info("Step 4: Starting dunning if the payment failed",
+		"invoice_id", input.InvoiceID)
+
+	dunningInput := invoiceModels.TriggerDunningActivityInput{
+		InvoiceID:     input.InvoiceID,
+		TenantID:      input.TenantID,
+		EnvironmentID: input.EnvironmentID,
+		UserID:        input.UserID,
+	}
+
+	// The invoice is processed even if its dunning cannot be started
+	err = workflow.ExecuteActivity(ctx, ActivityTriggerDunning, dunningInput).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to start dunning for invoice",
+			"error", err,
+			"invoice_id", input.InvoiceID)
+	}
+
 	logger.Info("Successfully processed invoice",
 		"invoice_id", input.InvoiceID)
 
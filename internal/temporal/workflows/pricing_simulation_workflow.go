@@ -0,0 +1,75 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowPricingSimulation = "PricingSimulationWorkflow"
+	// Activity names - must match the registered method names
+	ActivityRunPricingSimulation = "RunPricingSimulation"
+)
+
+// PricingSimulationWorkflow replays historical usage against a candidate plan for a simulation task
+func PricingSimulationWorkflow(ctx workflow.Context, input models.TaskProcessingWorkflowInput) (*models.TaskProcessingWorkflowResult, error) {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting pricing simulation workflow", "task_id", input.TaskID)
+
+	// Simulations re-rate every billing period of every matching subscription, allow long runs
+	// but do not retry aggressively as every attempt starts from scratch
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 4,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 30,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 10,
+			MaximumAttempts:    2,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var result models.ProcessTaskActivityResult
+	activityInput := models.ProcessTaskActivityInput{
+		TaskID:        input.TaskID,
+		TenantID:      input.TenantID,
+		EnvironmentID: input.EnvironmentID,
+	}
+	err := workflow.ExecuteActivity(ctx, ActivityRunPricingSimulation, activityInput).Get(ctx, &result)
+
+	if err != nil {
+		logger.Error("Pricing simulation failed", "task_id", input.TaskID, "error", err)
+		errorMsg := err.Error()
+		return &models.TaskProcessingWorkflowResult{
+			TaskID:       input.TaskID,
+			Status:       "failed",
+			CompletedAt:  workflow.Now(ctx),
+			ErrorSummary: &errorMsg,
+		}, nil
+	}
+
+	logger.Info("Pricing simulation completed successfully",
+		"task_id", input.TaskID,
+		"successful_records", result.SuccessfulRecords,
+		"failed_records", result.FailedRecords)
+
+	return &models.TaskProcessingWorkflowResult{
+		TaskID:            input.TaskID,
+		Status:            "completed",
+		ProcessedRecords:  result.ProcessedRecords,
+		SuccessfulRecords: result.SuccessfulRecords,
+		FailedRecords:     result.FailedRecords,
+		ErrorSummary:      result.ErrorSummary,
+		CompletedAt:       workflow.Now(ctx),
+		Metadata:          result.Metadata,
+	}, nil
+}
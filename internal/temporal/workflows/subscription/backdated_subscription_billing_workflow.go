@@ -0,0 +1,123 @@
+package subscription
+
+import (
+	"time"
+
+	eventsModels "github.com/flexprice/flexprice/internal/temporal/models/events"
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/temporal/searchattr"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowBackdatedSubscriptionBilling = "BackdatedSubscriptionBillingWorkflow"
+	// Activity names - must match the registered method names
+	ActivityCompleteBackdatedBilling = "CompleteBackdatedBillingActivity"
+)
+
+// BackdatedSubscriptionBillingWorkflow bills the missed periods of a backdated subscription:
+// 1. Reprocess the events of the customer since the start of the subscription as a child workflow
+// 2. Run the billing workflow of the subscription as a child workflow
+// 3. Hand the subscription back to the billing cron, which skips it until then
+//
+// The subscription is handed back to the billing cron whether billing succeeded or not, the cron
+// bills the periods the workflow missed.
+func BackdatedSubscriptionBillingWorkflow(
+	ctx workflow.Context,
+	input subscriptionModels.BackdatedSubscriptionBillingWorkflowInput,
+) (*subscriptionModels.BackdatedSubscriptionBillingWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting backdated subscription billing workflow",
+		"subscription_id", input.SubscriptionID,
+		"tenant_id", input.TenantID,
+		"environment_id", input.EnvironmentID)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return nil, err
+	}
+
+	searchattr.UpsertWorkflowSearchAttributes(ctx, map[string]interface{}{
+		searchattr.SearchAttributeSubscriptionID: input.SubscriptionID,
+		searchattr.SearchAttributeTenantID:       input.TenantID,
+		searchattr.SearchAttributeEnvironmentID:  input.EnvironmentID,
+	})
+
+	result := &subscriptionModels.BackdatedSubscriptionBillingWorkflowResult{
+		SubscriptionID: input.SubscriptionID,
+	}
+	billErr := billBackdatedSubscription(ctx, input, result)
+
+	// The subscription is handed back even when the workflow is cancelled
+	completeCtx, _ := workflow.NewDisconnectedContext(ctx)
+	completeCtx = workflow.WithActivityOptions(completeCtx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    10,
+		},
+	})
+	if err := workflow.ExecuteActivity(completeCtx, ActivityCompleteBackdatedBilling, input.ActivityInput()).Get(completeCtx, nil); err != nil {
+		logger.Error("Failed to complete backdated billing", "error", err, "subscription_id", input.SubscriptionID)
+		searchattr.UpsertFailureSearchAttributes(ctx, ActivityCompleteBackdatedBilling, err, input.SubscriptionID)
+		return nil, err
+	}
+
+	if billErr != nil {
+		return nil, billErr
+	}
+
+	result.CompletedAt = workflow.Now(ctx)
+	return result, nil
+}
+
+// billBackdatedSubscription reprocesses the events of the customer and then bills the subscription
+func billBackdatedSubscription(
+	ctx workflow.Context,
+	input subscriptionModels.BackdatedSubscriptionBillingWorkflowInput,
+	result *subscriptionModels.BackdatedSubscriptionBillingWorkflowResult,
+) error {
+	logger := workflow.GetLogger(ctx)
+
+	// Without an external ID the customer has no events to reprocess
+	if input.ExternalCustomerID != "" {
+		reprocessCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			TaskQueue: types.TemporalTaskQueueReprocessEvents.String(),
+		})
+		reprocessInput := eventsModels.ReprocessEventsWorkflowInput{
+			ExternalCustomerID: input.ExternalCustomerID,
+			StartDate:          input.ReprocessStartDate,
+			EndDate:            input.ReprocessEndDate,
+			BatchSize:          input.ReprocessBatchSize,
+			TenantID:           input.TenantID,
+			EnvironmentID:      input.EnvironmentID,
+			UserID:             input.UserID,
+		}
+		if err := workflow.ExecuteChildWorkflow(reprocessCtx, types.TemporalReprocessEventsWorkflow.String(), reprocessInput).Get(reprocessCtx, nil); err != nil {
+			logger.Error("Failed to reprocess events of backdated subscription, missed periods are left to the billing cron",
+				"error", err,
+				"subscription_id", input.SubscriptionID,
+				"external_customer_id", input.ExternalCustomerID)
+			return err
+		}
+		result.Reprocessed = true
+	}
+
+	billingCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: types.TemporalTaskQueueSubscription.String(),
+	})
+	if err := workflow.ExecuteChildWorkflow(billingCtx, ProcessSubscriptionBillingWorkflow, input.BillingWorkflowInput()).Get(billingCtx, nil); err != nil {
+		logger.Error("Failed to bill backdated subscription, missed periods are left to the billing cron",
+			"error", err,
+			"subscription_id", input.SubscriptionID)
+		return err
+	}
+	result.Billed = true
+
+	return nil
+}
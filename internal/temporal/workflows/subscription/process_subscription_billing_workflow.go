@@ -21,6 +21,7 @@ const (
 	ActivityUpdateCurrentPeriod    = "UpdateCurrentPeriodActivity"
 	ActivityCheckCancellation      = "CheckCancellationActivity"
 	ActivityProcessPlanChange      = "ProcessPendingPlanChangesActivity"
+	ActivityProcessTermRenewal     = "ProcessTermRenewalActivity"
 	// Activity from invoice package
 	ActivityTriggerInvoiceWorkflow = "TriggerInvoiceWorkflowActivity"
 )
@@ -28,9 +29,11 @@ const (
 // ProcessSubscriptionBillingWorkflow processes a subscription billing workflow
 // This workflow orchestrates the subscription billing period processing:
 // 1. Check if subscription is draft
+// 1a. Activate due pauses and resume ended ones, skip billing while all billing is paused
 // 2. Calculate billing periods up to current time
 // 3. For each period (except the last), create draft invoice
 // 4. Check for cancellation
+// 4a. Renew the contract term, or schedule the cancellation of subscriptions that do not auto-renew
 // 5. Process pending plan changes at period end
 // 6. Update subscription to new current period
 // 7. Trigger invoice workflows for processing (fire-and-forget)
@@ -104,6 +107,39 @@ func ProcessSubscriptionBillingWorkflow(
 		}, nil
 	}
 
+	// ================================================================================
+	// STEP 1a: Check subscription pause
+	// ================================================================================
+
+	logger.Info("Step 1a: Checking subscription pause",
+		"subscription_id", input.SubscriptionID)
+
+	var pauseOutput subscriptionModels.CheckSubscriptionPauseStatusActivityOutput
+	pauseInput := subscriptionModels.CheckSubscriptionPauseStatusActivityInput{
+		SubscriptionID: input.SubscriptionID,
+		TenantID:       input.TenantID,
+		EnvironmentID:  input.EnvironmentID,
+		UserID:         input.UserID,
+		CurrentTime:    now,
+	}
+	err = workflow.ExecuteActivity(ctx, ActivityCheckPause, pauseInput).Get(ctx, &pauseOutput)
+	if err != nil {
+		logger.Error("Failed to check subscription pause",
+			"error", err,
+			"subscription_id", input.SubscriptionID)
+		searchattr.UpsertFailureSearchAttributes(ctx, ActivityCheckPause, err, input.SubscriptionID)
+		return nil, err
+	}
+
+	if pauseOutput.ShouldSkipProcessing {
+		logger.Info("Subscription is paused, skipping period processing",
+			"subscription_id", input.SubscriptionID)
+		return &subscriptionModels.ProcessSubscriptionBillingWorkflowResult{
+			Success:     true,
+			CompletedAt: workflow.Now(ctx),
+		}, nil
+	}
+
 	// ================================================================================
 	// STEP 2: Calculate Billing Periods
 	// ================================================================================
@@ -151,6 +187,10 @@ func ProcessSubscriptionBillingWorkflow(
 
 	// Create invoice for completed periods (all except last)
 	// The last period becomes the new current period
+	// Subscriptions of customers with consolidated invoicing share the invoice created by the
+	// first subscription of their group, the others create no invoice
+	// The missed periods of backdated subscriptions with the catch_up billing mode are billed on a
+	// single catch-up invoice on the first run, which is triggered when the subscription is created
 	completedPeriods := periodsOutput.Periods[:len(periodsOutput.Periods)-1]
 	var createInvoicesOutput subscriptionModels.CreateInvoicesActivityOutput
 	createInvoicesInput := subscriptionModels.CreateInvoicesActivityInput{
@@ -226,9 +266,44 @@ func ProcessSubscriptionBillingWorkflow(
 	}
 
 	// ================================================================================
-	// STEP 6: Process Pending Plan Changes (only if subscription is still active)
+	// STEP 5a: Process Term Renewal (only if subscription is still active)
 	// ================================================================================
+	// Renewing to a different plan replaces the subscription, the pending plan changes of the
+	// replaced subscription are not processed
+	renewedToNewSubscription := false
 	if !cancelSubscriptionOutput.IsCancelled {
+		logger.Info("Step 5a: Processing term renewal",
+			"subscription_id", input.SubscriptionID)
+
+		var termRenewalOutput subscriptionModels.ProcessTermRenewalActivityOutput
+		termRenewalInput := subscriptionModels.ProcessTermRenewalActivityInput{
+			SubscriptionID: input.SubscriptionID,
+			TenantID:       input.TenantID,
+			EnvironmentID:  input.EnvironmentID,
+			UserID:         input.UserID,
+		}
+
+		err = workflow.ExecuteActivity(ctx, ActivityProcessTermRenewal, termRenewalInput).Get(ctx, &termRenewalOutput)
+		if err != nil {
+			// Log error but don't fail the workflow - the renewal is processed again by the next run
+			logger.Warn("Failed to process term renewal, but continuing",
+				"error", err,
+				"subscription_id", input.SubscriptionID)
+			searchattr.UpsertFailureSearchAttributes(ctx, ActivityProcessTermRenewal, err, input.SubscriptionID)
+		} else {
+			logger.Info("Processed term renewal",
+				"subscription_id", input.SubscriptionID,
+				"renewed", termRenewalOutput.Renewed,
+				"non_renewal_scheduled", termRenewalOutput.NonRenewalScheduled,
+				"renewed_subscription_id", termRenewalOutput.SubscriptionID)
+			renewedToNewSubscription = termRenewalOutput.SubscriptionID != input.SubscriptionID
+		}
+	}
+
+	// ================================================================================
+	// STEP 6: Process Pending Plan Changes (only if subscription is still active)
+	// ================================================================================
+	if !cancelSubscriptionOutput.IsCancelled && !renewedToNewSubscription {
 		logger.Info("Step 6: Processing pending plan changes",
 			"subscription_id", input.SubscriptionID)
 
@@ -253,7 +328,7 @@ func ProcessSubscriptionBillingWorkflow(
 				"was_changed", planChangeOutput.WasChanged)
 		}
 	} else {
-		logger.Info("Step 6: Skipping plan change processing (subscription is cancelled)",
+		logger.Info("Step 6: Skipping plan change processing (subscription is cancelled or replaced)",
 			"subscription_id", input.SubscriptionID)
 	}
 
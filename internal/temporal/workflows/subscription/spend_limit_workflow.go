@@ -0,0 +1,68 @@
+package subscription
+
+import (
+	"time"
+
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/temporal/searchattr"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowSpendLimit = "SpendLimitWorkflow"
+	// Activity names - must match the registered method names
+	ActivityCheckSpendLimits = "CheckSpendLimitsActivity"
+)
+
+// SpendLimitWorkflow checks the spend limit of a customer off the usage ingestion path:
+// 1. Wait for the delay so the usage tracked in the meantime is checked at once
+// 2. Limit or restore the subscriptions of the customer from the real-time prepaid balance
+func SpendLimitWorkflow(
+	ctx workflow.Context,
+	input subscriptionModels.SpendLimitWorkflowInput,
+) (*subscriptionModels.SpendLimitWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting spend limit workflow",
+		"customer_id", input.CustomerID,
+		"tenant_id", input.TenantID,
+		"environment_id", input.EnvironmentID)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return nil, err
+	}
+
+	searchattr.UpsertWorkflowSearchAttributes(ctx, map[string]interface{}{
+		searchattr.SearchAttributeTenantID:      input.TenantID,
+		searchattr.SearchAttributeEnvironmentID: input.EnvironmentID,
+	})
+
+	if input.Delay > 0 {
+		if err := workflow.Sleep(ctx, input.Delay); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    5,
+		},
+	})
+
+	if err := workflow.ExecuteActivity(ctx, ActivityCheckSpendLimits, input.ActivityInput()).Get(ctx, nil); err != nil {
+		logger.Error("Failed to check spend limits", "error", err, "customer_id", input.CustomerID)
+		searchattr.UpsertFailureSearchAttributes(ctx, ActivityCheckSpendLimits, err, "")
+		return nil, err
+	}
+
+	return &subscriptionModels.SpendLimitWorkflowResult{
+		CustomerID:  input.CustomerID,
+		CompletedAt: workflow.Now(ctx),
+	}, nil
+}
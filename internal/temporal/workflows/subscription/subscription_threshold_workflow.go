@@ -0,0 +1,72 @@
+package subscription
+
+import (
+	"time"
+
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/temporal/searchattr"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowSubscriptionThreshold = "SubscriptionThresholdWorkflow"
+	// Activity names - must match the registered method names
+	ActivityCheckBillingThreshold = "CheckBillingThresholdActivity"
+)
+
+// SubscriptionThresholdWorkflow checks the billing threshold of a subscription off the
+// usage ingestion path:
+// 1. Wait for the delay so the usage tracked in the meantime is checked at once
+// 2. Create the threshold invoice when the accrued usage exceeds the billing threshold
+func SubscriptionThresholdWorkflow(
+	ctx workflow.Context,
+	input subscriptionModels.SubscriptionThresholdWorkflowInput,
+) (*subscriptionModels.SubscriptionThresholdWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting subscription threshold workflow",
+		"subscription_id", input.SubscriptionID,
+		"tenant_id", input.TenantID,
+		"environment_id", input.EnvironmentID)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return nil, err
+	}
+
+	searchattr.UpsertWorkflowSearchAttributes(ctx, map[string]interface{}{
+		searchattr.SearchAttributeSubscriptionID: input.SubscriptionID,
+		searchattr.SearchAttributeTenantID:       input.TenantID,
+		searchattr.SearchAttributeEnvironmentID:  input.EnvironmentID,
+	})
+
+	if input.Delay > 0 {
+		if err := workflow.Sleep(ctx, input.Delay); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var output subscriptionModels.CheckBillingThresholdActivityOutput
+	if err := workflow.ExecuteActivity(ctx, ActivityCheckBillingThreshold, input.ActivityInput()).Get(ctx, &output); err != nil {
+		logger.Error("Failed to check billing threshold", "error", err, "subscription_id", input.SubscriptionID)
+		searchattr.UpsertFailureSearchAttributes(ctx, ActivityCheckBillingThreshold, err, input.SubscriptionID)
+		return nil, err
+	}
+
+	return &subscriptionModels.SubscriptionThresholdWorkflowResult{
+		SubscriptionID: input.SubscriptionID,
+		InvoiceID:      output.InvoiceID,
+		CompletedAt:    workflow.Now(ctx),
+	}, nil
+}
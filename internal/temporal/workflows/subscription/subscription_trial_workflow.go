@@ -0,0 +1,144 @@
+package subscription
+
+import (
+	"time"
+
+	subscriptionModels "github.com/flexprice/flexprice/internal/temporal/models/subscription"
+	"github.com/flexprice/flexprice/internal/temporal/searchattr"
+	"github.com/samber/lo"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowSubscriptionTrial = "SubscriptionTrialWorkflow"
+	// Activity names - must match the registered method names
+	ActivityGetTrialState     = "GetTrialStateActivity"
+	ActivitySendTrialReminder = "SendTrialReminderActivity"
+	ActivityEndTrial          = "EndTrialActivity"
+
+	// trialUsageCapCheckInterval is how often usage is checked against the trial usage caps
+	trialUsageCapCheckInterval = time.Hour
+	// trialWorkflowMaxIterations bounds the history of a run, the workflow continues as new after it
+	trialWorkflowMaxIterations = 500
+)
+
+// SubscriptionTrialWorkflow drives the trial of a subscription with durable timers:
+// 1. Read the trial of the subscription, stop if it is no longer trialing
+// 2. Send the trial will end reminder when it is due
+// 3. Sleep until the reminder, the end of the trial or the next usage cap check
+// 4. End the trial with its end action once it is over or a usage cap is reached
+//
+// The trial is read again every time the workflow wakes up, so extended trials and
+// subscriptions cancelled during the trial need no signal.
+func SubscriptionTrialWorkflow(
+	ctx workflow.Context,
+	input subscriptionModels.SubscriptionTrialWorkflowInput,
+) (*subscriptionModels.SubscriptionTrialWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting subscription trial workflow",
+		"subscription_id", input.SubscriptionID,
+		"tenant_id", input.TenantID,
+		"environment_id", input.EnvironmentID)
+
+	if err := input.Validate(); err != nil {
+		logger.Error("Invalid workflow input", "error", err)
+		return nil, err
+	}
+
+	searchattr.UpsertWorkflowSearchAttributes(ctx, map[string]interface{}{
+		searchattr.SearchAttributeSubscriptionID: input.SubscriptionID,
+		searchattr.SearchAttributeTenantID:       input.TenantID,
+		searchattr.SearchAttributeEnvironmentID:  input.EnvironmentID,
+	})
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    5,
+		},
+	})
+
+	activityInput := input.ActivityInput()
+	reminderSentFor := lo.FromPtr(input.ReminderSentFor)
+
+	for iteration := 0; ; iteration++ {
+		if iteration >= trialWorkflowMaxIterations {
+			input.ReminderSentFor = lo.ToPtr(reminderSentFor)
+			return nil, workflow.NewContinueAsNewError(ctx, SubscriptionTrialWorkflow, input)
+		}
+
+		var state subscriptionModels.GetTrialStateActivityOutput
+		if err := workflow.ExecuteActivity(ctx, ActivityGetTrialState, activityInput).Get(ctx, &state); err != nil {
+			logger.Error("Failed to get trial state", "error", err, "subscription_id", input.SubscriptionID)
+			searchattr.UpsertFailureSearchAttributes(ctx, ActivityGetTrialState, err, input.SubscriptionID)
+			return nil, err
+		}
+
+		if !state.IsTrialing {
+			logger.Info("Subscription is no longer trialing", "subscription_id", input.SubscriptionID)
+			return &subscriptionModels.SubscriptionTrialWorkflowResult{
+				SubscriptionID: input.SubscriptionID,
+				Outcome:        "not_trialing",
+				CompletedAt:    workflow.Now(ctx),
+			}, nil
+		}
+
+		now := workflow.Now(ctx)
+		if state.UsageCapReached || !now.Before(state.TrialEnd) {
+			logger.Info("Ending trial",
+				"subscription_id", input.SubscriptionID,
+				"trial_end", state.TrialEnd,
+				"usage_cap_reached", state.UsageCapReached)
+
+			var output subscriptionModels.EndTrialActivityOutput
+			if err := workflow.ExecuteActivity(ctx, ActivityEndTrial, activityInput).Get(ctx, &output); err != nil {
+				logger.Error("Failed to end trial", "error", err, "subscription_id", input.SubscriptionID)
+				searchattr.UpsertFailureSearchAttributes(ctx, ActivityEndTrial, err, input.SubscriptionID)
+				return nil, err
+			}
+
+			return &subscriptionModels.SubscriptionTrialWorkflowResult{
+				SubscriptionID: input.SubscriptionID,
+				Outcome:        output.SubscriptionStatus,
+				CompletedAt:    workflow.Now(ctx),
+			}, nil
+		}
+
+		// Reminders are sent once per trial end, an extended trial gets a new reminder
+		if state.ReminderAt != nil && !reminderSentFor.Equal(state.TrialEnd) && !now.Before(*state.ReminderAt) {
+			if err := workflow.ExecuteActivity(ctx, ActivitySendTrialReminder, activityInput).Get(ctx, nil); err != nil {
+				logger.Error("Failed to send trial reminder", "error", err, "subscription_id", input.SubscriptionID)
+				searchattr.UpsertFailureSearchAttributes(ctx, ActivitySendTrialReminder, err, input.SubscriptionID)
+				return nil, err
+			}
+			reminderSentFor = state.TrialEnd
+		}
+
+		wakeAt := nextTrialWakeUp(state, reminderSentFor, now)
+		logger.Info("Waiting for next trial transition",
+			"subscription_id", input.SubscriptionID,
+			"wake_at", wakeAt)
+
+		if err := workflow.Sleep(ctx, wakeAt.Sub(now)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// nextTrialWakeUp returns when the workflow next has to look at the trial: the pending reminder,
+// the end of the trial or the next usage cap check, whichever comes first
+func nextTrialWakeUp(state subscriptionModels.GetTrialStateActivityOutput, reminderSentFor time.Time, now time.Time) time.Time {
+	wakeAt := state.TrialEnd
+	if state.ReminderAt != nil && !reminderSentFor.Equal(state.TrialEnd) && state.ReminderAt.Before(wakeAt) {
+		wakeAt = *state.ReminderAt
+	}
+	if state.HasUsageCaps && now.Add(trialUsageCapCheckInterval).Before(wakeAt) {
+		wakeAt = now.Add(trialUsageCapCheckInterval)
+	}
+	return wakeAt
+}
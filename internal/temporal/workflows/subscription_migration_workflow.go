@@ -0,0 +1,142 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/flexprice/flexprice/internal/temporal/models"
+	"github.com/flexprice/flexprice/internal/types"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// Workflow name - must match the function name
+	WorkflowSubscriptionMigration = "SubscriptionMigrationWorkflow"
+	// Activity names - must match the registered method names
+	ActivityStartSubscriptionMigration        = "StartSubscriptionMigration"
+	ActivityProcessSubscriptionMigrationBatch = "ProcessSubscriptionMigrationBatch"
+	ActivityUpdateSubscriptionMigrationStatus = "UpdateSubscriptionMigrationStatus"
+)
+
+// SubscriptionMigrationWorkflow moves the subscriptions of a migration task to the target plan one
+// batch at a time. Pause and resume signals are handled between batches, the batch in flight
+// always completes before the migration pauses.
+func SubscriptionMigrationWorkflow(ctx workflow.Context, input models.TaskProcessingWorkflowInput) (*models.TaskProcessingWorkflowResult, error) {
+	// Validate input
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting subscription migration workflow", "task_id", input.TaskID)
+
+	// Progress is persisted after every subscription, a retried batch continues after the last persisted one
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 30,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second * 10,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute * 5,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	paused := false
+	pauseCh := workflow.GetSignalChannel(ctx, types.TemporalSignalPauseSubscriptionMigration)
+	resumeCh := workflow.GetSignalChannel(ctx, types.TemporalSignalResumeSubscriptionMigration)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			selector := workflow.NewSelector(ctx)
+			selector.AddReceive(pauseCh, func(c workflow.ReceiveChannel, _ bool) {
+				c.Receive(ctx, nil)
+				paused = true
+			})
+			selector.AddReceive(resumeCh, func(c workflow.ReceiveChannel, _ bool) {
+				c.Receive(ctx, nil)
+				paused = false
+			})
+			selector.Select(ctx)
+		}
+	})
+
+	activityInput := models.ProcessTaskActivityInput{
+		TaskID:        input.TaskID,
+		TenantID:      input.TenantID,
+		EnvironmentID: input.EnvironmentID,
+	}
+
+	// Activities which exhausted their retries fail the task, so the migration is not left processing
+	failed := func(err error) (*models.TaskProcessingWorkflowResult, error) {
+		logger.Error("Subscription migration failed", "task_id", input.TaskID, "error", err)
+		errorMsg := err.Error()
+
+		failCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				InitialInterval:    time.Second * 10,
+				BackoffCoefficient: 2.0,
+				MaximumInterval:    time.Minute,
+				MaximumAttempts:    5,
+			},
+		})
+		if err := workflow.ExecuteActivity(failCtx, ActivityUpdateSubscriptionMigrationStatus, models.SubscriptionMigrationStatusActivityInput{
+			ProcessTaskActivityInput: activityInput,
+			Status:                   types.TaskStatusFailed,
+			ErrorSummary:             &errorMsg,
+		}).Get(failCtx, nil); err != nil {
+			logger.Error("Failed to mark subscription migration as failed", "task_id", input.TaskID, "error", err)
+		}
+
+		return &models.TaskProcessingWorkflowResult{
+			TaskID:       input.TaskID,
+			Status:       "failed",
+			CompletedAt:  workflow.Now(ctx),
+			ErrorSummary: &errorMsg,
+		}, nil
+	}
+
+	if err := workflow.ExecuteActivity(ctx, ActivityStartSubscriptionMigration, activityInput).Get(ctx, nil); err != nil {
+		return failed(err)
+	}
+
+	var result models.SubscriptionMigrationBatchActivityResult
+	for batch := 1; !result.Done; batch++ {
+		if paused {
+			logger.Info("Subscription migration paused", "task_id", input.TaskID)
+			if err := updateSubscriptionMigrationStatus(ctx, activityInput, types.TaskStatusPaused); err != nil {
+				return failed(err)
+			}
+
+			if err := workflow.Await(ctx, func() bool { return !paused }); err != nil {
+				return failed(err)
+			}
+
+			logger.Info("Subscription migration resumed", "task_id", input.TaskID)
+			if err := updateSubscriptionMigrationStatus(ctx, activityInput, types.TaskStatusProcessing); err != nil {
+				return failed(err)
+			}
+		}
+
+		if err := workflow.ExecuteActivity(ctx, ActivityProcessSubscriptionMigrationBatch, activityInput).Get(ctx, &result); err != nil {
+			return failed(err)
+		}
+		logger.Info("Subscription migration batch processed", "task_id", input.TaskID, "batch", batch)
+	}
+
+	logger.Info("Subscription migration completed successfully", "task_id", input.TaskID)
+
+	return &models.TaskProcessingWorkflowResult{
+		TaskID:      input.TaskID,
+		Status:      "completed",
+		CompletedAt: workflow.Now(ctx),
+	}, nil
+}
+
+// updateSubscriptionMigrationStatus marks the migration task as paused or processing
+func updateSubscriptionMigrationStatus(ctx workflow.Context, input models.ProcessTaskActivityInput, status types.TaskStatus) error {
+	return workflow.ExecuteActivity(ctx, ActivityUpdateSubscriptionMigrationStatus, models.SubscriptionMigrationStatusActivityInput{
+		ProcessTaskActivityInput: input,
+		Status:                   status,
+	}).Get(ctx, nil)
+}
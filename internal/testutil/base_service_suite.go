@@ -21,6 +21,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/entityintegrationmapping"
 	"github.com/flexprice/flexprice/internal/domain/environment"
 	"github.com/flexprice/flexprice/internal/domain/events"
+	"github.com/flexprice/flexprice/internal/domain/experiment"
 	"github.com/flexprice/flexprice/internal/domain/feature"
 	"github.com/flexprice/flexprice/internal/domain/invoice"
 	"github.com/flexprice/flexprice/internal/domain/meter"
@@ -29,6 +30,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/price"
 	"github.com/flexprice/flexprice/internal/domain/priceunit"
 	"github.com/flexprice/flexprice/internal/domain/proration"
+	"github.com/flexprice/flexprice/internal/domain/quote"
 	"github.com/flexprice/flexprice/internal/domain/secret"
 	"github.com/flexprice/flexprice/internal/domain/settings"
 	"github.com/flexprice/flexprice/internal/domain/subscription"
@@ -90,6 +92,10 @@ type Stores struct {
 	SettingsRepo                 settings.Repository
 	AlertLogsRepo                alertlogs.Repository
 	FeatureUsageRepo             events.FeatureUsageRepository
+	ExperimentRepo               experiment.Repository
+	ExperimentAssignmentRepo     experiment.AssignmentRepository
+	QuoteRepo                    quote.Repository
+	MigrationResultRepo          subscription.MigrationResultRepository
 }
 
 // BaseServiceTestSuite provides common functionality for all service test suites
@@ -227,6 +233,10 @@ func (s *BaseServiceTestSuite) setupStores() {
 		SettingsRepo:                 NewInMemorySettingsStore(),
 		AlertLogsRepo:                NewInMemoryAlertLogsStore(),
 		FeatureUsageRepo:             NewInMemoryFeatureUsageStore(),
+		ExperimentRepo:               NewInMemoryExperimentStore(),
+		ExperimentAssignmentRepo:     NewInMemoryExperimentAssignmentStore(),
+		QuoteRepo:                    NewInMemoryQuoteStore(),
+		MigrationResultRepo:          NewInMemorySubscriptionMigrationResultStore(),
 	}
 
 	s.db = NewMockPostgresClient(s.logger)
@@ -277,6 +287,10 @@ func (s *BaseServiceTestSuite) clearStores() {
 	s.stores.SubscriptionLineItemRepo.(*InMemorySubscriptionLineItemStore).Clear()
 	s.stores.SubscriptionPhaseRepo.(*InMemorySubscriptionPhaseStore).Clear()
 	s.stores.AlertLogsRepo.(*InMemoryAlertLogsStore).Clear()
+	s.stores.ExperimentRepo.(*InMemoryExperimentStore).Clear()
+	s.stores.ExperimentAssignmentRepo.(*InMemoryExperimentAssignmentStore).Clear()
+	s.stores.QuoteRepo.(*InMemoryQuoteStore).Clear()
+	s.stores.MigrationResultRepo.(*InMemorySubscriptionMigrationResultStore).Clear()
 }
 
 func (s *BaseServiceTestSuite) ClearStores() {
@@ -41,6 +41,10 @@ func copyAddonAssociation(aa *addonassociation.AddonAssociation) *addonassociati
 		CancellationReason: aa.CancellationReason,
 		CancelledAt:        aa.CancelledAt,
 		Metadata:           lo.Assign(map[string]interface{}{}, aa.Metadata),
+		Quantity:           aa.Quantity,
+		BillingPeriod:      aa.BillingPeriod,
+		BillingPeriodCount: aa.BillingPeriodCount,
+		TrialEnd:           aa.TrialEnd,
 		BaseModel: types.BaseModel{
 			TenantID:  aa.TenantID,
 			Status:    aa.Status,
@@ -30,18 +30,19 @@ func copyCustomer(c *customer.Customer) *customer.Customer {
 
 	// Deep copy of customer
 	c = &customer.Customer{
-		ID:                c.ID,
-		ExternalID:        c.ExternalID,
-		Name:              c.Name,
-		Email:             c.Email,
-		AddressLine1:      c.AddressLine1,
-		AddressLine2:      c.AddressLine2,
-		AddressCity:       c.AddressCity,
-		AddressState:      c.AddressState,
-		AddressPostalCode: c.AddressPostalCode,
-		AddressCountry:    c.AddressCountry,
-		Metadata:          lo.Assign(map[string]string{}, c.Metadata),
-		EnvironmentID:     c.EnvironmentID,
+		ID:                  c.ID,
+		ExternalID:          c.ExternalID,
+		Name:                c.Name,
+		Email:               c.Email,
+		AddressLine1:        c.AddressLine1,
+		AddressLine2:        c.AddressLine2,
+		AddressCity:         c.AddressCity,
+		AddressState:        c.AddressState,
+		AddressPostalCode:   c.AddressPostalCode,
+		AddressCountry:      c.AddressCountry,
+		Metadata:            lo.Assign(map[string]string{}, c.Metadata),
+		ConsolidateInvoices: c.ConsolidateInvoices,
+		EnvironmentID:       c.EnvironmentID,
 		BaseModel: types.BaseModel{
 			TenantID:  c.TenantID,
 			Status:    c.Status,
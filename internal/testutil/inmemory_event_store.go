@@ -99,6 +99,10 @@ func (s *InMemoryEventStore) GetUsage(ctx context.Context, params *events.UsageP
 			continue
 		}
 
+		if !params.TimeWindow.Matches(event.Timestamp) {
+			continue
+		}
+
 		// Apply property filters
 		matchesFilters := true
 		for key, expectedValues := range params.Filters {
@@ -639,6 +643,9 @@ func (s *InMemoryEventStore) matchesBaseFilters(ctx context.Context, event *even
 		if !params.EndTime.IsZero() && event.Timestamp.After(params.EndTime) {
 			return false
 		}
+		if !params.TimeWindow.Matches(event.Timestamp) {
+			return false
+		}
 	}
 
 	// Check base filters
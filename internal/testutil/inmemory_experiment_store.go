@@ -0,0 +1,349 @@
+package testutil
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/flexprice/flexprice/internal/domain/experiment"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryExperimentStore implements experiment.Repository
+type InMemoryExperimentStore struct {
+	*InMemoryStore[*experiment.Experiment]
+}
+
+// NewInMemoryExperimentStore creates a new in-memory experiment store
+func NewInMemoryExperimentStore() *InMemoryExperimentStore {
+	return &InMemoryExperimentStore{
+		InMemoryStore: NewInMemoryStore[*experiment.Experiment](),
+	}
+}
+
+// Helper to copy experiment
+func copyExperiment(e *experiment.Experiment) *experiment.Experiment {
+	if e == nil {
+		return nil
+	}
+
+	copied := *e
+	copied.Variants = append([]types.ExperimentVariant(nil), e.Variants...)
+	copied.Metadata = lo.Assign(map[string]string{}, e.Metadata)
+	return &copied
+}
+
+func (s *InMemoryExperimentStore) Create(ctx context.Context, e *experiment.Experiment) error {
+	if e == nil {
+		return ierr.NewError("experiment cannot be nil").
+			WithHint("Experiment cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	if e.EnvironmentID == "" {
+		e.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if e.IsRunning() {
+		if _, err := s.GetRunningByPlan(ctx, e.PlanID); err == nil {
+			return ierr.NewError("plan already has a running experiment").
+				WithHint("The plan already has a running experiment").
+				Mark(ierr.ErrAlreadyExists)
+		}
+	}
+
+	return s.InMemoryStore.Create(ctx, e.ID, copyExperiment(e))
+}
+
+func (s *InMemoryExperimentStore) Get(ctx context.Context, id string) (*experiment.Experiment, error) {
+	e, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("experiment not found").
+			WithHint("Experiment not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyExperiment(e), nil
+}
+
+func (s *InMemoryExperimentStore) GetRunningByPlan(ctx context.Context, planID string) (*experiment.Experiment, error) {
+	filter := types.NewNoLimitExperimentFilter()
+	filter.PlanIDs = []string{planID}
+	filter.ExperimentStatus = []types.ExperimentStatus{types.ExperimentStatusRunning}
+
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items = lo.Filter(items, func(e *experiment.Experiment, _ int) bool {
+		return e.Status == types.StatusPublished
+	})
+	if len(items) == 0 {
+		return nil, ierr.NewError("no running experiment found").
+			WithHintf("No running experiment found for plan %s", planID).
+			Mark(ierr.ErrNotFound)
+	}
+	return items[0], nil
+}
+
+func (s *InMemoryExperimentStore) Update(ctx context.Context, e *experiment.Experiment) error {
+	if e == nil {
+		return ierr.NewError("experiment cannot be nil").
+			WithHint("Experiment cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	if e.IsRunning() {
+		if running, err := s.GetRunningByPlan(ctx, e.PlanID); err == nil && running.ID != e.ID {
+			return ierr.NewError("plan already has a running experiment").
+				WithHint("The plan already has a running experiment").
+				Mark(ierr.ErrAlreadyExists)
+		}
+	}
+
+	return s.InMemoryStore.Update(ctx, e.ID, copyExperiment(e))
+}
+
+func (s *InMemoryExperimentStore) Delete(ctx context.Context, id string) error {
+	return s.InMemoryStore.Delete(ctx, id)
+}
+
+func (s *InMemoryExperimentStore) List(ctx context.Context, filter *types.ExperimentFilter) ([]*experiment.Experiment, error) {
+	if filter == nil {
+		filter = types.NewExperimentFilter()
+	}
+
+	items, err := s.InMemoryStore.List(ctx, filter, experimentFilterFn, experimentSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(e *experiment.Experiment, _ int) *experiment.Experiment {
+		return copyExperiment(e)
+	}), nil
+}
+
+func (s *InMemoryExperimentStore) Count(ctx context.Context, filter *types.ExperimentFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewExperimentFilter()
+	}
+
+	return s.InMemoryStore.Count(ctx, filter, experimentFilterFn)
+}
+
+// experimentFilterFn implements filtering logic for experiments
+func experimentFilterFn(ctx context.Context, e *experiment.Experiment, filter interface{}) bool {
+	f, ok := filter.(*types.ExperimentFilter)
+	if !ok {
+		return false
+	}
+
+	if !CheckTenantFilter(ctx, e.TenantID) || !CheckEnvironmentFilter(ctx, e.EnvironmentID) {
+		return false
+	}
+
+	if len(f.ExperimentIDs) > 0 && !lo.Contains(f.ExperimentIDs, e.ID) {
+		return false
+	}
+
+	if len(f.PlanIDs) > 0 && !lo.Contains(f.PlanIDs, e.PlanID) {
+		return false
+	}
+
+	if len(f.ExperimentStatus) > 0 && !lo.Contains(f.ExperimentStatus, e.ExperimentStatus) {
+		return false
+	}
+
+	return true
+}
+
+// experimentSortFn implements sorting logic for experiments
+func experimentSortFn(i, j *experiment.Experiment) bool {
+	return i.CreatedAt.After(j.CreatedAt)
+}
+
+// InMemoryExperimentAssignmentStore implements experiment.AssignmentRepository
+type InMemoryExperimentAssignmentStore struct {
+	*InMemoryStore[*experiment.Assignment]
+}
+
+// NewInMemoryExperimentAssignmentStore creates a new in-memory experiment assignment store
+func NewInMemoryExperimentAssignmentStore() *InMemoryExperimentAssignmentStore {
+	return &InMemoryExperimentAssignmentStore{
+		InMemoryStore: NewInMemoryStore[*experiment.Assignment](),
+	}
+}
+
+func copyExperimentAssignment(a *experiment.Assignment) *experiment.Assignment {
+	if a == nil {
+		return nil
+	}
+
+	copied := *a
+	return &copied
+}
+
+func (s *InMemoryExperimentAssignmentStore) Create(ctx context.Context, a *experiment.Assignment) error {
+	if a == nil {
+		return ierr.NewError("experiment assignment cannot be nil").
+			WithHint("Experiment assignment cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	if a.EnvironmentID == "" {
+		a.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	if _, err := s.GetByExperimentAndCustomer(ctx, a.ExperimentID, a.CustomerID); err == nil {
+		return ierr.NewError("customer is already assigned to the experiment").
+			WithHint("Customer is already assigned to a variant of the experiment").
+			Mark(ierr.ErrAlreadyExists)
+	}
+
+	return s.InMemoryStore.Create(ctx, a.ID, copyExperimentAssignment(a))
+}
+
+func (s *InMemoryExperimentAssignmentStore) GetByExperimentAndCustomer(ctx context.Context, experimentID, customerID string) (*experiment.Assignment, error) {
+	filter := types.NewNoLimitExperimentAssignmentFilter()
+	filter.ExperimentIDs = []string{experimentID}
+	filter.CustomerIDs = []string{customerID}
+
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	items = lo.Filter(items, func(a *experiment.Assignment, _ int) bool {
+		return a.Status == types.StatusPublished
+	})
+	if len(items) == 0 {
+		return nil, ierr.NewError("experiment assignment not found").
+			WithHint("Customer is not assigned to the experiment").
+			Mark(ierr.ErrNotFound)
+	}
+	return items[0], nil
+}
+
+func (s *InMemoryExperimentAssignmentStore) SetSubscription(ctx context.Context, id string, subscriptionID string) error {
+	a, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updated := copyExperimentAssignment(a)
+	updated.SubscriptionID = lo.ToPtr(subscriptionID)
+	return s.InMemoryStore.Update(ctx, id, updated)
+}
+
+func (s *InMemoryExperimentAssignmentStore) MarkConverted(ctx context.Context, subscriptionID string, convertedAt time.Time) error {
+	filter := types.NewNoLimitExperimentAssignmentFilter()
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range items {
+		if lo.FromPtr(a.SubscriptionID) != subscriptionID || a.ConvertedAt != nil {
+			continue
+		}
+		updated := copyExperimentAssignment(a)
+		updated.ConvertedAt = lo.ToPtr(convertedAt)
+		if err := s.InMemoryStore.Update(ctx, a.ID, updated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InMemoryExperimentAssignmentStore) List(ctx context.Context, filter *types.ExperimentAssignmentFilter) ([]*experiment.Assignment, error) {
+	if filter == nil {
+		filter = types.NewExperimentAssignmentFilter()
+	}
+
+	items, err := s.InMemoryStore.List(ctx, filter, experimentAssignmentFilterFn, experimentAssignmentSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(a *experiment.Assignment, _ int) *experiment.Assignment {
+		return copyExperimentAssignment(a)
+	}), nil
+}
+
+func (s *InMemoryExperimentAssignmentStore) Count(ctx context.Context, filter *types.ExperimentAssignmentFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewExperimentAssignmentFilter()
+	}
+
+	return s.InMemoryStore.Count(ctx, filter, experimentAssignmentFilterFn)
+}
+
+// GetVariantStats counts assignments per variant. The in-memory store has no access to
+// subscriptions, so every assignment with a subscription counts as a conversion.
+func (s *InMemoryExperimentAssignmentStore) GetVariantStats(ctx context.Context, experimentID string) ([]types.ExperimentVariantStats, error) {
+	filter := types.NewNoLimitExperimentAssignmentFilter()
+	filter.ExperimentIDs = []string{experimentID}
+
+	items, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]*types.ExperimentVariantStats)
+	for _, a := range items {
+		if _, ok := stats[a.VariantKey]; !ok {
+			stats[a.VariantKey] = &types.ExperimentVariantStats{VariantKey: a.VariantKey}
+		}
+		stats[a.VariantKey].Assignments++
+		if a.ConvertedAt != nil {
+			stats[a.VariantKey].Conversions++
+		}
+	}
+
+	keys := lo.Keys(stats)
+	sort.Strings(keys)
+	return lo.Map(keys, func(key string, _ int) types.ExperimentVariantStats {
+		return *stats[key]
+	}), nil
+}
+
+// GetVariantRevenue returns no revenue since the in-memory store has no access to invoices
+func (s *InMemoryExperimentAssignmentStore) GetVariantRevenue(_ context.Context, _ string) ([]types.ExperimentVariantRevenue, error) {
+	return []types.ExperimentVariantRevenue{}, nil
+}
+
+// experimentAssignmentFilterFn implements filtering logic for experiment assignments
+func experimentAssignmentFilterFn(ctx context.Context, a *experiment.Assignment, filter interface{}) bool {
+	f, ok := filter.(*types.ExperimentAssignmentFilter)
+	if !ok {
+		return false
+	}
+
+	if !CheckTenantFilter(ctx, a.TenantID) || !CheckEnvironmentFilter(ctx, a.EnvironmentID) {
+		return false
+	}
+
+	if len(f.ExperimentIDs) > 0 && !lo.Contains(f.ExperimentIDs, a.ExperimentID) {
+		return false
+	}
+
+	if len(f.CustomerIDs) > 0 && !lo.Contains(f.CustomerIDs, a.CustomerID) {
+		return false
+	}
+
+	if len(f.VariantKeys) > 0 && !lo.Contains(f.VariantKeys, a.VariantKey) {
+		return false
+	}
+
+	return true
+}
+
+// experimentAssignmentSortFn implements sorting logic for experiment assignments
+func experimentAssignmentSortFn(i, j *experiment.Assignment) bool {
+	return i.CreatedAt.After(j.CreatedAt)
+}
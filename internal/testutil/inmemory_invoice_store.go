@@ -346,6 +346,22 @@ func invoiceFilterFn(ctx context.Context, inv *invoice.Invoice, filter interface
 		return false
 	}
 
+	// Filter by period start
+	if f.PeriodStartGTE != nil && (inv.PeriodStart == nil || inv.PeriodStart.Before(*f.PeriodStartGTE)) {
+		return false
+	}
+	if f.PeriodStartLTE != nil && (inv.PeriodStart == nil || inv.PeriodStart.After(*f.PeriodStartLTE)) {
+		return false
+	}
+
+	// Filter by period end
+	if f.PeriodEndGTE != nil && (inv.PeriodEnd == nil || inv.PeriodEnd.Before(*f.PeriodEndGTE)) {
+		return false
+	}
+	if f.PeriodEndLTE != nil && (inv.PeriodEnd == nil || inv.PeriodEnd.After(*f.PeriodEndLTE)) {
+		return false
+	}
+
 	// Filter by time range
 	if f.TimeRangeFilter != nil && (f.TimeRangeFilter.StartTime != nil || f.TimeRangeFilter.EndTime != nil) {
 		if f.TimeRangeFilter.StartTime != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/flexprice/flexprice/internal/domain/plan"
 	ierr "github.com/flexprice/flexprice/internal/errors"
 	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
 )
 
 // InMemoryPlanStore implements plan.Repository
@@ -48,6 +49,15 @@ func planFilterFn(ctx context.Context, p *plan.Plan, filter interface{}) bool {
 		return false
 	}
 
+	// Filter by version group
+	if f.BasePlanID != "" && p.GetBasePlanID() != f.BasePlanID {
+		return false
+	}
+
+	if len(f.VersionStatus) > 0 && !lo.Contains(f.VersionStatus, p.GetVersionStatus()) {
+		return false
+	}
+
 	// Filter by time range
 	if f.TimeRangeFilter != nil {
 		if f.StartTime != nil && p.CreatedAt.Before(*f.StartTime) {
@@ -115,6 +125,8 @@ func (s *InMemoryPlanStore) ListAll(ctx context.Context, filter *types.PlanFilte
 	unlimitedFilter := &types.PlanFilter{
 		QueryFilter:     types.NewNoLimitQueryFilter(),
 		TimeRangeFilter: filter.TimeRangeFilter,
+		BasePlanID:      filter.BasePlanID,
+		VersionStatus:   filter.VersionStatus,
 	}
 
 	return s.List(ctx, unlimitedFilter)
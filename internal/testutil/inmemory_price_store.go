@@ -55,6 +55,11 @@ func priceFilterFn(ctx context.Context, p *price.Price, filter interface{}) bool
 		}
 	}
 
+	// Filter by meter IDs
+	if len(f.MeterIDs) > 0 && !lo.Contains(f.MeterIDs, p.MeterID) {
+		return false
+	}
+
 	// filter by price ids
 	if len(f.PriceIDs) > 0 {
 		if !lo.Contains(f.PriceIDs, p.ID) {
@@ -0,0 +1,161 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/quote"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemoryQuoteStore implements quote.Repository
+type InMemoryQuoteStore struct {
+	*InMemoryStore[*quote.Quote]
+}
+
+// NewInMemoryQuoteStore creates a new in-memory quote store
+func NewInMemoryQuoteStore() *InMemoryQuoteStore {
+	return &InMemoryQuoteStore{
+		InMemoryStore: NewInMemoryStore[*quote.Quote](),
+	}
+}
+
+// Helper to copy quote together with its line items
+func copyQuote(q *quote.Quote) *quote.Quote {
+	if q == nil {
+		return nil
+	}
+
+	copied := *q
+	copied.Coupons = append([]string(nil), q.Coupons...)
+	copied.Phases = append([]types.QuotePhase(nil), q.Phases...)
+	copied.Metadata = lo.Assign(map[string]string{}, q.Metadata)
+	copied.LineItems = lo.Map(q.LineItems, func(item *quote.LineItem, _ int) *quote.LineItem {
+		copiedItem := *item
+		return &copiedItem
+	})
+	return &copied
+}
+
+func (s *InMemoryQuoteStore) CreateWithLineItems(ctx context.Context, q *quote.Quote) error {
+	if q == nil {
+		return ierr.NewError("quote cannot be nil").
+			WithHint("Quote cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	if q.EnvironmentID == "" {
+		q.EnvironmentID = types.GetEnvironmentID(ctx)
+	}
+
+	return s.InMemoryStore.Create(ctx, q.ID, copyQuote(q))
+}
+
+func (s *InMemoryQuoteStore) Get(ctx context.Context, id string) (*quote.Quote, error) {
+	q, err := s.InMemoryStore.Get(ctx, id)
+	if err != nil {
+		return nil, ierr.NewError("quote not found").
+			WithHint("Quote not found").
+			WithReportableDetails(map[string]interface{}{
+				"id": id,
+			}).
+			Mark(ierr.ErrNotFound)
+	}
+	return copyQuote(q), nil
+}
+
+// Update updates the quote and keeps its stored line items
+func (s *InMemoryQuoteStore) Update(ctx context.Context, q *quote.Quote) error {
+	if q == nil {
+		return ierr.NewError("quote cannot be nil").
+			WithHint("Quote cannot be nil").
+			Mark(ierr.ErrValidation)
+	}
+
+	existing, err := s.InMemoryStore.Get(ctx, q.ID)
+	if err != nil {
+		return ierr.NewError("quote not found").
+			WithHint("Quote not found").
+			Mark(ierr.ErrNotFound)
+	}
+
+	updated := copyQuote(q)
+	updated.LineItems = existing.LineItems
+	return s.InMemoryStore.Update(ctx, q.ID, updated)
+}
+
+func (s *InMemoryQuoteStore) ReplaceLineItems(ctx context.Context, q *quote.Quote) error {
+	existing, err := s.InMemoryStore.Get(ctx, q.ID)
+	if err != nil {
+		return ierr.NewError("quote not found").
+			WithHint("Quote not found").
+			Mark(ierr.ErrNotFound)
+	}
+
+	updated := copyQuote(existing)
+	updated.LineItems = copyQuote(q).LineItems
+	return s.InMemoryStore.Update(ctx, q.ID, updated)
+}
+
+func (s *InMemoryQuoteStore) Delete(ctx context.Context, id string) error {
+	return s.InMemoryStore.Delete(ctx, id)
+}
+
+func (s *InMemoryQuoteStore) List(ctx context.Context, filter *types.QuoteFilter) ([]*quote.Quote, error) {
+	if filter == nil {
+		filter = types.NewQuoteFilter()
+	}
+
+	items, err := s.InMemoryStore.List(ctx, filter, quoteFilterFn, quoteSortFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(items, func(q *quote.Quote, _ int) *quote.Quote {
+		return copyQuote(q)
+	}), nil
+}
+
+func (s *InMemoryQuoteStore) Count(ctx context.Context, filter *types.QuoteFilter) (int, error) {
+	if filter == nil {
+		filter = types.NewQuoteFilter()
+	}
+
+	return s.InMemoryStore.Count(ctx, filter, quoteFilterFn)
+}
+
+// quoteFilterFn implements filtering logic for quotes
+func quoteFilterFn(ctx context.Context, q *quote.Quote, filter interface{}) bool {
+	f, ok := filter.(*types.QuoteFilter)
+	if !ok {
+		return false
+	}
+
+	if !CheckTenantFilter(ctx, q.TenantID) || !CheckEnvironmentFilter(ctx, q.EnvironmentID) {
+		return false
+	}
+
+	if len(f.QuoteIDs) > 0 && !lo.Contains(f.QuoteIDs, q.ID) {
+		return false
+	}
+
+	if len(f.CustomerIDs) > 0 && !lo.Contains(f.CustomerIDs, q.CustomerID) {
+		return false
+	}
+
+	if len(f.PlanIDs) > 0 && !lo.Contains(f.PlanIDs, q.PlanID) {
+		return false
+	}
+
+	if len(f.QuoteStatus) > 0 && !lo.Contains(f.QuoteStatus, q.QuoteStatus) {
+		return false
+	}
+
+	return true
+}
+
+// quoteSortFn implements sorting logic for quotes
+func quoteSortFn(i, j *quote.Quote) bool {
+	return i.CreatedAt.After(j.CreatedAt)
+}
@@ -50,11 +50,13 @@ func lineItemFilterFn(ctx context.Context, item *subscription.SubscriptionLineIt
 	}
 
 	// Filter by entity IDs
-	if len(f.EntityIDs) > 0 {
-		// Check if the item's EntityID matches any of the plan IDs and EntityType is plan
-		if item.EntityType != types.SubscriptionLineItemEntityTypePlan || !lo.Contains(f.EntityIDs, item.EntityID) {
-			return false
-		}
+	if len(f.EntityIDs) > 0 && !lo.Contains(f.EntityIDs, item.EntityID) {
+		return false
+	}
+
+	// Filter by entity type
+	if f.EntityType != nil && item.EntityType != *f.EntityType {
+		return false
 	}
 
 	// Filter by price IDs
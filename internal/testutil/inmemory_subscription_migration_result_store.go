@@ -0,0 +1,139 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/flexprice/flexprice/internal/domain/subscription"
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/flexprice/flexprice/internal/types"
+	"github.com/samber/lo"
+)
+
+// InMemorySubscriptionMigrationResultStore implements subscription.MigrationResultRepository
+type InMemorySubscriptionMigrationResultStore struct {
+	*InMemoryStore[*subscription.MigrationResult]
+}
+
+// NewInMemorySubscriptionMigrationResultStore creates a new in-memory subscription migration result store
+func NewInMemorySubscriptionMigrationResultStore() *InMemorySubscriptionMigrationResultStore {
+	return &InMemorySubscriptionMigrationResultStore{
+		InMemoryStore: NewInMemoryStore[*subscription.MigrationResult](),
+	}
+}
+
+func copyMigrationResult(r *subscription.MigrationResult) *subscription.MigrationResult {
+	if r == nil {
+		return nil
+	}
+
+	copied := *r
+	return &copied
+}
+
+// subscriptionMigrationResultFilterFn implements filtering logic for subscription migration results
+func subscriptionMigrationResultFilterFn(ctx context.Context, r *subscription.MigrationResult, filter interface{}) bool {
+	if r == nil {
+		return false
+	}
+
+	f, ok := filter.(*types.SubscriptionMigrationResultFilter)
+	if !ok {
+		return true
+	}
+
+	if tenantID, ok := ctx.Value(types.CtxTenantID).(string); ok {
+		if r.TenantID != tenantID {
+			return false
+		}
+	}
+
+	if !CheckEnvironmentFilter(ctx, r.EnvironmentID) {
+		return false
+	}
+
+	if f.TaskID != "" && r.TaskID != f.TaskID {
+		return false
+	}
+
+	if len(f.MigrationStatus) > 0 && !lo.Contains(f.MigrationStatus, r.MigrationStatus) {
+		return false
+	}
+
+	if len(f.SubscriptionIDs) > 0 && !lo.Contains(f.SubscriptionIDs, r.SubscriptionID) {
+		return false
+	}
+
+	return true
+}
+
+// subscriptionMigrationResultSortFn keeps results in the order they were created in
+func subscriptionMigrationResultSortFn(i, j *subscription.MigrationResult) bool {
+	if !i.CreatedAt.Equal(j.CreatedAt) {
+		return i.CreatedAt.Before(j.CreatedAt)
+	}
+	return i.ID < j.ID
+}
+
+func (s *InMemorySubscriptionMigrationResultStore) CreateBulk(ctx context.Context, results []*subscription.MigrationResult) error {
+	for _, r := range results {
+		if r.EnvironmentID == "" {
+			r.EnvironmentID = types.GetEnvironmentID(ctx)
+		}
+
+		existing, err := s.List(ctx, &types.SubscriptionMigrationResultFilter{
+			QueryFilter:     types.NewNoLimitQueryFilter(),
+			TaskID:          r.TaskID,
+			SubscriptionIDs: []string{r.SubscriptionID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return ierr.NewError("subscription migration result already exists").
+				WithHint("Subscriptions were already selected for the migration").
+				Mark(ierr.ErrAlreadyExists)
+		}
+
+		if err := s.InMemoryStore.Create(ctx, r.ID, copyMigrationResult(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InMemorySubscriptionMigrationResultStore) Update(ctx context.Context, r *subscription.MigrationResult) error {
+	if r == nil {
+		return ierr.NewError("subscription migration result cannot be nil").
+			WithHint("Subscription migration result is required").
+			Mark(ierr.ErrValidation)
+	}
+	return s.InMemoryStore.Update(ctx, r.ID, copyMigrationResult(r))
+}
+
+func (s *InMemorySubscriptionMigrationResultStore) List(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) ([]*subscription.MigrationResult, error) {
+	items, err := s.InMemoryStore.List(ctx, filter, subscriptionMigrationResultFilterFn, subscriptionMigrationResultSortFn)
+	if err != nil {
+		return nil, ierr.WithError(err).
+			WithHint("Failed to list subscription migration results").
+			Mark(ierr.ErrDatabase)
+	}
+
+	return lo.Map(items, func(r *subscription.MigrationResult, _ int) *subscription.MigrationResult {
+		return copyMigrationResult(r)
+	}), nil
+}
+
+func (s *InMemorySubscriptionMigrationResultStore) Count(ctx context.Context, filter *types.SubscriptionMigrationResultFilter) (int, error) {
+	count, err := s.InMemoryStore.Count(ctx, filter, subscriptionMigrationResultFilterFn)
+	if err != nil {
+		return 0, ierr.WithError(err).
+			WithHint("Failed to count subscription migration results").
+			Mark(ierr.ErrDatabase)
+	}
+	return count, nil
+}
+
+// Clear removes all data from the store
+func (s *InMemorySubscriptionMigrationResultStore) Clear() {
+	s.InMemoryStore.Clear()
+}
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/flexprice/flexprice/internal/domain/subscription"
@@ -98,6 +99,21 @@ func subscriptionFilterFn(ctx context.Context, sub *subscription.Subscription, f
 		}
 	}
 
+	// Filter by trial end
+	if f.TrialEndBefore != nil && (sub.TrialEnd == nil || !sub.TrialEnd.Before(*f.TrialEndBefore)) {
+		return false
+	}
+
+	// Filter by current term end
+	if f.CurrentTermEndBefore != nil && (!sub.HasTerm() || !sub.CurrentTermEnd.Before(*f.CurrentTermEndBefore)) {
+		return false
+	}
+
+	// Filter out subscriptions whose missed periods are being billed
+	if f.ExcludeBackdatedBillingPending && sub.BackdatedBillingPending {
+		return false
+	}
+
 	return true
 }
 
@@ -167,6 +183,10 @@ func (s *InMemorySubscriptionStore) Get(ctx context.Context, id string) (*subscr
 }
 
 func (s *InMemorySubscriptionStore) List(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
+	if filter != nil && filter.QueryFilter != nil && filter.GetSort() == "current_term_end" {
+		return s.listByCurrentTermEnd(ctx, filter)
+	}
+
 	subs, err := s.InMemoryStore.List(ctx, filter, subscriptionFilterFn, subscriptionSortFn)
 	if err != nil {
 		return nil, ierr.WithError(err).
@@ -182,6 +202,32 @@ func (s *InMemorySubscriptionStore) List(ctx context.Context, filter *types.Subs
 	return subs, nil
 }
 
+// listByCurrentTermEnd lists subscriptions sorted by the end of their current term before paginating them
+func (s *InMemorySubscriptionStore) listByCurrentTermEnd(ctx context.Context, filter *types.SubscriptionFilter) ([]*subscription.Subscription, error) {
+	unlimitedFilter := *filter
+	unlimitedFilter.QueryFilter = types.NewNoLimitQueryFilter()
+	subs, err := s.List(ctx, &unlimitedFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(subs, func(i, j int) bool {
+		iVal := lo.FromPtr(subs[i].CurrentTermEnd)
+		jVal := lo.FromPtr(subs[j].CurrentTermEnd)
+		if filter.GetOrder() == "desc" {
+			return iVal.After(jVal)
+		}
+		return iVal.Before(jVal)
+	})
+
+	if filter.IsUnlimited() {
+		return subs, nil
+	}
+	start := min(filter.GetOffset(), len(subs))
+	end := min(start+filter.GetLimit(), len(subs))
+	return subs[start:end], nil
+}
+
 func (s *InMemorySubscriptionStore) ListByCustomerID(ctx context.Context, customerID string) ([]*subscription.Subscription, error) {
 	// Create a filter with customer ID
 	filter := &types.SubscriptionFilter{
@@ -190,6 +236,7 @@ func (s *InMemorySubscriptionStore) ListByCustomerID(ctx context.Context, custom
 		SubscriptionStatus: []types.SubscriptionStatus{
 			types.SubscriptionStatusActive,
 			types.SubscriptionStatusTrialing,
+			types.SubscriptionStatusPastDue,
 		},
 	}
 
@@ -284,6 +331,10 @@ func (s *InMemorySubscriptionStore) ListAll(ctx context.Context, filter *types.S
 		BillingPeriod:           filter.BillingPeriod,
 		SubscriptionStatusNotIn: filter.SubscriptionStatusNotIn,
 		ActiveAt:                filter.ActiveAt,
+		TrialEndBefore:          filter.TrialEndBefore,
+		CurrentTermEndBefore:    filter.CurrentTermEndBefore,
+
+		ExcludeBackdatedBillingPending: filter.ExcludeBackdatedBillingPending,
 	}
 
 	return s.List(ctx, unlimitedFilter)
@@ -402,20 +453,35 @@ func (s *InMemorySubscriptionStore) GetWithPauses(ctx context.Context, id string
 	return sub, pauses, nil
 }
 
-// ListSubscriptionsDueForRenewal retrieves all active subscriptions that are due for renewal in 24 hours
+// ListSubscriptionsDueForRenewal retrieves all active and past due subscriptions whose renewal is due in 24 hours.
+// Subscriptions with a term are due at their non-renewal deadline and only when they renew automatically,
+// other subscriptions are due at the end of their current period.
 func (s *InMemorySubscriptionStore) ListSubscriptionsDueForRenewal(ctx context.Context) ([]*subscription.Subscription, error) {
-	// Create a filter for active subscriptions
+	// Create a filter for active and past due subscriptions
 	filter := &types.SubscriptionFilter{
 		QueryFilter: types.NewNoLimitQueryFilter(),
 		SubscriptionStatus: []types.SubscriptionStatus{
 			types.SubscriptionStatusActive,
-		},
-		TimeRangeFilter: &types.TimeRangeFilter{
-			EndTime: lo.ToPtr(time.Now().UTC().Add(24 * time.Hour)),
+			types.SubscriptionStatusPastDue,
 		},
 	}
 
-	return s.ListAll(ctx, filter)
+	subs, err := s.ListAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	targetTime := time.Now().UTC().Add(24 * time.Hour)
+	windowStart := targetTime.Add(-1 * time.Hour)
+	windowEnd := targetTime.Add(1 * time.Hour)
+
+	return lo.Filter(subs, func(sub *subscription.Subscription, _ int) bool {
+		if sub.CancelAtPeriodEnd || (sub.HasTerm() && !sub.AutoRenew) {
+			return false
+		}
+		dueAt := sub.RenewalDueAt()
+		return !dueAt.Before(windowStart) && !dueAt.After(windowEnd)
+	}), nil
 }
 
 // GetRecentSubscriptionsByPlan returns subscription counts grouped by plan for last 7 days
@@ -25,6 +25,12 @@ func (m *MockPDFGenerator) RenderInvoicePdf(ctx context.Context, data *domain.In
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+// RenderQuotePdf implements pdf.Generator.
+func (m *MockPDFGenerator) RenderQuotePdf(ctx context.Context, data *domain.QuoteData) ([]byte, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 func NewMockPDFGenerator(logger *logger.Logger) pdf.Generator {
 	return &MockPDFGenerator{
 		logger: logger,
@@ -0,0 +1,62 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// CatalogFormat is the serialization format of a catalog document
+type CatalogFormat string
+
+const (
+	CatalogFormatJSON CatalogFormat = "json"
+	CatalogFormatYAML CatalogFormat = "yaml"
+)
+
+func (f CatalogFormat) Validate() error {
+	allowed := []CatalogFormat{
+		CatalogFormatJSON,
+		CatalogFormatYAML,
+	}
+
+	if !lo.Contains(allowed, f) {
+		return ierr.NewError("invalid catalog format").
+			WithHint("Catalog format must be json or yaml").
+			WithReportableDetails(map[string]interface{}{
+				"format":         f,
+				"allowed_values": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// CatalogEntityType is the type of an entity of a catalog document
+type CatalogEntityType string
+
+const (
+	CatalogEntityTypeFeature     CatalogEntityType = "feature"
+	CatalogEntityTypePlan        CatalogEntityType = "plan"
+	CatalogEntityTypePrice       CatalogEntityType = "price"
+	CatalogEntityTypeEntitlement CatalogEntityType = "entitlement"
+	CatalogEntityTypeCreditGrant CatalogEntityType = "credit_grant"
+	CatalogEntityTypeAddon       CatalogEntityType = "addon"
+	CatalogEntityTypeCoupon      CatalogEntityType = "coupon"
+)
+
+// CatalogChangeAction is the action applying a catalog document takes for an entity
+type CatalogChangeAction string
+
+const (
+	// CatalogChangeActionCreate creates an entity that does not exist yet
+	CatalogChangeActionCreate CatalogChangeAction = "create"
+	// CatalogChangeActionUpdate updates mutable fields of an existing entity in place
+	CatalogChangeActionUpdate CatalogChangeAction = "update"
+	// CatalogChangeActionReplace terminates an existing price and creates a new version of it
+	CatalogChangeActionReplace CatalogChangeAction = "replace"
+	// CatalogChangeActionUnchanged is reported for entities that already match the document
+	CatalogChangeActionUnchanged CatalogChangeAction = "unchanged"
+	// CatalogChangeActionConflict is reported for changes that cannot be applied, e.g. immutable fields
+	CatalogChangeActionConflict CatalogChangeAction = "conflict"
+)
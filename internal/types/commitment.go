@@ -1,6 +1,10 @@
 package types
 
-import "github.com/shopspring/decimal"
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
 
 // CommitmentType defines how commitment is specified - either as an amount or quantity
 type CommitmentType string
@@ -40,3 +44,47 @@ type CommitmentInfo struct {
 	ComputedOverageAmount            decimal.Decimal `json:"computed_overage_amount" swaggertype:"string"`
 	ComputedCommitmentUtilizedAmount decimal.Decimal `json:"computed_commitment_utilized_amount" swaggertype:"string"`
 }
+
+// CommitmentTrueUpCadence defines how often the commitment of a subscription phase is trued up
+type CommitmentTrueUpCadence string
+
+const (
+	// COMMITMENT_TRUE_UP_CADENCE_MONTHLY trues up a twelfth of the annual commitment every month
+	COMMITMENT_TRUE_UP_CADENCE_MONTHLY CommitmentTrueUpCadence = "monthly"
+	// COMMITMENT_TRUE_UP_CADENCE_ANNUAL trues up the annual commitment at the end of every contract year
+	COMMITMENT_TRUE_UP_CADENCE_ANNUAL CommitmentTrueUpCadence = "annual"
+)
+
+// Validate checks if the true-up cadence is valid
+func (c CommitmentTrueUpCadence) Validate() error {
+	allowed := []CommitmentTrueUpCadence{
+		COMMITMENT_TRUE_UP_CADENCE_MONTHLY,
+		COMMITMENT_TRUE_UP_CADENCE_ANNUAL,
+	}
+	if !lo.Contains(allowed, c) {
+		return ierr.NewError("invalid commitment true-up cadence").
+			WithHint("Commitment true-up cadence must be either 'monthly' or 'annual'").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+				"cadence": c,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// BillingPeriod returns the length of a commitment period of the cadence
+func (c CommitmentTrueUpCadence) BillingPeriod() BillingPeriod {
+	if c == COMMITMENT_TRUE_UP_CADENCE_MONTHLY {
+		return BILLING_PERIOD_MONTHLY
+	}
+	return BILLING_PERIOD_ANNUAL
+}
+
+// PeriodsPerYear returns the number of commitment periods of the cadence in a year
+func (c CommitmentTrueUpCadence) PeriodsPerYear() int64 {
+	if c == COMMITMENT_TRUE_UP_CADENCE_MONTHLY {
+		return 12
+	}
+	return 1
+}
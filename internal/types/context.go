@@ -9,14 +9,15 @@ import (
 type ContextKey string
 
 const (
-	CtxRequestID     ContextKey = "ctx_request_id"
-	CtxTenantID      ContextKey = "ctx_tenant_id"
-	CtxUserID        ContextKey = "ctx_user_id"
-	CtxJWT           ContextKey = "ctx_jwt"
-	CtxEnvironmentID ContextKey = "ctx_environment_id"
-	CtxDBTransaction ContextKey = "ctx_db_transaction"
-	CtxForceWriter   ContextKey = "ctx_force_writer" // Force DB operations to use writer connection
-	CtxRoles         ContextKey = "ctx_roles"        // RBAC roles array for permission checks
+	CtxRequestID      ContextKey = "ctx_request_id"
+	CtxTenantID       ContextKey = "ctx_tenant_id"
+	CtxUserID         ContextKey = "ctx_user_id"
+	CtxJWT            ContextKey = "ctx_jwt"
+	CtxEnvironmentID  ContextKey = "ctx_environment_id"
+	CtxDBTransaction  ContextKey = "ctx_db_transaction"
+	CtxForceWriter    ContextKey = "ctx_force_writer"    // Force DB operations to use writer connection
+	CtxRoles          ContextKey = "ctx_roles"           // RBAC roles array for permission checks
+	CtxRoundingConfig ContextKey = "ctx_rounding_config" // Rounding policy resolved for the current calculation
 
 	// Default values
 	DefaultTenantID = "00000000-0000-0000-0000-000000000000"
@@ -126,6 +127,18 @@ func ShouldForceWriter(ctx context.Context) bool {
 	return false
 }
 
+// WithRoundingConfig returns a context carrying the rounding policy, so that calculations
+// looping over many prices do not resolve the setting for every price
+func WithRoundingConfig(ctx context.Context, config RoundingConfig) context.Context {
+	return context.WithValue(ctx, CtxRoundingConfig, config)
+}
+
+// GetRoundingConfigFromContext returns the rounding policy carried by the context, if any
+func GetRoundingConfigFromContext(ctx context.Context) (RoundingConfig, bool) {
+	config, ok := ctx.Value(CtxRoundingConfig).(RoundingConfig)
+	return config, ok
+}
+
 // ValidateTenantContext validates that the required tenant context fields are present
 func ValidateTenantContext(ctx context.Context) error {
 	if ctx == nil {
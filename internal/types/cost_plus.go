@@ -0,0 +1,158 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// CostPlusMode is how a cost-plus price derives its amount from the cost basis
+type CostPlusMode string
+
+const (
+	// CostPlusModeMarkup adds a percentage on top of the cost, e.g. value 20 charges cost + 20%
+	CostPlusModeMarkup CostPlusMode = "MARKUP"
+
+	// CostPlusModeMultiplier multiplies the cost, e.g. value 1.3 charges cost × 1.3
+	CostPlusModeMultiplier CostPlusMode = "MULTIPLIER"
+)
+
+func (m CostPlusMode) String() string {
+	return string(m)
+}
+
+func (m CostPlusMode) Validate() error {
+	allowed := []CostPlusMode{
+		CostPlusModeMarkup,
+		CostPlusModeMultiplier,
+	}
+	if !lo.Contains(allowed, m) {
+		return ierr.NewError("invalid cost plus mode").
+			WithHint("Cost plus mode must be MARKUP or MULTIPLIER").
+			WithReportableDetails(map[string]interface{}{
+				"mode":    m,
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// CostPlusConfig configures a COST_PLUS price. The cost basis is the cost of the same usage under
+// the price of the meter in the active costsheet at rating time, so the charge follows cost changes.
+type CostPlusConfig struct {
+	// Mode is how the amount is derived from the cost basis
+	Mode CostPlusMode `json:"mode"`
+
+	// Value is the markup percentage for MARKUP and the factor for MULTIPLIER
+	Value decimal.Decimal `json:"value" swaggertype:"string"`
+
+	// MinMarginPercent is the guardrail for the margin, the amount is raised whenever the
+	// margin over the cost basis would fall below this percentage
+	MinMarginPercent *decimal.Decimal `json:"min_margin_percent,omitempty" swaggertype:"string"`
+}
+
+// Validate validates the cost plus config
+func (c *CostPlusConfig) Validate() error {
+	if c == nil {
+		return ierr.NewError("cost_plus is required").
+			WithHint("Please provide the cost plus configuration").
+			Mark(ierr.ErrValidation)
+	}
+
+	if err := c.Mode.Validate(); err != nil {
+		return err
+	}
+
+	switch c.Mode {
+	case CostPlusModeMarkup:
+		if c.Value.IsNegative() {
+			return ierr.NewError("cost plus markup cannot be negative").
+				WithHint("Please provide a non-negative markup percentage").
+				WithReportableDetails(map[string]interface{}{
+					"value": c.Value,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	case CostPlusModeMultiplier:
+		if !c.Value.IsPositive() {
+			return ierr.NewError("cost plus multiplier must be greater than zero").
+				WithHint("Please provide a positive multiplier").
+				WithReportableDetails(map[string]interface{}{
+					"value": c.Value,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if c.MinMarginPercent != nil {
+		if c.MinMarginPercent.IsNegative() || c.MinMarginPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return ierr.NewError("min_margin_percent must be between 0 and 100").
+				WithHint("The minimum margin must be at least 0% and below 100%").
+				WithReportableDetails(map[string]interface{}{
+					"min_margin_percent": c.MinMarginPercent,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+// Factor returns the factor the cost basis is multiplied with before the margin guardrail
+func (c *CostPlusConfig) Factor() decimal.Decimal {
+	if c.Mode == CostPlusModeMarkup {
+		return decimal.NewFromInt(1).Add(c.Value.Div(decimal.NewFromInt(100)))
+	}
+	return c.Value
+}
+
+// MinFactor returns the smallest factor that keeps the margin at MinMarginPercent,
+// i.e. 1 / (1 - min_margin). It is zero when no guardrail is configured.
+func (c *CostPlusConfig) MinFactor() decimal.Decimal {
+	if c.MinMarginPercent == nil {
+		return decimal.Zero
+	}
+	remaining := decimal.NewFromInt(1).Sub(c.MinMarginPercent.Div(decimal.NewFromInt(100)))
+	return decimal.NewFromInt(1).Div(remaining)
+}
+
+// EffectiveFactor returns the factor the cost basis is charged at after the margin guardrail
+func (c *CostPlusConfig) EffectiveFactor() decimal.Decimal {
+	return decimal.Max(c.Factor(), c.MinFactor())
+}
+
+// Apply returns the amount charged for the given cost basis and whether the margin guardrail
+// raised it. Amounts are not rounded.
+func (c *CostPlusConfig) Apply(cost decimal.Decimal) (decimal.Decimal, bool) {
+	return cost.Mul(c.EffectiveFactor()), cost.IsPositive() && c.MinFactor().GreaterThan(c.Factor())
+}
+
+// CostFor returns the cost basis of a charged amount, the inverse of Apply
+func (c *CostPlusConfig) CostFor(amount decimal.Decimal) decimal.Decimal {
+	factor := c.EffectiveFactor()
+	if !factor.IsPositive() {
+		return decimal.Zero
+	}
+	return amount.Div(factor)
+}
+
+// MarginPercent returns the margin of the charged amount over the cost basis in percent
+func (c *CostPlusConfig) MarginPercent() decimal.Decimal {
+	factor := c.EffectiveFactor()
+	if !factor.IsPositive() {
+		return decimal.Zero
+	}
+	return decimal.NewFromInt(1).Sub(decimal.NewFromInt(1).Div(factor)).Mul(decimal.NewFromInt(100))
+}
+
+// CostPlusBreakdown is the cost basis of a cost-plus charge
+type CostPlusBreakdown struct {
+	CostsheetID   string          `json:"costsheet_id"`
+	CostPriceID   string          `json:"cost_price_id"`
+	Cost          decimal.Decimal `json:"cost" swaggertype:"string"`
+	Amount        decimal.Decimal `json:"amount" swaggertype:"string"`
+	MarginPercent decimal.Decimal `json:"margin_percent" swaggertype:"string"`
+	// MinMarginApplied is true when the margin guardrail raised the amount
+	MinMarginApplied bool `json:"min_margin_applied"`
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostPlusConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *CostPlusConfig
+		wantErr bool
+	}{
+		{
+			name:   "markup",
+			config: &CostPlusConfig{Mode: CostPlusModeMarkup, Value: decimal.NewFromInt(20)},
+		},
+		{
+			name:   "multiplier_with_min_margin",
+			config: &CostPlusConfig{Mode: CostPlusModeMultiplier, Value: decimal.NewFromFloat(1.3), MinMarginPercent: lo.ToPtr(decimal.NewFromInt(10))},
+		},
+		{
+			name:    "missing_config",
+			wantErr: true,
+		},
+		{
+			name:    "invalid_mode",
+			config:  &CostPlusConfig{Mode: "DISCOUNT", Value: decimal.NewFromInt(20)},
+			wantErr: true,
+		},
+		{
+			name:    "negative_markup",
+			config:  &CostPlusConfig{Mode: CostPlusModeMarkup, Value: decimal.NewFromInt(-5)},
+			wantErr: true,
+		},
+		{
+			name:    "zero_multiplier",
+			config:  &CostPlusConfig{Mode: CostPlusModeMultiplier, Value: decimal.Zero},
+			wantErr: true,
+		},
+		{
+			name:    "min_margin_of_100_percent",
+			config:  &CostPlusConfig{Mode: CostPlusModeMarkup, Value: decimal.NewFromInt(20), MinMarginPercent: lo.ToPtr(decimal.NewFromInt(100))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCostPlusConfig_Apply(t *testing.T) {
+	cost := decimal.NewFromInt(100)
+
+	// cost + 20%
+	markup := &CostPlusConfig{Mode: CostPlusModeMarkup, Value: decimal.NewFromInt(20)}
+	amount, raised := markup.Apply(cost)
+	assert.True(t, decimal.NewFromInt(120).Equal(amount), "got %s", amount)
+	assert.False(t, raised)
+	assert.True(t, cost.Equal(markup.CostFor(amount)))
+
+	// cost × 1.3
+	multiplier := &CostPlusConfig{Mode: CostPlusModeMultiplier, Value: decimal.NewFromFloat(1.3)}
+	amount, raised = multiplier.Apply(cost)
+	assert.True(t, decimal.NewFromInt(130).Equal(amount), "got %s", amount)
+	assert.False(t, raised)
+
+	// cost × 1.1 only leaves a 9.09% margin, the 20% guardrail raises it to cost / 0.8
+	guarded := &CostPlusConfig{Mode: CostPlusModeMultiplier, Value: decimal.NewFromFloat(1.1), MinMarginPercent: lo.ToPtr(decimal.NewFromInt(20))}
+	amount, raised = guarded.Apply(cost)
+	assert.True(t, decimal.NewFromInt(125).Equal(amount), "got %s", amount)
+	assert.True(t, raised)
+	assert.True(t, decimal.NewFromInt(20).Equal(guarded.MarginPercent()), "got %s", guarded.MarginPercent())
+	assert.True(t, cost.Equal(guarded.CostFor(amount)))
+
+	// The guardrail does not lower markups above the minimum margin
+	markup.MinMarginPercent = lo.ToPtr(decimal.NewFromInt(10))
+	amount, raised = markup.Apply(cost)
+	assert.True(t, decimal.NewFromInt(120).Equal(amount), "got %s", amount)
+	assert.False(t, raised)
+
+	// No usage, no charge
+	amount, raised = guarded.Apply(decimal.Zero)
+	assert.True(t, amount.IsZero())
+	assert.False(t, raised)
+}
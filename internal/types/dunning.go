@@ -0,0 +1,140 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// DunningFinalAction determines what happens to a subscription when its dunning ends without payment
+type DunningFinalAction string
+
+const (
+	// DunningFinalActionCancel cancels the subscription immediately
+	DunningFinalActionCancel DunningFinalAction = "cancel"
+	// DunningFinalActionPause pauses the subscription until it is resumed
+	DunningFinalActionPause DunningFinalAction = "pause"
+	// DunningFinalActionMarkUnpaid keeps the subscription and marks it unpaid until the invoice is paid
+	DunningFinalActionMarkUnpaid DunningFinalAction = "mark_unpaid"
+)
+
+func (a DunningFinalAction) String() string {
+	return string(a)
+}
+
+func (a DunningFinalAction) Validate() error {
+	allowed := []DunningFinalAction{
+		DunningFinalActionCancel,
+		DunningFinalActionPause,
+		DunningFinalActionMarkUnpaid,
+	}
+	if !lo.Contains(allowed, a) {
+		return ierr.NewErrorf("invalid dunning final action: %s", a).
+			WithHint("Dunning final action must be cancel, pause or mark_unpaid").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// DunningOutcome is the state the dunning of an invoice is in after a dunning transition
+type DunningOutcome string
+
+const (
+	// DunningOutcomeSkipped means the invoice is not dunned, e.g. it is paid or dunning is disabled
+	DunningOutcomeSkipped DunningOutcome = "skipped"
+	// DunningOutcomePending means the invoice is still unpaid and the next step is due
+	DunningOutcomePending DunningOutcome = "pending"
+	// DunningOutcomeRecovered means the invoice was paid or voided and the subscription is active again
+	DunningOutcomeRecovered DunningOutcome = "recovered"
+	// DunningOutcomeStopped means the subscription was cancelled or paused outside of the dunning
+	DunningOutcomeStopped DunningOutcome = "stopped"
+	// DunningOutcomeExhausted means the last step ran without payment and the final action was applied
+	DunningOutcomeExhausted DunningOutcome = "exhausted"
+)
+
+func (o DunningOutcome) String() string {
+	return string(o)
+}
+
+// IsFinal returns true if the dunning of the invoice is over
+func (o DunningOutcome) IsFinal() bool {
+	return o != DunningOutcomePending
+}
+
+const (
+	// MAX_DUNNING_STEPS bounds the number of steps of a dunning policy
+	MAX_DUNNING_STEPS = 10
+	// MAX_DUNNING_DAYS bounds how long after a failed payment the last dunning step can run
+	MAX_DUNNING_DAYS = 90
+)
+
+// DunningStep is a step of a dunning policy
+type DunningStep struct {
+	// DaysAfterFailure is the number of days after the failed payment the step runs at
+	DaysAfterFailure int `json:"days_after_failure"`
+	// RetryPayment retries the payment of the invoice
+	RetryPayment bool `json:"retry_payment"`
+	// SendEmail emails the customer about the unpaid invoice when the invoice is still unpaid
+	SendEmail bool `json:"send_email"`
+	// SendWebhook publishes the invoice.dunning.step webhook when the invoice is still unpaid
+	SendWebhook bool `json:"send_webhook"`
+}
+
+// DunningConfig represents the dunning policy of an environment. Subscriptions with a failed
+// invoice payment are past due while the steps run, the final action applies once the last step
+// ran without the invoice being paid.
+type DunningConfig struct {
+	Enabled     bool               `json:"enabled"`
+	Steps       []DunningStep      `json:"steps"`
+	FinalAction DunningFinalAction `json:"final_action"`
+}
+
+// DefaultDunningConfig returns the dunning policy used when an environment has not configured one
+func DefaultDunningConfig() DunningConfig {
+	return DunningConfig{
+		Enabled: false,
+		Steps: []DunningStep{
+			{DaysAfterFailure: 3, RetryPayment: true, SendEmail: true, SendWebhook: true},
+			{DaysAfterFailure: 7, RetryPayment: true, SendEmail: true, SendWebhook: true},
+			{DaysAfterFailure: 14, RetryPayment: true, SendEmail: true, SendWebhook: true},
+		},
+		FinalAction: DunningFinalActionMarkUnpaid,
+	}
+}
+
+// Validate implements SettingConfig interface
+func (c DunningConfig) Validate() error {
+	if err := c.FinalAction.Validate(); err != nil {
+		return err
+	}
+	if c.Enabled && len(c.Steps) == 0 {
+		return ierr.NewError("steps are required").
+			WithHint("An enabled dunning policy needs at least one step").
+			Mark(ierr.ErrValidation)
+	}
+	if len(c.Steps) > MAX_DUNNING_STEPS {
+		return ierr.NewError("too many dunning steps").
+			WithHintf("A dunning policy can have at most %d steps", MAX_DUNNING_STEPS).
+			WithReportableDetails(map[string]any{
+				"steps": len(c.Steps),
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	previous := 0
+	for i, step := range c.Steps {
+		if step.DaysAfterFailure <= previous || step.DaysAfterFailure > MAX_DUNNING_DAYS {
+			return ierr.NewError("invalid days_after_failure").
+				WithHintf("days_after_failure must increase from one step to the next and be between 1 and %d", MAX_DUNNING_DAYS).
+				WithReportableDetails(map[string]any{
+					"step":               i + 1,
+					"days_after_failure": step.DaysAfterFailure,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		previous = step.DaysAfterFailure
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// ExperimentStatus is the lifecycle status of a price experiment
+type ExperimentStatus string
+
+const (
+	// ExperimentStatusDraft experiments can be edited and do not assign customers yet
+	ExperimentStatusDraft ExperimentStatus = "draft"
+	// ExperimentStatusRunning experiments assign new customers to variants
+	ExperimentStatusRunning ExperimentStatus = "running"
+	// ExperimentStatusCompleted experiments keep their assignments but stop assigning customers
+	ExperimentStatusCompleted ExperimentStatus = "completed"
+)
+
+func (s ExperimentStatus) String() string {
+	return string(s)
+}
+
+func (s ExperimentStatus) Validate() error {
+	allowed := []ExperimentStatus{
+		ExperimentStatusDraft,
+		ExperimentStatusRunning,
+		ExperimentStatusCompleted,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid experiment status").
+			WithHint("Experiment status must be draft, running or completed").
+			WithReportableDetails(map[string]any{
+				"status":  s,
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// ExperimentVariant is a plan a share of the customers of an experiment is assigned to
+type ExperimentVariant struct {
+	// Key identifies the variant within the experiment, e.g. control or treatment
+	Key string `json:"key" validate:"required"`
+	// PlanID is the plan subscriptions of customers assigned to the variant are created on
+	PlanID string `json:"plan_id" validate:"required"`
+	// Weight is the relative share of customers assigned to the variant
+	Weight int `json:"weight" validate:"required,gt=0"`
+}
+
+// ValidateExperimentVariants validates the variants of an experiment
+func ValidateExperimentVariants(variants []ExperimentVariant) error {
+	if len(variants) < 2 {
+		return ierr.NewError("experiment needs at least two variants").
+			WithHint("Provide at least two variants to compare").
+			Mark(ierr.ErrValidation)
+	}
+
+	keys := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		if v.Key == "" || v.PlanID == "" {
+			return ierr.NewError("variant key and plan_id are required").
+				WithHint("Every variant needs a key and a plan").
+				Mark(ierr.ErrValidation)
+		}
+		if v.Weight <= 0 {
+			return ierr.NewError("variant weight must be positive").
+				WithHint("Variant weights are relative shares and must be greater than zero").
+				WithReportableDetails(map[string]any{
+					"key":    v.Key,
+					"weight": v.Weight,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if keys[v.Key] {
+			return ierr.NewError("duplicate variant key").
+				WithHint("Variant keys must be unique within an experiment").
+				WithReportableDetails(map[string]any{
+					"key": v.Key,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		keys[v.Key] = true
+	}
+
+	return nil
+}
+
+// ExperimentFilter represents the filter options for experiments
+type ExperimentFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	// filters allows complex filtering based on multiple fields
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	ExperimentIDs    []string           `json:"experiment_ids,omitempty" form:"experiment_ids" validate:"omitempty"`
+	PlanIDs          []string           `json:"plan_ids,omitempty" form:"plan_ids" validate:"omitempty"`
+	ExperimentStatus []ExperimentStatus `json:"experiment_status,omitempty" form:"experiment_status" validate:"omitempty"`
+}
+
+// NewExperimentFilter creates a new experiment filter with default options
+func NewExperimentFilter() *ExperimentFilter {
+	return &ExperimentFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitExperimentFilter creates a new experiment filter without pagination
+func NewNoLimitExperimentFilter() *ExperimentFilter {
+	return &ExperimentFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the filter options
+func (f *ExperimentFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range append(f.ExperimentIDs, f.PlanIDs...) {
+		if id == "" {
+			return ierr.NewError("experiment and plan ids can not be empty").
+				WithHint("Experiment and plan ids can not be empty").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, status := range f.ExperimentStatus {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *ExperimentFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *ExperimentFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *ExperimentFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *ExperimentFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *ExperimentFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *ExperimentFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *ExperimentFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// ExperimentAssignmentFilter represents the filter options for experiment assignments
+type ExperimentAssignmentFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	ExperimentIDs []string `json:"experiment_ids,omitempty" form:"experiment_ids" validate:"omitempty"`
+	CustomerIDs   []string `json:"customer_ids,omitempty" form:"customer_ids" validate:"omitempty"`
+	VariantKeys   []string `json:"variant_keys,omitempty" form:"variant_keys" validate:"omitempty"`
+}
+
+// NewExperimentAssignmentFilter creates a new experiment assignment filter with default options
+func NewExperimentAssignmentFilter() *ExperimentAssignmentFilter {
+	return &ExperimentAssignmentFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitExperimentAssignmentFilter creates a new experiment assignment filter without pagination
+func NewNoLimitExperimentAssignmentFilter() *ExperimentAssignmentFilter {
+	return &ExperimentAssignmentFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the filter options
+func (f *ExperimentAssignmentFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *ExperimentAssignmentFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *ExperimentAssignmentFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
+
+// ExperimentVariantStats represents the assignment and conversion counts of a variant from repository
+type ExperimentVariantStats struct {
+	VariantKey  string
+	Assignments int
+	// Conversions counts assignments whose subscription has been activated
+	Conversions int
+}
+
+// ExperimentVariantRevenue represents the invoiced revenue of a variant in a currency from repository
+type ExperimentVariantRevenue struct {
+	VariantKey string
+	Currency   string
+	Revenue    string
+}
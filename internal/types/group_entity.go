@@ -43,3 +43,103 @@ func IsValidGroupEntityType(entityType string) bool {
 	groupEntityType := GroupEntityType(entityType)
 	return groupEntityType.Validate() == nil
 }
+
+// GroupPoolBasis defines what is accumulated across the members of a pooled tier group
+type GroupPoolBasis string
+
+const (
+	// GroupPoolBasisQuantity accumulates the billable quantities of all member line items
+	// and prices the combined quantity through the group's tier ladder
+	GroupPoolBasisQuantity GroupPoolBasis = "quantity"
+
+	// GroupPoolBasisAmount accumulates the rated amounts of all member line items.
+	// The tier ladder is applied on the combined spend where unit_amount is the rate
+	// charged per currency unit of spend in that tier (e.g. 0.9 for a 10% discount)
+	GroupPoolBasisAmount GroupPoolBasis = "amount"
+)
+
+func (b GroupPoolBasis) String() string {
+	return string(b)
+}
+
+func (b GroupPoolBasis) Validate() error {
+	allowed := []GroupPoolBasis{
+		GroupPoolBasisQuantity,
+		GroupPoolBasisAmount,
+	}
+	if !lo.Contains(allowed, b) {
+		return ierr.NewError("invalid pool basis").
+			WithHint("Unsupported pool basis: " + b.String()).
+			WithReportableDetails(map[string]interface{}{
+				"allowed_basis": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// GroupPoolingConfig defines a tier ladder shared by all prices of a group.
+// Quantities or amounts of every subscription line item whose price belongs to the
+// group are accumulated into a single ladder and the resulting charge is allocated
+// back to the line items proportionally to their contribution.
+type GroupPoolingConfig struct {
+	Basis    GroupPoolBasis `json:"basis"`
+	TierMode BillingTier    `json:"tier_mode"`
+	Tiers    []PriceTier    `json:"tiers"`
+}
+
+// Validate validates the pooling configuration
+func (c *GroupPoolingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if err := c.Basis.Validate(); err != nil {
+		return err
+	}
+
+	if c.TierMode == "" {
+		return ierr.NewError("tier_mode is required for pooled tiers").
+			WithHint("Please provide a tier mode for the pooled tiers").
+			Mark(ierr.ErrValidation)
+	}
+	if err := c.TierMode.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Tiers) == 0 {
+		return ierr.NewError("tiers are required for pooled tiers").
+			WithHint("Please provide at least one tier for the pooled tiers").
+			Mark(ierr.ErrValidation)
+	}
+
+	for i, tier := range c.Tiers {
+		if tier.UnitAmount.IsNegative() || (tier.FlatAmount != nil && tier.FlatAmount.IsNegative()) {
+			return ierr.NewError("pooled tier amounts cannot be negative").
+				WithHint("Pooled tier unit and flat amounts must be non-negative").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		// only the last tier is allowed to be open ended
+		if tier.UpTo == nil && i != len(c.Tiers)-1 {
+			return ierr.NewError("only the last pooled tier can have an empty up_to").
+				WithHint("Set up_to on every pooled tier except the last one").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if i > 0 && tier.UpTo != nil && c.Tiers[i-1].UpTo != nil && *tier.UpTo <= *c.Tiers[i-1].UpTo {
+			return ierr.NewError("pooled tiers must be in ascending order of up_to").
+				WithHint("Each pooled tier must end after the previous one").
+				WithReportableDetails(map[string]interface{}{
+					"tier_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	return nil
+}
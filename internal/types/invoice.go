@@ -170,6 +170,9 @@ const (
 	InvoiceBillingReasonProration InvoiceBillingReason = "PRORATION"
 	// InvoiceBillingReasonManual indicates invoice was created manually by an administrator
 	InvoiceBillingReasonManual InvoiceBillingReason = "MANUAL"
+	// InvoiceBillingReasonSubscriptionThreshold indicates an interim invoice generated because accrued
+	// current period charges exceeded the subscription's billing threshold
+	InvoiceBillingReasonSubscriptionThreshold InvoiceBillingReason = "SUBSCRIPTION_THRESHOLD"
 )
 
 func (r InvoiceBillingReason) String() string {
@@ -183,6 +186,7 @@ func (r InvoiceBillingReason) Validate() error {
 		InvoiceBillingReasonSubscriptionUpdate,
 		InvoiceBillingReasonProration,
 		InvoiceBillingReasonManual,
+		InvoiceBillingReasonSubscriptionThreshold,
 	}
 
 	if r != "" && !lo.Contains(allowed, r) {
@@ -264,6 +268,9 @@ type InvoiceConfig struct {
 	InvoiceNumberSuffixLength              int                 `json:"suffix_length,omitempty" validate:"required,min=1,max=10"`
 	DueDateDays                            *int                `json:"due_date_days,omitempty" validate:"omitempty,min=0"` // Number of days after period end when payment is due
 	AutoCompletePurchasedCreditTransaction bool                `json:"auto_complete_purchased_credit_transaction,omitempty"`
+	// ConsolidateSubscriptionInvoices invoices the subscriptions of a customer sharing a billing anchor
+	// and currency together. Customers can opt in or out individually with consolidate_invoices.
+	ConsolidateSubscriptionInvoices bool `json:"consolidate_subscription_invoices,omitempty"`
 }
 
 // Validate implements SettingConfig interface
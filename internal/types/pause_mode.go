@@ -16,7 +16,6 @@ const (
 	PauseModeScheduled PauseMode = "scheduled"
 
 	// PauseModePeriodEnd pauses the subscription at the end of the current billing period
-	// Not supported in Phase 0
 	PauseModePeriodEnd PauseMode = "period_end"
 )
 
@@ -46,6 +45,44 @@ func (m PauseMode) String() string {
 	return string(m)
 }
 
+// PauseBillingMode represents what is billed while a subscription is paused
+type PauseBillingMode string
+
+const (
+	// PauseBillingModeStopAll stops all billing during the pause, the billing period is moved
+	// by the length of the pause when the subscription resumes
+	PauseBillingModeStopAll PauseBillingMode = "stop_all"
+
+	// PauseBillingModeFixedFeesOnly keeps billing the fixed fees of the subscription every period
+	// during the pause, usage recorded during the pause is not billed
+	PauseBillingModeFixedFeesOnly PauseBillingMode = "fixed_fees_only"
+)
+
+// Validate validates the pause billing mode
+func (m PauseBillingMode) Validate() error {
+	allowed := []PauseBillingMode{
+		PauseBillingModeStopAll,
+		PauseBillingModeFixedFeesOnly,
+	}
+
+	if !lo.Contains(allowed, m) {
+		return ierr.NewError("invalid billing_mode").
+			WithHint("Invalid pause billing mode").
+			WithReportableDetails(map[string]any{
+				"mode":          m,
+				"allowed_modes": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the pause billing mode
+func (m PauseBillingMode) String() string {
+	return string(m)
+}
+
 // ResumeMode represents the mode of resuming a subscription
 type ResumeMode string
 
@@ -1,6 +1,9 @@
 package types
 
-import ierr "github.com/flexprice/flexprice/internal/errors"
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
 
 // PlanFilter represents the filter options for plans
 type PlanFilter struct {
@@ -12,6 +15,10 @@ type PlanFilter struct {
 	Sort      []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
 	PlanIDs   []string           `json:"plan_ids,omitempty" form:"plan_ids" validate:"omitempty"`
 	LookupKey *string            `json:"lookup_key,omitempty" form:"lookup_key" validate:"omitempty"`
+
+	// BasePlanID lists all versions of a plan, including the first one
+	BasePlanID    string              `json:"base_plan_id,omitempty" form:"base_plan_id" validate:"omitempty"`
+	VersionStatus []PlanVersionStatus `json:"version_status,omitempty" form:"version_status" validate:"omitempty"`
 }
 
 // NewPlanFilter creates a new plan filter with default options
@@ -41,6 +48,12 @@ func (f *PlanFilter) Validate() error {
 		}
 	}
 
+	for _, status := range f.VersionStatus {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+	}
+
 	for _, planID := range f.PlanIDs {
 		if planID == "" {
 			return ierr.NewError("plan id can not be empty").
@@ -105,3 +118,46 @@ func (f *PlanFilter) IsUnlimited() bool {
 	}
 	return f.QueryFilter.IsUnlimited()
 }
+
+// PlanVersionStatus is the lifecycle status of a plan version
+type PlanVersionStatus string
+
+const (
+	// PlanVersionStatusDraft versions can be edited freely and cannot be subscribed to
+	PlanVersionStatusDraft PlanVersionStatus = "draft"
+	// PlanVersionStatusPublished is the version new subscriptions are created on
+	PlanVersionStatusPublished PlanVersionStatus = "published"
+	// PlanVersionStatusArchived versions keep serving their subscriptions but accept no new ones
+	PlanVersionStatusArchived PlanVersionStatus = "archived"
+)
+
+func (s PlanVersionStatus) String() string {
+	return string(s)
+}
+
+func (s PlanVersionStatus) Validate() error {
+	allowed := []PlanVersionStatus{
+		PlanVersionStatusDraft,
+		PlanVersionStatusPublished,
+		PlanVersionStatusArchived,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewErrorf("invalid plan version status: %s", s).
+			WithHint("Plan version status must be draft, published or archived").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// PlanVersionChange describes how a price or entitlement differs between two plan versions
+type PlanVersionChange string
+
+const (
+	PlanVersionChangeAdded     PlanVersionChange = "added"
+	PlanVersionChangeRemoved   PlanVersionChange = "removed"
+	PlanVersionChangeChanged   PlanVersionChange = "changed"
+	PlanVersionChangeUnchanged PlanVersionChange = "unchanged"
+)
@@ -33,6 +33,7 @@ type PriceScope string
 // If prices is create for subscription then it will have SUBSCRIPTION as entity type with enitiy id as subscription id
 // If prices is create for addon then it will have ADDON as entity type with enitiy id as addon id
 // If prices is create for price overrides in subscription creation	 then it will have PRICE as entity type with enitiy id as price id
+// If prices is create for the rate card of a customer then it will have CUSTOMER as entity type with entity id as customer id
 type PriceEntityType string
 
 const (
@@ -41,6 +42,7 @@ const (
 	PRICE_ENTITY_TYPE_ADDON        PriceEntityType = "ADDON"
 	PRICE_ENTITY_TYPE_PRICE        PriceEntityType = "PRICE"
 	PRICE_ENTITY_TYPE_COSTSHEET    PriceEntityType = "COSTSHEET"
+	PRICE_ENTITY_TYPE_CUSTOMER     PriceEntityType = "CUSTOMER"
 )
 
 func (p PriceEntityType) Validate() error {
@@ -50,6 +52,7 @@ func (p PriceEntityType) Validate() error {
 		PRICE_ENTITY_TYPE_ADDON,
 		PRICE_ENTITY_TYPE_PRICE,
 		PRICE_ENTITY_TYPE_COSTSHEET,
+		PRICE_ENTITY_TYPE_CUSTOMER,
 	}
 	if p != "" && !lo.Contains(allowed, p) {
 		return ierr.NewError("invalid price entity type").
@@ -176,6 +179,10 @@ const (
 	// ex 1-100 emails for $100, 101-1000 emails for $90
 	BILLING_MODEL_TIERED BillingModel = "TIERED"
 
+	// Billing model for usage charged on top of the cost in the active costsheet
+	// ex cost + 20% or cost × 1.3
+	BILLING_MODEL_COST_PLUS BillingModel = "COST_PLUS"
+
 	// For BILLING_CADENCE_RECURRING
 	BILLING_PERIOD_MONTHLY   BillingPeriod = "MONTHLY"
 	BILLING_PERIOD_ANNUAL    BillingPeriod = "ANNUAL"
@@ -195,6 +202,10 @@ const (
 	// Tier boundaries are INCLUSIVE: if up_to is 1000, quantity 1000 belongs to this tier
 	BILLING_TIER_SLAB BillingTier = "SLAB"
 
+	// BILLING_TIER_STAIR_STEP means the whole quantity is charged the flat amount of the bracket it falls into
+	// Tier boundaries are INCLUSIVE: with brackets up to 10 and up to 50, quantity 10 is charged the first flat amount
+	BILLING_TIER_STAIR_STEP BillingTier = "STAIR_STEP"
+
 	// MAX_BILLING_AMOUNT is the maximum allowed billing amount (as a safeguard)
 	MAX_BILLING_AMOUNT = 1000000000000 // 1 trillion
 
@@ -255,6 +266,7 @@ func (b BillingModel) Validate() error {
 		BILLING_MODEL_FLAT_FEE,
 		BILLING_MODEL_PACKAGE,
 		BILLING_MODEL_TIERED,
+		BILLING_MODEL_COST_PLUS,
 	}
 
 	if b != "" && !lo.Contains(allowed, b) {
@@ -273,6 +285,7 @@ func (b BillingTier) Validate() error {
 	allowed := []BillingTier{
 		BILLING_TIER_VOLUME,
 		BILLING_TIER_SLAB,
+		BILLING_TIER_STAIR_STEP,
 	}
 	if b != "" && !lo.Contains(allowed, b) {
 		return ierr.NewError("invalid billing tier").
@@ -0,0 +1,194 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+)
+
+// QuoteStatus is the lifecycle status of a quote
+type QuoteStatus string
+
+const (
+	// QuoteStatusDraft quotes can be edited and are not shared with the customer yet
+	QuoteStatusDraft QuoteStatus = "draft"
+	// QuoteStatusOpen quotes are finalized and wait for the customer to accept or decline them
+	QuoteStatusOpen QuoteStatus = "open"
+	// QuoteStatusAccepted quotes have been converted into a subscription
+	QuoteStatusAccepted QuoteStatus = "accepted"
+	// QuoteStatusDeclined quotes have been declined by the customer
+	QuoteStatusDeclined QuoteStatus = "declined"
+	// QuoteStatusExpired quotes were not accepted before their validity date
+	QuoteStatusExpired QuoteStatus = "expired"
+)
+
+func (s QuoteStatus) String() string {
+	return string(s)
+}
+
+func (s QuoteStatus) Validate() error {
+	allowed := []QuoteStatus{
+		QuoteStatusDraft,
+		QuoteStatusOpen,
+		QuoteStatusAccepted,
+		QuoteStatusDeclined,
+		QuoteStatusExpired,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewError("invalid quote status").
+			WithHint("Quote status must be draft, open, accepted, declined or expired").
+			WithReportableDetails(map[string]any{
+				"status":  s,
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// QuotePhase is a phase of the subscription proposed by a quote. Phases follow each other from the
+// start of the subscription, line items of the quote assigned to a phase only apply during it.
+type QuotePhase struct {
+	// Name is shown on the quote, e.g. Ramp-up
+	Name string `json:"name,omitempty"`
+	// DurationPeriods is the number of billing periods the phase lasts, the last phase may be open ended
+	DurationPeriods int `json:"duration_periods,omitempty" validate:"omitempty,gt=0"`
+	// Coupons are applied to the subscription during the phase
+	Coupons []string `json:"coupons,omitempty"`
+}
+
+// ValidateQuotePhases validates the phases of a quote
+func ValidateQuotePhases(phases []QuotePhase) error {
+	for i, phase := range phases {
+		if phase.DurationPeriods < 0 {
+			return ierr.NewError("phase duration_periods must be positive").
+				WithHint("Phases last a positive number of billing periods").
+				WithReportableDetails(map[string]any{
+					"phase_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		if phase.DurationPeriods == 0 && i != len(phases)-1 {
+			return ierr.NewError("phase duration_periods is required for all phases except the last").
+				WithHint("Only the last phase of a quote can be open ended").
+				WithReportableDetails(map[string]any{
+					"phase_index": i,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// QuoteFilter represents the filter options for quotes
+type QuoteFilter struct {
+	*QueryFilter
+	*TimeRangeFilter
+
+	// filters allows complex filtering based on multiple fields
+	Filters []*FilterCondition `json:"filters,omitempty" form:"filters" validate:"omitempty"`
+	Sort    []*SortCondition   `json:"sort,omitempty" form:"sort" validate:"omitempty"`
+
+	QuoteIDs    []string      `json:"quote_ids,omitempty" form:"quote_ids" validate:"omitempty"`
+	CustomerIDs []string      `json:"customer_ids,omitempty" form:"customer_ids" validate:"omitempty"`
+	PlanIDs     []string      `json:"plan_ids,omitempty" form:"plan_ids" validate:"omitempty"`
+	QuoteStatus []QuoteStatus `json:"quote_status,omitempty" form:"quote_status" validate:"omitempty"`
+}
+
+// NewQuoteFilter creates a new quote filter with default options
+func NewQuoteFilter() *QuoteFilter {
+	return &QuoteFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+}
+
+// NewNoLimitQuoteFilter creates a new quote filter without pagination
+func NewNoLimitQuoteFilter() *QuoteFilter {
+	return &QuoteFilter{
+		QueryFilter: NewNoLimitQueryFilter(),
+	}
+}
+
+// Validate validates the filter options
+func (f *QuoteFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.TimeRangeFilter != nil {
+		if err := f.TimeRangeFilter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range append(append(f.QuoteIDs, f.CustomerIDs...), f.PlanIDs...) {
+		if id == "" {
+			return ierr.NewError("quote, customer and plan ids can not be empty").
+				WithHint("Quote, customer and plan ids can not be empty").
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, status := range f.QuoteStatus {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLimit implements BaseFilter interface
+func (f *QuoteFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset implements BaseFilter interface
+func (f *QuoteFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort implements BaseFilter interface
+func (f *QuoteFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder implements BaseFilter interface
+func (f *QuoteFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus implements BaseFilter interface
+func (f *QuoteFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand implements BaseFilter interface
+func (f *QuoteFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+func (f *QuoteFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().IsUnlimited()
+	}
+	return f.QueryFilter.IsUnlimited()
+}
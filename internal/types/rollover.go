@@ -0,0 +1,199 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/shopspring/decimal"
+)
+
+// RolloverConfig configures the carryover of unused included usage into the following periods.
+// Included usage is the usage limit of an entitlement that resets every billing period and the
+// free leading tiers of a usage price.
+type RolloverConfig struct {
+	// MaxCarryover is the maximum quantity carried over from a single period, unlimited when empty
+	MaxCarryover *decimal.Decimal `json:"max_carryover,omitempty" swaggertype:"string"`
+
+	// MaxPeriods is the number of following periods in which carried over units can be used
+	MaxPeriods int `json:"max_periods"`
+}
+
+// Validate validates the rollover config
+func (c *RolloverConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MaxPeriods < 1 {
+		return ierr.NewError("rollover max_periods must be at least 1").
+			WithHint("Please provide the number of periods unused usage rolls over for").
+			WithReportableDetails(map[string]interface{}{
+				"max_periods": c.MaxPeriods,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if c.MaxCarryover != nil && c.MaxCarryover.IsNegative() {
+		return ierr.NewError("rollover max_carryover cannot be negative").
+			WithHint("Please provide a non-negative maximum carryover").
+			WithReportableDetails(map[string]interface{}{
+				"max_carryover": c.MaxCarryover,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// Carryover returns the quantity carried over for the given unused included usage
+func (c *RolloverConfig) Carryover(unused decimal.Decimal) decimal.Decimal {
+	if !unused.IsPositive() {
+		return decimal.Zero
+	}
+	if c.MaxCarryover != nil {
+		return decimal.Min(unused, *c.MaxCarryover)
+	}
+	return unused
+}
+
+// RolloverBalance is a quantity of unused included usage carried over from a period
+type RolloverBalance struct {
+	SourcePeriodStart time.Time       `json:"source_period_start"`
+	SourcePeriodEnd   time.Time       `json:"source_period_end"`
+	Quantity          decimal.Decimal `json:"quantity" swaggertype:"string"`
+	Remaining         decimal.Decimal `json:"remaining" swaggertype:"string"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+}
+
+// IsAvailableAt returns true if the balance can be used in a period starting at t
+func (b RolloverBalance) IsAvailableAt(t time.Time) bool {
+	return b.Remaining.IsPositive() && !b.SourcePeriodEnd.After(t) && b.ExpiresAt.After(t)
+}
+
+// RolloverSettlement records how rollover was applied to a billing period of a line item
+type RolloverSettlement struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// Usage is the usage of the period before rolled over units and included usage were applied
+	Usage decimal.Decimal `json:"usage" swaggertype:"string"`
+	// Included is the included usage of the period
+	Included decimal.Decimal `json:"included" swaggertype:"string"`
+	// Consumed is the rolled over quantity used in the period
+	Consumed decimal.Decimal `json:"consumed" swaggertype:"string"`
+	// Granted is the unused included usage carried over into the following periods
+	Granted   decimal.Decimal `json:"granted" swaggertype:"string"`
+	SettledAt time.Time       `json:"settled_at"`
+}
+
+// LineItemRollover tracks the rollover balances of a subscription line item
+type LineItemRollover struct {
+	Balances    []RolloverBalance    `json:"balances,omitempty"`
+	Settlements []RolloverSettlement `json:"settlements,omitempty"`
+}
+
+// Available returns the rolled over quantity that can be used in a period starting at t
+func (r *LineItemRollover) Available(t time.Time) decimal.Decimal {
+	available := decimal.Zero
+	if r == nil {
+		return available
+	}
+	for _, b := range r.Balances {
+		if b.IsAvailableAt(t) {
+			available = available.Add(b.Remaining)
+		}
+	}
+	return available
+}
+
+// SettlementFor returns the settlement of the period starting at periodStart
+func (r *LineItemRollover) SettlementFor(periodStart time.Time) (*RolloverSettlement, bool) {
+	if r == nil {
+		return nil, false
+	}
+	for i := range r.Settlements {
+		if r.Settlements[i].PeriodStart.Equal(periodStart) {
+			return &r.Settlements[i], true
+		}
+	}
+	return nil, false
+}
+
+// ConsumedIn returns the rolled over quantity used by the given usage in the period starting at
+// periodStart. Rolled over units are used before the included usage of the period, settled
+// periods return what was recorded at settlement.
+func (r *LineItemRollover) ConsumedIn(periodStart time.Time, usage decimal.Decimal) decimal.Decimal {
+	if settlement, ok := r.SettlementFor(periodStart); ok {
+		return settlement.Consumed
+	}
+	if !usage.IsPositive() {
+		return decimal.Zero
+	}
+	return decimal.Min(r.Available(periodStart), usage)
+}
+
+// Settle applies rollover to a billing period. Rolled over units are consumed first, oldest
+// expiry first, and the included usage left unused is carried over until expiresAt. Settling
+// an already settled period returns the recorded settlement.
+func (r *LineItemRollover) Settle(
+	config *RolloverConfig,
+	periodStart, periodEnd time.Time,
+	usage, included decimal.Decimal,
+	expiresAt time.Time,
+) RolloverSettlement {
+	if settlement, ok := r.SettlementFor(periodStart); ok {
+		return *settlement
+	}
+
+	consumed := r.ConsumedIn(periodStart, usage)
+
+	// Consume the balances that expire first
+	sort.SliceStable(r.Balances, func(i, j int) bool {
+		return r.Balances[i].ExpiresAt.Before(r.Balances[j].ExpiresAt)
+	})
+	toConsume := consumed
+	for i := range r.Balances {
+		if !toConsume.IsPositive() {
+			break
+		}
+		if !r.Balances[i].IsAvailableAt(periodStart) {
+			continue
+		}
+		used := decimal.Min(r.Balances[i].Remaining, toConsume)
+		r.Balances[i].Remaining = r.Balances[i].Remaining.Sub(used)
+		toConsume = toConsume.Sub(used)
+	}
+
+	unused := decimal.Max(decimal.Zero, included.Sub(usage.Sub(consumed)))
+	granted := config.Carryover(unused)
+	if granted.IsPositive() {
+		r.Balances = append(r.Balances, RolloverBalance{
+			SourcePeriodStart: periodStart,
+			SourcePeriodEnd:   periodEnd,
+			Quantity:          granted,
+			Remaining:         granted,
+			ExpiresAt:         expiresAt,
+		})
+	}
+
+	// Drop balances that are used up or can not be used in any later period
+	kept := make([]RolloverBalance, 0, len(r.Balances))
+	for _, b := range r.Balances {
+		if b.Remaining.IsPositive() && b.ExpiresAt.After(periodEnd) {
+			kept = append(kept, b)
+		}
+	}
+	r.Balances = kept
+
+	settlement := RolloverSettlement{
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Usage:       usage,
+		Included:    included,
+		Consumed:    consumed,
+		Granted:     granted,
+		SettledAt:   time.Now().UTC(),
+	}
+	r.Settlements = append(r.Settlements, settlement)
+	return settlement
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloverConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *RolloverConfig
+		wantErr bool
+	}{
+		{
+			name: "no_rollover",
+		},
+		{
+			name:   "unlimited_carryover",
+			config: &RolloverConfig{MaxPeriods: 2},
+		},
+		{
+			name:   "capped_carryover",
+			config: &RolloverConfig{MaxPeriods: 1, MaxCarryover: lo.ToPtr(decimal.NewFromInt(500))},
+		},
+		{
+			name:    "zero_periods",
+			config:  &RolloverConfig{MaxPeriods: 0},
+			wantErr: true,
+		},
+		{
+			name:    "negative_carryover",
+			config:  &RolloverConfig{MaxPeriods: 2, MaxCarryover: lo.ToPtr(decimal.NewFromInt(-1))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLineItemRollover_Settle(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	mar := jan.AddDate(0, 2, 0)
+	apr := jan.AddDate(0, 3, 0)
+	may := jan.AddDate(0, 4, 0)
+
+	// 1000 minutes included per month, unused minutes roll over for up to 2 months capped at 600
+	config := &RolloverConfig{MaxPeriods: 2, MaxCarryover: lo.ToPtr(decimal.NewFromInt(600))}
+	included := decimal.NewFromInt(1000)
+
+	var empty *LineItemRollover
+	assert.True(t, empty.Available(jan).IsZero())
+	assert.True(t, empty.ConsumedIn(jan, decimal.NewFromInt(100)).IsZero())
+
+	rollover := &LineItemRollover{}
+
+	// January uses 200 of 1000 minutes, 800 are unused and 600 roll over until the end of March
+	settlement := rollover.Settle(config, jan, feb, decimal.NewFromInt(200), included, apr)
+	assert.True(t, settlement.Consumed.IsZero())
+	assert.True(t, decimal.NewFromInt(600).Equal(settlement.Granted), "got %s", settlement.Granted)
+	require.Len(t, rollover.Balances, 1)
+
+	// Rolled over minutes are not available in the period they come from
+	assert.True(t, rollover.Available(jan).IsZero())
+	assert.True(t, decimal.NewFromInt(600).Equal(rollover.Available(feb)))
+
+	// Settling the same period again does not grant twice
+	rollover.Settle(config, jan, feb, decimal.NewFromInt(200), included, apr)
+	require.Len(t, rollover.Balances, 1)
+	require.Len(t, rollover.Settlements, 1)
+
+	// February uses 1300 minutes, rolled over minutes go first so only 700 included minutes are used
+	assert.True(t, decimal.NewFromInt(600).Equal(rollover.ConsumedIn(feb, decimal.NewFromInt(1300))))
+	settlement = rollover.Settle(config, feb, mar, decimal.NewFromInt(1300), included, may)
+	assert.True(t, decimal.NewFromInt(600).Equal(settlement.Consumed), "got %s", settlement.Consumed)
+	assert.True(t, decimal.NewFromInt(300).Equal(settlement.Granted), "got %s", settlement.Granted)
+	require.Len(t, rollover.Balances, 1)
+	assert.True(t, rollover.Balances[0].SourcePeriodStart.Equal(feb))
+
+	// Settled periods keep the recorded consumption when rated again
+	assert.True(t, decimal.NewFromInt(600).Equal(rollover.ConsumedIn(feb, decimal.NewFromInt(50))))
+
+	// March uses nothing, the 300 minutes from February are kept and 600 more roll over
+	rollover.Settle(config, mar, apr, decimal.Zero, included, may.AddDate(0, 1, 0))
+	assert.True(t, decimal.NewFromInt(900).Equal(rollover.Available(apr)))
+
+	// April uses 1000 minutes, the 900 rolled over minutes go first and use up both balances
+	settlement = rollover.Settle(config, apr, may, decimal.NewFromInt(1000), included, may.AddDate(0, 2, 0))
+	assert.True(t, decimal.NewFromInt(900).Equal(settlement.Consumed), "got %s", settlement.Consumed)
+	require.Len(t, rollover.Balances, 1)
+	assert.True(t, decimal.NewFromInt(600).Equal(rollover.Available(may)), "got %s", rollover.Available(may))
+	for _, balance := range rollover.Balances {
+		assert.True(t, balance.ExpiresAt.After(may))
+	}
+}
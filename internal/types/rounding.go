@@ -0,0 +1,172 @@
+package types
+
+import (
+	"strings"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode determines how a half-way amount is rounded
+type RoundingMode string
+
+const (
+	// RoundingModeHalfUp rounds half-way amounts away from zero (10.125 -> 10.13)
+	RoundingModeHalfUp RoundingMode = "half_up"
+	// RoundingModeHalfEven rounds half-way amounts to the nearest even digit, a.k.a. banker's rounding (10.125 -> 10.12)
+	RoundingModeHalfEven RoundingMode = "half_even"
+)
+
+func (m RoundingMode) Validate() error {
+	allowed := []RoundingMode{
+		RoundingModeHalfUp,
+		RoundingModeHalfEven,
+	}
+	if !lo.Contains(allowed, m) {
+		return ierr.NewErrorf("invalid rounding mode: %s", m).
+			WithHint("Rounding mode must be half_up or half_even").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// RoundingLevel determines whether amounts are rounded per line item or once on the invoice total
+type RoundingLevel string
+
+const (
+	// RoundingLevelLineItem rounds every line item amount to the currency precision
+	RoundingLevelLineItem RoundingLevel = "line_item"
+	// RoundingLevelInvoice keeps line item amounts unrounded and rounds the invoice subtotal
+	RoundingLevelInvoice RoundingLevel = "invoice"
+)
+
+func (l RoundingLevel) Validate() error {
+	allowed := []RoundingLevel{
+		RoundingLevelLineItem,
+		RoundingLevelInvoice,
+	}
+	if !lo.Contains(allowed, l) {
+		return ierr.NewErrorf("invalid rounding level: %s", l).
+			WithHint("Rounding level must be line_item or invoice").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+const (
+	// DEFAULT_UNIT_AMOUNT_PRECISION is the default precision of unit rates converted from custom price units
+	DEFAULT_UNIT_AMOUNT_PRECISION = 8
+	// MAX_UNIT_AMOUNT_PRECISION is bounded by the scale of the price amount columns
+	MAX_UNIT_AMOUNT_PRECISION = 15
+)
+
+// CurrencyRoundingConfig overrides the rounding policy for a single currency
+type CurrencyRoundingConfig struct {
+	Mode  RoundingMode  `json:"mode,omitempty"`
+	Level RoundingLevel `json:"level,omitempty"`
+}
+
+// RoundingConfig represents the rounding policy of an environment
+type RoundingConfig struct {
+	Mode  RoundingMode  `json:"mode"`
+	Level RoundingLevel `json:"level"`
+	// UnitAmountPrecision is the number of decimal places kept for unit rates, allowing sub-cent
+	// rates for custom price units while totals are rounded to the currency precision
+	UnitAmountPrecision int32 `json:"unit_amount_precision"`
+	// Currencies overrides the policy per lowercase currency code
+	Currencies map[string]CurrencyRoundingConfig `json:"currencies,omitempty"`
+}
+
+// DefaultRoundingConfig returns the rounding policy used when an environment has not configured one
+func DefaultRoundingConfig() RoundingConfig {
+	return RoundingConfig{
+		Mode:                RoundingModeHalfUp,
+		Level:               RoundingLevelLineItem,
+		UnitAmountPrecision: DEFAULT_UNIT_AMOUNT_PRECISION,
+	}
+}
+
+// Validate implements SettingConfig interface
+func (c RoundingConfig) Validate() error {
+	if err := c.Mode.Validate(); err != nil {
+		return err
+	}
+	if err := c.Level.Validate(); err != nil {
+		return err
+	}
+	if c.UnitAmountPrecision < 1 || c.UnitAmountPrecision > MAX_UNIT_AMOUNT_PRECISION {
+		return ierr.NewError("invalid unit_amount_precision").
+			WithHintf("unit_amount_precision must be between 1 and %d", MAX_UNIT_AMOUNT_PRECISION).
+			WithReportableDetails(map[string]any{
+				"unit_amount_precision": c.UnitAmountPrecision,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	for currency, override := range c.Currencies {
+		if err := ValidateCurrencyCode(currency); err != nil {
+			return err
+		}
+		if override.Mode != "" {
+			if err := override.Mode.Validate(); err != nil {
+				return err
+			}
+		}
+		if override.Level != "" {
+			if err := override.Level.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ForCurrency returns the mode and level that apply to the given currency
+func (c RoundingConfig) ForCurrency(currency string) (RoundingMode, RoundingLevel) {
+	mode := lo.Ternary(c.Mode != "", c.Mode, RoundingModeHalfUp)
+	level := lo.Ternary(c.Level != "", c.Level, RoundingLevelLineItem)
+	if override, ok := c.Currencies[strings.ToLower(currency)]; ok {
+		mode = lo.Ternary(override.Mode != "", override.Mode, mode)
+		level = lo.Ternary(override.Level != "", override.Level, level)
+	}
+	return mode, level
+}
+
+// RoundAmount rounds an amount to the currency precision using the configured mode.
+// Use it for amounts that are always rounded, e.g. invoice totals, taxes and credit notes.
+func (c RoundingConfig) RoundAmount(amount decimal.Decimal, currency string) decimal.Decimal {
+	mode, _ := c.ForCurrency(currency)
+	return RoundDecimal(amount, GetCurrencyPrecision(currency), mode)
+}
+
+// RoundLineItemAmount rounds a line item amount to the currency precision when rounding happens
+// at the line item level. With invoice level rounding the amount is returned unchanged.
+func (c RoundingConfig) RoundLineItemAmount(amount decimal.Decimal, currency string) decimal.Decimal {
+	mode, level := c.ForCurrency(currency)
+	if level == RoundingLevelInvoice {
+		return amount
+	}
+	return RoundDecimal(amount, GetCurrencyPrecision(currency), mode)
+}
+
+// RoundUnitAmount rounds a unit rate to the configured unit amount precision, which may exceed
+// the currency precision. An unset precision falls back to the default precision.
+func (c RoundingConfig) RoundUnitAmount(amount decimal.Decimal, currency string) decimal.Decimal {
+	mode, _ := c.ForCurrency(currency)
+	precision := lo.Ternary(c.UnitAmountPrecision > 0, c.UnitAmountPrecision, DEFAULT_UNIT_AMOUNT_PRECISION)
+	return RoundDecimal(amount, lo.Max([]int32{precision, GetCurrencyPrecision(currency)}), mode)
+}
+
+// RoundDecimal rounds an amount to the given number of decimal places using the given mode
+func RoundDecimal(amount decimal.Decimal, precision int32, mode RoundingMode) decimal.Decimal {
+	if mode == RoundingModeHalfEven {
+		return amount.RoundBank(precision)
+	}
+	return amount.Round(precision)
+}
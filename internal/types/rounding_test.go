@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingConfig_RoundAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   RoundingConfig
+		amount   string
+		currency string
+		expected string
+	}{
+		{
+			name:     "half_up_rounds_half_away_from_zero",
+			config:   DefaultRoundingConfig(),
+			amount:   "10.125",
+			currency: "usd",
+			expected: "10.13",
+		},
+		{
+			name:     "half_even_rounds_half_to_even",
+			config:   RoundingConfig{Mode: RoundingModeHalfEven, Level: RoundingLevelLineItem},
+			amount:   "10.125",
+			currency: "usd",
+			expected: "10.12",
+		},
+		{
+			name:     "half_even_zero_decimal_currency",
+			config:   RoundingConfig{Mode: RoundingModeHalfEven, Level: RoundingLevelLineItem},
+			amount:   "1024.5",
+			currency: "jpy",
+			expected: "1024",
+		},
+		{
+			name: "currency_override",
+			config: RoundingConfig{
+				Mode:       RoundingModeHalfUp,
+				Level:      RoundingLevelLineItem,
+				Currencies: map[string]CurrencyRoundingConfig{"eur": {Mode: RoundingModeHalfEven}},
+			},
+			amount:   "10.125",
+			currency: "EUR",
+			expected: "10.12",
+		},
+		{
+			name:     "empty_config_defaults_to_half_up",
+			config:   RoundingConfig{},
+			amount:   "10.125",
+			currency: "usd",
+			expected: "10.13",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.RoundAmount(decimal.RequireFromString(tt.amount), tt.currency)
+			assert.Equal(t, tt.expected, result.StringFixed(GetCurrencyPrecision(tt.currency)))
+		})
+	}
+}
+
+func TestRoundingConfig_RoundLineItemAmount(t *testing.T) {
+	amount := decimal.RequireFromString("10.123456")
+
+	lineItem := RoundingConfig{Mode: RoundingModeHalfUp, Level: RoundingLevelLineItem}
+	assert.True(t, decimal.RequireFromString("10.12").Equal(lineItem.RoundLineItemAmount(amount, "usd")))
+
+	invoice := RoundingConfig{Mode: RoundingModeHalfUp, Level: RoundingLevelInvoice}
+	assert.True(t, amount.Equal(invoice.RoundLineItemAmount(amount, "usd")))
+	assert.True(t, decimal.RequireFromString("10.12").Equal(invoice.RoundAmount(amount, "usd")))
+}
+
+func TestRoundingConfig_RoundUnitAmount(t *testing.T) {
+	config := DefaultRoundingConfig()
+	config.UnitAmountPrecision = 4
+
+	// Unit rates keep sub-cent precision
+	assert.Equal(t, "0.0013", config.RoundUnitAmount(decimal.RequireFromString("0.00125"), "usd").String())
+	// but never less than the currency precision
+	config.UnitAmountPrecision = 1
+	assert.Equal(t, "0.13", config.RoundUnitAmount(decimal.RequireFromString("0.125"), "usd").String())
+}
+
+func TestRoundingConfig_Validate(t *testing.T) {
+	assert.NoError(t, DefaultRoundingConfig().Validate())
+
+	invalidMode := DefaultRoundingConfig()
+	invalidMode.Mode = "ceil"
+	assert.Error(t, invalidMode.Validate())
+
+	invalidLevel := DefaultRoundingConfig()
+	invalidLevel.Level = "tax"
+	assert.Error(t, invalidLevel.Validate())
+
+	invalidPrecision := DefaultRoundingConfig()
+	invalidPrecision.UnitAmountPrecision = MAX_UNIT_AMOUNT_PRECISION + 1
+	assert.Error(t, invalidPrecision.Validate())
+
+	zeroPrecision := DefaultRoundingConfig()
+	zeroPrecision.UnitAmountPrecision = 0
+	assert.Error(t, zeroPrecision.Validate())
+
+	invalidOverride := DefaultRoundingConfig()
+	invalidOverride.Currencies = map[string]CurrencyRoundingConfig{"usd": {Level: "tax"}}
+	assert.Error(t, invalidOverride.Validate())
+}
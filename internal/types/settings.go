@@ -26,6 +26,9 @@ const (
 	SettingKeyCustomerOnboarding       SettingKey = "customer_onboarding"
 	SettingKeyWalletBalanceAlertConfig SettingKey = "wallet_balance_alert_config"
 	SettingKeyPrepareProcessedEvents   SettingKey = "prepare_processed_events_config"
+	SettingKeyRoundingConfig           SettingKey = "rounding_config"
+	SettingKeyDunningConfig            SettingKey = "dunning_config"
+	SettingKeySpendLimitConfig         SettingKey = "spend_limit_config"
 )
 
 func (s *SettingKey) Validate() error {
@@ -38,6 +41,9 @@ func (s *SettingKey) Validate() error {
 		SettingKeyCustomerOnboarding,
 		SettingKeyWalletBalanceAlertConfig,
 		SettingKeyPrepareProcessedEvents,
+		SettingKeyRoundingConfig,
+		SettingKeyDunningConfig,
+		SettingKeySpendLimitConfig,
 	}
 
 	if !lo.Contains(allowedKeys, *s) {
@@ -255,6 +261,7 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 		InvoiceNumberSuffixLength:              5,
 		DueDateDays:                            lo.ToPtr(1),
 		AutoCompletePurchasedCreditTransaction: false,
+		ConsolidateSubscriptionInvoices:        false,
 	}
 
 	defaultSubscriptionConfig := SubscriptionConfig{
@@ -299,6 +306,10 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 		},
 	}
 
+	defaultRoundingConfig := DefaultRoundingConfig()
+	defaultDunningConfig := DefaultDunningConfig()
+	defaultSpendLimitConfig := DefaultSpendLimitConfig()
+
 	// Convert typed structs to maps using centralized utility
 	invoiceConfigMap, err := utils.ToMap(defaultInvoiceConfig)
 	if err != nil {
@@ -327,6 +338,19 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 	// Already a map, no conversion needed
 	defaultPrepareProcessedEventsConfigMap := defaultPrepareProcessedEventsConfig
 
+	roundingConfigMap, err := utils.ToMap(defaultRoundingConfig)
+	if err != nil {
+		return nil, err
+	}
+	dunningConfigMap, err := utils.ToMap(defaultDunningConfig)
+	if err != nil {
+		return nil, err
+	}
+	spendLimitConfigMap, err := utils.ToMap(defaultSpendLimitConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[SettingKey]DefaultSettingValue{
 		SettingKeyInvoiceConfig: {
 			Key:          SettingKeyInvoiceConfig,
@@ -363,6 +387,21 @@ func GetDefaultSettings() (map[SettingKey]DefaultSettingValue, error) {
 			DefaultValue: defaultPrepareProcessedEventsConfigMap,
 			Description:  "Configuration for preparing processed events (auto-create missing feature/meter/price and optional subscription rollout)",
 		},
+		SettingKeyRoundingConfig: {
+			Key:          SettingKeyRoundingConfig,
+			DefaultValue: roundingConfigMap,
+			Description:  "Default rounding policy (mode, line item or invoice level, unit rate precision and per currency overrides)",
+		},
+		SettingKeyDunningConfig: {
+			Key:          SettingKeyDunningConfig,
+			DefaultValue: dunningConfigMap,
+			Description:  "Dunning policy for failed invoice payments (retry schedule, notifications per step and final action)",
+		},
+		SettingKeySpendLimitConfig: {
+			Key:          SettingKeySpendLimitConfig,
+			DefaultValue: spendLimitConfigMap,
+			Description:  "Spend limit enforcement when the prepaid wallet balance of a customer reaches the floor (enforcement mode and floor)",
+		},
 	}, nil
 }
 
@@ -448,6 +487,27 @@ func ValidateSettingValue(key SettingKey, value map[string]interface{}) error {
 		}
 		return config.Validate()
 
+	case SettingKeyRoundingConfig:
+		config, err := utils.ToStruct[RoundingConfig](value)
+		if err != nil {
+			return err
+		}
+		return config.Validate()
+
+	case SettingKeyDunningConfig:
+		config, err := utils.ToStruct[DunningConfig](value)
+		if err != nil {
+			return err
+		}
+		return config.Validate()
+
+	case SettingKeySpendLimitConfig:
+		config, err := utils.ToStruct[SpendLimitConfig](value)
+		if err != nil {
+			return err
+		}
+		return config.Validate()
+
 	default:
 		return ierr.NewErrorf("unknown setting key: %s", key).
 			WithHintf("Unknown setting key: %s", key).
@@ -0,0 +1,132 @@
+package types
+
+import (
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// SpendLimitEnforcementMode determines what happens to the subscriptions of a customer whose
+// prepaid wallet balance reaches the spend limit floor
+type SpendLimitEnforcementMode string
+
+const (
+	// SpendLimitEnforcementModeNone only raises the wallet balance alerts, usage is not limited
+	SpendLimitEnforcementModeNone SpendLimitEnforcementMode = "none"
+	// SpendLimitEnforcementModeBlockUsage denies the metered features of the subscriptions
+	SpendLimitEnforcementModeBlockUsage SpendLimitEnforcementMode = "block_usage"
+	// SpendLimitEnforcementModeSuspend denies every feature of the subscriptions
+	SpendLimitEnforcementModeSuspend SpendLimitEnforcementMode = "suspend"
+)
+
+func (m SpendLimitEnforcementMode) String() string {
+	return string(m)
+}
+
+func (m SpendLimitEnforcementMode) Validate() error {
+	allowed := []SpendLimitEnforcementMode{
+		SpendLimitEnforcementModeNone,
+		SpendLimitEnforcementModeBlockUsage,
+		SpendLimitEnforcementModeSuspend,
+	}
+	if !lo.Contains(allowed, m) {
+		return ierr.NewErrorf("invalid spend limit enforcement mode: %s", m).
+			WithHint("Spend limit enforcement mode must be none, block_usage or suspend").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// LimitedState returns the spend limit state of the subscriptions of a customer whose balance
+// reached the floor
+func (m SpendLimitEnforcementMode) LimitedState() SpendLimitState {
+	switch m {
+	case SpendLimitEnforcementModeBlockUsage:
+		return SpendLimitStateUsageBlocked
+	case SpendLimitEnforcementModeSuspend:
+		return SpendLimitStateSuspended
+	default:
+		return SpendLimitStateOk
+	}
+}
+
+// SpendLimitState is the state a subscription is in with respect to the spend limit of its customer
+type SpendLimitState string
+
+const (
+	// SpendLimitStateOk means the subscription is not limited
+	SpendLimitStateOk SpendLimitState = "ok"
+	// SpendLimitStateUsageBlocked means the metered features of the subscription are denied
+	SpendLimitStateUsageBlocked SpendLimitState = "usage_blocked"
+	// SpendLimitStateSuspended means every feature of the subscription is denied
+	SpendLimitStateSuspended SpendLimitState = "suspended"
+)
+
+func (s SpendLimitState) String() string {
+	return string(s)
+}
+
+func (s SpendLimitState) Validate() error {
+	allowed := []SpendLimitState{
+		SpendLimitStateOk,
+		SpendLimitStateUsageBlocked,
+		SpendLimitStateSuspended,
+	}
+	if !lo.Contains(allowed, s) {
+		return ierr.NewErrorf("invalid spend limit state: %s", s).
+			WithHint("Spend limit state must be ok, usage_blocked or suspended").
+			WithReportableDetails(map[string]any{
+				"allowed": allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// IsLimited returns true if features of the subscription are denied
+func (s SpendLimitState) IsLimited() bool {
+	return s == SpendLimitStateUsageBlocked || s == SpendLimitStateSuspended
+}
+
+// DeniesFeature returns true if the state denies the entitlements of features of the given type
+func (s SpendLimitState) DeniesFeature(featureType FeatureType) bool {
+	switch s {
+	case SpendLimitStateSuspended:
+		return true
+	case SpendLimitStateUsageBlocked:
+		return featureType == FeatureTypeMetered
+	default:
+		return false
+	}
+}
+
+// SpendLimitConfig represents the spend limit enforcement of an environment. The subscriptions of
+// a customer are limited while the real-time balance of the customer's active prepaid wallets in
+// the subscription currency is at or below the floor, and are restored once a top-up brings the
+// balance back above it.
+type SpendLimitConfig struct {
+	EnforcementMode SpendLimitEnforcementMode `json:"enforcement_mode"`
+	// Floor is the real-time wallet balance, in the wallet currency, at or below which usage is limited
+	Floor decimal.Decimal `json:"floor" swaggertype:"string"`
+}
+
+// DefaultSpendLimitConfig returns the spend limit enforcement used when an environment has not configured one
+func DefaultSpendLimitConfig() SpendLimitConfig {
+	return SpendLimitConfig{
+		EnforcementMode: SpendLimitEnforcementModeNone,
+		Floor:           decimal.Zero,
+	}
+}
+
+// Validate implements SettingConfig interface
+func (c SpendLimitConfig) Validate() error {
+	return c.EnforcementMode.Validate()
+}
+
+// IsEnabled returns true if usage is limited when the balance reaches the floor
+func (c SpendLimitConfig) IsEnabled() bool {
+	return c.EnforcementMode != SpendLimitEnforcementModeNone
+}
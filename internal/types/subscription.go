@@ -61,6 +61,10 @@ const (
 	SubscriptionStatusIncomplete SubscriptionStatus = "incomplete"
 	SubscriptionStatusTrialing   SubscriptionStatus = "trialing"
 	SubscriptionStatusDraft      SubscriptionStatus = "draft"
+	// SubscriptionStatusPastDue is the status of a subscription with an invoice in dunning
+	SubscriptionStatusPastDue SubscriptionStatus = "past_due"
+	// SubscriptionStatusUnpaid is the status of a subscription whose dunning ended without payment
+	SubscriptionStatusUnpaid SubscriptionStatus = "unpaid"
 )
 
 func (s SubscriptionStatus) String() string {
@@ -75,6 +79,8 @@ func (s SubscriptionStatus) Validate() error {
 		SubscriptionStatusIncomplete,
 		SubscriptionStatusTrialing,
 		SubscriptionStatusDraft,
+		SubscriptionStatusPastDue,
+		SubscriptionStatusUnpaid,
 	}
 
 	if s != "" && !lo.Contains(allowed, s) {
@@ -89,6 +95,97 @@ func (s SubscriptionStatus) Validate() error {
 	return nil
 }
 
+// TrialEndAction determines what happens to a trialing subscription when its trial ends
+type TrialEndAction string
+
+const (
+	// TrialEndActionConvert converts the subscription to active and starts billing
+	TrialEndActionConvert TrialEndAction = "convert"
+	// TrialEndActionCancel cancels the subscription without billing it
+	TrialEndActionCancel TrialEndAction = "cancel"
+	// TrialEndActionPauseIfNoPaymentMethod converts the subscription when the customer has a
+	// payment method and pauses it otherwise
+	TrialEndActionPauseIfNoPaymentMethod TrialEndAction = "pause_if_no_payment_method"
+)
+
+func (a TrialEndAction) String() string {
+	return string(a)
+}
+
+func (a TrialEndAction) Validate() error {
+	allowed := []TrialEndAction{
+		TrialEndActionConvert,
+		TrialEndActionCancel,
+		TrialEndActionPauseIfNoPaymentMethod,
+	}
+
+	if a != "" && !lo.Contains(allowed, a) {
+		return ierr.NewError("invalid trial end action").
+			WithHint("Trial end action must be one of convert, cancel or pause_if_no_payment_method").
+			WithReportableDetails(map[string]any{
+				"trial_end_action": a,
+				"allowed_actions":  allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+// BackdatedBillingMode determines how the billing periods of a backdated subscription that ended
+// before the subscription was created are invoiced
+type BackdatedBillingMode string
+
+const (
+	// BackdatedBillingModePerPeriod generates one invoice for each missed billing period
+	BackdatedBillingModePerPeriod BackdatedBillingMode = "per_period"
+	// BackdatedBillingModeCatchUp generates a single catch-up invoice with the charges of all
+	// missed billing periods
+	BackdatedBillingModeCatchUp BackdatedBillingMode = "catch_up"
+)
+
+func (m BackdatedBillingMode) String() string {
+	return string(m)
+}
+
+func (m BackdatedBillingMode) Validate() error {
+	allowed := []BackdatedBillingMode{
+		BackdatedBillingModePerPeriod,
+		BackdatedBillingModeCatchUp,
+	}
+
+	if m != "" && !lo.Contains(allowed, m) {
+		return ierr.NewError("invalid backdated billing mode").
+			WithHint("Backdated billing mode must be one of per_period or catch_up").
+			WithReportableDetails(map[string]any{
+				"backdated_billing_mode": m,
+				"allowed_modes":          allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+	return nil
+}
+
+const (
+	// MAX_SUBSCRIPTION_TERM_MONTHS bounds the length of the contract term of a subscription
+	MAX_SUBSCRIPTION_TERM_MONTHS = 120
+)
+
+// SubscriptionRenewalAction is what happens to a term-based subscription at the end of its current term
+type SubscriptionRenewalAction string
+
+const (
+	// SubscriptionRenewalActionRenew starts a new term on the same plan
+	SubscriptionRenewalActionRenew SubscriptionRenewalAction = "renew"
+	// SubscriptionRenewalActionRenewToPlan starts a new term on the renewal plan of the subscription
+	SubscriptionRenewalActionRenewToPlan SubscriptionRenewalAction = "renew_to_plan"
+	// SubscriptionRenewalActionCancel cancels the subscription as it is not renewed
+	SubscriptionRenewalActionCancel SubscriptionRenewalAction = "cancel"
+)
+
+func (a SubscriptionRenewalAction) String() string {
+	return string(a)
+}
+
 // PaymentBehavior determines how subscription payments are handled
 type PaymentBehavior string
 
@@ -236,6 +333,12 @@ type SubscriptionFilter struct {
 	SubscriptionStatusNotIn []SubscriptionStatus `json:"-"`
 	// ActiveAt filters subscriptions that are active at the given time
 	ActiveAt *time.Time `json:"active_at,omitempty" form:"active_at"`
+	// TrialEndBefore filters subscriptions whose trial ended before the given time
+	TrialEndBefore *time.Time `json:"-"`
+	// CurrentTermEndBefore filters fixed-term subscriptions whose current term ends before the given time
+	CurrentTermEndBefore *time.Time `json:"-"`
+	// ExcludeBackdatedBillingPending skips backdated subscriptions whose missed periods are being billed
+	ExcludeBackdatedBillingPending bool `json:"-"`
 
 	// WithLineItems includes line items in the response
 	WithLineItems bool `json:"with_line_items,omitempty" form:"with_line_items"`
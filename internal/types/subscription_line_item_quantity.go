@@ -0,0 +1,133 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// QuantityChangeStatus is the status of a quantity change of a subscription line item
+type QuantityChangeStatus string
+
+const (
+	// QuantityChangeStatusApplied is a change that is in effect from its effective date
+	QuantityChangeStatusApplied QuantityChangeStatus = "applied"
+	// QuantityChangeStatusScheduled is a change that takes effect at a future period boundary
+	QuantityChangeStatusScheduled QuantityChangeStatus = "scheduled"
+	// QuantityChangeStatusCancelled is a scheduled change that was superseded before taking effect
+	QuantityChangeStatusCancelled QuantityChangeStatus = "cancelled"
+)
+
+// QuantityDecreaseBehavior determines how a quantity decrease of a line item is applied
+type QuantityDecreaseBehavior string
+
+const (
+	// QuantityDecreaseBehaviorNextPeriod keeps the current quantity until the end of the
+	// current period and applies the decrease from the next period
+	QuantityDecreaseBehaviorNextPeriod QuantityDecreaseBehavior = "next_period"
+	// QuantityDecreaseBehaviorImmediateCredit applies the decrease immediately and credits
+	// the unused time of advance billed items to the customer's wallet
+	QuantityDecreaseBehaviorImmediateCredit QuantityDecreaseBehavior = "immediate_credit"
+)
+
+func (b QuantityDecreaseBehavior) Validate() error {
+	allowed := []QuantityDecreaseBehavior{
+		QuantityDecreaseBehaviorNextPeriod,
+		QuantityDecreaseBehaviorImmediateCredit,
+	}
+
+	if !lo.Contains(allowed, b) {
+		return ierr.NewError("invalid quantity decrease behavior").
+			WithHint("Quantity decrease behavior must be next_period or immediate_credit").
+			WithReportableDetails(map[string]interface{}{
+				"decrease_behavior": b,
+				"allowed_values":    allowed,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// LineItemQuantityChange is an entry of the quantity history of a subscription line item
+type LineItemQuantityChange struct {
+	ID             string               `json:"id"`
+	OldQuantity    decimal.Decimal      `json:"old_quantity" swaggertype:"string"`
+	NewQuantity    decimal.Decimal      `json:"new_quantity" swaggertype:"string"`
+	EffectiveDate  time.Time            `json:"effective_date"`
+	Status         QuantityChangeStatus `json:"status"`
+	ProratedAmount decimal.Decimal      `json:"prorated_amount" swaggertype:"string"`
+	InvoiceID      string               `json:"invoice_id,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	CreatedBy      string               `json:"created_by,omitempty"`
+}
+
+// QuantitySegment is a part of a billing period during which a line item had a constant quantity
+type QuantitySegment struct {
+	Start    time.Time       `json:"start"`
+	End      time.Time       `json:"end"`
+	Quantity decimal.Decimal `json:"quantity" swaggertype:"string"`
+}
+
+// QuantityAt returns the quantity in effect at t given the current quantity and the quantity
+// history. Cancelled changes are ignored and changes are ordered by their effective date.
+func QuantityAt(current decimal.Decimal, changes []LineItemQuantityChange, t time.Time) decimal.Decimal {
+	active := activeQuantityChanges(changes)
+	if len(active) == 0 {
+		return current
+	}
+
+	// The last change in effect at t determines the quantity
+	for i := len(active) - 1; i >= 0; i-- {
+		if !active[i].EffectiveDate.After(t) {
+			return active[i].NewQuantity
+		}
+	}
+
+	// t is before all changes, the first change knows the quantity it replaced
+	return active[0].OldQuantity
+}
+
+// QuantitySegments splits [start, end) into segments of constant quantity using the
+// quantity history. It always returns at least one segment when start is before end.
+func QuantitySegments(current decimal.Decimal, changes []LineItemQuantityChange, start, end time.Time) []QuantitySegment {
+	if !start.Before(end) {
+		return nil
+	}
+
+	segments := []QuantitySegment{{Start: start, Quantity: QuantityAt(current, changes, start)}}
+	for _, change := range activeQuantityChanges(changes) {
+		if !change.EffectiveDate.After(start) || !change.EffectiveDate.Before(end) {
+			continue
+		}
+
+		last := &segments[len(segments)-1]
+		if last.Quantity.Equal(change.NewQuantity) {
+			continue
+		}
+		if last.Start.Equal(change.EffectiveDate) {
+			last.Quantity = change.NewQuantity
+			continue
+		}
+
+		last.End = change.EffectiveDate
+		segments = append(segments, QuantitySegment{Start: change.EffectiveDate, Quantity: change.NewQuantity})
+	}
+	segments[len(segments)-1].End = end
+
+	return segments
+}
+
+// activeQuantityChanges returns the changes that are not cancelled ordered by effective date
+func activeQuantityChanges(changes []LineItemQuantityChange) []LineItemQuantityChange {
+	active := lo.Filter(changes, func(c LineItemQuantityChange, _ int) bool {
+		return c.Status != QuantityChangeStatusCancelled
+	})
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].EffectiveDate.Before(active[j].EffectiveDate)
+	})
+	return active
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantitySegments(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	day := func(d int) time.Time { return start.AddDate(0, 0, d) }
+
+	changes := []LineItemQuantityChange{
+		{OldQuantity: decimal.NewFromInt(5), NewQuantity: decimal.NewFromInt(3), EffectiveDate: end, Status: QuantityChangeStatusScheduled},
+		{OldQuantity: decimal.NewFromInt(2), NewQuantity: decimal.NewFromInt(5), EffectiveDate: day(20), Status: QuantityChangeStatusApplied},
+		{OldQuantity: decimal.NewFromInt(5), NewQuantity: decimal.NewFromInt(1), EffectiveDate: day(25), Status: QuantityChangeStatusCancelled},
+		{OldQuantity: decimal.NewFromInt(1), NewQuantity: decimal.NewFromInt(2), EffectiveDate: day(10), Status: QuantityChangeStatusApplied},
+	}
+	current := decimal.NewFromInt(5)
+
+	assert.True(t, QuantityAt(current, changes, day(0)).Equal(decimal.NewFromInt(1)))
+	assert.True(t, QuantityAt(current, changes, day(10)).Equal(decimal.NewFromInt(2)))
+	assert.True(t, QuantityAt(current, changes, day(26)).Equal(decimal.NewFromInt(5)))
+	assert.True(t, QuantityAt(current, changes, end).Equal(decimal.NewFromInt(3)))
+	assert.True(t, QuantityAt(current, nil, end).Equal(current))
+
+	segments := QuantitySegments(current, changes, start, end)
+	require.Len(t, segments, 3)
+	assert.Equal(t, []time.Time{start, day(10), day(20)}, []time.Time{segments[0].Start, segments[1].Start, segments[2].Start})
+	assert.True(t, segments[2].End.Equal(end))
+	assert.True(t, segments[1].Quantity.Equal(decimal.NewFromInt(2)))
+
+	// A change at the start of the period only determines the quantity of the first segment
+	segments = QuantitySegments(current, changes, day(10), end)
+	require.Len(t, segments, 2)
+	assert.True(t, segments[0].Quantity.Equal(decimal.NewFromInt(2)))
+
+	assert.Nil(t, QuantitySegments(current, changes, end, end))
+}
@@ -0,0 +1,122 @@
+package types
+
+import "github.com/samber/lo"
+
+// SubscriptionMigrationStatus is the outcome of the migration of a single subscription of a bulk
+// subscription migration
+type SubscriptionMigrationStatus string
+
+const (
+	// SubscriptionMigrationStatusPending means the subscription was selected and is not migrated yet
+	SubscriptionMigrationStatusPending SubscriptionMigrationStatus = "pending"
+
+	// SubscriptionMigrationStatusMigrated means the subscription was moved to the target plan
+	SubscriptionMigrationStatusMigrated SubscriptionMigrationStatus = "migrated"
+
+	// SubscriptionMigrationStatusScheduled means the plan change was scheduled for the end of the
+	// current billing period of the subscription
+	SubscriptionMigrationStatusScheduled SubscriptionMigrationStatus = "scheduled"
+
+	// SubscriptionMigrationStatusFailed means the subscription could not be migrated
+	SubscriptionMigrationStatusFailed SubscriptionMigrationStatus = "failed"
+)
+
+func (s SubscriptionMigrationStatus) String() string {
+	return string(s)
+}
+
+const (
+	// DefaultSubscriptionMigrationBatchSize is the number of subscriptions migrated per batch
+	DefaultSubscriptionMigrationBatchSize = 50
+
+	// MaxSubscriptionMigrationBatchSize is the largest allowed batch size of a migration
+	MaxSubscriptionMigrationBatchSize = 500
+)
+
+// SubscriptionMigrationResultFilter defines filters for querying the results of a subscription migration
+type SubscriptionMigrationResultFilter struct {
+	*QueryFilter
+
+	// TaskID is the migration task of the results, it is set from the path of the request
+	TaskID string `json:"-" form:"-"`
+	// MigrationStatus filters results by the outcome of the migration of their subscription
+	MigrationStatus []SubscriptionMigrationStatus `json:"migration_status,omitempty" form:"migration_status"`
+	// SubscriptionIDs filters results by subscription
+	SubscriptionIDs []string `json:"subscription_ids,omitempty" form:"subscription_ids"`
+}
+
+// NewSubscriptionMigrationResultFilter creates a new subscription migration result filter with default
+// options, results are returned in the order the subscriptions were selected
+func NewSubscriptionMigrationResultFilter() *SubscriptionMigrationResultFilter {
+	filter := &SubscriptionMigrationResultFilter{
+		QueryFilter: NewDefaultQueryFilter(),
+	}
+	filter.Order = lo.ToPtr("asc")
+	return filter
+}
+
+// Validate validates the subscription migration result filter
+func (f *SubscriptionMigrationResultFilter) Validate() error {
+	if f.QueryFilter != nil {
+		if err := f.QueryFilter.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLimit returns the limit value for the filter
+func (f *SubscriptionMigrationResultFilter) GetLimit() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetLimit()
+	}
+	return f.QueryFilter.GetLimit()
+}
+
+// GetOffset returns the offset value for the filter
+func (f *SubscriptionMigrationResultFilter) GetOffset() int {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOffset()
+	}
+	return f.QueryFilter.GetOffset()
+}
+
+// GetSort returns the sort value for the filter
+func (f *SubscriptionMigrationResultFilter) GetSort() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetSort()
+	}
+	return f.QueryFilter.GetSort()
+}
+
+// GetOrder returns the order value for the filter
+func (f *SubscriptionMigrationResultFilter) GetOrder() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetOrder()
+	}
+	return f.QueryFilter.GetOrder()
+}
+
+// GetStatus returns the status value for the filter
+func (f *SubscriptionMigrationResultFilter) GetStatus() string {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetStatus()
+	}
+	return f.QueryFilter.GetStatus()
+}
+
+// GetExpand returns the expand value for the filter
+func (f *SubscriptionMigrationResultFilter) GetExpand() Expand {
+	if f.QueryFilter == nil {
+		return NewDefaultQueryFilter().GetExpand()
+	}
+	return f.QueryFilter.GetExpand()
+}
+
+// IsUnlimited returns whether the filter has unlimited pagination
+func (f *SubscriptionMigrationResultFilter) IsUnlimited() bool {
+	if f.QueryFilter == nil {
+		return false
+	}
+	return f.QueryFilter.IsUnlimited()
+}
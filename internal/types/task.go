@@ -10,8 +10,10 @@ import (
 type TaskType string
 
 const (
-	TaskTypeImport TaskType = "IMPORT"
-	TaskTypeExport TaskType = "EXPORT"
+	TaskTypeImport     TaskType = "IMPORT"
+	TaskTypeExport     TaskType = "EXPORT"
+	TaskTypeSimulation TaskType = "SIMULATION"
+	TaskTypeMigration  TaskType = "MIGRATION"
 )
 
 func (t TaskType) String() string {
@@ -22,6 +24,8 @@ func (t TaskType) Validate() error {
 	allowed := []TaskType{
 		TaskTypeImport,
 		TaskTypeExport,
+		TaskTypeSimulation,
+		TaskTypeMigration,
 	}
 	if !lo.Contains(allowed, t) {
 		return ierr.NewError("invalid task type").
@@ -38,6 +42,9 @@ const (
 	EntityTypePrices    EntityType = "PRICES"
 	EntityTypeCustomers EntityType = "CUSTOMERS"
 	EntityTypeFeatures  EntityType = "FEATURES"
+
+	EntityTypePricingSimulation     EntityType = "PRICING_SIMULATION"
+	EntityTypeSubscriptionMigration EntityType = "SUBSCRIPTION_MIGRATION"
 )
 
 func (e EntityType) String() string {
@@ -50,6 +57,8 @@ func (e EntityType) Validate() error {
 		EntityTypePrices,
 		EntityTypeCustomers,
 		EntityTypeFeatures,
+		EntityTypePricingSimulation,
+		EntityTypeSubscriptionMigration,
 	}
 	if !lo.Contains(allowed, e) {
 		return ierr.NewError("invalid entity type").
@@ -90,6 +99,7 @@ const (
 	TaskStatusProcessing TaskStatus = "PROCESSING"
 	TaskStatusCompleted  TaskStatus = "COMPLETED"
 	TaskStatusFailed     TaskStatus = "FAILED"
+	TaskStatusPaused     TaskStatus = "PAUSED"
 )
 
 func (s TaskStatus) String() string {
@@ -102,6 +112,7 @@ func (s TaskStatus) Validate() error {
 		TaskStatusProcessing,
 		TaskStatusCompleted,
 		TaskStatusFailed,
+		TaskStatusPaused,
 	}
 	if !lo.Contains(allowed, s) {
 		return ierr.NewError("invalid task status").
@@ -71,6 +71,21 @@ const (
 	TemporalReprocessEventsWorkflow             TemporalWorkflowType = "ReprocessEventsWorkflow"
 	TemporalReprocessRawEventsWorkflow          TemporalWorkflowType = "ReprocessRawEventsWorkflow"
 	TemporalReprocessEventsForPlanWorkflow      TemporalWorkflowType = "ReprocessEventsForPlanWorkflow"
+	TemporalPricingSimulationWorkflow           TemporalWorkflowType = "PricingSimulationWorkflow"
+	TemporalSubscriptionTrialWorkflow           TemporalWorkflowType = "SubscriptionTrialWorkflow"
+	TemporalSubscriptionThresholdWorkflow       TemporalWorkflowType = "SubscriptionThresholdWorkflow"
+	TemporalBackdatedBillingWorkflow            TemporalWorkflowType = "BackdatedSubscriptionBillingWorkflow"
+	TemporalSpendLimitWorkflow                  TemporalWorkflowType = "SpendLimitWorkflow"
+	TemporalSubscriptionMigrationWorkflow       TemporalWorkflowType = "SubscriptionMigrationWorkflow"
+	TemporalInvoiceDunningWorkflow              TemporalWorkflowType = "InvoiceDunningWorkflow"
+)
+
+// Signals of the subscription migration workflow
+const (
+	// TemporalSignalPauseSubscriptionMigration pauses a migration once the batch in flight completes
+	TemporalSignalPauseSubscriptionMigration = "pause_subscription_migration"
+	// TemporalSignalResumeSubscriptionMigration resumes a paused migration
+	TemporalSignalResumeSubscriptionMigration = "resume_subscription_migration"
 )
 
 // WorkflowTypesExcludedFromTracking are workflow types that are not persisted to the
@@ -115,6 +130,13 @@ func (w TemporalWorkflowType) Validate() error {
 		TemporalReprocessEventsWorkflow,             // "ReprocessEventsWorkflow"
 		TemporalReprocessRawEventsWorkflow,          // "ReprocessRawEventsWorkflow"
 		TemporalReprocessEventsForPlanWorkflow,      // "ReprocessEventsForPlanWorkflow"
+		TemporalPricingSimulationWorkflow,           // "PricingSimulationWorkflow"
+		TemporalSubscriptionTrialWorkflow,           // "SubscriptionTrialWorkflow"
+		TemporalSubscriptionThresholdWorkflow,       // "SubscriptionThresholdWorkflow"
+		TemporalBackdatedBillingWorkflow,            // "BackdatedSubscriptionBillingWorkflow"
+		TemporalSpendLimitWorkflow,                  // "SpendLimitWorkflow"
+		TemporalSubscriptionMigrationWorkflow,       // "SubscriptionMigrationWorkflow"
+		TemporalInvoiceDunningWorkflow,              // "InvoiceDunningWorkflow"
 	}
 	if lo.Contains(allowedWorkflows, w) {
 		return nil
@@ -128,7 +150,7 @@ func (w TemporalWorkflowType) Validate() error {
 // TaskQueue returns the logical task queue for the workflow
 func (w TemporalWorkflowType) TaskQueue() TemporalTaskQueue {
 	switch w {
-	case TemporalTaskProcessingWorkflow, TemporalSubscriptionChangeWorkflow, TemporalSubscriptionCreationWorkflow, TemporalHubSpotDealSyncWorkflow, TemporalHubSpotInvoiceSyncWorkflow, TemporalHubSpotQuoteSyncWorkflow, TemporalNomodInvoiceSyncWorkflow, TemporalMoyasarInvoiceSyncWorkflow:
+	case TemporalTaskProcessingWorkflow, TemporalSubscriptionChangeWorkflow, TemporalSubscriptionCreationWorkflow, TemporalHubSpotDealSyncWorkflow, TemporalHubSpotInvoiceSyncWorkflow, TemporalHubSpotQuoteSyncWorkflow, TemporalNomodInvoiceSyncWorkflow, TemporalMoyasarInvoiceSyncWorkflow, TemporalPricingSimulationWorkflow, TemporalSubscriptionMigrationWorkflow:
 		return TemporalTaskQueueTask
 	case TemporalPriceSyncWorkflow, TemporalQuickBooksPriceSyncWorkflow:
 		return TemporalTaskQueuePrice
@@ -136,9 +158,9 @@ func (w TemporalWorkflowType) TaskQueue() TemporalTaskQueue {
 		return TemporalTaskQueueExport
 	case TemporalScheduleSubscriptionBillingWorkflow:
 		return TemporalTaskQueueSubscription
-	case TemporalProcessSubscriptionBillingWorkflow:
+	case TemporalProcessSubscriptionBillingWorkflow, TemporalSubscriptionTrialWorkflow, TemporalSubscriptionThresholdWorkflow, TemporalBackdatedBillingWorkflow, TemporalSpendLimitWorkflow:
 		return TemporalTaskQueueSubscription
-	case TemporalProcessInvoiceWorkflow:
+	case TemporalProcessInvoiceWorkflow, TemporalInvoiceDunningWorkflow:
 		return TemporalTaskQueueInvoice
 	case TemporalCustomerOnboardingWorkflow, TemporalPrepareProcessedEventsWorkflow:
 		return TemporalTaskQueueWorkflows
@@ -170,6 +192,8 @@ func GetWorkflowsForTaskQueue(taskQueue TemporalTaskQueue) []TemporalWorkflowTyp
 			TemporalHubSpotQuoteSyncWorkflow,
 			TemporalNomodInvoiceSyncWorkflow,
 			TemporalMoyasarInvoiceSyncWorkflow,
+			TemporalPricingSimulationWorkflow,
+			TemporalSubscriptionMigrationWorkflow,
 		}
 	case TemporalTaskQueuePrice:
 		return []TemporalWorkflowType{
@@ -184,10 +208,15 @@ func GetWorkflowsForTaskQueue(taskQueue TemporalTaskQueue) []TemporalWorkflowTyp
 		return []TemporalWorkflowType{
 			TemporalScheduleSubscriptionBillingWorkflow,
 			TemporalProcessSubscriptionBillingWorkflow,
+			TemporalSubscriptionTrialWorkflow,
+			TemporalSubscriptionThresholdWorkflow,
+			TemporalBackdatedBillingWorkflow,
+			TemporalSpendLimitWorkflow,
 		}
 	case TemporalTaskQueueInvoice:
 		return []TemporalWorkflowType{
 			TemporalProcessInvoiceWorkflow,
+			TemporalInvoiceDunningWorkflow,
 		}
 	case TemporalTaskQueueWorkflows:
 		return []TemporalWorkflowType{
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	ierr "github.com/flexprice/flexprice/internal/errors"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PriceTimeWindow is a recurring window of the week during which usage of a price is
+// rated at a different unit amount (time-of-use pricing), e.g. off-peak hours or weekends.
+//
+// Windows are evaluated in the customer's timezone. Days follow ISO-8601 numbering
+// (1 = Monday ... 7 = Sunday) and times are "HH:MM" in 24h format where start_time is
+// inclusive and end_time is exclusive. "24:00" can be used as end_time to cover the rest
+// of the day. A window that spans midnight must be configured as two windows.
+type PriceTimeWindow struct {
+	// Name identifies the window on invoices, e.g. "off_peak" or "weekend"
+	Name string `json:"name"`
+
+	// DaysOfWeek the window applies to. Empty means every day.
+	DaysOfWeek []int `json:"days_of_week,omitempty"`
+
+	// StartTime of the window in "HH:MM". Empty means start of the day.
+	StartTime string `json:"start_time,omitempty"`
+
+	// EndTime of the window in "HH:MM". Empty means end of the day.
+	EndTime string `json:"end_time,omitempty"`
+
+	// UnitAmount is the amount charged per unit for usage within the window
+	UnitAmount decimal.Decimal `json:"unit_amount" swaggertype:"string"`
+}
+
+// StartMinute returns the start of the window as minutes since midnight
+func (w PriceTimeWindow) StartMinute() int {
+	if w.StartTime == "" {
+		return 0
+	}
+	minutes, _ := parseClockMinutes(w.StartTime)
+	return minutes
+}
+
+// EndMinute returns the end of the window as minutes since midnight
+func (w PriceTimeWindow) EndMinute() int {
+	if w.EndTime == "" {
+		return 24 * 60
+	}
+	minutes, _ := parseClockMinutes(w.EndTime)
+	return minutes
+}
+
+// CoversFullDay returns true if the window is not restricted by the time of day
+func (w PriceTimeWindow) CoversFullDay() bool {
+	return w.StartMinute() == 0 && w.EndMinute() == 24*60
+}
+
+// Contains returns true if the given timestamp falls within the window when observed in loc
+func (w PriceTimeWindow) Contains(t time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+
+	if len(w.DaysOfWeek) > 0 {
+		// time.Weekday uses 0 for Sunday, ISO-8601 uses 7
+		day := int(local.Weekday())
+		if day == 0 {
+			day = 7
+		}
+		if !lo.Contains(w.DaysOfWeek, day) {
+			return false
+		}
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	return minute >= w.StartMinute() && minute < w.EndMinute()
+}
+
+// Validate validates the time window
+func (w PriceTimeWindow) Validate() error {
+	if w.Name == "" {
+		return ierr.NewError("time window name is required").
+			WithHint("Please provide a name for every time window").
+			Mark(ierr.ErrValidation)
+	}
+
+	for _, day := range w.DaysOfWeek {
+		if day < 1 || day > 7 {
+			return ierr.NewError("invalid day of week in time window").
+				WithHint("Days of week must be between 1 (Monday) and 7 (Sunday)").
+				WithReportableDetails(map[string]interface{}{
+					"name":        w.Name,
+					"day_of_week": day,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	for _, clock := range []string{w.StartTime, w.EndTime} {
+		if clock == "" {
+			continue
+		}
+		if _, err := parseClockMinutes(clock); err != nil {
+			return ierr.WithError(err).
+				WithHint("Time window start and end times must be in HH:MM format").
+				WithReportableDetails(map[string]interface{}{
+					"name": w.Name,
+					"time": clock,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+	}
+
+	if w.StartMinute() >= w.EndMinute() {
+		return ierr.NewError("time window start_time must be before end_time").
+			WithHint("Split windows spanning midnight into two windows").
+			WithReportableDetails(map[string]interface{}{
+				"name":       w.Name,
+				"start_time": w.StartTime,
+				"end_time":   w.EndTime,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	if w.UnitAmount.IsNegative() {
+		return ierr.NewError("time window unit_amount cannot be negative").
+			WithHint("Please provide a non-negative unit amount for the time window").
+			WithReportableDetails(map[string]interface{}{
+				"name": w.Name,
+			}).
+			Mark(ierr.ErrValidation)
+	}
+
+	return nil
+}
+
+// ValidateTimeWindows validates a list of time windows of a price
+func ValidateTimeWindows(windows []PriceTimeWindow) error {
+	names := make(map[string]bool, len(windows))
+	for _, w := range windows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		if names[w.Name] {
+			return ierr.NewError("duplicate time window name").
+				WithHint("Time window names must be unique within a price").
+				WithReportableDetails(map[string]interface{}{
+					"name": w.Name,
+				}).
+				Mark(ierr.ErrValidation)
+		}
+		names[w.Name] = true
+	}
+	return nil
+}
+
+// TimeWindowFilter restricts usage aggregation to events whose timestamp, observed in
+// Timezone, falls in the Include window and in none of the Exclude windows. A nil Include
+// matches every event, which is used to aggregate the usage outside of all windows.
+type TimeWindowFilter struct {
+	Timezone string            `json:"timezone"`
+	Include  *PriceTimeWindow  `json:"include,omitempty"`
+	Exclude  []PriceTimeWindow `json:"exclude,omitempty"`
+}
+
+// Location returns the resolved location of the filter timezone, defaulting to UTC
+func (f *TimeWindowFilter) Location() *time.Location {
+	if f == nil || f.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(ResolveTimezone(f.Timezone))
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Matches returns true if the timestamp passes the filter
+func (f *TimeWindowFilter) Matches(t time.Time) bool {
+	if f == nil {
+		return true
+	}
+	loc := f.Location()
+	if f.Include != nil && !f.Include.Contains(t, loc) {
+		return false
+	}
+	for _, w := range f.Exclude {
+		if w.Contains(t, loc) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseClockMinutes parses a "HH:MM" clock time into minutes since midnight.
+// "24:00" is accepted to denote the end of the day.
+func parseClockMinutes(clock string) (int, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &hours, &minutes); err != nil || len(clock) != 5 {
+		return 0, fmt.Errorf("invalid clock time %q", clock)
+	}
+	if hours == 24 && minutes == 0 {
+		return 24 * 60, nil
+	}
+	if hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("invalid clock time %q", clock)
+	}
+	return hours*60 + minutes, nil
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceTimeWindow_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  PriceTimeWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid_time_range",
+			window: PriceTimeWindow{Name: "off_peak", StartTime: "00:00", EndTime: "06:00", UnitAmount: decimal.NewFromFloat(0.5)},
+		},
+		{
+			name:   "valid_end_of_day",
+			window: PriceTimeWindow{Name: "evening", StartTime: "18:00", EndTime: "24:00"},
+		},
+		{
+			name:   "valid_days_only",
+			window: PriceTimeWindow{Name: "weekend", DaysOfWeek: []int{6, 7}},
+		},
+		{
+			name:    "missing_name",
+			window:  PriceTimeWindow{StartTime: "00:00", EndTime: "06:00"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_day",
+			window:  PriceTimeWindow{Name: "weekend", DaysOfWeek: []int{0}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_clock",
+			window:  PriceTimeWindow{Name: "night", StartTime: "9:00", EndTime: "10:00"},
+			wantErr: true,
+		},
+		{
+			name:    "overnight_window",
+			window:  PriceTimeWindow{Name: "night", StartTime: "22:00", EndTime: "06:00"},
+			wantErr: true,
+		},
+		{
+			name:    "negative_unit_amount",
+			window:  PriceTimeWindow{Name: "night", UnitAmount: decimal.NewFromInt(-1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.window.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	duplicate := []PriceTimeWindow{{Name: "night"}, {Name: "night"}}
+	assert.Error(t, ValidateTimeWindows(duplicate))
+}
+
+func TestTimeWindowFilter_Matches(t *testing.T) {
+	offPeak := PriceTimeWindow{Name: "off_peak", StartTime: "00:00", EndTime: "06:00"}
+	weekend := PriceTimeWindow{Name: "weekend", DaysOfWeek: []int{6, 7}}
+
+	// Saturday 2024-03-16 02:00 in Asia/Kolkata is Friday 20:30 UTC
+	saturdayNightIST := time.Date(2024, 3, 15, 20, 30, 0, 0, time.UTC)
+	// Wednesday 2024-03-13 12:00 UTC
+	wednesdayNoon := time.Date(2024, 3, 13, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		filter    *TimeWindowFilter
+		timestamp time.Time
+		expected  bool
+	}{
+		{
+			name:      "nil_filter_matches_everything",
+			filter:    nil,
+			timestamp: wednesdayNoon,
+			expected:  true,
+		},
+		{
+			name:      "include_evaluated_in_timezone",
+			filter:    &TimeWindowFilter{Timezone: "Asia/Kolkata", Include: &offPeak},
+			timestamp: saturdayNightIST,
+			expected:  true,
+		},
+		{
+			name:      "include_evaluated_in_utc",
+			filter:    &TimeWindowFilter{Include: &offPeak},
+			timestamp: saturdayNightIST,
+			expected:  false,
+		},
+		{
+			name:      "earlier_window_excluded",
+			filter:    &TimeWindowFilter{Timezone: "IST", Include: &weekend, Exclude: []PriceTimeWindow{offPeak}},
+			timestamp: saturdayNightIST,
+			expected:  false,
+		},
+		{
+			name:      "outside_all_windows",
+			filter:    &TimeWindowFilter{Timezone: "UTC", Exclude: []PriceTimeWindow{offPeak, weekend}},
+			timestamp: wednesdayNoon,
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Matches(tt.timestamp))
+		})
+	}
+}
@@ -11,6 +11,8 @@ type TemplateName string
 const (
 	// TemplateInvoiceDefault is the default invoice template
 	TemplateInvoiceDefault TemplateName = "invoice.typ"
+	// TemplateQuoteDefault is the default quote template
+	TemplateQuoteDefault TemplateName = "quote.typ"
 )
 
 func (t TemplateName) String() string {
@@ -75,9 +75,11 @@ const (
 	UUID_PREFIX_INVOICE_LINE_ITEM          = "inv_line"
 	UUID_PREFIX_SUBSCRIPTION               = "subs"
 	UUID_PREFIX_SUBSCRIPTION_LINE_ITEM     = "subs_line"
+	UUID_PREFIX_QUANTITY_CHANGE            = "qty_chg"
 	UUID_PREFIX_SUBSCRIPTION_PAUSE         = "pause"
 	UUID_PREFIX_SUBSCRIPTION_CHANGE        = "subsc"
 	UUID_PREFIX_SUBSCRIPTION_SCHEDULE      = "sched"
+	UUID_PREFIX_MIGRATION_RESULT           = "mig_res"
 	UUID_PREFIX_CUSTOMER                   = "cust"
 	UUID_PREFIX_CONNECTION                 = "conn"
 	UUID_PREFIX_WALLET                     = "wallet"
@@ -110,6 +112,10 @@ const (
 	UUID_PREFIX_GROUP                      = "group"
 	UUID_PREFIX_SUBSCRIPTION_ENTITLEMENT   = "sub_ent"
 	UUID_PREFIX_SUBSCRIPTION_PHASE         = "phase"
+	UUID_PREFIX_EXPERIMENT                 = "exp"
+	UUID_PREFIX_EXPERIMENT_ASSIGNMENT      = "exp_assign"
+	UUID_PREFIX_QUOTE                      = "quote"
+	UUID_PREFIX_QUOTE_LINE_ITEM            = "quote_line"
 
 	// Temporal workflow prefixes
 	UUID_PREFIX_WORKFLOW = "wf"
@@ -118,6 +124,7 @@ const (
 
 const (
 	SHORT_ID_PREFIX_CREDIT_NOTE = "CN-"
+	SHORT_ID_PREFIX_QUOTE       = "QT-"
 )
 
 // GenerateWorkflowID generates a unique workflow ID with workflow prefix
@@ -150,3 +157,10 @@ func GenerateWorkflowIDWithContext(workflowType, contextID string) string {
 	}
 	return fmt.Sprintf("%s_%s_%s_%s", UUID_PREFIX_WORKFLOW, workflowType, contextID, GenerateUUID())
 }
+
+// GenerateFixedWorkflowID generates the same workflow ID for every run of a workflow type for a
+// context, used by workflows that must only run once per context
+// Example: "wf_InvoiceDunningWorkflow_inv123"
+func GenerateFixedWorkflowID(workflowType, contextID string) string {
+	return fmt.Sprintf("%s_%s_%s", UUID_PREFIX_WORKFLOW, workflowType, contextID)
+}
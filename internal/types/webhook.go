@@ -21,6 +21,14 @@ const (
 	WebhookEventInvoiceCreateDraft = "invoice.create.drafted"
 )
 
+// invoice dunning event names
+const (
+	WebhookEventInvoiceDunningStarted   = "invoice.dunning.started"
+	WebhookEventInvoiceDunningStep      = "invoice.dunning.step"
+	WebhookEventInvoiceDunningRecovered = "invoice.dunning.recovered"
+	WebhookEventInvoiceDunningExhausted = "invoice.dunning.exhausted"
+)
+
 // subscription event names
 const (
 	WebhookEventSubscriptionCreated      = "subscription.created"
@@ -32,6 +40,25 @@ const (
 	WebhookEventSubscriptionResumed      = "subscription.resumed"
 )
 
+// subscription trial event names
+const (
+	WebhookEventSubscriptionTrialWillEnd  = "subscription.trial.will_end"
+	WebhookEventSubscriptionTrialEnded    = "subscription.trial.ended"
+	WebhookEventSubscriptionTrialExtended = "subscription.trial.extended"
+)
+
+// subscription spend limit event names
+const (
+	WebhookEventSubscriptionSpendLimitReached  = "subscription.spend_limit.reached"
+	WebhookEventSubscriptionSpendLimitRestored = "subscription.spend_limit.restored"
+)
+
+// subscription term event names
+const (
+	WebhookEventSubscriptionRenewed             = "subscription.renewed"
+	WebhookEventSubscriptionNonRenewalScheduled = "subscription.non_renewal.scheduled"
+)
+
 // subscription phase event names
 const (
 	WebhookEventSubscriptionPhaseCreated = "subscription.phase.created"
@@ -39,6 +39,18 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventInvoiceUpdate] = func() PayloadBuilder {
 		return NewInvoicePayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventInvoiceDunningStarted] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningStep] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningRecovered] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventInvoiceDunningExhausted] = func() PayloadBuilder {
+		return NewInvoicePayloadBuilder(f.services)
+	}
 
 	// Register communication builder
 	f.builders[types.WebhookEventInvoiceCommunicationTriggered] = func() PayloadBuilder {
@@ -70,6 +82,27 @@ func NewPayloadBuilderFactory(services *Services) PayloadBuilderFactory {
 	f.builders[types.WebhookEventSubscriptionRenewalDue] = func() PayloadBuilder {
 		return NewSubscriptionPayloadBuilder(f.services)
 	}
+	f.builders[types.WebhookEventSubscriptionTrialWillEnd] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionTrialEnded] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionTrialExtended] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionSpendLimitReached] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionSpendLimitRestored] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionRenewed] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
+	f.builders[types.WebhookEventSubscriptionNonRenewalScheduled] = func() PayloadBuilder {
+		return NewSubscriptionPayloadBuilder(f.services)
+	}
 
 	// Register feature builders
 	f.builders[types.WebhookEventFeatureCreated] = func() PayloadBuilder {